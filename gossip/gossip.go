@@ -180,6 +180,20 @@ func (g *Gossip) GetInfo(key string) (interface{}, error) {
 	return nil, util.Errorf("key %q does not exist or has expired", key)
 }
 
+// GetInfoWithTimestamp returns an info value by key together with
+// the wall time (Unix-nanos) at which it was originated, or an error
+// if specified key does not exist or has expired. As an info is only
+// replaced by one with a greater timestamp, the timestamp identifies
+// the value's version.
+func (g *Gossip) GetInfoWithTimestamp(key string) (interface{}, int64, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if i := g.is.getInfo(key); i != nil {
+		return i.Val, i.Timestamp, nil
+	}
+	return nil, 0, util.Errorf("key %q does not exist or has expired", key)
+}
+
 // GetInfosAsJSON returns the contents of the infostore, marshalled to
 // JSON.
 func (g *Gossip) GetInfosAsJSON() ([]byte, error) {
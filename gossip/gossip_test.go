@@ -79,6 +79,17 @@ func TestGossipInfoStore(t *testing.T) {
 	if _, err := g.GetInfo("s2"); err == nil {
 		t.Errorf("expected error fetching nonexistent key \"s2\"")
 	}
+	_, ts, err := g.GetInfoWithTimestamp("s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.AddInfo("s", "c", time.Hour)
+	if val, newTS, err := g.GetInfoWithTimestamp("s"); val.(string) != "c" || newTS <= ts || err != nil {
+		t.Errorf("expected replaced info to have later timestamp than %d; got %v at %d, %v", ts, val, newTS, err)
+	}
+	if _, _, err := g.GetInfoWithTimestamp("s2"); err == nil {
+		t.Errorf("expected error fetching timestamp of nonexistent key \"s2\"")
+	}
 }
 
 // TestGossipGroupsInfoStore verifies gossiping of groups via the
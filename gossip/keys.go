@@ -29,6 +29,18 @@ const (
 	// The value is a string UUID for the cluster.
 	KeyClusterID = "cluster-id"
 
+	// KeyClosedTimestampPrefix is the key prefix for gossiping range
+	// closed timestamps. The suffix is the decimal Raft ID of the range
+	// and the value is a proto.Timestamp.
+	KeyClosedTimestampPrefix = "closed-timestamp-"
+
+	// KeyConfigDeltaPrefix is the key prefix for gossiping the changes
+	// to a configuration map since it was last gossiped in full. The
+	// suffix is the gossip key of the configuration map and the value
+	// is a storage.PrefixConfigDelta struct, identifying the full map
+	// to which it applies by the map's info timestamp.
+	KeyConfigDeltaPrefix = "config-delta-"
+
 	// KeyConfigAccounting is the accounting configuration map.
 	KeyConfigAccounting = "accounting"
 
@@ -43,6 +55,17 @@ const (
 	// The value is a storage.StoreDescriptor struct.
 	KeyMaxAvailCapacityPrefix = "max-avail-capacity-"
 
+	// KeyRangeLoadPrefix is the key prefix for gossiping the load on a
+	// range. The suffix is the decimal Raft ID of the range and the
+	// value is a storage.RangeLoad struct.
+	KeyRangeLoadPrefix = "range-load-"
+
+	// KeyRouteHintPrefix is the key prefix for gossiping the replica
+	// holding a range's leader lease, to which clients should direct
+	// consistent reads and writes. The suffix is the decimal Raft ID
+	// of the range and the value is a proto.Replica.
+	KeyRouteHintPrefix = "route-hint-"
+
 	// KeyNodeCount is the count of gossip nodes in the network. The
 	// value is an int64 containing the count of nodes in the cluster.
 	// TODO(spencer): should remove this and instead just count the
@@ -66,6 +89,29 @@ const (
 	KeyFirstRangeDescriptor = "first-range"
 )
 
+// MakeClosedTimestampGossipKey returns the gossip key for a range's
+// closed timestamp.
+func MakeClosedTimestampGossipKey(raftID int64) string {
+	return KeyClosedTimestampPrefix + strconv.FormatInt(raftID, 10)
+}
+
+// MakeConfigDeltaGossipKey returns the gossip key for the changes to
+// the configuration map gossiped under key.
+func MakeConfigDeltaGossipKey(key string) string {
+	return KeyConfigDeltaPrefix + key
+}
+
+// MakeRangeLoadGossipKey returns the gossip key for a range's load.
+func MakeRangeLoadGossipKey(raftID int64) string {
+	return KeyRangeLoadPrefix + strconv.FormatInt(raftID, 10)
+}
+
+// MakeRouteHintGossipKey returns the gossip key for a range's route
+// hint.
+func MakeRouteHintGossipKey(raftID int64) string {
+	return KeyRouteHintPrefix + strconv.FormatInt(raftID, 10)
+}
+
 // MakeNodeIDGossipKey returns the gossip key for node ID info.
 func MakeNodeIDGossipKey(nodeID proto.NodeID) string {
 	return KeyNodeIDPrefix + strconv.FormatInt(int64(nodeID), 16)
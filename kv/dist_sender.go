@@ -124,14 +124,13 @@ func (ds *DistSender) verifyPermissions(method string, header *proto.RequestHead
 		return nil
 	}
 	// Get permissions map from gossip.
-	configMap, err := ds.gossip.GetInfo(gossip.KeyConfigPermission)
+	permMap, err := storage.GossipedConfigMap(ds.gossip, gossip.KeyConfigPermission)
 	if err != nil {
 		return util.Errorf("permissions not available via gossip")
 	}
-	if configMap == nil {
+	if permMap == nil {
 		return util.Errorf("perm configs not available; cannot execute %s", method)
 	}
-	permMap := configMap.(storage.PrefixConfigMap)
 	headerEnd := header.EndKey
 	if headerEnd == nil {
 		headerEnd = header.Key
@@ -32,6 +32,10 @@ import (
 	gogoproto "github.com/gogo/protobuf/proto"
 )
 
+// defaultIntentResolutionConcurrency is the default maximum number of
+// intent resolutions a TxnCoordSender runs at once.
+const defaultIntentResolutionConcurrency = 16
+
 // txnMetadata holds information about an ongoing transaction, as
 // seen from the perspective of this coordinator. It records all
 // keys (and key ranges) mutated as part of the transaction for
@@ -100,35 +104,73 @@ func (tm *txnMetadata) addKeyRange(start, end proto.Key) {
 	tm.keys.Add(key, nil)
 }
 
+// pointIntents returns the keys of single-key intents, as opposed to
+// key ranges, laid down by this transaction.
+func (tm *txnMetadata) pointIntents() []proto.Key {
+	var keys []proto.Key
+	for _, o := range tm.keys.GetOverlaps(engine.KeyMin, engine.KeyMax) {
+		start, end := o.Key.Start().(proto.Key), o.Key.End().(proto.Key)
+		if start.Next().Equal(end) {
+			keys = append(keys, start)
+		}
+	}
+	return keys
+}
+
+// removePointIntents removes the specified single-key intents from the
+// key ranges awaiting cleanup.
+func (tm *txnMetadata) removePointIntents(keys []proto.Key) {
+	for _, key := range keys {
+		for _, o := range tm.keys.GetOverlaps(key, key.Next()) {
+			if o.Key.Start().(proto.Key).Equal(key) && o.Key.End().(proto.Key).Equal(key.Next()) {
+				tm.keys.Del(o.Key)
+			}
+		}
+	}
+}
+
 // close sends resolve intent commands for all key ranges this
 // transaction has covered, clears the keys cache and closes the
-// metadata heartbeat.
-func (tm *txnMetadata) close(txn *proto.Transaction, sender client.KVSender) {
+// metadata heartbeat. Resolve intent commands are sent only while
+// holding a slot of the resolveSem semaphore. If wait is true, close
+// returns only once they have completed.
+func (tm *txnMetadata) close(txn *proto.Transaction, sender client.KVSender, resolveSem chan struct{}, wait bool) {
+	var wg sync.WaitGroup
 	if tm.keys.Len() > 0 {
 		log.V(1).Infof("cleaning up %d intent(s) for transaction %s", tm.keys.Len(), txn)
 	}
 	for _, o := range tm.keys.GetOverlaps(engine.KeyMin, engine.KeyMax) {
+		header := proto.RequestHeader{
+			Timestamp: txn.Timestamp,
+			Key:       o.Key.Start().(proto.Key),
+			User:      storage.UserRoot,
+			Txn:       txn,
+		}
 		call := &client.Call{
 			Method: proto.InternalResolveIntent,
-			Args: &proto.InternalResolveIntentRequest{
-				RequestHeader: proto.RequestHeader{
-					Timestamp: txn.Timestamp,
-					Key:       o.Key.Start().(proto.Key),
-					User:      storage.UserRoot,
-					Txn:       txn,
-				},
-			},
-			Reply: &proto.InternalResolveIntentResponse{},
+			Args:   &proto.InternalResolveIntentRequest{RequestHeader: header},
+			Reply:  &proto.InternalResolveIntentResponse{},
 		}
-		// Set the end key only if it's not equal to Key.Next(). This
-		// saves us from unnecessarily clearing intents as a range.
+		// Resolve the span with a single range resolve only if its end
+		// key isn't equal to Key.Next(). This saves us from
+		// unnecessarily clearing intents as a range.
 		endKey := o.Key.End().(proto.Key)
-		if !call.Args.Header().Key.Next().Equal(endKey) {
-			call.Args.Header().EndKey = endKey
+		if !header.Key.Next().Equal(endKey) {
+			header.EndKey = endKey
+			call = &client.Call{
+				Method: proto.InternalResolveIntentRange,
+				Args:   &proto.InternalResolveIntentRangeRequest{RequestHeader: header},
+				Reply:  &proto.InternalResolveIntentRangeResponse{},
+			}
 		}
 		// We don't care about the reply channel; these are best
-		// effort. We simply fire and forget, each in its own goroutine.
+		// effort. We simply fire and forget, each in its own goroutine,
+		// which waits its turn for a slot.
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
+			resolveSem <- struct{}{}
+			defer func() { <-resolveSem }()
 			log.V(1).Infof("cleaning up intent %q for txn %s", call.Args.Header().Key, txn)
 			sender.Send(call)
 			if call.Reply.Header().Error != nil {
@@ -138,6 +180,9 @@ func (tm *txnMetadata) close(txn *proto.Transaction, sender client.KVSender) {
 	}
 	tm.keys.Clear()
 	close(tm.closer)
+	if wait {
+		wg.Wait()
+	}
 }
 
 // A TxnCoordSender is an implementation of client.KVSender which
@@ -157,6 +202,7 @@ type TxnCoordSender struct {
 	sync.Mutex                                // Protects the txns map.
 	txns              map[string]*txnMetadata // txn key to metadata
 	linearizable      bool                    // Enables linearizable behaviour.
+	resolveSem        chan struct{}           // Bounds concurrent intent resolutions
 }
 
 // NewTxnCoordSender creates a new TxnCoordSender for use from a KV
@@ -170,20 +216,33 @@ func NewTxnCoordSender(wrapped client.KVSender, clock *hlc.Clock, linearizable b
 		clientTimeout:     defaultClientTimeout,
 		txns:              map[string]*txnMetadata{},
 		linearizable:      linearizable,
+		resolveSem:        make(chan struct{}, defaultIntentResolutionConcurrency),
 	}
 	return tc
 }
 
+// SetIntentResolutionConcurrency sets the maximum number of intent
+// resolutions the coordinator runs at once in cleaning up ended
+// transactions; further resolutions are queued. It must be called
+// before the coordinator is used.
+func (tc *TxnCoordSender) SetIntentResolutionConcurrency(n int) {
+	tc.resolveSem = make(chan struct{}, n)
+}
+
 // Send implements the client.KVSender interface. If the call is part
 // of a transaction, the coordinator will initialize the transaction
 // if it's not nil but has an empty ID.
 func (tc *TxnCoordSender) Send(call *client.Call) {
 	header := call.Args.Header()
+	newTxn := header.Txn != nil && len(header.Txn.ID) == 0
 	tc.maybeBeginTxn(header)
 
 	// Process batch specially; otherwise, send via wrapped sender.
 	if call.Method == proto.Batch {
-		tc.sendBatch(call.Args.(*proto.BatchRequest), call.Reply.(*proto.BatchResponse))
+		batchArgs, batchReply := call.Args.(*proto.BatchRequest), call.Reply.(*proto.BatchResponse)
+		if !newTxn || !tc.maybeOnePhaseCommit(batchArgs, batchReply) {
+			tc.sendBatch(batchArgs, batchReply)
+		}
 	} else {
 		tc.sendOne(call)
 	}
@@ -253,6 +312,19 @@ func (tc *TxnCoordSender) sendOne(call *client.Call) {
 			// Remember when EndTransaction started in case we want to
 			// be linearizable.
 			startNS = tc.clock.PhysicalNow()
+			if tc.maybeEndReadOnlyTxn(call) {
+				return
+			}
+			// Unless the commit is durable, which waits on intent
+			// resolution here, delegate resolution of single-key intents
+			// to the range holding the transaction record.
+			if args := call.Args.(*proto.EndTransactionRequest); !args.Durable && len(args.Intents) == 0 {
+				tc.Lock()
+				if txnMeta, ok := tc.txns[string(header.Txn.ID)]; ok {
+					args.Intents = txnMeta.pointIntents()
+				}
+				tc.Unlock()
+			}
 		}
 	}
 
@@ -265,12 +337,24 @@ func (tc *TxnCoordSender) sendOne(call *client.Call) {
 			call.Reply.Header().Txn = gogoproto.Clone(header.Txn).(*proto.Transaction)
 		}
 		tc.updateResponseTxn(header, call.Reply.Header())
+		// A restarted transaction runs at an incremented epoch.
+		if txn := call.Reply.Header().Txn; txn.Epoch > header.Txn.Epoch {
+			tc.bumpEpoch(txn)
+		}
 	}
 
 	// If successful, we're in a transaction, and the command leaves
-	// transactional intents, add the key or key range to the intents map.
-	// If the transaction metadata doesn't yet exist, create it.
-	if call.Reply.Header().GoError() == nil && header.Txn != nil && proto.IsTransactional(call.Method) {
+	// transactional intents or read locks, add the key or key range to
+	// the intents map. If the transaction metadata doesn't yet exist,
+	// create it. A transaction which writes its record explicitly is
+	// tracked too, though the record isn't an intent, so that
+	// EndTransaction is sent to finalize it.
+	leavesIntents := proto.IsTransactional(call.Method)
+	if args, ok := call.Args.(*proto.GetRequest); ok && args.Lock {
+		leavesIntents = true
+	}
+	writesRecord := call.Method == proto.InternalBeginTransaction
+	if call.Reply.Header().GoError() == nil && header.Txn != nil && (leavesIntents || writesRecord) {
 		tc.Lock()
 		var ok bool
 		var txnMeta *txnMetadata
@@ -291,7 +375,14 @@ func (tc *TxnCoordSender) sendOne(call *client.Call) {
 			go tc.heartbeat(header.Txn, txnMeta.closer)
 		}
 		txnMeta.lastUpdateTS = tc.clock.Now()
-		txnMeta.addKeyRange(header.Key, header.EndKey)
+		if leavesIntents {
+			txnMeta.addKeyRange(header.Key, header.EndKey)
+		}
+		// Accumulate the intents recorded by the range, which are
+		// included in the transaction record with each heartbeat.
+		for _, span := range call.Reply.Header().Txn.Intents {
+			txnMeta.txn.AddIntent(span.Key, span.EndKey)
+		}
 		tc.Unlock()
 	}
 
@@ -299,7 +390,7 @@ func (tc *TxnCoordSender) sendOne(call *client.Call) {
 	switch t := call.Reply.Header().GoError().(type) {
 	case *proto.TransactionAbortedError:
 		// If already aborted, cleanup the txn on this TxnCoordSender.
-		tc.cleanupTxn(&t.Txn)
+		tc.cleanupTxn(&t.Txn, false)
 	case *proto.OpRequiresTxnError:
 		// Run a one-off transaction with that single command.
 		log.Infof("%s: auto-wrapping in txn and re-executing", call.Method)
@@ -317,8 +408,10 @@ func (tc *TxnCoordSender) sendOne(call *client.Call) {
 		})
 	case nil:
 		var txn *proto.Transaction
+		var durable bool
 		if call.Method == proto.EndTransaction {
 			txn = call.Reply.Header().Txn
+			durable = call.Args.(*proto.EndTransactionRequest).Durable
 			// If the -linearizable flag is set, we want to make sure that
 			// all the clocks in the system are past the commit timestamp
 			// of the transaction. This is guaranteed if either
@@ -340,13 +433,118 @@ func (tc *TxnCoordSender) sendOne(call *client.Call) {
 			}
 		}
 		if txn != nil && txn.Status != proto.PENDING {
-			tc.cleanupTxn(txn)
+			// Intents delegated to the range needn't be resolved here.
+			if intents := call.Args.(*proto.EndTransactionRequest).Intents; len(intents) > 0 {
+				tc.Lock()
+				if txnMeta, ok := tc.txns[string(txn.ID)]; ok {
+					txnMeta.removePointIntents(intents)
+				}
+				tc.Unlock()
+			}
+			tc.cleanupTxn(txn, durable)
+		}
+	}
+}
+
+// maybeEndReadOnlyTxn ends the transaction specified in the
+// EndTransaction call without sending it if the transaction has
+// written neither intents nor its record via this coordinator and so
+// has no need of a transaction record. The reply transaction is marked committed or
+// aborted as requested, and the reply's commit timestamp and restart
+// count are taken from the transaction as the range would. Calls
+// carrying a commit trigger, and commits of serializable transactions
+// whose timestamp has moved, are always sent so that the range may
+// still reject them. Returns true if the call was handled.
+func (tc *TxnCoordSender) maybeEndReadOnlyTxn(call *client.Call) bool {
+	args := call.Args.(*proto.EndTransactionRequest)
+	if args.InternalCommitTrigger != nil {
+		return false
+	}
+	if args.Commit && args.Txn.Isolation == proto.SERIALIZABLE && !args.Txn.Timestamp.Equal(args.Txn.OrigTimestamp) {
+		return false
+	}
+	tc.Lock()
+	_, ok := tc.txns[string(args.Txn.ID)]
+	tc.Unlock()
+	if ok {
+		return false
+	}
+	reply := call.Reply.(*proto.EndTransactionResponse)
+	reply.Timestamp = args.Timestamp
+	reply.Txn = gogoproto.Clone(args.Txn).(*proto.Transaction)
+	reply.Restarts = args.Txn.Restarts
+	if args.Commit {
+		reply.Txn.Status = proto.COMMITTED
+		reply.CommitTimestamp = args.Txn.Timestamp
+	} else {
+		reply.Txn.Status = proto.ABORTED
+	}
+	return true
+}
+
+// maybeOnePhaseCommit commits a new transaction consisting solely of
+// a batched Put followed by a commit in one phase, by writing the
+// value non-transactionally at the transaction's timestamp. As the
+// transaction has performed no reads, its timestamp may be moved
+// forward freely, and no intent or transaction record is written.
+// If the Put fails, nothing has been written and the caller should
+// execute the batch as usual. Returns true if the batch was handled.
+func (tc *TxnCoordSender) maybeOnePhaseCommit(batchArgs *proto.BatchRequest, batchReply *proto.BatchResponse) bool {
+	if tc.linearizable || len(batchArgs.Requests) != 2 {
+		return false
+	}
+	putArgs, ok := batchArgs.Requests[0].GetValue().(*proto.PutRequest)
+	if !ok {
+		return false
+	}
+	etArgs, ok := batchArgs.Requests[1].GetValue().(*proto.EndTransactionRequest)
+	if !ok || !etArgs.Commit || etArgs.Durable || etArgs.InternalCommitTrigger != nil {
+		return false
+	}
+	txn := batchArgs.Txn
+	args := gogoproto.Clone(putArgs).(*proto.PutRequest)
+	args.Txn = nil
+	args.Timestamp = txn.Timestamp
+	if args.User == "" {
+		args.User = batchArgs.User
+	}
+	if args.UserPriority == nil {
+		args.UserPriority = batchArgs.UserPriority
+	}
+	reply := &proto.PutResponse{}
+	tc.wrapped.Send(&client.Call{Method: proto.Put, Args: args, Reply: reply})
+	if reply.GoError() != nil {
+		return false
+	}
+
+	// The transaction commits at the timestamp of the write.
+	committed := gogoproto.Clone(txn).(*proto.Transaction)
+	committed.Status = proto.COMMITTED
+	if committed.Timestamp.Less(reply.Timestamp) {
+		committed.Timestamp = reply.Timestamp
+	}
+	reply.Txn = gogoproto.Clone(committed).(*proto.Transaction)
+	etReply := &proto.EndTransactionResponse{}
+	etReply.Timestamp = committed.Timestamp
+	etReply.CommitTimestamp = committed.Timestamp
+	etReply.Txn = gogoproto.Clone(committed).(*proto.Transaction)
+	for i, r := range []proto.Response{reply, etReply} {
+		if i < len(batchReply.Responses) {
+			// Copy into the pre-initialized replies.
+			dst := batchReply.Responses[i].GetValue().(proto.Response)
+			gogoproto.Merge(dst, r)
+		} else {
+			batchReply.Add(r)
 		}
 	}
+	batchReply.Txn = committed
+	batchReply.Completed = int32(len(batchArgs.Requests))
+	return true
 }
 
 // sendBatch unrolls a batched command and sends each constituent
-// command in parallel.
+// command in parallel. On the first error, the remaining commands are
+// not sent and the batch reply records the number completed.
 func (tc *TxnCoordSender) sendBatch(batchArgs *proto.BatchRequest, batchReply *proto.BatchResponse) {
 	// Prepare the calls by unrolling the batch. If the batchReply is
 	// pre-initialized with replies, use those; otherwise create replies
@@ -354,6 +552,7 @@ func (tc *TxnCoordSender) sendBatch(batchArgs *proto.BatchRequest, batchReply *p
 	// TODO(spencer): send calls in parallel.
 	batchReply.Txn = batchArgs.Txn
 	for i := range batchArgs.Requests {
+		batchReply.Completed = int32(i)
 		// Initialize args header values where appropriate.
 		args := batchArgs.Requests[i].GetValue().(proto.Request)
 		method, err := proto.MethodForRequest(args)
@@ -390,6 +589,7 @@ func (tc *TxnCoordSender) sendBatch(batchArgs *proto.BatchRequest, batchReply *p
 			return
 		}
 	}
+	batchReply.Completed = int32(len(batchArgs.Requests))
 }
 
 // updateResponseTxn updates the response txn based on the response
@@ -448,16 +648,57 @@ func (tc *TxnCoordSender) updateResponseTxn(argsHeader *proto.RequestHeader, rep
 
 // cleanupTxn is called to resolve write intents which were set down over
 // the course of the transaction. The txnMetadata object is removed from
-// the txns map.
-func (tc *TxnCoordSender) cleanupTxn(txn *proto.Transaction) {
+// the txns map. If wait is true, cleanupTxn returns only once the
+// intents have been resolved.
+func (tc *TxnCoordSender) cleanupTxn(txn *proto.Transaction, wait bool) {
 	tc.Lock()
-	defer tc.Unlock()
 	txnMeta, ok := tc.txns[string(txn.ID)]
+	delete(tc.txns, string(txn.ID))
+	tc.Unlock()
 	if !ok {
 		return
 	}
-	txnMeta.close(txn, tc.wrapped)
-	delete(tc.txns, string(txn.ID))
+	txnMeta.close(txn, tc.wrapped, tc.resolveSem, wait)
+}
+
+// bumpEpoch records the incremented epoch of a restarted transaction and
+// immediately heartbeats the transaction so that its record reflects the
+// new epoch. Intents written during earlier epochs are then pushable by
+// conflicting transactions, and subsequent heartbeats carry the new
+// epoch.
+func (tc *TxnCoordSender) bumpEpoch(txn *proto.Transaction) {
+	tc.Lock()
+	txnMeta, ok := tc.txns[string(txn.ID)]
+	if ok {
+		txnMeta.txn.Update(txn)
+	}
+	tc.Unlock()
+	// If no intents have been laid down, there's no record to update.
+	if !ok {
+		return
+	}
+	if reply := tc.sendHeartbeat(txn); reply.GoError() != nil {
+		log.Warningf("failed to record epoch %d of %q:%q: %s", txn.Epoch, txn.Key, txn.ID, reply.GoError())
+	}
+}
+
+// sendHeartbeat sends an InternalHeartbeatTxn RPC for txn.
+func (tc *TxnCoordSender) sendHeartbeat(txn *proto.Transaction) *proto.InternalHeartbeatTxnResponse {
+	request := &proto.InternalHeartbeatTxnRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       txn.Key,
+			User:      storage.UserRoot,
+			Txn:       txn,
+			Timestamp: tc.clock.Now(),
+		},
+	}
+	reply := &proto.InternalHeartbeatTxnResponse{}
+	tc.wrapped.Send(&client.Call{
+		Method: proto.InternalHeartbeatTxn,
+		Args:   request,
+		Reply:  reply,
+	})
+	return reply
 }
 
 // hasClientAbandonedCoord returns true if the transaction specified by
@@ -485,13 +726,6 @@ func (tc *TxnCoordSender) hasClientAbandonedCoord(txnID []byte) bool {
 // aborted or committed or if the TxnCoordSender is closed.
 func (tc *TxnCoordSender) heartbeat(txn *proto.Transaction, closer chan struct{}) {
 	ticker := time.NewTicker(tc.heartbeatInterval)
-	request := &proto.InternalHeartbeatTxnRequest{
-		RequestHeader: proto.RequestHeader{
-			Key:  txn.Key,
-			User: storage.UserRoot,
-			Txn:  txn,
-		},
-	}
 
 	// Loop with ticker for periodic heartbeats.
 	for {
@@ -503,21 +737,20 @@ func (tc *TxnCoordSender) heartbeat(txn *proto.Transaction, closer chan struct{}
 				log.V(1).Infof("transaction %q:%q abandoned; stopping heartbeat", txn.Key, txn.ID)
 				return
 			}
-			request.Header().Timestamp = tc.clock.Now()
-			reply := &proto.InternalHeartbeatTxnResponse{}
-			call := &client.Call{
-				Method: proto.InternalHeartbeatTxn,
-				Args:   request,
-				Reply:  reply,
+			// Heartbeat with the latest epoch of the transaction.
+			tc.Lock()
+			if txnMeta, ok := tc.txns[string(txn.ID)]; ok {
+				txn = gogoproto.Clone(&txnMeta.txn).(*proto.Transaction)
 			}
-			tc.wrapped.Send(call)
+			tc.Unlock()
+			reply := tc.sendHeartbeat(txn)
 			// If the transaction is not in pending state, then we can stop
 			// the heartbeat. It's either aborted or committed, and we resolve
 			// write intents accordingly.
 			if reply.GoError() != nil {
 				log.Warningf("heartbeat to %q:%q failed: %s", txn.Key, txn.ID, reply.GoError())
 			} else if reply.Txn.Status != proto.PENDING {
-				tc.cleanupTxn(reply.Txn)
+				tc.cleanupTxn(reply.Txn, false)
 				return
 			}
 		case <-closer:
@@ -19,7 +19,10 @@ package kv
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -42,11 +45,19 @@ import (
 func createTestDB() (db *client.KV, eng engine.Engine, clock *hlc.Clock,
 	manual *hlc.ManualClock, lSender *LocalSender,
 	transport multiraft.Transport, err error) {
+	return createTestDBWithEngine(engine.NewInMem(proto.Attributes{}, 50<<20))
+}
+
+// createTestDBWithEngine is like createTestDB, but builds the store
+// using the specified engine.
+func createTestDBWithEngine(e engine.Engine) (db *client.KV, eng engine.Engine, clock *hlc.Clock,
+	manual *hlc.ManualClock, lSender *LocalSender,
+	transport multiraft.Transport, err error) {
+	eng = e
 	rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), rpc.LoadInsecureTLSConfig())
 	g := gossip.New(rpcContext, 250*time.Millisecond, "")
 	manual = hlc.NewManualClock(0)
 	clock = hlc.NewClock(manual.UnixNano)
-	eng = engine.NewInMem(proto.Attributes{}, 50<<20)
 	lSender = NewLocalSender()
 	sender := NewTxnCoordSender(lSender, clock, false)
 	db = client.NewKV(sender, nil)
@@ -412,6 +423,416 @@ func TestTxnCoordSenderEndTxn(t *testing.T) {
 	verifyCleanup(key, db, eng, t)
 }
 
+// TestTxnCoordSenderRepeatedWrites verifies that a transaction which
+// writes a key repeatedly keeps a single intent holding the last value,
+// and that only that value is committed.
+func TestTxnCoordSenderRepeatedWrites(t *testing.T) {
+	db, eng, clock, _, ls, transport, err := createTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	defer db.Close()
+	defer ls.Close()
+
+	key := proto.Key("a")
+	txn := newTxn(db, clock, key)
+	for i := 1; i <= 3; i++ {
+		pReply := &proto.PutResponse{}
+		value := []byte(fmt.Sprintf("value%d", i))
+		if err := db.Call(proto.Put, createPutRequest(key, value, txn), pReply); err != nil {
+			t.Fatal(err)
+		}
+		// Expect the MVCC metadata and a single intent.
+		kvs, err := engine.Scan(eng, engine.MVCCEncodeKey(key), engine.MVCCEncodeKey(key.Next()), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != 2 {
+			t.Fatalf("%d: expected metadata and a single intent; got %d keys", i, len(kvs))
+		}
+		if v, err := engine.MVCCGet(eng, key, txn.Timestamp, txn); err != nil || v == nil || !bytes.Equal(v.Bytes, value) {
+			t.Fatalf("%d: expected intent value %q; got %v, %v", i, value, v, err)
+		}
+	}
+
+	etReply := &proto.EndTransactionResponse{}
+	db.Sender().Send(&client.Call{
+		Method: proto.EndTransaction,
+		Args: &proto.EndTransactionRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       txn.Key,
+				Timestamp: txn.Timestamp,
+				Txn:       txn,
+			},
+			Commit: true,
+		},
+		Reply: etReply,
+	})
+	if etReply.Error != nil {
+		t.Fatal(etReply.GoError())
+	}
+	verifyCleanup(key, db, eng, t)
+
+	kvs, err := engine.Scan(eng, engine.MVCCEncodeKey(key), engine.MVCCEncodeKey(key.Next()), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 {
+		t.Errorf("expected metadata and a single committed value; got %d keys", len(kvs))
+	}
+	if v, err := engine.MVCCGet(eng, key, etReply.Txn.Timestamp, nil); err != nil || v == nil || !bytes.Equal(v.Bytes, []byte("value3")) {
+		t.Errorf("expected committed value %q; got %v, %v", "value3", v, err)
+	}
+}
+
+// TestTxnCoordSenderEndTxnDurable verifies that the reply to a durable
+// commit is returned only once the transaction's intents have been
+// resolved.
+func TestTxnCoordSenderEndTxnDurable(t *testing.T) {
+	db, eng, clock, _, ls, transport, err := createTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	defer db.Close()
+	defer ls.Close()
+
+	txn := newTxn(db, clock, proto.Key("a"))
+	keys := []proto.Key{proto.Key("a"), proto.Key("c"), proto.Key("e")}
+	for _, key := range keys {
+		pReply := &proto.PutResponse{}
+		if err := db.Call(proto.Put, createPutRequest(key, []byte("value"), txn), pReply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	etReply := &proto.EndTransactionResponse{}
+	db.Sender().Send(&client.Call{
+		Method: proto.EndTransaction,
+		Args: &proto.EndTransactionRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       txn.Key,
+				Timestamp: txn.Timestamp,
+				Txn:       txn,
+			},
+			Commit:  true,
+			Durable: true,
+		},
+		Reply: etReply,
+	})
+	if etReply.Error != nil {
+		t.Fatal(etReply.GoError())
+	}
+	for _, key := range keys {
+		meta := &proto.MVCCMetadata{}
+		ok, _, _, err := engine.GetProto(eng, engine.MVCCEncodeKey(key), meta)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok || meta.Txn != nil {
+			t.Errorf("expected intent on %q to be resolved before the reply; got %+v", key, meta)
+		}
+	}
+}
+
+// A resolveCountingSender counts the resolve intent commands sent
+// through it and records the most sent concurrently.
+type resolveCountingSender struct {
+	client.KVSender
+	inFlight, maxInFlight, resolved int32
+}
+
+func (s *resolveCountingSender) Send(call *client.Call) {
+	if call.Method != proto.InternalResolveIntent {
+		s.KVSender.Send(call)
+		return
+	}
+	n := atomic.AddInt32(&s.inFlight, 1)
+	for {
+		max := atomic.LoadInt32(&s.maxInFlight)
+		if n <= max || atomic.CompareAndSwapInt32(&s.maxInFlight, max, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	s.KVSender.Send(call)
+	atomic.AddInt32(&s.inFlight, -1)
+	atomic.AddInt32(&s.resolved, 1)
+}
+
+// TestTxnCoordSenderIntentResolutionConcurrency verifies that the
+// intents of an ended transaction are all resolved, with no more
+// resolutions running at once than the configured concurrency.
+func TestTxnCoordSenderIntentResolutionConcurrency(t *testing.T) {
+	db, _, clock, _, ls, transport, err := createTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	defer db.Close()
+	defer ls.Close()
+
+	const concurrency, intents = 4, 100
+	sender := &resolveCountingSender{KVSender: ls}
+	coord := NewTxnCoordSender(sender, clock, false)
+	coord.SetIntentResolutionConcurrency(concurrency)
+	defer coord.Close()
+	txnDB := client.NewKV(coord, nil)
+	txnDB.User = storage.UserRoot
+
+	txn := newTxn(txnDB, clock, proto.Key("a"))
+	for i := 0; i < intents; i++ {
+		key := proto.Key(fmt.Sprintf("a%03d", i))
+		if err := txnDB.Call(proto.Put, createPutRequest(key, []byte("value"), txn), &proto.PutResponse{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	etReply := &proto.EndTransactionResponse{}
+	coord.Send(&client.Call{
+		Method: proto.EndTransaction,
+		Args: &proto.EndTransactionRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       txn.Key,
+				Timestamp: txn.Timestamp,
+				Txn:       txn,
+			},
+			Commit: true,
+		},
+		Reply: etReply,
+	})
+	if etReply.Error != nil {
+		t.Fatal(etReply.GoError())
+	}
+	if err := util.IsTrueWithin(func() bool {
+		return atomic.LoadInt32(&sender.resolved) == intents
+	}, 5*time.Second); err != nil {
+		t.Fatalf("expected %d intents to be resolved; got %d", intents, atomic.LoadInt32(&sender.resolved))
+	}
+	if max := atomic.LoadInt32(&sender.maxInFlight); max > concurrency {
+		t.Errorf("expected at most %d concurrent resolutions; got %d", concurrency, max)
+	}
+}
+
+// A blockingGetEngine blocks Gets of an armed key until released.
+type blockingGetEngine struct {
+	*engine.InMem
+	mu       sync.Mutex
+	blockKey proto.EncodedKey
+	release  chan struct{}
+}
+
+func (be *blockingGetEngine) arm(key proto.Key) {
+	be.mu.Lock()
+	defer be.mu.Unlock()
+	be.blockKey = engine.MVCCEncodeKey(key)
+}
+
+func (be *blockingGetEngine) Get(key proto.EncodedKey) ([]byte, error) {
+	be.mu.Lock()
+	block := be.blockKey != nil && bytes.Equal(key, be.blockKey)
+	be.mu.Unlock()
+	if block {
+		<-be.release
+	}
+	return be.InMem.Get(key)
+}
+
+func (be *blockingGetEngine) NewBatch() engine.Engine {
+	return engine.NewBatch(be)
+}
+
+// TestTxnCoordSenderBatchPartialResults verifies that a batch which
+// times out partway through reports how many of its requests
+// completed.
+func TestTxnCoordSenderBatchPartialResults(t *testing.T) {
+	be := &blockingGetEngine{
+		InMem:   engine.NewInMem(proto.Attributes{}, 50<<20),
+		release: make(chan struct{}),
+	}
+	db, _, _, _, ls, transport, err := createTestDBWithEngine(be)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	defer db.Close()
+	defer ls.Close()
+	defer close(be.release)
+
+	keys := []proto.Key{proto.Key("a"), proto.Key("b"), proto.Key("c"), proto.Key("d")}
+	be.arm(keys[2])
+	bArgs, bReply := &proto.BatchRequest{}, &proto.BatchResponse{}
+	for _, key := range keys {
+		bArgs.Add(&proto.GetRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:     key,
+				User:    storage.UserRoot,
+				Timeout: (10 * time.Millisecond).Nanoseconds(),
+			},
+		})
+	}
+	db.Sender().Send(&client.Call{Method: proto.Batch, Args: bArgs, Reply: bReply})
+	if _, ok := bReply.GoError().(*proto.DeadlineExceededError); !ok {
+		t.Fatalf("expected deadline exceeded error; got %v", bReply.GoError())
+	}
+	if bReply.Completed != 2 {
+		t.Errorf("expected 2 completed requests; got %d", bReply.Completed)
+	}
+	if len(bReply.Responses) != 3 {
+		t.Errorf("expected responses for the completed and failed requests only; got %d", len(bReply.Responses))
+	}
+}
+
+// TestTxnCoordSenderOnePhaseCommit verifies that a new transaction
+// consisting of a single batched Put and commit is committed in one
+// phase, leaving a committed value with no intent and writing no
+// transaction record.
+func TestTxnCoordSenderOnePhaseCommit(t *testing.T) {
+	db, eng, _, _, ls, transport, err := createTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	defer db.Close()
+	defer ls.Close()
+
+	key := proto.Key("a")
+	value := []byte("value")
+	header := proto.RequestHeader{Key: key, User: storage.UserRoot}
+	bArgs, bReply := &proto.BatchRequest{RequestHeader: header}, &proto.BatchResponse{}
+	bArgs.Txn = &proto.Transaction{Name: "test"}
+	bArgs.Add(&proto.PutRequest{RequestHeader: header, Value: proto.Value{Bytes: value}})
+	bArgs.Add(&proto.EndTransactionRequest{RequestHeader: header, Commit: true})
+	db.Sender().Send(&client.Call{Method: proto.Batch, Args: bArgs, Reply: bReply})
+	if err := bReply.GoError(); err != nil {
+		t.Fatal(err)
+	}
+	txn := bReply.Txn
+	if txn == nil || txn.Status != proto.COMMITTED {
+		t.Fatalf("expected committed transaction; got %+v", txn)
+	}
+	if len(bReply.Responses) != 2 || bReply.Completed != 2 {
+		t.Errorf("expected 2 completed responses; got %d of %d", bReply.Completed, len(bReply.Responses))
+	}
+	if etReply := bReply.Responses[1].GetValue().(*proto.EndTransactionResponse); etReply.Txn == nil ||
+		etReply.Txn.Status != proto.COMMITTED {
+		t.Errorf("expected committed transaction in end transaction reply; got %+v", etReply.Txn)
+	}
+
+	meta := &proto.MVCCMetadata{}
+	if ok, _, _, err := engine.GetProto(eng, engine.MVCCEncodeKey(key), meta); err != nil || !ok {
+		t.Fatalf("expected MVCC metadata for %q; got %t, %v", key, ok, err)
+	}
+	if meta.Txn != nil {
+		t.Errorf("expected no intent; got %+v", meta.Txn)
+	}
+	if v, err := engine.MVCCGet(eng, key, txn.Timestamp, nil); err != nil || v == nil || !bytes.Equal(v.Bytes, value) {
+		t.Errorf("expected committed value %q; got %v, %v", value, v, err)
+	}
+	ok, _, _, err := engine.GetProto(eng, engine.MVCCEncodeKey(engine.TransactionKey(txn.Key, txn.ID)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Errorf("expected no transaction record for one-phase commit")
+	}
+	if coord := getCoord(db); len(coord.txns) != 0 {
+		t.Errorf("expected empty transactions map; got %d", len(coord.txns))
+	}
+}
+
+// TestTxnCoordSenderEndReadOnlyTxn verifies that ending a
+// transaction which has only read commits it without writing a
+// transaction record.
+func TestTxnCoordSenderEndReadOnlyTxn(t *testing.T) {
+	db, eng, clock, _, ls, transport, err := createTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	defer db.Close()
+	defer ls.Close()
+
+	key := proto.Key("a")
+	txn := newTxn(db, clock, key)
+	txn.Restarts = 2
+	if err := db.Call(proto.Get, &proto.GetRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       key,
+			Timestamp: txn.Timestamp,
+			Txn:       txn,
+		},
+	}, &proto.GetResponse{}); err != nil {
+		t.Fatal(err)
+	}
+	etReply := &proto.EndTransactionResponse{}
+	if err := db.Call(proto.EndTransaction, &proto.EndTransactionRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       txn.Key,
+			Timestamp: txn.Timestamp,
+			Txn:       txn,
+		},
+		Commit: true,
+	}, etReply); err != nil {
+		t.Fatal(err)
+	}
+	if etReply.Txn == nil || etReply.Txn.Status != proto.COMMITTED {
+		t.Errorf("expected committed transaction; got %+v", etReply.Txn)
+	}
+	if !etReply.CommitTimestamp.Equal(txn.Timestamp) || etReply.Restarts != 2 {
+		t.Errorf("expected commit at %s after 2 restarts; got %s after %d",
+			txn.Timestamp, etReply.CommitTimestamp, etReply.Restarts)
+	}
+	ok, _, _, err := engine.GetProto(eng, engine.MVCCEncodeKey(engine.TransactionKey(txn.Key, txn.ID)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok {
+		t.Errorf("expected no transaction record for read-only transaction")
+	}
+}
+
+// TestTxnCoordSenderEndTxnWithRecord verifies that ending a
+// transaction which wrote only its record is sent to the range,
+// finalizing the record.
+func TestTxnCoordSenderEndTxnWithRecord(t *testing.T) {
+	db, eng, clock, _, ls, transport, err := createTestDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	defer db.Close()
+	defer ls.Close()
+
+	txn := newTxn(db, clock, proto.Key("a"))
+	if err := db.Call(proto.InternalBeginTransaction, &proto.InternalBeginTransactionRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       txn.Key,
+			Timestamp: txn.Timestamp,
+			Txn:       txn,
+		},
+	}, &proto.InternalBeginTransactionResponse{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Call(proto.EndTransaction, &proto.EndTransactionRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       txn.Key,
+			Timestamp: txn.Timestamp,
+			Txn:       txn,
+		},
+		Commit: true,
+	}, &proto.EndTransactionResponse{}); err != nil {
+		t.Fatal(err)
+	}
+	var record proto.Transaction
+	ok, err := engine.MVCCGetProto(eng, engine.TransactionKey(txn.Key, txn.ID), proto.ZeroTimestamp, nil, &record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || record.Status != proto.COMMITTED {
+		t.Errorf("expected committed transaction record; got %+v", record)
+	}
+}
+
 // TestTxnCoordSenderCleanupOnAborted verifies that if a txn receives a
 // TransactionAbortedError, the coordinator cleans up the transaction.
 func TestTxnCoordSenderCleanupOnAborted(t *testing.T) {
@@ -598,3 +1019,60 @@ func TestTxnCoordSenderTxnUpdatedOnError(t *testing.T) {
 		}
 	}
 }
+
+// TestTxnCoordSenderEpochBump verifies that when a transaction is
+// restarted at an incremented epoch, the coordinator immediately
+// heartbeats the transaction at the new epoch.
+func TestTxnCoordSenderEpochBump(t *testing.T) {
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+
+	var retry bool
+	var hbEpochs []int32
+	ts := NewTxnCoordSender(newTestSender(func(call *client.Call) {
+		switch call.Method {
+		case proto.InternalHeartbeatTxn:
+			txn := call.Args.Header().Txn
+			hbEpochs = append(hbEpochs, txn.Epoch)
+			call.Reply.Header().Txn = gogoproto.Clone(txn).(*proto.Transaction)
+		case proto.Put:
+			if retry {
+				call.Reply.Header().SetGoError(&proto.TransactionRetryError{Txn: *call.Args.Header().Txn})
+			}
+		}
+	}), clock, false)
+	defer ts.Close()
+
+	txn := &proto.Transaction{Name: "test txn", Key: proto.Key("a"), ID: []byte("txn-id")}
+	put := func() *proto.PutResponse {
+		reply := &proto.PutResponse{}
+		ts.Send(&client.Call{Method: proto.Put, Args: &proto.PutRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:          proto.Key("a"),
+				User:         storage.UserRoot,
+				UserPriority: gogoproto.Int32(-1),
+				Txn:          txn,
+			},
+		}, Reply: reply})
+		return reply
+	}
+
+	// The first write lays down an intent at epoch 0.
+	if reply := put(); reply.GoError() != nil {
+		t.Fatal(reply.GoError())
+	}
+	if len(hbEpochs) != 0 {
+		t.Fatalf("expected no heartbeats; got %v", hbEpochs)
+	}
+
+	// A retry restarts the transaction at epoch 1, which the
+	// coordinator records with an immediate heartbeat.
+	retry = true
+	reply := put()
+	if reply.Txn.Epoch != 1 {
+		t.Fatalf("expected restart at epoch 1; got %d", reply.Txn.Epoch)
+	}
+	if !reflect.DeepEqual(hbEpochs, []int32{1}) {
+		t.Errorf("expected a heartbeat at epoch 1; got %v", hbEpochs)
+	}
+}
@@ -31,10 +31,15 @@ type EventLeaderElection struct {
 }
 
 // An EventCommandCommitted is broadcast whenever a command has been committed.
+// Command is nil for the empty entries raft appends on its own account
+// (e.g. upon election); these are broadcast so that the application can
+// verify that every committed index is applied in order.
 type EventCommandCommitted struct {
 	GroupID   uint64
 	CommandID string
 	Command   []byte
+	// Index is the raft log index of the committed command.
+	Index uint64
 }
 
 // An EventMembershipChangeCommitted is broadcast whenever a membership change
@@ -45,6 +50,8 @@ type EventMembershipChangeCommitted struct {
 	NodeID     NodeID
 	ChangeType raftpb.ConfChangeType
 	Payload    []byte
+	// Index is the raft log index of the committed membership change.
+	Index uint64
 
 	// Callback should be invoked when this event and its payload have been
 	// processed. A non-nil error aborts the membership change.
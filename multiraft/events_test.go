@@ -55,7 +55,10 @@ func (e *eventDemux) start() {
 					e.LeaderElection <- event
 
 				case *EventCommandCommitted:
-					e.CommandCommitted <- event
+					// Skip the empty entries raft appends on its own account.
+					if event.Command != nil {
+						e.CommandCommitted <- event
+					}
 
 				case *EventMembershipChangeCommitted:
 					e.MembershipChangeCommitted <- event
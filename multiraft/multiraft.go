@@ -709,16 +709,18 @@ func (s *state) handleWriteResponse(response *writeResponse, readyGroups map[uin
 			var commandID string
 			switch entry.Type {
 			case raftpb.EntryNormal:
-				// etcd raft occasionally adds a nil entry (e.g. upon election); ignore these.
+				// etcd raft occasionally adds a nil entry (e.g. upon election);
+				// these are sent without a command ID or command.
+				var command []byte
 				if entry.Data != nil {
-					var command []byte
 					commandID, command = decodeCommand(entry.Data)
-					s.sendEvent(&EventCommandCommitted{
-						GroupID:   groupID,
-						CommandID: commandID,
-						Command:   command,
-					})
 				}
+				s.sendEvent(&EventCommandCommitted{
+					GroupID:   groupID,
+					CommandID: commandID,
+					Command:   command,
+					Index:     entry.Index,
+				})
 
 			case raftpb.EntryConfChange:
 				cc := raftpb.ConfChange{}
@@ -736,6 +738,7 @@ func (s *state) handleWriteResponse(response *writeResponse, readyGroups map[uin
 					NodeID:     NodeID(cc.NodeID),
 					ChangeType: cc.Type,
 					Payload:    payload,
+					Index:      entry.Index,
 					Callback: func(err error) {
 						s.callbackChan <- func() {
 							if err == nil {
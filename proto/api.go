@@ -37,6 +37,9 @@ const (
 	// matches the value specified in the request. Specifying a null value
 	// for existing means the value must not yet exist.
 	ConditionalPut = "ConditionalPut"
+	// ConditionalDelete deletes the key if the existing value matches
+	// the expected value.
+	ConditionalDelete = "ConditionalDelete"
 	// Increment increments the value at the specified key. Once called
 	// for a key, Put & Get will return errors; only Increment will
 	// continue to be a valid command. The value must be deleted before
@@ -52,6 +55,10 @@ const (
 	// args.RequestHeader.Key and args.RequestHeader.EndKey, with
 	// the latter endpoint excluded.
 	Scan = "Scan"
+	// ReverseScan fetches the values for all keys which fall between
+	// args.RequestHeader.Key and args.RequestHeader.EndKey in descending
+	// order, with the latter endpoint excluded.
+	ReverseScan = "ReverseScan"
 	// EndTransaction either commits or aborts an ongoing transaction.
 	EndTransaction = "EndTransaction"
 	// ReapQueue scans and deletes messages from a recipient message
@@ -87,101 +94,147 @@ func (s stringSet) keys() []string {
 
 // AllMethods specifies the complete set of methods.
 var AllMethods = stringSet{
-	Contains:              {},
-	Get:                   {},
-	Put:                   {},
-	ConditionalPut:        {},
-	Increment:             {},
-	Delete:                {},
-	DeleteRange:           {},
-	Scan:                  {},
-	EndTransaction:        {},
-	ReapQueue:             {},
-	EnqueueUpdate:         {},
-	EnqueueMessage:        {},
-	AdminSplit:            {},
-	AdminMerge:            {},
-	Batch:                 {},
-	InternalHeartbeatTxn:  {},
-	InternalGC:            {},
-	InternalPushTxn:       {},
-	InternalResolveIntent: {},
-	InternalMerge:         {},
-	InternalTruncateLog:   {},
+	Contains:                      {},
+	Get:                           {},
+	Put:                           {},
+	ConditionalPut:                {},
+	Increment:                     {},
+	Delete:                        {},
+	DeleteRange:                   {},
+	Scan:                          {},
+	EndTransaction:                {},
+	ReapQueue:                     {},
+	EnqueueUpdate:                 {},
+	EnqueueMessage:                {},
+	AdminSplit:                    {},
+	AdminMerge:                    {},
+	Batch:                         {},
+	InternalHeartbeatTxn:          {},
+	InternalGC:                    {},
+	InternalPushTxn:               {},
+	InternalResolveIntent:         {},
+	InternalMerge:                 {},
+	InternalTruncateLog:           {},
+	InternalBeginTransaction:      {},
+	InternalScanIntents:           {},
+	InternalInspectTimestampCache: {},
+	InternalGetTransaction:        {},
+	InternalPutIfAbsent:           {},
+	InternalRangeKeyBounds:        {},
+	InternalVerifyRange:           {},
+	ReverseScan:                   {},
+	ConditionalDelete:             {},
+	InternalResolveIntentRange:    {},
+	InternalLeaderLease:           {},
+	InternalComputeChecksum:       {},
+	InternalGetHistory:            {},
+	InternalBatchGet:              {},
 }
 
 // PublicMethods specifies the set of methods accessible via the
 // public key-value API.
 var PublicMethods = stringSet{
-	Contains:       {},
-	Get:            {},
-	Put:            {},
-	ConditionalPut: {},
-	Increment:      {},
-	Delete:         {},
-	DeleteRange:    {},
-	Scan:           {},
-	EndTransaction: {},
-	ReapQueue:      {},
-	EnqueueUpdate:  {},
-	EnqueueMessage: {},
-	Batch:          {},
-	AdminSplit:     {},
+	Contains:          {},
+	Get:               {},
+	Put:               {},
+	ConditionalPut:    {},
+	Increment:         {},
+	Delete:            {},
+	DeleteRange:       {},
+	Scan:              {},
+	EndTransaction:    {},
+	ReapQueue:         {},
+	EnqueueUpdate:     {},
+	EnqueueMessage:    {},
+	Batch:             {},
+	AdminSplit:        {},
+	ReverseScan:       {},
+	ConditionalDelete: {},
 }
 
 // InternalMethods specifies the set of methods accessible only
 // via the internal node RPC API.
 var InternalMethods = stringSet{
-	InternalHeartbeatTxn:  {},
-	InternalGC:            {},
-	InternalPushTxn:       {},
-	InternalResolveIntent: {},
-	InternalMerge:         {},
-	InternalTruncateLog:   {},
+	InternalHeartbeatTxn:          {},
+	InternalGC:                    {},
+	InternalPushTxn:               {},
+	InternalResolveIntent:         {},
+	InternalMerge:                 {},
+	InternalTruncateLog:           {},
+	InternalBeginTransaction:      {},
+	InternalScanIntents:           {},
+	InternalInspectTimestampCache: {},
+	InternalGetTransaction:        {},
+	InternalPutIfAbsent:           {},
+	InternalRangeKeyBounds:        {},
+	InternalVerifyRange:           {},
+	InternalResolveIntentRange:    {},
+	InternalLeaderLease:           {},
+	InternalComputeChecksum:       {},
+	InternalGetHistory:            {},
+	InternalBatchGet:              {},
 }
 
 // ReadMethods specifies the set of methods which read and return data.
 var ReadMethods = stringSet{
-	Contains:            {},
-	Get:                 {},
-	ConditionalPut:      {},
-	Increment:           {},
-	Scan:                {},
-	ReapQueue:           {},
-	InternalRangeLookup: {},
+	Contains:                      {},
+	Get:                           {},
+	ConditionalPut:                {},
+	Increment:                     {},
+	Scan:                          {},
+	ReapQueue:                     {},
+	InternalRangeLookup:           {},
+	InternalScanIntents:           {},
+	InternalInspectTimestampCache: {},
+	InternalGetTransaction:        {},
+	InternalPutIfAbsent:           {},
+	InternalRangeKeyBounds:        {},
+	InternalVerifyRange:           {},
+	ReverseScan:                   {},
+	ConditionalDelete:             {},
+	InternalComputeChecksum:       {},
+	InternalGetHistory:            {},
+	InternalBatchGet:              {},
 }
 
 // WriteMethods specifies the set of methods which write data.
 var WriteMethods = stringSet{
-	Put:                   {},
-	ConditionalPut:        {},
-	Increment:             {},
-	Delete:                {},
-	DeleteRange:           {},
-	EndTransaction:        {},
-	ReapQueue:             {},
-	EnqueueUpdate:         {},
-	EnqueueMessage:        {},
-	Batch:                 {},
-	InternalHeartbeatTxn:  {},
-	InternalGC:            {},
-	InternalPushTxn:       {},
-	InternalResolveIntent: {},
-	InternalMerge:         {},
-	InternalTruncateLog:   {},
+	Put:                        {},
+	ConditionalPut:             {},
+	Increment:                  {},
+	Delete:                     {},
+	DeleteRange:                {},
+	EndTransaction:             {},
+	ReapQueue:                  {},
+	EnqueueUpdate:              {},
+	EnqueueMessage:             {},
+	Batch:                      {},
+	InternalHeartbeatTxn:       {},
+	InternalGC:                 {},
+	InternalPushTxn:            {},
+	InternalResolveIntent:      {},
+	InternalMerge:              {},
+	InternalTruncateLog:        {},
+	InternalBeginTransaction:   {},
+	InternalPutIfAbsent:        {},
+	ConditionalDelete:          {},
+	InternalResolveIntentRange: {},
+	InternalLeaderLease:        {},
 }
 
 // TxnMethods specifies the set of methods which leave key intents
 // during transactions.
 var TxnMethods = stringSet{
-	Put:            {},
-	ConditionalPut: {},
-	Increment:      {},
-	Delete:         {},
-	DeleteRange:    {},
-	ReapQueue:      {},
-	EnqueueUpdate:  {},
-	EnqueueMessage: {},
+	Put:                 {},
+	ConditionalPut:      {},
+	Increment:           {},
+	Delete:              {},
+	DeleteRange:         {},
+	ReapQueue:           {},
+	EnqueueUpdate:       {},
+	EnqueueMessage:      {},
+	InternalPutIfAbsent: {},
+	ConditionalDelete:   {},
 }
 
 // adminMethods specifies the set of methods which are neither
@@ -316,6 +369,18 @@ func ScanArgs(key, endKey Key, maxResults int64) *ScanRequest {
 	}
 }
 
+// ReverseScanArgs returns a ReverseScanRequest object initialized to
+// scan from end to start keys with max results.
+func ReverseScanArgs(key, endKey Key, maxResults int64) *ReverseScanRequest {
+	return &ReverseScanRequest{
+		RequestHeader: RequestHeader{
+			Key:    key,
+			EndKey: endKey,
+		},
+		MaxResults: maxResults,
+	}
+}
+
 // MethodForRequest returns the method name corresponding to the type
 // of the request.
 func MethodForRequest(req Request) (string, error) {
@@ -362,6 +427,34 @@ func MethodForRequest(req Request) (string, error) {
 		return InternalMerge, nil
 	case *InternalTruncateLogRequest:
 		return InternalTruncateLog, nil
+	case *InternalBeginTransactionRequest:
+		return InternalBeginTransaction, nil
+	case *InternalScanIntentsRequest:
+		return InternalScanIntents, nil
+	case *InternalInspectTimestampCacheRequest:
+		return InternalInspectTimestampCache, nil
+	case *InternalGetTransactionRequest:
+		return InternalGetTransaction, nil
+	case *InternalPutIfAbsentRequest:
+		return InternalPutIfAbsent, nil
+	case *InternalRangeKeyBoundsRequest:
+		return InternalRangeKeyBounds, nil
+	case *InternalVerifyRangeRequest:
+		return InternalVerifyRange, nil
+	case *ReverseScanRequest:
+		return ReverseScan, nil
+	case *ConditionalDeleteRequest:
+		return ConditionalDelete, nil
+	case *InternalResolveIntentRangeRequest:
+		return InternalResolveIntentRange, nil
+	case *InternalLeaderLeaseRequest:
+		return InternalLeaderLease, nil
+	case *InternalComputeChecksumRequest:
+		return InternalComputeChecksum, nil
+	case *InternalGetHistoryRequest:
+		return InternalGetHistory, nil
+	case *InternalBatchGetRequest:
+		return InternalBatchGet, nil
 	}
 	return "", util.Errorf("unhandled request %T", req)
 }
@@ -421,6 +514,34 @@ func CreateArgs(method string) (Request, error) {
 		return &InternalMergeRequest{}, nil
 	case InternalTruncateLog:
 		return &InternalTruncateLogRequest{}, nil
+	case InternalBeginTransaction:
+		return &InternalBeginTransactionRequest{}, nil
+	case InternalScanIntents:
+		return &InternalScanIntentsRequest{}, nil
+	case InternalInspectTimestampCache:
+		return &InternalInspectTimestampCacheRequest{}, nil
+	case InternalGetTransaction:
+		return &InternalGetTransactionRequest{}, nil
+	case InternalPutIfAbsent:
+		return &InternalPutIfAbsentRequest{}, nil
+	case InternalRangeKeyBounds:
+		return &InternalRangeKeyBoundsRequest{}, nil
+	case InternalVerifyRange:
+		return &InternalVerifyRangeRequest{}, nil
+	case ReverseScan:
+		return &ReverseScanRequest{}, nil
+	case ConditionalDelete:
+		return &ConditionalDeleteRequest{}, nil
+	case InternalResolveIntentRange:
+		return &InternalResolveIntentRangeRequest{}, nil
+	case InternalLeaderLease:
+		return &InternalLeaderLeaseRequest{}, nil
+	case InternalComputeChecksum:
+		return &InternalComputeChecksumRequest{}, nil
+	case InternalGetHistory:
+		return &InternalGetHistoryRequest{}, nil
+	case InternalBatchGet:
+		return &InternalBatchGetRequest{}, nil
 	}
 	return nil, util.Errorf("unhandled method %s", method)
 }
@@ -470,6 +591,34 @@ func CreateReply(method string) (Response, error) {
 		return &InternalMergeResponse{}, nil
 	case InternalTruncateLog:
 		return &InternalTruncateLogResponse{}, nil
+	case InternalBeginTransaction:
+		return &InternalBeginTransactionResponse{}, nil
+	case InternalScanIntents:
+		return &InternalScanIntentsResponse{}, nil
+	case InternalInspectTimestampCache:
+		return &InternalInspectTimestampCacheResponse{}, nil
+	case InternalGetTransaction:
+		return &InternalGetTransactionResponse{}, nil
+	case InternalPutIfAbsent:
+		return &InternalPutIfAbsentResponse{}, nil
+	case InternalRangeKeyBounds:
+		return &InternalRangeKeyBoundsResponse{}, nil
+	case InternalVerifyRange:
+		return &InternalVerifyRangeResponse{}, nil
+	case ReverseScan:
+		return &ReverseScanResponse{}, nil
+	case ConditionalDelete:
+		return &ConditionalDeleteResponse{}, nil
+	case InternalResolveIntentRange:
+		return &InternalResolveIntentRangeResponse{}, nil
+	case InternalLeaderLease:
+		return &InternalLeaderLeaseResponse{}, nil
+	case InternalComputeChecksum:
+		return &InternalComputeChecksumResponse{}, nil
+	case InternalGetHistory:
+		return &InternalGetHistoryResponse{}, nil
+	case InternalBatchGet:
+		return &InternalBatchGetResponse{}, nil
 	}
 	return nil, util.Errorf("unhandled method %s", method)
 }
@@ -610,6 +759,17 @@ func (sr *ScanResponse) Verify(req Request) error {
 	return nil
 }
 
+// Verify verifies the integrity of every value returned in the
+// reverse scan.
+func (sr *ReverseScanResponse) Verify(req Request) error {
+	for _, kv := range sr.Rows {
+		if err := kv.Value.Verify(kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Add adds a request to the batch request. The batch inherits
 // the key range of the first request added to it.
 //
@@ -42,6 +42,10 @@
 		EnqueueUpdateResponse
 		EnqueueMessageRequest
 		EnqueueMessageResponse
+		ReverseScanRequest
+		ReverseScanResponse
+		ConditionalDeleteRequest
+		ConditionalDeleteResponse
 		RequestUnion
 		ResponseUnion
 		BatchRequest
@@ -141,8 +145,29 @@ type RequestHeader struct {
 	// isolation level set as desired. The response will contain the
 	// fully-initialized transaction with txn ID, priority, initial
 	// timestamp, and maximum timestamp.
-	Txn              *Transaction `protobuf:"bytes,9,opt,name=txn" json:"txn,omitempty"`
-	XXX_unrecognized []byte       `json:"-"`
+	Txn *Transaction `protobuf:"bytes,9,opt,name=txn" json:"txn,omitempty"`
+	// ConflictTimeout optionally bounds the time in nanoseconds a read may
+	// spend pushing transactions and resolving conflicting write intents
+	// before failing with a retryable ConflictTimeoutError. If zero, the
+	// store's default timeout applies.
+	ConflictTimeout int64 `protobuf:"varint,10,opt,name=conflict_timeout" json:"conflict_timeout"`
+	// MaxStaleness optionally allows a non-transactional read which would
+	// otherwise block on a write intent to instead return the most recent
+	// committed version beneath the intent, provided that version is no
+	// more than MaxStaleness nanoseconds older than the read timestamp.
+	MaxStaleness int64 `protobuf:"varint,11,opt,name=max_staleness" json:"max_staleness"`
+	// Timeout optionally bounds the time in nanoseconds a read-only
+	// command may spend executing against the storage engine. A read
+	// which exceeds its timeout is abandoned and fails with a
+	// DeadlineExceededError. If zero, no timeout applies.
+	Timeout int64 `protobuf:"varint,12,opt,name=timeout" json:"timeout"`
+	// ClampToClosedTimestamp optionally allows a non-transactional read
+	// which would otherwise wait on in-flight writes to instead be served
+	// at the range's closed timestamp, if that's earlier than the read
+	// timestamp. The timestamp of the response reports the timestamp the
+	// read was served at.
+	ClampToClosedTimestamp bool   `protobuf:"varint,13,opt,name=clamp_to_closed_timestamp" json:"clamp_to_closed_timestamp"`
+	XXX_unrecognized       []byte `json:"-"`
 }
 
 func (m *RequestHeader) Reset()         { *m = RequestHeader{} }
@@ -200,6 +225,108 @@ func (m *RequestHeader) GetTxn() *Transaction {
 	return nil
 }
 
+func (m *RequestHeader) GetConflictTimeout() int64 {
+	if m != nil {
+		return m.ConflictTimeout
+	}
+	return 0
+}
+
+func (m *RequestHeader) GetMaxStaleness() int64 {
+	if m != nil {
+		return m.MaxStaleness
+	}
+	return 0
+}
+
+func (m *RequestHeader) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *RequestHeader) GetClampToClosedTimestamp() bool {
+	if m != nil {
+		return m.ClampToClosedTimestamp
+	}
+	return false
+}
+
+// MVCCStatsDelta is the change to a range's MVCC statistics produced
+// by the application of a single command.
+type MVCCStatsDelta struct {
+	LiveBytes        int64  `protobuf:"varint,1,opt,name=live_bytes" json:"live_bytes"`
+	KeyBytes         int64  `protobuf:"varint,2,opt,name=key_bytes" json:"key_bytes"`
+	ValBytes         int64  `protobuf:"varint,3,opt,name=val_bytes" json:"val_bytes"`
+	IntentBytes      int64  `protobuf:"varint,4,opt,name=intent_bytes" json:"intent_bytes"`
+	LiveCount        int64  `protobuf:"varint,5,opt,name=live_count" json:"live_count"`
+	KeyCount         int64  `protobuf:"varint,6,opt,name=key_count" json:"key_count"`
+	ValCount         int64  `protobuf:"varint,7,opt,name=val_count" json:"val_count"`
+	IntentCount      int64  `protobuf:"varint,8,opt,name=intent_count" json:"intent_count"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *MVCCStatsDelta) Reset()         { *m = MVCCStatsDelta{} }
+func (m *MVCCStatsDelta) String() string { return proto1.CompactTextString(m) }
+func (*MVCCStatsDelta) ProtoMessage()    {}
+
+func (m *MVCCStatsDelta) GetLiveBytes() int64 {
+	if m != nil {
+		return m.LiveBytes
+	}
+	return 0
+}
+
+func (m *MVCCStatsDelta) GetKeyBytes() int64 {
+	if m != nil {
+		return m.KeyBytes
+	}
+	return 0
+}
+
+func (m *MVCCStatsDelta) GetValBytes() int64 {
+	if m != nil {
+		return m.ValBytes
+	}
+	return 0
+}
+
+func (m *MVCCStatsDelta) GetIntentBytes() int64 {
+	if m != nil {
+		return m.IntentBytes
+	}
+	return 0
+}
+
+func (m *MVCCStatsDelta) GetLiveCount() int64 {
+	if m != nil {
+		return m.LiveCount
+	}
+	return 0
+}
+
+func (m *MVCCStatsDelta) GetKeyCount() int64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+func (m *MVCCStatsDelta) GetValCount() int64 {
+	if m != nil {
+		return m.ValCount
+	}
+	return 0
+}
+
+func (m *MVCCStatsDelta) GetIntentCount() int64 {
+	if m != nil {
+		return m.IntentCount
+	}
+	return 0
+}
+
 // ResponseHeader is returned with every storage node response.
 type ResponseHeader struct {
 	// Error is non-nil if an error occurred.
@@ -215,8 +342,11 @@ type ResponseHeader struct {
 	// Transaction is non-nil if the request specified a non-nil
 	// transaction. The transaction timestamp and/or priority may have
 	// been updated, depending on the outcome of the request.
-	Txn              *Transaction `protobuf:"bytes,3,opt,name=txn" json:"txn,omitempty"`
-	XXX_unrecognized []byte       `json:"-"`
+	Txn *Transaction `protobuf:"bytes,3,opt,name=txn" json:"txn,omitempty"`
+	// StatsDelta is the change to the range's MVCC statistics produced by
+	// a successfully applied read-write command; nil for reads.
+	StatsDelta       *MVCCStatsDelta `protobuf:"bytes,4,opt,name=stats_delta" json:"stats_delta,omitempty"`
+	XXX_unrecognized []byte          `json:"-"`
 }
 
 func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
@@ -244,6 +374,13 @@ func (m *ResponseHeader) GetTxn() *Transaction {
 	return nil
 }
 
+func (m *ResponseHeader) GetStatsDelta() *MVCCStatsDelta {
+	if m != nil {
+		return m.StatsDelta
+	}
+	return nil
+}
+
 // A ContainsRequest is arguments to the Contains() method.
 type ContainsRequest struct {
 	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -274,7 +411,11 @@ func (m *ContainsResponse) GetExists() bool {
 
 // A GetRequest is arguments to the Get() method.
 type GetRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// If lock is set on a transactional Get, the key is read-locked
+	// until the transaction ends, causing writes by other transactions
+	// to fail with a WriteIntentError. Analogous to SELECT FOR UPDATE.
+	Lock             bool   `protobuf:"varint,2,opt,name=lock" json:"lock"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -282,6 +423,13 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto1.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 
+func (m *GetRequest) GetLock() bool {
+	if m != nil {
+		return m.Lock
+	}
+	return false
+}
+
 // A GetResponse is the return value from the Get() method.
 // If the key doesn't exist, returns nil for Value.Bytes.
 type GetResponse struct {
@@ -305,8 +453,12 @@ func (m *GetResponse) GetValue() *Value {
 // an empty value, the value parameter is still specified, but both
 // Bytes and Integer are set to nil.
 type PutRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Value            Value  `protobuf:"bytes,2,opt,name=value" json:"value"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Value         Value `protobuf:"bytes,2,opt,name=value" json:"value"`
+	// Coalesce is set by the range leader on a non-transactional put which
+	// should replace the key's latest version rather than add a new one.
+	// Any value supplied by a client is ignored.
+	Coalesce         bool   `protobuf:"varint,3,opt,name=coalesce" json:"coalesce"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -321,6 +473,13 @@ func (m *PutRequest) GetValue() Value {
 	return Value{}
 }
 
+func (m *PutRequest) GetCoalesce() bool {
+	if m != nil {
+		return m.Coalesce
+	}
+	return false
+}
+
 // A PutResponse is the return value from the Put() method.
 type PutResponse struct {
 	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
@@ -379,13 +538,20 @@ func (*ConditionalPutResponse) ProtoMessage()    {}
 
 // An IncrementRequest is arguments to the Increment() method. It
 // increments the value for key, and returns the new value. If no
-// value exists for a key, incrementing by 0 is not a noop, but will
-// create a zero value. IncrementRequest cannot be called on a key set
-// by Put() or ConditionalPut(). Similarly, Get(), Put() and
-// ConditionalPut() cannot be invoked on an incremented key.
+// value exists for a key, it is taken to hold InitialValue, and
+// incrementing by 0 is not a noop, but will create that value.
+// IncrementRequest cannot be called on a key set by Put() or
+// ConditionalPut(). Similarly, Get(), Put() and ConditionalPut()
+// cannot be invoked on an incremented key.
 type IncrementRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Increment        int64  `protobuf:"varint,2,opt,name=increment" json:"increment"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Increment     int64 `protobuf:"varint,2,opt,name=increment" json:"increment"`
+	// InitialValue is the value a missing key is taken to hold before
+	// the increment is applied.
+	InitialValue int64 `protobuf:"varint,3,opt,name=initial_value" json:"initial_value"`
+	// If set, Max is the largest value the increment may produce.
+	// Increments exceeding it fail without modifying the value.
+	Max              *int64 `protobuf:"varint,4,opt,name=max" json:"max,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -400,6 +566,20 @@ func (m *IncrementRequest) GetIncrement() int64 {
 	return 0
 }
 
+func (m *IncrementRequest) GetInitialValue() int64 {
+	if m != nil {
+		return m.InitialValue
+	}
+	return 0
+}
+
+func (m *IncrementRequest) GetMax() int64 {
+	if m != nil && m.Max != nil {
+		return *m.Max
+	}
+	return 0
+}
+
 // An IncrementResponse is the return value from the Increment
 // method. The new value after increment is specified in NewValue. If
 // the value could not be decoded as specified, Error will be set.
@@ -486,7 +666,27 @@ func (m *DeleteRangeResponse) GetNumDeleted() int64 {
 type ScanRequest struct {
 	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	// Must be > 0.
-	MaxResults       int64  `protobuf:"varint,2,opt,name=max_results" json:"max_results"`
+	MaxResults int64 `protobuf:"varint,2,opt,name=max_results" json:"max_results"`
+	// If true, rows are returned sorted by the timestamp of each key's
+	// latest version rather than by key. This requires buffering the
+	// entire span, which must hold no more than a bounded number of keys;
+	// the max_results earliest-written keys are returned.
+	OrderByTimestamp bool `protobuf:"varint,3,opt,name=order_by_timestamp" json:"order_by_timestamp"`
+	// ResumeToken, if set, is the resume token returned by the previous
+	// page of a scan. The scan resumes at the token's key; if the range
+	// has changed since the token was issued, the scan fails with a
+	// RangeKeyMismatchError and the client must re-resolve routing.
+	ResumeToken []byte `protobuf:"bytes,4,opt,name=resume_token" json:"resume_token,omitempty"`
+	// If greater than one, the span is divided into up to this many
+	// sub-spans which are scanned in parallel, each from its own engine
+	// snapshot, and the results merged in key order. Ignored for scans
+	// ordered by timestamp.
+	Parallelism int32 `protobuf:"varint,5,opt,name=parallelism" json:"parallelism"`
+	// ReturnStats, if true, has the scan report MVCC iteration
+	// statistics in its response. Counting versions steps through the
+	// version history of each key examined, so statistics are collected
+	// only on request.
+	ReturnStats      bool   `protobuf:"varint,6,opt,name=return_stats" json:"return_stats"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -501,12 +701,58 @@ func (m *ScanRequest) GetMaxResults() int64 {
 	return 0
 }
 
+func (m *ScanRequest) GetOrderByTimestamp() bool {
+	if m != nil {
+		return m.OrderByTimestamp
+	}
+	return false
+}
+
+func (m *ScanRequest) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
+func (m *ScanRequest) GetParallelism() int32 {
+	if m != nil {
+		return m.Parallelism
+	}
+	return 0
+}
+
+func (m *ScanRequest) GetReturnStats() bool {
+	if m != nil {
+		return m.ReturnStats
+	}
+	return false
+}
+
 // A ScanResponse is the return value from the Scan() method.
 type ScanResponse struct {
 	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	// Empty if no rows were scanned.
-	Rows             []KeyValue `protobuf:"bytes,2,rep,name=rows" json:"rows"`
-	XXX_unrecognized []byte     `json:"-"`
+	Rows []KeyValue `protobuf:"bytes,2,rep,name=rows" json:"rows"`
+	// KeysExamined is the number of distinct keys examined by the scan.
+	// The iteration statistics other than TombstonesSkipped are set
+	// only if requested via the request's ReturnStats.
+	KeysExamined int64 `protobuf:"varint,3,opt,name=keys_examined" json:"keys_examined"`
+	// VersionsExamined is the number of MVCC versions examined.
+	VersionsExamined int64 `protobuf:"varint,4,opt,name=versions_examined" json:"versions_examined"`
+	// VersionsSkipped is the number of examined MVCC versions
+	// which did not contribute a returned row.
+	VersionsSkipped int64 `protobuf:"varint,5,opt,name=versions_skipped" json:"versions_skipped"`
+	// IntentsEncountered is the number of write intents examined.
+	IntentsEncountered int64 `protobuf:"varint,6,opt,name=intents_encountered" json:"intents_encountered"`
+	// ResumeToken is set if the scan returned max_results rows and may
+	// be continued by supplying it with the next request.
+	ResumeToken []byte `protobuf:"bytes,7,opt,name=resume_token" json:"resume_token,omitempty"`
+	// TombstonesSkipped is the number of examined keys which were
+	// skipped for lack of a live value, e.g. deletion tombstones. It is
+	// always set, as it is counted without stepping through versions.
+	TombstonesSkipped int64  `protobuf:"varint,8,opt,name=tombstones_skipped" json:"tombstones_skipped"`
+	XXX_unrecognized  []byte `json:"-"`
 }
 
 func (m *ScanResponse) Reset()         { *m = ScanResponse{} }
@@ -520,6 +766,48 @@ func (m *ScanResponse) GetRows() []KeyValue {
 	return nil
 }
 
+func (m *ScanResponse) GetKeysExamined() int64 {
+	if m != nil {
+		return m.KeysExamined
+	}
+	return 0
+}
+
+func (m *ScanResponse) GetVersionsExamined() int64 {
+	if m != nil {
+		return m.VersionsExamined
+	}
+	return 0
+}
+
+func (m *ScanResponse) GetVersionsSkipped() int64 {
+	if m != nil {
+		return m.VersionsSkipped
+	}
+	return 0
+}
+
+func (m *ScanResponse) GetIntentsEncountered() int64 {
+	if m != nil {
+		return m.IntentsEncountered
+	}
+	return 0
+}
+
+func (m *ScanResponse) GetResumeToken() []byte {
+	if m != nil {
+		return m.ResumeToken
+	}
+	return nil
+}
+
+func (m *ScanResponse) GetTombstonesSkipped() int64 {
+	if m != nil {
+		return m.TombstonesSkipped
+	}
+	return 0
+}
+
 // An EndTransactionRequest is arguments to the EndTransaction() method.
 // It specifies whether to commit or roll back an extant transaction.
 type EndTransactionRequest struct {
@@ -530,7 +818,25 @@ type EndTransactionRequest struct {
 	// internal use only and will be ignored if requested through the
 	// public-facing KV API.
 	InternalCommitTrigger *InternalCommitTrigger `protobuf:"bytes,3,opt,name=internal_commit_trigger" json:"internal_commit_trigger,omitempty"`
-	XXX_unrecognized      []byte                 `json:"-"`
+	// Durable, if true on commit, defers the reply until the commit has
+	// been synced to the write-ahead log and the transaction's intents
+	// resolved.
+	Durable bool `protobuf:"varint,4,opt,name=durable" json:"durable"`
+	// Intents lists keys written by the transaction. Once the transaction
+	// commits or aborts, the range holding its record resolves their
+	// intents asynchronously, sparing the next reader the work.
+	Intents []Key `protobuf:"bytes,5,rep,name=intents,customtype=Key" json:"intents"`
+	// RefreshSpans lists the spans read by the transaction, which must lie
+	// within the range holding its record and may not hold inline values.
+	// If the transaction commits at a timestamp pushed past its original
+	// timestamp, its reads are re-validated at the commit timestamp, which
+	// may not exceed the request's timestamp. The commit proceeds at the
+	// pushed timestamp if they're unchanged and fails with a retry error
+	// otherwise. The transaction coordinator doesn't track reads, so
+	// RefreshSpans is populated by the client sending the EndTransaction,
+	// which must have recorded the spans it read itself.
+	RefreshSpans     []KeySpan `protobuf:"bytes,6,rep,name=refresh_spans" json:"refresh_spans"`
+	XXX_unrecognized []byte    `json:"-"`
 }
 
 func (m *EndTransactionRequest) Reset()         { *m = EndTransactionRequest{} }
@@ -551,6 +857,20 @@ func (m *EndTransactionRequest) GetInternalCommitTrigger() *InternalCommitTrigge
 	return nil
 }
 
+func (m *EndTransactionRequest) GetDurable() bool {
+	if m != nil {
+		return m.Durable
+	}
+	return false
+}
+
+func (m *EndTransactionRequest) GetRefreshSpans() []KeySpan {
+	if m != nil {
+		return m.RefreshSpans
+	}
+	return nil
+}
+
 // An EndTransactionResponse is the return value from the
 // EndTransaction() method. The final transaction record is returned
 // as part of the response header. In particular, transaction status
@@ -564,8 +884,14 @@ func (m *EndTransactionRequest) GetInternalCommitTrigger() *InternalCommitTrigge
 type EndTransactionResponse struct {
 	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
 	// Remaining time (ns).
-	CommitWait       int64  `protobuf:"varint,2,opt,name=commit_wait" json:"commit_wait"`
-	XXX_unrecognized []byte `json:"-"`
+	CommitWait int64 `protobuf:"varint,2,opt,name=commit_wait" json:"commit_wait"`
+	// The number of times the transaction restarted before ending.
+	Restarts int32 `protobuf:"varint,3,opt,name=restarts" json:"restarts"`
+	// The timestamp at which the transaction committed, which may have
+	// been pushed forward from its original timestamp. Unset if the
+	// transaction was aborted.
+	CommitTimestamp  Timestamp `protobuf:"bytes,4,opt,name=commit_timestamp" json:"commit_timestamp"`
+	XXX_unrecognized []byte    `json:"-"`
 }
 
 func (m *EndTransactionResponse) Reset()         { *m = EndTransactionResponse{} }
@@ -579,6 +905,20 @@ func (m *EndTransactionResponse) GetCommitWait() int64 {
 	return 0
 }
 
+func (m *EndTransactionResponse) GetRestarts() int32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *EndTransactionResponse) GetCommitTimestamp() Timestamp {
+	if m != nil {
+		return m.CommitTimestamp
+	}
+	return Timestamp{}
+}
+
 // A ReapQueueRequest is arguments to the ReapQueue() method. It
 // specifies the recipient inbox key to which messages are waiting
 // to be reapted and also the maximum number of results to return.
@@ -675,21 +1015,97 @@ func (m *EnqueueMessageResponse) Reset()         { *m = EnqueueMessageResponse{}
 func (m *EnqueueMessageResponse) String() string { return proto1.CompactTextString(m) }
 func (*EnqueueMessageResponse) ProtoMessage()    {}
 
+// A ReverseScanRequest is arguments to the ReverseScan() method. It specifies
+// the start and end keys for the scan and the maximum number of results.
+type ReverseScanRequest struct {
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Must be >= 0; zero returns all keys in the span.
+	MaxResults       int64  `protobuf:"varint,2,opt,name=max_results" json:"max_results"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ReverseScanRequest) Reset()         { *m = ReverseScanRequest{} }
+func (m *ReverseScanRequest) String() string { return proto1.CompactTextString(m) }
+func (*ReverseScanRequest) ProtoMessage()    {}
+
+func (m *ReverseScanRequest) GetMaxResults() int64 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+// A ReverseScanResponse is the return value from the ReverseScan() method.
+type ReverseScanResponse struct {
+	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Empty if no rows were scanned. Sorted by descending key.
+	Rows             []KeyValue `protobuf:"bytes,2,rep,name=rows" json:"rows"`
+	XXX_unrecognized []byte     `json:"-"`
+}
+
+func (m *ReverseScanResponse) Reset()         { *m = ReverseScanResponse{} }
+func (m *ReverseScanResponse) String() string { return proto1.CompactTextString(m) }
+func (*ReverseScanResponse) ProtoMessage()    {}
+
+func (m *ReverseScanResponse) GetRows() []KeyValue {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+// A ConditionalDeleteRequest is arguments to the ConditionalDelete()
+// method. The key is deleted only if its existing value matches
+// ExpValue, with the same semantics as ConditionalPut:
+// - If key doesn't exist and ExpValue is nil, writes a tombstone.
+// - Otherwise, returns error and the actual value of the key in the response.
+type ConditionalDeleteRequest struct {
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// ExpValue.Bytes empty to test for an existing empty value. Specify as
+	// nil to indicate there should be no existing entry.
+	ExpValue         *Value `protobuf:"bytes,2,opt,name=exp_value" json:"exp_value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ConditionalDeleteRequest) Reset()         { *m = ConditionalDeleteRequest{} }
+func (m *ConditionalDeleteRequest) String() string { return proto1.CompactTextString(m) }
+func (*ConditionalDeleteRequest) ProtoMessage()    {}
+
+func (m *ConditionalDeleteRequest) GetExpValue() *Value {
+	if m != nil {
+		return m.ExpValue
+	}
+	return nil
+}
+
+// A ConditionalDeleteResponse is the return value from the
+// ConditionalDelete() method.
+type ConditionalDeleteResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ConditionalDeleteResponse) Reset()         { *m = ConditionalDeleteResponse{} }
+func (m *ConditionalDeleteResponse) String() string { return proto1.CompactTextString(m) }
+func (*ConditionalDeleteResponse) ProtoMessage()    {}
+
 // A RequestUnion contains exactly one of the optional requests.
 type RequestUnion struct {
-	Contains         *ContainsRequest       `protobuf:"bytes,1,opt,name=contains" json:"contains,omitempty"`
-	Get              *GetRequest            `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put              *PutRequest            `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
-	ConditionalPut   *ConditionalPutRequest `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
-	Increment        *IncrementRequest      `protobuf:"bytes,5,opt,name=increment" json:"increment,omitempty"`
-	Delete           *DeleteRequest         `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
-	DeleteRange      *DeleteRangeRequest    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
-	Scan             *ScanRequest           `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
-	EndTransaction   *EndTransactionRequest `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
-	ReapQueue        *ReapQueueRequest      `protobuf:"bytes,10,opt,name=reap_queue" json:"reap_queue,omitempty"`
-	EnqueueUpdate    *EnqueueUpdateRequest  `protobuf:"bytes,11,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
-	EnqueueMessage   *EnqueueMessageRequest `protobuf:"bytes,12,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
-	XXX_unrecognized []byte                 `json:"-"`
+	Contains          *ContainsRequest          `protobuf:"bytes,1,opt,name=contains" json:"contains,omitempty"`
+	Get               *GetRequest               `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put               *PutRequest               `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
+	ConditionalPut    *ConditionalPutRequest    `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
+	Increment         *IncrementRequest         `protobuf:"bytes,5,opt,name=increment" json:"increment,omitempty"`
+	Delete            *DeleteRequest            `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
+	DeleteRange       *DeleteRangeRequest       `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
+	Scan              *ScanRequest              `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
+	EndTransaction    *EndTransactionRequest    `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReapQueue         *ReapQueueRequest         `protobuf:"bytes,10,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate     *EnqueueUpdateRequest     `protobuf:"bytes,11,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage    *EnqueueMessageRequest    `protobuf:"bytes,12,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	ReverseScan       *ReverseScanRequest       `protobuf:"bytes,13,opt,name=reverse_scan" json:"reverse_scan,omitempty"`
+	ConditionalDelete *ConditionalDeleteRequest `protobuf:"bytes,14,opt,name=conditional_delete" json:"conditional_delete,omitempty"`
+	XXX_unrecognized  []byte                    `json:"-"`
 }
 
 func (m *RequestUnion) Reset()         { *m = RequestUnion{} }
@@ -780,21 +1196,37 @@ func (m *RequestUnion) GetEnqueueMessage() *EnqueueMessageRequest {
 	return nil
 }
 
+func (m *RequestUnion) GetReverseScan() *ReverseScanRequest {
+	if m != nil {
+		return m.ReverseScan
+	}
+	return nil
+}
+
+func (m *RequestUnion) GetConditionalDelete() *ConditionalDeleteRequest {
+	if m != nil {
+		return m.ConditionalDelete
+	}
+	return nil
+}
+
 // A ResponseUnion contains exactly one of the optional responses.
 type ResponseUnion struct {
-	Contains         *ContainsResponse       `protobuf:"bytes,1,opt,name=contains" json:"contains,omitempty"`
-	Get              *GetResponse            `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put              *PutResponse            `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
-	ConditionalPut   *ConditionalPutResponse `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
-	Increment        *IncrementResponse      `protobuf:"bytes,5,opt,name=increment" json:"increment,omitempty"`
-	Delete           *DeleteResponse         `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
-	DeleteRange      *DeleteRangeResponse    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
-	Scan             *ScanResponse           `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
-	EndTransaction   *EndTransactionResponse `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
-	ReapQueue        *ReapQueueResponse      `protobuf:"bytes,10,opt,name=reap_queue" json:"reap_queue,omitempty"`
-	EnqueueUpdate    *EnqueueUpdateResponse  `protobuf:"bytes,11,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
-	EnqueueMessage   *EnqueueMessageResponse `protobuf:"bytes,12,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
-	XXX_unrecognized []byte                  `json:"-"`
+	Contains          *ContainsResponse          `protobuf:"bytes,1,opt,name=contains" json:"contains,omitempty"`
+	Get               *GetResponse               `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put               *PutResponse               `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
+	ConditionalPut    *ConditionalPutResponse    `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
+	Increment         *IncrementResponse         `protobuf:"bytes,5,opt,name=increment" json:"increment,omitempty"`
+	Delete            *DeleteResponse            `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
+	DeleteRange       *DeleteRangeResponse       `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
+	Scan              *ScanResponse              `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
+	EndTransaction    *EndTransactionResponse    `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReapQueue         *ReapQueueResponse         `protobuf:"bytes,10,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate     *EnqueueUpdateResponse     `protobuf:"bytes,11,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage    *EnqueueMessageResponse    `protobuf:"bytes,12,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	ReverseScan       *ReverseScanResponse       `protobuf:"bytes,13,opt,name=reverse_scan" json:"reverse_scan,omitempty"`
+	ConditionalDelete *ConditionalDeleteResponse `protobuf:"bytes,14,opt,name=conditional_delete" json:"conditional_delete,omitempty"`
+	XXX_unrecognized  []byte                     `json:"-"`
 }
 
 func (m *ResponseUnion) Reset()         { *m = ResponseUnion{} }
@@ -885,6 +1317,20 @@ func (m *ResponseUnion) GetEnqueueMessage() *EnqueueMessageResponse {
 	return nil
 }
 
+func (m *ResponseUnion) GetReverseScan() *ReverseScanResponse {
+	if m != nil {
+		return m.ReverseScan
+	}
+	return nil
+}
+
+func (m *ResponseUnion) GetConditionalDelete() *ConditionalDeleteResponse {
+	if m != nil {
+		return m.ConditionalDelete
+	}
+	return nil
+}
+
 // A BatchRequest contains one or more requests to be executed in
 // parallel, or if applicable (based on write-only commands and
 // range-locality), as a single update.
@@ -910,9 +1356,15 @@ func (m *BatchRequest) GetRequests() []RequestUnion {
 // error in the response header is set to the first error from the
 // slice of responses, if applicable.
 type BatchResponse struct {
-	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Responses        []ResponseUnion `protobuf:"bytes,2,rep,name=responses" json:"responses"`
-	XXX_unrecognized []byte          `json:"-"`
+	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Responses      []ResponseUnion `protobuf:"bytes,2,rep,name=responses" json:"responses"`
+	// Completed is the number of leading requests which executed
+	// successfully. If less than the number of requests, the request at
+	// that index failed with the batch's error and later requests were not
+	// executed, so that a client may resume the batch from it, as after a
+	// timeout.
+	Completed        int32  `protobuf:"varint,3,opt,name=completed" json:"completed"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *BatchResponse) Reset()         { *m = BatchResponse{} }
@@ -926,6 +1378,13 @@ func (m *BatchResponse) GetResponses() []ResponseUnion {
 	return nil
 }
 
+func (m *BatchResponse) GetCompleted() int32 {
+	if m != nil {
+		return m.Completed
+	}
+	return 0
+}
+
 // An AdminSplitRequest is arguments to the AdminSplit() method. The
 // existing range which contains RequestHeader.Key is split by
 // split_key. If split_key is not specified, then this method will
@@ -947,8 +1406,11 @@ func (m *BatchResponse) GetResponses() []ResponseUnion {
 // metadata (e.g. response cache and range stats must be copied or
 // recomputed).
 type AdminSplitRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	SplitKey         Key    `protobuf:"bytes,2,opt,name=split_key,customtype=Key" json:"split_key"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	SplitKey      Key `protobuf:"bytes,2,opt,name=split_key,customtype=Key" json:"split_key"`
+	// If dry_run is true, the split key is validated and the projected
+	// sizes of the two halves are returned, but no split is carried out.
+	DryRun           bool   `protobuf:"varint,3,opt,name=dry_run" json:"dry_run"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -956,10 +1418,23 @@ func (m *AdminSplitRequest) Reset()         { *m = AdminSplitRequest{} }
 func (m *AdminSplitRequest) String() string { return proto1.CompactTextString(m) }
 func (*AdminSplitRequest) ProtoMessage()    {}
 
+func (m *AdminSplitRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // An AdminSplitResponse is the return value from the AdminSplit()
 // method.
 type AdminSplitResponse struct {
-	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// The key at which the range was (or, for a dry run, would be) split.
+	SplitKey Key `protobuf:"bytes,2,opt,name=split_key,customtype=Key" json:"split_key"`
+	// For a dry run, the projected key and value bytes of the left and
+	// right halves of the split.
+	LeftBytes        int64  `protobuf:"varint,3,opt,name=left_bytes" json:"left_bytes"`
+	RightBytes       int64  `protobuf:"varint,4,opt,name=right_bytes" json:"right_bytes"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -967,6 +1442,20 @@ func (m *AdminSplitResponse) Reset()         { *m = AdminSplitResponse{} }
 func (m *AdminSplitResponse) String() string { return proto1.CompactTextString(m) }
 func (*AdminSplitResponse) ProtoMessage()    {}
 
+func (m *AdminSplitResponse) GetLeftBytes() int64 {
+	if m != nil {
+		return m.LeftBytes
+	}
+	return 0
+}
+
+func (m *AdminSplitResponse) GetRightBytes() int64 {
+	if m != nil {
+		return m.RightBytes
+	}
+	return 0
+}
+
 // An AdminMergeRequest is arguments to the AdminMerge() method. A
 // merge is always performed by calling AdminMerge on the range
 // that is subsuming the passed in subsumed_range. The ranges must
@@ -1042,6 +1531,12 @@ func (this *RequestUnion) GetValue() interface{} {
 	if this.EnqueueMessage != nil {
 		return this.EnqueueMessage
 	}
+	if this.ReverseScan != nil {
+		return this.ReverseScan
+	}
+	if this.ConditionalDelete != nil {
+		return this.ConditionalDelete
+	}
 	return nil
 }
 
@@ -1071,6 +1566,10 @@ func (this *RequestUnion) SetValue(value interface{}) bool {
 		this.EnqueueUpdate = vt
 	case *EnqueueMessageRequest:
 		this.EnqueueMessage = vt
+	case *ReverseScanRequest:
+		this.ReverseScan = vt
+	case *ConditionalDeleteRequest:
+		this.ConditionalDelete = vt
 	default:
 		return false
 	}
@@ -1113,6 +1612,12 @@ func (this *ResponseUnion) GetValue() interface{} {
 	if this.EnqueueMessage != nil {
 		return this.EnqueueMessage
 	}
+	if this.ReverseScan != nil {
+		return this.ReverseScan
+	}
+	if this.ConditionalDelete != nil {
+		return this.ConditionalDelete
+	}
 	return nil
 }
 
@@ -1142,6 +1647,10 @@ func (this *ResponseUnion) SetValue(value interface{}) bool {
 		this.EnqueueUpdate = vt
 	case *EnqueueMessageResponse:
 		this.EnqueueMessage = vt
+	case *ReverseScanResponse:
+		this.ReverseScan = vt
+	case *ConditionalDeleteResponse:
+		this.ConditionalDelete = vt
 	default:
 		return false
 	}
@@ -79,6 +79,27 @@ func (a Attributes) IsSubset(b Attributes) bool {
 	return true
 }
 
+// MatchScore returns how well attributes list a satisfies the
+// required and preferred attribute lists. If a lacks any of the
+// required attributes, the score is negative; otherwise it is the
+// number of preferred attributes found in a.
+func (a Attributes) MatchScore(required, preferred Attributes) int {
+	if !required.IsSubset(a) {
+		return -1
+	}
+	m := map[string]struct{}{}
+	for _, s := range a.Attrs {
+		m[s] = struct{}{}
+	}
+	score := 0
+	for _, s := range preferred.Attrs {
+		if _, ok := m[s]; ok {
+			score++
+		}
+	}
+	return score
+}
+
 // SortedString returns a sorted, de-duplicated, comma-separated list
 // of the attributes.
 func (a Attributes) SortedString() string {
@@ -100,14 +121,26 @@ func (r *RangeDescriptor) ContainsKey(key []byte) bool {
 }
 
 // ContainsKeyRange returns whether this RangeDescriptor contains the specified
-// key range from start to end.
+// key range from start (inclusive) to end (exclusive). A range whose end
+// equals EndKey is contained. An empty end specifies the single key start.
 func (r *RangeDescriptor) ContainsKeyRange(start, end []byte) bool {
+	return r.ContainsKeyRangeStrict(start, end, false)
+}
+
+// ContainsKeyRangeStrict is like ContainsKeyRange, but if exclusive is
+// true, additionally requires that end sort strictly before EndKey, so
+// that end itself is a key within the range. An empty end specifies the
+// single key start in either case.
+func (r *RangeDescriptor) ContainsKeyRangeStrict(start, end []byte, exclusive bool) bool {
 	if len(end) == 0 {
 		end = append(append([]byte(nil), start...), byte(0))
 	}
 	if bytes.Compare(end, start) < 0 {
 		panic(fmt.Sprintf("start key is larger than end key %q > %q", string(start), string(end)))
 	}
+	if exclusive {
+		return bytes.Compare(start, r.StartKey) >= 0 && bytes.Compare(end, r.EndKey) < 0
+	}
 	return bytes.Compare(start, r.StartKey) >= 0 && bytes.Compare(r.EndKey, end) >= 0
 }
 
@@ -141,3 +174,46 @@ func (p *PermConfig) CanWrite(user string) bool {
 	}
 	return false
 }
+
+// Validate returns an error if the accounting config is invalid.
+// Accounting configs currently impose no constraints.
+func (a *AcctConfig) Validate() error {
+	return nil
+}
+
+// Validate returns an error if the permission config names an empty
+// user.
+func (p *PermConfig) Validate() error {
+	for _, users := range [][]string{p.Read, p.Write} {
+		for _, u := range users {
+			if u == "" {
+				return util.Errorf("permission config specifies an empty user")
+			}
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if the zone config specifies no replicas,
+// more preferred than required replica attributes, or range size
+// bounds which are negative or inverted.
+func (z *ZoneConfig) Validate() error {
+	if len(z.ReplicaAttrs) == 0 {
+		return util.Errorf("zone config specifies no replicas")
+	}
+	if len(z.PreferredReplicaAttrs) > len(z.ReplicaAttrs) {
+		return util.Errorf("zone config specifies preferred attributes for %d replicas but only %d replicas",
+			len(z.PreferredReplicaAttrs), len(z.ReplicaAttrs))
+	}
+	if z.RangeMinBytes < 0 {
+		return util.Errorf("zone config range min bytes %d is negative", z.RangeMinBytes)
+	}
+	if z.RangeMaxBytes <= 0 {
+		return util.Errorf("zone config range max bytes %d is not positive", z.RangeMaxBytes)
+	}
+	if z.RangeMinBytes > z.RangeMaxBytes {
+		return util.Errorf("zone config range min bytes %d exceeds range max bytes %d",
+			z.RangeMinBytes, z.RangeMaxBytes)
+	}
+	return nil
+}
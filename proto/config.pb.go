@@ -116,7 +116,11 @@ func (m *GCPolicy) GetTTLSeconds() int32 {
 
 // AcctConfig holds accounting configuration.
 type AcctConfig struct {
-	ClusterId        string `protobuf:"bytes,1,opt,name=cluster_id" json:"cluster_id" yaml:"cluster_id,omitempty"`
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id" json:"cluster_id" yaml:"cluster_id,omitempty"`
+	// If Rollup is set, stores periodically aggregate the MVCC stats of
+	// the key span covered by the accounting prefix into an AcctRollup,
+	// written under engine.AcctRollupKey(prefix).
+	Rollup           bool   `protobuf:"varint,2,opt,name=rollup" json:"rollup" yaml:"rollup,omitempty"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -131,6 +135,105 @@ func (m *AcctConfig) GetClusterId() string {
 	return ""
 }
 
+func (m *AcctConfig) GetRollup() bool {
+	if m != nil {
+		return m.Rollup
+	}
+	return false
+}
+
+// AcctRollup is the aggregate of the MVCC stats of the ranges covering
+// the key span of an accounting prefix.
+type AcctRollup struct {
+	LiveBytes   int64 `protobuf:"varint,1,opt,name=live_bytes" json:"live_bytes"`
+	KeyBytes    int64 `protobuf:"varint,2,opt,name=key_bytes" json:"key_bytes"`
+	ValBytes    int64 `protobuf:"varint,3,opt,name=val_bytes" json:"val_bytes"`
+	IntentBytes int64 `protobuf:"varint,4,opt,name=intent_bytes" json:"intent_bytes"`
+	LiveCount   int64 `protobuf:"varint,5,opt,name=live_count" json:"live_count"`
+	KeyCount    int64 `protobuf:"varint,6,opt,name=key_count" json:"key_count"`
+	ValCount    int64 `protobuf:"varint,7,opt,name=val_count" json:"val_count"`
+	IntentCount int64 `protobuf:"varint,8,opt,name=intent_count" json:"intent_count"`
+	// Ranges is the number of ranges aggregated.
+	Ranges int64 `protobuf:"varint,9,opt,name=ranges" json:"ranges"`
+	// LastUpdateNanos is the wall time at which the rollup was computed.
+	LastUpdateNanos  int64  `protobuf:"varint,10,opt,name=last_update_nanos" json:"last_update_nanos"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *AcctRollup) Reset()         { *m = AcctRollup{} }
+func (m *AcctRollup) String() string { return proto1.CompactTextString(m) }
+func (*AcctRollup) ProtoMessage()    {}
+
+func (m *AcctRollup) GetLiveBytes() int64 {
+	if m != nil {
+		return m.LiveBytes
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetKeyBytes() int64 {
+	if m != nil {
+		return m.KeyBytes
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetValBytes() int64 {
+	if m != nil {
+		return m.ValBytes
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetIntentBytes() int64 {
+	if m != nil {
+		return m.IntentBytes
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetLiveCount() int64 {
+	if m != nil {
+		return m.LiveCount
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetKeyCount() int64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetValCount() int64 {
+	if m != nil {
+		return m.ValCount
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetIntentCount() int64 {
+	if m != nil {
+		return m.IntentCount
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetRanges() int64 {
+	if m != nil {
+		return m.Ranges
+	}
+	return 0
+}
+
+func (m *AcctRollup) GetLastUpdateNanos() int64 {
+	if m != nil {
+		return m.LastUpdateNanos
+	}
+	return 0
+}
+
 // PermConfig holds permission configuration, specifying read/write ACLs.
 type PermConfig struct {
 	// ACL lists users with read permissions.
@@ -168,8 +271,16 @@ type ZoneConfig struct {
 	RangeMaxBytes int64        `protobuf:"varint,3,opt,name=range_max_bytes" json:"range_max_bytes" yaml:"range_max_bytes,omitempty"`
 	// If GC policy is not set, uses the next highest, non-null policy
 	// in the zone config hierarchy, up to the default policy if necessary.
-	GC               *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
-	XXX_unrecognized []byte    `json:"-"`
+	GC *GCPolicy `protobuf:"bytes,4,opt,name=gc" json:"gc,omitempty" yaml:"gc,omitempty"`
+	// PreferredReplicaAttrs is a slice of Attributes parallel to ReplicaAttrs,
+	// each describing attributes which are preferred, but not required, for
+	// the corresponding replica. Stores matching more preferred attributes
+	// are chosen over those matching only the required attributes.
+	PreferredReplicaAttrs []Attributes `protobuf:"bytes,5,rep,name=preferred_replica_attrs" json:"preferred_replica_attrs" yaml:"preferred,omitempty"`
+	// Audit enables an append-only audit log of mutations to ranges in
+	// the zone.
+	Audit            bool   `protobuf:"varint,6,opt,name=audit" json:"audit" yaml:"audit,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *ZoneConfig) Reset()         { *m = ZoneConfig{} }
@@ -204,5 +315,19 @@ func (m *ZoneConfig) GetGC() *GCPolicy {
 	return nil
 }
 
+func (m *ZoneConfig) GetPreferredReplicaAttrs() []Attributes {
+	if m != nil {
+		return m.PreferredReplicaAttrs
+	}
+	return nil
+}
+
+func (m *ZoneConfig) GetAudit() bool {
+	if m != nil {
+		return m.Audit
+	}
+	return false
+}
+
 func init() {
 }
@@ -46,6 +46,24 @@ func TestAttributesIsSubset(t *testing.T) {
 	}
 }
 
+func TestAttributesMatchScore(t *testing.T) {
+	required := Attributes{Attrs: []string{"a"}}
+	preferred := Attributes{Attrs: []string{"ssd"}}
+	ssd := Attributes{Attrs: []string{"a", "ssd"}}
+	hdd := Attributes{Attrs: []string{"a", "hdd"}}
+	other := Attributes{Attrs: []string{"b", "ssd"}}
+	ssdScore, hddScore := ssd.MatchScore(required, preferred), hdd.MatchScore(required, preferred)
+	if ssdScore <= hddScore {
+		t.Errorf("expected %+v to score higher than %+v; got %d <= %d", ssd, hdd, ssdScore, hddScore)
+	}
+	if hddScore < 0 {
+		t.Errorf("expected %+v to be eligible; got score %d", hdd, hddScore)
+	}
+	if score := other.MatchScore(required, preferred); score >= 0 {
+		t.Errorf("expected %+v to be ineligible; got score %d", other, score)
+	}
+}
+
 func TestAttributesSortedString(t *testing.T) {
 	a := Attributes{Attrs: []string{"a", "b", "c"}}
 	if a.SortedString() != "a,b,c" {
@@ -129,6 +147,45 @@ func TestRangeDescriptorContains(t *testing.T) {
 	}
 }
 
+// TestRangeDescriptorContainsStrict verifies the handling of key
+// ranges ending exactly at the range's end key, with and without
+// exclusive matching.
+func TestRangeDescriptorContainsStrict(t *testing.T) {
+	desc := RangeDescriptor{}
+	desc.StartKey = []byte("a")
+	desc.EndKey = []byte("b")
+
+	testData := []struct {
+		start, end          []byte
+		contains, exclusive bool
+	}{
+		// Keys exactly at StartKey.
+		{[]byte("a"), nil, true, true},
+		{[]byte("a"), []byte("a\x00"), true, true},
+		{[]byte("a"), []byte("aa"), true, true},
+		// Ranges ending exactly at EndKey.
+		{[]byte("a"), []byte("b"), true, false},
+		{[]byte("aa"), []byte("b"), true, false},
+		// Keys exactly at EndKey.
+		{[]byte("b"), nil, false, false},
+		{[]byte("b"), []byte("b\x00"), false, false},
+		// Ranges extending beyond either boundary.
+		{[]byte("`"), []byte("aa"), false, false},
+		{[]byte("aa"), []byte("b\x00"), false, false},
+	}
+	for i, test := range testData {
+		if c := desc.ContainsKeyRange(test.start, test.end); c != test.contains {
+			t.Errorf("%d: expected key range %q-%q containment %t; got %t", i, test.start, test.end, test.contains, c)
+		}
+		if c := desc.ContainsKeyRangeStrict(test.start, test.end, false); c != test.contains {
+			t.Errorf("%d: expected non-exclusive key range %q-%q containment %t; got %t", i, test.start, test.end, test.contains, c)
+		}
+		if c := desc.ContainsKeyRangeStrict(test.start, test.end, true); c != test.exclusive {
+			t.Errorf("%d: expected exclusive key range %q-%q containment %t; got %t", i, test.start, test.end, test.exclusive, c)
+		}
+	}
+}
+
 func TestPermConfig(t *testing.T) {
 	p := &PermConfig{
 		Read:  []string{"foo", "bar", "baz"},
@@ -151,3 +208,48 @@ func TestPermConfig(t *testing.T) {
 		t.Errorf("unexpected read access for user \"bar\"")
 	}
 }
+
+func TestPermConfigValidate(t *testing.T) {
+	testCases := []struct {
+		config PermConfig
+		valid  bool
+	}{
+		{PermConfig{}, true},
+		{PermConfig{Read: []string{"foo"}, Write: []string{"foo"}}, true},
+		{PermConfig{Read: []string{"foo", ""}}, false},
+		{PermConfig{Write: []string{""}}, false},
+	}
+	for i, test := range testCases {
+		if err := test.config.Validate(); (err == nil) != test.valid {
+			t.Errorf("%d: expected valid %t; got %v", i, test.valid, err)
+		}
+	}
+}
+
+func TestZoneConfigValidate(t *testing.T) {
+	replicas := []Attributes{{Attrs: []string{"dc1"}}, {Attrs: []string{"dc2"}}}
+	testCases := []struct {
+		config ZoneConfig
+		valid  bool
+	}{
+		{ZoneConfig{ReplicaAttrs: replicas, RangeMinBytes: 1 << 10, RangeMaxBytes: 1 << 20}, true},
+		{ZoneConfig{ReplicaAttrs: replicas, RangeMaxBytes: 1 << 20}, true},
+		{ZoneConfig{ReplicaAttrs: replicas, RangeMinBytes: 1 << 20, RangeMaxBytes: 1 << 20}, true},
+		{ZoneConfig{ReplicaAttrs: replicas, PreferredReplicaAttrs: replicas[:1], RangeMaxBytes: 1 << 20}, true},
+		// No replicas.
+		{ZoneConfig{RangeMinBytes: 1 << 10, RangeMaxBytes: 1 << 20}, false},
+		// More preferred than required replica attributes.
+		{ZoneConfig{ReplicaAttrs: replicas[:1], PreferredReplicaAttrs: replicas, RangeMaxBytes: 1 << 20}, false},
+		// Negative min bytes.
+		{ZoneConfig{ReplicaAttrs: replicas, RangeMinBytes: -1, RangeMaxBytes: 1 << 20}, false},
+		// Zero max bytes.
+		{ZoneConfig{ReplicaAttrs: replicas}, false},
+		// Min bytes exceeding max bytes.
+		{ZoneConfig{ReplicaAttrs: replicas, RangeMinBytes: 1 << 20, RangeMaxBytes: 1 << 10}, false},
+	}
+	for i, test := range testCases {
+		if err := test.config.Validate(); (err == nil) != test.valid {
+			t.Errorf("%d: expected valid %t; got %v", i, test.valid, err)
+		}
+	}
+}
@@ -38,6 +38,9 @@ const (
 	KeyMaxLength = 4096
 	// MaxPriority is the maximum allowed priority.
 	MaxPriority = math.MaxInt32
+	// MaxTxnIntentSpans is the maximum number of intent spans recorded
+	// by a transaction. See Transaction.AddIntent.
+	MaxTxnIntentSpans = 100
 )
 
 var (
@@ -358,12 +361,13 @@ func MD5Equal(a, b [md5.Size]byte) bool {
 	return true
 }
 
-// Restart reconfigures a transaction for restart. The epoch is
-// incremented for an in-place restart. The timestamp of the
+// Restart reconfigures a transaction for restart. The epoch and the
+// count of restarts are incremented for an in-place restart. The timestamp of the
 // transaction on restart is set to the maximum of the transaction's
 // timestamp and the specified timestamp.
 func (t *Transaction) Restart(userPriority, upgradePriority int32, timestamp Timestamp) {
 	t.Epoch++
+	t.Restarts++
 	if t.Timestamp.Less(timestamp) {
 		t.Timestamp = timestamp
 	}
@@ -392,6 +396,9 @@ func (t *Transaction) Update(o *Transaction) {
 	if t.Epoch < o.Epoch {
 		t.Epoch = o.Epoch
 	}
+	if t.Restarts < o.Restarts {
+		t.Restarts = o.Restarts
+	}
 	if t.Timestamp.Less(o.Timestamp) {
 		t.Timestamp = o.Timestamp
 	}
@@ -400,6 +407,9 @@ func (t *Transaction) Update(o *Transaction) {
 	}
 	// Should not actually change at the time of writing.
 	t.MaxTimestamp = o.MaxTimestamp
+	if o.Deadline != nil {
+		t.Deadline = gogoproto.Clone(o.Deadline).(*Timestamp)
+	}
 	// Copy the list of nodes without time uncertainty.
 	t.CertainNodes = NodeList{Nodes: append(Int32Slice(nil),
 		o.CertainNodes.Nodes...)}
@@ -414,6 +424,45 @@ func (t *Transaction) UpgradePriority(minPriority int32) {
 	}
 }
 
+// AddIntent records that the transaction has laid intents on the keys
+// from start to end (exclusive), or on start alone if end is empty.
+// The intent spans are kept sorted, merging overlapping and contiguous
+// spans. Once there are more than MaxTxnIntentSpans, neighboring pairs
+// of spans are collapsed into single spans covering both, which may
+// then include keys the transaction hasn't written.
+func (t *Transaction) AddIntent(start, end Key) {
+	if len(end) == 0 {
+		end = start.Next()
+	}
+	// Merge with the spans which overlap or abut the new one.
+	i := sort.Search(len(t.Intents), func(i int) bool {
+		return !t.Intents[i].EndKey.Less(start)
+	})
+	j := i
+	for ; j < len(t.Intents) && !end.Less(t.Intents[j].Key); j++ {
+		if t.Intents[j].Key.Less(start) {
+			start = t.Intents[j].Key
+		}
+		if end.Less(t.Intents[j].EndKey) {
+			end = t.Intents[j].EndKey
+		}
+	}
+	span := KeySpan{Key: append(Key(nil), start...), EndKey: append(Key(nil), end...)}
+	t.Intents = append(t.Intents[:i], append([]KeySpan{span}, t.Intents[j:]...)...)
+
+	if len(t.Intents) > MaxTxnIntentSpans {
+		collapsed := t.Intents[:0]
+		for k := 0; k < len(t.Intents); k += 2 {
+			span := t.Intents[k]
+			if k+1 < len(t.Intents) {
+				span.EndKey = t.Intents[k+1].EndKey
+			}
+			collapsed = append(collapsed, span)
+		}
+		t.Intents = collapsed
+	}
+}
+
 // MD5 returns the MD5 digest of the transaction ID. This method
 // returns an empty string if the transaction is nil.
 func (t *Transaction) MD5() [md5.Size]byte {
@@ -458,6 +458,18 @@ func (m *NodeList) GetNodes() []int32 {
 	return nil
 }
 
+// A KeySpan is the span of keys from key (inclusive) to end_key
+// (exclusive).
+type KeySpan struct {
+	Key              Key    `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	EndKey           Key    `protobuf:"bytes,2,opt,name=end_key,customtype=Key" json:"end_key"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *KeySpan) Reset()         { *m = KeySpan{} }
+func (m *KeySpan) String() string { return proto1.CompactTextString(m) }
+func (*KeySpan) ProtoMessage()    {}
+
 // A Transaction is a unit of work performed on the database.
 // Cockroach transactions support two isolation levels: snapshot
 // isolation and serializable snapshot isolation. Each Cockroach
@@ -512,8 +524,18 @@ type Transaction struct {
 	// Bits of this mechanism are found in the local sender, the range and the
 	// txn_coord_sender, with brief comments referring here.
 	// See https://github.com/cockroachdb/cockroach/pull/221.
-	CertainNodes     NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
-	XXX_unrecognized []byte   `json:"-"`
+	CertainNodes NodeList `protobuf:"bytes,12,opt,name=certain_nodes" json:"certain_nodes"`
+	// Deadline is the timestamp after which the transaction may no
+	// longer commit. Unset if the transaction has no deadline.
+	Deadline *Timestamp `protobuf:"bytes,13,opt,name=deadline" json:"deadline,omitempty"`
+	// Restarts is the number of times the transaction has restarted,
+	// incrementing its epoch.
+	Restarts int32 `protobuf:"varint,14,opt,name=restarts" json:"restarts"`
+	// Intents are the spans of keys on which the transaction has laid
+	// intents, accumulated as its writes execute. Overlapping and
+	// contiguous spans are merged. See Transaction.AddIntent.
+	Intents          []KeySpan `protobuf:"bytes,15,rep,name=intents" json:"intents"`
+	XXX_unrecognized []byte    `json:"-"`
 }
 
 func (m *Transaction) Reset()      { *m = Transaction{} }
@@ -596,6 +618,27 @@ func (m *Transaction) GetCertainNodes() NodeList {
 	return NodeList{}
 }
 
+func (m *Transaction) GetDeadline() *Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *Transaction) GetRestarts() int32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *Transaction) GetIntents() []KeySpan {
+	if m != nil {
+		return m.Intents
+	}
+	return nil
+}
+
 // MVCCMetadata holds MVCC metadata for a key. Used by storage/engine/mvcc.go.
 type MVCCMetadata struct {
 	Txn *Transaction `protobuf:"bytes,1,opt,name=txn" json:"txn,omitempty"`
@@ -612,7 +655,17 @@ type MVCCMetadata struct {
 	// and subsequent version rows. If timestamp == (0, 0), then there
 	// is only a single MVCC metadata row with value inlined, and with
 	// empty timestamp, key_bytes, and val_bytes.
-	Value            *Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
+	Value *Value `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
+	// Are the versioned values of this key stored as raw bytes instead of
+	// encoded MVCCValue messages? Raw-encoded keys are designated by key
+	// prefix (see engine.RegisterRawValuePrefix), and a zero-length raw
+	// versioned value is a deletion tombstone.
+	Raw bool `protobuf:"varint,7,opt,name=raw" json:"raw"`
+	// Is this a read lock laid by a transaction? A read lock is recorded
+	// with an intent's txn but no versioned value, blocking writers but
+	// not readers. If the key had no versions when locked, timestamp,
+	// key_bytes and val_bytes are zero and deleted is true.
+	Lock             bool   `protobuf:"varint,8,opt,name=lock" json:"lock"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -662,6 +715,20 @@ func (m *MVCCMetadata) GetValue() *Value {
 	return nil
 }
 
+func (m *MVCCMetadata) GetRaw() bool {
+	if m != nil {
+		return m.Raw
+	}
+	return false
+}
+
+func (m *MVCCMetadata) GetLock() bool {
+	if m != nil {
+		return m.Lock
+	}
+	return false
+}
+
 // GCMetadata holds information about the last complete key/value
 // garbage collection scan of a range.
 type GCMetadata struct {
@@ -762,6 +829,25 @@ func (m *TimeSeriesData) GetDatapoints() []*TimeSeriesDatapoint {
 	return nil
 }
 
+// RangeTombstone is persisted to a store when a range is removed from
+// it. Commands for the removed range which were proposed by a replica
+// at a generation earlier than Generation are rejected.
+type RangeTombstone struct {
+	Generation       int64  `protobuf:"varint,1,opt,name=generation" json:"generation"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RangeTombstone) Reset()         { *m = RangeTombstone{} }
+func (m *RangeTombstone) String() string { return proto1.CompactTextString(m) }
+func (*RangeTombstone) ProtoMessage()    {}
+
+func (m *RangeTombstone) GetGeneration() int64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
 func init() {
 	proto1.RegisterEnum("proto.ReplicaChangeType", ReplicaChangeType_name, ReplicaChangeType_value)
 	proto1.RegisterEnum("proto.IsolationType", IsolationType_name, IsolationType_value)
@@ -1163,6 +1249,96 @@ func (m *NodeList) Unmarshal(data []byte) error {
 	}
 	return nil
 }
+func (m *KeySpan) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndKey.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+	return nil
+}
 func (m *Transaction) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
@@ -1433,6 +1609,73 @@ func (m *Transaction) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = &Timestamp{}
+			}
+			if err := m.Deadline.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Restarts |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Intents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Intents = append(m.Intents, KeySpan{})
+			if err := m.Intents[len(m.Intents)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1600,6 +1843,40 @@ func (m *MVCCMetadata) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Raw = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lock", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lock = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -19,6 +19,7 @@ package proto
 
 import (
 	"bytes"
+	"fmt"
 	"math"
 	"math/rand"
 	"reflect"
@@ -325,6 +326,59 @@ func TestNodeList(t *testing.T) {
 	}
 }
 
+// TestTransactionAddIntent verifies that intent spans are kept sorted,
+// that overlapping and contiguous spans are merged and that the number
+// of spans is bounded.
+func TestTransactionAddIntent(t *testing.T) {
+	txn := Transaction{}
+	txn.AddIntent(Key("c"), nil)
+	txn.AddIntent(Key("a"), nil)
+	txn.AddIntent(Key("e"), Key("g"))
+	txn.AddIntent(Key("a").Next(), nil) // contiguous with "a"
+	txn.AddIntent(Key("f"), Key("h"))   // overlaps [e, g)
+	expSpans := []KeySpan{
+		{Key: Key("a"), EndKey: Key("a").Next().Next()},
+		{Key: Key("c"), EndKey: Key("c").Next()},
+		{Key: Key("e"), EndKey: Key("h")},
+	}
+	if !reflect.DeepEqual(txn.Intents, expSpans) {
+		t.Fatalf("expected intents %+v; got %+v", expSpans, txn.Intents)
+	}
+
+	// A span abutting an existing span is merged with it.
+	txn.AddIntent(Key("b"), Key("c"))
+	expSpans = []KeySpan{
+		{Key: Key("a"), EndKey: Key("a").Next().Next()},
+		{Key: Key("b"), EndKey: Key("c").Next()},
+		{Key: Key("e"), EndKey: Key("h")},
+	}
+	if !reflect.DeepEqual(txn.Intents, expSpans) {
+		t.Fatalf("expected intents %+v; got %+v", expSpans, txn.Intents)
+	}
+
+	// Many distinct keys are collapsed into spans which cover them.
+	txn = Transaction{}
+	for i := 0; i < 10*MaxTxnIntentSpans; i++ {
+		txn.AddIntent(Key(fmt.Sprintf("%05d", i*2)), nil)
+	}
+	if len(txn.Intents) > MaxTxnIntentSpans {
+		t.Fatalf("expected at most %d intent spans; got %d", MaxTxnIntentSpans, len(txn.Intents))
+	}
+	for i := 0; i < 10*MaxTxnIntentSpans; i++ {
+		key := Key(fmt.Sprintf("%05d", i*2))
+		found := false
+		for _, span := range txn.Intents {
+			if !key.Less(span.Key) && key.Less(span.EndKey) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("key %q not covered by intent spans", key)
+		}
+	}
+}
+
 func ts(name string, dps ...*TimeSeriesDatapoint) *TimeSeriesData {
 	return &TimeSeriesData{
 		Name:       name,
@@ -17,7 +17,10 @@
 
 package proto
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // Error implements the Go error interface.
 func (ge *GenericError) Error() string {
@@ -87,15 +90,15 @@ func (e *TransactionAbortedError) Error() string {
 // NewTransactionPushError initializes a new TransactionPushError.
 // Txn is the transaction which will be retried.
 func NewTransactionPushError(txn, pusheeTxn *Transaction) *TransactionPushError {
-	return &TransactionPushError{Txn: txn, PusheeTxn: *pusheeTxn}
+	return &TransactionPushError{Txn: txn, PusheeTxn: *pusheeTxn, PusheePriority: pusheeTxn.Priority}
 }
 
 // Error formats error.
 func (e *TransactionPushError) Error() string {
 	if e.Txn == nil {
-		return fmt.Sprintf("failed to push %s", e.PusheeTxn)
+		return fmt.Sprintf("failed to push %s with priority %d", e.PusheeTxn, e.PusheePriority)
 	} else {
-		return fmt.Sprintf("txn %s failed to push %s", e.Txn, e.PusheeTxn)
+		return fmt.Sprintf("txn %s failed to push %s with priority %d", e.Txn, e.PusheeTxn, e.PusheePriority)
 	}
 }
 
@@ -147,3 +150,83 @@ func (e *OpRequiresTxnError) Error() string {
 func (e *ConditionFailedError) Error() string {
 	return fmt.Sprintf("unexpected value: %s", e.ActualValue)
 }
+
+// Error formats error.
+func (e *ConflictTimeoutError) Error() string {
+	return fmt.Sprintf("timed out resolving conflict with transaction %s at key %q", e.Txn, e.Key)
+}
+
+// CanRetry indicates whether or not this ConflictTimeoutError can be retried.
+func (e *ConflictTimeoutError) CanRetry() bool {
+	return true
+}
+
+// Error formats error.
+func (e *RaftGroupDeletedError) Error() string {
+	return fmt.Sprintf("raft group for range %d deleted", e.RaftID)
+}
+
+// CanRetry indicates whether or not this RaftGroupDeletedError can be retried.
+func (e *RaftGroupDeletedError) CanRetry() bool {
+	return false
+}
+
+// Error formats error.
+func (e *StoreOverloadedError) Error() string {
+	return fmt.Sprintf("store overloaded; %s command rejected", e.Method)
+}
+
+// CanRetry indicates whether or not this StoreOverloadedError can be retried.
+func (e *StoreOverloadedError) CanRetry() bool {
+	return true
+}
+
+// Error formats error.
+func (e *DeadlineExceededError) Error() string {
+	return fmt.Sprintf("storage engine operation exceeded deadline %s", time.Unix(0, e.Deadline))
+}
+
+// Error formats error.
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for value at key %q", e.Key)
+}
+
+// Error formats error.
+func (e *ProtectedKeyError) Error() string {
+	return fmt.Sprintf("key %q is reserved for system use and may not be written by user commands", e.Key)
+}
+
+// Error formats error.
+func (e *TooManyIntentsError) Error() string {
+	return fmt.Sprintf("write would leave %d intents on range %d, exceeding the limit of %d", e.IntentCount, e.RaftID, e.MaxIntents)
+}
+
+// Error formats error.
+func (e *CommandTooLargeError) Error() string {
+	return fmt.Sprintf("command of %d bytes exceeds the maximum command size of %d bytes", e.Size, e.MaxSize)
+}
+
+// Error formats error.
+func (e *ProposalBufferFullError) Error() string {
+	return fmt.Sprintf("range %d has %d bytes of unapplied proposals, exceeding the limit of %d", e.RaftID, e.PendingBytes, e.MaxBytes)
+}
+
+// CanRetry indicates whether or not this ProposalBufferFullError can be retried.
+func (e *ProposalBufferFullError) CanRetry() bool {
+	return true
+}
+
+// Error formats error.
+func (e *TimestampTooFarError) Error() string {
+	return fmt.Sprintf("command timestamp %s exceeds the maximum allowed timestamp %s", e.Timestamp, e.MaxTimestamp)
+}
+
+// Error formats error.
+func (e *CommandQueueTimeoutError) Error() string {
+	return fmt.Sprintf("command on %q-%q timed out after waiting %s in the command queue", e.Key, e.EndKey, time.Duration(e.Timeout))
+}
+
+// CanRetry indicates whether or not this CommandQueueTimeoutError can be retried.
+func (e *CommandQueueTimeoutError) CanRetry() bool {
+	return true
+}
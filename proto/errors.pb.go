@@ -147,9 +147,12 @@ func (m *TransactionAbortedError) GetTxn() Transaction {
 type TransactionPushError struct {
 	// txn can be null in the event the push error happened to a
 	// non-transactional method.
-	Txn              *Transaction `protobuf:"bytes,1,opt,name=txn" json:"txn,omitempty"`
-	PusheeTxn        Transaction  `protobuf:"bytes,2,opt,name=pushee_txn" json:"pushee_txn"`
-	XXX_unrecognized []byte       `json:"-"`
+	Txn       *Transaction `protobuf:"bytes,1,opt,name=txn" json:"txn,omitempty"`
+	PusheeTxn Transaction  `protobuf:"bytes,2,opt,name=pushee_txn" json:"pushee_txn"`
+	// The priority of the pushee transaction, which won the conflict. A
+	// subsequent push succeeds if the pusher's priority exceeds it.
+	PusheePriority   int32  `protobuf:"varint,3,opt,name=pushee_priority" json:"pushee_priority"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *TransactionPushError) Reset()         { *m = TransactionPushError{} }
@@ -170,6 +173,13 @@ func (m *TransactionPushError) GetPusheeTxn() Transaction {
 	return Transaction{}
 }
 
+func (m *TransactionPushError) GetPusheePriority() int32 {
+	if m != nil {
+		return m.PusheePriority
+	}
+	return 0
+}
+
 // A TransactionRetryError indicates that the transaction must be
 // retried, usually with an increased transaction timestamp. The
 // transaction struct to use is returned with the error.
@@ -310,6 +320,253 @@ func (m *ConditionFailedError) GetActualValue() *Value {
 	return nil
 }
 
+// A ConflictTimeoutError indicates that a read exceeded its conflict
+// resolution timeout while trying to push the transaction owning a
+// write intent at key. The read may be retried.
+type ConflictTimeoutError struct {
+	Key              Key         `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	Txn              Transaction `protobuf:"bytes,2,opt,name=txn" json:"txn"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *ConflictTimeoutError) Reset()         { *m = ConflictTimeoutError{} }
+func (m *ConflictTimeoutError) String() string { return proto1.CompactTextString(m) }
+func (*ConflictTimeoutError) ProtoMessage()    {}
+
+func (m *ConflictTimeoutError) GetTxn() Transaction {
+	if m != nil {
+		return m.Txn
+	}
+	return Transaction{}
+}
+
+// A RaftGroupDeletedError indicates that a raft command was applied
+// to a range which has been removed from this store.
+type RaftGroupDeletedError struct {
+	RaftID           int64  `protobuf:"varint,1,opt,name=raft_id" json:"raft_id"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *RaftGroupDeletedError) Reset()         { *m = RaftGroupDeletedError{} }
+func (m *RaftGroupDeletedError) String() string { return proto1.CompactTextString(m) }
+func (*RaftGroupDeletedError) ProtoMessage()    {}
+
+func (m *RaftGroupDeletedError) GetRaftID() int64 {
+	if m != nil {
+		return m.RaftID
+	}
+	return 0
+}
+
+// A StoreOverloadedError indicates that the store is overloaded and
+// shed the command rather than executing it. Lower priority commands
+// are shed first. The command may be retried.
+type StoreOverloadedError struct {
+	Method           string `protobuf:"bytes,1,opt,name=method" json:"method"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *StoreOverloadedError) Reset()         { *m = StoreOverloadedError{} }
+func (m *StoreOverloadedError) String() string { return proto1.CompactTextString(m) }
+func (*StoreOverloadedError) ProtoMessage()    {}
+
+func (m *StoreOverloadedError) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+// A DeadlineExceededError indicates that a storage engine operation
+// did not complete before the deadline of the command it served. The
+// deadline is in unix nanoseconds.
+type DeadlineExceededError struct {
+	Deadline         int64  `protobuf:"varint,1,opt,name=deadline" json:"deadline"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *DeadlineExceededError) Reset()         { *m = DeadlineExceededError{} }
+func (m *DeadlineExceededError) String() string { return proto1.CompactTextString(m) }
+func (*DeadlineExceededError) ProtoMessage()    {}
+
+func (m *DeadlineExceededError) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+// A ChecksumMismatchError indicates that a value read from the
+// storage engine does not match its stored checksum, which suggests
+// on-disk corruption of the value at key.
+type ChecksumMismatchError struct {
+	Key              Key    `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ChecksumMismatchError) Reset()         { *m = ChecksumMismatchError{} }
+func (m *ChecksumMismatchError) String() string { return proto1.CompactTextString(m) }
+func (*ChecksumMismatchError) ProtoMessage()    {}
+
+// A ProtectedKeyError indicates that a user command attempted to
+// write to a key in a reserved system span, such as range-local or
+// range metadata keys, which may be written only by the system.
+type ProtectedKeyError struct {
+	Key              Key    `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ProtectedKeyError) Reset()         { *m = ProtectedKeyError{} }
+func (m *ProtectedKeyError) String() string { return proto1.CompactTextString(m) }
+func (*ProtectedKeyError) ProtoMessage()    {}
+
+// A TooManyIntentsError indicates that a transactional write was
+// rejected because it would have pushed the count of open write
+// intents on the range past the configured limit.
+type TooManyIntentsError struct {
+	RaftID           int64  `protobuf:"varint,1,opt,name=raft_id" json:"raft_id"`
+	IntentCount      int64  `protobuf:"varint,2,opt,name=intent_count" json:"intent_count"`
+	MaxIntents       int64  `protobuf:"varint,3,opt,name=max_intents" json:"max_intents"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *TooManyIntentsError) Reset()         { *m = TooManyIntentsError{} }
+func (m *TooManyIntentsError) String() string { return proto1.CompactTextString(m) }
+func (*TooManyIntentsError) ProtoMessage()    {}
+
+func (m *TooManyIntentsError) GetRaftID() int64 {
+	if m != nil {
+		return m.RaftID
+	}
+	return 0
+}
+
+func (m *TooManyIntentsError) GetIntentCount() int64 {
+	if m != nil {
+		return m.IntentCount
+	}
+	return 0
+}
+
+func (m *TooManyIntentsError) GetMaxIntents() int64 {
+	if m != nil {
+		return m.MaxIntents
+	}
+	return 0
+}
+
+// A CommandTooLargeError indicates that a read-write command was
+// rejected before being proposed to Raft because its serialized size
+// exceeds the configured maximum.
+type CommandTooLargeError struct {
+	Size             int64  `protobuf:"varint,1,opt,name=size" json:"size"`
+	MaxSize          int64  `protobuf:"varint,2,opt,name=max_size" json:"max_size"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CommandTooLargeError) Reset()         { *m = CommandTooLargeError{} }
+func (m *CommandTooLargeError) String() string { return proto1.CompactTextString(m) }
+func (*CommandTooLargeError) ProtoMessage()    {}
+
+func (m *CommandTooLargeError) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CommandTooLargeError) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+// A ProposalBufferFullError indicates that a read-write command was
+// rejected because the range's proposals awaiting application already
+// hold the configured maximum number of bytes. The command may be
+// retried once the buffer drains.
+type ProposalBufferFullError struct {
+	RaftID           int64  `protobuf:"varint,1,opt,name=raft_id" json:"raft_id"`
+	PendingBytes     int64  `protobuf:"varint,2,opt,name=pending_bytes" json:"pending_bytes"`
+	MaxBytes         int64  `protobuf:"varint,3,opt,name=max_bytes" json:"max_bytes"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ProposalBufferFullError) Reset()         { *m = ProposalBufferFullError{} }
+func (m *ProposalBufferFullError) String() string { return proto1.CompactTextString(m) }
+func (*ProposalBufferFullError) ProtoMessage()    {}
+
+func (m *ProposalBufferFullError) GetRaftID() int64 {
+	if m != nil {
+		return m.RaftID
+	}
+	return 0
+}
+
+func (m *ProposalBufferFullError) GetPendingBytes() int64 {
+	if m != nil {
+		return m.PendingBytes
+	}
+	return 0
+}
+
+func (m *ProposalBufferFullError) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+// A TimestampTooFarError indicates that a command was rejected because
+// its timestamp is further ahead of the node's clock than the
+// configured maximum future offset allows.
+type TimestampTooFarError struct {
+	Timestamp        Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp"`
+	MaxTimestamp     Timestamp `protobuf:"bytes,2,opt,name=max_timestamp" json:"max_timestamp"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *TimestampTooFarError) Reset()         { *m = TimestampTooFarError{} }
+func (m *TimestampTooFarError) String() string { return proto1.CompactTextString(m) }
+func (*TimestampTooFarError) ProtoMessage()    {}
+
+func (m *TimestampTooFarError) GetTimestamp() Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return Timestamp{}
+}
+
+func (m *TimestampTooFarError) GetMaxTimestamp() Timestamp {
+	if m != nil {
+		return m.MaxTimestamp
+	}
+	return Timestamp{}
+}
+
+// A CommandQueueTimeoutError indicates that a command was abandoned
+// after waiting longer than the range's command queue timeout for
+// overlapping commands ahead of it to complete.
+type CommandQueueTimeoutError struct {
+	Key    Key `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	EndKey Key `protobuf:"bytes,2,opt,name=end_key,customtype=Key" json:"end_key"`
+	// The timeout in nanoseconds.
+	Timeout          int64  `protobuf:"varint,3,opt,name=timeout" json:"timeout"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CommandQueueTimeoutError) Reset()         { *m = CommandQueueTimeoutError{} }
+func (m *CommandQueueTimeoutError) String() string { return proto1.CompactTextString(m) }
+func (*CommandQueueTimeoutError) ProtoMessage()    {}
+
+func (m *CommandQueueTimeoutError) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 // Error is a union type containing all available errors.
 type Error struct {
 	Generic                       *GenericError                       `protobuf:"bytes,1,opt,name=generic" json:"generic,omitempty"`
@@ -325,6 +582,17 @@ type Error struct {
 	WriteTooOld                   *WriteTooOldError                   `protobuf:"bytes,11,opt,name=write_too_old" json:"write_too_old,omitempty"`
 	OpRequiresTxn                 *OpRequiresTxnError                 `protobuf:"bytes,12,opt,name=op_requires_txn" json:"op_requires_txn,omitempty"`
 	ConditionFailed               *ConditionFailedError               `protobuf:"bytes,13,opt,name=condition_failed" json:"condition_failed,omitempty"`
+	ConflictTimeout               *ConflictTimeoutError               `protobuf:"bytes,14,opt,name=conflict_timeout" json:"conflict_timeout,omitempty"`
+	RaftGroupDeleted              *RaftGroupDeletedError              `protobuf:"bytes,15,opt,name=raft_group_deleted" json:"raft_group_deleted,omitempty"`
+	StoreOverloaded               *StoreOverloadedError               `protobuf:"bytes,16,opt,name=store_overloaded" json:"store_overloaded,omitempty"`
+	DeadlineExceeded              *DeadlineExceededError              `protobuf:"bytes,17,opt,name=deadline_exceeded" json:"deadline_exceeded,omitempty"`
+	ChecksumMismatch              *ChecksumMismatchError              `protobuf:"bytes,18,opt,name=checksum_mismatch" json:"checksum_mismatch,omitempty"`
+	ProtectedKey                  *ProtectedKeyError                  `protobuf:"bytes,19,opt,name=protected_key" json:"protected_key,omitempty"`
+	TooManyIntents                *TooManyIntentsError                `protobuf:"bytes,20,opt,name=too_many_intents" json:"too_many_intents,omitempty"`
+	CommandTooLarge               *CommandTooLargeError               `protobuf:"bytes,21,opt,name=command_too_large" json:"command_too_large,omitempty"`
+	ProposalBufferFull            *ProposalBufferFullError            `protobuf:"bytes,22,opt,name=proposal_buffer_full" json:"proposal_buffer_full,omitempty"`
+	TimestampTooFar               *TimestampTooFarError               `protobuf:"bytes,23,opt,name=timestamp_too_far" json:"timestamp_too_far,omitempty"`
+	CommandQueueTimeout           *CommandQueueTimeoutError           `protobuf:"bytes,24,opt,name=command_queue_timeout" json:"command_queue_timeout,omitempty"`
 	XXX_unrecognized              []byte                              `json:"-"`
 }
 
@@ -423,6 +691,83 @@ func (m *Error) GetConditionFailed() *ConditionFailedError {
 	return nil
 }
 
+func (m *Error) GetConflictTimeout() *ConflictTimeoutError {
+	if m != nil {
+		return m.ConflictTimeout
+	}
+	return nil
+}
+
+func (m *Error) GetRaftGroupDeleted() *RaftGroupDeletedError {
+	if m != nil {
+		return m.RaftGroupDeleted
+	}
+	return nil
+}
+
+func (m *Error) GetStoreOverloaded() *StoreOverloadedError {
+	if m != nil {
+		return m.StoreOverloaded
+	}
+	return nil
+}
+
+func (m *Error) GetDeadlineExceeded() *DeadlineExceededError {
+	if m != nil {
+		return m.DeadlineExceeded
+	}
+	return nil
+}
+
+func (m *Error) GetChecksumMismatch() *ChecksumMismatchError {
+	if m != nil {
+		return m.ChecksumMismatch
+	}
+	return nil
+}
+
+func (m *Error) GetProtectedKey() *ProtectedKeyError {
+	if m != nil {
+		return m.ProtectedKey
+	}
+	return nil
+}
+
+func (m *Error) GetTooManyIntents() *TooManyIntentsError {
+	if m != nil {
+		return m.TooManyIntents
+	}
+	return nil
+}
+
+func (m *Error) GetCommandTooLarge() *CommandTooLargeError {
+	if m != nil {
+		return m.CommandTooLarge
+	}
+	return nil
+}
+
+func (m *Error) GetProposalBufferFull() *ProposalBufferFullError {
+	if m != nil {
+		return m.ProposalBufferFull
+	}
+	return nil
+}
+
+func (m *Error) GetTimestampTooFar() *TimestampTooFarError {
+	if m != nil {
+		return m.TimestampTooFar
+	}
+	return nil
+}
+
+func (m *Error) GetCommandQueueTimeout() *CommandQueueTimeoutError {
+	if m != nil {
+		return m.CommandQueueTimeout
+	}
+	return nil
+}
+
 func init() {
 }
 func (this *Error) GetValue() interface{} {
@@ -465,6 +810,39 @@ func (this *Error) GetValue() interface{} {
 	if this.ConditionFailed != nil {
 		return this.ConditionFailed
 	}
+	if this.ConflictTimeout != nil {
+		return this.ConflictTimeout
+	}
+	if this.RaftGroupDeleted != nil {
+		return this.RaftGroupDeleted
+	}
+	if this.StoreOverloaded != nil {
+		return this.StoreOverloaded
+	}
+	if this.DeadlineExceeded != nil {
+		return this.DeadlineExceeded
+	}
+	if this.ChecksumMismatch != nil {
+		return this.ChecksumMismatch
+	}
+	if this.ProtectedKey != nil {
+		return this.ProtectedKey
+	}
+	if this.TooManyIntents != nil {
+		return this.TooManyIntents
+	}
+	if this.CommandTooLarge != nil {
+		return this.CommandTooLarge
+	}
+	if this.ProposalBufferFull != nil {
+		return this.ProposalBufferFull
+	}
+	if this.TimestampTooFar != nil {
+		return this.TimestampTooFar
+	}
+	if this.CommandQueueTimeout != nil {
+		return this.CommandQueueTimeout
+	}
 	return nil
 }
 
@@ -496,6 +874,28 @@ func (this *Error) SetValue(value interface{}) bool {
 		this.OpRequiresTxn = vt
 	case *ConditionFailedError:
 		this.ConditionFailed = vt
+	case *ConflictTimeoutError:
+		this.ConflictTimeout = vt
+	case *RaftGroupDeletedError:
+		this.RaftGroupDeleted = vt
+	case *StoreOverloadedError:
+		this.StoreOverloaded = vt
+	case *DeadlineExceededError:
+		this.DeadlineExceeded = vt
+	case *ChecksumMismatchError:
+		this.ChecksumMismatch = vt
+	case *ProtectedKeyError:
+		this.ProtectedKey = vt
+	case *TooManyIntentsError:
+		this.TooManyIntents = vt
+	case *CommandTooLargeError:
+		this.CommandTooLarge = vt
+	case *ProposalBufferFullError:
+		this.ProposalBufferFull = vt
+	case *TimestampTooFarError:
+		this.TimestampTooFar = vt
+	case *CommandQueueTimeoutError:
+		this.CommandQueueTimeout = vt
 	default:
 		return false
 	}
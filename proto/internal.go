@@ -58,6 +58,43 @@ const (
 	InternalMerge = "InternalMerge"
 	// InternalTruncateLog discards a prefix of the raft log.
 	InternalTruncateLog = "InternalTruncateLog"
+	// InternalBeginTransaction explicitly creates the PENDING record for
+	// the transaction specified in the request header, including its
+	// isolation, priority and deadline. Fails if a record already
+	// exists.
+	InternalBeginTransaction = "InternalBeginTransaction"
+	// InternalScanIntents is a diagnostic command which scans a key
+	// span and returns the write intents found, grouped by owning
+	// transaction.
+	InternalScanIntents = "InternalScanIntents"
+	// InternalInspectTimestampCache returns the timestamp cache state
+	// for a key or span, for debugging.
+	InternalInspectTimestampCache = "InternalInspectTimestampCache"
+	// InternalGetTransaction returns the complete stored record of the
+	// transaction with the specified anchor key and ID.
+	InternalGetTransaction = "InternalGetTransaction"
+	// InternalPutIfAbsent writes a value to a key only if the key is
+	// absent and returns the key's resulting value.
+	InternalPutIfAbsent = "InternalPutIfAbsent"
+	// InternalRangeKeyBounds returns the smallest and largest keys
+	// currently stored in a range.
+	InternalRangeKeyBounds = "InternalRangeKeyBounds"
+	// InternalVerifyRange reports keys stored outside a range's
+	// descriptor bounds which no range holds.
+	InternalVerifyRange = "InternalVerifyRange"
+	// InternalResolveIntentRange resolves existing write intents of a
+	// transaction throughout a key range.
+	InternalResolveIntentRange = "InternalResolveIntentRange"
+	// InternalLeaderLease requests a time-bounded lease granting the
+	// proposing replica the right to serve reads locally.
+	InternalLeaderLease = "InternalLeaderLease"
+	// InternalComputeChecksum computes a checksum of a range's data
+	// for comparison across replicas.
+	InternalComputeChecksum = "InternalComputeChecksum"
+	// InternalGetHistory returns the committed versions of a key.
+	InternalGetHistory = "InternalGetHistory"
+	// InternalBatchGet looks up multiple keys in a single command.
+	InternalBatchGet = "InternalBatchGet"
 )
 
 // ToValue generates a Value message which contains an encoded copy of this
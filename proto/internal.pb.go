@@ -127,10 +127,15 @@ func (*InternalHeartbeatTxnResponse) ProtoMessage()    {}
 // sent by range leaders after scanning range data to find expired
 // MVCC values.
 type InternalGCRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	GCMeta           GCMetadata                `protobuf:"bytes,2,opt,name=gc_meta" json:"gc_meta"`
-	Keys             []InternalGCRequest_GCKey `protobuf:"bytes,3,rep,name=keys" json:"keys"`
-	XXX_unrecognized []byte                    `json:"-"`
+	RequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	GCMeta        GCMetadata                `protobuf:"bytes,2,opt,name=gc_meta" json:"gc_meta"`
+	Keys          []InternalGCRequest_GCKey `protobuf:"bytes,3,rep,name=keys" json:"keys"`
+	// TxnKeys lists the keys of transaction records to delete. Each
+	// record must be committed or aborted, with a timestamp no later than
+	// TxnThreshold.
+	TxnKeys          []Key     `protobuf:"bytes,4,rep,name=txn_keys,customtype=Key" json:"txn_keys"`
+	TxnThreshold     Timestamp `protobuf:"bytes,5,opt,name=txn_threshold" json:"txn_threshold"`
+	XXX_unrecognized []byte    `json:"-"`
 }
 
 func (m *InternalGCRequest) Reset()         { *m = InternalGCRequest{} }
@@ -151,6 +156,13 @@ func (m *InternalGCRequest) GetKeys() []InternalGCRequest_GCKey {
 	return nil
 }
 
+func (m *InternalGCRequest) GetTxnThreshold() Timestamp {
+	if m != nil {
+		return m.TxnThreshold
+	}
+	return Timestamp{}
+}
+
 type InternalGCRequest_GCKey struct {
 	Key              Key       `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
 	Timestamp        Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
@@ -333,26 +345,619 @@ func (m *InternalTruncateLogResponse) Reset()         { *m = InternalTruncateLog
 func (m *InternalTruncateLogResponse) String() string { return proto1.CompactTextString(m) }
 func (*InternalTruncateLogResponse) ProtoMessage()    {}
 
+// An InternalBeginTransactionRequest is arguments to the
+// InternalBeginTransaction() method. It explicitly creates the
+// transaction record for the transaction specified in the header.
+type InternalBeginTransactionRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalBeginTransactionRequest) Reset()         { *m = InternalBeginTransactionRequest{} }
+func (m *InternalBeginTransactionRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalBeginTransactionRequest) ProtoMessage()    {}
+
+// An InternalBeginTransactionResponse is the return value from the
+// InternalBeginTransaction() method. It returns the newly created
+// transaction record in the response header.
+type InternalBeginTransactionResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalBeginTransactionResponse) Reset()         { *m = InternalBeginTransactionResponse{} }
+func (m *InternalBeginTransactionResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalBeginTransactionResponse) ProtoMessage()    {}
+
+// InternalTxnIntents lists the keys of the write intents owned by a
+// single transaction.
+type InternalTxnIntents struct {
+	Txn              Transaction `protobuf:"bytes,1,opt,name=txn" json:"txn"`
+	Keys             []Key       `protobuf:"bytes,2,rep,name=keys,customtype=Key" json:"keys"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *InternalTxnIntents) Reset()         { *m = InternalTxnIntents{} }
+func (m *InternalTxnIntents) String() string { return proto1.CompactTextString(m) }
+func (*InternalTxnIntents) ProtoMessage()    {}
+
+func (m *InternalTxnIntents) GetTxn() Transaction {
+	if m != nil {
+		return m.Txn
+	}
+	return Transaction{}
+}
+
+// An InternalScanIntentsRequest is arguments to the InternalScanIntents()
+// method. It specifies the key span [Key, EndKey) to scan for write
+// intents.
+type InternalScanIntentsRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalScanIntentsRequest) Reset()         { *m = InternalScanIntentsRequest{} }
+func (m *InternalScanIntentsRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalScanIntentsRequest) ProtoMessage()    {}
+
+// An InternalScanIntentsResponse is the return value from the
+// InternalScanIntents() method. It returns the intents found within
+// the requested span, grouped by the transaction which owns them.
+type InternalScanIntentsResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	TxnIntents       []InternalTxnIntents `protobuf:"bytes,2,rep,name=txn_intents" json:"txn_intents"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *InternalScanIntentsResponse) Reset()         { *m = InternalScanIntentsResponse{} }
+func (m *InternalScanIntentsResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalScanIntentsResponse) ProtoMessage()    {}
+
+func (m *InternalScanIntentsResponse) GetTxnIntents() []InternalTxnIntents {
+	if m != nil {
+		return m.TxnIntents
+	}
+	return nil
+}
+
+// An InternalInspectTimestampCacheRequest is arguments to the
+// InternalInspectTimestampCache() method. It specifies the key or
+// key span [Key, EndKey) whose timestamp cache entries to inspect.
+type InternalInspectTimestampCacheRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalInspectTimestampCacheRequest) Reset()         { *m = InternalInspectTimestampCacheRequest{} }
+func (m *InternalInspectTimestampCacheRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalInspectTimestampCacheRequest) ProtoMessage()    {}
+
+// An InternalInspectTimestampCacheResponse is the return value from
+// the InternalInspectTimestampCache() method. It returns the maximum
+// read and write timestamps cached for the specified key or span, and
+// whether each was supplied by the cache's low water mark rather than
+// by a cache entry.
+type InternalInspectTimestampCacheResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	ReadTimestamp    Timestamp `protobuf:"bytes,2,opt,name=read_timestamp" json:"read_timestamp"`
+	WriteTimestamp   Timestamp `protobuf:"bytes,3,opt,name=write_timestamp" json:"write_timestamp"`
+	ReadLowWater     bool      `protobuf:"varint,4,opt,name=read_low_water" json:"read_low_water"`
+	WriteLowWater    bool      `protobuf:"varint,5,opt,name=write_low_water" json:"write_low_water"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *InternalInspectTimestampCacheResponse) Reset()         { *m = InternalInspectTimestampCacheResponse{} }
+func (m *InternalInspectTimestampCacheResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalInspectTimestampCacheResponse) ProtoMessage()    {}
+
+func (m *InternalInspectTimestampCacheResponse) GetReadTimestamp() Timestamp {
+	if m != nil {
+		return m.ReadTimestamp
+	}
+	return Timestamp{}
+}
+
+func (m *InternalInspectTimestampCacheResponse) GetWriteTimestamp() Timestamp {
+	if m != nil {
+		return m.WriteTimestamp
+	}
+	return Timestamp{}
+}
+
+func (m *InternalInspectTimestampCacheResponse) GetReadLowWater() bool {
+	if m != nil {
+		return m.ReadLowWater
+	}
+	return false
+}
+
+func (m *InternalInspectTimestampCacheResponse) GetWriteLowWater() bool {
+	if m != nil {
+		return m.WriteLowWater
+	}
+	return false
+}
+
+// An InternalGetTransactionRequest is arguments to the
+// InternalGetTransaction() method. It specifies the anchor key of the
+// transaction in the request header's Key and the transaction's ID.
+type InternalGetTransactionRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	TxnID            []byte `protobuf:"bytes,2,opt,name=txn_id" json:"txn_id"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalGetTransactionRequest) Reset()         { *m = InternalGetTransactionRequest{} }
+func (m *InternalGetTransactionRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalGetTransactionRequest) ProtoMessage()    {}
+
+func (m *InternalGetTransactionRequest) GetTxnID() []byte {
+	if m != nil {
+		return m.TxnID
+	}
+	return nil
+}
+
+// An InternalGetTransactionResponse is the return value from the
+// InternalGetTransaction() method. It returns the stored transaction
+// record, or a nil Txn if no record exists.
+type InternalGetTransactionResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Txn              *Transaction `protobuf:"bytes,2,opt,name=txn" json:"txn,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
+
+func (m *InternalGetTransactionResponse) Reset()         { *m = InternalGetTransactionResponse{} }
+func (m *InternalGetTransactionResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalGetTransactionResponse) ProtoMessage()    {}
+
+func (m *InternalGetTransactionResponse) GetTxn() *Transaction {
+	if m != nil {
+		return m.Txn
+	}
+	return nil
+}
+
+// An InternalPutIfAbsentRequest is arguments to the
+// InternalPutIfAbsent() method. The value is written to the key
+// specified in the request header only if the key has no value.
+type InternalPutIfAbsentRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Value            Value  `protobuf:"bytes,2,opt,name=value" json:"value"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalPutIfAbsentRequest) Reset()         { *m = InternalPutIfAbsentRequest{} }
+func (m *InternalPutIfAbsentRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalPutIfAbsentRequest) ProtoMessage()    {}
+
+func (m *InternalPutIfAbsentRequest) GetValue() Value {
+	if m != nil {
+		return m.Value
+	}
+	return Value{}
+}
+
+// An InternalPutIfAbsentResponse is the return value from the
+// InternalPutIfAbsent() method. Inserted is true if the value was
+// written. Value is the key's value following the command: the
+// inserted value if Inserted is true and the existing value otherwise.
+type InternalPutIfAbsentResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Inserted         bool   `protobuf:"varint,2,opt,name=inserted" json:"inserted"`
+	Value            *Value `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalPutIfAbsentResponse) Reset()         { *m = InternalPutIfAbsentResponse{} }
+func (m *InternalPutIfAbsentResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalPutIfAbsentResponse) ProtoMessage()    {}
+
+func (m *InternalPutIfAbsentResponse) GetInserted() bool {
+	if m != nil {
+		return m.Inserted
+	}
+	return false
+}
+
+func (m *InternalPutIfAbsentResponse) GetValue() *Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// A ScanResumeToken is the decoded form of the opaque resume token
+// returned by a Scan which reached its max results. It records the key
+// at which to resume and the bounds of the range which issued it, so
+// that a scan resumed after the range has split or merged is rejected
+// rather than silently missing keys.
+type ScanResumeToken struct {
+	Key              Key    `protobuf:"bytes,1,opt,name=key,customtype=Key" json:"key"`
+	RaftID           int64  `protobuf:"varint,2,opt,name=raft_id" json:"raft_id"`
+	StartKey         Key    `protobuf:"bytes,3,opt,name=start_key,customtype=Key" json:"start_key"`
+	EndKey           Key    `protobuf:"bytes,4,opt,name=end_key,customtype=Key" json:"end_key"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ScanResumeToken) Reset()         { *m = ScanResumeToken{} }
+func (m *ScanResumeToken) String() string { return proto1.CompactTextString(m) }
+func (*ScanResumeToken) ProtoMessage()    {}
+
+func (m *ScanResumeToken) GetRaftID() int64 {
+	if m != nil {
+		return m.RaftID
+	}
+	return 0
+}
+
+// An AuditEntry records a single mutation in a range's audit log,
+// written when auditing is enabled in the zone config. Values are
+// recorded only by their MD5 hashes; a hash is empty if the key had
+// no value.
+type AuditEntry struct {
+	User             string    `protobuf:"bytes,1,opt,name=user" json:"user"`
+	Method           string    `protobuf:"bytes,2,opt,name=method" json:"method"`
+	Key              Key       `protobuf:"bytes,3,opt,name=key,customtype=Key" json:"key"`
+	EndKey           Key       `protobuf:"bytes,4,opt,name=end_key,customtype=Key" json:"end_key"`
+	Timestamp        Timestamp `protobuf:"bytes,5,opt,name=timestamp" json:"timestamp"`
+	OldValueHash     []byte    `protobuf:"bytes,6,opt,name=old_value_hash" json:"old_value_hash,omitempty"`
+	NewValueHash     []byte    `protobuf:"bytes,7,opt,name=new_value_hash" json:"new_value_hash,omitempty"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto1.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+
+func (m *AuditEntry) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetTimestamp() Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return Timestamp{}
+}
+
+func (m *AuditEntry) GetOldValueHash() []byte {
+	if m != nil {
+		return m.OldValueHash
+	}
+	return nil
+}
+
+func (m *AuditEntry) GetNewValueHash() []byte {
+	if m != nil {
+		return m.NewValueHash
+	}
+	return nil
+}
+
+// An InternalRangeKeyBoundsRequest is arguments to the
+// InternalRangeKeyBounds() method. It requests the smallest and largest
+// keys currently stored in the range addressed by Key.
+type InternalRangeKeyBoundsRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalRangeKeyBoundsRequest) Reset()         { *m = InternalRangeKeyBoundsRequest{} }
+func (m *InternalRangeKeyBoundsRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalRangeKeyBoundsRequest) ProtoMessage()    {}
+
+// An InternalRangeKeyBoundsResponse is the return value from the
+// InternalRangeKeyBounds() method. MinKey and MaxKey are the smallest
+// and largest keys with data in the range, which may be narrower than
+// the range's bounds. Both are empty if the range holds no data.
+type InternalRangeKeyBoundsResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	MinKey           Key    `protobuf:"bytes,2,opt,name=min_key,customtype=Key" json:"min_key"`
+	MaxKey           Key    `protobuf:"bytes,3,opt,name=max_key,customtype=Key" json:"max_key"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalRangeKeyBoundsResponse) Reset()         { *m = InternalRangeKeyBoundsResponse{} }
+func (m *InternalRangeKeyBoundsResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalRangeKeyBoundsResponse) ProtoMessage()    {}
+
+// An InternalVerifyRangeRequest is arguments to the InternalVerifyRange()
+// method. It requests the keys stored outside the bounds of the range
+// addressed by Key which no range on the store holds. If MaxKeys is
+// non-zero, at most that many keys are reported.
+type InternalVerifyRangeRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	MaxKeys          int64  `protobuf:"varint,2,opt,name=max_keys" json:"max_keys"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalVerifyRangeRequest) Reset()         { *m = InternalVerifyRangeRequest{} }
+func (m *InternalVerifyRangeRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalVerifyRangeRequest) ProtoMessage()    {}
+
+func (m *InternalVerifyRangeRequest) GetMaxKeys() int64 {
+	if m != nil {
+		return m.MaxKeys
+	}
+	return 0
+}
+
+// An InternalVerifyRangeResponse is the return value from the
+// InternalVerifyRange() method. OutOfBoundsKeys lists the keys found
+// outside the range's descriptor bounds, in order.
+type InternalVerifyRangeResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	OutOfBoundsKeys  []Key  `protobuf:"bytes,2,rep,name=out_of_bounds_keys,customtype=Key" json:"out_of_bounds_keys"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalVerifyRangeResponse) Reset()         { *m = InternalVerifyRangeResponse{} }
+func (m *InternalVerifyRangeResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalVerifyRangeResponse) ProtoMessage()    {}
+
+// An InternalResolveIntentRangeRequest is arguments to the
+// InternalResolveIntentRange() method. It resolves, in a single
+// command, every write intent belonging to the header's transaction in
+// the span from Key to EndKey.
+type InternalResolveIntentRangeRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalResolveIntentRangeRequest) Reset()         { *m = InternalResolveIntentRangeRequest{} }
+func (m *InternalResolveIntentRangeRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalResolveIntentRangeRequest) ProtoMessage()    {}
+
+// An InternalResolveIntentRangeResponse is the return value from the
+// InternalResolveIntentRange() method.
+type InternalResolveIntentRangeResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalResolveIntentRangeResponse) Reset()         { *m = InternalResolveIntentRangeResponse{} }
+func (m *InternalResolveIntentRangeResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalResolveIntentRangeResponse) ProtoMessage()    {}
+
+// An InternalLeaderLeaseRequest is arguments to the
+// InternalLeaderLease() method. It is proposed through Raft by a
+// replica to acquire or renew the range's leader lease.
+type InternalLeaderLeaseRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Lease            Lease  `protobuf:"bytes,2,opt,name=lease" json:"lease"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalLeaderLeaseRequest) Reset()         { *m = InternalLeaderLeaseRequest{} }
+func (m *InternalLeaderLeaseRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalLeaderLeaseRequest) ProtoMessage()    {}
+
+func (m *InternalLeaderLeaseRequest) GetLease() Lease {
+	if m != nil {
+		return m.Lease
+	}
+	return Lease{}
+}
+
+// An InternalLeaderLeaseResponse is the return value from the
+// InternalLeaderLease() method.
+type InternalLeaderLeaseResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalLeaderLeaseResponse) Reset()         { *m = InternalLeaderLeaseResponse{} }
+func (m *InternalLeaderLeaseResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalLeaderLeaseResponse) ProtoMessage()    {}
+
+// An InternalComputeChecksumRequest is arguments to the
+// InternalComputeChecksum() method. It requests a checksum of the data
+// of the range addressed by Key as of the header's timestamp.
+type InternalComputeChecksumRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalComputeChecksumRequest) Reset()         { *m = InternalComputeChecksumRequest{} }
+func (m *InternalComputeChecksumRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalComputeChecksumRequest) ProtoMessage()    {}
+
+// An InternalComputeChecksumResponse is the return value from the
+// InternalComputeChecksum() method. Checksum is a SHA-256 digest of the
+// range's key/value pairs and MVCC stats; replicas holding the same
+// data at the same applied state return the same checksum.
+type InternalComputeChecksumResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Checksum         []byte `protobuf:"bytes,2,opt,name=checksum" json:"checksum,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalComputeChecksumResponse) Reset()         { *m = InternalComputeChecksumResponse{} }
+func (m *InternalComputeChecksumResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalComputeChecksumResponse) ProtoMessage()    {}
+
+func (m *InternalComputeChecksumResponse) GetChecksum() []byte {
+	if m != nil {
+		return m.Checksum
+	}
+	return nil
+}
+
+// An MVCCVersion is a single committed version of a key, as returned
+// by InternalGetHistory.
+type MVCCVersion struct {
+	Timestamp Timestamp `protobuf:"bytes,1,opt,name=timestamp" json:"timestamp"`
+	// True to indicate a deletion tombstone.
+	Deleted bool `protobuf:"varint,2,opt,name=deleted" json:"deleted"`
+	// The value. Nil if deleted is true.
+	Value            *Value `protobuf:"bytes,3,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *MVCCVersion) Reset()         { *m = MVCCVersion{} }
+func (m *MVCCVersion) String() string { return proto1.CompactTextString(m) }
+func (*MVCCVersion) ProtoMessage()    {}
+
+func (m *MVCCVersion) GetTimestamp() Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return Timestamp{}
+}
+
+func (m *MVCCVersion) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *MVCCVersion) GetValue() *Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// A BatchGetValue is the result of looking up a single key with
+// InternalBatchGet.
+type BatchGetValue struct {
+	// The value. Nil if the key is absent or deleted.
+	Value            *Value `protobuf:"bytes,1,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *BatchGetValue) Reset()         { *m = BatchGetValue{} }
+func (m *BatchGetValue) String() string { return proto1.CompactTextString(m) }
+func (*BatchGetValue) ProtoMessage()    {}
+
+func (m *BatchGetValue) GetValue() *Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// An InternalGetHistoryRequest is arguments to the InternalGetHistory()
+// method. It requests the committed versions of Key, newest first. If
+// Before is non-zero, only versions older than it are returned; if
+// MaxVersions is non-zero, at most that many versions are returned.
+type InternalGetHistoryRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Before           Timestamp `protobuf:"bytes,2,opt,name=before" json:"before"`
+	MaxVersions      int64     `protobuf:"varint,3,opt,name=max_versions" json:"max_versions"`
+	XXX_unrecognized []byte    `json:"-"`
+}
+
+func (m *InternalGetHistoryRequest) Reset()         { *m = InternalGetHistoryRequest{} }
+func (m *InternalGetHistoryRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalGetHistoryRequest) ProtoMessage()    {}
+
+func (m *InternalGetHistoryRequest) GetBefore() Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return Timestamp{}
+}
+
+func (m *InternalGetHistoryRequest) GetMaxVersions() int64 {
+	if m != nil {
+		return m.MaxVersions
+	}
+	return 0
+}
+
+// An InternalGetHistoryResponse is the return value from the
+// InternalGetHistory() method. To page through a long history, set
+// Before in the next request to the timestamp of the last version.
+type InternalGetHistoryResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Versions         []MVCCVersion `protobuf:"bytes,2,rep,name=versions" json:"versions"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *InternalGetHistoryResponse) Reset()         { *m = InternalGetHistoryResponse{} }
+func (m *InternalGetHistoryResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalGetHistoryResponse) ProtoMessage()    {}
+
+func (m *InternalGetHistoryResponse) GetVersions() []MVCCVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+// An InternalBatchGetRequest is arguments to the InternalBatchGet()
+// method. It looks up each of the listed keys, which must lie within
+// the span of the header's Key and EndKey so that all are covered by
+// a single command queue entry.
+type InternalBatchGetRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Keys             []Key  `protobuf:"bytes,2,rep,name=keys,customtype=Key" json:"keys"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *InternalBatchGetRequest) Reset()         { *m = InternalBatchGetRequest{} }
+func (m *InternalBatchGetRequest) String() string { return proto1.CompactTextString(m) }
+func (*InternalBatchGetRequest) ProtoMessage()    {}
+
+// An InternalBatchGetResponse is the return value from the
+// InternalBatchGet() method. Values are parallel to the request's keys.
+type InternalBatchGetResponse struct {
+	ResponseHeader   `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Values           []BatchGetValue `protobuf:"bytes,2,rep,name=values" json:"values"`
+	XXX_unrecognized []byte          `json:"-"`
+}
+
+func (m *InternalBatchGetResponse) Reset()         { *m = InternalBatchGetResponse{} }
+func (m *InternalBatchGetResponse) String() string { return proto1.CompactTextString(m) }
+func (*InternalBatchGetResponse) ProtoMessage()    {}
+
+func (m *InternalBatchGetResponse) GetValues() []BatchGetValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 // A ReadWriteCmdResponse is a union type containing instances of all
 // mutating commands. Note that any entry added here must be handled
 // in storage/engine/db.cc in GetResponseHeader().
 type ReadWriteCmdResponse struct {
-	Put                   *PutResponse                   `protobuf:"bytes,1,opt,name=put" json:"put,omitempty"`
-	ConditionalPut        *ConditionalPutResponse        `protobuf:"bytes,2,opt,name=conditional_put" json:"conditional_put,omitempty"`
-	Increment             *IncrementResponse             `protobuf:"bytes,3,opt,name=increment" json:"increment,omitempty"`
-	Delete                *DeleteResponse                `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
-	DeleteRange           *DeleteRangeResponse           `protobuf:"bytes,5,opt,name=delete_range" json:"delete_range,omitempty"`
-	EndTransaction        *EndTransactionResponse        `protobuf:"bytes,6,opt,name=end_transaction" json:"end_transaction,omitempty"`
-	ReapQueue             *ReapQueueResponse             `protobuf:"bytes,7,opt,name=reap_queue" json:"reap_queue,omitempty"`
-	EnqueueUpdate         *EnqueueUpdateResponse         `protobuf:"bytes,8,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
-	EnqueueMessage        *EnqueueMessageResponse        `protobuf:"bytes,9,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
-	InternalHeartbeatTxn  *InternalHeartbeatTxnResponse  `protobuf:"bytes,10,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
-	InternalPushTxn       *InternalPushTxnResponse       `protobuf:"bytes,11,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
-	InternalResolveIntent *InternalResolveIntentResponse `protobuf:"bytes,12,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
-	InternalMerge         *InternalMergeResponse         `protobuf:"bytes,13,opt,name=internal_merge" json:"internal_merge,omitempty"`
-	InternalTruncateLog   *InternalTruncateLogResponse   `protobuf:"bytes,14,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
-	InternalGc            *InternalGCResponse            `protobuf:"bytes,15,opt,name=internal_gc" json:"internal_gc,omitempty"`
-	XXX_unrecognized      []byte                         `json:"-"`
+	Put                        *PutResponse                        `protobuf:"bytes,1,opt,name=put" json:"put,omitempty"`
+	ConditionalPut             *ConditionalPutResponse             `protobuf:"bytes,2,opt,name=conditional_put" json:"conditional_put,omitempty"`
+	Increment                  *IncrementResponse                  `protobuf:"bytes,3,opt,name=increment" json:"increment,omitempty"`
+	Delete                     *DeleteResponse                     `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
+	DeleteRange                *DeleteRangeResponse                `protobuf:"bytes,5,opt,name=delete_range" json:"delete_range,omitempty"`
+	EndTransaction             *EndTransactionResponse             `protobuf:"bytes,6,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReapQueue                  *ReapQueueResponse                  `protobuf:"bytes,7,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate              *EnqueueUpdateResponse              `protobuf:"bytes,8,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage             *EnqueueMessageResponse             `protobuf:"bytes,9,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	InternalHeartbeatTxn       *InternalHeartbeatTxnResponse       `protobuf:"bytes,10,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
+	InternalPushTxn            *InternalPushTxnResponse            `protobuf:"bytes,11,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
+	InternalResolveIntent      *InternalResolveIntentResponse      `protobuf:"bytes,12,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
+	InternalMerge              *InternalMergeResponse              `protobuf:"bytes,13,opt,name=internal_merge" json:"internal_merge,omitempty"`
+	InternalTruncateLog        *InternalTruncateLogResponse        `protobuf:"bytes,14,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
+	InternalGc                 *InternalGCResponse                 `protobuf:"bytes,15,opt,name=internal_gc" json:"internal_gc,omitempty"`
+	InternalBeginTransaction   *InternalBeginTransactionResponse   `protobuf:"bytes,16,opt,name=internal_begin_transaction" json:"internal_begin_transaction,omitempty"`
+	InternalPutIfAbsent        *InternalPutIfAbsentResponse        `protobuf:"bytes,17,opt,name=internal_put_if_absent" json:"internal_put_if_absent,omitempty"`
+	Get                        *GetResponse                        `protobuf:"bytes,18,opt,name=get" json:"get,omitempty"`
+	ConditionalDelete          *ConditionalDeleteResponse          `protobuf:"bytes,19,opt,name=conditional_delete" json:"conditional_delete,omitempty"`
+	Batch                      *BatchResponse                      `protobuf:"bytes,20,opt,name=batch" json:"batch,omitempty"`
+	InternalResolveIntentRange *InternalResolveIntentRangeResponse `protobuf:"bytes,21,opt,name=internal_resolve_intent_range" json:"internal_resolve_intent_range,omitempty"`
+	InternalLeaderLease        *InternalLeaderLeaseResponse        `protobuf:"bytes,22,opt,name=internal_leader_lease" json:"internal_leader_lease,omitempty"`
+	XXX_unrecognized           []byte                              `json:"-"`
 }
 
 func (m *ReadWriteCmdResponse) Reset()         { *m = ReadWriteCmdResponse{} }
@@ -464,33 +1069,205 @@ func (m *ReadWriteCmdResponse) GetInternalGc() *InternalGCResponse {
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetInternalBeginTransaction() *InternalBeginTransactionResponse {
+	if m != nil {
+		return m.InternalBeginTransaction
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetInternalPutIfAbsent() *InternalPutIfAbsentResponse {
+	if m != nil {
+		return m.InternalPutIfAbsent
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetGet() *GetResponse {
+	if m != nil {
+		return m.Get
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetConditionalDelete() *ConditionalDeleteResponse {
+	if m != nil {
+		return m.ConditionalDelete
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetBatch() *BatchResponse {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetInternalResolveIntentRange() *InternalResolveIntentRangeResponse {
+	if m != nil {
+		return m.InternalResolveIntentRange
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetInternalLeaderLease() *InternalLeaderLeaseResponse {
+	if m != nil {
+		return m.InternalLeaderLease
+	}
+	return nil
+}
+
+// A ResponseCacheEntry is a single response cache entry, pairing a
+// client command ID with the response recorded for it.
+type ResponseCacheEntry struct {
+	CmdID            ClientCmdID          `protobuf:"bytes,1,opt,name=cmd_id" json:"cmd_id"`
+	Response         ReadWriteCmdResponse `protobuf:"bytes,2,opt,name=response" json:"response"`
+	XXX_unrecognized []byte               `json:"-"`
+}
+
+func (m *ResponseCacheEntry) Reset()         { *m = ResponseCacheEntry{} }
+func (m *ResponseCacheEntry) String() string { return proto1.CompactTextString(m) }
+func (*ResponseCacheEntry) ProtoMessage()    {}
+
+func (m *ResponseCacheEntry) GetCmdID() ClientCmdID {
+	if m != nil {
+		return m.CmdID
+	}
+	return ClientCmdID{}
+}
+
+func (m *ResponseCacheEntry) GetResponse() ReadWriteCmdResponse {
+	if m != nil {
+		return m.Response
+	}
+	return ReadWriteCmdResponse{}
+}
+
+// A Lease grants the replica holding it the exclusive right to serve
+// reads for a range locally from its start until its expiration.
+type Lease struct {
+	Start      Timestamp `protobuf:"bytes,1,opt,name=start" json:"start"`
+	Expiration Timestamp `protobuf:"bytes,2,opt,name=expiration" json:"expiration"`
+	// The replica holding the lease.
+	Replica Replica `protobuf:"bytes,3,opt,name=replica" json:"replica"`
+	// The epoch of the lease, advanced each time the lease is granted to
+	// a different replica. See InternalRaftCommand.LeaseEpoch.
+	Epoch            int64  `protobuf:"varint,4,opt,name=epoch" json:"epoch"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Lease) Reset()         { *m = Lease{} }
+func (m *Lease) String() string { return proto1.CompactTextString(m) }
+func (*Lease) ProtoMessage()    {}
+
+func (m *Lease) GetStart() Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return Timestamp{}
+}
+
+func (m *Lease) GetExpiration() Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return Timestamp{}
+}
+
+func (m *Lease) GetReplica() Replica {
+	if m != nil {
+		return m.Replica
+	}
+	return Replica{}
+}
+
+func (m *Lease) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// A LeaseTransfer carries state handed from the outgoing holder of a
+// range's leader lease to the incoming holder. The fence is the
+// timestamp above which the outgoing holder ceased serving reads.
+// The response cache entries allow the incoming holder to continue
+// recognizing replayed client commands.
+type LeaseTransfer struct {
+	Fence         Timestamp            `protobuf:"bytes,1,opt,name=fence" json:"fence"`
+	ResponseCache []ResponseCacheEntry `protobuf:"bytes,2,rep,name=response_cache" json:"response_cache"`
+	// The replica receiving the lease, which becomes the range's route hint.
+	Holder           Replica `protobuf:"bytes,3,opt,name=holder" json:"holder"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *LeaseTransfer) Reset()         { *m = LeaseTransfer{} }
+func (m *LeaseTransfer) String() string { return proto1.CompactTextString(m) }
+func (*LeaseTransfer) ProtoMessage()    {}
+
+func (m *LeaseTransfer) GetFence() Timestamp {
+	if m != nil {
+		return m.Fence
+	}
+	return Timestamp{}
+}
+
+func (m *LeaseTransfer) GetResponseCache() []ResponseCacheEntry {
+	if m != nil {
+		return m.ResponseCache
+	}
+	return nil
+}
+
+func (m *LeaseTransfer) GetHolder() Replica {
+	if m != nil {
+		return m.Holder
+	}
+	return Replica{}
+}
+
 // An InternalRaftCommandUnion is the union of all commands which can be
 // sent via raft.
 type InternalRaftCommandUnion struct {
 	// Non-batched external requests. This section is the same as RequestUnion.
-	Contains       *ContainsRequest       `protobuf:"bytes,1,opt,name=contains" json:"contains,omitempty"`
-	Get            *GetRequest            `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put            *PutRequest            `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
-	ConditionalPut *ConditionalPutRequest `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
-	Increment      *IncrementRequest      `protobuf:"bytes,5,opt,name=increment" json:"increment,omitempty"`
-	Delete         *DeleteRequest         `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
-	DeleteRange    *DeleteRangeRequest    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
-	Scan           *ScanRequest           `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
-	EndTransaction *EndTransactionRequest `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
-	ReapQueue      *ReapQueueRequest      `protobuf:"bytes,10,opt,name=reap_queue" json:"reap_queue,omitempty"`
-	EnqueueUpdate  *EnqueueUpdateRequest  `protobuf:"bytes,11,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
-	EnqueueMessage *EnqueueMessageRequest `protobuf:"bytes,12,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	Contains          *ContainsRequest          `protobuf:"bytes,1,opt,name=contains" json:"contains,omitempty"`
+	Get               *GetRequest               `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put               *PutRequest               `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
+	ConditionalPut    *ConditionalPutRequest    `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
+	Increment         *IncrementRequest         `protobuf:"bytes,5,opt,name=increment" json:"increment,omitempty"`
+	Delete            *DeleteRequest            `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
+	DeleteRange       *DeleteRangeRequest       `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
+	Scan              *ScanRequest              `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
+	EndTransaction    *EndTransactionRequest    `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	ReapQueue         *ReapQueueRequest         `protobuf:"bytes,10,opt,name=reap_queue" json:"reap_queue,omitempty"`
+	EnqueueUpdate     *EnqueueUpdateRequest     `protobuf:"bytes,11,opt,name=enqueue_update" json:"enqueue_update,omitempty"`
+	EnqueueMessage    *EnqueueMessageRequest    `protobuf:"bytes,12,opt,name=enqueue_message" json:"enqueue_message,omitempty"`
+	ReverseScan       *ReverseScanRequest       `protobuf:"bytes,13,opt,name=reverse_scan" json:"reverse_scan,omitempty"`
+	ConditionalDelete *ConditionalDeleteRequest `protobuf:"bytes,14,opt,name=conditional_delete" json:"conditional_delete,omitempty"`
 	// Other requests. Allow a gap in tag numbers so the previous list can
 	// be copy/pasted from RequestUnion.
-	Batch                 *BatchRequest                 `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
-	InternalRangeLookup   *InternalRangeLookupRequest   `protobuf:"bytes,31,opt,name=internal_range_lookup" json:"internal_range_lookup,omitempty"`
-	InternalHeartbeatTxn  *InternalHeartbeatTxnRequest  `protobuf:"bytes,32,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
-	InternalPushTxn       *InternalPushTxnRequest       `protobuf:"bytes,33,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
-	InternalResolveIntent *InternalResolveIntentRequest `protobuf:"bytes,34,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
-	InternalMergeResponse *InternalMergeRequest         `protobuf:"bytes,35,opt,name=internal_merge_response" json:"internal_merge_response,omitempty"`
-	InternalTruncateLog   *InternalTruncateLogRequest   `protobuf:"bytes,36,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
-	InternalGc            *InternalGCRequest            `protobuf:"bytes,37,opt,name=internal_gc" json:"internal_gc,omitempty"`
-	XXX_unrecognized      []byte                        `json:"-"`
+	Batch                         *BatchRequest                         `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
+	InternalRangeLookup           *InternalRangeLookupRequest           `protobuf:"bytes,31,opt,name=internal_range_lookup" json:"internal_range_lookup,omitempty"`
+	InternalHeartbeatTxn          *InternalHeartbeatTxnRequest          `protobuf:"bytes,32,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
+	InternalPushTxn               *InternalPushTxnRequest               `protobuf:"bytes,33,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
+	InternalResolveIntent         *InternalResolveIntentRequest         `protobuf:"bytes,34,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
+	InternalMergeResponse         *InternalMergeRequest                 `protobuf:"bytes,35,opt,name=internal_merge_response" json:"internal_merge_response,omitempty"`
+	InternalTruncateLog           *InternalTruncateLogRequest           `protobuf:"bytes,36,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
+	InternalGc                    *InternalGCRequest                    `protobuf:"bytes,37,opt,name=internal_gc" json:"internal_gc,omitempty"`
+	InternalBeginTransaction      *InternalBeginTransactionRequest      `protobuf:"bytes,38,opt,name=internal_begin_transaction" json:"internal_begin_transaction,omitempty"`
+	InternalScanIntents           *InternalScanIntentsRequest           `protobuf:"bytes,39,opt,name=internal_scan_intents" json:"internal_scan_intents,omitempty"`
+	InternalInspectTimestampCache *InternalInspectTimestampCacheRequest `protobuf:"bytes,40,opt,name=internal_inspect_timestamp_cache" json:"internal_inspect_timestamp_cache,omitempty"`
+	InternalGetTransaction        *InternalGetTransactionRequest        `protobuf:"bytes,41,opt,name=internal_get_transaction" json:"internal_get_transaction,omitempty"`
+	InternalPutIfAbsent           *InternalPutIfAbsentRequest           `protobuf:"bytes,42,opt,name=internal_put_if_absent" json:"internal_put_if_absent,omitempty"`
+	InternalRangeKeyBounds        *InternalRangeKeyBoundsRequest        `protobuf:"bytes,43,opt,name=internal_range_key_bounds" json:"internal_range_key_bounds,omitempty"`
+	InternalVerifyRange           *InternalVerifyRangeRequest           `protobuf:"bytes,44,opt,name=internal_verify_range" json:"internal_verify_range,omitempty"`
+	InternalResolveIntentRange    *InternalResolveIntentRangeRequest    `protobuf:"bytes,45,opt,name=internal_resolve_intent_range" json:"internal_resolve_intent_range,omitempty"`
+	InternalLeaderLease           *InternalLeaderLeaseRequest           `protobuf:"bytes,46,opt,name=internal_leader_lease" json:"internal_leader_lease,omitempty"`
+	InternalComputeChecksum       *InternalComputeChecksumRequest       `protobuf:"bytes,47,opt,name=internal_compute_checksum" json:"internal_compute_checksum,omitempty"`
+	InternalGetHistory            *InternalGetHistoryRequest            `protobuf:"bytes,48,opt,name=internal_get_history" json:"internal_get_history,omitempty"`
+	InternalBatchGet              *InternalBatchGetRequest              `protobuf:"bytes,49,opt,name=internal_batch_get" json:"internal_batch_get,omitempty"`
+	XXX_unrecognized              []byte                                `json:"-"`
 }
 
 func (m *InternalRaftCommandUnion) Reset()         { *m = InternalRaftCommandUnion{} }
@@ -581,6 +1358,20 @@ func (m *InternalRaftCommandUnion) GetEnqueueMessage() *EnqueueMessageRequest {
 	return nil
 }
 
+func (m *InternalRaftCommandUnion) GetReverseScan() *ReverseScanRequest {
+	if m != nil {
+		return m.ReverseScan
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetConditionalDelete() *ConditionalDeleteRequest {
+	if m != nil {
+		return m.ConditionalDelete
+	}
+	return nil
+}
+
 func (m *InternalRaftCommandUnion) GetBatch() *BatchRequest {
 	if m != nil {
 		return m.Batch
@@ -637,12 +1428,103 @@ func (m *InternalRaftCommandUnion) GetInternalGc() *InternalGCRequest {
 	return nil
 }
 
+func (m *InternalRaftCommandUnion) GetInternalBeginTransaction() *InternalBeginTransactionRequest {
+	if m != nil {
+		return m.InternalBeginTransaction
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalScanIntents() *InternalScanIntentsRequest {
+	if m != nil {
+		return m.InternalScanIntents
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalInspectTimestampCache() *InternalInspectTimestampCacheRequest {
+	if m != nil {
+		return m.InternalInspectTimestampCache
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalGetTransaction() *InternalGetTransactionRequest {
+	if m != nil {
+		return m.InternalGetTransaction
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalPutIfAbsent() *InternalPutIfAbsentRequest {
+	if m != nil {
+		return m.InternalPutIfAbsent
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalRangeKeyBounds() *InternalRangeKeyBoundsRequest {
+	if m != nil {
+		return m.InternalRangeKeyBounds
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalVerifyRange() *InternalVerifyRangeRequest {
+	if m != nil {
+		return m.InternalVerifyRange
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalResolveIntentRange() *InternalResolveIntentRangeRequest {
+	if m != nil {
+		return m.InternalResolveIntentRange
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalLeaderLease() *InternalLeaderLeaseRequest {
+	if m != nil {
+		return m.InternalLeaderLease
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalComputeChecksum() *InternalComputeChecksumRequest {
+	if m != nil {
+		return m.InternalComputeChecksum
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalGetHistory() *InternalGetHistoryRequest {
+	if m != nil {
+		return m.InternalGetHistory
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetInternalBatchGet() *InternalBatchGetRequest {
+	if m != nil {
+		return m.InternalBatchGet
+	}
+	return nil
+}
+
 // An InternalRaftCommand is a command which can be serialized and
 // sent via raft.
 type InternalRaftCommand struct {
-	RaftID           int64                    `protobuf:"varint,2,opt,name=raft_id" json:"raft_id"`
-	Cmd              InternalRaftCommandUnion `protobuf:"bytes,3,opt,name=cmd" json:"cmd"`
-	XXX_unrecognized []byte                   `json:"-"`
+	RaftID int64                    `protobuf:"varint,2,opt,name=raft_id" json:"raft_id"`
+	Cmd    InternalRaftCommandUnion `protobuf:"bytes,3,opt,name=cmd" json:"cmd"`
+	// Generation is the generation of the replica which proposed the
+	// command. See RangeTombstone.
+	Generation int64 `protobuf:"varint,4,opt,name=generation" json:"generation"`
+	// LeaseEpoch is the epoch of the leader lease under which the
+	// command was proposed. Commands proposed under an earlier epoch than
+	// that of the lease persisted by the applying replica are rejected.
+	LeaseEpoch       int64  `protobuf:"varint,5,opt,name=lease_epoch" json:"lease_epoch"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *InternalRaftCommand) Reset()         { *m = InternalRaftCommand{} }
@@ -663,6 +1545,20 @@ func (m *InternalRaftCommand) GetCmd() InternalRaftCommandUnion {
 	return InternalRaftCommandUnion{}
 }
 
+func (m *InternalRaftCommand) GetGeneration() int64 {
+	if m != nil {
+		return m.Generation
+	}
+	return 0
+}
+
+func (m *InternalRaftCommand) GetLeaseEpoch() int64 {
+	if m != nil {
+		return m.LeaseEpoch
+	}
+	return 0
+}
+
 // InternalTimeSeriesData is a collection of data samples for some measurable
 // value, where each sample is taken over a uniform time interval.
 //
@@ -885,6 +1781,27 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.InternalGc != nil {
 		return this.InternalGc
 	}
+	if this.InternalBeginTransaction != nil {
+		return this.InternalBeginTransaction
+	}
+	if this.InternalPutIfAbsent != nil {
+		return this.InternalPutIfAbsent
+	}
+	if this.Get != nil {
+		return this.Get
+	}
+	if this.ConditionalDelete != nil {
+		return this.ConditionalDelete
+	}
+	if this.Batch != nil {
+		return this.Batch
+	}
+	if this.InternalResolveIntentRange != nil {
+		return this.InternalResolveIntentRange
+	}
+	if this.InternalLeaderLease != nil {
+		return this.InternalLeaderLease
+	}
 	return nil
 }
 
@@ -920,6 +1837,20 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.InternalTruncateLog = vt
 	case *InternalGCResponse:
 		this.InternalGc = vt
+	case *InternalBeginTransactionResponse:
+		this.InternalBeginTransaction = vt
+	case *InternalPutIfAbsentResponse:
+		this.InternalPutIfAbsent = vt
+	case *GetResponse:
+		this.Get = vt
+	case *ConditionalDeleteResponse:
+		this.ConditionalDelete = vt
+	case *BatchResponse:
+		this.Batch = vt
+	case *InternalResolveIntentRangeResponse:
+		this.InternalResolveIntentRange = vt
+	case *InternalLeaderLeaseResponse:
+		this.InternalLeaderLease = vt
 	default:
 		return false
 	}
@@ -962,6 +1893,12 @@ func (this *InternalRaftCommandUnion) GetValue() interface{} {
 	if this.EnqueueMessage != nil {
 		return this.EnqueueMessage
 	}
+	if this.ReverseScan != nil {
+		return this.ReverseScan
+	}
+	if this.ConditionalDelete != nil {
+		return this.ConditionalDelete
+	}
 	if this.Batch != nil {
 		return this.Batch
 	}
@@ -986,6 +1923,42 @@ func (this *InternalRaftCommandUnion) GetValue() interface{} {
 	if this.InternalGc != nil {
 		return this.InternalGc
 	}
+	if this.InternalBeginTransaction != nil {
+		return this.InternalBeginTransaction
+	}
+	if this.InternalScanIntents != nil {
+		return this.InternalScanIntents
+	}
+	if this.InternalInspectTimestampCache != nil {
+		return this.InternalInspectTimestampCache
+	}
+	if this.InternalGetTransaction != nil {
+		return this.InternalGetTransaction
+	}
+	if this.InternalPutIfAbsent != nil {
+		return this.InternalPutIfAbsent
+	}
+	if this.InternalRangeKeyBounds != nil {
+		return this.InternalRangeKeyBounds
+	}
+	if this.InternalVerifyRange != nil {
+		return this.InternalVerifyRange
+	}
+	if this.InternalResolveIntentRange != nil {
+		return this.InternalResolveIntentRange
+	}
+	if this.InternalLeaderLease != nil {
+		return this.InternalLeaderLease
+	}
+	if this.InternalComputeChecksum != nil {
+		return this.InternalComputeChecksum
+	}
+	if this.InternalGetHistory != nil {
+		return this.InternalGetHistory
+	}
+	if this.InternalBatchGet != nil {
+		return this.InternalBatchGet
+	}
 	return nil
 }
 
@@ -1015,6 +1988,10 @@ func (this *InternalRaftCommandUnion) SetValue(value interface{}) bool {
 		this.EnqueueUpdate = vt
 	case *EnqueueMessageRequest:
 		this.EnqueueMessage = vt
+	case *ReverseScanRequest:
+		this.ReverseScan = vt
+	case *ConditionalDeleteRequest:
+		this.ConditionalDelete = vt
 	case *BatchRequest:
 		this.Batch = vt
 	case *InternalRangeLookupRequest:
@@ -1031,6 +2008,30 @@ func (this *InternalRaftCommandUnion) SetValue(value interface{}) bool {
 		this.InternalTruncateLog = vt
 	case *InternalGCRequest:
 		this.InternalGc = vt
+	case *InternalBeginTransactionRequest:
+		this.InternalBeginTransaction = vt
+	case *InternalScanIntentsRequest:
+		this.InternalScanIntents = vt
+	case *InternalInspectTimestampCacheRequest:
+		this.InternalInspectTimestampCache = vt
+	case *InternalGetTransactionRequest:
+		this.InternalGetTransaction = vt
+	case *InternalPutIfAbsentRequest:
+		this.InternalPutIfAbsent = vt
+	case *InternalRangeKeyBoundsRequest:
+		this.InternalRangeKeyBounds = vt
+	case *InternalVerifyRangeRequest:
+		this.InternalVerifyRange = vt
+	case *InternalResolveIntentRangeRequest:
+		this.InternalResolveIntentRange = vt
+	case *InternalLeaderLeaseRequest:
+		this.InternalLeaderLease = vt
+	case *InternalComputeChecksumRequest:
+		this.InternalComputeChecksum = vt
+	case *InternalGetHistoryRequest:
+		this.InternalGetHistory = vt
+	case *InternalBatchGetRequest:
+		this.InternalBatchGet = vt
 	default:
 		return false
 	}
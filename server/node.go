@@ -499,3 +499,73 @@ func (n *Node) InternalMerge(args *proto.InternalMergeRequest, reply *proto.Inte
 func (n *Node) InternalTruncateLog(args *proto.InternalTruncateLogRequest, reply *proto.InternalTruncateLogResponse) error {
 	return n.executeCmd(proto.InternalTruncateLog, args, reply)
 }
+
+// InternalBeginTransaction .
+func (n *Node) InternalBeginTransaction(args *proto.InternalBeginTransactionRequest, reply *proto.InternalBeginTransactionResponse) error {
+	return n.executeCmd(proto.InternalBeginTransaction, args, reply)
+}
+
+// InternalScanIntents .
+func (n *Node) InternalScanIntents(args *proto.InternalScanIntentsRequest, reply *proto.InternalScanIntentsResponse) error {
+	return n.executeCmd(proto.InternalScanIntents, args, reply)
+}
+
+// InternalInspectTimestampCache .
+func (n *Node) InternalInspectTimestampCache(args *proto.InternalInspectTimestampCacheRequest, reply *proto.InternalInspectTimestampCacheResponse) error {
+	return n.executeCmd(proto.InternalInspectTimestampCache, args, reply)
+}
+
+// InternalGetTransaction .
+func (n *Node) InternalGetTransaction(args *proto.InternalGetTransactionRequest, reply *proto.InternalGetTransactionResponse) error {
+	return n.executeCmd(proto.InternalGetTransaction, args, reply)
+}
+
+// InternalPutIfAbsent .
+func (n *Node) InternalPutIfAbsent(args *proto.InternalPutIfAbsentRequest, reply *proto.InternalPutIfAbsentResponse) error {
+	return n.executeCmd(proto.InternalPutIfAbsent, args, reply)
+}
+
+// InternalRangeKeyBounds .
+func (n *Node) InternalRangeKeyBounds(args *proto.InternalRangeKeyBoundsRequest, reply *proto.InternalRangeKeyBoundsResponse) error {
+	return n.executeCmd(proto.InternalRangeKeyBounds, args, reply)
+}
+
+// InternalVerifyRange .
+func (n *Node) InternalVerifyRange(args *proto.InternalVerifyRangeRequest, reply *proto.InternalVerifyRangeResponse) error {
+	return n.executeCmd(proto.InternalVerifyRange, args, reply)
+}
+
+// ReverseScan .
+func (n *Node) ReverseScan(args *proto.ReverseScanRequest, reply *proto.ReverseScanResponse) error {
+	return n.executeCmd(proto.ReverseScan, args, reply)
+}
+
+// ConditionalDelete .
+func (n *Node) ConditionalDelete(args *proto.ConditionalDeleteRequest, reply *proto.ConditionalDeleteResponse) error {
+	return n.executeCmd(proto.ConditionalDelete, args, reply)
+}
+
+// InternalResolveIntentRange .
+func (n *Node) InternalResolveIntentRange(args *proto.InternalResolveIntentRangeRequest, reply *proto.InternalResolveIntentRangeResponse) error {
+	return n.executeCmd(proto.InternalResolveIntentRange, args, reply)
+}
+
+// InternalLeaderLease .
+func (n *Node) InternalLeaderLease(args *proto.InternalLeaderLeaseRequest, reply *proto.InternalLeaderLeaseResponse) error {
+	return n.executeCmd(proto.InternalLeaderLease, args, reply)
+}
+
+// InternalComputeChecksum .
+func (n *Node) InternalComputeChecksum(args *proto.InternalComputeChecksumRequest, reply *proto.InternalComputeChecksumResponse) error {
+	return n.executeCmd(proto.InternalComputeChecksum, args, reply)
+}
+
+// InternalGetHistory .
+func (n *Node) InternalGetHistory(args *proto.InternalGetHistoryRequest, reply *proto.InternalGetHistoryResponse) error {
+	return n.executeCmd(proto.InternalGetHistory, args, reply)
+}
+
+// InternalBatchGet .
+func (n *Node) InternalBatchGet(args *proto.InternalBatchGetRequest, reply *proto.InternalBatchGetResponse) error {
+	return n.executeCmd(proto.InternalBatchGet, args, reply)
+}
@@ -41,6 +41,15 @@ type allocator struct {
 // available stores matching attributes for missing replicas and picks
 // using randomly weighted selection based on available capacities.
 func (a *allocator) allocate(required proto.Attributes, existingReplicas []proto.Replica) (
+	*StoreDescriptor, error) {
+	return a.allocatePreferred(required, proto.Attributes{}, existingReplicas)
+}
+
+// allocatePreferred is like allocate, but additionally favors stores
+// matching the preferred attributes. Only stores matching all the
+// required attributes are eligible; of those, selection is limited to
+// the stores which match the greatest number of preferred attributes.
+func (a *allocator) allocatePreferred(required, preferred proto.Attributes, existingReplicas []proto.Replica) (
 	*StoreDescriptor, error) {
 	// Get a set of current nodes -- we never want to allocate on an existing node.
 	usedNodes := make(map[proto.NodeID]struct{})
@@ -53,11 +62,22 @@ func (a *allocator) allocate(required proto.Attributes, existingReplicas []proto
 		return nil, err
 	}
 
-	// Randomly pick a node weighted by capacity.
+	// Randomly pick a node weighted by capacity from among those
+	// with the best attribute match score.
 	var candidates []*StoreDescriptor
 	var capacityTotal float64
+	bestScore := 0
 	for _, s := range stores {
-		if _, ok := usedNodes[s.Node.NodeID]; !ok {
+		if _, ok := usedNodes[s.Node.NodeID]; ok {
+			continue
+		}
+		score := s.CombinedAttrs().MatchScore(required, preferred)
+		if score > bestScore {
+			bestScore = score
+			candidates = nil
+			capacityTotal = 0
+		}
+		if score == bestScore {
 			candidates = append(candidates, s)
 			capacityTotal += s.Capacity.PercentAvail()
 		}
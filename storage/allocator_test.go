@@ -288,3 +288,60 @@ func TestExistingReplica(t *testing.T) {
 		t.Errorf("expected result to have node 3 and store 4: %+v", result)
 	}
 }
+
+func TestPreferredAttributes(t *testing.T) {
+	stores := []*StoreDescriptor{
+		{
+			StoreID: 1,
+			Attrs:   proto.Attributes{Attrs: []string{"hdd"}},
+			Node: NodeDescriptor{
+				NodeID: 1,
+				Attrs:  proto.Attributes{Attrs: []string{"a"}},
+			},
+			Capacity: engine.StoreCapacity{
+				Capacity:  100,
+				Available: 100,
+			},
+		},
+		{
+			StoreID: 2,
+			Attrs:   proto.Attributes{Attrs: []string{"ssd"}},
+			Node: NodeDescriptor{
+				NodeID: 2,
+				Attrs:  proto.Attributes{Attrs: []string{"a"}},
+			},
+			Capacity: engine.StoreCapacity{
+				Capacity:  100,
+				Available: 1,
+			},
+		},
+	}
+	var a = allocator{
+		storeFinder: func(a proto.Attributes) ([]*StoreDescriptor, error) {
+			return filterStores(a, stores)
+		},
+		rand: *rand.New(rand.NewSource(0)),
+	}
+	required := proto.Attributes{Attrs: []string{"a"}}
+	preferred := proto.Attributes{Attrs: []string{"ssd"}}
+	// The ssd store is always chosen despite its lower capacity.
+	for i := 0; i < 10; i++ {
+		result, err := a.allocatePreferred(required, preferred, []proto.Replica{})
+		if err != nil {
+			t.Fatalf("Unable to perform allocation: %v", err)
+		}
+		if result.StoreID != 2 {
+			t.Fatalf("expected preferred store 2; got %+v", result)
+		}
+	}
+	// With the ssd store's node in use, the hdd store is still eligible.
+	result, err := a.allocatePreferred(required, preferred, []proto.Replica{
+		{NodeID: 2, StoreID: 2},
+	})
+	if err != nil {
+		t.Fatalf("Unable to perform allocation: %v", err)
+	}
+	if result.StoreID != 1 {
+		t.Errorf("expected store 1; got %+v", result)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// bloomFilterHashes is the number of bit positions set per key.
+const bloomFilterHashes = 4
+
+// A bloomFilter is a probabilistic set of keys. A negative answer from
+// mayContain is definite; a positive answer may be a false positive.
+// Keys are never removed, so deletions only raise the false positive
+// rate until the filter is rebuilt.
+type bloomFilter struct {
+	sync.RWMutex
+	bits []uint64
+}
+
+// newBloomFilter returns a new, empty bloomFilter holding the
+// specified number of bits, rounded up to a multiple of 64.
+func newBloomFilter(bits int) *bloomFilter {
+	return &bloomFilter{bits: make([]uint64, (bits+63)/64)}
+}
+
+// positions returns the bit positions for key, derived from two
+// halves of its 64-bit FNV-1a hash by double hashing.
+func (bf *bloomFilter) positions(key proto.Key) [bloomFilterHashes]uint64 {
+	h := fnv.New64a()
+	h.Write(key)
+	sum := h.Sum64()
+	h1, h2 := sum&0xffffffff, sum>>32
+	n := uint64(len(bf.bits)) * 64
+	var pos [bloomFilterHashes]uint64
+	for i := range pos {
+		pos[i] = (h1 + uint64(i)*h2) % n
+	}
+	return pos
+}
+
+// add adds key to the filter.
+func (bf *bloomFilter) add(key proto.Key) {
+	pos := bf.positions(key)
+	bf.Lock()
+	defer bf.Unlock()
+	for _, p := range pos {
+		bf.bits[p/64] |= 1 << (p % 64)
+	}
+}
+
+// mayContain returns false if key has definitely not been added to
+// the filter.
+func (bf *bloomFilter) mayContain(key proto.Key) bool {
+	pos := bf.positions(key)
+	bf.RLock()
+	defer bf.RUnlock()
+	for _, p := range pos {
+		if bf.bits[p/64]&(1<<(p%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,245 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// DefaultChangeFeedBufferSize is the default number of change events
+// buffered for a change feed subscriber.
+const DefaultChangeFeedBufferSize = 1000
+
+// A ChangeEvent is a committed write to a key, delivered to change
+// feed subscribers.
+type ChangeEvent struct {
+	Key       proto.Key
+	Timestamp proto.Timestamp
+	Deleted   bool         // True for a deletion
+	Value     *proto.Value // Nil if Deleted is true
+}
+
+// A ChangeFeed is a subscription to the committed writes to a span of
+// keys in a range. Events are delivered on C in commit order. If the
+// subscriber falls more than the feed's buffer size behind, the feed
+// is terminated: C is closed and Err returns the cause.
+type ChangeFeed struct {
+	C <-chan ChangeEvent
+
+	start, end proto.Key
+	timestamp  proto.Timestamp
+	events     chan ChangeEvent
+	registry   *changeFeedRegistry
+	err        error // Protected by registry's mutex
+}
+
+// Err returns the error which terminated the feed, if any.
+func (f *ChangeFeed) Err() error {
+	f.registry.Lock()
+	defer f.registry.Unlock()
+	return f.err
+}
+
+// Close unsubscribes the feed and closes C.
+func (f *ChangeFeed) Close() {
+	f.registry.Lock()
+	defer f.registry.Unlock()
+	f.registry.removeLocked(f, nil)
+}
+
+// matches returns whether the event falls within the feed's span and
+// at or after its timestamp.
+func (f *ChangeFeed) matches(ev ChangeEvent) bool {
+	return !ev.Key.Less(f.start) && ev.Key.Less(f.end) && !ev.Timestamp.Less(f.timestamp)
+}
+
+// A changeFeedRegistry holds the change feeds subscribed to a range.
+type changeFeedRegistry struct {
+	sync.Mutex
+	feeds map[*ChangeFeed]struct{}
+}
+
+// newChangeFeedRegistry returns a new, empty changeFeedRegistry.
+func newChangeFeedRegistry() *changeFeedRegistry {
+	return &changeFeedRegistry{feeds: map[*ChangeFeed]struct{}{}}
+}
+
+// subscribe registers and returns a new feed for the span from start
+// to end (exclusive), or for start alone if end is empty.
+func (cr *changeFeedRegistry) subscribe(start, end proto.Key, timestamp proto.Timestamp, bufferSize int) *ChangeFeed {
+	if len(end) == 0 {
+		end = start.Next()
+	}
+	if bufferSize <= 0 {
+		bufferSize = DefaultChangeFeedBufferSize
+	}
+	events := make(chan ChangeEvent, bufferSize)
+	f := &ChangeFeed{
+		C:         events,
+		start:     start,
+		end:       end,
+		timestamp: timestamp,
+		events:    events,
+		registry:  cr,
+	}
+	cr.Lock()
+	defer cr.Unlock()
+	cr.feeds[f] = struct{}{}
+	return f
+}
+
+// removeLocked unsubscribes f, terminating it with err. The registry
+// must be locked.
+func (cr *changeFeedRegistry) removeLocked(f *ChangeFeed, err error) {
+	if _, ok := cr.feeds[f]; !ok {
+		return
+	}
+	delete(cr.feeds, f)
+	f.err = err
+	close(f.events)
+}
+
+// empty returns whether no feeds are subscribed.
+func (cr *changeFeedRegistry) empty() bool {
+	cr.Lock()
+	defer cr.Unlock()
+	return len(cr.feeds) == 0
+}
+
+// publish delivers events to the feeds they match, terminating any
+// feed whose buffer is full.
+func (cr *changeFeedRegistry) publish(events []ChangeEvent) {
+	cr.Lock()
+	defer cr.Unlock()
+	for f := range cr.feeds {
+		for _, ev := range events {
+			if f.matches(ev) && !cr.sendLocked(f, ev) {
+				break
+			}
+		}
+	}
+}
+
+// sendLocked delivers ev to f without blocking. If f's buffer is full,
+// f is terminated and false is returned. The registry must be locked.
+func (cr *changeFeedRegistry) sendLocked(f *ChangeFeed, ev ChangeEvent) bool {
+	select {
+	case f.events <- ev:
+		return true
+	default:
+		cr.removeLocked(f, util.Errorf("change feed for %q-%q fell more than %d events behind",
+			f.start, f.end, cap(f.events)))
+		return false
+	}
+}
+
+// closeAll terminates all feeds with err.
+func (cr *changeFeedRegistry) closeAll(err error) {
+	cr.Lock()
+	defer cr.Unlock()
+	for f := range cr.feeds {
+		cr.removeLocked(f, err)
+	}
+}
+
+// Subscribe registers a change feed for the committed writes to keys
+// in the span from start to end (exclusive), or to start alone if end
+// is empty, at timestamps no earlier than timestamp. Up to bufferSize
+// events are buffered for the subscriber; if bufferSize is not
+// positive, DefaultChangeFeedBufferSize is used. Events are produced
+// by non-transactional writes to single keys and by the resolution of
+// committed write intents. The feed is terminated if the
+// range is stopped; writes to keys moved to another range by a split
+// are not delivered.
+func (r *Range) Subscribe(start, end proto.Key, timestamp proto.Timestamp, bufferSize int) *ChangeFeed {
+	return r.feeds.subscribe(start, end, timestamp, bufferSize)
+}
+
+// publishChanges delivers the writes of a successfully committed
+// command to the range's change feeds, if any. For commands resolving
+// intents, committed lists the keys of the write intents committed.
+func (r *Range) publishChanges(method string, args proto.Request, committed []proto.Key) {
+	if r.feeds.empty() {
+		return
+	}
+	events := r.changeEvents(r.rm.Engine(), method, args, committed, nil)
+	if len(events) > 0 {
+		r.feeds.publish(events)
+	}
+}
+
+// changeEvents appends the change events for the committed writes of
+// the command to events, reading the written values from eng. The
+// resolution of intents produces events only for the keys listed in
+// committed; read locks released and intents already resolved produce
+// none.
+func (r *Range) changeEvents(eng engine.Engine, method string, args proto.Request, committed []proto.Key,
+	events []ChangeEvent) []ChangeEvent {
+	header := args.Header()
+	var timestamp proto.Timestamp
+	switch method {
+	case proto.Put, proto.ConditionalPut, proto.Increment, proto.Delete,
+		proto.ConditionalDelete, proto.InternalPutIfAbsent:
+		// Transactional writes are delivered on resolution.
+		if header.Txn != nil {
+			return events
+		}
+		timestamp = header.Timestamp
+	case proto.InternalResolveIntent, proto.InternalResolveIntentRange:
+		if header.Txn == nil || header.Txn.Status != proto.COMMITTED {
+			return events
+		}
+		for _, key := range committed {
+			events = appendChangeEvent(eng, key, header.Txn.Timestamp, events)
+		}
+		return events
+	case proto.Batch:
+		for _, union := range args.(*proto.BatchRequest).Requests {
+			subArgs := union.GetValue().(proto.Request)
+			subMethod, err := proto.MethodForRequest(subArgs)
+			if err != nil {
+				log.Error(err)
+				continue
+			}
+			events = r.changeEvents(eng, subMethod, subArgs, nil, events)
+		}
+		return events
+	default:
+		return events
+	}
+	return appendChangeEvent(eng, header.Key, timestamp, events)
+}
+
+// appendChangeEvent appends the change event for the value of key
+// committed at timestamp to events, reading the value from eng.
+func appendChangeEvent(eng engine.Engine, key proto.Key, timestamp proto.Timestamp, events []ChangeEvent) []ChangeEvent {
+	value, err := engine.MVCCGet(eng, key, timestamp, nil)
+	if err != nil {
+		log.Warningf("unable to read committed value of %q for change feeds: %s", key, err)
+		return events
+	}
+	return append(events, ChangeEvent{
+		Key:       key,
+		Timestamp: timestamp,
+		Deleted:   value == nil,
+		Value:     value,
+	})
+}
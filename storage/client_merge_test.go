@@ -228,3 +228,82 @@ func TestStoreRangeMergeDistantRanges(t *testing.T) {
 		t.Fatal("Should not be able to merge two ranges that are not adjacent.")
 	}
 }
+
+// TestStoreMergeRanges verifies that Store.MergeRanges merges two
+// adjacent ranges, keeping the data from both.
+func TestStoreMergeRanges(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Stop()
+
+	if _, _, err := createSplitRanges(store); err != nil {
+		t.Fatal(err)
+	}
+	left := store.LookupRange([]byte("a"), nil)
+	right := store.LookupRange([]byte("c"), nil)
+	for _, test := range []struct {
+		key proto.Key
+		rng *storage.Range
+	}{
+		{proto.Key("aaa"), left},
+		{proto.Key("ccc"), right},
+	} {
+		pArgs, pReply := putArgs(test.key, []byte("value"), test.rng.Desc().RaftID, store.StoreID())
+		if err := store.ExecuteCmd(proto.Put, pArgs, pReply); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := store.MergeRanges(left, right); err != nil {
+		t.Fatal(err)
+	}
+	if rng := store.LookupRange([]byte("c"), nil); rng != left {
+		t.Fatalf("expected key %q to be contained by the merged range; got %s", "c", rng)
+	}
+	if !bytes.Equal(left.Desc().EndKey, engine.KeyMax) {
+		t.Errorf("expected merged range to end at %q; got %q", engine.KeyMax, left.Desc().EndKey)
+	}
+	if _, err := store.GetRange(right.Desc().RaftID); err == nil {
+		t.Errorf("expected range %d to be removed from the store", right.Desc().RaftID)
+	}
+	for _, key := range []proto.Key{proto.Key("aaa"), proto.Key("ccc")} {
+		gArgs, gReply := getArgs(key, left.Desc().RaftID, store.StoreID())
+		if err := store.ExecuteCmd(proto.Get, gArgs, gReply); err != nil {
+			t.Fatal(err)
+		}
+		if gReply.Value == nil {
+			t.Errorf("expected value for key %q after merge", key)
+		}
+	}
+}
+
+// TestStoreMergeRangesRejected verifies that Store.MergeRanges rejects
+// ranges which aren't adjacent or whose replica sets differ.
+func TestStoreMergeRangesRejected(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Stop()
+
+	// Split into three ranges: [KeyMin, "b"), ["b", "d") and ["d", KeyMax).
+	for _, key := range []string{"d", "b"} {
+		args, reply := adminSplitArgs(engine.KeyMin, []byte(key), 1, store.StoreID())
+		if err := store.ExecuteCmd(proto.AdminSplit, args, reply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rangeA := store.LookupRange([]byte("a"), nil)
+	rangeB := store.LookupRange([]byte("c"), nil)
+	rangeC := store.LookupRange([]byte("e"), nil)
+
+	if err := store.MergeRanges(rangeA, rangeC); err == nil {
+		t.Error("expected merge of non-adjacent ranges to fail")
+	}
+
+	desc := *rangeB.Desc()
+	desc.Replicas = append(append([]proto.Replica(nil), desc.Replicas...), proto.Replica{NodeID: 2, StoreID: 2})
+	rangeB.SetDesc(&desc)
+	if err := store.MergeRanges(rangeA, rangeB); err == nil {
+		t.Error("expected merge of ranges with differing replica sets to fail")
+	}
+	if !bytes.Equal(rangeA.Desc().EndKey, []byte("b")) {
+		t.Errorf("expected rejected merges to leave range end key %q; got %q", "b", rangeA.Desc().EndKey)
+	}
+}
@@ -270,3 +270,102 @@ func TestFailedReplicaChange(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// TestLeaseEpochReplicated verifies that the lease epoch is advanced
+// through raft, so that every replica of a range observes the same
+// epoch when the lease changes hands, and that it is recovered from
+// the persisted lease when a store restarts.
+func TestLeaseEpochReplicated(t *testing.T) {
+	mtc := multiTestContext{}
+	mtc.Start(t, 2)
+	defer mtc.Stop()
+
+	rng, err := mtc.stores[0].GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rng.ChangeReplicas(proto.ADD_REPLICA,
+		proto.Replica{
+			NodeID:  mtc.stores[1].Ident.NodeID,
+			StoreID: mtc.stores[1].Ident.StoreID,
+			Attrs:   proto.Attributes{},
+		}); err != nil {
+		t.Fatal(err)
+	}
+
+	// grant has store i request the lease for its own replica, lasting
+	// ten seconds from the current time.
+	grant := func(i int) {
+		start := mtc.clock.Now()
+		replica := proto.Replica{NodeID: mtc.stores[i].Ident.NodeID, StoreID: mtc.stores[i].Ident.StoreID}
+		args := &proto.InternalLeaderLeaseRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       engine.KeyMin,
+				Timestamp: start,
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: mtc.stores[i].StoreID()},
+			},
+			Lease: proto.Lease{
+				Start:      start,
+				Expiration: proto.Timestamp{WallTime: start.WallTime + 10*1E9},
+				Replica:    replica,
+			},
+		}
+		if err := mtc.stores[i].ExecuteCmd(proto.InternalLeaderLease, args, &proto.InternalLeaderLeaseResponse{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// verifyEpoch waits for the replica on each store to apply the
+	// lease held by store holder at the expected epoch.
+	verifyEpoch := func(holder int, epoch int64) {
+		if err := util.IsTrueWithin(func() bool {
+			for _, store := range mtc.stores {
+				r, err := store.GetRange(1)
+				if err != nil || r.LeaseEpoch() != epoch {
+					return false
+				}
+				if r.LeaseHolder().StoreID != mtc.stores[holder].StoreID() {
+					return false
+				}
+			}
+			return true
+		}, 1*time.Second); err != nil {
+			t.Fatalf("expected all replicas to reach lease epoch %d: %s", epoch, err)
+		}
+		for i, eng := range mtc.engines {
+			lease := &proto.Lease{}
+			ok, err := engine.MVCCGetProto(eng, engine.RangeLeaderLeaseKey(1), proto.ZeroTimestamp, nil, lease)
+			if err != nil || !ok {
+				t.Fatalf("store %d: expected persisted lease; got %t, %v", i, ok, err)
+			}
+			if lease.Epoch != epoch {
+				t.Errorf("store %d: expected persisted lease epoch %d; got %d", i, epoch, lease.Epoch)
+			}
+		}
+	}
+
+	// The first lease begins epoch 1, which a renewal keeps.
+	mtc.manualClock.Set(1 * 1E9)
+	grant(0)
+	verifyEpoch(0, 1)
+	mtc.manualClock.Set(2 * 1E9)
+	grant(0)
+	verifyEpoch(0, 1)
+
+	// Once the lease has expired, the second store acquires it,
+	// advancing the epoch on both replicas.
+	mtc.manualClock.Set(13 * 1E9)
+	grant(1)
+	verifyEpoch(1, 2)
+
+	// The epoch is loaded from the persisted lease on restart.
+	mtc.StopStore(1)
+	mtc.RestartStore(1, t)
+	r, err := mtc.stores[1].GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.LeaseEpoch() != 2 {
+		t.Errorf("expected lease epoch 2 after restart; got %d", r.LeaseEpoch())
+	}
+}
@@ -258,6 +258,60 @@ func TestStoreRangeSplit(t *testing.T) {
 	}
 }
 
+// TestStoreRangeSplitAtKey verifies that Range.Split divides the
+// full keyspace range in two at the split key.
+func TestStoreRangeSplitAtKey(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Stop()
+
+	for _, key := range []string{"c", "x"} {
+		pArgs, pReply := putArgs([]byte(key), []byte("value"), 1, store.StoreID())
+		if err := store.ExecuteCmd(proto.Put, pArgs, pReply); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rng := store.LookupRange(engine.KeyMin, nil)
+	if err := rng.Split(proto.Key("m")); err != nil {
+		t.Fatal(err)
+	}
+	newRng := store.LookupRange(proto.Key("m"), nil)
+	if newRng == rng {
+		t.Fatal("expected split to create a new range")
+	}
+	for _, test := range []struct {
+		key         proto.Key
+		left, right bool
+	}{
+		{engine.KeyMin, true, false},
+		{proto.Key("c"), true, false},
+		{proto.Key("l"), true, false},
+		{proto.Key("m"), false, true},
+		{proto.Key("x"), false, true},
+	} {
+		if rng.ContainsKey(test.key) != test.left || newRng.ContainsKey(test.key) != test.right {
+			t.Errorf("expected key %q contained by left=%t, right=%t", test.key, test.left, test.right)
+		}
+	}
+
+	// Each half holds its own data.
+	for _, test := range []struct {
+		key proto.Key
+		rng *storage.Range
+	}{
+		{proto.Key("c"), rng},
+		{proto.Key("x"), newRng},
+	} {
+		gArgs, gReply := getArgs(test.key, test.rng.Desc().RaftID, store.StoreID())
+		if err := store.ExecuteCmd(proto.Get, gArgs, gReply); err != nil {
+			t.Fatal(err)
+		}
+		if gReply.Value == nil {
+			t.Errorf("expected value for key %q after split", test.key)
+		}
+	}
+}
+
 // TestStoreRangeSplitStats starts by splitting the system keys from user-space
 // keys and verifying that the user space side of the split (which is empty),
 // has all zeros for stats. It then writes random data to the user space side,
@@ -397,7 +451,11 @@ func TestStoreRangeSplitOnConfigs(t *testing.T) {
 	defer store.Stop()
 
 	acctConfig := &proto.AcctConfig{}
-	zoneConfig := &proto.ZoneConfig{}
+	zoneConfig := &proto.ZoneConfig{
+		ReplicaAttrs:  []proto.Attributes{{}, {}, {}},
+		RangeMinBytes: 1 << 20,
+		RangeMaxBytes: 64 << 20,
+	}
 
 	// Write zone configs for db3 & db4.
 	for _, k := range []string{"db4", "db3"} {
@@ -435,3 +493,64 @@ func TestStoreRangeSplitOnConfigs(t *testing.T) {
 		t.Errorf("expected splits not found: %s", err)
 	}
 }
+
+// TestStoreAcctRollups verifies that the stats of accounting prefixes
+// with rollups enabled are rolled up once the keyspace is split along
+// the prefixes' boundaries.
+func TestStoreAcctRollups(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Stop()
+
+	// Write accounting configs for db1 & db2 with rollups enabled.
+	acctConfig := &proto.AcctConfig{Rollup: true}
+	prefixes := []proto.Key{proto.Key("db1"), proto.Key("db2")}
+	for _, prefix := range prefixes {
+		store.DB().PreparePutProto(engine.MakeKey(engine.KeyConfigAccountingPrefix, prefix), acctConfig)
+	}
+	if err := store.DB().Flush(); err != nil {
+		t.Fatal(err)
+	}
+	// Write two keys under db1 and one under db2.
+	for _, key := range []string{"db1a", "db1b", "db2a"} {
+		store.DB().Prepare(proto.Put, proto.PutArgs(proto.Key(key), []byte("value")), &proto.PutResponse{})
+	}
+	if err := store.DB().Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each prefix is rolled up once it occupies its own range; verify
+	// the rollups match the prefixes' range stats.
+	expCounts := []int64{2, 1}
+	if err := util.IsTrueWithin(func() bool {
+		if err := store.RollupAccounting(); err != nil {
+			t.Fatal(err)
+		}
+		for i, prefix := range prefixes {
+			rollup := &proto.AcctRollup{}
+			ok, _, err := store.DB().GetProto(engine.AcctRollupKey(prefix), rollup)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				return false
+			}
+			rng := store.LookupRange(prefix, nil)
+			if rng == nil || !rng.Desc().StartKey.Equal(prefix) {
+				return false
+			}
+			var ms engine.MVCCStats
+			if err := engine.MVCCGetRangeStats(store.Engine(), rng.Desc().RaftID, &ms); err != nil {
+				t.Fatal(err)
+			}
+			if rollup.Ranges != 1 || rollup.LiveCount != ms.LiveCount || rollup.LiveBytes != ms.LiveBytes {
+				return false
+			}
+			if rollup.LiveCount < expCounts[i] {
+				t.Errorf("%s: expected at least %d live keys; got %d", prefix, expCounts[i], rollup.LiveCount)
+			}
+		}
+		return true
+	}, 500*time.Millisecond); err != nil {
+		t.Errorf("expected accounting rollups not found: %s", err)
+	}
+}
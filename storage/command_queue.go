@@ -46,6 +46,13 @@ import (
 // possibly signaling waiting commands who were gated by the executing
 // command's affected key(s).
 //
+// Alternatively, AddWaiting() adds a command to the queue while it is
+// still waiting, initializing its WaitGroup in the same step. Waiting
+// commands are admitted in priority order: a command added this way
+// doesn't wait on overlapping commands of lower priority which are
+// themselves still waiting, but instead makes them wait on it. Among
+// commands of equal priority, admission is first come, first served.
+//
 // CommandQueue is not thread safe.
 type CommandQueue struct {
 	cache *util.IntervalCache
@@ -53,7 +60,10 @@ type CommandQueue struct {
 
 type cmd struct {
 	readOnly bool
-	pending  []*sync.WaitGroup // Pending commands gated on cmd
+	priority int32
+	wg       *sync.WaitGroup // Signaled as gating commands complete
+	waiting  int             // Number of commands on which cmd waits
+	pending  []*cmd          // Pending commands gated on cmd
 }
 
 // NewCommandQueue returns a new command queue.
@@ -69,8 +79,9 @@ func NewCommandQueue() *CommandQueue {
 // tree. This happens on calls to Remove() and to Clear().
 func (cq *CommandQueue) onEvicted(key, value interface{}) {
 	c := value.(*cmd)
-	for _, wg := range c.pending {
-		wg.Done()
+	for _, p := range c.pending {
+		p.waiting--
+		p.wg.Done()
 	}
 }
 
@@ -87,16 +98,34 @@ func (cq *CommandQueue) GetWait(start, end proto.Key, readOnly bool, wg *sync.Wa
 		end = start.Next()
 		start = end[:len(start)]
 	}
+	waiter := &cmd{readOnly: readOnly, wg: wg}
 	for _, c := range cq.cache.GetOverlaps(start, end) {
 		c := c.Value.(*cmd)
 		// Only add to the wait group if one of the commands isn't read-only.
 		if !readOnly || !c.readOnly {
-			c.pending = append(c.pending, wg)
+			c.pending = append(c.pending, waiter)
+			waiter.waiting++
 			wg.Add(1)
 		}
 	}
 }
 
+// WouldWait returns whether a command affecting the specified key
+// range would have to wait on executing commands. Arguments are as for
+// GetWait.
+func (cq *CommandQueue) WouldWait(start, end proto.Key, readOnly bool) bool {
+	if len(end) == 0 {
+		end = start.Next()
+		start = end[:len(start)]
+	}
+	for _, c := range cq.cache.GetOverlaps(start, end) {
+		if !readOnly || !c.Value.(*cmd).readOnly {
+			return true
+		}
+	}
+	return false
+}
+
 // Add adds a command to the queue which affects the specified key
 // range. If end is empty, it is set to start.Next(), meaning the
 // command affects a single key. The returned interface is the key for
@@ -115,6 +144,40 @@ func (cq *CommandQueue) Add(start, end proto.Key, readOnly bool) interface{} {
 	return key
 }
 
+// AddWaiting adds a command of the specified priority to the queue
+// which affects the specified key range, and initializes the supplied
+// wait group with the overlapping commands on which it must wait
+// before executing. Overlapping commands of lower priority which are
+// still waiting are made to wait on this command instead. Read-only
+// commands neither wait on nor gate other read-only commands. Arguments
+// and the returned key are otherwise as for Add; the caller should
+// call wg.Wait() before executing the command.
+func (cq *CommandQueue) AddWaiting(start, end proto.Key, readOnly bool, priority int32, wg *sync.WaitGroup) interface{} {
+	if len(end) == 0 {
+		end = start.Next()
+	}
+	c := &cmd{readOnly: readOnly, priority: priority, wg: wg}
+	for _, o := range cq.cache.GetOverlaps(start, end) {
+		o := o.Value.(*cmd)
+		if readOnly && o.readOnly {
+			continue
+		}
+		if o.waiting > 0 && o.priority < priority {
+			// Admit this command ahead of the lower priority waiter.
+			c.pending = append(c.pending, o)
+			o.waiting++
+			o.wg.Add(1)
+		} else {
+			o.pending = append(o.pending, c)
+			c.waiting++
+			wg.Add(1)
+		}
+	}
+	key := cq.cache.NewKey(start, end)
+	cq.cache.Add(key, c)
+	return key
+}
+
 // Remove is invoked to signal that the command associated with the
 // specified key has completed and should be removed. Any pending
 // commands waiting on this command will be signaled if this is the
@@ -165,3 +165,66 @@ func TestCommandQueueClear(t *testing.T) {
 		t.Fatal("commands should finish when clearing queue")
 	}
 }
+
+// TestCommandQueuePriority verifies that waiting writes to a key are
+// admitted in priority order once the executing command completes,
+// and in arrival order among equal priorities.
+func TestCommandQueuePriority(t *testing.T) {
+	cq := NewCommandQueue()
+	wgLow1, wgLow2, wgHigh := sync.WaitGroup{}, sync.WaitGroup{}, sync.WaitGroup{}
+
+	// Interleave low and high priority writes behind an executing write.
+	wk := cq.Add(proto.Key("a"), nil, false)
+	wkLow1 := cq.AddWaiting(proto.Key("a"), nil, false, 1, &wgLow1)
+	wkHigh := cq.AddWaiting(proto.Key("a"), nil, false, 10, &wgHigh)
+	wkLow2 := cq.AddWaiting(proto.Key("a"), nil, false, 1, &wgLow2)
+	cmdDoneLow1 := waitForCmd(&wgLow1)
+	cmdDoneHigh := waitForCmd(&wgHigh)
+	cmdDoneLow2 := waitForCmd(&wgLow2)
+	if testCmdDone(cmdDoneHigh, 1*time.Millisecond) {
+		t.Fatal("command should not finish with command outstanding")
+	}
+
+	// The high priority write is admitted first.
+	cq.Remove(wk)
+	if !testCmdDone(cmdDoneHigh, 5*time.Millisecond) {
+		t.Fatal("high priority command should finish first")
+	}
+	if testCmdDone(cmdDoneLow1, 1*time.Millisecond) || testCmdDone(cmdDoneLow2, 1*time.Millisecond) {
+		t.Fatal("low priority commands should wait on high priority command")
+	}
+
+	// The low priority writes follow in arrival order.
+	cq.Remove(wkHigh)
+	if !testCmdDone(cmdDoneLow1, 5*time.Millisecond) {
+		t.Fatal("first low priority command should finish")
+	}
+	if testCmdDone(cmdDoneLow2, 1*time.Millisecond) {
+		t.Fatal("second low priority command should wait on the first")
+	}
+	cq.Remove(wkLow1)
+	if !testCmdDone(cmdDoneLow2, 5*time.Millisecond) {
+		t.Fatal("second low priority command should finish")
+	}
+	cq.Remove(wkLow2)
+}
+
+// TestCommandQueuePriorityReadOnly verifies that waiting reads of
+// differing priorities don't wait on one another.
+func TestCommandQueuePriorityReadOnly(t *testing.T) {
+	cq := NewCommandQueue()
+	wg1, wg2 := sync.WaitGroup{}, sync.WaitGroup{}
+
+	wk := cq.Add(proto.Key("a"), nil, false)
+	cq.AddWaiting(proto.Key("a"), nil, true, 1, &wg1)
+	cq.AddWaiting(proto.Key("a"), nil, true, 10, &wg2)
+	cmdDone1 := waitForCmd(&wg1)
+	cmdDone2 := waitForCmd(&wg2)
+	if testCmdDone(cmdDone1, 1*time.Millisecond) || testCmdDone(cmdDone2, 1*time.Millisecond) {
+		t.Fatal("reads should wait on executing write")
+	}
+	cq.Remove(wk)
+	if !testCmdDone(cmdDone1, 5*time.Millisecond) || !testCmdDone(cmdDone2, 5*time.Millisecond) {
+		t.Fatal("reads should finish together once write completes")
+	}
+}
@@ -26,6 +26,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* RequestHeader_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RequestHeader_reflection_ = NULL;
+const ::google::protobuf::Descriptor* MVCCStatsDelta_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  MVCCStatsDelta_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ResponseHeader_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ResponseHeader_reflection_ = NULL;
@@ -101,6 +104,18 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* EnqueueMessageResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   EnqueueMessageResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReverseScanRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReverseScanRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ReverseScanResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ReverseScanResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ConditionalDeleteRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ConditionalDeleteRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ConditionalDeleteResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ConditionalDeleteResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* RequestUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RequestUnion_reflection_ = NULL;
@@ -152,7 +167,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ClientCmdID));
   RequestHeader_descriptor_ = file->message_type(1);
-  static const int RequestHeader_offsets_[9] = {
+  static const int RequestHeader_offsets_[13] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, cmd_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, key_),
@@ -162,6 +177,10 @@ void protobuf_AssignDesc_api_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, raft_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, user_priority_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, txn_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, conflict_timeout_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, max_staleness_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, timeout_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestHeader, clamp_to_closed_timestamp_),
   };
   RequestHeader_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -174,11 +193,34 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RequestHeader));
-  ResponseHeader_descriptor_ = file->message_type(2);
-  static const int ResponseHeader_offsets_[3] = {
+  MVCCStatsDelta_descriptor_ = file->message_type(2);
+  static const int MVCCStatsDelta_offsets_[8] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, live_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, key_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, val_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, intent_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, live_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, key_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, val_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, intent_count_),
+  };
+  MVCCStatsDelta_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      MVCCStatsDelta_descriptor_,
+      MVCCStatsDelta::default_instance_,
+      MVCCStatsDelta_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCStatsDelta, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(MVCCStatsDelta));
+  ResponseHeader_descriptor_ = file->message_type(3);
+  static const int ResponseHeader_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseHeader, error_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseHeader, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseHeader, txn_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseHeader, stats_delta_),
   };
   ResponseHeader_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -191,7 +233,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ResponseHeader));
-  ContainsRequest_descriptor_ = file->message_type(3);
+  ContainsRequest_descriptor_ = file->message_type(4);
   static const int ContainsRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ContainsRequest, header_),
   };
@@ -206,7 +248,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ContainsRequest));
-  ContainsResponse_descriptor_ = file->message_type(4);
+  ContainsResponse_descriptor_ = file->message_type(5);
   static const int ContainsResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ContainsResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ContainsResponse, exists_),
@@ -222,9 +264,10 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ContainsResponse));
-  GetRequest_descriptor_ = file->message_type(5);
-  static const int GetRequest_offsets_[1] = {
+  GetRequest_descriptor_ = file->message_type(6);
+  static const int GetRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRequest, lock_),
   };
   GetRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -237,7 +280,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GetRequest));
-  GetResponse_descriptor_ = file->message_type(6);
+  GetResponse_descriptor_ = file->message_type(7);
   static const int GetResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetResponse, value_),
@@ -253,10 +296,11 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GetResponse));
-  PutRequest_descriptor_ = file->message_type(7);
-  static const int PutRequest_offsets_[2] = {
+  PutRequest_descriptor_ = file->message_type(8);
+  static const int PutRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRequest, value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRequest, coalesce_),
   };
   PutRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -269,7 +313,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(PutRequest));
-  PutResponse_descriptor_ = file->message_type(8);
+  PutResponse_descriptor_ = file->message_type(9);
   static const int PutResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutResponse, header_),
   };
@@ -284,7 +328,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(PutResponse));
-  ConditionalPutRequest_descriptor_ = file->message_type(9);
+  ConditionalPutRequest_descriptor_ = file->message_type(10);
   static const int ConditionalPutRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutRequest, value_),
@@ -301,7 +345,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ConditionalPutRequest));
-  ConditionalPutResponse_descriptor_ = file->message_type(10);
+  ConditionalPutResponse_descriptor_ = file->message_type(11);
   static const int ConditionalPutResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalPutResponse, header_),
   };
@@ -316,10 +360,12 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ConditionalPutResponse));
-  IncrementRequest_descriptor_ = file->message_type(11);
-  static const int IncrementRequest_offsets_[2] = {
+  IncrementRequest_descriptor_ = file->message_type(12);
+  static const int IncrementRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementRequest, increment_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementRequest, initial_value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementRequest, max_),
   };
   IncrementRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -332,7 +378,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(IncrementRequest));
-  IncrementResponse_descriptor_ = file->message_type(12);
+  IncrementResponse_descriptor_ = file->message_type(13);
   static const int IncrementResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(IncrementResponse, new_value_),
@@ -348,7 +394,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(IncrementResponse));
-  DeleteRequest_descriptor_ = file->message_type(13);
+  DeleteRequest_descriptor_ = file->message_type(14);
   static const int DeleteRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRequest, header_),
   };
@@ -363,7 +409,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteRequest));
-  DeleteResponse_descriptor_ = file->message_type(14);
+  DeleteResponse_descriptor_ = file->message_type(15);
   static const int DeleteResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteResponse, header_),
   };
@@ -378,7 +424,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteResponse));
-  DeleteRangeRequest_descriptor_ = file->message_type(15);
+  DeleteRangeRequest_descriptor_ = file->message_type(16);
   static const int DeleteRangeRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeRequest, max_entries_to_delete_),
@@ -394,7 +440,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteRangeRequest));
-  DeleteRangeResponse_descriptor_ = file->message_type(16);
+  DeleteRangeResponse_descriptor_ = file->message_type(17);
   static const int DeleteRangeResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeResponse, num_deleted_),
@@ -410,10 +456,14 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(DeleteRangeResponse));
-  ScanRequest_descriptor_ = file->message_type(17);
-  static const int ScanRequest_offsets_[2] = {
+  ScanRequest_descriptor_ = file->message_type(18);
+  static const int ScanRequest_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, max_results_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, order_by_timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, resume_token_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, parallelism_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, return_stats_),
   };
   ScanRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -426,10 +476,16 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ScanRequest));
-  ScanResponse_descriptor_ = file->message_type(18);
-  static const int ScanResponse_offsets_[2] = {
+  ScanResponse_descriptor_ = file->message_type(19);
+  static const int ScanResponse_offsets_[8] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, rows_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, keys_examined_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, versions_examined_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, versions_skipped_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, intents_encountered_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, resume_token_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, tombstones_skipped_),
   };
   ScanResponse_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -442,11 +498,14 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ScanResponse));
-  EndTransactionRequest_descriptor_ = file->message_type(19);
-  static const int EndTransactionRequest_offsets_[3] = {
+  EndTransactionRequest_descriptor_ = file->message_type(20);
+  static const int EndTransactionRequest_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, commit_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, internal_commit_trigger_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, durable_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, intents_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, refresh_spans_),
   };
   EndTransactionRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -459,10 +518,12 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EndTransactionRequest));
-  EndTransactionResponse_descriptor_ = file->message_type(20);
-  static const int EndTransactionResponse_offsets_[2] = {
+  EndTransactionResponse_descriptor_ = file->message_type(21);
+  static const int EndTransactionResponse_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, commit_wait_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, restarts_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, commit_timestamp_),
   };
   EndTransactionResponse_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -475,7 +536,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EndTransactionResponse));
-  ReapQueueRequest_descriptor_ = file->message_type(21);
+  ReapQueueRequest_descriptor_ = file->message_type(22);
   static const int ReapQueueRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueRequest, max_results_),
@@ -491,7 +552,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ReapQueueRequest));
-  ReapQueueResponse_descriptor_ = file->message_type(22);
+  ReapQueueResponse_descriptor_ = file->message_type(23);
   static const int ReapQueueResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReapQueueResponse, messages_),
@@ -507,7 +568,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ReapQueueResponse));
-  EnqueueUpdateRequest_descriptor_ = file->message_type(23);
+  EnqueueUpdateRequest_descriptor_ = file->message_type(24);
   static const int EnqueueUpdateRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateRequest, header_),
   };
@@ -522,7 +583,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EnqueueUpdateRequest));
-  EnqueueUpdateResponse_descriptor_ = file->message_type(24);
+  EnqueueUpdateResponse_descriptor_ = file->message_type(25);
   static const int EnqueueUpdateResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueUpdateResponse, header_),
   };
@@ -537,7 +598,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EnqueueUpdateResponse));
-  EnqueueMessageRequest_descriptor_ = file->message_type(25);
+  EnqueueMessageRequest_descriptor_ = file->message_type(26);
   static const int EnqueueMessageRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageRequest, msg_),
@@ -553,7 +614,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EnqueueMessageRequest));
-  EnqueueMessageResponse_descriptor_ = file->message_type(26);
+  EnqueueMessageResponse_descriptor_ = file->message_type(27);
   static const int EnqueueMessageResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EnqueueMessageResponse, header_),
   };
@@ -568,8 +629,71 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(EnqueueMessageResponse));
-  RequestUnion_descriptor_ = file->message_type(27);
-  static const int RequestUnion_offsets_[12] = {
+  ReverseScanRequest_descriptor_ = file->message_type(28);
+  static const int ReverseScanRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, max_results_),
+  };
+  ReverseScanRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ReverseScanRequest_descriptor_,
+      ReverseScanRequest::default_instance_,
+      ReverseScanRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ReverseScanRequest));
+  ReverseScanResponse_descriptor_ = file->message_type(29);
+  static const int ReverseScanResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, rows_),
+  };
+  ReverseScanResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ReverseScanResponse_descriptor_,
+      ReverseScanResponse::default_instance_,
+      ReverseScanResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ReverseScanResponse));
+  ConditionalDeleteRequest_descriptor_ = file->message_type(30);
+  static const int ConditionalDeleteRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalDeleteRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalDeleteRequest, exp_value_),
+  };
+  ConditionalDeleteRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ConditionalDeleteRequest_descriptor_,
+      ConditionalDeleteRequest::default_instance_,
+      ConditionalDeleteRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalDeleteRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalDeleteRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ConditionalDeleteRequest));
+  ConditionalDeleteResponse_descriptor_ = file->message_type(31);
+  static const int ConditionalDeleteResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalDeleteResponse, header_),
+  };
+  ConditionalDeleteResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ConditionalDeleteResponse_descriptor_,
+      ConditionalDeleteResponse::default_instance_,
+      ConditionalDeleteResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalDeleteResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConditionalDeleteResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ConditionalDeleteResponse));
+  RequestUnion_descriptor_ = file->message_type(32);
+  static const int RequestUnion_offsets_[14] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, contains_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, get_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, put_),
@@ -582,6 +706,8 @@ void protobuf_AssignDesc_api_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, reap_queue_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, enqueue_update_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, enqueue_message_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, reverse_scan_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, conditional_delete_),
   };
   RequestUnion_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -594,8 +720,8 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(RequestUnion));
-  ResponseUnion_descriptor_ = file->message_type(28);
-  static const int ResponseUnion_offsets_[12] = {
+  ResponseUnion_descriptor_ = file->message_type(33);
+  static const int ResponseUnion_offsets_[14] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, contains_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, get_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, put_),
@@ -608,6 +734,8 @@ void protobuf_AssignDesc_api_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, reap_queue_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, enqueue_update_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, enqueue_message_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, reverse_scan_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, conditional_delete_),
   };
   ResponseUnion_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -620,7 +748,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ResponseUnion));
-  BatchRequest_descriptor_ = file->message_type(29);
+  BatchRequest_descriptor_ = file->message_type(34);
   static const int BatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, requests_),
@@ -636,10 +764,11 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(BatchRequest));
-  BatchResponse_descriptor_ = file->message_type(30);
-  static const int BatchResponse_offsets_[2] = {
+  BatchResponse_descriptor_ = file->message_type(35);
+  static const int BatchResponse_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, responses_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, completed_),
   };
   BatchResponse_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -652,10 +781,11 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(BatchResponse));
-  AdminSplitRequest_descriptor_ = file->message_type(31);
-  static const int AdminSplitRequest_offsets_[2] = {
+  AdminSplitRequest_descriptor_ = file->message_type(36);
+  static const int AdminSplitRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, split_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, dry_run_),
   };
   AdminSplitRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -668,9 +798,12 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AdminSplitRequest));
-  AdminSplitResponse_descriptor_ = file->message_type(32);
-  static const int AdminSplitResponse_offsets_[1] = {
+  AdminSplitResponse_descriptor_ = file->message_type(37);
+  static const int AdminSplitResponse_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, split_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, left_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, right_bytes_),
   };
   AdminSplitResponse_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -683,7 +816,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AdminSplitResponse));
-  AdminMergeRequest_descriptor_ = file->message_type(33);
+  AdminMergeRequest_descriptor_ = file->message_type(38);
   static const int AdminMergeRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, subsumed_range_),
@@ -699,7 +832,7 @@ void protobuf_AssignDesc_api_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AdminMergeRequest));
-  AdminMergeResponse_descriptor_ = file->message_type(34);
+  AdminMergeResponse_descriptor_ = file->message_type(39);
   static const int AdminMergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeResponse, header_),
   };
@@ -730,6 +863,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     ClientCmdID_descriptor_, &ClientCmdID::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     RequestHeader_descriptor_, &RequestHeader::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    MVCCStatsDelta_descriptor_, &MVCCStatsDelta::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ResponseHeader_descriptor_, &ResponseHeader::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -780,6 +915,14 @@ void protobuf_RegisterTypes(const ::std::string&) {
     EnqueueMessageRequest_descriptor_, &EnqueueMessageRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     EnqueueMessageResponse_descriptor_, &EnqueueMessageResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ReverseScanRequest_descriptor_, &ReverseScanRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ReverseScanResponse_descriptor_, &ReverseScanResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ConditionalDeleteRequest_descriptor_, &ConditionalDeleteRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ConditionalDeleteResponse_descriptor_, &ConditionalDeleteResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     RequestUnion_descriptor_, &RequestUnion::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -805,6 +948,8 @@ void protobuf_ShutdownFile_api_2eproto() {
   delete ClientCmdID_reflection_;
   delete RequestHeader::default_instance_;
   delete RequestHeader_reflection_;
+  delete MVCCStatsDelta::default_instance_;
+  delete MVCCStatsDelta_reflection_;
   delete ResponseHeader::default_instance_;
   delete ResponseHeader_reflection_;
   delete ContainsRequest::default_instance_;
@@ -855,6 +1000,14 @@ void protobuf_ShutdownFile_api_2eproto() {
   delete EnqueueMessageRequest_reflection_;
   delete EnqueueMessageResponse::default_instance_;
   delete EnqueueMessageResponse_reflection_;
+  delete ReverseScanRequest::default_instance_;
+  delete ReverseScanRequest_reflection_;
+  delete ReverseScanResponse::default_instance_;
+  delete ReverseScanResponse_reflection_;
+  delete ConditionalDeleteRequest::default_instance_;
+  delete ConditionalDeleteRequest_reflection_;
+  delete ConditionalDeleteResponse::default_instance_;
+  delete ConditionalDeleteResponse_reflection_;
   delete RequestUnion::default_instance_;
   delete RequestUnion_reflection_;
   delete ResponseUnion::default_instance_;
@@ -888,7 +1041,7 @@ void protobuf_AddDesc_api_2eproto() {
     "roto\032\014errors.proto\032-github.com/gogo/prot"
     "obuf/gogoproto/gogo.proto\"<\n\013ClientCmdID"
     "\022\027\n\twall_time\030\001 \001(\003B\004\310\336\037\000\022\024\n\006random\030\002 \001("
-    "\003B\004\310\336\037\000\"\274\002\n\rRequestHeader\022)\n\ttimestamp\030\001"
+    "\003B\004\310\336\037\000\"\271\003\n\rRequestHeader\022)\n\ttimestamp\030\001"
     " \001(\0132\020.proto.TimestampB\004\310\336\037\000\0221\n\006cmd_id\030\002"
     " \001(\0132\022.proto.ClientCmdIDB\r\310\336\037\000\342\336\037\005CmdID\022"
     "\030\n\003key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034\n\007end_key\030\004 \001"
@@ -896,112 +1049,155 @@ void protobuf_AddDesc_api_2eproto() {
     "eplica\030\006 \001(\0132\016.proto.ReplicaB\004\310\336\037\000\022\037\n\007ra"
     "ft_id\030\007 \001(\003B\016\310\336\037\000\342\336\037\006RaftID\022\030\n\ruser_prio"
     "rity\030\010 \001(\005:\0011\022\037\n\003txn\030\t \001(\0132\022.proto.Trans"
-    "action\"y\n\016ResponseHeader\022\033\n\005error\030\001 \001(\0132"
-    "\014.proto.Error\022)\n\ttimestamp\030\002 \001(\0132\020.proto"
-    ".TimestampB\004\310\336\037\000\022\037\n\003txn\030\003 \001(\0132\022.proto.Tr"
-    "ansaction\"A\n\017ContainsRequest\022.\n\006header\030\001"
-    " \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"Y\n\020"
-    "ContainsResponse\022/\n\006header\030\001 \001(\0132\025.proto"
-    ".ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\024\n\006exists\030\002 \001("
-    "\010B\004\310\336\037\000\"<\n\nGetRequest\022.\n\006header\030\001 \001(\0132\024."
-    "proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"[\n\013GetResp"
-    "onse\022/\n\006header\030\001 \001(\0132\025.proto.ResponseHea"
-    "derB\010\310\336\037\000\320\336\037\001\022\033\n\005value\030\002 \001(\0132\014.proto.Val"
-    "ue\"_\n\nPutRequest\022.\n\006header\030\001 \001(\0132\024.proto"
-    ".RequestHeaderB\010\310\336\037\000\320\336\037\001\022!\n\005value\030\002 \001(\0132"
-    "\014.proto.ValueB\004\310\336\037\000\">\n\013PutResponse\022/\n\006he"
-    "ader\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320"
-    "\336\037\001\"\213\001\n\025ConditionalPutRequest\022.\n\006header\030"
-    "\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022!\n"
-    "\005value\030\002 \001(\0132\014.proto.ValueB\004\310\336\037\000\022\037\n\texp_"
-    "value\030\003 \001(\0132\014.proto.Value\"I\n\026Conditional"
-    "PutResponse\022/\n\006header\030\001 \001(\0132\025.proto.Resp"
-    "onseHeaderB\010\310\336\037\000\320\336\037\001\"[\n\020IncrementRequest"
-    "\022.\n\006header\030\001 \001(\0132\024.proto.RequestHeaderB\010"
-    "\310\336\037\000\320\336\037\001\022\027\n\tincrement\030\002 \001(\003B\004\310\336\037\000\"]\n\021Inc"
-    "rementResponse\022/\n\006header\030\001 \001(\0132\025.proto.R"
-    "esponseHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tnew_value\030\002 \001"
-    "(\003B\004\310\336\037\000\"\?\n\rDeleteRequest\022.\n\006header\030\001 \001("
-    "\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"A\n\016Del"
-    "eteResponse\022/\n\006header\030\001 \001(\0132\025.proto.Resp"
-    "onseHeaderB\010\310\336\037\000\320\336\037\001\"i\n\022DeleteRangeReque"
+    "action\022\036\n\020conflict_timeout\030\n \001(\003B\004\310\336\037\000\022\033"
+    "\n\rmax_staleness\030\013 \001(\003B\004\310\336\037\000\022\025\n\007timeout\030\014"
+    " \001(\003B\004\310\336\037\000\022\'\n\031clamp_to_closed_timestamp\030"
+    "\r \001(\010B\004\310\336\037\000\"\340\001\n\016MVCCStatsDelta\022\030\n\nlive_b"
+    "ytes\030\001 \001(\003B\004\310\336\037\000\022\027\n\tkey_bytes\030\002 \001(\003B\004\310\336\037"
+    "\000\022\027\n\tval_bytes\030\003 \001(\003B\004\310\336\037\000\022\032\n\014intent_byt"
+    "es\030\004 \001(\003B\004\310\336\037\000\022\030\n\nlive_count\030\005 \001(\003B\004\310\336\037\000"
+    "\022\027\n\tkey_count\030\006 \001(\003B\004\310\336\037\000\022\027\n\tval_count\030\007"
+    " \001(\003B\004\310\336\037\000\022\032\n\014intent_count\030\010 \001(\003B\004\310\336\037\000\"\245"
+    "\001\n\016ResponseHeader\022\033\n\005error\030\001 \001(\0132\014.proto"
+    ".Error\022)\n\ttimestamp\030\002 \001(\0132\020.proto.Timest"
+    "ampB\004\310\336\037\000\022\037\n\003txn\030\003 \001(\0132\022.proto.Transacti"
+    "on\022*\n\013stats_delta\030\004 \001(\0132\025.proto.MVCCStat"
+    "sDelta\"A\n\017ContainsRequest\022.\n\006header\030\001 \001("
+    "\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"Y\n\020Con"
+    "tainsResponse\022/\n\006header\030\001 \001(\0132\025.proto.Re"
+    "sponseHeaderB\010\310\336\037\000\320\336\037\001\022\024\n\006exists\030\002 \001(\010B\004"
+    "\310\336\037\000\"P\n\nGetRequest\022.\n\006header\030\001 \001(\0132\024.pro"
+    "to.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\022\n\004lock\030\002 \001(\010"
+    "B\004\310\336\037\000\"[\n\013GetResponse\022/\n\006header\030\001 \001(\0132\025."
+    "proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\033\n\005value\030"
+    "\002 \001(\0132\014.proto.Value\"w\n\nPutRequest\022.\n\006hea"
+    "der\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037"
+    "\001\022!\n\005value\030\002 \001(\0132\014.proto.ValueB\004\310\336\037\000\022\026\n\010"
+    "coalesce\030\003 \001(\010B\004\310\336\037\000\">\n\013PutResponse\022/\n\006h"
+    "eader\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\"\213\001\n\025ConditionalPutRequest\022.\n\006header"
+    "\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022!"
+    "\n\005value\030\002 \001(\0132\014.proto.ValueB\004\310\336\037\000\022\037\n\texp"
+    "_value\030\003 \001(\0132\014.proto.Value\"I\n\026Conditiona"
+    "lPutResponse\022/\n\006header\030\001 \001(\0132\025.proto.Res"
+    "ponseHeaderB\010\310\336\037\000\320\336\037\001\"\205\001\n\020IncrementReque"
     "st\022.\n\006header\030\001 \001(\0132\024.proto.RequestHeader"
-    "B\010\310\336\037\000\320\336\037\001\022#\n\025max_entries_to_delete\030\002 \001("
-    "\003B\004\310\336\037\000\"a\n\023DeleteRangeResponse\022/\n\006header"
+    "B\010\310\336\037\000\320\336\037\001\022\027\n\tincrement\030\002 \001(\003B\004\310\336\037\000\022\033\n\ri"
+    "nitial_value\030\003 \001(\003B\004\310\336\037\000\022\013\n\003max\030\004 \001(\003\"]\n"
+    "\021IncrementResponse\022/\n\006header\030\001 \001(\0132\025.pro"
+    "to.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\027\n\tnew_value"
+    "\030\002 \001(\003B\004\310\336\037\000\"\?\n\rDeleteRequest\022.\n\006header\030"
+    "\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"A\n"
+    "\016DeleteResponse\022/\n\006header\030\001 \001(\0132\025.proto."
+    "ResponseHeaderB\010\310\336\037\000\320\336\037\001\"i\n\022DeleteRangeR"
+    "equest\022.\n\006header\030\001 \001(\0132\024.proto.RequestHe"
+    "aderB\010\310\336\037\000\320\336\037\001\022#\n\025max_entries_to_delete\030"
+    "\002 \001(\003B\004\310\336\037\000\"a\n\023DeleteRangeResponse\022/\n\006he"
+    "ader\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320"
+    "\336\037\001\022\031\n\013num_deleted\030\002 \001(\003B\004\310\336\037\000\"\307\001\n\013ScanR"
+    "equest\022.\n\006header\030\001 \001(\0132\024.proto.RequestHe"
+    "aderB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037"
+    "\000\022 \n\022order_by_timestamp\030\003 \001(\010B\004\310\336\037\000\022\024\n\014r"
+    "esume_token\030\004 \001(\014\022\031\n\013parallelism\030\005 \001(\005B\004"
+    "\310\336\037\000\022\032\n\014return_stats\030\006 \001(\010B\004\310\336\037\000\"\235\002\n\014Sca"
+    "nResponse\022/\n\006header\030\001 \001(\0132\025.proto.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001\022#\n\004rows\030\002 \003(\0132\017.proto"
+    ".KeyValueB\004\310\336\037\000\022\033\n\rkeys_examined\030\003 \001(\003B\004"
+    "\310\336\037\000\022\037\n\021versions_examined\030\004 \001(\003B\004\310\336\037\000\022\036\n"
+    "\020versions_skipped\030\005 \001(\003B\004\310\336\037\000\022!\n\023intents"
+    "_encountered\030\006 \001(\003B\004\310\336\037\000\022\024\n\014resume_token"
+    "\030\007 \001(\014\022 \n\022tombstones_skipped\030\010 \001(\003B\004\310\336\037\000"
+    "\"\376\001\n\025EndTransactionRequest\022.\n\006header\030\001 \001"
+    "(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\024\n\006co"
+    "mmit\030\002 \001(\010B\004\310\336\037\000\022=\n\027internal_commit_trig"
+    "ger\030\003 \001(\0132\034.proto.InternalCommitTrigger\022"
+    "\025\n\007durable\030\004 \001(\010B\004\310\336\037\000\022\034\n\007intents\030\005 \003(\014B"
+    "\013\310\336\037\000\332\336\037\003Key\022+\n\rrefresh_spans\030\006 \003(\0132\016.pr"
+    "oto.KeySpanB\004\310\336\037\000\"\256\001\n\026EndTransactionResp"
+    "onse\022/\n\006header\030\001 \001(\0132\025.proto.ResponseHea"
+    "derB\010\310\336\037\000\320\336\037\001\022\031\n\013commit_wait\030\002 \001(\003B\004\310\336\037\000"
+    "\022\026\n\010restarts\030\003 \001(\005B\004\310\336\037\000\0220\n\020commit_times"
+    "tamp\030\004 \001(\0132\020.proto.TimestampB\004\310\336\037\000\"]\n\020Re"
+    "apQueueRequest\022.\n\006header\030\001 \001(\0132\024.proto.R"
+    "equestHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 "
+    "\001(\003B\004\310\336\037\000\"j\n\021ReapQueueResponse\022/\n\006header"
     "\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022"
-    "\031\n\013num_deleted\030\002 \001(\003B\004\310\336\037\000\"X\n\013ScanReques"
-    "t\022.\n\006header\030\001 \001(\0132\024.proto.RequestHeaderB"
-    "\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000\"d\n\014"
-    "ScanResponse\022/\n\006header\030\001 \001(\0132\025.proto.Res"
-    "ponseHeaderB\010\310\336\037\000\320\336\037\001\022#\n\004rows\030\002 \003(\0132\017.pr"
-    "oto.KeyValueB\004\310\336\037\000\"\234\001\n\025EndTransactionReq"
-    "uest\022.\n\006header\030\001 \001(\0132\024.proto.RequestHead"
-    "erB\010\310\336\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004\310\336\037\000\022=\n\027in"
-    "ternal_commit_trigger\030\003 \001(\0132\034.proto.Inte"
-    "rnalCommitTrigger\"d\n\026EndTransactionRespo"
-    "nse\022/\n\006header\030\001 \001(\0132\025.proto.ResponseHead"
-    "erB\010\310\336\037\000\320\336\037\001\022\031\n\013commit_wait\030\002 \001(\003B\004\310\336\037\000\""
-    "]\n\020ReapQueueRequest\022.\n\006header\030\001 \001(\0132\024.pr"
-    "oto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013max_resul"
-    "ts\030\002 \001(\003B\004\310\336\037\000\"j\n\021ReapQueueResponse\022/\n\006h"
+    "$\n\010messages\030\002 \003(\0132\014.proto.ValueB\004\310\336\037\000\"F\n"
+    "\024EnqueueUpdateRequest\022.\n\006header\030\001 \001(\0132\024."
+    "proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"H\n\025Enqueue"
+    "UpdateResponse\022/\n\006header\030\001 \001(\0132\025.proto.R"
+    "esponseHeaderB\010\310\336\037\000\320\336\037\001\"h\n\025EnqueueMessag"
+    "eRequest\022.\n\006header\030\001 \001(\0132\024.proto.Request"
+    "HeaderB\010\310\336\037\000\320\336\037\001\022\037\n\003msg\030\002 \001(\0132\014.proto.Va"
+    "lueB\004\310\336\037\000\"I\n\026EnqueueMessageResponse\022/\n\006h"
     "eader\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000"
-    "\320\336\037\001\022$\n\010messages\030\002 \003(\0132\014.proto.ValueB\004\310\336"
-    "\037\000\"F\n\024EnqueueUpdateRequest\022.\n\006header\030\001 \001"
-    "(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"H\n\025En"
-    "queueUpdateResponse\022/\n\006header\030\001 \001(\0132\025.pr"
-    "oto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"h\n\025EnqueueM"
-    "essageRequest\022.\n\006header\030\001 \001(\0132\024.proto.Re"
-    "questHeaderB\010\310\336\037\000\320\336\037\001\022\037\n\003msg\030\002 \001(\0132\014.pro"
-    "to.ValueB\004\310\336\037\000\"I\n\026EnqueueMessageResponse"
-    "\022/\n\006header\030\001 \001(\0132\025.proto.ResponseHeaderB"
-    "\010\310\336\037\000\320\336\037\001\"\252\004\n\014RequestUnion\022(\n\010contains\030\001"
-    " \001(\0132\026.proto.ContainsRequest\022\036\n\003get\030\002 \001("
-    "\0132\021.proto.GetRequest\022\036\n\003put\030\003 \001(\0132\021.prot"
-    "o.PutRequest\0225\n\017conditional_put\030\004 \001(\0132\034."
-    "proto.ConditionalPutRequest\022*\n\tincrement"
-    "\030\005 \001(\0132\027.proto.IncrementRequest\022$\n\006delet"
-    "e\030\006 \001(\0132\024.proto.DeleteRequest\022/\n\014delete_"
-    "range\030\007 \001(\0132\031.proto.DeleteRangeRequest\022 "
-    "\n\004scan\030\010 \001(\0132\022.proto.ScanRequest\0225\n\017end_"
-    "transaction\030\t \001(\0132\034.proto.EndTransaction"
-    "Request\022+\n\nreap_queue\030\n \001(\0132\027.proto.Reap"
-    "QueueRequest\0223\n\016enqueue_update\030\013 \001(\0132\033.p"
-    "roto.EnqueueUpdateRequest\0225\n\017enqueue_mes"
-    "sage\030\014 \001(\0132\034.proto.EnqueueMessageRequest"
-    ":\004\310\240\037\001\"\267\004\n\rResponseUnion\022)\n\010contains\030\001 \001"
-    "(\0132\027.proto.ContainsResponse\022\037\n\003get\030\002 \001(\013"
-    "2\022.proto.GetResponse\022\037\n\003put\030\003 \001(\0132\022.prot"
-    "o.PutResponse\0226\n\017conditional_put\030\004 \001(\0132\035"
-    ".proto.ConditionalPutResponse\022+\n\tincreme"
-    "nt\030\005 \001(\0132\030.proto.IncrementResponse\022%\n\006de"
-    "lete\030\006 \001(\0132\025.proto.DeleteResponse\0220\n\014del"
-    "ete_range\030\007 \001(\0132\032.proto.DeleteRangeRespo"
-    "nse\022!\n\004scan\030\010 \001(\0132\023.proto.ScanResponse\0226"
-    "\n\017end_transaction\030\t \001(\0132\035.proto.EndTrans"
-    "actionResponse\022,\n\nreap_queue\030\n \001(\0132\030.pro"
-    "to.ReapQueueResponse\0224\n\016enqueue_update\030\013"
-    " \001(\0132\034.proto.EnqueueUpdateResponse\0226\n\017en"
-    "queue_message\030\014 \001(\0132\035.proto.EnqueueMessa"
-    "geResponse:\004\310\240\037\001\"k\n\014BatchRequest\022.\n\006head"
-    "er\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001"
-    "\022+\n\010requests\030\002 \003(\0132\023.proto.RequestUnionB"
-    "\004\310\336\037\000\"o\n\rBatchResponse\022/\n\006header\030\001 \001(\0132\025"
-    ".proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022-\n\trespo"
-    "nses\030\002 \003(\0132\024.proto.ResponseUnionB\004\310\336\037\000\"c"
-    "\n\021AdminSplitRequest\022.\n\006header\030\001 \001(\0132\024.pr"
-    "oto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\036\n\tsplit_key"
-    "\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\"E\n\022AdminSplitRespons"
-    "e\022/\n\006header\030\001 \001(\0132\025.proto.ResponseHeader"
-    "B\010\310\336\037\000\320\336\037\001\"y\n\021AdminMergeRequest\022.\n\006heade"
-    "r\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022"
-    "4\n\016subsumed_range\030\002 \001(\0132\026.proto.RangeDes"
-    "criptorB\004\310\336\037\000\"E\n\022AdminMergeResponse\022/\n\006h"
-    "eader\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000"
-    "\320\336\037\001", 4524);
+    "\320\336\037\001\"_\n\022ReverseScanRequest\022.\n\006header\030\001 \001"
+    "(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013ma"
+    "x_results\030\002 \001(\003B\004\310\336\037\000\"k\n\023ReverseScanResp"
+    "onse\022/\n\006header\030\001 \001(\0132\025.proto.ResponseHea"
+    "derB\010\310\336\037\000\320\336\037\001\022#\n\004rows\030\002 \003(\0132\017.proto.KeyV"
+    "alueB\004\310\336\037\000\"k\n\030ConditionalDeleteRequest\022."
+    "\n\006header\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336"
+    "\037\000\320\336\037\001\022\037\n\texp_value\030\002 \001(\0132\014.proto.Value\""
+    "L\n\031ConditionalDeleteResponse\022/\n\006header\030\001"
+    " \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\230\005"
+    "\n\014RequestUnion\022(\n\010contains\030\001 \001(\0132\026.proto"
+    ".ContainsRequest\022\036\n\003get\030\002 \001(\0132\021.proto.Ge"
+    "tRequest\022\036\n\003put\030\003 \001(\0132\021.proto.PutRequest"
+    "\0225\n\017conditional_put\030\004 \001(\0132\034.proto.Condit"
+    "ionalPutRequest\022*\n\tincrement\030\005 \001(\0132\027.pro"
+    "to.IncrementRequest\022$\n\006delete\030\006 \001(\0132\024.pr"
+    "oto.DeleteRequest\022/\n\014delete_range\030\007 \001(\0132"
+    "\031.proto.DeleteRangeRequest\022 \n\004scan\030\010 \001(\013"
+    "2\022.proto.ScanRequest\0225\n\017end_transaction\030"
+    "\t \001(\0132\034.proto.EndTransactionRequest\022+\n\nr"
+    "eap_queue\030\n \001(\0132\027.proto.ReapQueueRequest"
+    "\0223\n\016enqueue_update\030\013 \001(\0132\033.proto.Enqueue"
+    "UpdateRequest\0225\n\017enqueue_message\030\014 \001(\0132\034"
+    ".proto.EnqueueMessageRequest\022/\n\014reverse_"
+    "scan\030\r \001(\0132\031.proto.ReverseScanRequest\022;\n"
+    "\022conditional_delete\030\016 \001(\0132\037.proto.Condit"
+    "ionalDeleteRequest:\004\310\240\037\001\"\247\005\n\rResponseUni"
+    "on\022)\n\010contains\030\001 \001(\0132\027.proto.ContainsRes"
+    "ponse\022\037\n\003get\030\002 \001(\0132\022.proto.GetResponse\022\037"
+    "\n\003put\030\003 \001(\0132\022.proto.PutResponse\0226\n\017condi"
+    "tional_put\030\004 \001(\0132\035.proto.ConditionalPutR"
+    "esponse\022+\n\tincrement\030\005 \001(\0132\030.proto.Incre"
+    "mentResponse\022%\n\006delete\030\006 \001(\0132\025.proto.Del"
+    "eteResponse\0220\n\014delete_range\030\007 \001(\0132\032.prot"
+    "o.DeleteRangeResponse\022!\n\004scan\030\010 \001(\0132\023.pr"
+    "oto.ScanResponse\0226\n\017end_transaction\030\t \001("
+    "\0132\035.proto.EndTransactionResponse\022,\n\nreap"
+    "_queue\030\n \001(\0132\030.proto.ReapQueueResponse\0224"
+    "\n\016enqueue_update\030\013 \001(\0132\034.proto.EnqueueUp"
+    "dateResponse\0226\n\017enqueue_message\030\014 \001(\0132\035."
+    "proto.EnqueueMessageResponse\0220\n\014reverse_"
+    "scan\030\r \001(\0132\032.proto.ReverseScanResponse\022<"
+    "\n\022conditional_delete\030\016 \001(\0132 .proto.Condi"
+    "tionalDeleteResponse:\004\310\240\037\001\"k\n\014BatchReque"
+    "st\022.\n\006header\030\001 \001(\0132\024.proto.RequestHeader"
+    "B\010\310\336\037\000\320\336\037\001\022+\n\010requests\030\002 \003(\0132\023.proto.Req"
+    "uestUnionB\004\310\336\037\000\"\210\001\n\rBatchResponse\022/\n\006hea"
+    "der\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336"
+    "\037\001\022-\n\tresponses\030\002 \003(\0132\024.proto.ResponseUn"
+    "ionB\004\310\336\037\000\022\027\n\tcompleted\030\003 \001(\005B\004\310\336\037\000\"z\n\021Ad"
+    "minSplitRequest\022.\n\006header\030\001 \001(\0132\024.proto."
+    "RequestHeaderB\010\310\336\037\000\320\336\037\001\022\036\n\tsplit_key\030\002 \001"
+    "(\014B\013\310\336\037\000\332\336\037\003Key\022\025\n\007dry_run\030\003 \001(\010B\004\310\336\037\000\"\232"
+    "\001\n\022AdminSplitResponse\022/\n\006header\030\001 \001(\0132\025."
+    "proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\036\n\tsplit_"
+    "key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\030\n\nleft_bytes\030\003 \001"
+    "(\003B\004\310\336\037\000\022\031\n\013right_bytes\030\004 \001(\003B\004\310\336\037\000\"y\n\021A"
+    "dminMergeRequest\022.\n\006header\030\001 \001(\0132\024.proto"
+    ".RequestHeaderB\010\310\336\037\000\320\336\037\001\0224\n\016subsumed_ran"
+    "ge\030\002 \001(\0132\026.proto.RangeDescriptorB\004\310\336\037\000\"E"
+    "\n\022AdminMergeResponse\022/\n\006header\030\001 \001(\0132\025.p"
+    "roto.ResponseHeaderB\010\310\336\037\000\320\336\037\001", 6229);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "api.proto", &protobuf_RegisterTypes);
   ClientCmdID::default_instance_ = new ClientCmdID();
   RequestHeader::default_instance_ = new RequestHeader();
+  MVCCStatsDelta::default_instance_ = new MVCCStatsDelta();
   ResponseHeader::default_instance_ = new ResponseHeader();
   ContainsRequest::default_instance_ = new ContainsRequest();
   ContainsResponse::default_instance_ = new ContainsResponse();
@@ -1027,6 +1223,10 @@ void protobuf_AddDesc_api_2eproto() {
   EnqueueUpdateResponse::default_instance_ = new EnqueueUpdateResponse();
   EnqueueMessageRequest::default_instance_ = new EnqueueMessageRequest();
   EnqueueMessageResponse::default_instance_ = new EnqueueMessageResponse();
+  ReverseScanRequest::default_instance_ = new ReverseScanRequest();
+  ReverseScanResponse::default_instance_ = new ReverseScanResponse();
+  ConditionalDeleteRequest::default_instance_ = new ConditionalDeleteRequest();
+  ConditionalDeleteResponse::default_instance_ = new ConditionalDeleteResponse();
   RequestUnion::default_instance_ = new RequestUnion();
   ResponseUnion::default_instance_ = new ResponseUnion();
   BatchRequest::default_instance_ = new BatchRequest();
@@ -1037,6 +1237,7 @@ void protobuf_AddDesc_api_2eproto() {
   AdminMergeResponse::default_instance_ = new AdminMergeResponse();
   ClientCmdID::default_instance_->InitAsDefaultInstance();
   RequestHeader::default_instance_->InitAsDefaultInstance();
+  MVCCStatsDelta::default_instance_->InitAsDefaultInstance();
   ResponseHeader::default_instance_->InitAsDefaultInstance();
   ContainsRequest::default_instance_->InitAsDefaultInstance();
   ContainsResponse::default_instance_->InitAsDefaultInstance();
@@ -1062,6 +1263,10 @@ void protobuf_AddDesc_api_2eproto() {
   EnqueueUpdateResponse::default_instance_->InitAsDefaultInstance();
   EnqueueMessageRequest::default_instance_->InitAsDefaultInstance();
   EnqueueMessageResponse::default_instance_->InitAsDefaultInstance();
+  ReverseScanRequest::default_instance_->InitAsDefaultInstance();
+  ReverseScanResponse::default_instance_->InitAsDefaultInstance();
+  ConditionalDeleteRequest::default_instance_->InitAsDefaultInstance();
+  ConditionalDeleteResponse::default_instance_->InitAsDefaultInstance();
   RequestUnion::default_instance_->InitAsDefaultInstance();
   ResponseUnion::default_instance_->InitAsDefaultInstance();
   BatchRequest::default_instance_->InitAsDefaultInstance();
@@ -1366,6 +1571,10 @@ const int RequestHeader::kReplicaFieldNumber;
 const int RequestHeader::kRaftIdFieldNumber;
 const int RequestHeader::kUserPriorityFieldNumber;
 const int RequestHeader::kTxnFieldNumber;
+const int RequestHeader::kConflictTimeoutFieldNumber;
+const int RequestHeader::kMaxStalenessFieldNumber;
+const int RequestHeader::kTimeoutFieldNumber;
+const int RequestHeader::kClampToClosedTimestampFieldNumber;
 #endif  // !_MSC_VER
 
 RequestHeader::RequestHeader()
@@ -1400,6 +1609,10 @@ void RequestHeader::SharedCtor() {
   raft_id_ = GOOGLE_LONGLONG(0);
   user_priority_ = 1;
   txn_ = NULL;
+  conflict_timeout_ = GOOGLE_LONGLONG(0);
+  max_staleness_ = GOOGLE_LONGLONG(0);
+  timeout_ = GOOGLE_LONGLONG(0);
+  clamp_to_closed_timestamp_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1448,6 +1661,16 @@ RequestHeader* RequestHeader::New() const {
 }
 
 void RequestHeader::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<RequestHeader*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
   if (_has_bits_[0 / 32] & 255) {
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
@@ -1476,9 +1699,17 @@ void RequestHeader::Clear() {
     raft_id_ = GOOGLE_LONGLONG(0);
     user_priority_ = 1;
   }
-  if (has_txn()) {
-    if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  if (_has_bits_[8 / 32] & 7936) {
+    ZR_(clamp_to_closed_timestamp_, timeout_);
+    if (has_txn()) {
+      if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+    }
+    conflict_timeout_ = GOOGLE_LONGLONG(0);
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -1613,6 +1844,66 @@ bool RequestHeader::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(80)) goto parse_conflict_timeout;
+        break;
+      }
+
+      // optional int64 conflict_timeout = 10;
+      case 10: {
+        if (tag == 80) {
+         parse_conflict_timeout:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &conflict_timeout_)));
+          set_has_conflict_timeout();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(88)) goto parse_max_staleness;
+        break;
+      }
+
+      // optional int64 max_staleness = 11;
+      case 11: {
+        if (tag == 88) {
+         parse_max_staleness:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_staleness_)));
+          set_has_max_staleness();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(96)) goto parse_timeout;
+        break;
+      }
+
+      // optional int64 timeout = 12;
+      case 12: {
+        if (tag == 96) {
+         parse_timeout:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &timeout_)));
+          set_has_timeout();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(104)) goto parse_clamp_to_closed_timestamp;
+        break;
+      }
+
+      // optional bool clamp_to_closed_timestamp = 13;
+      case 13: {
+        if (tag == 104) {
+         parse_clamp_to_closed_timestamp:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &clamp_to_closed_timestamp_)));
+          set_has_clamp_to_closed_timestamp();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1698,6 +1989,26 @@ void RequestHeader::SerializeWithCachedSizes(
       9, this->txn(), output);
   }
 
+  // optional int64 conflict_timeout = 10;
+  if (has_conflict_timeout()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(10, this->conflict_timeout(), output);
+  }
+
+  // optional int64 max_staleness = 11;
+  if (has_max_staleness()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(11, this->max_staleness(), output);
+  }
+
+  // optional int64 timeout = 12;
+  if (has_timeout()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(12, this->timeout(), output);
+  }
+
+  // optional bool clamp_to_closed_timestamp = 13;
+  if (has_clamp_to_closed_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(13, this->clamp_to_closed_timestamp(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1771,6 +2082,26 @@ void RequestHeader::SerializeWithCachedSizes(
         9, this->txn(), target);
   }
 
+  // optional int64 conflict_timeout = 10;
+  if (has_conflict_timeout()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(10, this->conflict_timeout(), target);
+  }
+
+  // optional int64 max_staleness = 11;
+  if (has_max_staleness()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(11, this->max_staleness(), target);
+  }
+
+  // optional int64 timeout = 12;
+  if (has_timeout()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(12, this->timeout(), target);
+  }
+
+  // optional bool clamp_to_closed_timestamp = 13;
+  if (has_clamp_to_closed_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(13, this->clamp_to_closed_timestamp(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1848,6 +2179,32 @@ int RequestHeader::ByteSize() const {
           this->txn());
     }
 
+    // optional int64 conflict_timeout = 10;
+    if (has_conflict_timeout()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->conflict_timeout());
+    }
+
+    // optional int64 max_staleness = 11;
+    if (has_max_staleness()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_staleness());
+    }
+
+    // optional int64 timeout = 12;
+    if (has_timeout()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->timeout());
+    }
+
+    // optional bool clamp_to_closed_timestamp = 13;
+    if (has_clamp_to_closed_timestamp()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -1904,6 +2261,18 @@ void RequestHeader::MergeFrom(const RequestHeader& from) {
     if (from.has_txn()) {
       mutable_txn()->::proto::Transaction::MergeFrom(from.txn());
     }
+    if (from.has_conflict_timeout()) {
+      set_conflict_timeout(from.conflict_timeout());
+    }
+    if (from.has_max_staleness()) {
+      set_max_staleness(from.max_staleness());
+    }
+    if (from.has_timeout()) {
+      set_timeout(from.timeout());
+    }
+    if (from.has_clamp_to_closed_timestamp()) {
+      set_clamp_to_closed_timestamp(from.clamp_to_closed_timestamp());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -1936,6 +2305,10 @@ void RequestHeader::Swap(RequestHeader* other) {
     std::swap(raft_id_, other->raft_id_);
     std::swap(user_priority_, other->user_priority_);
     std::swap(txn_, other->txn_);
+    std::swap(conflict_timeout_, other->conflict_timeout_);
+    std::swap(max_staleness_, other->max_staleness_);
+    std::swap(timeout_, other->timeout_);
+    std::swap(clamp_to_closed_timestamp_, other->clamp_to_closed_timestamp_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -1954,129 +2327,220 @@ void RequestHeader::Swap(RequestHeader* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ResponseHeader::kErrorFieldNumber;
-const int ResponseHeader::kTimestampFieldNumber;
-const int ResponseHeader::kTxnFieldNumber;
+const int MVCCStatsDelta::kLiveBytesFieldNumber;
+const int MVCCStatsDelta::kKeyBytesFieldNumber;
+const int MVCCStatsDelta::kValBytesFieldNumber;
+const int MVCCStatsDelta::kIntentBytesFieldNumber;
+const int MVCCStatsDelta::kLiveCountFieldNumber;
+const int MVCCStatsDelta::kKeyCountFieldNumber;
+const int MVCCStatsDelta::kValCountFieldNumber;
+const int MVCCStatsDelta::kIntentCountFieldNumber;
 #endif  // !_MSC_VER
 
-ResponseHeader::ResponseHeader()
+MVCCStatsDelta::MVCCStatsDelta()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ResponseHeader)
+  // @@protoc_insertion_point(constructor:proto.MVCCStatsDelta)
 }
 
-void ResponseHeader::InitAsDefaultInstance() {
-  error_ = const_cast< ::proto::Error*>(&::proto::Error::default_instance());
-  timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
-  txn_ = const_cast< ::proto::Transaction*>(&::proto::Transaction::default_instance());
+void MVCCStatsDelta::InitAsDefaultInstance() {
 }
 
-ResponseHeader::ResponseHeader(const ResponseHeader& from)
+MVCCStatsDelta::MVCCStatsDelta(const MVCCStatsDelta& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ResponseHeader)
+  // @@protoc_insertion_point(copy_constructor:proto.MVCCStatsDelta)
 }
 
-void ResponseHeader::SharedCtor() {
+void MVCCStatsDelta::SharedCtor() {
   _cached_size_ = 0;
-  error_ = NULL;
-  timestamp_ = NULL;
-  txn_ = NULL;
+  live_bytes_ = GOOGLE_LONGLONG(0);
+  key_bytes_ = GOOGLE_LONGLONG(0);
+  val_bytes_ = GOOGLE_LONGLONG(0);
+  intent_bytes_ = GOOGLE_LONGLONG(0);
+  live_count_ = GOOGLE_LONGLONG(0);
+  key_count_ = GOOGLE_LONGLONG(0);
+  val_count_ = GOOGLE_LONGLONG(0);
+  intent_count_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ResponseHeader::~ResponseHeader() {
-  // @@protoc_insertion_point(destructor:proto.ResponseHeader)
+MVCCStatsDelta::~MVCCStatsDelta() {
+  // @@protoc_insertion_point(destructor:proto.MVCCStatsDelta)
   SharedDtor();
 }
 
-void ResponseHeader::SharedDtor() {
+void MVCCStatsDelta::SharedDtor() {
   if (this != default_instance_) {
-    delete error_;
-    delete timestamp_;
-    delete txn_;
   }
 }
 
-void ResponseHeader::SetCachedSize(int size) const {
+void MVCCStatsDelta::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ResponseHeader::descriptor() {
+const ::google::protobuf::Descriptor* MVCCStatsDelta::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ResponseHeader_descriptor_;
+  return MVCCStatsDelta_descriptor_;
 }
 
-const ResponseHeader& ResponseHeader::default_instance() {
+const MVCCStatsDelta& MVCCStatsDelta::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-ResponseHeader* ResponseHeader::default_instance_ = NULL;
+MVCCStatsDelta* MVCCStatsDelta::default_instance_ = NULL;
 
-ResponseHeader* ResponseHeader::New() const {
-  return new ResponseHeader;
+MVCCStatsDelta* MVCCStatsDelta::New() const {
+  return new MVCCStatsDelta;
 }
 
-void ResponseHeader::Clear() {
-  if (_has_bits_[0 / 32] & 7) {
-    if (has_error()) {
-      if (error_ != NULL) error_->::proto::Error::Clear();
-    }
-    if (has_timestamp()) {
-      if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
-    }
-    if (has_txn()) {
-      if (txn_ != NULL) txn_->::proto::Transaction::Clear();
-    }
+void MVCCStatsDelta::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<MVCCStatsDelta*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 255) {
+    ZR_(live_bytes_, intent_count_);
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ResponseHeader::MergePartialFromCodedStream(
+bool MVCCStatsDelta::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ResponseHeader)
+  // @@protoc_insertion_point(parse_start:proto.MVCCStatsDelta)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.Error error = 1;
+      // optional int64 live_bytes = 1;
       case 1: {
-        if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_error()));
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &live_bytes_)));
+          set_has_live_bytes();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_timestamp;
+        if (input->ExpectTag(16)) goto parse_key_bytes;
         break;
       }
 
-      // optional .proto.Timestamp timestamp = 2;
+      // optional int64 key_bytes = 2;
       case 2: {
-        if (tag == 18) {
-         parse_timestamp:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_timestamp()));
+        if (tag == 16) {
+         parse_key_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &key_bytes_)));
+          set_has_key_bytes();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_txn;
+        if (input->ExpectTag(24)) goto parse_val_bytes;
         break;
       }
 
-      // optional .proto.Transaction txn = 3;
+      // optional int64 val_bytes = 3;
       case 3: {
-        if (tag == 26) {
-         parse_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_txn()));
+        if (tag == 24) {
+         parse_val_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &val_bytes_)));
+          set_has_val_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_intent_bytes;
+        break;
+      }
+
+      // optional int64 intent_bytes = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_intent_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &intent_bytes_)));
+          set_has_intent_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_live_count;
+        break;
+      }
+
+      // optional int64 live_count = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_live_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &live_count_)));
+          set_has_live_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_key_count;
+        break;
+      }
+
+      // optional int64 key_count = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_key_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &key_count_)));
+          set_has_key_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_val_count;
+        break;
+      }
+
+      // optional int64 val_count = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_val_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &val_count_)));
+          set_has_val_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(64)) goto parse_intent_count;
+        break;
+      }
+
+      // optional int64 intent_count = 8;
+      case 8: {
+        if (tag == 64) {
+         parse_intent_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &intent_count_)));
+          set_has_intent_count();
         } else {
           goto handle_unusual;
         }
@@ -2098,97 +2562,173 @@ bool ResponseHeader::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ResponseHeader)
+  // @@protoc_insertion_point(parse_success:proto.MVCCStatsDelta)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ResponseHeader)
+  // @@protoc_insertion_point(parse_failure:proto.MVCCStatsDelta)
   return false;
 #undef DO_
 }
 
-void ResponseHeader::SerializeWithCachedSizes(
+void MVCCStatsDelta::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ResponseHeader)
-  // optional .proto.Error error = 1;
-  if (has_error()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->error(), output);
+  // @@protoc_insertion_point(serialize_start:proto.MVCCStatsDelta)
+  // optional int64 live_bytes = 1;
+  if (has_live_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->live_bytes(), output);
   }
 
-  // optional .proto.Timestamp timestamp = 2;
-  if (has_timestamp()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->timestamp(), output);
+  // optional int64 key_bytes = 2;
+  if (has_key_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->key_bytes(), output);
   }
 
-  // optional .proto.Transaction txn = 3;
-  if (has_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->txn(), output);
+  // optional int64 val_bytes = 3;
+  if (has_val_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->val_bytes(), output);
+  }
+
+  // optional int64 intent_bytes = 4;
+  if (has_intent_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->intent_bytes(), output);
+  }
+
+  // optional int64 live_count = 5;
+  if (has_live_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->live_count(), output);
+  }
+
+  // optional int64 key_count = 6;
+  if (has_key_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->key_count(), output);
+  }
+
+  // optional int64 val_count = 7;
+  if (has_val_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(7, this->val_count(), output);
+  }
+
+  // optional int64 intent_count = 8;
+  if (has_intent_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(8, this->intent_count(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.ResponseHeader)
+  // @@protoc_insertion_point(serialize_end:proto.MVCCStatsDelta)
 }
 
-::google::protobuf::uint8* ResponseHeader::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* MVCCStatsDelta::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ResponseHeader)
-  // optional .proto.Error error = 1;
-  if (has_error()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->error(), target);
+  // @@protoc_insertion_point(serialize_to_array_start:proto.MVCCStatsDelta)
+  // optional int64 live_bytes = 1;
+  if (has_live_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->live_bytes(), target);
   }
 
-  // optional .proto.Timestamp timestamp = 2;
-  if (has_timestamp()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->timestamp(), target);
+  // optional int64 key_bytes = 2;
+  if (has_key_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->key_bytes(), target);
   }
 
-  // optional .proto.Transaction txn = 3;
-  if (has_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->txn(), target);
+  // optional int64 val_bytes = 3;
+  if (has_val_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->val_bytes(), target);
+  }
+
+  // optional int64 intent_bytes = 4;
+  if (has_intent_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->intent_bytes(), target);
+  }
+
+  // optional int64 live_count = 5;
+  if (has_live_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->live_count(), target);
+  }
+
+  // optional int64 key_count = 6;
+  if (has_key_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->key_count(), target);
+  }
+
+  // optional int64 val_count = 7;
+  if (has_val_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(7, this->val_count(), target);
+  }
+
+  // optional int64 intent_count = 8;
+  if (has_intent_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(8, this->intent_count(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ResponseHeader)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.MVCCStatsDelta)
   return target;
 }
 
-int ResponseHeader::ByteSize() const {
+int MVCCStatsDelta::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.Error error = 1;
-    if (has_error()) {
+    // optional int64 live_bytes = 1;
+    if (has_live_bytes()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->error());
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->live_bytes());
     }
 
-    // optional .proto.Timestamp timestamp = 2;
-    if (has_timestamp()) {
+    // optional int64 key_bytes = 2;
+    if (has_key_bytes()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->timestamp());
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->key_bytes());
     }
 
-    // optional .proto.Transaction txn = 3;
-    if (has_txn()) {
+    // optional int64 val_bytes = 3;
+    if (has_val_bytes()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->txn());
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->val_bytes());
+    }
+
+    // optional int64 intent_bytes = 4;
+    if (has_intent_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->intent_bytes());
+    }
+
+    // optional int64 live_count = 5;
+    if (has_live_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->live_count());
+    }
+
+    // optional int64 key_count = 6;
+    if (has_key_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->key_count());
+    }
+
+    // optional int64 val_count = 7;
+    if (has_val_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->val_count());
+    }
+
+    // optional int64 intent_count = 8;
+    if (has_intent_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->intent_count());
     }
 
   }
@@ -2203,10 +2743,10 @@ int ResponseHeader::ByteSize() const {
   return total_size;
 }
 
-void ResponseHeader::MergeFrom(const ::google::protobuf::Message& from) {
+void MVCCStatsDelta::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ResponseHeader* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ResponseHeader*>(
+  const MVCCStatsDelta* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const MVCCStatsDelta*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -2215,55 +2755,75 @@ void ResponseHeader::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ResponseHeader::MergeFrom(const ResponseHeader& from) {
+void MVCCStatsDelta::MergeFrom(const MVCCStatsDelta& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_error()) {
-      mutable_error()->::proto::Error::MergeFrom(from.error());
+    if (from.has_live_bytes()) {
+      set_live_bytes(from.live_bytes());
     }
-    if (from.has_timestamp()) {
-      mutable_timestamp()->::proto::Timestamp::MergeFrom(from.timestamp());
+    if (from.has_key_bytes()) {
+      set_key_bytes(from.key_bytes());
     }
-    if (from.has_txn()) {
-      mutable_txn()->::proto::Transaction::MergeFrom(from.txn());
+    if (from.has_val_bytes()) {
+      set_val_bytes(from.val_bytes());
+    }
+    if (from.has_intent_bytes()) {
+      set_intent_bytes(from.intent_bytes());
+    }
+    if (from.has_live_count()) {
+      set_live_count(from.live_count());
+    }
+    if (from.has_key_count()) {
+      set_key_count(from.key_count());
+    }
+    if (from.has_val_count()) {
+      set_val_count(from.val_count());
+    }
+    if (from.has_intent_count()) {
+      set_intent_count(from.intent_count());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ResponseHeader::CopyFrom(const ::google::protobuf::Message& from) {
+void MVCCStatsDelta::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ResponseHeader::CopyFrom(const ResponseHeader& from) {
+void MVCCStatsDelta::CopyFrom(const MVCCStatsDelta& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ResponseHeader::IsInitialized() const {
+bool MVCCStatsDelta::IsInitialized() const {
 
   return true;
 }
 
-void ResponseHeader::Swap(ResponseHeader* other) {
+void MVCCStatsDelta::Swap(MVCCStatsDelta* other) {
   if (other != this) {
-    std::swap(error_, other->error_);
-    std::swap(timestamp_, other->timestamp_);
-    std::swap(txn_, other->txn_);
+    std::swap(live_bytes_, other->live_bytes_);
+    std::swap(key_bytes_, other->key_bytes_);
+    std::swap(val_bytes_, other->val_bytes_);
+    std::swap(intent_bytes_, other->intent_bytes_);
+    std::swap(live_count_, other->live_count_);
+    std::swap(key_count_, other->key_count_);
+    std::swap(val_count_, other->val_count_);
+    std::swap(intent_count_, other->intent_count_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ResponseHeader::GetMetadata() const {
+::google::protobuf::Metadata MVCCStatsDelta::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ResponseHeader_descriptor_;
-  metadata.reflection = ResponseHeader_reflection_;
+  metadata.descriptor = MVCCStatsDelta_descriptor_;
+  metadata.reflection = MVCCStatsDelta_reflection_;
   return metadata;
 }
 
@@ -2271,87 +2831,149 @@ void ResponseHeader::Swap(ResponseHeader* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ContainsRequest::kHeaderFieldNumber;
+const int ResponseHeader::kErrorFieldNumber;
+const int ResponseHeader::kTimestampFieldNumber;
+const int ResponseHeader::kTxnFieldNumber;
+const int ResponseHeader::kStatsDeltaFieldNumber;
 #endif  // !_MSC_VER
 
-ContainsRequest::ContainsRequest()
+ResponseHeader::ResponseHeader()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ContainsRequest)
+  // @@protoc_insertion_point(constructor:proto.ResponseHeader)
 }
 
-void ContainsRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+void ResponseHeader::InitAsDefaultInstance() {
+  error_ = const_cast< ::proto::Error*>(&::proto::Error::default_instance());
+  timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+  txn_ = const_cast< ::proto::Transaction*>(&::proto::Transaction::default_instance());
+  stats_delta_ = const_cast< ::proto::MVCCStatsDelta*>(&::proto::MVCCStatsDelta::default_instance());
 }
 
-ContainsRequest::ContainsRequest(const ContainsRequest& from)
+ResponseHeader::ResponseHeader(const ResponseHeader& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ContainsRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.ResponseHeader)
 }
 
-void ContainsRequest::SharedCtor() {
+void ResponseHeader::SharedCtor() {
   _cached_size_ = 0;
-  header_ = NULL;
+  error_ = NULL;
+  timestamp_ = NULL;
+  txn_ = NULL;
+  stats_delta_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ContainsRequest::~ContainsRequest() {
-  // @@protoc_insertion_point(destructor:proto.ContainsRequest)
+ResponseHeader::~ResponseHeader() {
+  // @@protoc_insertion_point(destructor:proto.ResponseHeader)
   SharedDtor();
 }
 
-void ContainsRequest::SharedDtor() {
+void ResponseHeader::SharedDtor() {
   if (this != default_instance_) {
-    delete header_;
+    delete error_;
+    delete timestamp_;
+    delete txn_;
+    delete stats_delta_;
   }
 }
 
-void ContainsRequest::SetCachedSize(int size) const {
+void ResponseHeader::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ContainsRequest::descriptor() {
+const ::google::protobuf::Descriptor* ResponseHeader::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ContainsRequest_descriptor_;
+  return ResponseHeader_descriptor_;
 }
 
-const ContainsRequest& ContainsRequest::default_instance() {
+const ResponseHeader& ResponseHeader::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-ContainsRequest* ContainsRequest::default_instance_ = NULL;
+ResponseHeader* ResponseHeader::default_instance_ = NULL;
 
-ContainsRequest* ContainsRequest::New() const {
-  return new ContainsRequest;
+ResponseHeader* ResponseHeader::New() const {
+  return new ResponseHeader;
 }
 
-void ContainsRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+void ResponseHeader::Clear() {
+  if (_has_bits_[0 / 32] & 15) {
+    if (has_error()) {
+      if (error_ != NULL) error_->::proto::Error::Clear();
+    }
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+    }
+    if (has_txn()) {
+      if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+    }
+    if (has_stats_delta()) {
+      if (stats_delta_ != NULL) stats_delta_->::proto::MVCCStatsDelta::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ContainsRequest::MergePartialFromCodedStream(
+bool ResponseHeader::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ContainsRequest)
+  // @@protoc_insertion_point(parse_start:proto.ResponseHeader)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.RequestHeader header = 1;
+      // optional .proto.Error error = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_header()));
+               input, mutable_error()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .proto.Timestamp timestamp = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_txn;
+        break;
+      }
+
+      // optional .proto.Transaction txn = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_stats_delta;
+        break;
+      }
+
+      // optional .proto.MVCCStatsDelta stats_delta = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_stats_delta:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_stats_delta()));
         } else {
           goto handle_unusual;
         }
@@ -2373,57 +2995,117 @@ bool ContainsRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ContainsRequest)
+  // @@protoc_insertion_point(parse_success:proto.ResponseHeader)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ContainsRequest)
+  // @@protoc_insertion_point(parse_failure:proto.ResponseHeader)
   return false;
 #undef DO_
 }
 
-void ContainsRequest::SerializeWithCachedSizes(
+void ResponseHeader::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ContainsRequest)
-  // optional .proto.RequestHeader header = 1;
-  if (has_header()) {
+  // @@protoc_insertion_point(serialize_start:proto.ResponseHeader)
+  // optional .proto.Error error = 1;
+  if (has_error()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->header(), output);
+      1, this->error(), output);
+  }
+
+  // optional .proto.Timestamp timestamp = 2;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->timestamp(), output);
+  }
+
+  // optional .proto.Transaction txn = 3;
+  if (has_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->txn(), output);
+  }
+
+  // optional .proto.MVCCStatsDelta stats_delta = 4;
+  if (has_stats_delta()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->stats_delta(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.ContainsRequest)
+  // @@protoc_insertion_point(serialize_end:proto.ResponseHeader)
 }
 
-::google::protobuf::uint8* ContainsRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ResponseHeader::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ContainsRequest)
-  // optional .proto.RequestHeader header = 1;
-  if (has_header()) {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ResponseHeader)
+  // optional .proto.Error error = 1;
+  if (has_error()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        1, this->header(), target);
+        1, this->error(), target);
+  }
+
+  // optional .proto.Timestamp timestamp = 2;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->timestamp(), target);
+  }
+
+  // optional .proto.Transaction txn = 3;
+  if (has_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->txn(), target);
+  }
+
+  // optional .proto.MVCCStatsDelta stats_delta = 4;
+  if (has_stats_delta()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->stats_delta(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ContainsRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ResponseHeader)
   return target;
 }
 
-int ContainsRequest::ByteSize() const {
+int ResponseHeader::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.RequestHeader header = 1;
-    if (has_header()) {
+    // optional .proto.Error error = 1;
+    if (has_error()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->header());
+          this->error());
+    }
+
+    // optional .proto.Timestamp timestamp = 2;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp());
+    }
+
+    // optional .proto.Transaction txn = 3;
+    if (has_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->txn());
+    }
+
+    // optional .proto.MVCCStatsDelta stats_delta = 4;
+    if (has_stats_delta()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->stats_delta());
     }
 
   }
@@ -2438,10 +3120,10 @@ int ContainsRequest::ByteSize() const {
   return total_size;
 }
 
-void ContainsRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ResponseHeader::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ContainsRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ContainsRequest*>(
+  const ResponseHeader* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ResponseHeader*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -2450,47 +3132,59 @@ void ContainsRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ContainsRequest::MergeFrom(const ContainsRequest& from) {
+void ResponseHeader::MergeFrom(const ResponseHeader& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_header()) {
-      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    if (from.has_error()) {
+      mutable_error()->::proto::Error::MergeFrom(from.error());
+    }
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_txn()) {
+      mutable_txn()->::proto::Transaction::MergeFrom(from.txn());
+    }
+    if (from.has_stats_delta()) {
+      mutable_stats_delta()->::proto::MVCCStatsDelta::MergeFrom(from.stats_delta());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ContainsRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ResponseHeader::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ContainsRequest::CopyFrom(const ContainsRequest& from) {
+void ResponseHeader::CopyFrom(const ResponseHeader& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ContainsRequest::IsInitialized() const {
+bool ResponseHeader::IsInitialized() const {
 
   return true;
 }
 
-void ContainsRequest::Swap(ContainsRequest* other) {
+void ResponseHeader::Swap(ResponseHeader* other) {
   if (other != this) {
-    std::swap(header_, other->header_);
+    std::swap(error_, other->error_);
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(txn_, other->txn_);
+    std::swap(stats_delta_, other->stats_delta_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ContainsRequest::GetMetadata() const {
+::google::protobuf::Metadata ResponseHeader::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ContainsRequest_descriptor_;
-  metadata.reflection = ContainsRequest_reflection_;
+  metadata.descriptor = ResponseHeader_descriptor_;
+  metadata.reflection = ResponseHeader_reflection_;
   return metadata;
 }
 
@@ -2498,88 +3192,83 @@ void ContainsRequest::Swap(ContainsRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ContainsResponse::kHeaderFieldNumber;
-const int ContainsResponse::kExistsFieldNumber;
+const int ContainsRequest::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-ContainsResponse::ContainsResponse()
+ContainsRequest::ContainsRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ContainsResponse)
+  // @@protoc_insertion_point(constructor:proto.ContainsRequest)
 }
 
-void ContainsResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+void ContainsRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
 }
 
-ContainsResponse::ContainsResponse(const ContainsResponse& from)
+ContainsRequest::ContainsRequest(const ContainsRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ContainsResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.ContainsRequest)
 }
 
-void ContainsResponse::SharedCtor() {
+void ContainsRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  exists_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ContainsResponse::~ContainsResponse() {
-  // @@protoc_insertion_point(destructor:proto.ContainsResponse)
+ContainsRequest::~ContainsRequest() {
+  // @@protoc_insertion_point(destructor:proto.ContainsRequest)
   SharedDtor();
 }
 
-void ContainsResponse::SharedDtor() {
+void ContainsRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ContainsResponse::SetCachedSize(int size) const {
+void ContainsRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ContainsResponse::descriptor() {
+const ::google::protobuf::Descriptor* ContainsRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ContainsResponse_descriptor_;
+  return ContainsRequest_descriptor_;
 }
 
-const ContainsResponse& ContainsResponse::default_instance() {
+const ContainsRequest& ContainsRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-ContainsResponse* ContainsResponse::default_instance_ = NULL;
+ContainsRequest* ContainsRequest::default_instance_ = NULL;
 
-ContainsResponse* ContainsResponse::New() const {
-  return new ContainsResponse;
+ContainsRequest* ContainsRequest::New() const {
+  return new ContainsRequest;
 }
 
-void ContainsResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
-    }
-    exists_ = false;
+void ContainsRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ContainsResponse::MergePartialFromCodedStream(
+bool ContainsRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ContainsResponse)
+  // @@protoc_insertion_point(parse_start:proto.ContainsRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.ResponseHeader header = 1;
+      // optional .proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -2587,21 +3276,6 @@ bool ContainsResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_exists;
-        break;
-      }
-
-      // optional bool exists = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_exists:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &exists_)));
-          set_has_exists();
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -2620,74 +3294,59 @@ bool ContainsResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ContainsResponse)
+  // @@protoc_insertion_point(parse_success:proto.ContainsRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ContainsResponse)
+  // @@protoc_insertion_point(parse_failure:proto.ContainsRequest)
   return false;
 #undef DO_
 }
 
-void ContainsResponse::SerializeWithCachedSizes(
+void ContainsRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ContainsResponse)
-  // optional .proto.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:proto.ContainsRequest)
+  // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional bool exists = 2;
-  if (has_exists()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->exists(), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.ContainsResponse)
+  // @@protoc_insertion_point(serialize_end:proto.ContainsRequest)
 }
 
-::google::protobuf::uint8* ContainsResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ContainsRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ContainsResponse)
-  // optional .proto.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ContainsRequest)
+  // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, this->header(), target);
   }
 
-  // optional bool exists = 2;
-  if (has_exists()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->exists(), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ContainsResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ContainsRequest)
   return target;
 }
 
-int ContainsResponse::ByteSize() const {
+int ContainsRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.ResponseHeader header = 1;
+    // optional .proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
           this->header());
     }
 
-    // optional bool exists = 2;
-    if (has_exists()) {
-      total_size += 1 + 1;
-    }
-
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -2700,10 +3359,10 @@ int ContainsResponse::ByteSize() const {
   return total_size;
 }
 
-void ContainsResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void ContainsRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ContainsResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ContainsResponse*>(
+  const ContainsRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ContainsRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -2712,51 +3371,47 @@ void ContainsResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ContainsResponse::MergeFrom(const ContainsResponse& from) {
+void ContainsRequest::MergeFrom(const ContainsRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
-    }
-    if (from.has_exists()) {
-      set_exists(from.exists());
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ContainsResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void ContainsRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ContainsResponse::CopyFrom(const ContainsResponse& from) {
+void ContainsRequest::CopyFrom(const ContainsRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ContainsResponse::IsInitialized() const {
+bool ContainsRequest::IsInitialized() const {
 
   return true;
 }
 
-void ContainsResponse::Swap(ContainsResponse* other) {
+void ContainsRequest::Swap(ContainsRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(exists_, other->exists_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ContainsResponse::GetMetadata() const {
+::google::protobuf::Metadata ContainsRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ContainsResponse_descriptor_;
-  metadata.reflection = ContainsResponse_reflection_;
+  metadata.descriptor = ContainsRequest_descriptor_;
+  metadata.reflection = ContainsRequest_reflection_;
   return metadata;
 }
 
@@ -2764,83 +3419,88 @@ void ContainsResponse::Swap(ContainsResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int GetRequest::kHeaderFieldNumber;
+const int ContainsResponse::kHeaderFieldNumber;
+const int ContainsResponse::kExistsFieldNumber;
 #endif  // !_MSC_VER
 
-GetRequest::GetRequest()
+ContainsResponse::ContainsResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.GetRequest)
+  // @@protoc_insertion_point(constructor:proto.ContainsResponse)
 }
 
-void GetRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+void ContainsResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
 }
 
-GetRequest::GetRequest(const GetRequest& from)
+ContainsResponse::ContainsResponse(const ContainsResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.GetRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.ContainsResponse)
 }
 
-void GetRequest::SharedCtor() {
+void ContainsResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  exists_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-GetRequest::~GetRequest() {
-  // @@protoc_insertion_point(destructor:proto.GetRequest)
+ContainsResponse::~ContainsResponse() {
+  // @@protoc_insertion_point(destructor:proto.ContainsResponse)
   SharedDtor();
 }
 
-void GetRequest::SharedDtor() {
+void ContainsResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void GetRequest::SetCachedSize(int size) const {
+void ContainsResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* GetRequest::descriptor() {
+const ::google::protobuf::Descriptor* ContainsResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return GetRequest_descriptor_;
+  return ContainsResponse_descriptor_;
 }
 
-const GetRequest& GetRequest::default_instance() {
+const ContainsResponse& ContainsResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-GetRequest* GetRequest::default_instance_ = NULL;
+ContainsResponse* ContainsResponse::default_instance_ = NULL;
 
-GetRequest* GetRequest::New() const {
-  return new GetRequest;
+ContainsResponse* ContainsResponse::New() const {
+  return new ContainsResponse;
 }
 
-void GetRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+void ContainsResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    exists_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool GetRequest::MergePartialFromCodedStream(
+bool ContainsResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.GetRequest)
+  // @@protoc_insertion_point(parse_start:proto.ContainsResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.RequestHeader header = 1;
+      // optional .proto.ResponseHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -2848,6 +3508,21 @@ bool GetRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_exists;
+        break;
+      }
+
+      // optional bool exists = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_exists:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &exists_)));
+          set_has_exists();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -2866,59 +3541,74 @@ bool GetRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.GetRequest)
+  // @@protoc_insertion_point(parse_success:proto.ContainsResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.GetRequest)
+  // @@protoc_insertion_point(parse_failure:proto.ContainsResponse)
   return false;
 #undef DO_
 }
 
-void GetRequest::SerializeWithCachedSizes(
+void ContainsResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.GetRequest)
-  // optional .proto.RequestHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:proto.ContainsResponse)
+  // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional bool exists = 2;
+  if (has_exists()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->exists(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.GetRequest)
+  // @@protoc_insertion_point(serialize_end:proto.ContainsResponse)
 }
 
-::google::protobuf::uint8* GetRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ContainsResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.GetRequest)
-  // optional .proto.RequestHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ContainsResponse)
+  // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, this->header(), target);
   }
 
+  // optional bool exists = 2;
+  if (has_exists()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->exists(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.GetRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ContainsResponse)
   return target;
 }
 
-int GetRequest::ByteSize() const {
+int ContainsResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.RequestHeader header = 1;
+    // optional .proto.ResponseHeader header = 1;
     if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
           this->header());
     }
 
+    // optional bool exists = 2;
+    if (has_exists()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -2931,10 +3621,10 @@ int GetRequest::ByteSize() const {
   return total_size;
 }
 
-void GetRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ContainsResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const GetRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const GetRequest*>(
+  const ContainsResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ContainsResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -2943,47 +3633,51 @@ void GetRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void GetRequest::MergeFrom(const GetRequest& from) {
+void ContainsResponse::MergeFrom(const ContainsResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_exists()) {
+      set_exists(from.exists());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void GetRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ContainsResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GetRequest::CopyFrom(const GetRequest& from) {
+void ContainsResponse::CopyFrom(const ContainsResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GetRequest::IsInitialized() const {
+bool ContainsResponse::IsInitialized() const {
 
   return true;
 }
 
-void GetRequest::Swap(GetRequest* other) {
+void ContainsResponse::Swap(ContainsResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(exists_, other->exists_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata GetRequest::GetMetadata() const {
+::google::protobuf::Metadata ContainsResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = GetRequest_descriptor_;
-  metadata.reflection = GetRequest_reflection_;
+  metadata.descriptor = ContainsResponse_descriptor_;
+  metadata.reflection = ContainsResponse_reflection_;
   return metadata;
 }
 
@@ -2991,92 +3685,88 @@ void GetRequest::Swap(GetRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int GetResponse::kHeaderFieldNumber;
-const int GetResponse::kValueFieldNumber;
+const int GetRequest::kHeaderFieldNumber;
+const int GetRequest::kLockFieldNumber;
 #endif  // !_MSC_VER
 
-GetResponse::GetResponse()
+GetRequest::GetRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.GetResponse)
+  // @@protoc_insertion_point(constructor:proto.GetRequest)
 }
 
-void GetResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
-  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+void GetRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
 }
 
-GetResponse::GetResponse(const GetResponse& from)
+GetRequest::GetRequest(const GetRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.GetResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.GetRequest)
 }
 
-void GetResponse::SharedCtor() {
+void GetRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  value_ = NULL;
+  lock_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-GetResponse::~GetResponse() {
-  // @@protoc_insertion_point(destructor:proto.GetResponse)
+GetRequest::~GetRequest() {
+  // @@protoc_insertion_point(destructor:proto.GetRequest)
   SharedDtor();
 }
 
-void GetResponse::SharedDtor() {
+void GetRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete value_;
   }
 }
 
-void GetResponse::SetCachedSize(int size) const {
+void GetRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* GetResponse::descriptor() {
+const ::google::protobuf::Descriptor* GetRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return GetResponse_descriptor_;
+  return GetRequest_descriptor_;
 }
 
-const GetResponse& GetResponse::default_instance() {
+const GetRequest& GetRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-GetResponse* GetResponse::default_instance_ = NULL;
+GetRequest* GetRequest::default_instance_ = NULL;
 
-GetResponse* GetResponse::New() const {
-  return new GetResponse;
+GetRequest* GetRequest::New() const {
+  return new GetRequest;
 }
 
-void GetResponse::Clear() {
+void GetRequest::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_header()) {
-      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
-    }
-    if (has_value()) {
-      if (value_ != NULL) value_->::proto::Value::Clear();
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
     }
+    lock_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool GetResponse::MergePartialFromCodedStream(
+bool GetRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.GetResponse)
+  // @@protoc_insertion_point(parse_start:proto.GetRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.ResponseHeader header = 1;
+      // optional .proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -3084,16 +3774,18 @@ bool GetResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_value;
+        if (input->ExpectTag(16)) goto parse_lock;
         break;
       }
 
-      // optional .proto.Value value = 2;
+      // optional bool lock = 2;
       case 2: {
-        if (tag == 18) {
-         parse_value:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_value()));
+        if (tag == 16) {
+         parse_lock:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &lock_)));
+          set_has_lock();
         } else {
           goto handle_unusual;
         }
@@ -3115,77 +3807,72 @@ bool GetResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.GetResponse)
+  // @@protoc_insertion_point(parse_success:proto.GetRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.GetResponse)
+  // @@protoc_insertion_point(parse_failure:proto.GetRequest)
   return false;
 #undef DO_
 }
 
-void GetResponse::SerializeWithCachedSizes(
+void GetRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.GetResponse)
-  // optional .proto.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:proto.GetRequest)
+  // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional .proto.Value value = 2;
-  if (has_value()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->value(), output);
+  // optional bool lock = 2;
+  if (has_lock()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->lock(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.GetResponse)
+  // @@protoc_insertion_point(serialize_end:proto.GetRequest)
 }
 
-::google::protobuf::uint8* GetResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GetRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.GetResponse)
-  // optional .proto.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:proto.GetRequest)
+  // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, this->header(), target);
   }
 
-  // optional .proto.Value value = 2;
-  if (has_value()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->value(), target);
+  // optional bool lock = 2;
+  if (has_lock()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->lock(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.GetResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.GetRequest)
   return target;
 }
 
-int GetResponse::ByteSize() const {
+int GetRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.ResponseHeader header = 1;
+    // optional .proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
           this->header());
     }
 
-    // optional .proto.Value value = 2;
-    if (has_value()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->value());
+    // optional bool lock = 2;
+    if (has_lock()) {
+      total_size += 1 + 1;
     }
 
   }
@@ -3200,10 +3887,10 @@ int GetResponse::ByteSize() const {
   return total_size;
 }
 
-void GetResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void GetRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const GetResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const GetResponse*>(
+  const GetRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const GetRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -3212,51 +3899,51 @@ void GetResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void GetResponse::MergeFrom(const GetResponse& from) {
+void GetRequest::MergeFrom(const GetRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_value()) {
-      mutable_value()->::proto::Value::MergeFrom(from.value());
+    if (from.has_lock()) {
+      set_lock(from.lock());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void GetResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void GetRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void GetResponse::CopyFrom(const GetResponse& from) {
+void GetRequest::CopyFrom(const GetRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool GetResponse::IsInitialized() const {
+bool GetRequest::IsInitialized() const {
 
   return true;
 }
 
-void GetResponse::Swap(GetResponse* other) {
+void GetRequest::Swap(GetRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(value_, other->value_);
+    std::swap(lock_, other->lock_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata GetResponse::GetMetadata() const {
+::google::protobuf::Metadata GetRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = GetResponse_descriptor_;
-  metadata.reflection = GetResponse_reflection_;
+  metadata.descriptor = GetRequest_descriptor_;
+  metadata.reflection = GetRequest_reflection_;
   return metadata;
 }
 
@@ -3264,72 +3951,72 @@ void GetResponse::Swap(GetResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int PutRequest::kHeaderFieldNumber;
-const int PutRequest::kValueFieldNumber;
+const int GetResponse::kHeaderFieldNumber;
+const int GetResponse::kValueFieldNumber;
 #endif  // !_MSC_VER
 
-PutRequest::PutRequest()
+GetResponse::GetResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.PutRequest)
+  // @@protoc_insertion_point(constructor:proto.GetResponse)
 }
 
-void PutRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+void GetResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
   value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
 }
 
-PutRequest::PutRequest(const PutRequest& from)
+GetResponse::GetResponse(const GetResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.PutRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.GetResponse)
 }
 
-void PutRequest::SharedCtor() {
+void GetResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   value_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-PutRequest::~PutRequest() {
-  // @@protoc_insertion_point(destructor:proto.PutRequest)
+GetResponse::~GetResponse() {
+  // @@protoc_insertion_point(destructor:proto.GetResponse)
   SharedDtor();
 }
 
-void PutRequest::SharedDtor() {
+void GetResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
     delete value_;
   }
 }
 
-void PutRequest::SetCachedSize(int size) const {
+void GetResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* PutRequest::descriptor() {
+const ::google::protobuf::Descriptor* GetResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return PutRequest_descriptor_;
+  return GetResponse_descriptor_;
 }
 
-const PutRequest& PutRequest::default_instance() {
+const GetResponse& GetResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-PutRequest* PutRequest::default_instance_ = NULL;
+GetResponse* GetResponse::default_instance_ = NULL;
 
-PutRequest* PutRequest::New() const {
-  return new PutRequest;
+GetResponse* GetResponse::New() const {
+  return new GetResponse;
 }
 
-void PutRequest::Clear() {
+void GetResponse::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_header()) {
-      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
     }
     if (has_value()) {
       if (value_ != NULL) value_->::proto::Value::Clear();
@@ -3339,17 +4026,17 @@ void PutRequest::Clear() {
   mutable_unknown_fields()->Clear();
 }
 
-bool PutRequest::MergePartialFromCodedStream(
+bool GetResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.PutRequest)
+  // @@protoc_insertion_point(parse_start:proto.GetResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.RequestHeader header = 1;
+      // optional .proto.ResponseHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -3388,18 +4075,18 @@ bool PutRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.PutRequest)
+  // @@protoc_insertion_point(parse_success:proto.GetResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.PutRequest)
+  // @@protoc_insertion_point(parse_failure:proto.GetResponse)
   return false;
 #undef DO_
 }
 
-void PutRequest::SerializeWithCachedSizes(
+void GetResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.PutRequest)
-  // optional .proto.RequestHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:proto.GetResponse)
+  // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
@@ -3415,13 +4102,13 @@ void PutRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.PutRequest)
+  // @@protoc_insertion_point(serialize_end:proto.GetResponse)
 }
 
-::google::protobuf::uint8* PutRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* GetResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.PutRequest)
-  // optional .proto.RequestHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:proto.GetResponse)
+  // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
@@ -3439,15 +4126,15 @@ void PutRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.PutRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.GetResponse)
   return target;
 }
 
-int PutRequest::ByteSize() const {
+int GetResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.RequestHeader header = 1;
+    // optional .proto.ResponseHeader header = 1;
     if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
@@ -3473,10 +4160,10 @@ int PutRequest::ByteSize() const {
   return total_size;
 }
 
-void PutRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void GetResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const PutRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const PutRequest*>(
+  const GetResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const GetResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -3485,11 +4172,11 @@ void PutRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void PutRequest::MergeFrom(const PutRequest& from) {
+void GetResponse::MergeFrom(const GetResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
     }
     if (from.has_value()) {
       mutable_value()->::proto::Value::MergeFrom(from.value());
@@ -3498,24 +4185,24 @@ void PutRequest::MergeFrom(const PutRequest& from) {
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void PutRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void GetResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void PutRequest::CopyFrom(const PutRequest& from) {
+void GetResponse::CopyFrom(const GetResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool PutRequest::IsInitialized() const {
+bool GetResponse::IsInitialized() const {
 
   return true;
 }
 
-void PutRequest::Swap(PutRequest* other) {
+void GetResponse::Swap(GetResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
     std::swap(value_, other->value_);
@@ -3525,11 +4212,11 @@ void PutRequest::Swap(PutRequest* other) {
   }
 }
 
-::google::protobuf::Metadata PutRequest::GetMetadata() const {
+::google::protobuf::Metadata GetResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = PutRequest_descriptor_;
-  metadata.reflection = PutRequest_reflection_;
+  metadata.descriptor = GetResponse_descriptor_;
+  metadata.reflection = GetResponse_reflection_;
   return metadata;
 }
 
@@ -3537,83 +4224,95 @@ void PutRequest::Swap(PutRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int PutResponse::kHeaderFieldNumber;
+const int PutRequest::kHeaderFieldNumber;
+const int PutRequest::kValueFieldNumber;
+const int PutRequest::kCoalesceFieldNumber;
 #endif  // !_MSC_VER
 
-PutResponse::PutResponse()
+PutRequest::PutRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.PutResponse)
+  // @@protoc_insertion_point(constructor:proto.PutRequest)
 }
 
-void PutResponse::InitAsDefaultInstance() {
-  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+void PutRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
 }
 
-PutResponse::PutResponse(const PutResponse& from)
+PutRequest::PutRequest(const PutRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.PutResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.PutRequest)
 }
 
-void PutResponse::SharedCtor() {
+void PutRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  value_ = NULL;
+  coalesce_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-PutResponse::~PutResponse() {
-  // @@protoc_insertion_point(destructor:proto.PutResponse)
+PutRequest::~PutRequest() {
+  // @@protoc_insertion_point(destructor:proto.PutRequest)
   SharedDtor();
 }
 
-void PutResponse::SharedDtor() {
+void PutRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete value_;
   }
 }
 
-void PutResponse::SetCachedSize(int size) const {
+void PutRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* PutResponse::descriptor() {
+const ::google::protobuf::Descriptor* PutRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return PutResponse_descriptor_;
+  return PutRequest_descriptor_;
 }
 
-const PutResponse& PutResponse::default_instance() {
+const PutRequest& PutRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-PutResponse* PutResponse::default_instance_ = NULL;
+PutRequest* PutRequest::default_instance_ = NULL;
 
-PutResponse* PutResponse::New() const {
-  return new PutResponse;
+PutRequest* PutRequest::New() const {
+  return new PutRequest;
 }
 
-void PutResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+void PutRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    if (has_value()) {
+      if (value_ != NULL) value_->::proto::Value::Clear();
+    }
+    coalesce_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool PutResponse::MergePartialFromCodedStream(
+bool PutRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.PutResponse)
+  // @@protoc_insertion_point(parse_start:proto.PutRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.ResponseHeader header = 1;
+      // optional .proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
@@ -3621,6 +4320,34 @@ bool PutResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .proto.Value value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_coalesce;
+        break;
+      }
+
+      // optional bool coalesce = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_coalesce:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &coalesce_)));
+          set_has_coalesce();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -3639,59 +4366,94 @@ bool PutResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.PutResponse)
+  // @@protoc_insertion_point(parse_success:proto.PutRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.PutResponse)
+  // @@protoc_insertion_point(parse_failure:proto.PutRequest)
   return false;
 #undef DO_
 }
 
-void PutResponse::SerializeWithCachedSizes(
+void PutRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.PutResponse)
-  // optional .proto.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_start:proto.PutRequest)
+  // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional .proto.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->value(), output);
+  }
+
+  // optional bool coalesce = 3;
+  if (has_coalesce()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->coalesce(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.PutResponse)
+  // @@protoc_insertion_point(serialize_end:proto.PutRequest)
 }
 
-::google::protobuf::uint8* PutResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* PutRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.PutResponse)
-  // optional .proto.ResponseHeader header = 1;
+  // @@protoc_insertion_point(serialize_to_array_start:proto.PutRequest)
+  // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
         1, this->header(), target);
   }
 
+  // optional .proto.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->value(), target);
+  }
+
+  // optional bool coalesce = 3;
+  if (has_coalesce()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->coalesce(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.PutResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.PutRequest)
   return target;
 }
 
-int PutResponse::ByteSize() const {
+int PutRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.ResponseHeader header = 1;
+    // optional .proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
           this->header());
     }
 
+    // optional .proto.Value value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+    // optional bool coalesce = 3;
+    if (has_coalesce()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -3704,10 +4466,10 @@ int PutResponse::ByteSize() const {
   return total_size;
 }
 
-void PutResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void PutRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const PutResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const PutResponse*>(
+  const PutRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const PutRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -3716,47 +4478,55 @@ void PutResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void PutResponse::MergeFrom(const PutResponse& from) {
+void PutRequest::MergeFrom(const PutRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
-      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_value()) {
+      mutable_value()->::proto::Value::MergeFrom(from.value());
+    }
+    if (from.has_coalesce()) {
+      set_coalesce(from.coalesce());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void PutResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void PutRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void PutResponse::CopyFrom(const PutResponse& from) {
+void PutRequest::CopyFrom(const PutRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool PutResponse::IsInitialized() const {
+bool PutRequest::IsInitialized() const {
 
   return true;
 }
 
-void PutResponse::Swap(PutResponse* other) {
+void PutRequest::Swap(PutRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(value_, other->value_);
+    std::swap(coalesce_, other->coalesce_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata PutResponse::GetMetadata() const {
+::google::protobuf::Metadata PutRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = PutResponse_descriptor_;
-  metadata.reflection = PutResponse_reflection_;
+  metadata.descriptor = PutRequest_descriptor_;
+  metadata.reflection = PutRequest_reflection_;
   return metadata;
 }
 
@@ -3764,40 +4534,267 @@ void PutResponse::Swap(PutResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ConditionalPutRequest::kHeaderFieldNumber;
-const int ConditionalPutRequest::kValueFieldNumber;
-const int ConditionalPutRequest::kExpValueFieldNumber;
+const int PutResponse::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-ConditionalPutRequest::ConditionalPutRequest()
+PutResponse::PutResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ConditionalPutRequest)
+  // @@protoc_insertion_point(constructor:proto.PutResponse)
 }
 
-void ConditionalPutRequest::InitAsDefaultInstance() {
-  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
-  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
-  exp_value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+void PutResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
 }
 
-ConditionalPutRequest::ConditionalPutRequest(const ConditionalPutRequest& from)
+PutResponse::PutResponse(const PutResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ConditionalPutRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.PutResponse)
 }
 
-void ConditionalPutRequest::SharedCtor() {
+void PutResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  value_ = NULL;
-  exp_value_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ConditionalPutRequest::~ConditionalPutRequest() {
-  // @@protoc_insertion_point(destructor:proto.ConditionalPutRequest)
+PutResponse::~PutResponse() {
+  // @@protoc_insertion_point(destructor:proto.PutResponse)
+  SharedDtor();
+}
+
+void PutResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void PutResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* PutResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return PutResponse_descriptor_;
+}
+
+const PutResponse& PutResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
+  return *default_instance_;
+}
+
+PutResponse* PutResponse::default_instance_ = NULL;
+
+PutResponse* PutResponse::New() const {
+  return new PutResponse;
+}
+
+void PutResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool PutResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.PutResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.PutResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.PutResponse)
+  return false;
+#undef DO_
+}
+
+void PutResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.PutResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.PutResponse)
+}
+
+::google::protobuf::uint8* PutResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.PutResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.PutResponse)
+  return target;
+}
+
+int PutResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void PutResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const PutResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const PutResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void PutResponse::MergeFrom(const PutResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void PutResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void PutResponse::CopyFrom(const PutResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool PutResponse::IsInitialized() const {
+
+  return true;
+}
+
+void PutResponse::Swap(PutResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata PutResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = PutResponse_descriptor_;
+  metadata.reflection = PutResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ConditionalPutRequest::kHeaderFieldNumber;
+const int ConditionalPutRequest::kValueFieldNumber;
+const int ConditionalPutRequest::kExpValueFieldNumber;
+#endif  // !_MSC_VER
+
+ConditionalPutRequest::ConditionalPutRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ConditionalPutRequest)
+}
+
+void ConditionalPutRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+  exp_value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+}
+
+ConditionalPutRequest::ConditionalPutRequest(const ConditionalPutRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ConditionalPutRequest)
+}
+
+void ConditionalPutRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  value_ = NULL;
+  exp_value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ConditionalPutRequest::~ConditionalPutRequest() {
+  // @@protoc_insertion_point(destructor:proto.ConditionalPutRequest)
   SharedDtor();
 }
 
@@ -4310,6 +5307,8 @@ void ConditionalPutResponse::Swap(ConditionalPutResponse* other) {
 #ifndef _MSC_VER
 const int IncrementRequest::kHeaderFieldNumber;
 const int IncrementRequest::kIncrementFieldNumber;
+const int IncrementRequest::kInitialValueFieldNumber;
+const int IncrementRequest::kMaxFieldNumber;
 #endif  // !_MSC_VER
 
 IncrementRequest::IncrementRequest()
@@ -4333,6 +5332,8 @@ void IncrementRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   increment_ = GOOGLE_LONGLONG(0);
+  initial_value_ = GOOGLE_LONGLONG(0);
+  max_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -4369,12 +5370,26 @@ IncrementRequest* IncrementRequest::New() const {
 }
 
 void IncrementRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<IncrementRequest*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(increment_, max_);
     if (has_header()) {
       if (header_ != NULL) header_->::proto::RequestHeader::Clear();
     }
-    increment_ = GOOGLE_LONGLONG(0);
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -4412,18 +5427,48 @@ bool IncrementRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectAtEnd()) goto success;
+        if (input->ExpectTag(24)) goto parse_initial_value;
         break;
       }
 
-      default: {
-      handle_unusual:
-        if (tag == 0 ||
-            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
-            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
-          goto success;
-        }
-        DO_(::google::protobuf::internal::WireFormat::SkipField(
+      // optional int64 initial_value = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_initial_value:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &initial_value_)));
+          set_has_initial_value();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_max;
+        break;
+      }
+
+      // optional int64 max = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_max:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_)));
+          set_has_max();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
               input, tag, mutable_unknown_fields()));
         break;
       }
@@ -4452,6 +5497,16 @@ void IncrementRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->increment(), output);
   }
 
+  // optional int64 initial_value = 3;
+  if (has_initial_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->initial_value(), output);
+  }
+
+  // optional int64 max = 4;
+  if (has_max()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->max(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -4474,6 +5529,16 @@ void IncrementRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->increment(), target);
   }
 
+  // optional int64 initial_value = 3;
+  if (has_initial_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->initial_value(), target);
+  }
+
+  // optional int64 max = 4;
+  if (has_max()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->max(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -4500,6 +5565,20 @@ int IncrementRequest::ByteSize() const {
           this->increment());
     }
 
+    // optional int64 initial_value = 3;
+    if (has_initial_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->initial_value());
+    }
+
+    // optional int64 max = 4;
+    if (has_max()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -4533,6 +5612,12 @@ void IncrementRequest::MergeFrom(const IncrementRequest& from) {
     if (from.has_increment()) {
       set_increment(from.increment());
     }
+    if (from.has_initial_value()) {
+      set_initial_value(from.initial_value());
+    }
+    if (from.has_max()) {
+      set_max(from.max());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -4558,6 +5643,8 @@ void IncrementRequest::Swap(IncrementRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
     std::swap(increment_, other->increment_);
+    std::swap(initial_value_, other->initial_value_);
+    std::swap(max_, other->max_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -5387,21 +6474,1604 @@ bool DeleteRangeRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_max_entries_to_delete;
+        if (input->ExpectTag(16)) goto parse_max_entries_to_delete;
+        break;
+      }
+
+      // optional int64 max_entries_to_delete = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_entries_to_delete:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_entries_to_delete_)));
+          set_has_max_entries_to_delete();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.DeleteRangeRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.DeleteRangeRequest)
+  return false;
+#undef DO_
+}
+
+void DeleteRangeRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.DeleteRangeRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int64 max_entries_to_delete = 2;
+  if (has_max_entries_to_delete()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_entries_to_delete(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.DeleteRangeRequest)
+}
+
+::google::protobuf::uint8* DeleteRangeRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.DeleteRangeRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional int64 max_entries_to_delete = 2;
+  if (has_max_entries_to_delete()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_entries_to_delete(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.DeleteRangeRequest)
+  return target;
+}
+
+int DeleteRangeRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 max_entries_to_delete = 2;
+    if (has_max_entries_to_delete()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_entries_to_delete());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void DeleteRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const DeleteRangeRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void DeleteRangeRequest::MergeFrom(const DeleteRangeRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_max_entries_to_delete()) {
+      set_max_entries_to_delete(from.max_entries_to_delete());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void DeleteRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void DeleteRangeRequest::CopyFrom(const DeleteRangeRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool DeleteRangeRequest::IsInitialized() const {
+
+  return true;
+}
+
+void DeleteRangeRequest::Swap(DeleteRangeRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(max_entries_to_delete_, other->max_entries_to_delete_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata DeleteRangeRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = DeleteRangeRequest_descriptor_;
+  metadata.reflection = DeleteRangeRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int DeleteRangeResponse::kHeaderFieldNumber;
+const int DeleteRangeResponse::kNumDeletedFieldNumber;
+#endif  // !_MSC_VER
+
+DeleteRangeResponse::DeleteRangeResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.DeleteRangeResponse)
+}
+
+void DeleteRangeResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+DeleteRangeResponse::DeleteRangeResponse(const DeleteRangeResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.DeleteRangeResponse)
+}
+
+void DeleteRangeResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  num_deleted_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+DeleteRangeResponse::~DeleteRangeResponse() {
+  // @@protoc_insertion_point(destructor:proto.DeleteRangeResponse)
+  SharedDtor();
+}
+
+void DeleteRangeResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void DeleteRangeResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* DeleteRangeResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return DeleteRangeResponse_descriptor_;
+}
+
+const DeleteRangeResponse& DeleteRangeResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
+  return *default_instance_;
+}
+
+DeleteRangeResponse* DeleteRangeResponse::default_instance_ = NULL;
+
+DeleteRangeResponse* DeleteRangeResponse::New() const {
+  return new DeleteRangeResponse;
+}
+
+void DeleteRangeResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    num_deleted_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool DeleteRangeResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.DeleteRangeResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_num_deleted;
+        break;
+      }
+
+      // optional int64 num_deleted = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_num_deleted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &num_deleted_)));
+          set_has_num_deleted();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.DeleteRangeResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.DeleteRangeResponse)
+  return false;
+#undef DO_
+}
+
+void DeleteRangeResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.DeleteRangeResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int64 num_deleted = 2;
+  if (has_num_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->num_deleted(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.DeleteRangeResponse)
+}
+
+::google::protobuf::uint8* DeleteRangeResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.DeleteRangeResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional int64 num_deleted = 2;
+  if (has_num_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->num_deleted(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.DeleteRangeResponse)
+  return target;
+}
+
+int DeleteRangeResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 num_deleted = 2;
+    if (has_num_deleted()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->num_deleted());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void DeleteRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const DeleteRangeResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void DeleteRangeResponse::MergeFrom(const DeleteRangeResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_num_deleted()) {
+      set_num_deleted(from.num_deleted());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void DeleteRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void DeleteRangeResponse::CopyFrom(const DeleteRangeResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool DeleteRangeResponse::IsInitialized() const {
+
+  return true;
+}
+
+void DeleteRangeResponse::Swap(DeleteRangeResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(num_deleted_, other->num_deleted_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata DeleteRangeResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = DeleteRangeResponse_descriptor_;
+  metadata.reflection = DeleteRangeResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanRequest::kHeaderFieldNumber;
+const int ScanRequest::kMaxResultsFieldNumber;
+const int ScanRequest::kOrderByTimestampFieldNumber;
+const int ScanRequest::kResumeTokenFieldNumber;
+const int ScanRequest::kParallelismFieldNumber;
+const int ScanRequest::kReturnStatsFieldNumber;
+#endif  // !_MSC_VER
+
+ScanRequest::ScanRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ScanRequest)
+}
+
+void ScanRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+ScanRequest::ScanRequest(const ScanRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ScanRequest)
+}
+
+void ScanRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
+  order_by_timestamp_ = false;
+  resume_token_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  parallelism_ = 0;
+  return_stats_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanRequest::~ScanRequest() {
+  // @@protoc_insertion_point(destructor:proto.ScanRequest)
+  SharedDtor();
+}
+
+void ScanRequest::SharedDtor() {
+  if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete resume_token_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ScanRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanRequest_descriptor_;
+}
+
+const ScanRequest& ScanRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
+  return *default_instance_;
+}
+
+ScanRequest* ScanRequest::default_instance_ = NULL;
+
+ScanRequest* ScanRequest::New() const {
+  return new ScanRequest;
+}
+
+void ScanRequest::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<ScanRequest*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 63) {
+    ZR_(order_by_timestamp_, parallelism_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    max_results_ = GOOGLE_LONGLONG(0);
+    if (has_resume_token()) {
+      if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        resume_token_->clear();
+      }
+    }
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ScanRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ScanRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_order_by_timestamp;
+        break;
+      }
+
+      // optional bool order_by_timestamp = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_order_by_timestamp:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &order_by_timestamp_)));
+          set_has_order_by_timestamp();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_resume_token;
+        break;
+      }
+
+      // optional bytes resume_token = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_resume_token:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_resume_token()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_parallelism;
+        break;
+      }
+
+      // optional int32 parallelism = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_parallelism:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &parallelism_)));
+          set_has_parallelism();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_return_stats;
+        break;
+      }
+
+      // optional bool return_stats = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_return_stats:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &return_stats_)));
+          set_has_return_stats();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ScanRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ScanRequest)
+  return false;
+#undef DO_
+}
+
+void ScanRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ScanRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  }
+
+  // optional bool order_by_timestamp = 3;
+  if (has_order_by_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->order_by_timestamp(), output);
+  }
+
+  // optional bytes resume_token = 4;
+  if (has_resume_token()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->resume_token(), output);
+  }
+
+  // optional int32 parallelism = 5;
+  if (has_parallelism()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(5, this->parallelism(), output);
+  }
+
+  // optional bool return_stats = 6;
+  if (has_return_stats()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(6, this->return_stats(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ScanRequest)
+}
+
+::google::protobuf::uint8* ScanRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ScanRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  }
+
+  // optional bool order_by_timestamp = 3;
+  if (has_order_by_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->order_by_timestamp(), target);
+  }
+
+  // optional bytes resume_token = 4;
+  if (has_resume_token()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->resume_token(), target);
+  }
+
+  // optional int32 parallelism = 5;
+  if (has_parallelism()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(5, this->parallelism(), target);
+  }
+
+  // optional bool return_stats = 6;
+  if (has_return_stats()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(6, this->return_stats(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ScanRequest)
+  return target;
+}
+
+int ScanRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
+    }
+
+    // optional bool order_by_timestamp = 3;
+    if (has_order_by_timestamp()) {
+      total_size += 1 + 1;
+    }
+
+    // optional bytes resume_token = 4;
+    if (has_resume_token()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->resume_token());
+    }
+
+    // optional int32 parallelism = 5;
+    if (has_parallelism()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->parallelism());
+    }
+
+    // optional bool return_stats = 6;
+    if (has_return_stats()) {
+      total_size += 1 + 1;
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ScanRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ScanRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanRequest::MergeFrom(const ScanRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
+    }
+    if (from.has_order_by_timestamp()) {
+      set_order_by_timestamp(from.order_by_timestamp());
+    }
+    if (from.has_resume_token()) {
+      set_resume_token(from.resume_token());
+    }
+    if (from.has_parallelism()) {
+      set_parallelism(from.parallelism());
+    }
+    if (from.has_return_stats()) {
+      set_return_stats(from.return_stats());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanRequest::CopyFrom(const ScanRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ScanRequest::Swap(ScanRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(max_results_, other->max_results_);
+    std::swap(order_by_timestamp_, other->order_by_timestamp_);
+    std::swap(resume_token_, other->resume_token_);
+    std::swap(parallelism_, other->parallelism_);
+    std::swap(return_stats_, other->return_stats_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ScanRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanRequest_descriptor_;
+  metadata.reflection = ScanRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanResponse::kHeaderFieldNumber;
+const int ScanResponse::kRowsFieldNumber;
+const int ScanResponse::kKeysExaminedFieldNumber;
+const int ScanResponse::kVersionsExaminedFieldNumber;
+const int ScanResponse::kVersionsSkippedFieldNumber;
+const int ScanResponse::kIntentsEncounteredFieldNumber;
+const int ScanResponse::kResumeTokenFieldNumber;
+const int ScanResponse::kTombstonesSkippedFieldNumber;
+#endif  // !_MSC_VER
+
+ScanResponse::ScanResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ScanResponse)
+}
+
+void ScanResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+ScanResponse::ScanResponse(const ScanResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ScanResponse)
+}
+
+void ScanResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  keys_examined_ = GOOGLE_LONGLONG(0);
+  versions_examined_ = GOOGLE_LONGLONG(0);
+  versions_skipped_ = GOOGLE_LONGLONG(0);
+  intents_encountered_ = GOOGLE_LONGLONG(0);
+  resume_token_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  tombstones_skipped_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanResponse::~ScanResponse() {
+  // @@protoc_insertion_point(destructor:proto.ScanResponse)
+  SharedDtor();
+}
+
+void ScanResponse::SharedDtor() {
+  if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete resume_token_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ScanResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanResponse_descriptor_;
+}
+
+const ScanResponse& ScanResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
+  return *default_instance_;
+}
+
+ScanResponse* ScanResponse::default_instance_ = NULL;
+
+ScanResponse* ScanResponse::New() const {
+  return new ScanResponse;
+}
+
+void ScanResponse::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<ScanResponse*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 253) {
+    ZR_(keys_examined_, intents_encountered_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    if (has_resume_token()) {
+      if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        resume_token_->clear();
+      }
+    }
+    tombstones_skipped_ = GOOGLE_LONGLONG(0);
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  rows_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ScanResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ScanResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
+        break;
+      }
+
+      // repeated .proto.KeyValue rows = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
+        if (input->ExpectTag(24)) goto parse_keys_examined;
+        break;
+      }
+
+      // optional int64 keys_examined = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_keys_examined:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &keys_examined_)));
+          set_has_keys_examined();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_versions_examined;
+        break;
+      }
+
+      // optional int64 versions_examined = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_versions_examined:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &versions_examined_)));
+          set_has_versions_examined();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_versions_skipped;
+        break;
+      }
+
+      // optional int64 versions_skipped = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_versions_skipped:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &versions_skipped_)));
+          set_has_versions_skipped();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_intents_encountered;
+        break;
+      }
+
+      // optional int64 intents_encountered = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_intents_encountered:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &intents_encountered_)));
+          set_has_intents_encountered();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_resume_token;
+        break;
+      }
+
+      // optional bytes resume_token = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_resume_token:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_resume_token()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(64)) goto parse_tombstones_skipped;
+        break;
+      }
+
+      // optional int64 tombstones_skipped = 8;
+      case 8: {
+        if (tag == 64) {
+         parse_tombstones_skipped:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &tombstones_skipped_)));
+          set_has_tombstones_skipped();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ScanResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ScanResponse)
+  return false;
+#undef DO_
+}
+
+void ScanResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ScanResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->rows(i), output);
+  }
+
+  // optional int64 keys_examined = 3;
+  if (has_keys_examined()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->keys_examined(), output);
+  }
+
+  // optional int64 versions_examined = 4;
+  if (has_versions_examined()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->versions_examined(), output);
+  }
+
+  // optional int64 versions_skipped = 5;
+  if (has_versions_skipped()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->versions_skipped(), output);
+  }
+
+  // optional int64 intents_encountered = 6;
+  if (has_intents_encountered()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->intents_encountered(), output);
+  }
+
+  // optional bytes resume_token = 7;
+  if (has_resume_token()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      7, this->resume_token(), output);
+  }
+
+  // optional int64 tombstones_skipped = 8;
+  if (has_tombstones_skipped()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(8, this->tombstones_skipped(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ScanResponse)
+}
+
+::google::protobuf::uint8* ScanResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ScanResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->rows(i), target);
+  }
+
+  // optional int64 keys_examined = 3;
+  if (has_keys_examined()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->keys_examined(), target);
+  }
+
+  // optional int64 versions_examined = 4;
+  if (has_versions_examined()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->versions_examined(), target);
+  }
+
+  // optional int64 versions_skipped = 5;
+  if (has_versions_skipped()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->versions_skipped(), target);
+  }
+
+  // optional int64 intents_encountered = 6;
+  if (has_intents_encountered()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->intents_encountered(), target);
+  }
+
+  // optional bytes resume_token = 7;
+  if (has_resume_token()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        7, this->resume_token(), target);
+  }
+
+  // optional int64 tombstones_skipped = 8;
+  if (has_tombstones_skipped()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(8, this->tombstones_skipped(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ScanResponse)
+  return target;
+}
+
+int ScanResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 keys_examined = 3;
+    if (has_keys_examined()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->keys_examined());
+    }
+
+    // optional int64 versions_examined = 4;
+    if (has_versions_examined()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->versions_examined());
+    }
+
+    // optional int64 versions_skipped = 5;
+    if (has_versions_skipped()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->versions_skipped());
+    }
+
+    // optional int64 intents_encountered = 6;
+    if (has_intents_encountered()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->intents_encountered());
+    }
+
+    // optional bytes resume_token = 7;
+    if (has_resume_token()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->resume_token());
+    }
+
+    // optional int64 tombstones_skipped = 8;
+    if (has_tombstones_skipped()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->tombstones_skipped());
+    }
+
+  }
+  // repeated .proto.KeyValue rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ScanResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ScanResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanResponse::MergeFrom(const ScanResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  rows_.MergeFrom(from.rows_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_keys_examined()) {
+      set_keys_examined(from.keys_examined());
+    }
+    if (from.has_versions_examined()) {
+      set_versions_examined(from.versions_examined());
+    }
+    if (from.has_versions_skipped()) {
+      set_versions_skipped(from.versions_skipped());
+    }
+    if (from.has_intents_encountered()) {
+      set_intents_encountered(from.intents_encountered());
+    }
+    if (from.has_resume_token()) {
+      set_resume_token(from.resume_token());
+    }
+    if (from.has_tombstones_skipped()) {
+      set_tombstones_skipped(from.tombstones_skipped());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanResponse::CopyFrom(const ScanResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ScanResponse::Swap(ScanResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    rows_.Swap(&other->rows_);
+    std::swap(keys_examined_, other->keys_examined_);
+    std::swap(versions_examined_, other->versions_examined_);
+    std::swap(versions_skipped_, other->versions_skipped_);
+    std::swap(intents_encountered_, other->intents_encountered_);
+    std::swap(resume_token_, other->resume_token_);
+    std::swap(tombstones_skipped_, other->tombstones_skipped_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ScanResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanResponse_descriptor_;
+  metadata.reflection = ScanResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int EndTransactionRequest::kHeaderFieldNumber;
+const int EndTransactionRequest::kCommitFieldNumber;
+const int EndTransactionRequest::kInternalCommitTriggerFieldNumber;
+const int EndTransactionRequest::kDurableFieldNumber;
+const int EndTransactionRequest::kIntentsFieldNumber;
+const int EndTransactionRequest::kRefreshSpansFieldNumber;
+#endif  // !_MSC_VER
+
+EndTransactionRequest::EndTransactionRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.EndTransactionRequest)
+}
+
+void EndTransactionRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+  internal_commit_trigger_ = const_cast< ::proto::InternalCommitTrigger*>(&::proto::InternalCommitTrigger::default_instance());
+}
+
+EndTransactionRequest::EndTransactionRequest(const EndTransactionRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.EndTransactionRequest)
+}
+
+void EndTransactionRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  commit_ = false;
+  internal_commit_trigger_ = NULL;
+  durable_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+EndTransactionRequest::~EndTransactionRequest() {
+  // @@protoc_insertion_point(destructor:proto.EndTransactionRequest)
+  SharedDtor();
+}
+
+void EndTransactionRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete internal_commit_trigger_;
+  }
+}
+
+void EndTransactionRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* EndTransactionRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return EndTransactionRequest_descriptor_;
+}
+
+const EndTransactionRequest& EndTransactionRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
+  return *default_instance_;
+}
+
+EndTransactionRequest* EndTransactionRequest::default_instance_ = NULL;
+
+EndTransactionRequest* EndTransactionRequest::New() const {
+  return new EndTransactionRequest;
+}
+
+void EndTransactionRequest::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<EndTransactionRequest*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(commit_, durable_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    if (has_internal_commit_trigger()) {
+      if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::proto::InternalCommitTrigger::Clear();
+    }
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  intents_.Clear();
+  refresh_spans_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool EndTransactionRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.EndTransactionRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_commit;
+        break;
+      }
+
+      // optional bool commit = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_commit:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &commit_)));
+          set_has_commit();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_internal_commit_trigger;
+        break;
+      }
+
+      // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_internal_commit_trigger:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_commit_trigger()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_durable;
+        break;
+      }
+
+      // optional bool durable = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_durable:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &durable_)));
+          set_has_durable();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_intents;
+        break;
+      }
+
+      // repeated bytes intents = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_intents:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_intents()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_intents;
+        if (input->ExpectTag(50)) goto parse_refresh_spans;
         break;
       }
 
-      // optional int64 max_entries_to_delete = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_max_entries_to_delete:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &max_entries_to_delete_)));
-          set_has_max_entries_to_delete();
+      // repeated .proto.KeySpan refresh_spans = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_refresh_spans:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_refresh_spans()));
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(50)) goto parse_refresh_spans;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -5420,38 +8090,61 @@ bool DeleteRangeRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(parse_success:proto.EndTransactionRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(parse_failure:proto.EndTransactionRequest)
   return false;
 #undef DO_
 }
 
-void DeleteRangeRequest::SerializeWithCachedSizes(
+void EndTransactionRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_start:proto.EndTransactionRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 max_entries_to_delete = 2;
-  if (has_max_entries_to_delete()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_entries_to_delete(), output);
+  // optional bool commit = 2;
+  if (has_commit()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->commit(), output);
+  }
+
+  // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
+  if (has_internal_commit_trigger()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->internal_commit_trigger(), output);
+  }
+
+  // optional bool durable = 4;
+  if (has_durable()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->durable(), output);
+  }
+
+  // repeated bytes intents = 5;
+  for (int i = 0; i < this->intents_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      5, this->intents(i), output);
+  }
+
+  // repeated .proto.KeySpan refresh_spans = 6;
+  for (int i = 0; i < this->refresh_spans_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->refresh_spans(i), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_end:proto.EndTransactionRequest)
 }
 
-::google::protobuf::uint8* DeleteRangeRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* EndTransactionRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.EndTransactionRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -5459,20 +8152,45 @@ void DeleteRangeRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 max_entries_to_delete = 2;
-  if (has_max_entries_to_delete()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_entries_to_delete(), target);
+  // optional bool commit = 2;
+  if (has_commit()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->commit(), target);
+  }
+
+  // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
+  if (has_internal_commit_trigger()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->internal_commit_trigger(), target);
+  }
+
+  // optional bool durable = 4;
+  if (has_durable()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->durable(), target);
+  }
+
+  // repeated bytes intents = 5;
+  for (int i = 0; i < this->intents_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(5, this->intents(i), target);
+  }
+
+  // repeated .proto.KeySpan refresh_spans = 6;
+  for (int i = 0; i < this->refresh_spans_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->refresh_spans(i), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.DeleteRangeRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.EndTransactionRequest)
   return target;
 }
 
-int DeleteRangeRequest::ByteSize() const {
+int EndTransactionRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -5483,14 +8201,39 @@ int DeleteRangeRequest::ByteSize() const {
           this->header());
     }
 
-    // optional int64 max_entries_to_delete = 2;
-    if (has_max_entries_to_delete()) {
+    // optional bool commit = 2;
+    if (has_commit()) {
+      total_size += 1 + 1;
+    }
+
+    // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
+    if (has_internal_commit_trigger()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->max_entries_to_delete());
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_commit_trigger());
+    }
+
+    // optional bool durable = 4;
+    if (has_durable()) {
+      total_size += 1 + 1;
     }
 
   }
+  // repeated bytes intents = 5;
+  total_size += 1 * this->intents_size();
+  for (int i = 0; i < this->intents_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->intents(i));
+  }
+
+  // repeated .proto.KeySpan refresh_spans = 6;
+  total_size += 1 * this->refresh_spans_size();
+  for (int i = 0; i < this->refresh_spans_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->refresh_spans(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -5502,10 +8245,10 @@ int DeleteRangeRequest::ByteSize() const {
   return total_size;
 }
 
-void DeleteRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const DeleteRangeRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeRequest*>(
+  const EndTransactionRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5514,51 +8257,63 @@ void DeleteRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void DeleteRangeRequest::MergeFrom(const DeleteRangeRequest& from) {
+void EndTransactionRequest::MergeFrom(const EndTransactionRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
+  intents_.MergeFrom(from.intents_);
+  refresh_spans_.MergeFrom(from.refresh_spans_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_max_entries_to_delete()) {
-      set_max_entries_to_delete(from.max_entries_to_delete());
+    if (from.has_commit()) {
+      set_commit(from.commit());
+    }
+    if (from.has_internal_commit_trigger()) {
+      mutable_internal_commit_trigger()->::proto::InternalCommitTrigger::MergeFrom(from.internal_commit_trigger());
+    }
+    if (from.has_durable()) {
+      set_durable(from.durable());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void DeleteRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void EndTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void DeleteRangeRequest::CopyFrom(const DeleteRangeRequest& from) {
+void EndTransactionRequest::CopyFrom(const EndTransactionRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool DeleteRangeRequest::IsInitialized() const {
+bool EndTransactionRequest::IsInitialized() const {
 
   return true;
 }
 
-void DeleteRangeRequest::Swap(DeleteRangeRequest* other) {
+void EndTransactionRequest::Swap(EndTransactionRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(max_entries_to_delete_, other->max_entries_to_delete_);
+    std::swap(commit_, other->commit_);
+    std::swap(internal_commit_trigger_, other->internal_commit_trigger_);
+    std::swap(durable_, other->durable_);
+    intents_.Swap(&other->intents_);
+    refresh_spans_.Swap(&other->refresh_spans_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata DeleteRangeRequest::GetMetadata() const {
+::google::protobuf::Metadata EndTransactionRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = DeleteRangeRequest_descriptor_;
-  metadata.reflection = DeleteRangeRequest_reflection_;
+  metadata.descriptor = EndTransactionRequest_descriptor_;
+  metadata.reflection = EndTransactionRequest_reflection_;
   return metadata;
 }
 
@@ -5566,82 +8321,92 @@ void DeleteRangeRequest::Swap(DeleteRangeRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int DeleteRangeResponse::kHeaderFieldNumber;
-const int DeleteRangeResponse::kNumDeletedFieldNumber;
+const int EndTransactionResponse::kHeaderFieldNumber;
+const int EndTransactionResponse::kCommitWaitFieldNumber;
+const int EndTransactionResponse::kRestartsFieldNumber;
+const int EndTransactionResponse::kCommitTimestampFieldNumber;
 #endif  // !_MSC_VER
 
-DeleteRangeResponse::DeleteRangeResponse()
+EndTransactionResponse::EndTransactionResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(constructor:proto.EndTransactionResponse)
 }
 
-void DeleteRangeResponse::InitAsDefaultInstance() {
+void EndTransactionResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+  commit_timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
 }
 
-DeleteRangeResponse::DeleteRangeResponse(const DeleteRangeResponse& from)
+EndTransactionResponse::EndTransactionResponse(const EndTransactionResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.EndTransactionResponse)
 }
 
-void DeleteRangeResponse::SharedCtor() {
+void EndTransactionResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  num_deleted_ = GOOGLE_LONGLONG(0);
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  restarts_ = 0;
+  commit_timestamp_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-DeleteRangeResponse::~DeleteRangeResponse() {
-  // @@protoc_insertion_point(destructor:proto.DeleteRangeResponse)
+EndTransactionResponse::~EndTransactionResponse() {
+  // @@protoc_insertion_point(destructor:proto.EndTransactionResponse)
   SharedDtor();
 }
 
-void DeleteRangeResponse::SharedDtor() {
+void EndTransactionResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete commit_timestamp_;
   }
 }
 
-void DeleteRangeResponse::SetCachedSize(int size) const {
+void EndTransactionResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* DeleteRangeResponse::descriptor() {
+const ::google::protobuf::Descriptor* EndTransactionResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return DeleteRangeResponse_descriptor_;
+  return EndTransactionResponse_descriptor_;
 }
 
-const DeleteRangeResponse& DeleteRangeResponse::default_instance() {
+const EndTransactionResponse& EndTransactionResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-DeleteRangeResponse* DeleteRangeResponse::default_instance_ = NULL;
+EndTransactionResponse* EndTransactionResponse::default_instance_ = NULL;
 
-DeleteRangeResponse* DeleteRangeResponse::New() const {
-  return new DeleteRangeResponse;
+EndTransactionResponse* EndTransactionResponse::New() const {
+  return new EndTransactionResponse;
 }
 
-void DeleteRangeResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+void EndTransactionResponse::Clear() {
+  if (_has_bits_[0 / 32] & 15) {
     if (has_header()) {
       if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
     }
-    num_deleted_ = GOOGLE_LONGLONG(0);
+    commit_wait_ = GOOGLE_LONGLONG(0);
+    restarts_ = 0;
+    if (has_commit_timestamp()) {
+      if (commit_timestamp_ != NULL) commit_timestamp_->::proto::Timestamp::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool DeleteRangeResponse::MergePartialFromCodedStream(
+bool EndTransactionResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(parse_start:proto.EndTransactionResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -5655,18 +8420,46 @@ bool DeleteRangeResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_num_deleted;
+        if (input->ExpectTag(16)) goto parse_commit_wait;
         break;
       }
 
-      // optional int64 num_deleted = 2;
+      // optional int64 commit_wait = 2;
       case 2: {
         if (tag == 16) {
-         parse_num_deleted:
+         parse_commit_wait:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &num_deleted_)));
-          set_has_num_deleted();
+                 input, &commit_wait_)));
+          set_has_commit_wait();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_restarts;
+        break;
+      }
+
+      // optional int32 restarts = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_restarts:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &restarts_)));
+          set_has_restarts();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_commit_timestamp;
+        break;
+      }
+
+      // optional .proto.Timestamp commit_timestamp = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_commit_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_commit_timestamp()));
         } else {
           goto handle_unusual;
         }
@@ -5688,38 +8481,49 @@ bool DeleteRangeResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(parse_success:proto.EndTransactionResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(parse_failure:proto.EndTransactionResponse)
   return false;
 #undef DO_
 }
 
-void DeleteRangeResponse::SerializeWithCachedSizes(
+void EndTransactionResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_start:proto.EndTransactionResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 num_deleted = 2;
-  if (has_num_deleted()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->num_deleted(), output);
+  // optional int64 commit_wait = 2;
+  if (has_commit_wait()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->commit_wait(), output);
+  }
+
+  // optional int32 restarts = 3;
+  if (has_restarts()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->restarts(), output);
+  }
+
+  // optional .proto.Timestamp commit_timestamp = 4;
+  if (has_commit_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->commit_timestamp(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_end:proto.EndTransactionResponse)
 }
 
-::google::protobuf::uint8* DeleteRangeResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* EndTransactionResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.EndTransactionResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -5727,20 +8531,32 @@ void DeleteRangeResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 num_deleted = 2;
-  if (has_num_deleted()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->num_deleted(), target);
+  // optional int64 commit_wait = 2;
+  if (has_commit_wait()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->commit_wait(), target);
+  }
+
+  // optional int32 restarts = 3;
+  if (has_restarts()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->restarts(), target);
+  }
+
+  // optional .proto.Timestamp commit_timestamp = 4;
+  if (has_commit_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->commit_timestamp(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.DeleteRangeResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.EndTransactionResponse)
   return target;
 }
 
-int DeleteRangeResponse::ByteSize() const {
+int EndTransactionResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -5751,11 +8567,25 @@ int DeleteRangeResponse::ByteSize() const {
           this->header());
     }
 
-    // optional int64 num_deleted = 2;
-    if (has_num_deleted()) {
+    // optional int64 commit_wait = 2;
+    if (has_commit_wait()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->commit_wait());
+    }
+
+    // optional int32 restarts = 3;
+    if (has_restarts()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->restarts());
+    }
+
+    // optional .proto.Timestamp commit_timestamp = 4;
+    if (has_commit_timestamp()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->num_deleted());
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->commit_timestamp());
     }
 
   }
@@ -5770,10 +8600,10 @@ int DeleteRangeResponse::ByteSize() const {
   return total_size;
 }
 
-void DeleteRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const DeleteRangeResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const DeleteRangeResponse*>(
+  const EndTransactionResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5782,51 +8612,59 @@ void DeleteRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void DeleteRangeResponse::MergeFrom(const DeleteRangeResponse& from) {
+void EndTransactionResponse::MergeFrom(const EndTransactionResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
     }
-    if (from.has_num_deleted()) {
-      set_num_deleted(from.num_deleted());
+    if (from.has_commit_wait()) {
+      set_commit_wait(from.commit_wait());
+    }
+    if (from.has_restarts()) {
+      set_restarts(from.restarts());
+    }
+    if (from.has_commit_timestamp()) {
+      mutable_commit_timestamp()->::proto::Timestamp::MergeFrom(from.commit_timestamp());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void DeleteRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void EndTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void DeleteRangeResponse::CopyFrom(const DeleteRangeResponse& from) {
+void EndTransactionResponse::CopyFrom(const EndTransactionResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool DeleteRangeResponse::IsInitialized() const {
+bool EndTransactionResponse::IsInitialized() const {
 
   return true;
 }
 
-void DeleteRangeResponse::Swap(DeleteRangeResponse* other) {
+void EndTransactionResponse::Swap(EndTransactionResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(num_deleted_, other->num_deleted_);
+    std::swap(commit_wait_, other->commit_wait_);
+    std::swap(restarts_, other->restarts_);
+    std::swap(commit_timestamp_, other->commit_timestamp_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata DeleteRangeResponse::GetMetadata() const {
+::google::protobuf::Metadata EndTransactionResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = DeleteRangeResponse_descriptor_;
-  metadata.reflection = DeleteRangeResponse_reflection_;
+  metadata.descriptor = EndTransactionResponse_descriptor_;
+  metadata.reflection = EndTransactionResponse_reflection_;
   return metadata;
 }
 
@@ -5834,67 +8672,67 @@ void DeleteRangeResponse::Swap(DeleteRangeResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ScanRequest::kHeaderFieldNumber;
-const int ScanRequest::kMaxResultsFieldNumber;
+const int ReapQueueRequest::kHeaderFieldNumber;
+const int ReapQueueRequest::kMaxResultsFieldNumber;
 #endif  // !_MSC_VER
 
-ScanRequest::ScanRequest()
+ReapQueueRequest::ReapQueueRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ScanRequest)
+  // @@protoc_insertion_point(constructor:proto.ReapQueueRequest)
 }
 
-void ScanRequest::InitAsDefaultInstance() {
+void ReapQueueRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
 }
 
-ScanRequest::ScanRequest(const ScanRequest& from)
+ReapQueueRequest::ReapQueueRequest(const ReapQueueRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ScanRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.ReapQueueRequest)
 }
 
-void ScanRequest::SharedCtor() {
+void ReapQueueRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   max_results_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ScanRequest::~ScanRequest() {
-  // @@protoc_insertion_point(destructor:proto.ScanRequest)
+ReapQueueRequest::~ReapQueueRequest() {
+  // @@protoc_insertion_point(destructor:proto.ReapQueueRequest)
   SharedDtor();
 }
 
-void ScanRequest::SharedDtor() {
+void ReapQueueRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ScanRequest::SetCachedSize(int size) const {
+void ReapQueueRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ScanRequest::descriptor() {
+const ::google::protobuf::Descriptor* ReapQueueRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ScanRequest_descriptor_;
+  return ReapQueueRequest_descriptor_;
 }
 
-const ScanRequest& ScanRequest::default_instance() {
+const ReapQueueRequest& ReapQueueRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-ScanRequest* ScanRequest::default_instance_ = NULL;
+ReapQueueRequest* ReapQueueRequest::default_instance_ = NULL;
 
-ScanRequest* ScanRequest::New() const {
-  return new ScanRequest;
+ReapQueueRequest* ReapQueueRequest::New() const {
+  return new ReapQueueRequest;
 }
 
-void ScanRequest::Clear() {
+void ReapQueueRequest::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_header()) {
       if (header_ != NULL) header_->::proto::RequestHeader::Clear();
@@ -5905,11 +8743,11 @@ void ScanRequest::Clear() {
   mutable_unknown_fields()->Clear();
 }
 
-bool ScanRequest::MergePartialFromCodedStream(
+bool ReapQueueRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ScanRequest)
+  // @@protoc_insertion_point(parse_start:proto.ReapQueueRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -5956,17 +8794,17 @@ bool ScanRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ScanRequest)
+  // @@protoc_insertion_point(parse_success:proto.ReapQueueRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ScanRequest)
+  // @@protoc_insertion_point(parse_failure:proto.ReapQueueRequest)
   return false;
 #undef DO_
 }
 
-void ScanRequest::SerializeWithCachedSizes(
+void ReapQueueRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_start:proto.ReapQueueRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -5982,12 +8820,12 @@ void ScanRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_end:proto.ReapQueueRequest)
 }
 
-::google::protobuf::uint8* ScanRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReapQueueRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ReapQueueRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6004,11 +8842,11 @@ void ScanRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ReapQueueRequest)
   return target;
 }
 
-int ScanRequest::ByteSize() const {
+int ReapQueueRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -6038,10 +8876,10 @@ int ScanRequest::ByteSize() const {
   return total_size;
 }
 
-void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ReapQueueRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ScanRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ScanRequest*>(
+  const ReapQueueRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReapQueueRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6050,7 +8888,7 @@ void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ScanRequest::MergeFrom(const ScanRequest& from) {
+void ReapQueueRequest::MergeFrom(const ReapQueueRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
@@ -6063,24 +8901,24 @@ void ScanRequest::MergeFrom(const ScanRequest& from) {
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ReapQueueRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ScanRequest::CopyFrom(const ScanRequest& from) {
+void ReapQueueRequest::CopyFrom(const ReapQueueRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ScanRequest::IsInitialized() const {
+bool ReapQueueRequest::IsInitialized() const {
 
   return true;
 }
 
-void ScanRequest::Swap(ScanRequest* other) {
+void ReapQueueRequest::Swap(ReapQueueRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
     std::swap(max_results_, other->max_results_);
@@ -6090,11 +8928,11 @@ void ScanRequest::Swap(ScanRequest* other) {
   }
 }
 
-::google::protobuf::Metadata ScanRequest::GetMetadata() const {
+::google::protobuf::Metadata ReapQueueRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ScanRequest_descriptor_;
-  metadata.reflection = ScanRequest_reflection_;
+  metadata.descriptor = ReapQueueRequest_descriptor_;
+  metadata.reflection = ReapQueueRequest_reflection_;
   return metadata;
 }
 
@@ -6102,79 +8940,79 @@ void ScanRequest::Swap(ScanRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ScanResponse::kHeaderFieldNumber;
-const int ScanResponse::kRowsFieldNumber;
+const int ReapQueueResponse::kHeaderFieldNumber;
+const int ReapQueueResponse::kMessagesFieldNumber;
 #endif  // !_MSC_VER
 
-ScanResponse::ScanResponse()
+ReapQueueResponse::ReapQueueResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ScanResponse)
+  // @@protoc_insertion_point(constructor:proto.ReapQueueResponse)
 }
 
-void ScanResponse::InitAsDefaultInstance() {
+void ReapQueueResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
 }
 
-ScanResponse::ScanResponse(const ScanResponse& from)
+ReapQueueResponse::ReapQueueResponse(const ReapQueueResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ScanResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.ReapQueueResponse)
 }
 
-void ScanResponse::SharedCtor() {
+void ReapQueueResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ScanResponse::~ScanResponse() {
-  // @@protoc_insertion_point(destructor:proto.ScanResponse)
+ReapQueueResponse::~ReapQueueResponse() {
+  // @@protoc_insertion_point(destructor:proto.ReapQueueResponse)
   SharedDtor();
 }
 
-void ScanResponse::SharedDtor() {
+void ReapQueueResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ScanResponse::SetCachedSize(int size) const {
+void ReapQueueResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ScanResponse::descriptor() {
+const ::google::protobuf::Descriptor* ReapQueueResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ScanResponse_descriptor_;
+  return ReapQueueResponse_descriptor_;
 }
 
-const ScanResponse& ScanResponse::default_instance() {
+const ReapQueueResponse& ReapQueueResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-ScanResponse* ScanResponse::default_instance_ = NULL;
+ReapQueueResponse* ReapQueueResponse::default_instance_ = NULL;
 
-ScanResponse* ScanResponse::New() const {
-  return new ScanResponse;
+ReapQueueResponse* ReapQueueResponse::New() const {
+  return new ReapQueueResponse;
 }
 
-void ScanResponse::Clear() {
+void ReapQueueResponse::Clear() {
   if (has_header()) {
     if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   }
-  rows_.Clear();
+  messages_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ScanResponse::MergePartialFromCodedStream(
+bool ReapQueueResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ScanResponse)
+  // @@protoc_insertion_point(parse_start:proto.ReapQueueResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -6188,20 +9026,20 @@ bool ScanResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_rows;
+        if (input->ExpectTag(18)) goto parse_messages;
         break;
       }
 
-      // repeated .proto.KeyValue rows = 2;
+      // repeated .proto.Value messages = 2;
       case 2: {
         if (tag == 18) {
-         parse_rows:
+         parse_messages:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-                input, add_rows()));
+                input, add_messages()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_rows;
+        if (input->ExpectTag(18)) goto parse_messages;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6220,39 +9058,39 @@ bool ScanResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ScanResponse)
+  // @@protoc_insertion_point(parse_success:proto.ReapQueueResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ScanResponse)
+  // @@protoc_insertion_point(parse_failure:proto.ReapQueueResponse)
   return false;
 #undef DO_
 }
 
-void ScanResponse::SerializeWithCachedSizes(
+void ReapQueueResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_start:proto.ReapQueueResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // repeated .proto.KeyValue rows = 2;
-  for (int i = 0; i < this->rows_size(); i++) {
+  // repeated .proto.Value messages = 2;
+  for (int i = 0; i < this->messages_size(); i++) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->rows(i), output);
+      2, this->messages(i), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_end:proto.ReapQueueResponse)
 }
 
-::google::protobuf::uint8* ScanResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReapQueueResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ReapQueueResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6260,22 +9098,22 @@ void ScanResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // repeated .proto.KeyValue rows = 2;
-  for (int i = 0; i < this->rows_size(); i++) {
+  // repeated .proto.Value messages = 2;
+  for (int i = 0; i < this->messages_size(); i++) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, this->rows(i), target);
+        2, this->messages(i), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ReapQueueResponse)
   return target;
 }
 
-int ScanResponse::ByteSize() const {
+int ReapQueueResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -6287,12 +9125,12 @@ int ScanResponse::ByteSize() const {
     }
 
   }
-  // repeated .proto.KeyValue rows = 2;
-  total_size += 1 * this->rows_size();
-  for (int i = 0; i < this->rows_size(); i++) {
+  // repeated .proto.Value messages = 2;
+  total_size += 1 * this->messages_size();
+  for (int i = 0; i < this->messages_size(); i++) {
     total_size +=
       ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->rows(i));
+        this->messages(i));
   }
 
   if (!unknown_fields().empty()) {
@@ -6306,10 +9144,10 @@ int ScanResponse::ByteSize() const {
   return total_size;
 }
 
-void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void ReapQueueResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ScanResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ScanResponse*>(
+  const ReapQueueResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReapQueueResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6318,9 +9156,9 @@ void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ScanResponse::MergeFrom(const ScanResponse& from) {
+void ReapQueueResponse::MergeFrom(const ReapQueueResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
-  rows_.MergeFrom(from.rows_);
+  messages_.MergeFrom(from.messages_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
@@ -6329,38 +9167,38 @@ void ScanResponse::MergeFrom(const ScanResponse& from) {
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void ReapQueueResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ScanResponse::CopyFrom(const ScanResponse& from) {
+void ReapQueueResponse::CopyFrom(const ReapQueueResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ScanResponse::IsInitialized() const {
+bool ReapQueueResponse::IsInitialized() const {
 
   return true;
 }
 
-void ScanResponse::Swap(ScanResponse* other) {
+void ReapQueueResponse::Swap(ReapQueueResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    rows_.Swap(&other->rows_);
+    messages_.Swap(&other->messages_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ScanResponse::GetMetadata() const {
+::google::protobuf::Metadata ReapQueueResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ScanResponse_descriptor_;
-  metadata.reflection = ScanResponse_reflection_;
+  metadata.descriptor = ReapQueueResponse_descriptor_;
+  metadata.reflection = ReapQueueResponse_reflection_;
   return metadata;
 }
 
@@ -6368,89 +9206,77 @@ void ScanResponse::Swap(ScanResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EndTransactionRequest::kHeaderFieldNumber;
-const int EndTransactionRequest::kCommitFieldNumber;
-const int EndTransactionRequest::kInternalCommitTriggerFieldNumber;
+const int EnqueueUpdateRequest::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-EndTransactionRequest::EndTransactionRequest()
+EnqueueUpdateRequest::EnqueueUpdateRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(constructor:proto.EnqueueUpdateRequest)
 }
 
-void EndTransactionRequest::InitAsDefaultInstance() {
+void EnqueueUpdateRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
-  internal_commit_trigger_ = const_cast< ::proto::InternalCommitTrigger*>(&::proto::InternalCommitTrigger::default_instance());
 }
 
-EndTransactionRequest::EndTransactionRequest(const EndTransactionRequest& from)
+EnqueueUpdateRequest::EnqueueUpdateRequest(const EnqueueUpdateRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.EnqueueUpdateRequest)
 }
 
-void EndTransactionRequest::SharedCtor() {
+void EnqueueUpdateRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  commit_ = false;
-  internal_commit_trigger_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
-
-EndTransactionRequest::~EndTransactionRequest() {
-  // @@protoc_insertion_point(destructor:proto.EndTransactionRequest)
+
+EnqueueUpdateRequest::~EnqueueUpdateRequest() {
+  // @@protoc_insertion_point(destructor:proto.EnqueueUpdateRequest)
   SharedDtor();
 }
 
-void EndTransactionRequest::SharedDtor() {
+void EnqueueUpdateRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete internal_commit_trigger_;
   }
 }
 
-void EndTransactionRequest::SetCachedSize(int size) const {
+void EnqueueUpdateRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EndTransactionRequest::descriptor() {
+const ::google::protobuf::Descriptor* EnqueueUpdateRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EndTransactionRequest_descriptor_;
+  return EnqueueUpdateRequest_descriptor_;
 }
 
-const EndTransactionRequest& EndTransactionRequest::default_instance() {
+const EnqueueUpdateRequest& EnqueueUpdateRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-EndTransactionRequest* EndTransactionRequest::default_instance_ = NULL;
+EnqueueUpdateRequest* EnqueueUpdateRequest::default_instance_ = NULL;
 
-EndTransactionRequest* EndTransactionRequest::New() const {
-  return new EndTransactionRequest;
+EnqueueUpdateRequest* EnqueueUpdateRequest::New() const {
+  return new EnqueueUpdateRequest;
 }
 
-void EndTransactionRequest::Clear() {
-  if (_has_bits_[0 / 32] & 7) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
-    }
-    commit_ = false;
-    if (has_internal_commit_trigger()) {
-      if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::proto::InternalCommitTrigger::Clear();
-    }
+void EnqueueUpdateRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool EndTransactionRequest::MergePartialFromCodedStream(
+bool EnqueueUpdateRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_start:proto.EnqueueUpdateRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -6464,34 +9290,6 @@ bool EndTransactionRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_commit;
-        break;
-      }
-
-      // optional bool commit = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_commit:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
-                 input, &commit_)));
-          set_has_commit();
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(26)) goto parse_internal_commit_trigger;
-        break;
-      }
-
-      // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_internal_commit_trigger:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_commit_trigger()));
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6510,44 +9308,33 @@ bool EndTransactionRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_success:proto.EnqueueUpdateRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(parse_failure:proto.EnqueueUpdateRequest)
   return false;
 #undef DO_
 }
 
-void EndTransactionRequest::SerializeWithCachedSizes(
+void EnqueueUpdateRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_start:proto.EnqueueUpdateRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional bool commit = 2;
-  if (has_commit()) {
-    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->commit(), output);
-  }
-
-  // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
-  if (has_internal_commit_trigger()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->internal_commit_trigger(), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_end:proto.EnqueueUpdateRequest)
 }
 
-::google::protobuf::uint8* EndTransactionRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* EnqueueUpdateRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueUpdateRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6555,27 +9342,15 @@ void EndTransactionRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional bool commit = 2;
-  if (has_commit()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->commit(), target);
-  }
-
-  // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
-  if (has_internal_commit_trigger()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->internal_commit_trigger(), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.EndTransactionRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueUpdateRequest)
   return target;
 }
 
-int EndTransactionRequest::ByteSize() const {
+int EnqueueUpdateRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -6586,18 +9361,6 @@ int EndTransactionRequest::ByteSize() const {
           this->header());
     }
 
-    // optional bool commit = 2;
-    if (has_commit()) {
-      total_size += 1 + 1;
-    }
-
-    // optional .proto.InternalCommitTrigger internal_commit_trigger = 3;
-    if (has_internal_commit_trigger()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_commit_trigger());
-    }
-
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -6610,10 +9373,10 @@ int EndTransactionRequest::ByteSize() const {
   return total_size;
 }
 
-void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void EnqueueUpdateRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EndTransactionRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionRequest*>(
+  const EnqueueUpdateRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueUpdateRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6622,55 +9385,47 @@ void EndTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void EndTransactionRequest::MergeFrom(const EndTransactionRequest& from) {
+void EnqueueUpdateRequest::MergeFrom(const EnqueueUpdateRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_commit()) {
-      set_commit(from.commit());
-    }
-    if (from.has_internal_commit_trigger()) {
-      mutable_internal_commit_trigger()->::proto::InternalCommitTrigger::MergeFrom(from.internal_commit_trigger());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EndTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void EnqueueUpdateRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EndTransactionRequest::CopyFrom(const EndTransactionRequest& from) {
+void EnqueueUpdateRequest::CopyFrom(const EnqueueUpdateRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EndTransactionRequest::IsInitialized() const {
+bool EnqueueUpdateRequest::IsInitialized() const {
 
   return true;
 }
 
-void EndTransactionRequest::Swap(EndTransactionRequest* other) {
+void EnqueueUpdateRequest::Swap(EnqueueUpdateRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(commit_, other->commit_);
-    std::swap(internal_commit_trigger_, other->internal_commit_trigger_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata EndTransactionRequest::GetMetadata() const {
+::google::protobuf::Metadata EnqueueUpdateRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EndTransactionRequest_descriptor_;
-  metadata.reflection = EndTransactionRequest_reflection_;
+  metadata.descriptor = EnqueueUpdateRequest_descriptor_;
+  metadata.reflection = EnqueueUpdateRequest_reflection_;
   return metadata;
 }
 
@@ -6678,82 +9433,77 @@ void EndTransactionRequest::Swap(EndTransactionRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EndTransactionResponse::kHeaderFieldNumber;
-const int EndTransactionResponse::kCommitWaitFieldNumber;
+const int EnqueueUpdateResponse::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-EndTransactionResponse::EndTransactionResponse()
+EnqueueUpdateResponse::EnqueueUpdateResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(constructor:proto.EnqueueUpdateResponse)
 }
 
-void EndTransactionResponse::InitAsDefaultInstance() {
+void EnqueueUpdateResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
 }
 
-EndTransactionResponse::EndTransactionResponse(const EndTransactionResponse& from)
+EnqueueUpdateResponse::EnqueueUpdateResponse(const EnqueueUpdateResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.EnqueueUpdateResponse)
 }
 
-void EndTransactionResponse::SharedCtor() {
+void EnqueueUpdateResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  commit_wait_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EndTransactionResponse::~EndTransactionResponse() {
-  // @@protoc_insertion_point(destructor:proto.EndTransactionResponse)
+EnqueueUpdateResponse::~EnqueueUpdateResponse() {
+  // @@protoc_insertion_point(destructor:proto.EnqueueUpdateResponse)
   SharedDtor();
 }
 
-void EndTransactionResponse::SharedDtor() {
+void EnqueueUpdateResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void EndTransactionResponse::SetCachedSize(int size) const {
+void EnqueueUpdateResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EndTransactionResponse::descriptor() {
+const ::google::protobuf::Descriptor* EnqueueUpdateResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EndTransactionResponse_descriptor_;
+  return EnqueueUpdateResponse_descriptor_;
 }
 
-const EndTransactionResponse& EndTransactionResponse::default_instance() {
+const EnqueueUpdateResponse& EnqueueUpdateResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-EndTransactionResponse* EndTransactionResponse::default_instance_ = NULL;
+EnqueueUpdateResponse* EnqueueUpdateResponse::default_instance_ = NULL;
 
-EndTransactionResponse* EndTransactionResponse::New() const {
-  return new EndTransactionResponse;
+EnqueueUpdateResponse* EnqueueUpdateResponse::New() const {
+  return new EnqueueUpdateResponse;
 }
 
-void EndTransactionResponse::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
-    }
-    commit_wait_ = GOOGLE_LONGLONG(0);
+void EnqueueUpdateResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool EndTransactionResponse::MergePartialFromCodedStream(
+bool EnqueueUpdateResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_start:proto.EnqueueUpdateResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -6767,21 +9517,6 @@ bool EndTransactionResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_commit_wait;
-        break;
-      }
-
-      // optional int64 commit_wait = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_commit_wait:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &commit_wait_)));
-          set_has_commit_wait();
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6800,38 +9535,33 @@ bool EndTransactionResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_success:proto.EnqueueUpdateResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(parse_failure:proto.EnqueueUpdateResponse)
   return false;
 #undef DO_
 }
 
-void EndTransactionResponse::SerializeWithCachedSizes(
+void EnqueueUpdateResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_start:proto.EnqueueUpdateResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 commit_wait = 2;
-  if (has_commit_wait()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->commit_wait(), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_end:proto.EnqueueUpdateResponse)
 }
 
-::google::protobuf::uint8* EndTransactionResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* EnqueueUpdateResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueUpdateResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6839,20 +9569,15 @@ void EndTransactionResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 commit_wait = 2;
-  if (has_commit_wait()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->commit_wait(), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.EndTransactionResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueUpdateResponse)
   return target;
 }
 
-int EndTransactionResponse::ByteSize() const {
+int EnqueueUpdateResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -6863,13 +9588,6 @@ int EndTransactionResponse::ByteSize() const {
           this->header());
     }
 
-    // optional int64 commit_wait = 2;
-    if (has_commit_wait()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->commit_wait());
-    }
-
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -6882,10 +9600,10 @@ int EndTransactionResponse::ByteSize() const {
   return total_size;
 }
 
-void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void EnqueueUpdateResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EndTransactionResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EndTransactionResponse*>(
+  const EnqueueUpdateResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueUpdateResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -6894,51 +9612,47 @@ void EndTransactionResponse::MergeFrom(const ::google::protobuf::Message& from)
   }
 }
 
-void EndTransactionResponse::MergeFrom(const EndTransactionResponse& from) {
+void EnqueueUpdateResponse::MergeFrom(const EnqueueUpdateResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
     }
-    if (from.has_commit_wait()) {
-      set_commit_wait(from.commit_wait());
-    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EndTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void EnqueueUpdateResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EndTransactionResponse::CopyFrom(const EndTransactionResponse& from) {
+void EnqueueUpdateResponse::CopyFrom(const EnqueueUpdateResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EndTransactionResponse::IsInitialized() const {
+bool EnqueueUpdateResponse::IsInitialized() const {
 
   return true;
 }
 
-void EndTransactionResponse::Swap(EndTransactionResponse* other) {
+void EnqueueUpdateResponse::Swap(EnqueueUpdateResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(commit_wait_, other->commit_wait_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata EndTransactionResponse::GetMetadata() const {
+::google::protobuf::Metadata EnqueueUpdateResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EndTransactionResponse_descriptor_;
-  metadata.reflection = EndTransactionResponse_reflection_;
+  metadata.descriptor = EnqueueUpdateResponse_descriptor_;
+  metadata.reflection = EnqueueUpdateResponse_reflection_;
   return metadata;
 }
 
@@ -6946,82 +9660,86 @@ void EndTransactionResponse::Swap(EndTransactionResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ReapQueueRequest::kHeaderFieldNumber;
-const int ReapQueueRequest::kMaxResultsFieldNumber;
+const int EnqueueMessageRequest::kHeaderFieldNumber;
+const int EnqueueMessageRequest::kMsgFieldNumber;
 #endif  // !_MSC_VER
 
-ReapQueueRequest::ReapQueueRequest()
+EnqueueMessageRequest::EnqueueMessageRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(constructor:proto.EnqueueMessageRequest)
 }
 
-void ReapQueueRequest::InitAsDefaultInstance() {
+void EnqueueMessageRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+  msg_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
 }
 
-ReapQueueRequest::ReapQueueRequest(const ReapQueueRequest& from)
+EnqueueMessageRequest::EnqueueMessageRequest(const EnqueueMessageRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.EnqueueMessageRequest)
 }
 
-void ReapQueueRequest::SharedCtor() {
+void EnqueueMessageRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  max_results_ = GOOGLE_LONGLONG(0);
+  msg_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ReapQueueRequest::~ReapQueueRequest() {
-  // @@protoc_insertion_point(destructor:proto.ReapQueueRequest)
+EnqueueMessageRequest::~EnqueueMessageRequest() {
+  // @@protoc_insertion_point(destructor:proto.EnqueueMessageRequest)
   SharedDtor();
 }
 
-void ReapQueueRequest::SharedDtor() {
+void EnqueueMessageRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
+    delete msg_;
   }
 }
 
-void ReapQueueRequest::SetCachedSize(int size) const {
+void EnqueueMessageRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ReapQueueRequest::descriptor() {
+const ::google::protobuf::Descriptor* EnqueueMessageRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ReapQueueRequest_descriptor_;
+  return EnqueueMessageRequest_descriptor_;
 }
 
-const ReapQueueRequest& ReapQueueRequest::default_instance() {
+const EnqueueMessageRequest& EnqueueMessageRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-ReapQueueRequest* ReapQueueRequest::default_instance_ = NULL;
+EnqueueMessageRequest* EnqueueMessageRequest::default_instance_ = NULL;
 
-ReapQueueRequest* ReapQueueRequest::New() const {
-  return new ReapQueueRequest;
+EnqueueMessageRequest* EnqueueMessageRequest::New() const {
+  return new EnqueueMessageRequest;
 }
 
-void ReapQueueRequest::Clear() {
+void EnqueueMessageRequest::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_header()) {
       if (header_ != NULL) header_->::proto::RequestHeader::Clear();
     }
-    max_results_ = GOOGLE_LONGLONG(0);
+    if (has_msg()) {
+      if (msg_ != NULL) msg_->::proto::Value::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ReapQueueRequest::MergePartialFromCodedStream(
+bool EnqueueMessageRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(parse_start:proto.EnqueueMessageRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -7035,18 +9753,16 @@ bool ReapQueueRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_max_results;
+        if (input->ExpectTag(18)) goto parse_msg;
         break;
       }
 
-      // optional int64 max_results = 2;
+      // optional .proto.Value msg = 2;
       case 2: {
-        if (tag == 16) {
-         parse_max_results:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &max_results_)));
-          set_has_max_results();
+        if (tag == 18) {
+         parse_msg:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_msg()));
         } else {
           goto handle_unusual;
         }
@@ -7068,38 +9784,39 @@ bool ReapQueueRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(parse_success:proto.EnqueueMessageRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(parse_failure:proto.EnqueueMessageRequest)
   return false;
 #undef DO_
 }
 
-void ReapQueueRequest::SerializeWithCachedSizes(
+void EnqueueMessageRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(serialize_start:proto.EnqueueMessageRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional int64 max_results = 2;
-  if (has_max_results()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  // optional .proto.Value msg = 2;
+  if (has_msg()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->msg(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(serialize_end:proto.EnqueueMessageRequest)
 }
 
-::google::protobuf::uint8* ReapQueueRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* EnqueueMessageRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueMessageRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7107,20 +9824,22 @@ void ReapQueueRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional int64 max_results = 2;
-  if (has_max_results()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  // optional .proto.Value msg = 2;
+  if (has_msg()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->msg(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ReapQueueRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueMessageRequest)
   return target;
 }
 
-int ReapQueueRequest::ByteSize() const {
+int EnqueueMessageRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -7131,11 +9850,11 @@ int ReapQueueRequest::ByteSize() const {
           this->header());
     }
 
-    // optional int64 max_results = 2;
-    if (has_max_results()) {
+    // optional .proto.Value msg = 2;
+    if (has_msg()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->max_results());
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->msg());
     }
 
   }
@@ -7150,10 +9869,10 @@ int ReapQueueRequest::ByteSize() const {
   return total_size;
 }
 
-void ReapQueueRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void EnqueueMessageRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ReapQueueRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ReapQueueRequest*>(
+  const EnqueueMessageRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueMessageRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7162,51 +9881,51 @@ void ReapQueueRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ReapQueueRequest::MergeFrom(const ReapQueueRequest& from) {
+void EnqueueMessageRequest::MergeFrom(const EnqueueMessageRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_max_results()) {
-      set_max_results(from.max_results());
+    if (from.has_msg()) {
+      mutable_msg()->::proto::Value::MergeFrom(from.msg());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ReapQueueRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void EnqueueMessageRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ReapQueueRequest::CopyFrom(const ReapQueueRequest& from) {
+void EnqueueMessageRequest::CopyFrom(const EnqueueMessageRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ReapQueueRequest::IsInitialized() const {
+bool EnqueueMessageRequest::IsInitialized() const {
 
   return true;
 }
 
-void ReapQueueRequest::Swap(ReapQueueRequest* other) {
+void EnqueueMessageRequest::Swap(EnqueueMessageRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(max_results_, other->max_results_);
+    std::swap(msg_, other->msg_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ReapQueueRequest::GetMetadata() const {
+::google::protobuf::Metadata EnqueueMessageRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ReapQueueRequest_descriptor_;
-  metadata.reflection = ReapQueueRequest_reflection_;
+  metadata.descriptor = EnqueueMessageRequest_descriptor_;
+  metadata.reflection = EnqueueMessageRequest_reflection_;
   return metadata;
 }
 
@@ -7214,79 +9933,77 @@ void ReapQueueRequest::Swap(ReapQueueRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ReapQueueResponse::kHeaderFieldNumber;
-const int ReapQueueResponse::kMessagesFieldNumber;
+const int EnqueueMessageResponse::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-ReapQueueResponse::ReapQueueResponse()
+EnqueueMessageResponse::EnqueueMessageResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(constructor:proto.EnqueueMessageResponse)
 }
 
-void ReapQueueResponse::InitAsDefaultInstance() {
+void EnqueueMessageResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
 }
 
-ReapQueueResponse::ReapQueueResponse(const ReapQueueResponse& from)
+EnqueueMessageResponse::EnqueueMessageResponse(const EnqueueMessageResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.EnqueueMessageResponse)
 }
 
-void ReapQueueResponse::SharedCtor() {
+void EnqueueMessageResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ReapQueueResponse::~ReapQueueResponse() {
-  // @@protoc_insertion_point(destructor:proto.ReapQueueResponse)
+EnqueueMessageResponse::~EnqueueMessageResponse() {
+  // @@protoc_insertion_point(destructor:proto.EnqueueMessageResponse)
   SharedDtor();
 }
 
-void ReapQueueResponse::SharedDtor() {
+void EnqueueMessageResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ReapQueueResponse::SetCachedSize(int size) const {
+void EnqueueMessageResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ReapQueueResponse::descriptor() {
+const ::google::protobuf::Descriptor* EnqueueMessageResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ReapQueueResponse_descriptor_;
+  return EnqueueMessageResponse_descriptor_;
 }
 
-const ReapQueueResponse& ReapQueueResponse::default_instance() {
+const EnqueueMessageResponse& EnqueueMessageResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-ReapQueueResponse* ReapQueueResponse::default_instance_ = NULL;
+EnqueueMessageResponse* EnqueueMessageResponse::default_instance_ = NULL;
 
-ReapQueueResponse* ReapQueueResponse::New() const {
-  return new ReapQueueResponse;
+EnqueueMessageResponse* EnqueueMessageResponse::New() const {
+  return new EnqueueMessageResponse;
 }
 
-void ReapQueueResponse::Clear() {
+void EnqueueMessageResponse::Clear() {
   if (has_header()) {
     if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   }
-  messages_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ReapQueueResponse::MergePartialFromCodedStream(
+bool EnqueueMessageResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(parse_start:proto.EnqueueMessageResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -7300,20 +10017,6 @@ bool ReapQueueResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_messages;
-        break;
-      }
-
-      // repeated .proto.Value messages = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_messages:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-                input, add_messages()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(18)) goto parse_messages;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -7332,39 +10035,33 @@ bool ReapQueueResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(parse_success:proto.EnqueueMessageResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(parse_failure:proto.EnqueueMessageResponse)
   return false;
 #undef DO_
 }
 
-void ReapQueueResponse::SerializeWithCachedSizes(
+void EnqueueMessageResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(serialize_start:proto.EnqueueMessageResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // repeated .proto.Value messages = 2;
-  for (int i = 0; i < this->messages_size(); i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->messages(i), output);
-  }
-
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(serialize_end:proto.EnqueueMessageResponse)
 }
 
-::google::protobuf::uint8* ReapQueueResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* EnqueueMessageResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueMessageResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7372,22 +10069,15 @@ void ReapQueueResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // repeated .proto.Value messages = 2;
-  for (int i = 0; i < this->messages_size(); i++) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->messages(i), target);
-  }
-
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ReapQueueResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueMessageResponse)
   return target;
 }
 
-int ReapQueueResponse::ByteSize() const {
+int EnqueueMessageResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -7399,14 +10089,6 @@ int ReapQueueResponse::ByteSize() const {
     }
 
   }
-  // repeated .proto.Value messages = 2;
-  total_size += 1 * this->messages_size();
-  for (int i = 0; i < this->messages_size(); i++) {
-    total_size +=
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->messages(i));
-  }
-
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -7418,10 +10100,10 @@ int ReapQueueResponse::ByteSize() const {
   return total_size;
 }
 
-void ReapQueueResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void EnqueueMessageResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ReapQueueResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ReapQueueResponse*>(
+  const EnqueueMessageResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueMessageResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7430,9 +10112,8 @@ void ReapQueueResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ReapQueueResponse::MergeFrom(const ReapQueueResponse& from) {
+void EnqueueMessageResponse::MergeFrom(const EnqueueMessageResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
-  messages_.MergeFrom(from.messages_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
@@ -7441,38 +10122,37 @@ void ReapQueueResponse::MergeFrom(const ReapQueueResponse& from) {
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ReapQueueResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void EnqueueMessageResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ReapQueueResponse::CopyFrom(const ReapQueueResponse& from) {
+void EnqueueMessageResponse::CopyFrom(const EnqueueMessageResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ReapQueueResponse::IsInitialized() const {
+bool EnqueueMessageResponse::IsInitialized() const {
 
   return true;
 }
 
-void ReapQueueResponse::Swap(ReapQueueResponse* other) {
+void EnqueueMessageResponse::Swap(EnqueueMessageResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    messages_.Swap(&other->messages_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ReapQueueResponse::GetMetadata() const {
+::google::protobuf::Metadata EnqueueMessageResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ReapQueueResponse_descriptor_;
-  metadata.reflection = ReapQueueResponse_reflection_;
+  metadata.descriptor = EnqueueMessageResponse_descriptor_;
+  metadata.reflection = EnqueueMessageResponse_reflection_;
   return metadata;
 }
 
@@ -7480,77 +10160,82 @@ void ReapQueueResponse::Swap(ReapQueueResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EnqueueUpdateRequest::kHeaderFieldNumber;
+const int ReverseScanRequest::kHeaderFieldNumber;
+const int ReverseScanRequest::kMaxResultsFieldNumber;
 #endif  // !_MSC_VER
 
-EnqueueUpdateRequest::EnqueueUpdateRequest()
+ReverseScanRequest::ReverseScanRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(constructor:proto.ReverseScanRequest)
 }
 
-void EnqueueUpdateRequest::InitAsDefaultInstance() {
+void ReverseScanRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
 }
 
-EnqueueUpdateRequest::EnqueueUpdateRequest(const EnqueueUpdateRequest& from)
+ReverseScanRequest::ReverseScanRequest(const ReverseScanRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.ReverseScanRequest)
 }
 
-void EnqueueUpdateRequest::SharedCtor() {
+void ReverseScanRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EnqueueUpdateRequest::~EnqueueUpdateRequest() {
-  // @@protoc_insertion_point(destructor:proto.EnqueueUpdateRequest)
+ReverseScanRequest::~ReverseScanRequest() {
+  // @@protoc_insertion_point(destructor:proto.ReverseScanRequest)
   SharedDtor();
 }
 
-void EnqueueUpdateRequest::SharedDtor() {
+void ReverseScanRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void EnqueueUpdateRequest::SetCachedSize(int size) const {
+void ReverseScanRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EnqueueUpdateRequest::descriptor() {
+const ::google::protobuf::Descriptor* ReverseScanRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EnqueueUpdateRequest_descriptor_;
+  return ReverseScanRequest_descriptor_;
 }
 
-const EnqueueUpdateRequest& EnqueueUpdateRequest::default_instance() {
+const ReverseScanRequest& ReverseScanRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-EnqueueUpdateRequest* EnqueueUpdateRequest::default_instance_ = NULL;
+ReverseScanRequest* ReverseScanRequest::default_instance_ = NULL;
 
-EnqueueUpdateRequest* EnqueueUpdateRequest::New() const {
-  return new EnqueueUpdateRequest;
+ReverseScanRequest* ReverseScanRequest::New() const {
+  return new ReverseScanRequest;
 }
 
-void EnqueueUpdateRequest::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+void ReverseScanRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    max_results_ = GOOGLE_LONGLONG(0);
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool EnqueueUpdateRequest::MergePartialFromCodedStream(
+bool ReverseScanRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(parse_start:proto.ReverseScanRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -7564,6 +10249,21 @@ bool EnqueueUpdateRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -7582,33 +10282,38 @@ bool EnqueueUpdateRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(parse_success:proto.ReverseScanRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(parse_failure:proto.ReverseScanRequest)
   return false;
 #undef DO_
 }
 
-void EnqueueUpdateRequest::SerializeWithCachedSizes(
+void ReverseScanRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(serialize_start:proto.ReverseScanRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(serialize_end:proto.ReverseScanRequest)
 }
 
-::google::protobuf::uint8* EnqueueUpdateRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReverseScanRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ReverseScanRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7616,15 +10321,20 @@ void EnqueueUpdateRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueUpdateRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ReverseScanRequest)
   return target;
 }
 
-int EnqueueUpdateRequest::ByteSize() const {
+int ReverseScanRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -7635,6 +10345,13 @@ int EnqueueUpdateRequest::ByteSize() const {
           this->header());
     }
 
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -7647,10 +10364,10 @@ int EnqueueUpdateRequest::ByteSize() const {
   return total_size;
 }
 
-void EnqueueUpdateRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ReverseScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EnqueueUpdateRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueUpdateRequest*>(
+  const ReverseScanRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReverseScanRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7659,47 +10376,51 @@ void EnqueueUpdateRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void EnqueueUpdateRequest::MergeFrom(const EnqueueUpdateRequest& from) {
+void ReverseScanRequest::MergeFrom(const ReverseScanRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
     }
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EnqueueUpdateRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ReverseScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EnqueueUpdateRequest::CopyFrom(const EnqueueUpdateRequest& from) {
+void ReverseScanRequest::CopyFrom(const ReverseScanRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EnqueueUpdateRequest::IsInitialized() const {
+bool ReverseScanRequest::IsInitialized() const {
 
   return true;
 }
 
-void EnqueueUpdateRequest::Swap(EnqueueUpdateRequest* other) {
+void ReverseScanRequest::Swap(ReverseScanRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(max_results_, other->max_results_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata EnqueueUpdateRequest::GetMetadata() const {
+::google::protobuf::Metadata ReverseScanRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EnqueueUpdateRequest_descriptor_;
-  metadata.reflection = EnqueueUpdateRequest_reflection_;
+  metadata.descriptor = ReverseScanRequest_descriptor_;
+  metadata.reflection = ReverseScanRequest_reflection_;
   return metadata;
 }
 
@@ -7707,77 +10428,79 @@ void EnqueueUpdateRequest::Swap(EnqueueUpdateRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EnqueueUpdateResponse::kHeaderFieldNumber;
+const int ReverseScanResponse::kHeaderFieldNumber;
+const int ReverseScanResponse::kRowsFieldNumber;
 #endif  // !_MSC_VER
 
-EnqueueUpdateResponse::EnqueueUpdateResponse()
+ReverseScanResponse::ReverseScanResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(constructor:proto.ReverseScanResponse)
 }
 
-void EnqueueUpdateResponse::InitAsDefaultInstance() {
+void ReverseScanResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
 }
 
-EnqueueUpdateResponse::EnqueueUpdateResponse(const EnqueueUpdateResponse& from)
+ReverseScanResponse::ReverseScanResponse(const ReverseScanResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.ReverseScanResponse)
 }
 
-void EnqueueUpdateResponse::SharedCtor() {
+void ReverseScanResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EnqueueUpdateResponse::~EnqueueUpdateResponse() {
-  // @@protoc_insertion_point(destructor:proto.EnqueueUpdateResponse)
+ReverseScanResponse::~ReverseScanResponse() {
+  // @@protoc_insertion_point(destructor:proto.ReverseScanResponse)
   SharedDtor();
 }
 
-void EnqueueUpdateResponse::SharedDtor() {
+void ReverseScanResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void EnqueueUpdateResponse::SetCachedSize(int size) const {
+void ReverseScanResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EnqueueUpdateResponse::descriptor() {
+const ::google::protobuf::Descriptor* ReverseScanResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EnqueueUpdateResponse_descriptor_;
+  return ReverseScanResponse_descriptor_;
 }
 
-const EnqueueUpdateResponse& EnqueueUpdateResponse::default_instance() {
+const ReverseScanResponse& ReverseScanResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-EnqueueUpdateResponse* EnqueueUpdateResponse::default_instance_ = NULL;
+ReverseScanResponse* ReverseScanResponse::default_instance_ = NULL;
 
-EnqueueUpdateResponse* EnqueueUpdateResponse::New() const {
-  return new EnqueueUpdateResponse;
+ReverseScanResponse* ReverseScanResponse::New() const {
+  return new ReverseScanResponse;
 }
 
-void EnqueueUpdateResponse::Clear() {
+void ReverseScanResponse::Clear() {
   if (has_header()) {
     if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   }
+  rows_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool EnqueueUpdateResponse::MergePartialFromCodedStream(
+bool ReverseScanResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(parse_start:proto.ReverseScanResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -7791,6 +10514,20 @@ bool EnqueueUpdateResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_rows;
+        break;
+      }
+
+      // repeated .proto.KeyValue rows = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -7809,33 +10546,39 @@ bool EnqueueUpdateResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(parse_success:proto.ReverseScanResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(parse_failure:proto.ReverseScanResponse)
   return false;
 #undef DO_
 }
 
-void EnqueueUpdateResponse::SerializeWithCachedSizes(
+void ReverseScanResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(serialize_start:proto.ReverseScanResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
+  // repeated .proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->rows(i), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(serialize_end:proto.ReverseScanResponse)
 }
 
-::google::protobuf::uint8* EnqueueUpdateResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ReverseScanResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ReverseScanResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7843,15 +10586,22 @@ void EnqueueUpdateResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
+  // repeated .proto.KeyValue rows = 2;
+  for (int i = 0; i < this->rows_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->rows(i), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueUpdateResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ReverseScanResponse)
   return target;
 }
 
-int EnqueueUpdateResponse::ByteSize() const {
+int ReverseScanResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -7863,6 +10613,14 @@ int EnqueueUpdateResponse::ByteSize() const {
     }
 
   }
+  // repeated .proto.KeyValue rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -7874,10 +10632,10 @@ int EnqueueUpdateResponse::ByteSize() const {
   return total_size;
 }
 
-void EnqueueUpdateResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void ReverseScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EnqueueUpdateResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueUpdateResponse*>(
+  const ReverseScanResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReverseScanResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7886,8 +10644,9 @@ void EnqueueUpdateResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void EnqueueUpdateResponse::MergeFrom(const EnqueueUpdateResponse& from) {
+void ReverseScanResponse::MergeFrom(const ReverseScanResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
+  rows_.MergeFrom(from.rows_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
@@ -7896,37 +10655,38 @@ void EnqueueUpdateResponse::MergeFrom(const EnqueueUpdateResponse& from) {
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EnqueueUpdateResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void ReverseScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EnqueueUpdateResponse::CopyFrom(const EnqueueUpdateResponse& from) {
+void ReverseScanResponse::CopyFrom(const ReverseScanResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EnqueueUpdateResponse::IsInitialized() const {
+bool ReverseScanResponse::IsInitialized() const {
 
   return true;
 }
 
-void EnqueueUpdateResponse::Swap(EnqueueUpdateResponse* other) {
+void ReverseScanResponse::Swap(ReverseScanResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    rows_.Swap(&other->rows_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata EnqueueUpdateResponse::GetMetadata() const {
+::google::protobuf::Metadata ReverseScanResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EnqueueUpdateResponse_descriptor_;
-  metadata.reflection = EnqueueUpdateResponse_reflection_;
+  metadata.descriptor = ReverseScanResponse_descriptor_;
+  metadata.reflection = ReverseScanResponse_reflection_;
   return metadata;
 }
 
@@ -7934,86 +10694,86 @@ void EnqueueUpdateResponse::Swap(EnqueueUpdateResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EnqueueMessageRequest::kHeaderFieldNumber;
-const int EnqueueMessageRequest::kMsgFieldNumber;
+const int ConditionalDeleteRequest::kHeaderFieldNumber;
+const int ConditionalDeleteRequest::kExpValueFieldNumber;
 #endif  // !_MSC_VER
 
-EnqueueMessageRequest::EnqueueMessageRequest()
+ConditionalDeleteRequest::ConditionalDeleteRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(constructor:proto.ConditionalDeleteRequest)
 }
 
-void EnqueueMessageRequest::InitAsDefaultInstance() {
+void ConditionalDeleteRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
-  msg_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+  exp_value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
 }
 
-EnqueueMessageRequest::EnqueueMessageRequest(const EnqueueMessageRequest& from)
+ConditionalDeleteRequest::ConditionalDeleteRequest(const ConditionalDeleteRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(copy_constructor:proto.ConditionalDeleteRequest)
 }
 
-void EnqueueMessageRequest::SharedCtor() {
+void ConditionalDeleteRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  msg_ = NULL;
+  exp_value_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EnqueueMessageRequest::~EnqueueMessageRequest() {
-  // @@protoc_insertion_point(destructor:proto.EnqueueMessageRequest)
+ConditionalDeleteRequest::~ConditionalDeleteRequest() {
+  // @@protoc_insertion_point(destructor:proto.ConditionalDeleteRequest)
   SharedDtor();
 }
 
-void EnqueueMessageRequest::SharedDtor() {
+void ConditionalDeleteRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
-    delete msg_;
+    delete exp_value_;
   }
 }
 
-void EnqueueMessageRequest::SetCachedSize(int size) const {
+void ConditionalDeleteRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EnqueueMessageRequest::descriptor() {
+const ::google::protobuf::Descriptor* ConditionalDeleteRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EnqueueMessageRequest_descriptor_;
+  return ConditionalDeleteRequest_descriptor_;
 }
 
-const EnqueueMessageRequest& EnqueueMessageRequest::default_instance() {
+const ConditionalDeleteRequest& ConditionalDeleteRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-EnqueueMessageRequest* EnqueueMessageRequest::default_instance_ = NULL;
+ConditionalDeleteRequest* ConditionalDeleteRequest::default_instance_ = NULL;
 
-EnqueueMessageRequest* EnqueueMessageRequest::New() const {
-  return new EnqueueMessageRequest;
+ConditionalDeleteRequest* ConditionalDeleteRequest::New() const {
+  return new ConditionalDeleteRequest;
 }
 
-void EnqueueMessageRequest::Clear() {
+void ConditionalDeleteRequest::Clear() {
   if (_has_bits_[0 / 32] & 3) {
     if (has_header()) {
       if (header_ != NULL) header_->::proto::RequestHeader::Clear();
     }
-    if (has_msg()) {
-      if (msg_ != NULL) msg_->::proto::Value::Clear();
+    if (has_exp_value()) {
+      if (exp_value_ != NULL) exp_value_->::proto::Value::Clear();
     }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool EnqueueMessageRequest::MergePartialFromCodedStream(
+bool ConditionalDeleteRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(parse_start:proto.ConditionalDeleteRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -8027,16 +10787,16 @@ bool EnqueueMessageRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_msg;
+        if (input->ExpectTag(18)) goto parse_exp_value;
         break;
       }
 
-      // optional .proto.Value msg = 2;
+      // optional .proto.Value exp_value = 2;
       case 2: {
         if (tag == 18) {
-         parse_msg:
+         parse_exp_value:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_msg()));
+               input, mutable_exp_value()));
         } else {
           goto handle_unusual;
         }
@@ -8058,39 +10818,39 @@ bool EnqueueMessageRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(parse_success:proto.ConditionalDeleteRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(parse_failure:proto.ConditionalDeleteRequest)
   return false;
 #undef DO_
 }
 
-void EnqueueMessageRequest::SerializeWithCachedSizes(
+void ConditionalDeleteRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(serialize_start:proto.ConditionalDeleteRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, this->header(), output);
   }
 
-  // optional .proto.Value msg = 2;
-  if (has_msg()) {
+  // optional .proto.Value exp_value = 2;
+  if (has_exp_value()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->msg(), output);
+      2, this->exp_value(), output);
   }
 
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(serialize_end:proto.ConditionalDeleteRequest)
 }
 
-::google::protobuf::uint8* EnqueueMessageRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ConditionalDeleteRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ConditionalDeleteRequest)
   // optional .proto.RequestHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -8098,22 +10858,22 @@ void EnqueueMessageRequest::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
-  // optional .proto.Value msg = 2;
-  if (has_msg()) {
+  // optional .proto.Value exp_value = 2;
+  if (has_exp_value()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        2, this->msg(), target);
+        2, this->exp_value(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueMessageRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ConditionalDeleteRequest)
   return target;
 }
 
-int EnqueueMessageRequest::ByteSize() const {
+int ConditionalDeleteRequest::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -8124,11 +10884,11 @@ int EnqueueMessageRequest::ByteSize() const {
           this->header());
     }
 
-    // optional .proto.Value msg = 2;
-    if (has_msg()) {
+    // optional .proto.Value exp_value = 2;
+    if (has_exp_value()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->msg());
+          this->exp_value());
     }
 
   }
@@ -8143,10 +10903,10 @@ int EnqueueMessageRequest::ByteSize() const {
   return total_size;
 }
 
-void EnqueueMessageRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ConditionalDeleteRequest::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EnqueueMessageRequest* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueMessageRequest*>(
+  const ConditionalDeleteRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ConditionalDeleteRequest*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8155,51 +10915,51 @@ void EnqueueMessageRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void EnqueueMessageRequest::MergeFrom(const EnqueueMessageRequest& from) {
+void ConditionalDeleteRequest::MergeFrom(const ConditionalDeleteRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
     }
-    if (from.has_msg()) {
-      mutable_msg()->::proto::Value::MergeFrom(from.msg());
+    if (from.has_exp_value()) {
+      mutable_exp_value()->::proto::Value::MergeFrom(from.exp_value());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EnqueueMessageRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ConditionalDeleteRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EnqueueMessageRequest::CopyFrom(const EnqueueMessageRequest& from) {
+void ConditionalDeleteRequest::CopyFrom(const ConditionalDeleteRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EnqueueMessageRequest::IsInitialized() const {
+bool ConditionalDeleteRequest::IsInitialized() const {
 
   return true;
 }
 
-void EnqueueMessageRequest::Swap(EnqueueMessageRequest* other) {
+void ConditionalDeleteRequest::Swap(ConditionalDeleteRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
-    std::swap(msg_, other->msg_);
+    std::swap(exp_value_, other->exp_value_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata EnqueueMessageRequest::GetMetadata() const {
+::google::protobuf::Metadata ConditionalDeleteRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EnqueueMessageRequest_descriptor_;
-  metadata.reflection = EnqueueMessageRequest_reflection_;
+  metadata.descriptor = ConditionalDeleteRequest_descriptor_;
+  metadata.reflection = ConditionalDeleteRequest_reflection_;
   return metadata;
 }
 
@@ -8207,65 +10967,65 @@ void EnqueueMessageRequest::Swap(EnqueueMessageRequest* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int EnqueueMessageResponse::kHeaderFieldNumber;
+const int ConditionalDeleteResponse::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-EnqueueMessageResponse::EnqueueMessageResponse()
+ConditionalDeleteResponse::ConditionalDeleteResponse()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(constructor:proto.ConditionalDeleteResponse)
 }
 
-void EnqueueMessageResponse::InitAsDefaultInstance() {
+void ConditionalDeleteResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
 }
 
-EnqueueMessageResponse::EnqueueMessageResponse(const EnqueueMessageResponse& from)
+ConditionalDeleteResponse::ConditionalDeleteResponse(const ConditionalDeleteResponse& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.ConditionalDeleteResponse)
 }
 
-void EnqueueMessageResponse::SharedCtor() {
+void ConditionalDeleteResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-EnqueueMessageResponse::~EnqueueMessageResponse() {
-  // @@protoc_insertion_point(destructor:proto.EnqueueMessageResponse)
+ConditionalDeleteResponse::~ConditionalDeleteResponse() {
+  // @@protoc_insertion_point(destructor:proto.ConditionalDeleteResponse)
   SharedDtor();
 }
 
-void EnqueueMessageResponse::SharedDtor() {
+void ConditionalDeleteResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void EnqueueMessageResponse::SetCachedSize(int size) const {
+void ConditionalDeleteResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* EnqueueMessageResponse::descriptor() {
+const ::google::protobuf::Descriptor* ConditionalDeleteResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return EnqueueMessageResponse_descriptor_;
+  return ConditionalDeleteResponse_descriptor_;
 }
 
-const EnqueueMessageResponse& EnqueueMessageResponse::default_instance() {
+const ConditionalDeleteResponse& ConditionalDeleteResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_api_2eproto();
   return *default_instance_;
 }
 
-EnqueueMessageResponse* EnqueueMessageResponse::default_instance_ = NULL;
+ConditionalDeleteResponse* ConditionalDeleteResponse::default_instance_ = NULL;
 
-EnqueueMessageResponse* EnqueueMessageResponse::New() const {
-  return new EnqueueMessageResponse;
+ConditionalDeleteResponse* ConditionalDeleteResponse::New() const {
+  return new ConditionalDeleteResponse;
 }
 
-void EnqueueMessageResponse::Clear() {
+void ConditionalDeleteResponse::Clear() {
   if (has_header()) {
     if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   }
@@ -8273,11 +11033,11 @@ void EnqueueMessageResponse::Clear() {
   mutable_unknown_fields()->Clear();
 }
 
-bool EnqueueMessageResponse::MergePartialFromCodedStream(
+bool ConditionalDeleteResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(parse_start:proto.ConditionalDeleteResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -8309,17 +11069,17 @@ bool EnqueueMessageResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(parse_success:proto.ConditionalDeleteResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(parse_failure:proto.ConditionalDeleteResponse)
   return false;
 #undef DO_
 }
 
-void EnqueueMessageResponse::SerializeWithCachedSizes(
+void ConditionalDeleteResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(serialize_start:proto.ConditionalDeleteResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -8330,12 +11090,12 @@ void EnqueueMessageResponse::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(serialize_end:proto.ConditionalDeleteResponse)
 }
 
-::google::protobuf::uint8* EnqueueMessageResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ConditionalDeleteResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ConditionalDeleteResponse)
   // optional .proto.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -8347,11 +11107,11 @@ void EnqueueMessageResponse::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.EnqueueMessageResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ConditionalDeleteResponse)
   return target;
 }
 
-int EnqueueMessageResponse::ByteSize() const {
+int ConditionalDeleteResponse::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -8374,10 +11134,10 @@ int EnqueueMessageResponse::ByteSize() const {
   return total_size;
 }
 
-void EnqueueMessageResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void ConditionalDeleteResponse::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const EnqueueMessageResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const EnqueueMessageResponse*>(
+  const ConditionalDeleteResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ConditionalDeleteResponse*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8386,7 +11146,7 @@ void EnqueueMessageResponse::MergeFrom(const ::google::protobuf::Message& from)
   }
 }
 
-void EnqueueMessageResponse::MergeFrom(const EnqueueMessageResponse& from) {
+void ConditionalDeleteResponse::MergeFrom(const ConditionalDeleteResponse& from) {
   GOOGLE_CHECK_NE(&from, this);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
@@ -8396,24 +11156,24 @@ void EnqueueMessageResponse::MergeFrom(const EnqueueMessageResponse& from) {
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void EnqueueMessageResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void ConditionalDeleteResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void EnqueueMessageResponse::CopyFrom(const EnqueueMessageResponse& from) {
+void ConditionalDeleteResponse::CopyFrom(const ConditionalDeleteResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool EnqueueMessageResponse::IsInitialized() const {
+bool ConditionalDeleteResponse::IsInitialized() const {
 
   return true;
 }
 
-void EnqueueMessageResponse::Swap(EnqueueMessageResponse* other) {
+void ConditionalDeleteResponse::Swap(ConditionalDeleteResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
@@ -8422,11 +11182,11 @@ void EnqueueMessageResponse::Swap(EnqueueMessageResponse* other) {
   }
 }
 
-::google::protobuf::Metadata EnqueueMessageResponse::GetMetadata() const {
+::google::protobuf::Metadata ConditionalDeleteResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = EnqueueMessageResponse_descriptor_;
-  metadata.reflection = EnqueueMessageResponse_reflection_;
+  metadata.descriptor = ConditionalDeleteResponse_descriptor_;
+  metadata.reflection = ConditionalDeleteResponse_reflection_;
   return metadata;
 }
 
@@ -8446,6 +11206,8 @@ const int RequestUnion::kEndTransactionFieldNumber;
 const int RequestUnion::kReapQueueFieldNumber;
 const int RequestUnion::kEnqueueUpdateFieldNumber;
 const int RequestUnion::kEnqueueMessageFieldNumber;
+const int RequestUnion::kReverseScanFieldNumber;
+const int RequestUnion::kConditionalDeleteFieldNumber;
 #endif  // !_MSC_VER
 
 RequestUnion::RequestUnion()
@@ -8467,6 +11229,8 @@ void RequestUnion::InitAsDefaultInstance() {
   reap_queue_ = const_cast< ::proto::ReapQueueRequest*>(&::proto::ReapQueueRequest::default_instance());
   enqueue_update_ = const_cast< ::proto::EnqueueUpdateRequest*>(&::proto::EnqueueUpdateRequest::default_instance());
   enqueue_message_ = const_cast< ::proto::EnqueueMessageRequest*>(&::proto::EnqueueMessageRequest::default_instance());
+  reverse_scan_ = const_cast< ::proto::ReverseScanRequest*>(&::proto::ReverseScanRequest::default_instance());
+  conditional_delete_ = const_cast< ::proto::ConditionalDeleteRequest*>(&::proto::ConditionalDeleteRequest::default_instance());
 }
 
 RequestUnion::RequestUnion(const RequestUnion& from)
@@ -8490,6 +11254,8 @@ void RequestUnion::SharedCtor() {
   reap_queue_ = NULL;
   enqueue_update_ = NULL;
   enqueue_message_ = NULL;
+  reverse_scan_ = NULL;
+  conditional_delete_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -8512,6 +11278,8 @@ void RequestUnion::SharedDtor() {
     delete reap_queue_;
     delete enqueue_update_;
     delete enqueue_message_;
+    delete reverse_scan_;
+    delete conditional_delete_;
   }
 }
 
@@ -8563,7 +11331,7 @@ void RequestUnion::Clear() {
       if (scan_ != NULL) scan_->::proto::ScanRequest::Clear();
     }
   }
-  if (_has_bits_[8 / 32] & 3840) {
+  if (_has_bits_[8 / 32] & 16128) {
     if (has_end_transaction()) {
       if (end_transaction_ != NULL) end_transaction_->::proto::EndTransactionRequest::Clear();
     }
@@ -8576,6 +11344,12 @@ void RequestUnion::Clear() {
     if (has_enqueue_message()) {
       if (enqueue_message_ != NULL) enqueue_message_->::proto::EnqueueMessageRequest::Clear();
     }
+    if (has_reverse_scan()) {
+      if (reverse_scan_ != NULL) reverse_scan_->::proto::ReverseScanRequest::Clear();
+    }
+    if (has_conditional_delete()) {
+      if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteRequest::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -8742,6 +11516,32 @@ bool RequestUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(106)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .proto.ReverseScanRequest reverse_scan = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_conditional_delete;
+        break;
+      }
+
+      // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_conditional_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_delete()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -8843,6 +11643,18 @@ void RequestUnion::SerializeWithCachedSizes(
       12, this->enqueue_message(), output);
   }
 
+  // optional .proto.ReverseScanRequest reverse_scan = 13;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->reverse_scan(), output);
+  }
+
+  // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+  if (has_conditional_delete()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->conditional_delete(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -8937,6 +11749,20 @@ void RequestUnion::SerializeWithCachedSizes(
         12, this->enqueue_message(), target);
   }
 
+  // optional .proto.ReverseScanRequest reverse_scan = 13;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->reverse_scan(), target);
+  }
+
+  // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+  if (has_conditional_delete()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->conditional_delete(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -9035,6 +11861,20 @@ int RequestUnion::ByteSize() const {
           this->enqueue_message());
     }
 
+    // optional .proto.ReverseScanRequest reverse_scan = 13;
+    if (has_reverse_scan()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->reverse_scan());
+    }
+
+    // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+    if (has_conditional_delete()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_delete());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -9100,6 +11940,12 @@ void RequestUnion::MergeFrom(const RequestUnion& from) {
     if (from.has_enqueue_message()) {
       mutable_enqueue_message()->::proto::EnqueueMessageRequest::MergeFrom(from.enqueue_message());
     }
+    if (from.has_reverse_scan()) {
+      mutable_reverse_scan()->::proto::ReverseScanRequest::MergeFrom(from.reverse_scan());
+    }
+    if (from.has_conditional_delete()) {
+      mutable_conditional_delete()->::proto::ConditionalDeleteRequest::MergeFrom(from.conditional_delete());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -9135,6 +11981,8 @@ void RequestUnion::Swap(RequestUnion* other) {
     std::swap(reap_queue_, other->reap_queue_);
     std::swap(enqueue_update_, other->enqueue_update_);
     std::swap(enqueue_message_, other->enqueue_message_);
+    std::swap(reverse_scan_, other->reverse_scan_);
+    std::swap(conditional_delete_, other->conditional_delete_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -9165,6 +12013,8 @@ const int ResponseUnion::kEndTransactionFieldNumber;
 const int ResponseUnion::kReapQueueFieldNumber;
 const int ResponseUnion::kEnqueueUpdateFieldNumber;
 const int ResponseUnion::kEnqueueMessageFieldNumber;
+const int ResponseUnion::kReverseScanFieldNumber;
+const int ResponseUnion::kConditionalDeleteFieldNumber;
 #endif  // !_MSC_VER
 
 ResponseUnion::ResponseUnion()
@@ -9186,6 +12036,8 @@ void ResponseUnion::InitAsDefaultInstance() {
   reap_queue_ = const_cast< ::proto::ReapQueueResponse*>(&::proto::ReapQueueResponse::default_instance());
   enqueue_update_ = const_cast< ::proto::EnqueueUpdateResponse*>(&::proto::EnqueueUpdateResponse::default_instance());
   enqueue_message_ = const_cast< ::proto::EnqueueMessageResponse*>(&::proto::EnqueueMessageResponse::default_instance());
+  reverse_scan_ = const_cast< ::proto::ReverseScanResponse*>(&::proto::ReverseScanResponse::default_instance());
+  conditional_delete_ = const_cast< ::proto::ConditionalDeleteResponse*>(&::proto::ConditionalDeleteResponse::default_instance());
 }
 
 ResponseUnion::ResponseUnion(const ResponseUnion& from)
@@ -9209,6 +12061,8 @@ void ResponseUnion::SharedCtor() {
   reap_queue_ = NULL;
   enqueue_update_ = NULL;
   enqueue_message_ = NULL;
+  reverse_scan_ = NULL;
+  conditional_delete_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -9231,6 +12085,8 @@ void ResponseUnion::SharedDtor() {
     delete reap_queue_;
     delete enqueue_update_;
     delete enqueue_message_;
+    delete reverse_scan_;
+    delete conditional_delete_;
   }
 }
 
@@ -9282,7 +12138,7 @@ void ResponseUnion::Clear() {
       if (scan_ != NULL) scan_->::proto::ScanResponse::Clear();
     }
   }
-  if (_has_bits_[8 / 32] & 3840) {
+  if (_has_bits_[8 / 32] & 16128) {
     if (has_end_transaction()) {
       if (end_transaction_ != NULL) end_transaction_->::proto::EndTransactionResponse::Clear();
     }
@@ -9295,6 +12151,12 @@ void ResponseUnion::Clear() {
     if (has_enqueue_message()) {
       if (enqueue_message_ != NULL) enqueue_message_->::proto::EnqueueMessageResponse::Clear();
     }
+    if (has_reverse_scan()) {
+      if (reverse_scan_ != NULL) reverse_scan_->::proto::ReverseScanResponse::Clear();
+    }
+    if (has_conditional_delete()) {
+      if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteResponse::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -9461,6 +12323,32 @@ bool ResponseUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(106)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .proto.ReverseScanResponse reverse_scan = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_conditional_delete;
+        break;
+      }
+
+      // optional .proto.ConditionalDeleteResponse conditional_delete = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_conditional_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_delete()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -9562,6 +12450,18 @@ void ResponseUnion::SerializeWithCachedSizes(
       12, this->enqueue_message(), output);
   }
 
+  // optional .proto.ReverseScanResponse reverse_scan = 13;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->reverse_scan(), output);
+  }
+
+  // optional .proto.ConditionalDeleteResponse conditional_delete = 14;
+  if (has_conditional_delete()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->conditional_delete(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -9656,6 +12556,20 @@ void ResponseUnion::SerializeWithCachedSizes(
         12, this->enqueue_message(), target);
   }
 
+  // optional .proto.ReverseScanResponse reverse_scan = 13;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->reverse_scan(), target);
+  }
+
+  // optional .proto.ConditionalDeleteResponse conditional_delete = 14;
+  if (has_conditional_delete()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->conditional_delete(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -9754,6 +12668,20 @@ int ResponseUnion::ByteSize() const {
           this->enqueue_message());
     }
 
+    // optional .proto.ReverseScanResponse reverse_scan = 13;
+    if (has_reverse_scan()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->reverse_scan());
+    }
+
+    // optional .proto.ConditionalDeleteResponse conditional_delete = 14;
+    if (has_conditional_delete()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_delete());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -9819,6 +12747,12 @@ void ResponseUnion::MergeFrom(const ResponseUnion& from) {
     if (from.has_enqueue_message()) {
       mutable_enqueue_message()->::proto::EnqueueMessageResponse::MergeFrom(from.enqueue_message());
     }
+    if (from.has_reverse_scan()) {
+      mutable_reverse_scan()->::proto::ReverseScanResponse::MergeFrom(from.reverse_scan());
+    }
+    if (from.has_conditional_delete()) {
+      mutable_conditional_delete()->::proto::ConditionalDeleteResponse::MergeFrom(from.conditional_delete());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -9854,6 +12788,8 @@ void ResponseUnion::Swap(ResponseUnion* other) {
     std::swap(reap_queue_, other->reap_queue_);
     std::swap(enqueue_update_, other->enqueue_update_);
     std::swap(enqueue_message_, other->enqueue_message_);
+    std::swap(reverse_scan_, other->reverse_scan_);
+    std::swap(conditional_delete_, other->conditional_delete_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -10140,6 +13076,7 @@ void BatchRequest::Swap(BatchRequest* other) {
 #ifndef _MSC_VER
 const int BatchResponse::kHeaderFieldNumber;
 const int BatchResponse::kResponsesFieldNumber;
+const int BatchResponse::kCompletedFieldNumber;
 #endif  // !_MSC_VER
 
 BatchResponse::BatchResponse()
@@ -10162,6 +13099,7 @@ BatchResponse::BatchResponse(const BatchResponse& from)
 void BatchResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  completed_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -10198,8 +13136,11 @@ BatchResponse* BatchResponse::New() const {
 }
 
 void BatchResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  if (_has_bits_[0 / 32] & 5) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    completed_ = 0;
   }
   responses_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
@@ -10238,6 +13179,21 @@ bool BatchResponse::MergePartialFromCodedStream(
           goto handle_unusual;
         }
         if (input->ExpectTag(18)) goto parse_responses;
+        if (input->ExpectTag(24)) goto parse_completed;
+        break;
+      }
+
+      // optional int32 completed = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_completed:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &completed_)));
+          set_has_completed();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -10279,6 +13235,11 @@ void BatchResponse::SerializeWithCachedSizes(
       2, this->responses(i), output);
   }
 
+  // optional int32 completed = 3;
+  if (has_completed()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->completed(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -10303,6 +13264,11 @@ void BatchResponse::SerializeWithCachedSizes(
         2, this->responses(i), target);
   }
 
+  // optional int32 completed = 3;
+  if (has_completed()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->completed(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -10322,6 +13288,13 @@ int BatchResponse::ByteSize() const {
           this->header());
     }
 
+    // optional int32 completed = 3;
+    if (has_completed()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->completed());
+    }
+
   }
   // repeated .proto.ResponseUnion responses = 2;
   total_size += 1 * this->responses_size();
@@ -10361,6 +13334,9 @@ void BatchResponse::MergeFrom(const BatchResponse& from) {
     if (from.has_header()) {
       mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_completed()) {
+      set_completed(from.completed());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -10386,6 +13362,7 @@ void BatchResponse::Swap(BatchResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
     responses_.Swap(&other->responses_);
+    std::swap(completed_, other->completed_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -10406,6 +13383,7 @@ void BatchResponse::Swap(BatchResponse* other) {
 #ifndef _MSC_VER
 const int AdminSplitRequest::kHeaderFieldNumber;
 const int AdminSplitRequest::kSplitKeyFieldNumber;
+const int AdminSplitRequest::kDryRunFieldNumber;
 #endif  // !_MSC_VER
 
 AdminSplitRequest::AdminSplitRequest()
@@ -10430,6 +13408,7 @@ void AdminSplitRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  dry_run_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -10469,7 +13448,7 @@ AdminSplitRequest* AdminSplitRequest::New() const {
 }
 
 void AdminSplitRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 7) {
     if (has_header()) {
       if (header_ != NULL) header_->::proto::RequestHeader::Clear();
     }
@@ -10478,6 +13457,7 @@ void AdminSplitRequest::Clear() {
         split_key_->clear();
       }
     }
+    dry_run_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -10514,6 +13494,21 @@ bool AdminSplitRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(24)) goto parse_dry_run;
+        break;
+      }
+
+      // optional bool dry_run = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_dry_run:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &dry_run_)));
+          set_has_dry_run();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -10555,6 +13550,11 @@ void AdminSplitRequest::SerializeWithCachedSizes(
       2, this->split_key(), output);
   }
 
+  // optional bool dry_run = 3;
+  if (has_dry_run()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->dry_run(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -10579,6 +13579,11 @@ void AdminSplitRequest::SerializeWithCachedSizes(
         2, this->split_key(), target);
   }
 
+  // optional bool dry_run = 3;
+  if (has_dry_run()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->dry_run(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -10605,6 +13610,11 @@ int AdminSplitRequest::ByteSize() const {
           this->split_key());
     }
 
+    // optional bool dry_run = 3;
+    if (has_dry_run()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -10638,6 +13648,9 @@ void AdminSplitRequest::MergeFrom(const AdminSplitRequest& from) {
     if (from.has_split_key()) {
       set_split_key(from.split_key());
     }
+    if (from.has_dry_run()) {
+      set_dry_run(from.dry_run());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -10663,6 +13676,7 @@ void AdminSplitRequest::Swap(AdminSplitRequest* other) {
   if (other != this) {
     std::swap(header_, other->header_);
     std::swap(split_key_, other->split_key_);
+    std::swap(dry_run_, other->dry_run_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -10682,6 +13696,9 @@ void AdminSplitRequest::Swap(AdminSplitRequest* other) {
 
 #ifndef _MSC_VER
 const int AdminSplitResponse::kHeaderFieldNumber;
+const int AdminSplitResponse::kSplitKeyFieldNumber;
+const int AdminSplitResponse::kLeftBytesFieldNumber;
+const int AdminSplitResponse::kRightBytesFieldNumber;
 #endif  // !_MSC_VER
 
 AdminSplitResponse::AdminSplitResponse()
@@ -10702,8 +13719,12 @@ AdminSplitResponse::AdminSplitResponse(const AdminSplitResponse& from)
 }
 
 void AdminSplitResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   header_ = NULL;
+  split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  left_bytes_ = GOOGLE_LONGLONG(0);
+  right_bytes_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -10713,6 +13734,9 @@ AdminSplitResponse::~AdminSplitResponse() {
 }
 
 void AdminSplitResponse::SharedDtor() {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete split_key_;
+  }
   if (this != default_instance_) {
     delete header_;
   }
@@ -10740,9 +13764,31 @@ AdminSplitResponse* AdminSplitResponse::New() const {
 }
 
 void AdminSplitResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<AdminSplitResponse*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(left_bytes_, right_bytes_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    if (has_split_key()) {
+      if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        split_key_->clear();
+      }
+    }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -10765,6 +13811,49 @@ bool AdminSplitResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(18)) goto parse_split_key;
+        break;
+      }
+
+      // optional bytes split_key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_split_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_split_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_left_bytes;
+        break;
+      }
+
+      // optional int64 left_bytes = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_left_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &left_bytes_)));
+          set_has_left_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_right_bytes;
+        break;
+      }
+
+      // optional int64 right_bytes = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_right_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &right_bytes_)));
+          set_has_right_bytes();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -10800,6 +13889,22 @@ void AdminSplitResponse::SerializeWithCachedSizes(
       1, this->header(), output);
   }
 
+  // optional bytes split_key = 2;
+  if (has_split_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->split_key(), output);
+  }
+
+  // optional int64 left_bytes = 3;
+  if (has_left_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->left_bytes(), output);
+  }
+
+  // optional int64 right_bytes = 4;
+  if (has_right_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->right_bytes(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -10817,6 +13922,23 @@ void AdminSplitResponse::SerializeWithCachedSizes(
         1, this->header(), target);
   }
 
+  // optional bytes split_key = 2;
+  if (has_split_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->split_key(), target);
+  }
+
+  // optional int64 left_bytes = 3;
+  if (has_left_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->left_bytes(), target);
+  }
+
+  // optional int64 right_bytes = 4;
+  if (has_right_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->right_bytes(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -10836,6 +13958,27 @@ int AdminSplitResponse::ByteSize() const {
           this->header());
     }
 
+    // optional bytes split_key = 2;
+    if (has_split_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->split_key());
+    }
+
+    // optional int64 left_bytes = 3;
+    if (has_left_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->left_bytes());
+    }
+
+    // optional int64 right_bytes = 4;
+    if (has_right_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->right_bytes());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -10866,6 +14009,15 @@ void AdminSplitResponse::MergeFrom(const AdminSplitResponse& from) {
     if (from.has_header()) {
       mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
     }
+    if (from.has_split_key()) {
+      set_split_key(from.split_key());
+    }
+    if (from.has_left_bytes()) {
+      set_left_bytes(from.left_bytes());
+    }
+    if (from.has_right_bytes()) {
+      set_right_bytes(from.right_bytes());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -10890,6 +14042,9 @@ bool AdminSplitResponse::IsInitialized() const {
 void AdminSplitResponse::Swap(AdminSplitResponse* other) {
   if (other != this) {
     std::swap(header_, other->header_);
+    std::swap(split_key_, other->split_key_);
+    std::swap(left_bytes_, other->left_bytes_);
+    std::swap(right_bytes_, other->right_bytes_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
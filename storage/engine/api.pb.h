@@ -39,6 +39,7 @@ void protobuf_ShutdownFile_api_2eproto();
 
 class ClientCmdID;
 class RequestHeader;
+class MVCCStatsDelta;
 class ResponseHeader;
 class ContainsRequest;
 class ContainsResponse;
@@ -64,6 +65,10 @@ class EnqueueUpdateRequest;
 class EnqueueUpdateResponse;
 class EnqueueMessageRequest;
 class EnqueueMessageResponse;
+class ReverseScanRequest;
+class ReverseScanResponse;
+class ConditionalDeleteRequest;
+class ConditionalDeleteResponse;
 class RequestUnion;
 class ResponseUnion;
 class BatchRequest;
@@ -303,6 +308,34 @@ class RequestHeader : public ::google::protobuf::Message {
   inline ::proto::Transaction* release_txn();
   inline void set_allocated_txn(::proto::Transaction* txn);
 
+  // optional int64 conflict_timeout = 10;
+  inline bool has_conflict_timeout() const;
+  inline void clear_conflict_timeout();
+  static const int kConflictTimeoutFieldNumber = 10;
+  inline ::google::protobuf::int64 conflict_timeout() const;
+  inline void set_conflict_timeout(::google::protobuf::int64 value);
+
+  // optional int64 max_staleness = 11;
+  inline bool has_max_staleness() const;
+  inline void clear_max_staleness();
+  static const int kMaxStalenessFieldNumber = 11;
+  inline ::google::protobuf::int64 max_staleness() const;
+  inline void set_max_staleness(::google::protobuf::int64 value);
+
+  // optional int64 timeout = 12;
+  inline bool has_timeout() const;
+  inline void clear_timeout();
+  static const int kTimeoutFieldNumber = 12;
+  inline ::google::protobuf::int64 timeout() const;
+  inline void set_timeout(::google::protobuf::int64 value);
+
+  // optional bool clamp_to_closed_timestamp = 13;
+  inline bool has_clamp_to_closed_timestamp() const;
+  inline void clear_clamp_to_closed_timestamp();
+  static const int kClampToClosedTimestampFieldNumber = 13;
+  inline bool clamp_to_closed_timestamp() const;
+  inline void set_clamp_to_closed_timestamp(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.RequestHeader)
  private:
   inline void set_has_timestamp();
@@ -323,6 +356,14 @@ class RequestHeader : public ::google::protobuf::Message {
   inline void clear_has_user_priority();
   inline void set_has_txn();
   inline void clear_has_txn();
+  inline void set_has_conflict_timeout();
+  inline void clear_has_conflict_timeout();
+  inline void set_has_max_staleness();
+  inline void clear_has_max_staleness();
+  inline void set_has_timeout();
+  inline void clear_has_timeout();
+  inline void set_has_clamp_to_closed_timestamp();
+  inline void clear_has_clamp_to_closed_timestamp();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -336,7 +377,11 @@ class RequestHeader : public ::google::protobuf::Message {
   ::proto::Replica* replica_;
   ::google::protobuf::int64 raft_id_;
   ::proto::Transaction* txn_;
+  ::google::protobuf::int64 conflict_timeout_;
   ::google::protobuf::int32 user_priority_;
+  bool clamp_to_closed_timestamp_;
+  ::google::protobuf::int64 max_staleness_;
+  ::google::protobuf::int64 timeout_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -346,6 +391,155 @@ class RequestHeader : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class MVCCStatsDelta : public ::google::protobuf::Message {
+ public:
+  MVCCStatsDelta();
+  virtual ~MVCCStatsDelta();
+
+  MVCCStatsDelta(const MVCCStatsDelta& from);
+
+  inline MVCCStatsDelta& operator=(const MVCCStatsDelta& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const MVCCStatsDelta& default_instance();
+
+  void Swap(MVCCStatsDelta* other);
+
+  // implements Message ----------------------------------------------
+
+  MVCCStatsDelta* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const MVCCStatsDelta& from);
+  void MergeFrom(const MVCCStatsDelta& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 live_bytes = 1;
+  inline bool has_live_bytes() const;
+  inline void clear_live_bytes();
+  static const int kLiveBytesFieldNumber = 1;
+  inline ::google::protobuf::int64 live_bytes() const;
+  inline void set_live_bytes(::google::protobuf::int64 value);
+
+  // optional int64 key_bytes = 2;
+  inline bool has_key_bytes() const;
+  inline void clear_key_bytes();
+  static const int kKeyBytesFieldNumber = 2;
+  inline ::google::protobuf::int64 key_bytes() const;
+  inline void set_key_bytes(::google::protobuf::int64 value);
+
+  // optional int64 val_bytes = 3;
+  inline bool has_val_bytes() const;
+  inline void clear_val_bytes();
+  static const int kValBytesFieldNumber = 3;
+  inline ::google::protobuf::int64 val_bytes() const;
+  inline void set_val_bytes(::google::protobuf::int64 value);
+
+  // optional int64 intent_bytes = 4;
+  inline bool has_intent_bytes() const;
+  inline void clear_intent_bytes();
+  static const int kIntentBytesFieldNumber = 4;
+  inline ::google::protobuf::int64 intent_bytes() const;
+  inline void set_intent_bytes(::google::protobuf::int64 value);
+
+  // optional int64 live_count = 5;
+  inline bool has_live_count() const;
+  inline void clear_live_count();
+  static const int kLiveCountFieldNumber = 5;
+  inline ::google::protobuf::int64 live_count() const;
+  inline void set_live_count(::google::protobuf::int64 value);
+
+  // optional int64 key_count = 6;
+  inline bool has_key_count() const;
+  inline void clear_key_count();
+  static const int kKeyCountFieldNumber = 6;
+  inline ::google::protobuf::int64 key_count() const;
+  inline void set_key_count(::google::protobuf::int64 value);
+
+  // optional int64 val_count = 7;
+  inline bool has_val_count() const;
+  inline void clear_val_count();
+  static const int kValCountFieldNumber = 7;
+  inline ::google::protobuf::int64 val_count() const;
+  inline void set_val_count(::google::protobuf::int64 value);
+
+  // optional int64 intent_count = 8;
+  inline bool has_intent_count() const;
+  inline void clear_intent_count();
+  static const int kIntentCountFieldNumber = 8;
+  inline ::google::protobuf::int64 intent_count() const;
+  inline void set_intent_count(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.MVCCStatsDelta)
+ private:
+  inline void set_has_live_bytes();
+  inline void clear_has_live_bytes();
+  inline void set_has_key_bytes();
+  inline void clear_has_key_bytes();
+  inline void set_has_val_bytes();
+  inline void clear_has_val_bytes();
+  inline void set_has_intent_bytes();
+  inline void clear_has_intent_bytes();
+  inline void set_has_live_count();
+  inline void clear_has_live_count();
+  inline void set_has_key_count();
+  inline void clear_has_key_count();
+  inline void set_has_val_count();
+  inline void clear_has_val_count();
+  inline void set_has_intent_count();
+  inline void clear_has_intent_count();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 live_bytes_;
+  ::google::protobuf::int64 key_bytes_;
+  ::google::protobuf::int64 val_bytes_;
+  ::google::protobuf::int64 intent_bytes_;
+  ::google::protobuf::int64 live_count_;
+  ::google::protobuf::int64 key_count_;
+  ::google::protobuf::int64 val_count_;
+  ::google::protobuf::int64 intent_count_;
+  friend void  protobuf_AddDesc_api_2eproto();
+  friend void protobuf_AssignDesc_api_2eproto();
+  friend void protobuf_ShutdownFile_api_2eproto();
+
+  void InitAsDefaultInstance();
+  static MVCCStatsDelta* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class ResponseHeader : public ::google::protobuf::Message {
  public:
   ResponseHeader();
@@ -426,6 +620,15 @@ class ResponseHeader : public ::google::protobuf::Message {
   inline ::proto::Transaction* release_txn();
   inline void set_allocated_txn(::proto::Transaction* txn);
 
+  // optional .proto.MVCCStatsDelta stats_delta = 4;
+  inline bool has_stats_delta() const;
+  inline void clear_stats_delta();
+  static const int kStatsDeltaFieldNumber = 4;
+  inline const ::proto::MVCCStatsDelta& stats_delta() const;
+  inline ::proto::MVCCStatsDelta* mutable_stats_delta();
+  inline ::proto::MVCCStatsDelta* release_stats_delta();
+  inline void set_allocated_stats_delta(::proto::MVCCStatsDelta* stats_delta);
+
   // @@protoc_insertion_point(class_scope:proto.ResponseHeader)
  private:
   inline void set_has_error();
@@ -434,6 +637,8 @@ class ResponseHeader : public ::google::protobuf::Message {
   inline void clear_has_timestamp();
   inline void set_has_txn();
   inline void clear_has_txn();
+  inline void set_has_stats_delta();
+  inline void clear_has_stats_delta();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -442,6 +647,7 @@ class ResponseHeader : public ::google::protobuf::Message {
   ::proto::Error* error_;
   ::proto::Timestamp* timestamp_;
   ::proto::Transaction* txn_;
+  ::proto::MVCCStatsDelta* stats_delta_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -685,16 +891,26 @@ class GetRequest : public ::google::protobuf::Message {
   inline ::proto::RequestHeader* release_header();
   inline void set_allocated_header(::proto::RequestHeader* header);
 
+  // optional bool lock = 2;
+  inline bool has_lock() const;
+  inline void clear_lock();
+  static const int kLockFieldNumber = 2;
+  inline bool lock() const;
+  inline void set_lock(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.GetRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_lock();
+  inline void clear_has_lock();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::proto::RequestHeader* header_;
+  bool lock_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -868,12 +1084,21 @@ class PutRequest : public ::google::protobuf::Message {
   inline ::proto::Value* release_value();
   inline void set_allocated_value(::proto::Value* value);
 
+  // optional bool coalesce = 3;
+  inline bool has_coalesce() const;
+  inline void clear_coalesce();
+  static const int kCoalesceFieldNumber = 3;
+  inline bool coalesce() const;
+  inline void set_coalesce(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.PutRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_value();
   inline void clear_has_value();
+  inline void set_has_coalesce();
+  inline void clear_has_coalesce();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -881,6 +1106,7 @@ class PutRequest : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::RequestHeader* header_;
   ::proto::Value* value_;
+  bool coalesce_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -1226,12 +1452,30 @@ class IncrementRequest : public ::google::protobuf::Message {
   inline ::google::protobuf::int64 increment() const;
   inline void set_increment(::google::protobuf::int64 value);
 
+  // optional int64 initial_value = 3;
+  inline bool has_initial_value() const;
+  inline void clear_initial_value();
+  static const int kInitialValueFieldNumber = 3;
+  inline ::google::protobuf::int64 initial_value() const;
+  inline void set_initial_value(::google::protobuf::int64 value);
+
+  // optional int64 max = 4;
+  inline bool has_max() const;
+  inline void clear_max();
+  static const int kMaxFieldNumber = 4;
+  inline ::google::protobuf::int64 max() const;
+  inline void set_max(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:proto.IncrementRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_increment();
   inline void clear_has_increment();
+  inline void set_has_initial_value();
+  inline void clear_has_initial_value();
+  inline void set_has_max();
+  inline void clear_has_max();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1239,6 +1483,8 @@ class IncrementRequest : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::RequestHeader* header_;
   ::google::protobuf::int64 increment_;
+  ::google::protobuf::int64 initial_value_;
+  ::google::protobuf::int64 max_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -1752,12 +1998,53 @@ class ScanRequest : public ::google::protobuf::Message {
   inline ::google::protobuf::int64 max_results() const;
   inline void set_max_results(::google::protobuf::int64 value);
 
+  // optional bool order_by_timestamp = 3;
+  inline bool has_order_by_timestamp() const;
+  inline void clear_order_by_timestamp();
+  static const int kOrderByTimestampFieldNumber = 3;
+  inline bool order_by_timestamp() const;
+  inline void set_order_by_timestamp(bool value);
+
+  // optional bytes resume_token = 4;
+  inline bool has_resume_token() const;
+  inline void clear_resume_token();
+  static const int kResumeTokenFieldNumber = 4;
+  inline const ::std::string& resume_token() const;
+  inline void set_resume_token(const ::std::string& value);
+  inline void set_resume_token(const char* value);
+  inline void set_resume_token(const void* value, size_t size);
+  inline ::std::string* mutable_resume_token();
+  inline ::std::string* release_resume_token();
+  inline void set_allocated_resume_token(::std::string* resume_token);
+
+  // optional int32 parallelism = 5;
+  inline bool has_parallelism() const;
+  inline void clear_parallelism();
+  static const int kParallelismFieldNumber = 5;
+  inline ::google::protobuf::int32 parallelism() const;
+  inline void set_parallelism(::google::protobuf::int32 value);
+
+  // optional bool return_stats = 6;
+  inline bool has_return_stats() const;
+  inline void clear_return_stats();
+  static const int kReturnStatsFieldNumber = 6;
+  inline bool return_stats() const;
+  inline void set_return_stats(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.ScanRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_max_results();
   inline void clear_has_max_results();
+  inline void set_has_order_by_timestamp();
+  inline void clear_has_order_by_timestamp();
+  inline void set_has_resume_token();
+  inline void clear_has_resume_token();
+  inline void set_has_parallelism();
+  inline void clear_has_parallelism();
+  inline void set_has_return_stats();
+  inline void clear_has_return_stats();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1765,6 +2052,10 @@ class ScanRequest : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::RequestHeader* header_;
   ::google::protobuf::int64 max_results_;
+  ::std::string* resume_token_;
+  bool order_by_timestamp_;
+  bool return_stats_;
+  ::google::protobuf::int32 parallelism_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -1848,10 +2139,69 @@ class ScanResponse : public ::google::protobuf::Message {
   inline ::google::protobuf::RepeatedPtrField< ::proto::KeyValue >*
       mutable_rows();
 
+  // optional int64 keys_examined = 3;
+  inline bool has_keys_examined() const;
+  inline void clear_keys_examined();
+  static const int kKeysExaminedFieldNumber = 3;
+  inline ::google::protobuf::int64 keys_examined() const;
+  inline void set_keys_examined(::google::protobuf::int64 value);
+
+  // optional int64 versions_examined = 4;
+  inline bool has_versions_examined() const;
+  inline void clear_versions_examined();
+  static const int kVersionsExaminedFieldNumber = 4;
+  inline ::google::protobuf::int64 versions_examined() const;
+  inline void set_versions_examined(::google::protobuf::int64 value);
+
+  // optional int64 versions_skipped = 5;
+  inline bool has_versions_skipped() const;
+  inline void clear_versions_skipped();
+  static const int kVersionsSkippedFieldNumber = 5;
+  inline ::google::protobuf::int64 versions_skipped() const;
+  inline void set_versions_skipped(::google::protobuf::int64 value);
+
+  // optional int64 intents_encountered = 6;
+  inline bool has_intents_encountered() const;
+  inline void clear_intents_encountered();
+  static const int kIntentsEncounteredFieldNumber = 6;
+  inline ::google::protobuf::int64 intents_encountered() const;
+  inline void set_intents_encountered(::google::protobuf::int64 value);
+
+  // optional bytes resume_token = 7;
+  inline bool has_resume_token() const;
+  inline void clear_resume_token();
+  static const int kResumeTokenFieldNumber = 7;
+  inline const ::std::string& resume_token() const;
+  inline void set_resume_token(const ::std::string& value);
+  inline void set_resume_token(const char* value);
+  inline void set_resume_token(const void* value, size_t size);
+  inline ::std::string* mutable_resume_token();
+  inline ::std::string* release_resume_token();
+  inline void set_allocated_resume_token(::std::string* resume_token);
+
+  // optional int64 tombstones_skipped = 8;
+  inline bool has_tombstones_skipped() const;
+  inline void clear_tombstones_skipped();
+  static const int kTombstonesSkippedFieldNumber = 8;
+  inline ::google::protobuf::int64 tombstones_skipped() const;
+  inline void set_tombstones_skipped(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:proto.ScanResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_keys_examined();
+  inline void clear_has_keys_examined();
+  inline void set_has_versions_examined();
+  inline void clear_has_versions_examined();
+  inline void set_has_versions_skipped();
+  inline void clear_has_versions_skipped();
+  inline void set_has_intents_encountered();
+  inline void clear_has_intents_encountered();
+  inline void set_has_resume_token();
+  inline void clear_has_resume_token();
+  inline void set_has_tombstones_skipped();
+  inline void clear_has_tombstones_skipped();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1859,6 +2209,12 @@ class ScanResponse : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::ResponseHeader* header_;
   ::google::protobuf::RepeatedPtrField< ::proto::KeyValue > rows_;
+  ::google::protobuf::int64 keys_examined_;
+  ::google::protobuf::int64 versions_examined_;
+  ::google::protobuf::int64 versions_skipped_;
+  ::google::protobuf::int64 intents_encountered_;
+  ::std::string* resume_token_;
+  ::google::protobuf::int64 tombstones_skipped_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -1946,6 +2302,41 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   inline ::proto::InternalCommitTrigger* release_internal_commit_trigger();
   inline void set_allocated_internal_commit_trigger(::proto::InternalCommitTrigger* internal_commit_trigger);
 
+  // optional bool durable = 4;
+  inline bool has_durable() const;
+  inline void clear_durable();
+  static const int kDurableFieldNumber = 4;
+  inline bool durable() const;
+  inline void set_durable(bool value);
+
+  // repeated bytes intents = 5;
+  inline int intents_size() const;
+  inline void clear_intents();
+  static const int kIntentsFieldNumber = 5;
+  inline const ::std::string& intents(int index) const;
+  inline ::std::string* mutable_intents(int index);
+  inline void set_intents(int index, const ::std::string& value);
+  inline void set_intents(int index, const char* value);
+  inline void set_intents(int index, const void* value, size_t size);
+  inline ::std::string* add_intents();
+  inline void add_intents(const ::std::string& value);
+  inline void add_intents(const char* value);
+  inline void add_intents(const void* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& intents() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_intents();
+
+  // repeated .proto.KeySpan refresh_spans = 6;
+  inline int refresh_spans_size() const;
+  inline void clear_refresh_spans();
+  static const int kRefreshSpansFieldNumber = 6;
+  inline const ::proto::KeySpan& refresh_spans(int index) const;
+  inline ::proto::KeySpan* mutable_refresh_spans(int index);
+  inline ::proto::KeySpan* add_refresh_spans();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >&
+      refresh_spans() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >*
+      mutable_refresh_spans();
+
   // @@protoc_insertion_point(class_scope:proto.EndTransactionRequest)
  private:
   inline void set_has_header();
@@ -1954,6 +2345,8 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   inline void clear_has_commit();
   inline void set_has_internal_commit_trigger();
   inline void clear_has_internal_commit_trigger();
+  inline void set_has_durable();
+  inline void clear_has_durable();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1961,7 +2354,10 @@ class EndTransactionRequest : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::RequestHeader* header_;
   ::proto::InternalCommitTrigger* internal_commit_trigger_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> intents_;
+  ::google::protobuf::RepeatedPtrField< ::proto::KeySpan > refresh_spans_;
   bool commit_;
+  bool durable_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -2040,12 +2436,32 @@ class EndTransactionResponse : public ::google::protobuf::Message {
   inline ::google::protobuf::int64 commit_wait() const;
   inline void set_commit_wait(::google::protobuf::int64 value);
 
+  // optional int32 restarts = 3;
+  inline bool has_restarts() const;
+  inline void clear_restarts();
+  static const int kRestartsFieldNumber = 3;
+  inline ::google::protobuf::int32 restarts() const;
+  inline void set_restarts(::google::protobuf::int32 value);
+
+  // optional .proto.Timestamp commit_timestamp = 4;
+  inline bool has_commit_timestamp() const;
+  inline void clear_commit_timestamp();
+  static const int kCommitTimestampFieldNumber = 4;
+  inline const ::proto::Timestamp& commit_timestamp() const;
+  inline ::proto::Timestamp* mutable_commit_timestamp();
+  inline ::proto::Timestamp* release_commit_timestamp();
+  inline void set_allocated_commit_timestamp(::proto::Timestamp* commit_timestamp);
+
   // @@protoc_insertion_point(class_scope:proto.EndTransactionResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_commit_wait();
   inline void clear_has_commit_wait();
+  inline void set_has_restarts();
+  inline void clear_has_restarts();
+  inline void set_has_commit_timestamp();
+  inline void clear_has_commit_timestamp();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -2053,6 +2469,8 @@ class EndTransactionResponse : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::ResponseHeader* header_;
   ::google::protobuf::int64 commit_wait_;
+  ::proto::Timestamp* commit_timestamp_;
+  ::google::protobuf::int32 restarts_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -2583,14 +3001,14 @@ class EnqueueMessageResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class RequestUnion : public ::google::protobuf::Message {
+class ReverseScanRequest : public ::google::protobuf::Message {
  public:
-  RequestUnion();
-  virtual ~RequestUnion();
+  ReverseScanRequest();
+  virtual ~ReverseScanRequest();
 
-  RequestUnion(const RequestUnion& from);
+  ReverseScanRequest(const ReverseScanRequest& from);
 
-  inline RequestUnion& operator=(const RequestUnion& from) {
+  inline ReverseScanRequest& operator=(const ReverseScanRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2604,17 +3022,17 @@ class RequestUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RequestUnion& default_instance();
+  static const ReverseScanRequest& default_instance();
 
-  void Swap(RequestUnion* other);
+  void Swap(ReverseScanRequest* other);
 
   // implements Message ----------------------------------------------
 
-  RequestUnion* New() const;
+  ReverseScanRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RequestUnion& from);
-  void MergeFrom(const RequestUnion& from);
+  void CopyFrom(const ReverseScanRequest& from);
+  void MergeFrom(const ReverseScanRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2636,174 +3054,52 @@ class RequestUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .proto.ContainsRequest contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::proto::ContainsRequest& contains() const;
-  inline ::proto::ContainsRequest* mutable_contains();
-  inline ::proto::ContainsRequest* release_contains();
-  inline void set_allocated_contains(::proto::ContainsRequest* contains);
-
-  // optional .proto.GetRequest get = 2;
-  inline bool has_get() const;
-  inline void clear_get();
-  static const int kGetFieldNumber = 2;
-  inline const ::proto::GetRequest& get() const;
-  inline ::proto::GetRequest* mutable_get();
-  inline ::proto::GetRequest* release_get();
-  inline void set_allocated_get(::proto::GetRequest* get);
-
-  // optional .proto.PutRequest put = 3;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 3;
-  inline const ::proto::PutRequest& put() const;
-  inline ::proto::PutRequest* mutable_put();
-  inline ::proto::PutRequest* release_put();
-  inline void set_allocated_put(::proto::PutRequest* put);
-
-  // optional .proto.ConditionalPutRequest conditional_put = 4;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  inline const ::proto::ConditionalPutRequest& conditional_put() const;
-  inline ::proto::ConditionalPutRequest* mutable_conditional_put();
-  inline ::proto::ConditionalPutRequest* release_conditional_put();
-  inline void set_allocated_conditional_put(::proto::ConditionalPutRequest* conditional_put);
-
-  // optional .proto.IncrementRequest increment = 5;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  inline const ::proto::IncrementRequest& increment() const;
-  inline ::proto::IncrementRequest* mutable_increment();
-  inline ::proto::IncrementRequest* release_increment();
-  inline void set_allocated_increment(::proto::IncrementRequest* increment);
-
-  // optional .proto.DeleteRequest delete = 6;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  inline const ::proto::DeleteRequest& delete_() const;
-  inline ::proto::DeleteRequest* mutable_delete_();
-  inline ::proto::DeleteRequest* release_delete_();
-  inline void set_allocated_delete_(::proto::DeleteRequest* delete_);
-
-  // optional .proto.DeleteRangeRequest delete_range = 7;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  inline const ::proto::DeleteRangeRequest& delete_range() const;
-  inline ::proto::DeleteRangeRequest* mutable_delete_range();
-  inline ::proto::DeleteRangeRequest* release_delete_range();
-  inline void set_allocated_delete_range(::proto::DeleteRangeRequest* delete_range);
-
-  // optional .proto.ScanRequest scan = 8;
-  inline bool has_scan() const;
-  inline void clear_scan();
-  static const int kScanFieldNumber = 8;
-  inline const ::proto::ScanRequest& scan() const;
-  inline ::proto::ScanRequest* mutable_scan();
-  inline ::proto::ScanRequest* release_scan();
-  inline void set_allocated_scan(::proto::ScanRequest* scan);
-
-  // optional .proto.EndTransactionRequest end_transaction = 9;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  inline const ::proto::EndTransactionRequest& end_transaction() const;
-  inline ::proto::EndTransactionRequest* mutable_end_transaction();
-  inline ::proto::EndTransactionRequest* release_end_transaction();
-  inline void set_allocated_end_transaction(::proto::EndTransactionRequest* end_transaction);
-
-  // optional .proto.ReapQueueRequest reap_queue = 10;
-  inline bool has_reap_queue() const;
-  inline void clear_reap_queue();
-  static const int kReapQueueFieldNumber = 10;
-  inline const ::proto::ReapQueueRequest& reap_queue() const;
-  inline ::proto::ReapQueueRequest* mutable_reap_queue();
-  inline ::proto::ReapQueueRequest* release_reap_queue();
-  inline void set_allocated_reap_queue(::proto::ReapQueueRequest* reap_queue);
-
-  // optional .proto.EnqueueUpdateRequest enqueue_update = 11;
-  inline bool has_enqueue_update() const;
-  inline void clear_enqueue_update();
-  static const int kEnqueueUpdateFieldNumber = 11;
-  inline const ::proto::EnqueueUpdateRequest& enqueue_update() const;
-  inline ::proto::EnqueueUpdateRequest* mutable_enqueue_update();
-  inline ::proto::EnqueueUpdateRequest* release_enqueue_update();
-  inline void set_allocated_enqueue_update(::proto::EnqueueUpdateRequest* enqueue_update);
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
 
-  // optional .proto.EnqueueMessageRequest enqueue_message = 12;
-  inline bool has_enqueue_message() const;
-  inline void clear_enqueue_message();
-  static const int kEnqueueMessageFieldNumber = 12;
-  inline const ::proto::EnqueueMessageRequest& enqueue_message() const;
-  inline ::proto::EnqueueMessageRequest* mutable_enqueue_message();
-  inline ::proto::EnqueueMessageRequest* release_enqueue_message();
-  inline void set_allocated_enqueue_message(::proto::EnqueueMessageRequest* enqueue_message);
+  // optional int64 max_results = 2;
+  inline bool has_max_results() const;
+  inline void clear_max_results();
+  static const int kMaxResultsFieldNumber = 2;
+  inline ::google::protobuf::int64 max_results() const;
+  inline void set_max_results(::google::protobuf::int64 value);
 
-  // @@protoc_insertion_point(class_scope:proto.RequestUnion)
+  // @@protoc_insertion_point(class_scope:proto.ReverseScanRequest)
  private:
-  inline void set_has_contains();
-  inline void clear_has_contains();
-  inline void set_has_get();
-  inline void clear_has_get();
-  inline void set_has_put();
-  inline void clear_has_put();
-  inline void set_has_conditional_put();
-  inline void clear_has_conditional_put();
-  inline void set_has_increment();
-  inline void clear_has_increment();
-  inline void set_has_delete_();
-  inline void clear_has_delete_();
-  inline void set_has_delete_range();
-  inline void clear_has_delete_range();
-  inline void set_has_scan();
-  inline void clear_has_scan();
-  inline void set_has_end_transaction();
-  inline void clear_has_end_transaction();
-  inline void set_has_reap_queue();
-  inline void clear_has_reap_queue();
-  inline void set_has_enqueue_update();
-  inline void clear_has_enqueue_update();
-  inline void set_has_enqueue_message();
-  inline void clear_has_enqueue_message();
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_max_results();
+  inline void clear_has_max_results();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::proto::ContainsRequest* contains_;
-  ::proto::GetRequest* get_;
-  ::proto::PutRequest* put_;
-  ::proto::ConditionalPutRequest* conditional_put_;
-  ::proto::IncrementRequest* increment_;
-  ::proto::DeleteRequest* delete__;
-  ::proto::DeleteRangeRequest* delete_range_;
-  ::proto::ScanRequest* scan_;
-  ::proto::EndTransactionRequest* end_transaction_;
-  ::proto::ReapQueueRequest* reap_queue_;
-  ::proto::EnqueueUpdateRequest* enqueue_update_;
-  ::proto::EnqueueMessageRequest* enqueue_message_;
+  ::proto::RequestHeader* header_;
+  ::google::protobuf::int64 max_results_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
 
   void InitAsDefaultInstance();
-  static RequestUnion* default_instance_;
+  static ReverseScanRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class ResponseUnion : public ::google::protobuf::Message {
+class ReverseScanResponse : public ::google::protobuf::Message {
  public:
-  ResponseUnion();
-  virtual ~ResponseUnion();
+  ReverseScanResponse();
+  virtual ~ReverseScanResponse();
 
-  ResponseUnion(const ResponseUnion& from);
+  ReverseScanResponse(const ReverseScanResponse& from);
 
-  inline ResponseUnion& operator=(const ResponseUnion& from) {
+  inline ReverseScanResponse& operator=(const ReverseScanResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2817,17 +3113,17 @@ class ResponseUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ResponseUnion& default_instance();
+  static const ReverseScanResponse& default_instance();
 
-  void Swap(ResponseUnion* other);
+  void Swap(ReverseScanResponse* other);
 
   // implements Message ----------------------------------------------
 
-  ResponseUnion* New() const;
+  ReverseScanResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ResponseUnion& from);
-  void MergeFrom(const ResponseUnion& from);
+  void CopyFrom(const ReverseScanResponse& from);
+  void MergeFrom(const ReverseScanResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2849,11 +3145,516 @@ class ResponseUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .proto.ContainsResponse contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::proto::ContainsResponse& contains() const;
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // repeated .proto.KeyValue rows = 2;
+  inline int rows_size() const;
+  inline void clear_rows();
+  static const int kRowsFieldNumber = 2;
+  inline const ::proto::KeyValue& rows(int index) const;
+  inline ::proto::KeyValue* mutable_rows(int index);
+  inline ::proto::KeyValue* add_rows();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::KeyValue >&
+      rows() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::KeyValue >*
+      mutable_rows();
+
+  // @@protoc_insertion_point(class_scope:proto.ReverseScanResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::proto::KeyValue > rows_;
+  friend void  protobuf_AddDesc_api_2eproto();
+  friend void protobuf_AssignDesc_api_2eproto();
+  friend void protobuf_ShutdownFile_api_2eproto();
+
+  void InitAsDefaultInstance();
+  static ReverseScanResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ConditionalDeleteRequest : public ::google::protobuf::Message {
+ public:
+  ConditionalDeleteRequest();
+  virtual ~ConditionalDeleteRequest();
+
+  ConditionalDeleteRequest(const ConditionalDeleteRequest& from);
+
+  inline ConditionalDeleteRequest& operator=(const ConditionalDeleteRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ConditionalDeleteRequest& default_instance();
+
+  void Swap(ConditionalDeleteRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  ConditionalDeleteRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ConditionalDeleteRequest& from);
+  void MergeFrom(const ConditionalDeleteRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // optional .proto.Value exp_value = 2;
+  inline bool has_exp_value() const;
+  inline void clear_exp_value();
+  static const int kExpValueFieldNumber = 2;
+  inline const ::proto::Value& exp_value() const;
+  inline ::proto::Value* mutable_exp_value();
+  inline ::proto::Value* release_exp_value();
+  inline void set_allocated_exp_value(::proto::Value* exp_value);
+
+  // @@protoc_insertion_point(class_scope:proto.ConditionalDeleteRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_exp_value();
+  inline void clear_has_exp_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  ::proto::Value* exp_value_;
+  friend void  protobuf_AddDesc_api_2eproto();
+  friend void protobuf_AssignDesc_api_2eproto();
+  friend void protobuf_ShutdownFile_api_2eproto();
+
+  void InitAsDefaultInstance();
+  static ConditionalDeleteRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ConditionalDeleteResponse : public ::google::protobuf::Message {
+ public:
+  ConditionalDeleteResponse();
+  virtual ~ConditionalDeleteResponse();
+
+  ConditionalDeleteResponse(const ConditionalDeleteResponse& from);
+
+  inline ConditionalDeleteResponse& operator=(const ConditionalDeleteResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ConditionalDeleteResponse& default_instance();
+
+  void Swap(ConditionalDeleteResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  ConditionalDeleteResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ConditionalDeleteResponse& from);
+  void MergeFrom(const ConditionalDeleteResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:proto.ConditionalDeleteResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_api_2eproto();
+  friend void protobuf_AssignDesc_api_2eproto();
+  friend void protobuf_ShutdownFile_api_2eproto();
+
+  void InitAsDefaultInstance();
+  static ConditionalDeleteResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RequestUnion : public ::google::protobuf::Message {
+ public:
+  RequestUnion();
+  virtual ~RequestUnion();
+
+  RequestUnion(const RequestUnion& from);
+
+  inline RequestUnion& operator=(const RequestUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RequestUnion& default_instance();
+
+  void Swap(RequestUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  RequestUnion* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RequestUnion& from);
+  void MergeFrom(const RequestUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ContainsRequest contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::proto::ContainsRequest& contains() const;
+  inline ::proto::ContainsRequest* mutable_contains();
+  inline ::proto::ContainsRequest* release_contains();
+  inline void set_allocated_contains(::proto::ContainsRequest* contains);
+
+  // optional .proto.GetRequest get = 2;
+  inline bool has_get() const;
+  inline void clear_get();
+  static const int kGetFieldNumber = 2;
+  inline const ::proto::GetRequest& get() const;
+  inline ::proto::GetRequest* mutable_get();
+  inline ::proto::GetRequest* release_get();
+  inline void set_allocated_get(::proto::GetRequest* get);
+
+  // optional .proto.PutRequest put = 3;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 3;
+  inline const ::proto::PutRequest& put() const;
+  inline ::proto::PutRequest* mutable_put();
+  inline ::proto::PutRequest* release_put();
+  inline void set_allocated_put(::proto::PutRequest* put);
+
+  // optional .proto.ConditionalPutRequest conditional_put = 4;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  inline const ::proto::ConditionalPutRequest& conditional_put() const;
+  inline ::proto::ConditionalPutRequest* mutable_conditional_put();
+  inline ::proto::ConditionalPutRequest* release_conditional_put();
+  inline void set_allocated_conditional_put(::proto::ConditionalPutRequest* conditional_put);
+
+  // optional .proto.IncrementRequest increment = 5;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  inline const ::proto::IncrementRequest& increment() const;
+  inline ::proto::IncrementRequest* mutable_increment();
+  inline ::proto::IncrementRequest* release_increment();
+  inline void set_allocated_increment(::proto::IncrementRequest* increment);
+
+  // optional .proto.DeleteRequest delete = 6;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  inline const ::proto::DeleteRequest& delete_() const;
+  inline ::proto::DeleteRequest* mutable_delete_();
+  inline ::proto::DeleteRequest* release_delete_();
+  inline void set_allocated_delete_(::proto::DeleteRequest* delete_);
+
+  // optional .proto.DeleteRangeRequest delete_range = 7;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  inline const ::proto::DeleteRangeRequest& delete_range() const;
+  inline ::proto::DeleteRangeRequest* mutable_delete_range();
+  inline ::proto::DeleteRangeRequest* release_delete_range();
+  inline void set_allocated_delete_range(::proto::DeleteRangeRequest* delete_range);
+
+  // optional .proto.ScanRequest scan = 8;
+  inline bool has_scan() const;
+  inline void clear_scan();
+  static const int kScanFieldNumber = 8;
+  inline const ::proto::ScanRequest& scan() const;
+  inline ::proto::ScanRequest* mutable_scan();
+  inline ::proto::ScanRequest* release_scan();
+  inline void set_allocated_scan(::proto::ScanRequest* scan);
+
+  // optional .proto.EndTransactionRequest end_transaction = 9;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  inline const ::proto::EndTransactionRequest& end_transaction() const;
+  inline ::proto::EndTransactionRequest* mutable_end_transaction();
+  inline ::proto::EndTransactionRequest* release_end_transaction();
+  inline void set_allocated_end_transaction(::proto::EndTransactionRequest* end_transaction);
+
+  // optional .proto.ReapQueueRequest reap_queue = 10;
+  inline bool has_reap_queue() const;
+  inline void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 10;
+  inline const ::proto::ReapQueueRequest& reap_queue() const;
+  inline ::proto::ReapQueueRequest* mutable_reap_queue();
+  inline ::proto::ReapQueueRequest* release_reap_queue();
+  inline void set_allocated_reap_queue(::proto::ReapQueueRequest* reap_queue);
+
+  // optional .proto.EnqueueUpdateRequest enqueue_update = 11;
+  inline bool has_enqueue_update() const;
+  inline void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 11;
+  inline const ::proto::EnqueueUpdateRequest& enqueue_update() const;
+  inline ::proto::EnqueueUpdateRequest* mutable_enqueue_update();
+  inline ::proto::EnqueueUpdateRequest* release_enqueue_update();
+  inline void set_allocated_enqueue_update(::proto::EnqueueUpdateRequest* enqueue_update);
+
+  // optional .proto.EnqueueMessageRequest enqueue_message = 12;
+  inline bool has_enqueue_message() const;
+  inline void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 12;
+  inline const ::proto::EnqueueMessageRequest& enqueue_message() const;
+  inline ::proto::EnqueueMessageRequest* mutable_enqueue_message();
+  inline ::proto::EnqueueMessageRequest* release_enqueue_message();
+  inline void set_allocated_enqueue_message(::proto::EnqueueMessageRequest* enqueue_message);
+
+  // optional .proto.ReverseScanRequest reverse_scan = 13;
+  inline bool has_reverse_scan() const;
+  inline void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 13;
+  inline const ::proto::ReverseScanRequest& reverse_scan() const;
+  inline ::proto::ReverseScanRequest* mutable_reverse_scan();
+  inline ::proto::ReverseScanRequest* release_reverse_scan();
+  inline void set_allocated_reverse_scan(::proto::ReverseScanRequest* reverse_scan);
+
+  // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+  inline bool has_conditional_delete() const;
+  inline void clear_conditional_delete();
+  static const int kConditionalDeleteFieldNumber = 14;
+  inline const ::proto::ConditionalDeleteRequest& conditional_delete() const;
+  inline ::proto::ConditionalDeleteRequest* mutable_conditional_delete();
+  inline ::proto::ConditionalDeleteRequest* release_conditional_delete();
+  inline void set_allocated_conditional_delete(::proto::ConditionalDeleteRequest* conditional_delete);
+
+  // @@protoc_insertion_point(class_scope:proto.RequestUnion)
+ private:
+  inline void set_has_contains();
+  inline void clear_has_contains();
+  inline void set_has_get();
+  inline void clear_has_get();
+  inline void set_has_put();
+  inline void clear_has_put();
+  inline void set_has_conditional_put();
+  inline void clear_has_conditional_put();
+  inline void set_has_increment();
+  inline void clear_has_increment();
+  inline void set_has_delete_();
+  inline void clear_has_delete_();
+  inline void set_has_delete_range();
+  inline void clear_has_delete_range();
+  inline void set_has_scan();
+  inline void clear_has_scan();
+  inline void set_has_end_transaction();
+  inline void clear_has_end_transaction();
+  inline void set_has_reap_queue();
+  inline void clear_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void clear_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void clear_has_enqueue_message();
+  inline void set_has_reverse_scan();
+  inline void clear_has_reverse_scan();
+  inline void set_has_conditional_delete();
+  inline void clear_has_conditional_delete();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ContainsRequest* contains_;
+  ::proto::GetRequest* get_;
+  ::proto::PutRequest* put_;
+  ::proto::ConditionalPutRequest* conditional_put_;
+  ::proto::IncrementRequest* increment_;
+  ::proto::DeleteRequest* delete__;
+  ::proto::DeleteRangeRequest* delete_range_;
+  ::proto::ScanRequest* scan_;
+  ::proto::EndTransactionRequest* end_transaction_;
+  ::proto::ReapQueueRequest* reap_queue_;
+  ::proto::EnqueueUpdateRequest* enqueue_update_;
+  ::proto::EnqueueMessageRequest* enqueue_message_;
+  ::proto::ReverseScanRequest* reverse_scan_;
+  ::proto::ConditionalDeleteRequest* conditional_delete_;
+  friend void  protobuf_AddDesc_api_2eproto();
+  friend void protobuf_AssignDesc_api_2eproto();
+  friend void protobuf_ShutdownFile_api_2eproto();
+
+  void InitAsDefaultInstance();
+  static RequestUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ResponseUnion : public ::google::protobuf::Message {
+ public:
+  ResponseUnion();
+  virtual ~ResponseUnion();
+
+  ResponseUnion(const ResponseUnion& from);
+
+  inline ResponseUnion& operator=(const ResponseUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ResponseUnion& default_instance();
+
+  void Swap(ResponseUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  ResponseUnion* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ResponseUnion& from);
+  void MergeFrom(const ResponseUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ContainsResponse contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::proto::ContainsResponse& contains() const;
   inline ::proto::ContainsResponse* mutable_contains();
   inline ::proto::ContainsResponse* release_contains();
   inline void set_allocated_contains(::proto::ContainsResponse* contains);
@@ -2957,6 +3758,24 @@ class ResponseUnion : public ::google::protobuf::Message {
   inline ::proto::EnqueueMessageResponse* release_enqueue_message();
   inline void set_allocated_enqueue_message(::proto::EnqueueMessageResponse* enqueue_message);
 
+  // optional .proto.ReverseScanResponse reverse_scan = 13;
+  inline bool has_reverse_scan() const;
+  inline void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 13;
+  inline const ::proto::ReverseScanResponse& reverse_scan() const;
+  inline ::proto::ReverseScanResponse* mutable_reverse_scan();
+  inline ::proto::ReverseScanResponse* release_reverse_scan();
+  inline void set_allocated_reverse_scan(::proto::ReverseScanResponse* reverse_scan);
+
+  // optional .proto.ConditionalDeleteResponse conditional_delete = 14;
+  inline bool has_conditional_delete() const;
+  inline void clear_conditional_delete();
+  static const int kConditionalDeleteFieldNumber = 14;
+  inline const ::proto::ConditionalDeleteResponse& conditional_delete() const;
+  inline ::proto::ConditionalDeleteResponse* mutable_conditional_delete();
+  inline ::proto::ConditionalDeleteResponse* release_conditional_delete();
+  inline void set_allocated_conditional_delete(::proto::ConditionalDeleteResponse* conditional_delete);
+
   // @@protoc_insertion_point(class_scope:proto.ResponseUnion)
  private:
   inline void set_has_contains();
@@ -2983,6 +3802,10 @@ class ResponseUnion : public ::google::protobuf::Message {
   inline void clear_has_enqueue_update();
   inline void set_has_enqueue_message();
   inline void clear_has_enqueue_message();
+  inline void set_has_reverse_scan();
+  inline void clear_has_reverse_scan();
+  inline void set_has_conditional_delete();
+  inline void clear_has_conditional_delete();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -3000,6 +3823,8 @@ class ResponseUnion : public ::google::protobuf::Message {
   ::proto::ReapQueueResponse* reap_queue_;
   ::proto::EnqueueUpdateResponse* enqueue_update_;
   ::proto::EnqueueMessageResponse* enqueue_message_;
+  ::proto::ReverseScanResponse* reverse_scan_;
+  ::proto::ConditionalDeleteResponse* conditional_delete_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -3177,10 +4002,19 @@ class BatchResponse : public ::google::protobuf::Message {
   inline ::google::protobuf::RepeatedPtrField< ::proto::ResponseUnion >*
       mutable_responses();
 
+  // optional int32 completed = 3;
+  inline bool has_completed() const;
+  inline void clear_completed();
+  static const int kCompletedFieldNumber = 3;
+  inline ::google::protobuf::int32 completed() const;
+  inline void set_completed(::google::protobuf::int32 value);
+
   // @@protoc_insertion_point(class_scope:proto.BatchResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_completed();
+  inline void clear_has_completed();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -3188,6 +4022,7 @@ class BatchResponse : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::ResponseHeader* header_;
   ::google::protobuf::RepeatedPtrField< ::proto::ResponseUnion > responses_;
+  ::google::protobuf::int32 completed_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -3271,12 +4106,21 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   inline ::std::string* release_split_key();
   inline void set_allocated_split_key(::std::string* split_key);
 
+  // optional bool dry_run = 3;
+  inline bool has_dry_run() const;
+  inline void clear_dry_run();
+  static const int kDryRunFieldNumber = 3;
+  inline bool dry_run() const;
+  inline void set_dry_run(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.AdminSplitRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_split_key();
   inline void clear_has_split_key();
+  inline void set_has_dry_run();
+  inline void clear_has_dry_run();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -3284,6 +4128,7 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::RequestHeader* header_;
   ::std::string* split_key_;
+  bool dry_run_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -3355,16 +4200,51 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   inline ::proto::ResponseHeader* release_header();
   inline void set_allocated_header(::proto::ResponseHeader* header);
 
+  // optional bytes split_key = 2;
+  inline bool has_split_key() const;
+  inline void clear_split_key();
+  static const int kSplitKeyFieldNumber = 2;
+  inline const ::std::string& split_key() const;
+  inline void set_split_key(const ::std::string& value);
+  inline void set_split_key(const char* value);
+  inline void set_split_key(const void* value, size_t size);
+  inline ::std::string* mutable_split_key();
+  inline ::std::string* release_split_key();
+  inline void set_allocated_split_key(::std::string* split_key);
+
+  // optional int64 left_bytes = 3;
+  inline bool has_left_bytes() const;
+  inline void clear_left_bytes();
+  static const int kLeftBytesFieldNumber = 3;
+  inline ::google::protobuf::int64 left_bytes() const;
+  inline void set_left_bytes(::google::protobuf::int64 value);
+
+  // optional int64 right_bytes = 4;
+  inline bool has_right_bytes() const;
+  inline void clear_right_bytes();
+  static const int kRightBytesFieldNumber = 4;
+  inline ::google::protobuf::int64 right_bytes() const;
+  inline void set_right_bytes(::google::protobuf::int64 value);
+
   // @@protoc_insertion_point(class_scope:proto.AdminSplitResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_split_key();
+  inline void clear_has_split_key();
+  inline void set_has_left_bytes();
+  inline void clear_has_left_bytes();
+  inline void set_has_right_bytes();
+  inline void clear_has_right_bytes();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::proto::ResponseHeader* header_;
+  ::std::string* split_key_;
+  ::google::protobuf::int64 left_bytes_;
+  ::google::protobuf::int64 right_bytes_;
   friend void  protobuf_AddDesc_api_2eproto();
   friend void protobuf_AssignDesc_api_2eproto();
   friend void protobuf_ShutdownFile_api_2eproto();
@@ -4003,46 +4883,338 @@ inline void RequestHeader::set_user_priority(::google::protobuf::int32 value) {
   user_priority_ = value;
   // @@protoc_insertion_point(field_set:proto.RequestHeader.user_priority)
 }
-
-// optional .proto.Transaction txn = 9;
-inline bool RequestHeader::has_txn() const {
-  return (_has_bits_[0] & 0x00000100u) != 0;
+
+// optional .proto.Transaction txn = 9;
+inline bool RequestHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
+}
+inline void RequestHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000100u;
+}
+inline void RequestHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000100u;
+}
+inline void RequestHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::proto::Transaction& RequestHeader::txn() const {
+  // @@protoc_insertion_point(field_get:proto.RequestHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::proto::Transaction* RequestHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.RequestHeader.txn)
+  return txn_;
+}
+inline ::proto::Transaction* RequestHeader::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.RequestHeader.txn)
+}
+
+// optional int64 conflict_timeout = 10;
+inline bool RequestHeader::has_conflict_timeout() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
+}
+inline void RequestHeader::set_has_conflict_timeout() {
+  _has_bits_[0] |= 0x00000200u;
+}
+inline void RequestHeader::clear_has_conflict_timeout() {
+  _has_bits_[0] &= ~0x00000200u;
+}
+inline void RequestHeader::clear_conflict_timeout() {
+  conflict_timeout_ = GOOGLE_LONGLONG(0);
+  clear_has_conflict_timeout();
+}
+inline ::google::protobuf::int64 RequestHeader::conflict_timeout() const {
+  // @@protoc_insertion_point(field_get:proto.RequestHeader.conflict_timeout)
+  return conflict_timeout_;
+}
+inline void RequestHeader::set_conflict_timeout(::google::protobuf::int64 value) {
+  set_has_conflict_timeout();
+  conflict_timeout_ = value;
+  // @@protoc_insertion_point(field_set:proto.RequestHeader.conflict_timeout)
+}
+
+// optional int64 max_staleness = 11;
+inline bool RequestHeader::has_max_staleness() const {
+  return (_has_bits_[0] & 0x00000400u) != 0;
+}
+inline void RequestHeader::set_has_max_staleness() {
+  _has_bits_[0] |= 0x00000400u;
+}
+inline void RequestHeader::clear_has_max_staleness() {
+  _has_bits_[0] &= ~0x00000400u;
+}
+inline void RequestHeader::clear_max_staleness() {
+  max_staleness_ = GOOGLE_LONGLONG(0);
+  clear_has_max_staleness();
+}
+inline ::google::protobuf::int64 RequestHeader::max_staleness() const {
+  // @@protoc_insertion_point(field_get:proto.RequestHeader.max_staleness)
+  return max_staleness_;
+}
+inline void RequestHeader::set_max_staleness(::google::protobuf::int64 value) {
+  set_has_max_staleness();
+  max_staleness_ = value;
+  // @@protoc_insertion_point(field_set:proto.RequestHeader.max_staleness)
+}
+
+// optional int64 timeout = 12;
+inline bool RequestHeader::has_timeout() const {
+  return (_has_bits_[0] & 0x00000800u) != 0;
+}
+inline void RequestHeader::set_has_timeout() {
+  _has_bits_[0] |= 0x00000800u;
+}
+inline void RequestHeader::clear_has_timeout() {
+  _has_bits_[0] &= ~0x00000800u;
+}
+inline void RequestHeader::clear_timeout() {
+  timeout_ = GOOGLE_LONGLONG(0);
+  clear_has_timeout();
+}
+inline ::google::protobuf::int64 RequestHeader::timeout() const {
+  // @@protoc_insertion_point(field_get:proto.RequestHeader.timeout)
+  return timeout_;
+}
+inline void RequestHeader::set_timeout(::google::protobuf::int64 value) {
+  set_has_timeout();
+  timeout_ = value;
+  // @@protoc_insertion_point(field_set:proto.RequestHeader.timeout)
+}
+
+// optional bool clamp_to_closed_timestamp = 13;
+inline bool RequestHeader::has_clamp_to_closed_timestamp() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void RequestHeader::set_has_clamp_to_closed_timestamp() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void RequestHeader::clear_has_clamp_to_closed_timestamp() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void RequestHeader::clear_clamp_to_closed_timestamp() {
+  clamp_to_closed_timestamp_ = false;
+  clear_has_clamp_to_closed_timestamp();
+}
+inline bool RequestHeader::clamp_to_closed_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.RequestHeader.clamp_to_closed_timestamp)
+  return clamp_to_closed_timestamp_;
+}
+inline void RequestHeader::set_clamp_to_closed_timestamp(bool value) {
+  set_has_clamp_to_closed_timestamp();
+  clamp_to_closed_timestamp_ = value;
+  // @@protoc_insertion_point(field_set:proto.RequestHeader.clamp_to_closed_timestamp)
+}
+
+// -------------------------------------------------------------------
+
+// MVCCStatsDelta
+
+// optional int64 live_bytes = 1;
+inline bool MVCCStatsDelta::has_live_bytes() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void MVCCStatsDelta::set_has_live_bytes() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void MVCCStatsDelta::clear_has_live_bytes() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void MVCCStatsDelta::clear_live_bytes() {
+  live_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_live_bytes();
+}
+inline ::google::protobuf::int64 MVCCStatsDelta::live_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.live_bytes)
+  return live_bytes_;
+}
+inline void MVCCStatsDelta::set_live_bytes(::google::protobuf::int64 value) {
+  set_has_live_bytes();
+  live_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.live_bytes)
+}
+
+// optional int64 key_bytes = 2;
+inline bool MVCCStatsDelta::has_key_bytes() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void MVCCStatsDelta::set_has_key_bytes() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void MVCCStatsDelta::clear_has_key_bytes() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void MVCCStatsDelta::clear_key_bytes() {
+  key_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_key_bytes();
+}
+inline ::google::protobuf::int64 MVCCStatsDelta::key_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.key_bytes)
+  return key_bytes_;
+}
+inline void MVCCStatsDelta::set_key_bytes(::google::protobuf::int64 value) {
+  set_has_key_bytes();
+  key_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.key_bytes)
+}
+
+// optional int64 val_bytes = 3;
+inline bool MVCCStatsDelta::has_val_bytes() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void MVCCStatsDelta::set_has_val_bytes() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void MVCCStatsDelta::clear_has_val_bytes() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void MVCCStatsDelta::clear_val_bytes() {
+  val_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_val_bytes();
+}
+inline ::google::protobuf::int64 MVCCStatsDelta::val_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.val_bytes)
+  return val_bytes_;
+}
+inline void MVCCStatsDelta::set_val_bytes(::google::protobuf::int64 value) {
+  set_has_val_bytes();
+  val_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.val_bytes)
+}
+
+// optional int64 intent_bytes = 4;
+inline bool MVCCStatsDelta::has_intent_bytes() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void MVCCStatsDelta::set_has_intent_bytes() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void MVCCStatsDelta::clear_has_intent_bytes() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void MVCCStatsDelta::clear_intent_bytes() {
+  intent_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_intent_bytes();
+}
+inline ::google::protobuf::int64 MVCCStatsDelta::intent_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.intent_bytes)
+  return intent_bytes_;
+}
+inline void MVCCStatsDelta::set_intent_bytes(::google::protobuf::int64 value) {
+  set_has_intent_bytes();
+  intent_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.intent_bytes)
+}
+
+// optional int64 live_count = 5;
+inline bool MVCCStatsDelta::has_live_count() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void MVCCStatsDelta::set_has_live_count() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void MVCCStatsDelta::clear_has_live_count() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void MVCCStatsDelta::clear_live_count() {
+  live_count_ = GOOGLE_LONGLONG(0);
+  clear_has_live_count();
+}
+inline ::google::protobuf::int64 MVCCStatsDelta::live_count() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.live_count)
+  return live_count_;
+}
+inline void MVCCStatsDelta::set_live_count(::google::protobuf::int64 value) {
+  set_has_live_count();
+  live_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.live_count)
+}
+
+// optional int64 key_count = 6;
+inline bool MVCCStatsDelta::has_key_count() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void MVCCStatsDelta::set_has_key_count() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void MVCCStatsDelta::clear_has_key_count() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void MVCCStatsDelta::clear_key_count() {
+  key_count_ = GOOGLE_LONGLONG(0);
+  clear_has_key_count();
+}
+inline ::google::protobuf::int64 MVCCStatsDelta::key_count() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.key_count)
+  return key_count_;
+}
+inline void MVCCStatsDelta::set_key_count(::google::protobuf::int64 value) {
+  set_has_key_count();
+  key_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.key_count)
+}
+
+// optional int64 val_count = 7;
+inline bool MVCCStatsDelta::has_val_count() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void MVCCStatsDelta::set_has_val_count() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void MVCCStatsDelta::clear_has_val_count() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void MVCCStatsDelta::clear_val_count() {
+  val_count_ = GOOGLE_LONGLONG(0);
+  clear_has_val_count();
 }
-inline void RequestHeader::set_has_txn() {
-  _has_bits_[0] |= 0x00000100u;
+inline ::google::protobuf::int64 MVCCStatsDelta::val_count() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.val_count)
+  return val_count_;
 }
-inline void RequestHeader::clear_has_txn() {
-  _has_bits_[0] &= ~0x00000100u;
+inline void MVCCStatsDelta::set_val_count(::google::protobuf::int64 value) {
+  set_has_val_count();
+  val_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.val_count)
 }
-inline void RequestHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
-  clear_has_txn();
+
+// optional int64 intent_count = 8;
+inline bool MVCCStatsDelta::has_intent_count() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
 }
-inline const ::proto::Transaction& RequestHeader::txn() const {
-  // @@protoc_insertion_point(field_get:proto.RequestHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline void MVCCStatsDelta::set_has_intent_count() {
+  _has_bits_[0] |= 0x00000080u;
 }
-inline ::proto::Transaction* RequestHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.RequestHeader.txn)
-  return txn_;
+inline void MVCCStatsDelta::clear_has_intent_count() {
+  _has_bits_[0] &= ~0x00000080u;
 }
-inline ::proto::Transaction* RequestHeader::release_txn() {
-  clear_has_txn();
-  ::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+inline void MVCCStatsDelta::clear_intent_count() {
+  intent_count_ = GOOGLE_LONGLONG(0);
+  clear_has_intent_count();
 }
-inline void RequestHeader::set_allocated_txn(::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:proto.RequestHeader.txn)
+inline ::google::protobuf::int64 MVCCStatsDelta::intent_count() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCStatsDelta.intent_count)
+  return intent_count_;
+}
+inline void MVCCStatsDelta::set_intent_count(::google::protobuf::int64 value) {
+  set_has_intent_count();
+  intent_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCStatsDelta.intent_count)
 }
 
 // -------------------------------------------------------------------
@@ -4172,6 +5344,47 @@ inline void ResponseHeader::set_allocated_txn(::proto::Transaction* txn) {
   // @@protoc_insertion_point(field_set_allocated:proto.ResponseHeader.txn)
 }
 
+// optional .proto.MVCCStatsDelta stats_delta = 4;
+inline bool ResponseHeader::has_stats_delta() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ResponseHeader::set_has_stats_delta() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ResponseHeader::clear_has_stats_delta() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ResponseHeader::clear_stats_delta() {
+  if (stats_delta_ != NULL) stats_delta_->::proto::MVCCStatsDelta::Clear();
+  clear_has_stats_delta();
+}
+inline const ::proto::MVCCStatsDelta& ResponseHeader::stats_delta() const {
+  // @@protoc_insertion_point(field_get:proto.ResponseHeader.stats_delta)
+  return stats_delta_ != NULL ? *stats_delta_ : *default_instance_->stats_delta_;
+}
+inline ::proto::MVCCStatsDelta* ResponseHeader::mutable_stats_delta() {
+  set_has_stats_delta();
+  if (stats_delta_ == NULL) stats_delta_ = new ::proto::MVCCStatsDelta;
+  // @@protoc_insertion_point(field_mutable:proto.ResponseHeader.stats_delta)
+  return stats_delta_;
+}
+inline ::proto::MVCCStatsDelta* ResponseHeader::release_stats_delta() {
+  clear_has_stats_delta();
+  ::proto::MVCCStatsDelta* temp = stats_delta_;
+  stats_delta_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_stats_delta(::proto::MVCCStatsDelta* stats_delta) {
+  delete stats_delta_;
+  stats_delta_ = stats_delta;
+  if (stats_delta) {
+    set_has_stats_delta();
+  } else {
+    clear_has_stats_delta();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ResponseHeader.stats_delta)
+}
+
 // -------------------------------------------------------------------
 
 // ContainsRequest
@@ -4331,6 +5544,30 @@ inline void GetRequest::set_allocated_header(::proto::RequestHeader* header) {
   // @@protoc_insertion_point(field_set_allocated:proto.GetRequest.header)
 }
 
+// optional bool lock = 2;
+inline bool GetRequest::has_lock() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void GetRequest::set_has_lock() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void GetRequest::clear_has_lock() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void GetRequest::clear_lock() {
+  lock_ = false;
+  clear_has_lock();
+}
+inline bool GetRequest::lock() const {
+  // @@protoc_insertion_point(field_get:proto.GetRequest.lock)
+  return lock_;
+}
+inline void GetRequest::set_lock(bool value) {
+  set_has_lock();
+  lock_ = value;
+  // @@protoc_insertion_point(field_set:proto.GetRequest.lock)
+}
+
 // -------------------------------------------------------------------
 
 // GetResponse
@@ -4503,6 +5740,30 @@ inline void PutRequest::set_allocated_value(::proto::Value* value) {
   // @@protoc_insertion_point(field_set_allocated:proto.PutRequest.value)
 }
 
+// optional bool coalesce = 3;
+inline bool PutRequest::has_coalesce() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void PutRequest::set_has_coalesce() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void PutRequest::clear_has_coalesce() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void PutRequest::clear_coalesce() {
+  coalesce_ = false;
+  clear_has_coalesce();
+}
+inline bool PutRequest::coalesce() const {
+  // @@protoc_insertion_point(field_get:proto.PutRequest.coalesce)
+  return coalesce_;
+}
+inline void PutRequest::set_coalesce(bool value) {
+  set_has_coalesce();
+  coalesce_ = value;
+  // @@protoc_insertion_point(field_set:proto.PutRequest.coalesce)
+}
+
 // -------------------------------------------------------------------
 
 // PutResponse
@@ -4789,6 +6050,54 @@ inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
   // @@protoc_insertion_point(field_set:proto.IncrementRequest.increment)
 }
 
+// optional int64 initial_value = 3;
+inline bool IncrementRequest::has_initial_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void IncrementRequest::set_has_initial_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void IncrementRequest::clear_has_initial_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void IncrementRequest::clear_initial_value() {
+  initial_value_ = GOOGLE_LONGLONG(0);
+  clear_has_initial_value();
+}
+inline ::google::protobuf::int64 IncrementRequest::initial_value() const {
+  // @@protoc_insertion_point(field_get:proto.IncrementRequest.initial_value)
+  return initial_value_;
+}
+inline void IncrementRequest::set_initial_value(::google::protobuf::int64 value) {
+  set_has_initial_value();
+  initial_value_ = value;
+  // @@protoc_insertion_point(field_set:proto.IncrementRequest.initial_value)
+}
+
+// optional int64 max = 4;
+inline bool IncrementRequest::has_max() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void IncrementRequest::set_has_max() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void IncrementRequest::clear_has_max() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void IncrementRequest::clear_max() {
+  max_ = GOOGLE_LONGLONG(0);
+  clear_has_max();
+}
+inline ::google::protobuf::int64 IncrementRequest::max() const {
+  // @@protoc_insertion_point(field_get:proto.IncrementRequest.max)
+  return max_;
+}
+inline void IncrementRequest::set_max(::google::protobuf::int64 value) {
+  set_has_max();
+  max_ = value;
+  // @@protoc_insertion_point(field_set:proto.IncrementRequest.max)
+}
+
 // -------------------------------------------------------------------
 
 // IncrementResponse
@@ -5155,6 +6464,154 @@ inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
   // @@protoc_insertion_point(field_set:proto.ScanRequest.max_results)
 }
 
+// optional bool order_by_timestamp = 3;
+inline bool ScanRequest::has_order_by_timestamp() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ScanRequest::set_has_order_by_timestamp() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ScanRequest::clear_has_order_by_timestamp() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ScanRequest::clear_order_by_timestamp() {
+  order_by_timestamp_ = false;
+  clear_has_order_by_timestamp();
+}
+inline bool ScanRequest::order_by_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.ScanRequest.order_by_timestamp)
+  return order_by_timestamp_;
+}
+inline void ScanRequest::set_order_by_timestamp(bool value) {
+  set_has_order_by_timestamp();
+  order_by_timestamp_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanRequest.order_by_timestamp)
+}
+
+// optional bytes resume_token = 4;
+inline bool ScanRequest::has_resume_token() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ScanRequest::set_has_resume_token() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ScanRequest::clear_has_resume_token() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ScanRequest::clear_resume_token() {
+  if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_->clear();
+  }
+  clear_has_resume_token();
+}
+inline const ::std::string& ScanRequest::resume_token() const {
+  // @@protoc_insertion_point(field_get:proto.ScanRequest.resume_token)
+  return *resume_token_;
+}
+inline void ScanRequest::set_resume_token(const ::std::string& value) {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  resume_token_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.ScanRequest.resume_token)
+}
+inline void ScanRequest::set_resume_token(const char* value) {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  resume_token_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.ScanRequest.resume_token)
+}
+inline void ScanRequest::set_resume_token(const void* value, size_t size) {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  resume_token_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.ScanRequest.resume_token)
+}
+inline ::std::string* ScanRequest::mutable_resume_token() {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.ScanRequest.resume_token)
+  return resume_token_;
+}
+inline ::std::string* ScanRequest::release_resume_token() {
+  clear_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = resume_token_;
+    resume_token_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void ScanRequest::set_allocated_resume_token(::std::string* resume_token) {
+  if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete resume_token_;
+  }
+  if (resume_token) {
+    set_has_resume_token();
+    resume_token_ = resume_token;
+  } else {
+    clear_has_resume_token();
+    resume_token_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ScanRequest.resume_token)
+}
+
+// optional int32 parallelism = 5;
+inline bool ScanRequest::has_parallelism() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void ScanRequest::set_has_parallelism() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void ScanRequest::clear_has_parallelism() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void ScanRequest::clear_parallelism() {
+  parallelism_ = 0;
+  clear_has_parallelism();
+}
+inline ::google::protobuf::int32 ScanRequest::parallelism() const {
+  // @@protoc_insertion_point(field_get:proto.ScanRequest.parallelism)
+  return parallelism_;
+}
+inline void ScanRequest::set_parallelism(::google::protobuf::int32 value) {
+  set_has_parallelism();
+  parallelism_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanRequest.parallelism)
+}
+
+// optional bool return_stats = 6;
+inline bool ScanRequest::has_return_stats() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void ScanRequest::set_has_return_stats() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void ScanRequest::clear_has_return_stats() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void ScanRequest::clear_return_stats() {
+  return_stats_ = false;
+  clear_has_return_stats();
+}
+inline bool ScanRequest::return_stats() const {
+  // @@protoc_insertion_point(field_get:proto.ScanRequest.return_stats)
+  return return_stats_;
+}
+inline void ScanRequest::set_return_stats(bool value) {
+  set_has_return_stats();
+  return_stats_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanRequest.return_stats)
+}
+
 // -------------------------------------------------------------------
 
 // ScanResponse
@@ -5230,6 +6687,202 @@ ScanResponse::mutable_rows() {
   return &rows_;
 }
 
+// optional int64 keys_examined = 3;
+inline bool ScanResponse::has_keys_examined() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ScanResponse::set_has_keys_examined() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ScanResponse::clear_has_keys_examined() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ScanResponse::clear_keys_examined() {
+  keys_examined_ = GOOGLE_LONGLONG(0);
+  clear_has_keys_examined();
+}
+inline ::google::protobuf::int64 ScanResponse::keys_examined() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResponse.keys_examined)
+  return keys_examined_;
+}
+inline void ScanResponse::set_keys_examined(::google::protobuf::int64 value) {
+  set_has_keys_examined();
+  keys_examined_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanResponse.keys_examined)
+}
+
+// optional int64 versions_examined = 4;
+inline bool ScanResponse::has_versions_examined() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ScanResponse::set_has_versions_examined() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ScanResponse::clear_has_versions_examined() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ScanResponse::clear_versions_examined() {
+  versions_examined_ = GOOGLE_LONGLONG(0);
+  clear_has_versions_examined();
+}
+inline ::google::protobuf::int64 ScanResponse::versions_examined() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResponse.versions_examined)
+  return versions_examined_;
+}
+inline void ScanResponse::set_versions_examined(::google::protobuf::int64 value) {
+  set_has_versions_examined();
+  versions_examined_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanResponse.versions_examined)
+}
+
+// optional int64 versions_skipped = 5;
+inline bool ScanResponse::has_versions_skipped() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void ScanResponse::set_has_versions_skipped() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void ScanResponse::clear_has_versions_skipped() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void ScanResponse::clear_versions_skipped() {
+  versions_skipped_ = GOOGLE_LONGLONG(0);
+  clear_has_versions_skipped();
+}
+inline ::google::protobuf::int64 ScanResponse::versions_skipped() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResponse.versions_skipped)
+  return versions_skipped_;
+}
+inline void ScanResponse::set_versions_skipped(::google::protobuf::int64 value) {
+  set_has_versions_skipped();
+  versions_skipped_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanResponse.versions_skipped)
+}
+
+// optional int64 intents_encountered = 6;
+inline bool ScanResponse::has_intents_encountered() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void ScanResponse::set_has_intents_encountered() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void ScanResponse::clear_has_intents_encountered() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void ScanResponse::clear_intents_encountered() {
+  intents_encountered_ = GOOGLE_LONGLONG(0);
+  clear_has_intents_encountered();
+}
+inline ::google::protobuf::int64 ScanResponse::intents_encountered() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResponse.intents_encountered)
+  return intents_encountered_;
+}
+inline void ScanResponse::set_intents_encountered(::google::protobuf::int64 value) {
+  set_has_intents_encountered();
+  intents_encountered_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanResponse.intents_encountered)
+}
+
+// optional bytes resume_token = 7;
+inline bool ScanResponse::has_resume_token() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void ScanResponse::set_has_resume_token() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void ScanResponse::clear_has_resume_token() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void ScanResponse::clear_resume_token() {
+  if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_->clear();
+  }
+  clear_has_resume_token();
+}
+inline const ::std::string& ScanResponse::resume_token() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResponse.resume_token)
+  return *resume_token_;
+}
+inline void ScanResponse::set_resume_token(const ::std::string& value) {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  resume_token_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.ScanResponse.resume_token)
+}
+inline void ScanResponse::set_resume_token(const char* value) {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  resume_token_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.ScanResponse.resume_token)
+}
+inline void ScanResponse::set_resume_token(const void* value, size_t size) {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  resume_token_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.ScanResponse.resume_token)
+}
+inline ::std::string* ScanResponse::mutable_resume_token() {
+  set_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    resume_token_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.ScanResponse.resume_token)
+  return resume_token_;
+}
+inline ::std::string* ScanResponse::release_resume_token() {
+  clear_has_resume_token();
+  if (resume_token_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = resume_token_;
+    resume_token_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void ScanResponse::set_allocated_resume_token(::std::string* resume_token) {
+  if (resume_token_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete resume_token_;
+  }
+  if (resume_token) {
+    set_has_resume_token();
+    resume_token_ = resume_token;
+  } else {
+    clear_has_resume_token();
+    resume_token_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ScanResponse.resume_token)
+}
+
+// optional int64 tombstones_skipped = 8;
+inline bool ScanResponse::has_tombstones_skipped() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void ScanResponse::set_has_tombstones_skipped() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void ScanResponse::clear_has_tombstones_skipped() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void ScanResponse::clear_tombstones_skipped() {
+  tombstones_skipped_ = GOOGLE_LONGLONG(0);
+  clear_has_tombstones_skipped();
+}
+inline ::google::protobuf::int64 ScanResponse::tombstones_skipped() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResponse.tombstones_skipped)
+  return tombstones_skipped_;
+}
+inline void ScanResponse::set_tombstones_skipped(::google::protobuf::int64 value) {
+  set_has_tombstones_skipped();
+  tombstones_skipped_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanResponse.tombstones_skipped)
+}
+
 // -------------------------------------------------------------------
 
 // EndTransactionRequest
@@ -5340,6 +6993,114 @@ inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::proto
   // @@protoc_insertion_point(field_set_allocated:proto.EndTransactionRequest.internal_commit_trigger)
 }
 
+// optional bool durable = 4;
+inline bool EndTransactionRequest::has_durable() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void EndTransactionRequest::set_has_durable() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void EndTransactionRequest::clear_has_durable() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void EndTransactionRequest::clear_durable() {
+  durable_ = false;
+  clear_has_durable();
+}
+inline bool EndTransactionRequest::durable() const {
+  // @@protoc_insertion_point(field_get:proto.EndTransactionRequest.durable)
+  return durable_;
+}
+inline void EndTransactionRequest::set_durable(bool value) {
+  set_has_durable();
+  durable_ = value;
+  // @@protoc_insertion_point(field_set:proto.EndTransactionRequest.durable)
+}
+
+// repeated bytes intents = 5;
+inline int EndTransactionRequest::intents_size() const {
+  return intents_.size();
+}
+inline void EndTransactionRequest::clear_intents() {
+  intents_.Clear();
+}
+inline const ::std::string& EndTransactionRequest::intents(int index) const {
+  // @@protoc_insertion_point(field_get:proto.EndTransactionRequest.intents)
+  return intents_.Get(index);
+}
+inline ::std::string* EndTransactionRequest::mutable_intents(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.EndTransactionRequest.intents)
+  return intents_.Mutable(index);
+}
+inline void EndTransactionRequest::set_intents(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:proto.EndTransactionRequest.intents)
+  intents_.Mutable(index)->assign(value);
+}
+inline void EndTransactionRequest::set_intents(int index, const char* value) {
+  intents_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.EndTransactionRequest.intents)
+}
+inline void EndTransactionRequest::set_intents(int index, const void* value, size_t size) {
+  intents_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.EndTransactionRequest.intents)
+}
+inline ::std::string* EndTransactionRequest::add_intents() {
+  return intents_.Add();
+}
+inline void EndTransactionRequest::add_intents(const ::std::string& value) {
+  intents_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:proto.EndTransactionRequest.intents)
+}
+inline void EndTransactionRequest::add_intents(const char* value) {
+  intents_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:proto.EndTransactionRequest.intents)
+}
+inline void EndTransactionRequest::add_intents(const void* value, size_t size) {
+  intents_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:proto.EndTransactionRequest.intents)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+EndTransactionRequest::intents() const {
+  // @@protoc_insertion_point(field_list:proto.EndTransactionRequest.intents)
+  return intents_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+EndTransactionRequest::mutable_intents() {
+  // @@protoc_insertion_point(field_mutable_list:proto.EndTransactionRequest.intents)
+  return &intents_;
+}
+
+// repeated .proto.KeySpan refresh_spans = 6;
+inline int EndTransactionRequest::refresh_spans_size() const {
+  return refresh_spans_.size();
+}
+inline void EndTransactionRequest::clear_refresh_spans() {
+  refresh_spans_.Clear();
+}
+inline const ::proto::KeySpan& EndTransactionRequest::refresh_spans(int index) const {
+  // @@protoc_insertion_point(field_get:proto.EndTransactionRequest.refresh_spans)
+  return refresh_spans_.Get(index);
+}
+inline ::proto::KeySpan* EndTransactionRequest::mutable_refresh_spans(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.EndTransactionRequest.refresh_spans)
+  return refresh_spans_.Mutable(index);
+}
+inline ::proto::KeySpan* EndTransactionRequest::add_refresh_spans() {
+  // @@protoc_insertion_point(field_add:proto.EndTransactionRequest.refresh_spans)
+  return refresh_spans_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >&
+EndTransactionRequest::refresh_spans() const {
+  // @@protoc_insertion_point(field_list:proto.EndTransactionRequest.refresh_spans)
+  return refresh_spans_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >*
+EndTransactionRequest::mutable_refresh_spans() {
+  // @@protoc_insertion_point(field_mutable_list:proto.EndTransactionRequest.refresh_spans)
+  return &refresh_spans_;
+}
+
 // -------------------------------------------------------------------
 
 // EndTransactionResponse
@@ -5409,6 +7170,71 @@ inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 va
   // @@protoc_insertion_point(field_set:proto.EndTransactionResponse.commit_wait)
 }
 
+// optional int32 restarts = 3;
+inline bool EndTransactionResponse::has_restarts() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void EndTransactionResponse::set_has_restarts() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void EndTransactionResponse::clear_has_restarts() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void EndTransactionResponse::clear_restarts() {
+  restarts_ = 0;
+  clear_has_restarts();
+}
+inline ::google::protobuf::int32 EndTransactionResponse::restarts() const {
+  // @@protoc_insertion_point(field_get:proto.EndTransactionResponse.restarts)
+  return restarts_;
+}
+inline void EndTransactionResponse::set_restarts(::google::protobuf::int32 value) {
+  set_has_restarts();
+  restarts_ = value;
+  // @@protoc_insertion_point(field_set:proto.EndTransactionResponse.restarts)
+}
+
+// optional .proto.Timestamp commit_timestamp = 4;
+inline bool EndTransactionResponse::has_commit_timestamp() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void EndTransactionResponse::set_has_commit_timestamp() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void EndTransactionResponse::clear_has_commit_timestamp() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void EndTransactionResponse::clear_commit_timestamp() {
+  if (commit_timestamp_ != NULL) commit_timestamp_->::proto::Timestamp::Clear();
+  clear_has_commit_timestamp();
+}
+inline const ::proto::Timestamp& EndTransactionResponse::commit_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.EndTransactionResponse.commit_timestamp)
+  return commit_timestamp_ != NULL ? *commit_timestamp_ : *default_instance_->commit_timestamp_;
+}
+inline ::proto::Timestamp* EndTransactionResponse::mutable_commit_timestamp() {
+  set_has_commit_timestamp();
+  if (commit_timestamp_ == NULL) commit_timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.EndTransactionResponse.commit_timestamp)
+  return commit_timestamp_;
+}
+inline ::proto::Timestamp* EndTransactionResponse::release_commit_timestamp() {
+  clear_has_commit_timestamp();
+  ::proto::Timestamp* temp = commit_timestamp_;
+  commit_timestamp_ = NULL;
+  return temp;
+}
+inline void EndTransactionResponse::set_allocated_commit_timestamp(::proto::Timestamp* commit_timestamp) {
+  delete commit_timestamp_;
+  commit_timestamp_ = commit_timestamp;
+  if (commit_timestamp) {
+    set_has_commit_timestamp();
+  } else {
+    clear_has_commit_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.EndTransactionResponse.commit_timestamp)
+}
+
 // -------------------------------------------------------------------
 
 // ReapQueueRequest
@@ -5587,52 +7413,297 @@ inline ::proto::RequestHeader* EnqueueUpdateRequest::release_header() {
   header_ = NULL;
   return temp;
 }
-inline void EnqueueUpdateRequest::set_allocated_header(::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueUpdateRequest.header)
+inline void EnqueueUpdateRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueUpdateRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// EnqueueUpdateResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool EnqueueUpdateResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void EnqueueUpdateResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void EnqueueUpdateResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void EnqueueUpdateResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& EnqueueUpdateResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.EnqueueUpdateResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* EnqueueUpdateResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.EnqueueUpdateResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* EnqueueUpdateResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void EnqueueUpdateResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueUpdateResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// EnqueueMessageRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool EnqueueMessageRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void EnqueueMessageRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void EnqueueMessageRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void EnqueueMessageRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& EnqueueMessageRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.EnqueueMessageRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* EnqueueMessageRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.EnqueueMessageRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* EnqueueMessageRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void EnqueueMessageRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueMessageRequest.header)
+}
+
+// optional .proto.Value msg = 2;
+inline bool EnqueueMessageRequest::has_msg() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void EnqueueMessageRequest::set_has_msg() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void EnqueueMessageRequest::clear_has_msg() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void EnqueueMessageRequest::clear_msg() {
+  if (msg_ != NULL) msg_->::proto::Value::Clear();
+  clear_has_msg();
+}
+inline const ::proto::Value& EnqueueMessageRequest::msg() const {
+  // @@protoc_insertion_point(field_get:proto.EnqueueMessageRequest.msg)
+  return msg_ != NULL ? *msg_ : *default_instance_->msg_;
+}
+inline ::proto::Value* EnqueueMessageRequest::mutable_msg() {
+  set_has_msg();
+  if (msg_ == NULL) msg_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.EnqueueMessageRequest.msg)
+  return msg_;
+}
+inline ::proto::Value* EnqueueMessageRequest::release_msg() {
+  clear_has_msg();
+  ::proto::Value* temp = msg_;
+  msg_ = NULL;
+  return temp;
+}
+inline void EnqueueMessageRequest::set_allocated_msg(::proto::Value* msg) {
+  delete msg_;
+  msg_ = msg;
+  if (msg) {
+    set_has_msg();
+  } else {
+    clear_has_msg();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueMessageRequest.msg)
+}
+
+// -------------------------------------------------------------------
+
+// EnqueueMessageResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool EnqueueMessageResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void EnqueueMessageResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void EnqueueMessageResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void EnqueueMessageResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& EnqueueMessageResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.EnqueueMessageResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* EnqueueMessageResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.EnqueueMessageResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* EnqueueMessageResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void EnqueueMessageResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueMessageResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// ReverseScanRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool ReverseScanRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ReverseScanRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ReverseScanRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ReverseScanRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& ReverseScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.ReverseScanRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* ReverseScanRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.ReverseScanRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* ReverseScanRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ReverseScanRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ReverseScanRequest.header)
+}
+
+// optional int64 max_results = 2;
+inline bool ReverseScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ReverseScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ReverseScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ReverseScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+inline ::google::protobuf::int64 ReverseScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:proto.ReverseScanRequest.max_results)
+  return max_results_;
+}
+inline void ReverseScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:proto.ReverseScanRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// EnqueueUpdateResponse
+// ReverseScanResponse
 
 // optional .proto.ResponseHeader header = 1;
-inline bool EnqueueUpdateResponse::has_header() const {
+inline bool ReverseScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EnqueueUpdateResponse::set_has_header() {
+inline void ReverseScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EnqueueUpdateResponse::clear_has_header() {
+inline void ReverseScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EnqueueUpdateResponse::clear_header() {
+inline void ReverseScanResponse::clear_header() {
   if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::ResponseHeader& EnqueueUpdateResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.EnqueueUpdateResponse.header)
+inline const ::proto::ResponseHeader& ReverseScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.ReverseScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::ResponseHeader* EnqueueUpdateResponse::mutable_header() {
+inline ::proto::ResponseHeader* ReverseScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.EnqueueUpdateResponse.header)
+  // @@protoc_insertion_point(field_mutable:proto.ReverseScanResponse.header)
   return header_;
 }
-inline ::proto::ResponseHeader* EnqueueUpdateResponse::release_header() {
+inline ::proto::ResponseHeader* ReverseScanResponse::release_header() {
   clear_has_header();
   ::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EnqueueUpdateResponse::set_allocated_header(::proto::ResponseHeader* header) {
+inline void ReverseScanResponse::set_allocated_header(::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5640,44 +7711,74 @@ inline void EnqueueUpdateResponse::set_allocated_header(::proto::ResponseHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueUpdateResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReverseScanResponse.header)
+}
+
+// repeated .proto.KeyValue rows = 2;
+inline int ReverseScanResponse::rows_size() const {
+  return rows_.size();
+}
+inline void ReverseScanResponse::clear_rows() {
+  rows_.Clear();
+}
+inline const ::proto::KeyValue& ReverseScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:proto.ReverseScanResponse.rows)
+  return rows_.Get(index);
+}
+inline ::proto::KeyValue* ReverseScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.ReverseScanResponse.rows)
+  return rows_.Mutable(index);
+}
+inline ::proto::KeyValue* ReverseScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:proto.ReverseScanResponse.rows)
+  return rows_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::KeyValue >&
+ReverseScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:proto.ReverseScanResponse.rows)
+  return rows_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::KeyValue >*
+ReverseScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:proto.ReverseScanResponse.rows)
+  return &rows_;
 }
 
 // -------------------------------------------------------------------
 
-// EnqueueMessageRequest
+// ConditionalDeleteRequest
 
 // optional .proto.RequestHeader header = 1;
-inline bool EnqueueMessageRequest::has_header() const {
+inline bool ConditionalDeleteRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EnqueueMessageRequest::set_has_header() {
+inline void ConditionalDeleteRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EnqueueMessageRequest::clear_has_header() {
+inline void ConditionalDeleteRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EnqueueMessageRequest::clear_header() {
+inline void ConditionalDeleteRequest::clear_header() {
   if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::RequestHeader& EnqueueMessageRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.EnqueueMessageRequest.header)
+inline const ::proto::RequestHeader& ConditionalDeleteRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.ConditionalDeleteRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::RequestHeader* EnqueueMessageRequest::mutable_header() {
+inline ::proto::RequestHeader* ConditionalDeleteRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.EnqueueMessageRequest.header)
+  // @@protoc_insertion_point(field_mutable:proto.ConditionalDeleteRequest.header)
   return header_;
 }
-inline ::proto::RequestHeader* EnqueueMessageRequest::release_header() {
+inline ::proto::RequestHeader* ConditionalDeleteRequest::release_header() {
   clear_has_header();
   ::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EnqueueMessageRequest::set_allocated_header(::proto::RequestHeader* header) {
+inline void ConditionalDeleteRequest::set_allocated_header(::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5685,85 +7786,85 @@ inline void EnqueueMessageRequest::set_allocated_header(::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueMessageRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ConditionalDeleteRequest.header)
 }
 
-// optional .proto.Value msg = 2;
-inline bool EnqueueMessageRequest::has_msg() const {
+// optional .proto.Value exp_value = 2;
+inline bool ConditionalDeleteRequest::has_exp_value() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void EnqueueMessageRequest::set_has_msg() {
+inline void ConditionalDeleteRequest::set_has_exp_value() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void EnqueueMessageRequest::clear_has_msg() {
+inline void ConditionalDeleteRequest::clear_has_exp_value() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void EnqueueMessageRequest::clear_msg() {
-  if (msg_ != NULL) msg_->::proto::Value::Clear();
-  clear_has_msg();
+inline void ConditionalDeleteRequest::clear_exp_value() {
+  if (exp_value_ != NULL) exp_value_->::proto::Value::Clear();
+  clear_has_exp_value();
 }
-inline const ::proto::Value& EnqueueMessageRequest::msg() const {
-  // @@protoc_insertion_point(field_get:proto.EnqueueMessageRequest.msg)
-  return msg_ != NULL ? *msg_ : *default_instance_->msg_;
+inline const ::proto::Value& ConditionalDeleteRequest::exp_value() const {
+  // @@protoc_insertion_point(field_get:proto.ConditionalDeleteRequest.exp_value)
+  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
 }
-inline ::proto::Value* EnqueueMessageRequest::mutable_msg() {
-  set_has_msg();
-  if (msg_ == NULL) msg_ = new ::proto::Value;
-  // @@protoc_insertion_point(field_mutable:proto.EnqueueMessageRequest.msg)
-  return msg_;
+inline ::proto::Value* ConditionalDeleteRequest::mutable_exp_value() {
+  set_has_exp_value();
+  if (exp_value_ == NULL) exp_value_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.ConditionalDeleteRequest.exp_value)
+  return exp_value_;
 }
-inline ::proto::Value* EnqueueMessageRequest::release_msg() {
-  clear_has_msg();
-  ::proto::Value* temp = msg_;
-  msg_ = NULL;
+inline ::proto::Value* ConditionalDeleteRequest::release_exp_value() {
+  clear_has_exp_value();
+  ::proto::Value* temp = exp_value_;
+  exp_value_ = NULL;
   return temp;
 }
-inline void EnqueueMessageRequest::set_allocated_msg(::proto::Value* msg) {
-  delete msg_;
-  msg_ = msg;
-  if (msg) {
-    set_has_msg();
+inline void ConditionalDeleteRequest::set_allocated_exp_value(::proto::Value* exp_value) {
+  delete exp_value_;
+  exp_value_ = exp_value;
+  if (exp_value) {
+    set_has_exp_value();
   } else {
-    clear_has_msg();
+    clear_has_exp_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueMessageRequest.msg)
+  // @@protoc_insertion_point(field_set_allocated:proto.ConditionalDeleteRequest.exp_value)
 }
 
 // -------------------------------------------------------------------
 
-// EnqueueMessageResponse
+// ConditionalDeleteResponse
 
 // optional .proto.ResponseHeader header = 1;
-inline bool EnqueueMessageResponse::has_header() const {
+inline bool ConditionalDeleteResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EnqueueMessageResponse::set_has_header() {
+inline void ConditionalDeleteResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EnqueueMessageResponse::clear_has_header() {
+inline void ConditionalDeleteResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EnqueueMessageResponse::clear_header() {
+inline void ConditionalDeleteResponse::clear_header() {
   if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::ResponseHeader& EnqueueMessageResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.EnqueueMessageResponse.header)
+inline const ::proto::ResponseHeader& ConditionalDeleteResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.ConditionalDeleteResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::ResponseHeader* EnqueueMessageResponse::mutable_header() {
+inline ::proto::ResponseHeader* ConditionalDeleteResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.EnqueueMessageResponse.header)
+  // @@protoc_insertion_point(field_mutable:proto.ConditionalDeleteResponse.header)
   return header_;
 }
-inline ::proto::ResponseHeader* EnqueueMessageResponse::release_header() {
+inline ::proto::ResponseHeader* ConditionalDeleteResponse::release_header() {
   clear_has_header();
   ::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EnqueueMessageResponse::set_allocated_header(::proto::ResponseHeader* header) {
+inline void ConditionalDeleteResponse::set_allocated_header(::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -5771,7 +7872,7 @@ inline void EnqueueMessageResponse::set_allocated_header(::proto::ResponseHeader
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.EnqueueMessageResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ConditionalDeleteResponse.header)
 }
 
 // -------------------------------------------------------------------
@@ -6270,6 +8371,88 @@ inline void RequestUnion::set_allocated_enqueue_message(::proto::EnqueueMessageR
   // @@protoc_insertion_point(field_set_allocated:proto.RequestUnion.enqueue_message)
 }
 
+// optional .proto.ReverseScanRequest reverse_scan = 13;
+inline bool RequestUnion::has_reverse_scan() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void RequestUnion::set_has_reverse_scan() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void RequestUnion::clear_has_reverse_scan() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void RequestUnion::clear_reverse_scan() {
+  if (reverse_scan_ != NULL) reverse_scan_->::proto::ReverseScanRequest::Clear();
+  clear_has_reverse_scan();
+}
+inline const ::proto::ReverseScanRequest& RequestUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:proto.RequestUnion.reverse_scan)
+  return reverse_scan_ != NULL ? *reverse_scan_ : *default_instance_->reverse_scan_;
+}
+inline ::proto::ReverseScanRequest* RequestUnion::mutable_reverse_scan() {
+  set_has_reverse_scan();
+  if (reverse_scan_ == NULL) reverse_scan_ = new ::proto::ReverseScanRequest;
+  // @@protoc_insertion_point(field_mutable:proto.RequestUnion.reverse_scan)
+  return reverse_scan_;
+}
+inline ::proto::ReverseScanRequest* RequestUnion::release_reverse_scan() {
+  clear_has_reverse_scan();
+  ::proto::ReverseScanRequest* temp = reverse_scan_;
+  reverse_scan_ = NULL;
+  return temp;
+}
+inline void RequestUnion::set_allocated_reverse_scan(::proto::ReverseScanRequest* reverse_scan) {
+  delete reverse_scan_;
+  reverse_scan_ = reverse_scan;
+  if (reverse_scan) {
+    set_has_reverse_scan();
+  } else {
+    clear_has_reverse_scan();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.RequestUnion.reverse_scan)
+}
+
+// optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+inline bool RequestUnion::has_conditional_delete() const {
+  return (_has_bits_[0] & 0x00002000u) != 0;
+}
+inline void RequestUnion::set_has_conditional_delete() {
+  _has_bits_[0] |= 0x00002000u;
+}
+inline void RequestUnion::clear_has_conditional_delete() {
+  _has_bits_[0] &= ~0x00002000u;
+}
+inline void RequestUnion::clear_conditional_delete() {
+  if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteRequest::Clear();
+  clear_has_conditional_delete();
+}
+inline const ::proto::ConditionalDeleteRequest& RequestUnion::conditional_delete() const {
+  // @@protoc_insertion_point(field_get:proto.RequestUnion.conditional_delete)
+  return conditional_delete_ != NULL ? *conditional_delete_ : *default_instance_->conditional_delete_;
+}
+inline ::proto::ConditionalDeleteRequest* RequestUnion::mutable_conditional_delete() {
+  set_has_conditional_delete();
+  if (conditional_delete_ == NULL) conditional_delete_ = new ::proto::ConditionalDeleteRequest;
+  // @@protoc_insertion_point(field_mutable:proto.RequestUnion.conditional_delete)
+  return conditional_delete_;
+}
+inline ::proto::ConditionalDeleteRequest* RequestUnion::release_conditional_delete() {
+  clear_has_conditional_delete();
+  ::proto::ConditionalDeleteRequest* temp = conditional_delete_;
+  conditional_delete_ = NULL;
+  return temp;
+}
+inline void RequestUnion::set_allocated_conditional_delete(::proto::ConditionalDeleteRequest* conditional_delete) {
+  delete conditional_delete_;
+  conditional_delete_ = conditional_delete;
+  if (conditional_delete) {
+    set_has_conditional_delete();
+  } else {
+    clear_has_conditional_delete();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.RequestUnion.conditional_delete)
+}
+
 // -------------------------------------------------------------------
 
 // ResponseUnion
@@ -6766,6 +8949,88 @@ inline void ResponseUnion::set_allocated_enqueue_message(::proto::EnqueueMessage
   // @@protoc_insertion_point(field_set_allocated:proto.ResponseUnion.enqueue_message)
 }
 
+// optional .proto.ReverseScanResponse reverse_scan = 13;
+inline bool ResponseUnion::has_reverse_scan() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void ResponseUnion::set_has_reverse_scan() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void ResponseUnion::clear_has_reverse_scan() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void ResponseUnion::clear_reverse_scan() {
+  if (reverse_scan_ != NULL) reverse_scan_->::proto::ReverseScanResponse::Clear();
+  clear_has_reverse_scan();
+}
+inline const ::proto::ReverseScanResponse& ResponseUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:proto.ResponseUnion.reverse_scan)
+  return reverse_scan_ != NULL ? *reverse_scan_ : *default_instance_->reverse_scan_;
+}
+inline ::proto::ReverseScanResponse* ResponseUnion::mutable_reverse_scan() {
+  set_has_reverse_scan();
+  if (reverse_scan_ == NULL) reverse_scan_ = new ::proto::ReverseScanResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ResponseUnion.reverse_scan)
+  return reverse_scan_;
+}
+inline ::proto::ReverseScanResponse* ResponseUnion::release_reverse_scan() {
+  clear_has_reverse_scan();
+  ::proto::ReverseScanResponse* temp = reverse_scan_;
+  reverse_scan_ = NULL;
+  return temp;
+}
+inline void ResponseUnion::set_allocated_reverse_scan(::proto::ReverseScanResponse* reverse_scan) {
+  delete reverse_scan_;
+  reverse_scan_ = reverse_scan;
+  if (reverse_scan) {
+    set_has_reverse_scan();
+  } else {
+    clear_has_reverse_scan();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ResponseUnion.reverse_scan)
+}
+
+// optional .proto.ConditionalDeleteResponse conditional_delete = 14;
+inline bool ResponseUnion::has_conditional_delete() const {
+  return (_has_bits_[0] & 0x00002000u) != 0;
+}
+inline void ResponseUnion::set_has_conditional_delete() {
+  _has_bits_[0] |= 0x00002000u;
+}
+inline void ResponseUnion::clear_has_conditional_delete() {
+  _has_bits_[0] &= ~0x00002000u;
+}
+inline void ResponseUnion::clear_conditional_delete() {
+  if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteResponse::Clear();
+  clear_has_conditional_delete();
+}
+inline const ::proto::ConditionalDeleteResponse& ResponseUnion::conditional_delete() const {
+  // @@protoc_insertion_point(field_get:proto.ResponseUnion.conditional_delete)
+  return conditional_delete_ != NULL ? *conditional_delete_ : *default_instance_->conditional_delete_;
+}
+inline ::proto::ConditionalDeleteResponse* ResponseUnion::mutable_conditional_delete() {
+  set_has_conditional_delete();
+  if (conditional_delete_ == NULL) conditional_delete_ = new ::proto::ConditionalDeleteResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ResponseUnion.conditional_delete)
+  return conditional_delete_;
+}
+inline ::proto::ConditionalDeleteResponse* ResponseUnion::release_conditional_delete() {
+  clear_has_conditional_delete();
+  ::proto::ConditionalDeleteResponse* temp = conditional_delete_;
+  conditional_delete_ = NULL;
+  return temp;
+}
+inline void ResponseUnion::set_allocated_conditional_delete(::proto::ConditionalDeleteResponse* conditional_delete) {
+  delete conditional_delete_;
+  conditional_delete_ = conditional_delete;
+  if (conditional_delete) {
+    set_has_conditional_delete();
+  } else {
+    clear_has_conditional_delete();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ResponseUnion.conditional_delete)
+}
+
 // -------------------------------------------------------------------
 
 // BatchRequest
@@ -6916,6 +9181,30 @@ BatchResponse::mutable_responses() {
   return &responses_;
 }
 
+// optional int32 completed = 3;
+inline bool BatchResponse::has_completed() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void BatchResponse::set_has_completed() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void BatchResponse::clear_has_completed() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void BatchResponse::clear_completed() {
+  completed_ = 0;
+  clear_has_completed();
+}
+inline ::google::protobuf::int32 BatchResponse::completed() const {
+  // @@protoc_insertion_point(field_get:proto.BatchResponse.completed)
+  return completed_;
+}
+inline void BatchResponse::set_completed(::google::protobuf::int32 value) {
+  set_has_completed();
+  completed_ = value;
+  // @@protoc_insertion_point(field_set:proto.BatchResponse.completed)
+}
+
 // -------------------------------------------------------------------
 
 // AdminSplitRequest
@@ -7037,6 +9326,30 @@ inline void AdminSplitRequest::set_allocated_split_key(::std::string* split_key)
   // @@protoc_insertion_point(field_set_allocated:proto.AdminSplitRequest.split_key)
 }
 
+// optional bool dry_run = 3;
+inline bool AdminSplitRequest::has_dry_run() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void AdminSplitRequest::set_has_dry_run() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void AdminSplitRequest::clear_has_dry_run() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void AdminSplitRequest::clear_dry_run() {
+  dry_run_ = false;
+  clear_has_dry_run();
+}
+inline bool AdminSplitRequest::dry_run() const {
+  // @@protoc_insertion_point(field_get:proto.AdminSplitRequest.dry_run)
+  return dry_run_;
+}
+inline void AdminSplitRequest::set_dry_run(bool value) {
+  set_has_dry_run();
+  dry_run_ = value;
+  // @@protoc_insertion_point(field_set:proto.AdminSplitRequest.dry_run)
+}
+
 // -------------------------------------------------------------------
 
 // AdminSplitResponse
@@ -7082,6 +9395,130 @@ inline void AdminSplitResponse::set_allocated_header(::proto::ResponseHeader* he
   // @@protoc_insertion_point(field_set_allocated:proto.AdminSplitResponse.header)
 }
 
+// optional bytes split_key = 2;
+inline bool AdminSplitResponse::has_split_key() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AdminSplitResponse::set_has_split_key() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AdminSplitResponse::clear_has_split_key() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AdminSplitResponse::clear_split_key() {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_->clear();
+  }
+  clear_has_split_key();
+}
+inline const ::std::string& AdminSplitResponse::split_key() const {
+  // @@protoc_insertion_point(field_get:proto.AdminSplitResponse.split_key)
+  return *split_key_;
+}
+inline void AdminSplitResponse::set_split_key(const ::std::string& value) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
+  }
+  split_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.AdminSplitResponse.split_key)
+}
+inline void AdminSplitResponse::set_split_key(const char* value) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
+  }
+  split_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.AdminSplitResponse.split_key)
+}
+inline void AdminSplitResponse::set_split_key(const void* value, size_t size) {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
+  }
+  split_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.AdminSplitResponse.split_key)
+}
+inline ::std::string* AdminSplitResponse::mutable_split_key() {
+  set_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    split_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.AdminSplitResponse.split_key)
+  return split_key_;
+}
+inline ::std::string* AdminSplitResponse::release_split_key() {
+  clear_has_split_key();
+  if (split_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = split_key_;
+    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AdminSplitResponse::set_allocated_split_key(::std::string* split_key) {
+  if (split_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete split_key_;
+  }
+  if (split_key) {
+    set_has_split_key();
+    split_key_ = split_key;
+  } else {
+    clear_has_split_key();
+    split_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AdminSplitResponse.split_key)
+}
+
+// optional int64 left_bytes = 3;
+inline bool AdminSplitResponse::has_left_bytes() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void AdminSplitResponse::set_has_left_bytes() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void AdminSplitResponse::clear_has_left_bytes() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void AdminSplitResponse::clear_left_bytes() {
+  left_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_left_bytes();
+}
+inline ::google::protobuf::int64 AdminSplitResponse::left_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.AdminSplitResponse.left_bytes)
+  return left_bytes_;
+}
+inline void AdminSplitResponse::set_left_bytes(::google::protobuf::int64 value) {
+  set_has_left_bytes();
+  left_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.AdminSplitResponse.left_bytes)
+}
+
+// optional int64 right_bytes = 4;
+inline bool AdminSplitResponse::has_right_bytes() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void AdminSplitResponse::set_has_right_bytes() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void AdminSplitResponse::clear_has_right_bytes() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void AdminSplitResponse::clear_right_bytes() {
+  right_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_right_bytes();
+}
+inline ::google::protobuf::int64 AdminSplitResponse::right_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.AdminSplitResponse.right_bytes)
+  return right_bytes_;
+}
+inline void AdminSplitResponse::set_right_bytes(::google::protobuf::int64 value) {
+  set_has_right_bytes();
+  right_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.AdminSplitResponse.right_bytes)
+}
+
 // -------------------------------------------------------------------
 
 // AdminMergeRequest
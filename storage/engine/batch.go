@@ -135,6 +135,25 @@ func (b *Batch) Clear(key proto.EncodedKey) error {
 	return nil
 }
 
+// ClearRange stores a BatchDelete in the updates tree for every key
+// in the span [start, end) visible through the batch, including keys
+// with pending updates which have not yet been committed.
+func (b *Batch) ClearRange(start, end proto.EncodedKey) error {
+	var keys []proto.EncodedKey
+	if err := b.Iterate(start, end, func(kv proto.RawKeyValue) (bool, error) {
+		keys = append(keys, kv.Key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := b.Clear(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Merge stores the key / value as a BatchMerge in the updates tree.
 // If the updates map already contains a BatchPut, then this value is
 // merged with the Put and kept as a BatchPut. If the updates map
@@ -181,6 +200,16 @@ func (b *Batch) Merge(key proto.EncodedKey, value []byte) error {
 // Commit writes all pending updates to the underlying engine in
 // an atomic write batch.
 func (b *Batch) Commit() error {
+	return b.commit(false)
+}
+
+// CommitSync writes all pending updates to the underlying engine in
+// an atomic write batch which is synced to its write-ahead log.
+func (b *Batch) CommitSync() error {
+	return b.commit(true)
+}
+
+func (b *Batch) commit(sync bool) error {
 	if b.committed {
 		panic("this batch was already committed")
 	}
@@ -190,6 +219,9 @@ func (b *Batch) Commit() error {
 		return false
 	}, proto.RawKeyValue{Key: proto.EncodedKey(KeyMin)}, proto.RawKeyValue{Key: proto.EncodedKey(KeyMax)})
 	b.committed = true
+	if sync {
+		return b.engine.SyncWriteBatch(batch)
+	}
 	return b.engine.WriteBatch(batch)
 }
 
@@ -212,6 +244,11 @@ func (b *Batch) WriteBatch([]interface{}) error {
 	return util.Errorf("cannot write batch from a Batch")
 }
 
+// SyncWriteBatch returns an error if called on a Batch.
+func (b *Batch) SyncWriteBatch([]interface{}) error {
+	return util.Errorf("cannot write batch from a Batch")
+}
+
 // Capacity returns an error if called on a Batch.
 func (b *Batch) Capacity() (StoreCapacity, error) {
 	return StoreCapacity{}, util.Errorf("cannot report capacity from a Batch")
@@ -221,6 +258,15 @@ func (b *Batch) Capacity() (StoreCapacity, error) {
 func (b *Batch) SetGCTimeouts(minTxnTS, minRCacheTS int64) {
 }
 
+// CompactRange is a noop for Batch.
+func (b *Batch) CompactRange(start, end proto.EncodedKey) {
+}
+
+// CompactionStatus returns the compaction status of the wrapped engine.
+func (b *Batch) CompactionStatus() CompactionStatus {
+	return b.engine.CompactionStatus()
+}
+
 // ApproximateSize returns an error if called on a Batch.
 func (b *Batch) ApproximateSize(start, end proto.EncodedKey) (uint64, error) {
 	return 0, util.Errorf("cannot get approximate size from a Batch")
@@ -231,6 +277,27 @@ func (b *Batch) Flush() error {
 	return util.Errorf("cannot flush a Batch")
 }
 
+// PendingBytes returns the number of key and value bytes held in the
+// batch's updates which have yet to be committed.
+func (b *Batch) PendingBytes() int64 {
+	if b.committed {
+		return 0
+	}
+	var n int64
+	b.updates.Do(func(c llrb.Comparable) (done bool) {
+		switch t := c.(type) {
+		case BatchPut:
+			n += int64(len(t.Key) + len(t.Value))
+		case BatchMerge:
+			n += int64(len(t.Key) + len(t.Value))
+		case BatchDelete:
+			n += int64(len(t.Key))
+		}
+		return false
+	})
+	return n
+}
+
 // NewIterator returns an iterator over Batch. Batch iterators are
 // not thread safe.
 func (b *Batch) NewIterator() Iterator {
@@ -274,6 +341,49 @@ func (bi *batchIterator) Seek(key []byte) {
 	bi.mergeUpdates(key)
 }
 
+// SeekReverse finds the last key < key in the engine iterator merged
+// with the batch updates, stepping back over keys deleted in the
+// batch, and then seeks forward to it so that Next() proceeds as
+// after a regular Seek.
+func (bi *batchIterator) SeekReverse(key []byte) {
+	bi.pending = []proto.RawKeyValue{}
+	bi.err = nil
+	end := proto.EncodedKey(key)
+	for {
+		// The last engine key before end, if any.
+		var start proto.EncodedKey
+		bi.iter.SeekReverse(end)
+		valid := bi.iter.Valid()
+		if valid {
+			start = bi.iter.Key()
+		} else if bi.err = bi.iter.Error(); bi.err != nil {
+			return
+		}
+		// Batch updates after the engine key take precedence.
+		from := proto.EncodedKey(KeyMin)
+		if valid {
+			from = start.Next()
+		}
+		bi.getUpdates(from, end)
+		if bi.err != nil {
+			return
+		}
+		if len(bi.pending) > 0 {
+			break
+		}
+		if !valid {
+			return
+		}
+		// Otherwise the engine key is the candidate unless deleted.
+		if _, ok := bi.updates.Get(proto.RawKeyValue{Key: start}).(BatchDelete); !ok {
+			bi.pending = append(bi.pending, proto.RawKeyValue{Key: start})
+			break
+		}
+		end = start
+	}
+	bi.Seek(bi.pending[len(bi.pending)-1].Key)
+}
+
 func (bi *batchIterator) Valid() bool {
 	return bi.err == nil && len(bi.pending) > 0
 }
@@ -347,6 +457,13 @@ func (bi *batchIterator) mergeUpdates(start proto.EncodedKey) {
 		start = kv.Key.Next()
 	}
 
+	// Surface any error which invalidated the engine iterator rather
+	// than treating it as the end of the iteration.
+	if bi.err == nil {
+		if bi.err = bi.iter.Error(); bi.err != nil {
+			return
+		}
+	}
 	if len(bi.pending) == 0 {
 		bi.getUpdates(start, proto.EncodedKey(KeyMax))
 	}
@@ -341,6 +341,66 @@ func TestBatchScanWithDelete(t *testing.T) {
 	}
 }
 
+// TestBatchSeekReverse verifies that a reverse seek on a batch
+// iterator merges batch updates with the engine, skipping keys
+// deleted in the batch.
+func TestBatchSeekReverse(t *testing.T) {
+	e := NewInMem(proto.Attributes{}, 1<<20)
+	defer e.Stop()
+
+	for _, k := range []string{"a", "c", "e"} {
+		if err := e.Put(proto.EncodedKey(k), []byte("value")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b := e.NewBatch()
+	if err := b.Put(proto.EncodedKey("d"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Clear(proto.EncodedKey("e")); err != nil {
+		t.Fatal(err)
+	}
+
+	iter := b.NewIterator()
+	defer iter.Close()
+	testCases := []struct {
+		seekKey, expKey string
+	}{
+		{"z", "d"},
+		{"d", "c"},
+		{"c", "a"},
+		{"a", ""},
+	}
+	for i, test := range testCases {
+		iter.SeekReverse([]byte(test.seekKey))
+		if test.expKey == "" {
+			if iter.Valid() {
+				t.Errorf("%d: expected invalid iterator; got key %q", i, iter.Key())
+			}
+			continue
+		}
+		if !iter.Valid() || !iter.Key().Equal(proto.EncodedKey(test.expKey)) {
+			t.Errorf("%d: expected key %q; got valid=%t, err=%v", i, test.expKey, iter.Valid(), iter.Error())
+		}
+	}
+
+	// Iteration proceeds forward from the reverse seek.
+	iter.SeekReverse([]byte("d"))
+	iter.Next()
+	if !iter.Valid() || !iter.Key().Equal(proto.EncodedKey("d")) {
+		t.Errorf("expected next key \"d\" after reverse seek; got valid=%t", iter.Valid())
+	}
+
+	// A key deleted in the batch is skipped.
+	if err := b.Clear(proto.EncodedKey("c")); err != nil {
+		t.Fatal(err)
+	}
+	iter.SeekReverse([]byte("d"))
+	if !iter.Valid() || !iter.Key().Equal(proto.EncodedKey("a")) {
+		t.Errorf("expected key \"a\" after batch deletion of \"c\"; got valid=%t", iter.Valid())
+	}
+}
+
 // TestBatchScanMaxWithDeleted verifies that if a deletion
 // in the updates map shadows an entry from the engine, the
 // max on a scan is still reached.
@@ -370,6 +430,86 @@ func TestBatchScanMaxWithDeleted(t *testing.T) {
 	}
 }
 
+// TestBatchClearRange verifies that a batched ClearRange removes both
+// committed keys and keys with pending updates in the span, leaving
+// neighboring keys intact.
+func TestBatchClearRange(t *testing.T) {
+	e := NewInMem(proto.Attributes{}, 1<<20)
+	defer e.Stop()
+
+	b := e.NewBatch()
+	for _, k := range []string{"a", "b", "d"} {
+		if err := e.Put(proto.EncodedKey(k), []byte("value")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := b.Put(proto.EncodedKey("c"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.ClearRange(proto.EncodedKey("b"), proto.EncodedKey("d")); err != nil {
+		t.Fatal(err)
+	}
+	// The underlying engine is unchanged until commit.
+	if kvs, err := Scan(e, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0); err != nil || len(kvs) != 3 {
+		t.Errorf("expected 3 keys in engine before commit; got %v (%v)", kvs, err)
+	}
+	if err := b.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := Scan(e, proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 || !bytes.Equal(kvs[0].Key, []byte("a")) || !bytes.Equal(kvs[1].Key, []byte("d")) {
+		t.Errorf("expected scan of \"a\", \"d\"; got %v", kvs)
+	}
+}
+
+// TestBatchPendingBytes verifies that a batch reports the size of its
+// buffered updates until committed.
+func TestBatchPendingBytes(t *testing.T) {
+	e := NewInMem(proto.Attributes{}, 1<<20)
+	defer e.Stop()
+
+	b := e.NewBatch()
+	if n := b.PendingBytes(); n != 0 {
+		t.Errorf("expected empty batch to have no pending bytes; got %d", n)
+	}
+	if err := b.Put(proto.EncodedKey("a"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Clear(proto.EncodedKey("bb")); err != nil {
+		t.Fatal(err)
+	}
+	if n, exp := b.PendingBytes(), int64(len("a")+len("value")+len("bb")); n != exp {
+		t.Errorf("expected %d pending bytes; got %d", exp, n)
+	}
+	if err := b.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if n := b.PendingBytes(); n != 0 {
+		t.Errorf("expected no pending bytes after commit; got %d", n)
+	}
+}
+
+// TestBatchCommitSync verifies that a batch committed with a synced
+// write applies its updates to the underlying engine.
+func TestBatchCommitSync(t *testing.T) {
+	e := NewInMem(proto.Attributes{}, 1<<20)
+	defer e.Stop()
+
+	b := e.NewBatch()
+	if err := b.Put(proto.EncodedKey("a"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.CommitSync(); err != nil {
+		t.Fatal(err)
+	}
+	if val, err := e.Get(proto.EncodedKey("a")); err != nil || !bytes.Equal(val, []byte("value")) {
+		t.Errorf("expected committed value; got %q, %v", val, err)
+	}
+}
+
 // TestBatchConcurrency verifies operation of batch when the
 // underlying engine has concurrent modifications to overlapping
 // keys. This should never happen with the way Cockroach uses
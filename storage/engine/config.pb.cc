@@ -35,6 +35,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* AcctConfig_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   AcctConfig_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AcctRollup_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AcctRollup_reflection_ = NULL;
 const ::google::protobuf::Descriptor* PermConfig_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   PermConfig_reflection_ = NULL;
@@ -117,8 +120,9 @@ void protobuf_AssignDesc_config_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GCPolicy));
   AcctConfig_descriptor_ = file->message_type(4);
-  static const int AcctConfig_offsets_[1] = {
+  static const int AcctConfig_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, cluster_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctConfig, rollup_),
   };
   AcctConfig_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -131,7 +135,31 @@ void protobuf_AssignDesc_config_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(AcctConfig));
-  PermConfig_descriptor_ = file->message_type(5);
+  AcctRollup_descriptor_ = file->message_type(5);
+  static const int AcctRollup_offsets_[10] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, live_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, key_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, val_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, intent_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, live_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, key_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, val_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, intent_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, ranges_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, last_update_nanos_),
+  };
+  AcctRollup_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AcctRollup_descriptor_,
+      AcctRollup::default_instance_,
+      AcctRollup_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AcctRollup, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AcctRollup));
+  PermConfig_descriptor_ = file->message_type(6);
   static const int PermConfig_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PermConfig, read_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PermConfig, write_),
@@ -147,12 +175,14 @@ void protobuf_AssignDesc_config_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(PermConfig));
-  ZoneConfig_descriptor_ = file->message_type(6);
-  static const int ZoneConfig_offsets_[4] = {
+  ZoneConfig_descriptor_ = file->message_type(7);
+  static const int ZoneConfig_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, replica_attrs_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, range_min_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, range_max_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, gc_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, preferred_replica_attrs_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ZoneConfig, audit_),
   };
   ZoneConfig_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -187,6 +217,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     GCPolicy_descriptor_, &GCPolicy::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     AcctConfig_descriptor_, &AcctConfig::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AcctRollup_descriptor_, &AcctRollup::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     PermConfig_descriptor_, &PermConfig::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -206,6 +238,8 @@ void protobuf_ShutdownFile_config_2eproto() {
   delete GCPolicy_reflection_;
   delete AcctConfig::default_instance_;
   delete AcctConfig_reflection_;
+  delete AcctRollup::default_instance_;
+  delete AcctRollup_reflection_;
   delete PermConfig::default_instance_;
   delete PermConfig_reflection_;
   delete ZoneConfig::default_instance_;
@@ -231,19 +265,30 @@ void protobuf_AddDesc_config_2eproto() {
     "\n\tstart_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034\n\007end_ke"
     "y\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022&\n\010replicas\030\004 \003(\0132\016"
     ".proto.ReplicaB\004\310\336\037\000\"3\n\010GCPolicy\022\'\n\013ttl_"
-    "seconds\030\001 \001(\005B\022\310\336\037\000\342\336\037\nTTLSeconds\"E\n\nAcc"
+    "seconds\030\001 \001(\005B\022\310\336\037\000\342\336\037\nTTLSeconds\"v\n\nAcc"
     "tConfig\0227\n\ncluster_id\030\001 \001(\tB#\310\336\037\000\362\336\037\033yam"
-    "l:\"cluster_id,omitempty\"\"h\n\nPermConfig\022+"
-    "\n\004read\030\001 \003(\tB\035\310\336\037\000\362\336\037\025yaml:\"read,omitemp"
-    "ty\"\022-\n\005write\030\002 \003(\tB\036\310\336\037\000\362\336\037\026yaml:\"write,"
-    "omitempty\"\"\233\002\n\nZoneConfig\022K\n\rreplica_att"
-    "rs\030\001 \003(\0132\021.proto.AttributesB!\310\336\037\000\362\336\037\031yam"
-    "l:\"replicas,omitempty\"\022A\n\017range_min_byte"
-    "s\030\002 \001(\003B(\310\336\037\000\362\336\037 yaml:\"range_min_bytes,o"
-    "mitempty\"\022A\n\017range_max_bytes\030\003 \001(\003B(\310\336\037\000"
-    "\362\336\037 yaml:\"range_max_bytes,omitempty\"\022:\n\002"
-    "gc\030\004 \001(\0132\017.proto.GCPolicyB\035\342\336\037\002GC\362\336\037\023yam"
-    "l:\"gc,omitempty\"", 936);
+    "l:\"cluster_id,omitempty\"\022/\n\006rollup\030\002 \001(\010"
+    "B\037\310\336\037\000\362\336\037\027yaml:\"rollup,omitempty\"\"\223\002\n\nAc"
+    "ctRollup\022\030\n\nlive_bytes\030\001 \001(\003B\004\310\336\037\000\022\027\n\tke"
+    "y_bytes\030\002 \001(\003B\004\310\336\037\000\022\027\n\tval_bytes\030\003 \001(\003B\004"
+    "\310\336\037\000\022\032\n\014intent_bytes\030\004 \001(\003B\004\310\336\037\000\022\030\n\nlive"
+    "_count\030\005 \001(\003B\004\310\336\037\000\022\027\n\tkey_count\030\006 \001(\003B\004\310"
+    "\336\037\000\022\027\n\tval_count\030\007 \001(\003B\004\310\336\037\000\022\032\n\014intent_c"
+    "ount\030\010 \001(\003B\004\310\336\037\000\022\024\n\006ranges\030\t \001(\003B\004\310\336\037\000\022\037"
+    "\n\021last_update_nanos\030\n \001(\003B\004\310\336\037\000\"h\n\nPermC"
+    "onfig\022+\n\004read\030\001 \003(\tB\035\310\336\037\000\362\336\037\025yaml:\"read,"
+    "omitempty\"\022-\n\005write\030\002 \003(\tB\036\310\336\037\000\362\336\037\026yaml:"
+    "\"write,omitempty\"\"\242\003\n\nZoneConfig\022K\n\rrepl"
+    "ica_attrs\030\001 \003(\0132\021.proto.AttributesB!\310\336\037\000"
+    "\362\336\037\031yaml:\"replicas,omitempty\"\022A\n\017range_m"
+    "in_bytes\030\002 \001(\003B(\310\336\037\000\362\336\037 yaml:\"range_min_"
+    "bytes,omitempty\"\022A\n\017range_max_bytes\030\003 \001("
+    "\003B(\310\336\037\000\362\336\037 yaml:\"range_max_bytes,omitemp"
+    "ty\"\022:\n\002gc\030\004 \001(\0132\017.proto.GCPolicyB\035\342\336\037\002GC"
+    "\362\336\037\023yaml:\"gc,omitempty\"\022V\n\027preferred_rep"
+    "lica_attrs\030\005 \003(\0132\021.proto.AttributesB\"\310\336\037"
+    "\000\362\336\037\032yaml:\"preferred,omitempty\"\022-\n\005audit"
+    "\030\006 \001(\010B\036\310\336\037\000\362\336\037\026yaml:\"audit,omitempty\"", 1398);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "config.proto", &protobuf_RegisterTypes);
   Attributes::default_instance_ = new Attributes();
@@ -251,6 +296,7 @@ void protobuf_AddDesc_config_2eproto() {
   RangeDescriptor::default_instance_ = new RangeDescriptor();
   GCPolicy::default_instance_ = new GCPolicy();
   AcctConfig::default_instance_ = new AcctConfig();
+  AcctRollup::default_instance_ = new AcctRollup();
   PermConfig::default_instance_ = new PermConfig();
   ZoneConfig::default_instance_ = new ZoneConfig();
   Attributes::default_instance_->InitAsDefaultInstance();
@@ -258,6 +304,7 @@ void protobuf_AddDesc_config_2eproto() {
   RangeDescriptor::default_instance_->InitAsDefaultInstance();
   GCPolicy::default_instance_->InitAsDefaultInstance();
   AcctConfig::default_instance_->InitAsDefaultInstance();
+  AcctRollup::default_instance_->InitAsDefaultInstance();
   PermConfig::default_instance_->InitAsDefaultInstance();
   ZoneConfig::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_config_2eproto);
@@ -1405,6 +1452,7 @@ void GCPolicy::Swap(GCPolicy* other) {
 
 #ifndef _MSC_VER
 const int AcctConfig::kClusterIdFieldNumber;
+const int AcctConfig::kRollupFieldNumber;
 #endif  // !_MSC_VER
 
 AcctConfig::AcctConfig()
@@ -1427,6 +1475,7 @@ void AcctConfig::SharedCtor() {
   ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   cluster_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  rollup_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1465,10 +1514,13 @@ AcctConfig* AcctConfig::New() const {
 }
 
 void AcctConfig::Clear() {
-  if (has_cluster_id()) {
-    if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-      cluster_id_->clear();
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_cluster_id()) {
+      if (cluster_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        cluster_id_->clear();
+      }
     }
+    rollup_ = false;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -1496,6 +1548,21 @@ bool AcctConfig::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(16)) goto parse_rollup;
+        break;
+      }
+
+      // optional bool rollup = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_rollup:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &rollup_)));
+          set_has_rollup();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1535,6 +1602,11 @@ void AcctConfig::SerializeWithCachedSizes(
       1, this->cluster_id(), output);
   }
 
+  // optional bool rollup = 2;
+  if (has_rollup()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->rollup(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1556,6 +1628,11 @@ void AcctConfig::SerializeWithCachedSizes(
         1, this->cluster_id(), target);
   }
 
+  // optional bool rollup = 2;
+  if (has_rollup()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->rollup(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1575,6 +1652,11 @@ int AcctConfig::ByteSize() const {
           this->cluster_id());
     }
 
+    // optional bool rollup = 2;
+    if (has_rollup()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -1605,6 +1687,9 @@ void AcctConfig::MergeFrom(const AcctConfig& from) {
     if (from.has_cluster_id()) {
       set_cluster_id(from.cluster_id());
     }
+    if (from.has_rollup()) {
+      set_rollup(from.rollup());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -1629,6 +1714,7 @@ bool AcctConfig::IsInitialized() const {
 void AcctConfig::Swap(AcctConfig* other) {
   if (other != this) {
     std::swap(cluster_id_, other->cluster_id_);
+    std::swap(rollup_, other->rollup_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -1644,6 +1730,591 @@ void AcctConfig::Swap(AcctConfig* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AcctRollup::kLiveBytesFieldNumber;
+const int AcctRollup::kKeyBytesFieldNumber;
+const int AcctRollup::kValBytesFieldNumber;
+const int AcctRollup::kIntentBytesFieldNumber;
+const int AcctRollup::kLiveCountFieldNumber;
+const int AcctRollup::kKeyCountFieldNumber;
+const int AcctRollup::kValCountFieldNumber;
+const int AcctRollup::kIntentCountFieldNumber;
+const int AcctRollup::kRangesFieldNumber;
+const int AcctRollup::kLastUpdateNanosFieldNumber;
+#endif  // !_MSC_VER
+
+AcctRollup::AcctRollup()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.AcctRollup)
+}
+
+void AcctRollup::InitAsDefaultInstance() {
+}
+
+AcctRollup::AcctRollup(const AcctRollup& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.AcctRollup)
+}
+
+void AcctRollup::SharedCtor() {
+  _cached_size_ = 0;
+  live_bytes_ = GOOGLE_LONGLONG(0);
+  key_bytes_ = GOOGLE_LONGLONG(0);
+  val_bytes_ = GOOGLE_LONGLONG(0);
+  intent_bytes_ = GOOGLE_LONGLONG(0);
+  live_count_ = GOOGLE_LONGLONG(0);
+  key_count_ = GOOGLE_LONGLONG(0);
+  val_count_ = GOOGLE_LONGLONG(0);
+  intent_count_ = GOOGLE_LONGLONG(0);
+  ranges_ = GOOGLE_LONGLONG(0);
+  last_update_nanos_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AcctRollup::~AcctRollup() {
+  // @@protoc_insertion_point(destructor:proto.AcctRollup)
+  SharedDtor();
+}
+
+void AcctRollup::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void AcctRollup::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AcctRollup::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AcctRollup_descriptor_;
+}
+
+const AcctRollup& AcctRollup::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_config_2eproto();
+  return *default_instance_;
+}
+
+AcctRollup* AcctRollup::default_instance_ = NULL;
+
+AcctRollup* AcctRollup::New() const {
+  return new AcctRollup;
+}
+
+void AcctRollup::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<AcctRollup*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 255) {
+    ZR_(live_bytes_, intent_count_);
+  }
+  ZR_(ranges_, last_update_nanos_);
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AcctRollup::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.AcctRollup)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 live_bytes = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &live_bytes_)));
+          set_has_live_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_key_bytes;
+        break;
+      }
+
+      // optional int64 key_bytes = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_key_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &key_bytes_)));
+          set_has_key_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_val_bytes;
+        break;
+      }
+
+      // optional int64 val_bytes = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_val_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &val_bytes_)));
+          set_has_val_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_intent_bytes;
+        break;
+      }
+
+      // optional int64 intent_bytes = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_intent_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &intent_bytes_)));
+          set_has_intent_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_live_count;
+        break;
+      }
+
+      // optional int64 live_count = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_live_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &live_count_)));
+          set_has_live_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(48)) goto parse_key_count;
+        break;
+      }
+
+      // optional int64 key_count = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_key_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &key_count_)));
+          set_has_key_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(56)) goto parse_val_count;
+        break;
+      }
+
+      // optional int64 val_count = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_val_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &val_count_)));
+          set_has_val_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(64)) goto parse_intent_count;
+        break;
+      }
+
+      // optional int64 intent_count = 8;
+      case 8: {
+        if (tag == 64) {
+         parse_intent_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &intent_count_)));
+          set_has_intent_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(72)) goto parse_ranges;
+        break;
+      }
+
+      // optional int64 ranges = 9;
+      case 9: {
+        if (tag == 72) {
+         parse_ranges:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &ranges_)));
+          set_has_ranges();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(80)) goto parse_last_update_nanos;
+        break;
+      }
+
+      // optional int64 last_update_nanos = 10;
+      case 10: {
+        if (tag == 80) {
+         parse_last_update_nanos:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &last_update_nanos_)));
+          set_has_last_update_nanos();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.AcctRollup)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.AcctRollup)
+  return false;
+#undef DO_
+}
+
+void AcctRollup::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.AcctRollup)
+  // optional int64 live_bytes = 1;
+  if (has_live_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->live_bytes(), output);
+  }
+
+  // optional int64 key_bytes = 2;
+  if (has_key_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->key_bytes(), output);
+  }
+
+  // optional int64 val_bytes = 3;
+  if (has_val_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->val_bytes(), output);
+  }
+
+  // optional int64 intent_bytes = 4;
+  if (has_intent_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->intent_bytes(), output);
+  }
+
+  // optional int64 live_count = 5;
+  if (has_live_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->live_count(), output);
+  }
+
+  // optional int64 key_count = 6;
+  if (has_key_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(6, this->key_count(), output);
+  }
+
+  // optional int64 val_count = 7;
+  if (has_val_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(7, this->val_count(), output);
+  }
+
+  // optional int64 intent_count = 8;
+  if (has_intent_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(8, this->intent_count(), output);
+  }
+
+  // optional int64 ranges = 9;
+  if (has_ranges()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(9, this->ranges(), output);
+  }
+
+  // optional int64 last_update_nanos = 10;
+  if (has_last_update_nanos()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(10, this->last_update_nanos(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.AcctRollup)
+}
+
+::google::protobuf::uint8* AcctRollup::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.AcctRollup)
+  // optional int64 live_bytes = 1;
+  if (has_live_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->live_bytes(), target);
+  }
+
+  // optional int64 key_bytes = 2;
+  if (has_key_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->key_bytes(), target);
+  }
+
+  // optional int64 val_bytes = 3;
+  if (has_val_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->val_bytes(), target);
+  }
+
+  // optional int64 intent_bytes = 4;
+  if (has_intent_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->intent_bytes(), target);
+  }
+
+  // optional int64 live_count = 5;
+  if (has_live_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->live_count(), target);
+  }
+
+  // optional int64 key_count = 6;
+  if (has_key_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(6, this->key_count(), target);
+  }
+
+  // optional int64 val_count = 7;
+  if (has_val_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(7, this->val_count(), target);
+  }
+
+  // optional int64 intent_count = 8;
+  if (has_intent_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(8, this->intent_count(), target);
+  }
+
+  // optional int64 ranges = 9;
+  if (has_ranges()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(9, this->ranges(), target);
+  }
+
+  // optional int64 last_update_nanos = 10;
+  if (has_last_update_nanos()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(10, this->last_update_nanos(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.AcctRollup)
+  return target;
+}
+
+int AcctRollup::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 live_bytes = 1;
+    if (has_live_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->live_bytes());
+    }
+
+    // optional int64 key_bytes = 2;
+    if (has_key_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->key_bytes());
+    }
+
+    // optional int64 val_bytes = 3;
+    if (has_val_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->val_bytes());
+    }
+
+    // optional int64 intent_bytes = 4;
+    if (has_intent_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->intent_bytes());
+    }
+
+    // optional int64 live_count = 5;
+    if (has_live_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->live_count());
+    }
+
+    // optional int64 key_count = 6;
+    if (has_key_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->key_count());
+    }
+
+    // optional int64 val_count = 7;
+    if (has_val_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->val_count());
+    }
+
+    // optional int64 intent_count = 8;
+    if (has_intent_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->intent_count());
+    }
+
+  }
+  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    // optional int64 ranges = 9;
+    if (has_ranges()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->ranges());
+    }
+
+    // optional int64 last_update_nanos = 10;
+    if (has_last_update_nanos()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->last_update_nanos());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AcctRollup::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AcctRollup* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AcctRollup*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AcctRollup::MergeFrom(const AcctRollup& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_live_bytes()) {
+      set_live_bytes(from.live_bytes());
+    }
+    if (from.has_key_bytes()) {
+      set_key_bytes(from.key_bytes());
+    }
+    if (from.has_val_bytes()) {
+      set_val_bytes(from.val_bytes());
+    }
+    if (from.has_intent_bytes()) {
+      set_intent_bytes(from.intent_bytes());
+    }
+    if (from.has_live_count()) {
+      set_live_count(from.live_count());
+    }
+    if (from.has_key_count()) {
+      set_key_count(from.key_count());
+    }
+    if (from.has_val_count()) {
+      set_val_count(from.val_count());
+    }
+    if (from.has_intent_count()) {
+      set_intent_count(from.intent_count());
+    }
+  }
+  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    if (from.has_ranges()) {
+      set_ranges(from.ranges());
+    }
+    if (from.has_last_update_nanos()) {
+      set_last_update_nanos(from.last_update_nanos());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AcctRollup::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AcctRollup::CopyFrom(const AcctRollup& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AcctRollup::IsInitialized() const {
+
+  return true;
+}
+
+void AcctRollup::Swap(AcctRollup* other) {
+  if (other != this) {
+    std::swap(live_bytes_, other->live_bytes_);
+    std::swap(key_bytes_, other->key_bytes_);
+    std::swap(val_bytes_, other->val_bytes_);
+    std::swap(intent_bytes_, other->intent_bytes_);
+    std::swap(live_count_, other->live_count_);
+    std::swap(key_count_, other->key_count_);
+    std::swap(val_count_, other->val_count_);
+    std::swap(intent_count_, other->intent_count_);
+    std::swap(ranges_, other->ranges_);
+    std::swap(last_update_nanos_, other->last_update_nanos_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AcctRollup::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AcctRollup_descriptor_;
+  metadata.reflection = AcctRollup_reflection_;
+  return metadata;
+}
+
+
 // ===================================================================
 
 #ifndef _MSC_VER
@@ -1932,6 +2603,8 @@ const int ZoneConfig::kReplicaAttrsFieldNumber;
 const int ZoneConfig::kRangeMinBytesFieldNumber;
 const int ZoneConfig::kRangeMaxBytesFieldNumber;
 const int ZoneConfig::kGcFieldNumber;
+const int ZoneConfig::kPreferredReplicaAttrsFieldNumber;
+const int ZoneConfig::kAuditFieldNumber;
 #endif  // !_MSC_VER
 
 ZoneConfig::ZoneConfig()
@@ -1956,6 +2629,7 @@ void ZoneConfig::SharedCtor() {
   range_min_bytes_ = GOOGLE_LONGLONG(0);
   range_max_bytes_ = GOOGLE_LONGLONG(0);
   gc_ = NULL;
+  audit_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -2002,17 +2676,19 @@ void ZoneConfig::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  if (_has_bits_[0 / 32] & 14) {
+  if (_has_bits_[0 / 32] & 46) {
     ZR_(range_min_bytes_, range_max_bytes_);
     if (has_gc()) {
       if (gc_ != NULL) gc_->::proto::GCPolicy::Clear();
     }
+    audit_ = false;
   }
 
 #undef OFFSET_OF_FIELD_
 #undef ZR_
 
   replica_attrs_.Clear();
+  preferred_replica_attrs_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -2080,6 +2756,35 @@ bool ZoneConfig::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(42)) goto parse_preferred_replica_attrs;
+        break;
+      }
+
+      // repeated .proto.Attributes preferred_replica_attrs = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_preferred_replica_attrs:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_preferred_replica_attrs()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_preferred_replica_attrs;
+        if (input->ExpectTag(48)) goto parse_audit;
+        break;
+      }
+
+      // optional bool audit = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_audit:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &audit_)));
+          set_has_audit();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -2131,6 +2836,17 @@ void ZoneConfig::SerializeWithCachedSizes(
       4, this->gc(), output);
   }
 
+  // repeated .proto.Attributes preferred_replica_attrs = 5;
+  for (int i = 0; i < this->preferred_replica_attrs_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->preferred_replica_attrs(i), output);
+  }
+
+  // optional bool audit = 6;
+  if (has_audit()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(6, this->audit(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -2165,6 +2881,18 @@ void ZoneConfig::SerializeWithCachedSizes(
         4, this->gc(), target);
   }
 
+  // repeated .proto.Attributes preferred_replica_attrs = 5;
+  for (int i = 0; i < this->preferred_replica_attrs_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->preferred_replica_attrs(i), target);
+  }
+
+  // optional bool audit = 6;
+  if (has_audit()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(6, this->audit(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -2198,6 +2926,11 @@ int ZoneConfig::ByteSize() const {
           this->gc());
     }
 
+    // optional bool audit = 6;
+    if (has_audit()) {
+      total_size += 1 + 1;
+    }
+
   }
   // repeated .proto.Attributes replica_attrs = 1;
   total_size += 1 * this->replica_attrs_size();
@@ -2207,6 +2940,14 @@ int ZoneConfig::ByteSize() const {
         this->replica_attrs(i));
   }
 
+  // repeated .proto.Attributes preferred_replica_attrs = 5;
+  total_size += 1 * this->preferred_replica_attrs_size();
+  for (int i = 0; i < this->preferred_replica_attrs_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->preferred_replica_attrs(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -2233,6 +2974,7 @@ void ZoneConfig::MergeFrom(const ::google::protobuf::Message& from) {
 void ZoneConfig::MergeFrom(const ZoneConfig& from) {
   GOOGLE_CHECK_NE(&from, this);
   replica_attrs_.MergeFrom(from.replica_attrs_);
+  preferred_replica_attrs_.MergeFrom(from.preferred_replica_attrs_);
   if (from._has_bits_[1 / 32] & (0xffu << (1 % 32))) {
     if (from.has_range_min_bytes()) {
       set_range_min_bytes(from.range_min_bytes());
@@ -2243,6 +2985,9 @@ void ZoneConfig::MergeFrom(const ZoneConfig& from) {
     if (from.has_gc()) {
       mutable_gc()->::proto::GCPolicy::MergeFrom(from.gc());
     }
+    if (from.has_audit()) {
+      set_audit(from.audit());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -2270,6 +3015,8 @@ void ZoneConfig::Swap(ZoneConfig* other) {
     std::swap(range_min_bytes_, other->range_min_bytes_);
     std::swap(range_max_bytes_, other->range_max_bytes_);
     std::swap(gc_, other->gc_);
+    preferred_replica_attrs_.Swap(&other->preferred_replica_attrs_);
+    std::swap(audit_, other->audit_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
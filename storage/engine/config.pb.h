@@ -39,6 +39,7 @@ class Replica;
 class RangeDescriptor;
 class GCPolicy;
 class AcctConfig;
+class AcctRollup;
 class PermConfig;
 class ZoneConfig;
 
@@ -497,16 +498,26 @@ class AcctConfig : public ::google::protobuf::Message {
   inline ::std::string* release_cluster_id();
   inline void set_allocated_cluster_id(::std::string* cluster_id);
 
+  // optional bool rollup = 2;
+  inline bool has_rollup() const;
+  inline void clear_rollup();
+  static const int kRollupFieldNumber = 2;
+  inline bool rollup() const;
+  inline void set_rollup(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.AcctConfig)
  private:
   inline void set_has_cluster_id();
   inline void clear_has_cluster_id();
+  inline void set_has_rollup();
+  inline void clear_has_rollup();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::std::string* cluster_id_;
+  bool rollup_;
   friend void  protobuf_AddDesc_config_2eproto();
   friend void protobuf_AssignDesc_config_2eproto();
   friend void protobuf_ShutdownFile_config_2eproto();
@@ -516,6 +527,175 @@ class AcctConfig : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class AcctRollup : public ::google::protobuf::Message {
+ public:
+  AcctRollup();
+  virtual ~AcctRollup();
+
+  AcctRollup(const AcctRollup& from);
+
+  inline AcctRollup& operator=(const AcctRollup& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AcctRollup& default_instance();
+
+  void Swap(AcctRollup* other);
+
+  // implements Message ----------------------------------------------
+
+  AcctRollup* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AcctRollup& from);
+  void MergeFrom(const AcctRollup& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 live_bytes = 1;
+  inline bool has_live_bytes() const;
+  inline void clear_live_bytes();
+  static const int kLiveBytesFieldNumber = 1;
+  inline ::google::protobuf::int64 live_bytes() const;
+  inline void set_live_bytes(::google::protobuf::int64 value);
+
+  // optional int64 key_bytes = 2;
+  inline bool has_key_bytes() const;
+  inline void clear_key_bytes();
+  static const int kKeyBytesFieldNumber = 2;
+  inline ::google::protobuf::int64 key_bytes() const;
+  inline void set_key_bytes(::google::protobuf::int64 value);
+
+  // optional int64 val_bytes = 3;
+  inline bool has_val_bytes() const;
+  inline void clear_val_bytes();
+  static const int kValBytesFieldNumber = 3;
+  inline ::google::protobuf::int64 val_bytes() const;
+  inline void set_val_bytes(::google::protobuf::int64 value);
+
+  // optional int64 intent_bytes = 4;
+  inline bool has_intent_bytes() const;
+  inline void clear_intent_bytes();
+  static const int kIntentBytesFieldNumber = 4;
+  inline ::google::protobuf::int64 intent_bytes() const;
+  inline void set_intent_bytes(::google::protobuf::int64 value);
+
+  // optional int64 live_count = 5;
+  inline bool has_live_count() const;
+  inline void clear_live_count();
+  static const int kLiveCountFieldNumber = 5;
+  inline ::google::protobuf::int64 live_count() const;
+  inline void set_live_count(::google::protobuf::int64 value);
+
+  // optional int64 key_count = 6;
+  inline bool has_key_count() const;
+  inline void clear_key_count();
+  static const int kKeyCountFieldNumber = 6;
+  inline ::google::protobuf::int64 key_count() const;
+  inline void set_key_count(::google::protobuf::int64 value);
+
+  // optional int64 val_count = 7;
+  inline bool has_val_count() const;
+  inline void clear_val_count();
+  static const int kValCountFieldNumber = 7;
+  inline ::google::protobuf::int64 val_count() const;
+  inline void set_val_count(::google::protobuf::int64 value);
+
+  // optional int64 intent_count = 8;
+  inline bool has_intent_count() const;
+  inline void clear_intent_count();
+  static const int kIntentCountFieldNumber = 8;
+  inline ::google::protobuf::int64 intent_count() const;
+  inline void set_intent_count(::google::protobuf::int64 value);
+
+  // optional int64 ranges = 9;
+  inline bool has_ranges() const;
+  inline void clear_ranges();
+  static const int kRangesFieldNumber = 9;
+  inline ::google::protobuf::int64 ranges() const;
+  inline void set_ranges(::google::protobuf::int64 value);
+
+  // optional int64 last_update_nanos = 10;
+  inline bool has_last_update_nanos() const;
+  inline void clear_last_update_nanos();
+  static const int kLastUpdateNanosFieldNumber = 10;
+  inline ::google::protobuf::int64 last_update_nanos() const;
+  inline void set_last_update_nanos(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.AcctRollup)
+ private:
+  inline void set_has_live_bytes();
+  inline void clear_has_live_bytes();
+  inline void set_has_key_bytes();
+  inline void clear_has_key_bytes();
+  inline void set_has_val_bytes();
+  inline void clear_has_val_bytes();
+  inline void set_has_intent_bytes();
+  inline void clear_has_intent_bytes();
+  inline void set_has_live_count();
+  inline void clear_has_live_count();
+  inline void set_has_key_count();
+  inline void clear_has_key_count();
+  inline void set_has_val_count();
+  inline void clear_has_val_count();
+  inline void set_has_intent_count();
+  inline void clear_has_intent_count();
+  inline void set_has_ranges();
+  inline void clear_has_ranges();
+  inline void set_has_last_update_nanos();
+  inline void clear_has_last_update_nanos();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 live_bytes_;
+  ::google::protobuf::int64 key_bytes_;
+  ::google::protobuf::int64 val_bytes_;
+  ::google::protobuf::int64 intent_bytes_;
+  ::google::protobuf::int64 live_count_;
+  ::google::protobuf::int64 key_count_;
+  ::google::protobuf::int64 val_count_;
+  ::google::protobuf::int64 intent_count_;
+  ::google::protobuf::int64 ranges_;
+  ::google::protobuf::int64 last_update_nanos_;
+  friend void  protobuf_AddDesc_config_2eproto();
+  friend void protobuf_AssignDesc_config_2eproto();
+  friend void protobuf_ShutdownFile_config_2eproto();
+
+  void InitAsDefaultInstance();
+  static AcctRollup* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class PermConfig : public ::google::protobuf::Message {
  public:
   PermConfig();
@@ -707,6 +887,25 @@ class ZoneConfig : public ::google::protobuf::Message {
   inline ::proto::GCPolicy* release_gc();
   inline void set_allocated_gc(::proto::GCPolicy* gc);
 
+  // repeated .proto.Attributes preferred_replica_attrs = 5;
+  inline int preferred_replica_attrs_size() const;
+  inline void clear_preferred_replica_attrs();
+  static const int kPreferredReplicaAttrsFieldNumber = 5;
+  inline const ::proto::Attributes& preferred_replica_attrs(int index) const;
+  inline ::proto::Attributes* mutable_preferred_replica_attrs(int index);
+  inline ::proto::Attributes* add_preferred_replica_attrs();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::Attributes >&
+      preferred_replica_attrs() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::Attributes >*
+      mutable_preferred_replica_attrs();
+
+  // optional bool audit = 6;
+  inline bool has_audit() const;
+  inline void clear_audit();
+  static const int kAuditFieldNumber = 6;
+  inline bool audit() const;
+  inline void set_audit(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.ZoneConfig)
  private:
   inline void set_has_range_min_bytes();
@@ -715,6 +914,8 @@ class ZoneConfig : public ::google::protobuf::Message {
   inline void clear_has_range_max_bytes();
   inline void set_has_gc();
   inline void clear_has_gc();
+  inline void set_has_audit();
+  inline void clear_has_audit();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -724,6 +925,8 @@ class ZoneConfig : public ::google::protobuf::Message {
   ::google::protobuf::int64 range_min_bytes_;
   ::google::protobuf::int64 range_max_bytes_;
   ::proto::GCPolicy* gc_;
+  ::google::protobuf::RepeatedPtrField< ::proto::Attributes > preferred_replica_attrs_;
+  bool audit_;
   friend void  protobuf_AddDesc_config_2eproto();
   friend void protobuf_AssignDesc_config_2eproto();
   friend void protobuf_ShutdownFile_config_2eproto();
@@ -1203,6 +1406,274 @@ inline void AcctConfig::set_allocated_cluster_id(::std::string* cluster_id) {
   // @@protoc_insertion_point(field_set_allocated:proto.AcctConfig.cluster_id)
 }
 
+// optional bool rollup = 2;
+inline bool AcctConfig::has_rollup() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AcctConfig::set_has_rollup() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AcctConfig::clear_has_rollup() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AcctConfig::clear_rollup() {
+  rollup_ = false;
+  clear_has_rollup();
+}
+inline bool AcctConfig::rollup() const {
+  // @@protoc_insertion_point(field_get:proto.AcctConfig.rollup)
+  return rollup_;
+}
+inline void AcctConfig::set_rollup(bool value) {
+  set_has_rollup();
+  rollup_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctConfig.rollup)
+}
+
+// -------------------------------------------------------------------
+
+// AcctRollup
+
+// optional int64 live_bytes = 1;
+inline bool AcctRollup::has_live_bytes() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void AcctRollup::set_has_live_bytes() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void AcctRollup::clear_has_live_bytes() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void AcctRollup::clear_live_bytes() {
+  live_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_live_bytes();
+}
+inline ::google::protobuf::int64 AcctRollup::live_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.live_bytes)
+  return live_bytes_;
+}
+inline void AcctRollup::set_live_bytes(::google::protobuf::int64 value) {
+  set_has_live_bytes();
+  live_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.live_bytes)
+}
+
+// optional int64 key_bytes = 2;
+inline bool AcctRollup::has_key_bytes() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AcctRollup::set_has_key_bytes() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AcctRollup::clear_has_key_bytes() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AcctRollup::clear_key_bytes() {
+  key_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_key_bytes();
+}
+inline ::google::protobuf::int64 AcctRollup::key_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.key_bytes)
+  return key_bytes_;
+}
+inline void AcctRollup::set_key_bytes(::google::protobuf::int64 value) {
+  set_has_key_bytes();
+  key_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.key_bytes)
+}
+
+// optional int64 val_bytes = 3;
+inline bool AcctRollup::has_val_bytes() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void AcctRollup::set_has_val_bytes() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void AcctRollup::clear_has_val_bytes() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void AcctRollup::clear_val_bytes() {
+  val_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_val_bytes();
+}
+inline ::google::protobuf::int64 AcctRollup::val_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.val_bytes)
+  return val_bytes_;
+}
+inline void AcctRollup::set_val_bytes(::google::protobuf::int64 value) {
+  set_has_val_bytes();
+  val_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.val_bytes)
+}
+
+// optional int64 intent_bytes = 4;
+inline bool AcctRollup::has_intent_bytes() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void AcctRollup::set_has_intent_bytes() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void AcctRollup::clear_has_intent_bytes() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void AcctRollup::clear_intent_bytes() {
+  intent_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_intent_bytes();
+}
+inline ::google::protobuf::int64 AcctRollup::intent_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.intent_bytes)
+  return intent_bytes_;
+}
+inline void AcctRollup::set_intent_bytes(::google::protobuf::int64 value) {
+  set_has_intent_bytes();
+  intent_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.intent_bytes)
+}
+
+// optional int64 live_count = 5;
+inline bool AcctRollup::has_live_count() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void AcctRollup::set_has_live_count() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void AcctRollup::clear_has_live_count() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void AcctRollup::clear_live_count() {
+  live_count_ = GOOGLE_LONGLONG(0);
+  clear_has_live_count();
+}
+inline ::google::protobuf::int64 AcctRollup::live_count() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.live_count)
+  return live_count_;
+}
+inline void AcctRollup::set_live_count(::google::protobuf::int64 value) {
+  set_has_live_count();
+  live_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.live_count)
+}
+
+// optional int64 key_count = 6;
+inline bool AcctRollup::has_key_count() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void AcctRollup::set_has_key_count() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void AcctRollup::clear_has_key_count() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void AcctRollup::clear_key_count() {
+  key_count_ = GOOGLE_LONGLONG(0);
+  clear_has_key_count();
+}
+inline ::google::protobuf::int64 AcctRollup::key_count() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.key_count)
+  return key_count_;
+}
+inline void AcctRollup::set_key_count(::google::protobuf::int64 value) {
+  set_has_key_count();
+  key_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.key_count)
+}
+
+// optional int64 val_count = 7;
+inline bool AcctRollup::has_val_count() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void AcctRollup::set_has_val_count() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void AcctRollup::clear_has_val_count() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void AcctRollup::clear_val_count() {
+  val_count_ = GOOGLE_LONGLONG(0);
+  clear_has_val_count();
+}
+inline ::google::protobuf::int64 AcctRollup::val_count() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.val_count)
+  return val_count_;
+}
+inline void AcctRollup::set_val_count(::google::protobuf::int64 value) {
+  set_has_val_count();
+  val_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.val_count)
+}
+
+// optional int64 intent_count = 8;
+inline bool AcctRollup::has_intent_count() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void AcctRollup::set_has_intent_count() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void AcctRollup::clear_has_intent_count() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void AcctRollup::clear_intent_count() {
+  intent_count_ = GOOGLE_LONGLONG(0);
+  clear_has_intent_count();
+}
+inline ::google::protobuf::int64 AcctRollup::intent_count() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.intent_count)
+  return intent_count_;
+}
+inline void AcctRollup::set_intent_count(::google::protobuf::int64 value) {
+  set_has_intent_count();
+  intent_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.intent_count)
+}
+
+// optional int64 ranges = 9;
+inline bool AcctRollup::has_ranges() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
+}
+inline void AcctRollup::set_has_ranges() {
+  _has_bits_[0] |= 0x00000100u;
+}
+inline void AcctRollup::clear_has_ranges() {
+  _has_bits_[0] &= ~0x00000100u;
+}
+inline void AcctRollup::clear_ranges() {
+  ranges_ = GOOGLE_LONGLONG(0);
+  clear_has_ranges();
+}
+inline ::google::protobuf::int64 AcctRollup::ranges() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.ranges)
+  return ranges_;
+}
+inline void AcctRollup::set_ranges(::google::protobuf::int64 value) {
+  set_has_ranges();
+  ranges_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.ranges)
+}
+
+// optional int64 last_update_nanos = 10;
+inline bool AcctRollup::has_last_update_nanos() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
+}
+inline void AcctRollup::set_has_last_update_nanos() {
+  _has_bits_[0] |= 0x00000200u;
+}
+inline void AcctRollup::clear_has_last_update_nanos() {
+  _has_bits_[0] &= ~0x00000200u;
+}
+inline void AcctRollup::clear_last_update_nanos() {
+  last_update_nanos_ = GOOGLE_LONGLONG(0);
+  clear_has_last_update_nanos();
+}
+inline ::google::protobuf::int64 AcctRollup::last_update_nanos() const {
+  // @@protoc_insertion_point(field_get:proto.AcctRollup.last_update_nanos)
+  return last_update_nanos_;
+}
+inline void AcctRollup::set_last_update_nanos(::google::protobuf::int64 value) {
+  set_has_last_update_nanos();
+  last_update_nanos_ = value;
+  // @@protoc_insertion_point(field_set:proto.AcctRollup.last_update_nanos)
+}
+
 // -------------------------------------------------------------------
 
 // PermConfig
@@ -1438,6 +1909,60 @@ inline void ZoneConfig::set_allocated_gc(::proto::GCPolicy* gc) {
   // @@protoc_insertion_point(field_set_allocated:proto.ZoneConfig.gc)
 }
 
+// repeated .proto.Attributes preferred_replica_attrs = 5;
+inline int ZoneConfig::preferred_replica_attrs_size() const {
+  return preferred_replica_attrs_.size();
+}
+inline void ZoneConfig::clear_preferred_replica_attrs() {
+  preferred_replica_attrs_.Clear();
+}
+inline const ::proto::Attributes& ZoneConfig::preferred_replica_attrs(int index) const {
+  // @@protoc_insertion_point(field_get:proto.ZoneConfig.preferred_replica_attrs)
+  return preferred_replica_attrs_.Get(index);
+}
+inline ::proto::Attributes* ZoneConfig::mutable_preferred_replica_attrs(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.ZoneConfig.preferred_replica_attrs)
+  return preferred_replica_attrs_.Mutable(index);
+}
+inline ::proto::Attributes* ZoneConfig::add_preferred_replica_attrs() {
+  // @@protoc_insertion_point(field_add:proto.ZoneConfig.preferred_replica_attrs)
+  return preferred_replica_attrs_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::Attributes >&
+ZoneConfig::preferred_replica_attrs() const {
+  // @@protoc_insertion_point(field_list:proto.ZoneConfig.preferred_replica_attrs)
+  return preferred_replica_attrs_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::Attributes >*
+ZoneConfig::mutable_preferred_replica_attrs() {
+  // @@protoc_insertion_point(field_mutable_list:proto.ZoneConfig.preferred_replica_attrs)
+  return &preferred_replica_attrs_;
+}
+
+// optional bool audit = 6;
+inline bool ZoneConfig::has_audit() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void ZoneConfig::set_has_audit() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void ZoneConfig::clear_has_audit() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void ZoneConfig::clear_audit() {
+  audit_ = false;
+  clear_has_audit();
+}
+inline bool ZoneConfig::audit() const {
+  // @@protoc_insertion_point(field_get:proto.ZoneConfig.audit)
+  return audit_;
+}
+inline void ZoneConfig::set_audit(bool value) {
+  set_has_audit();
+  audit_ = value;
+  // @@protoc_insertion_point(field_set:proto.ZoneConfig.audit)
+}
+
 
 // @@protoc_insertion_point(namespace_scope)
 
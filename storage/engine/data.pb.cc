@@ -53,6 +53,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* NodeList_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   NodeList_reflection_ = NULL;
+const ::google::protobuf::Descriptor* KeySpan_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  KeySpan_reflection_ = NULL;
 const ::google::protobuf::Descriptor* Transaction_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   Transaction_reflection_ = NULL;
@@ -68,6 +71,9 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* TimeSeriesData_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   TimeSeriesData_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RangeTombstone_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RangeTombstone_reflection_ = NULL;
 const ::google::protobuf::EnumDescriptor* ReplicaChangeType_descriptor_ = NULL;
 const ::google::protobuf::EnumDescriptor* IsolationType_descriptor_ = NULL;
 const ::google::protobuf::EnumDescriptor* TransactionStatus_descriptor_ = NULL;
@@ -263,8 +269,24 @@ void protobuf_AssignDesc_data_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(NodeList));
-  Transaction_descriptor_ = file->message_type(11);
-  static const int Transaction_offsets_[12] = {
+  KeySpan_descriptor_ = file->message_type(11);
+  static const int KeySpan_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeySpan, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeySpan, end_key_),
+  };
+  KeySpan_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      KeySpan_descriptor_,
+      KeySpan::default_instance_,
+      KeySpan_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeySpan, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeySpan, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(KeySpan));
+  Transaction_descriptor_ = file->message_type(12);
+  static const int Transaction_offsets_[15] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, name_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, key_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, id_),
@@ -277,6 +299,9 @@ void protobuf_AssignDesc_data_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, orig_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, max_timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, certain_nodes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, deadline_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, restarts_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Transaction, intents_),
   };
   Transaction_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -289,14 +314,16 @@ void protobuf_AssignDesc_data_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(Transaction));
-  MVCCMetadata_descriptor_ = file->message_type(12);
-  static const int MVCCMetadata_offsets_[6] = {
+  MVCCMetadata_descriptor_ = file->message_type(13);
+  static const int MVCCMetadata_offsets_[8] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, deleted_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, key_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, val_bytes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, raw_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCMetadata, lock_),
   };
   MVCCMetadata_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -309,7 +336,7 @@ void protobuf_AssignDesc_data_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(MVCCMetadata));
-  GCMetadata_descriptor_ = file->message_type(13);
+  GCMetadata_descriptor_ = file->message_type(14);
   static const int GCMetadata_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, last_scan_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCMetadata, oldest_intent_nanos_),
@@ -325,7 +352,7 @@ void protobuf_AssignDesc_data_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(GCMetadata));
-  TimeSeriesDatapoint_descriptor_ = file->message_type(14);
+  TimeSeriesDatapoint_descriptor_ = file->message_type(15);
   static const int TimeSeriesDatapoint_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesDatapoint, timestamp_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesDatapoint, int_value_),
@@ -342,7 +369,7 @@ void protobuf_AssignDesc_data_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(TimeSeriesDatapoint));
-  TimeSeriesData_descriptor_ = file->message_type(15);
+  TimeSeriesData_descriptor_ = file->message_type(16);
   static const int TimeSeriesData_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesData, name_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimeSeriesData, datapoints_),
@@ -358,6 +385,21 @@ void protobuf_AssignDesc_data_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(TimeSeriesData));
+  RangeTombstone_descriptor_ = file->message_type(17);
+  static const int RangeTombstone_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeTombstone, generation_),
+  };
+  RangeTombstone_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      RangeTombstone_descriptor_,
+      RangeTombstone::default_instance_,
+      RangeTombstone_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeTombstone, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeTombstone, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(RangeTombstone));
   ReplicaChangeType_descriptor_ = file->enum_type(0);
   IsolationType_descriptor_ = file->enum_type(1);
   TransactionStatus_descriptor_ = file->enum_type(2);
@@ -395,6 +437,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     InternalCommitTrigger_descriptor_, &InternalCommitTrigger::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     NodeList_descriptor_, &NodeList::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    KeySpan_descriptor_, &KeySpan::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     Transaction_descriptor_, &Transaction::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -405,6 +449,8 @@ void protobuf_RegisterTypes(const ::std::string&) {
     TimeSeriesDatapoint_descriptor_, &TimeSeriesDatapoint::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     TimeSeriesData_descriptor_, &TimeSeriesData::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    RangeTombstone_descriptor_, &RangeTombstone::default_instance());
 }
 
 }  // namespace
@@ -432,6 +478,8 @@ void protobuf_ShutdownFile_data_2eproto() {
   delete InternalCommitTrigger_reflection_;
   delete NodeList::default_instance_;
   delete NodeList_reflection_;
+  delete KeySpan::default_instance_;
+  delete KeySpan_reflection_;
   delete Transaction::default_instance_;
   delete Transaction_reflection_;
   delete MVCCMetadata::default_instance_;
@@ -442,6 +490,8 @@ void protobuf_ShutdownFile_data_2eproto() {
   delete TimeSeriesDatapoint_reflection_;
   delete TimeSeriesData::default_instance_;
   delete TimeSeriesData_reflection_;
+  delete RangeTombstone::default_instance_;
+  delete RangeTombstone_reflection_;
 }
 
 void protobuf_AddDesc_data_2eproto() {
@@ -485,35 +535,41 @@ void protobuf_AddDesc_data_2eproto() {
     "\002 \001(\0132\023.proto.MergeTrigger\022=\n\027change_rep"
     "licas_trigger\030\003 \001(\0132\034.proto.ChangeReplic"
     "asTrigger\"#\n\010NodeList\022\021\n\005nodes\030\001 \003(\005B\002\020\001"
-    ":\004\220\241\037\001\"\313\003\n\013Transaction\022\022\n\004name\030\001 \001(\tB\004\310\336"
-    "\037\000\022\030\n\003key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\026\n\002id\030\003 \001(\014"
-    "B\n\310\336\037\000\342\336\037\002ID\022\026\n\010priority\030\004 \001(\005B\004\310\336\037\000\022-\n\t"
-    "isolation\030\005 \001(\0162\024.proto.IsolationTypeB\004\310"
-    "\336\037\000\022.\n\006status\030\006 \001(\0162\030.proto.TransactionS"
-    "tatusB\004\310\336\037\000\022\023\n\005epoch\030\007 \001(\005B\004\310\336\037\000\022(\n\016last"
-    "_heartbeat\030\010 \001(\0132\020.proto.Timestamp\022)\n\tti"
-    "mestamp\030\t \001(\0132\020.proto.TimestampB\004\310\336\037\000\022.\n"
-    "\016orig_timestamp\030\n \001(\0132\020.proto.TimestampB"
-    "\004\310\336\037\000\022-\n\rmax_timestamp\030\013 \001(\0132\020.proto.Tim"
-    "estampB\004\310\336\037\000\022,\n\rcertain_nodes\030\014 \001(\0132\017.pr"
-    "oto.NodeListB\004\310\336\037\000:\010\230\240\037\000\220\241\037\001\"\306\001\n\014MVCCMet"
-    "adata\022\037\n\003txn\030\001 \001(\0132\022.proto.Transaction\022)"
-    "\n\ttimestamp\030\002 \001(\0132\020.proto.TimestampB\004\310\336\037"
-    "\000\022\025\n\007deleted\030\003 \001(\010B\004\310\336\037\000\022\027\n\tkey_bytes\030\004 "
-    "\001(\003B\004\310\336\037\000\022\027\n\tval_bytes\030\005 \001(\003B\004\310\336\037\000\022\033\n\005va"
-    "lue\030\006 \001(\0132\014.proto.Value:\004\220\241\037\001\"N\n\nGCMetad"
-    "ata\022\035\n\017last_scan_nanos\030\001 \001(\003B\004\310\336\037\000\022\033\n\023ol"
-    "dest_intent_nanos\030\002 \001(\003:\004\220\241\037\001\"b\n\023TimeSer"
-    "iesDatapoint\022\035\n\017timestamp_nanos\030\001 \001(\003B\004\310"
-    "\336\037\000\022\021\n\tint_value\030\002 \001(\003\022\023\n\013float_value\030\003 "
-    "\001(\002:\004\220\241\037\001\"Z\n\016TimeSeriesData\022\022\n\004name\030\001 \001("
-    "\tB\004\310\336\037\000\022.\n\ndatapoints\030\002 \003(\0132\032.proto.Time"
-    "SeriesDatapoint:\004\220\241\037\001*>\n\021ReplicaChangeTy"
-    "pe\022\017\n\013ADD_REPLICA\020\000\022\022\n\016REMOVE_REPLICA\020\001\032"
-    "\004\210\243\036\000*5\n\rIsolationType\022\020\n\014SERIALIZABLE\020\000"
-    "\022\014\n\010SNAPSHOT\020\001\032\004\210\243\036\000*B\n\021TransactionStatu"
-    "s\022\013\n\007PENDING\020\000\022\r\n\tCOMMITTED\020\001\022\013\n\007ABORTED"
-    "\020\002\032\004\210\243\036\000", 2408);
+    ":\004\220\241\037\001\"G\n\007KeySpan\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003"
+    "Key\022\034\n\007end_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key:\004\220\241\037\001\""
+    "\256\004\n\013Transaction\022\022\n\004name\030\001 \001(\tB\004\310\336\037\000\022\030\n\003k"
+    "ey\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\026\n\002id\030\003 \001(\014B\n\310\336\037\000\342"
+    "\336\037\002ID\022\026\n\010priority\030\004 \001(\005B\004\310\336\037\000\022-\n\tisolati"
+    "on\030\005 \001(\0162\024.proto.IsolationTypeB\004\310\336\037\000\022.\n\006"
+    "status\030\006 \001(\0162\030.proto.TransactionStatusB\004"
+    "\310\336\037\000\022\023\n\005epoch\030\007 \001(\005B\004\310\336\037\000\022(\n\016last_heartb"
+    "eat\030\010 \001(\0132\020.proto.Timestamp\022)\n\ttimestamp"
+    "\030\t \001(\0132\020.proto.TimestampB\004\310\336\037\000\022.\n\016orig_t"
+    "imestamp\030\n \001(\0132\020.proto.TimestampB\004\310\336\037\000\022-"
+    "\n\rmax_timestamp\030\013 \001(\0132\020.proto.TimestampB"
+    "\004\310\336\037\000\022,\n\rcertain_nodes\030\014 \001(\0132\017.proto.Nod"
+    "eListB\004\310\336\037\000\022\"\n\010deadline\030\r \001(\0132\020.proto.Ti"
+    "mestamp\022\026\n\010restarts\030\016 \001(\005B\004\310\336\037\000\022%\n\007inten"
+    "ts\030\017 \003(\0132\016.proto.KeySpanB\004\310\336\037\000:\010\230\240\037\000\220\241\037\001"
+    "\"\355\001\n\014MVCCMetadata\022\037\n\003txn\030\001 \001(\0132\022.proto.T"
+    "ransaction\022)\n\ttimestamp\030\002 \001(\0132\020.proto.Ti"
+    "mestampB\004\310\336\037\000\022\025\n\007deleted\030\003 \001(\010B\004\310\336\037\000\022\027\n\t"
+    "key_bytes\030\004 \001(\003B\004\310\336\037\000\022\027\n\tval_bytes\030\005 \001(\003"
+    "B\004\310\336\037\000\022\033\n\005value\030\006 \001(\0132\014.proto.Value\022\021\n\003r"
+    "aw\030\007 \001(\010B\004\310\336\037\000\022\022\n\004lock\030\010 \001(\010B\004\310\336\037\000:\004\220\241\037\001"
+    "\"N\n\nGCMetadata\022\035\n\017last_scan_nanos\030\001 \001(\003B"
+    "\004\310\336\037\000\022\033\n\023oldest_intent_nanos\030\002 \001(\003:\004\220\241\037\001"
+    "\"b\n\023TimeSeriesDatapoint\022\035\n\017timestamp_nan"
+    "os\030\001 \001(\003B\004\310\336\037\000\022\021\n\tint_value\030\002 \001(\003\022\023\n\013flo"
+    "at_value\030\003 \001(\002:\004\220\241\037\001\"Z\n\016TimeSeriesData\022\022"
+    "\n\004name\030\001 \001(\tB\004\310\336\037\000\022.\n\ndatapoints\030\002 \003(\0132\032"
+    ".proto.TimeSeriesDatapoint:\004\220\241\037\001\"*\n\016Rang"
+    "eTombstone\022\030\n\ngeneration\030\001 \001(\003B\004\310\336\037\000*>\n\021"
+    "ReplicaChangeType\022\017\n\013ADD_REPLICA\020\000\022\022\n\016RE"
+    "MOVE_REPLICA\020\001\032\004\210\243\036\000*5\n\rIsolationType\022\020\n"
+    "\014SERIALIZABLE\020\000\022\014\n\010SNAPSHOT\020\001\032\004\210\243\036\000*B\n\021T"
+    "ransactionStatus\022\013\n\007PENDING\020\000\022\r\n\tCOMMITT"
+    "ED\020\001\022\013\n\007ABORTED\020\002\032\004\210\243\036\000", 2663);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "data.proto", &protobuf_RegisterTypes);
   Timestamp::default_instance_ = new Timestamp();
@@ -527,11 +583,13 @@ void protobuf_AddDesc_data_2eproto() {
   ChangeReplicasTrigger::default_instance_ = new ChangeReplicasTrigger();
   InternalCommitTrigger::default_instance_ = new InternalCommitTrigger();
   NodeList::default_instance_ = new NodeList();
+  KeySpan::default_instance_ = new KeySpan();
   Transaction::default_instance_ = new Transaction();
   MVCCMetadata::default_instance_ = new MVCCMetadata();
   GCMetadata::default_instance_ = new GCMetadata();
   TimeSeriesDatapoint::default_instance_ = new TimeSeriesDatapoint();
   TimeSeriesData::default_instance_ = new TimeSeriesData();
+  RangeTombstone::default_instance_ = new RangeTombstone();
   Timestamp::default_instance_->InitAsDefaultInstance();
   Value::default_instance_->InitAsDefaultInstance();
   MVCCValue::default_instance_->InitAsDefaultInstance();
@@ -543,11 +601,13 @@ void protobuf_AddDesc_data_2eproto() {
   ChangeReplicasTrigger::default_instance_->InitAsDefaultInstance();
   InternalCommitTrigger::default_instance_->InitAsDefaultInstance();
   NodeList::default_instance_->InitAsDefaultInstance();
+  KeySpan::default_instance_->InitAsDefaultInstance();
   Transaction::default_instance_->InitAsDefaultInstance();
   MVCCMetadata::default_instance_->InitAsDefaultInstance();
   GCMetadata::default_instance_->InitAsDefaultInstance();
   TimeSeriesDatapoint::default_instance_->InitAsDefaultInstance();
   TimeSeriesData::default_instance_->InitAsDefaultInstance();
+  RangeTombstone::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_data_2eproto);
 }
 
@@ -3906,6 +3966,286 @@ void NodeList::Swap(NodeList* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+const int KeySpan::kKeyFieldNumber;
+const int KeySpan::kEndKeyFieldNumber;
+#endif  // !_MSC_VER
+
+KeySpan::KeySpan()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.KeySpan)
+}
+
+void KeySpan::InitAsDefaultInstance() {
+}
+
+KeySpan::KeySpan(const KeySpan& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.KeySpan)
+}
+
+void KeySpan::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+KeySpan::~KeySpan() {
+  // @@protoc_insertion_point(destructor:proto.KeySpan)
+  SharedDtor();
+}
+
+void KeySpan::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void KeySpan::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* KeySpan::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return KeySpan_descriptor_;
+}
+
+const KeySpan& KeySpan::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_data_2eproto();
+  return *default_instance_;
+}
+
+KeySpan* KeySpan::default_instance_ = NULL;
+
+KeySpan* KeySpan::New() const {
+  return new KeySpan;
+}
+
+void KeySpan::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool KeySpan::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.KeySpan)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_end_key;
+        break;
+      }
+
+      // optional bytes end_key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.KeySpan)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.KeySpan)
+  return false;
+#undef DO_
+}
+
+void KeySpan::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.KeySpan)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->end_key(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.KeySpan)
+}
+
+::google::protobuf::uint8* KeySpan::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.KeySpan)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->end_key(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.KeySpan)
+  return target;
+}
+
+int KeySpan::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional bytes end_key = 2;
+    if (has_end_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void KeySpan::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const KeySpan* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const KeySpan*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void KeySpan::MergeFrom(const KeySpan& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void KeySpan::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void KeySpan::CopyFrom(const KeySpan& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool KeySpan::IsInitialized() const {
+
+  return true;
+}
+
+void KeySpan::Swap(KeySpan* other) {
+  if (other != this) {
+    std::swap(key_, other->key_);
+    std::swap(end_key_, other->end_key_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata KeySpan::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = KeySpan_descriptor_;
+  metadata.reflection = KeySpan_reflection_;
+  return metadata;
+}
+
+
 // ===================================================================
 
 #ifndef _MSC_VER
@@ -3921,6 +4261,9 @@ const int Transaction::kTimestampFieldNumber;
 const int Transaction::kOrigTimestampFieldNumber;
 const int Transaction::kMaxTimestampFieldNumber;
 const int Transaction::kCertainNodesFieldNumber;
+const int Transaction::kDeadlineFieldNumber;
+const int Transaction::kRestartsFieldNumber;
+const int Transaction::kIntentsFieldNumber;
 #endif  // !_MSC_VER
 
 Transaction::Transaction()
@@ -3935,6 +4278,7 @@ void Transaction::InitAsDefaultInstance() {
   orig_timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
   max_timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
   certain_nodes_ = const_cast< ::proto::NodeList*>(&::proto::NodeList::default_instance());
+  deadline_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
 }
 
 Transaction::Transaction(const Transaction& from)
@@ -3959,6 +4303,8 @@ void Transaction::SharedCtor() {
   orig_timestamp_ = NULL;
   max_timestamp_ = NULL;
   certain_nodes_ = NULL;
+  deadline_ = NULL;
+  restarts_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -3983,6 +4329,7 @@ void Transaction::SharedDtor() {
     delete orig_timestamp_;
     delete max_timestamp_;
     delete certain_nodes_;
+    delete deadline_;
   }
 }
 
@@ -4039,7 +4386,7 @@ void Transaction::Clear() {
       if (last_heartbeat_ != NULL) last_heartbeat_->::proto::Timestamp::Clear();
     }
   }
-  if (_has_bits_[8 / 32] & 3840) {
+  if (_has_bits_[8 / 32] & 16128) {
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
     }
@@ -4052,11 +4399,16 @@ void Transaction::Clear() {
     if (has_certain_nodes()) {
       if (certain_nodes_ != NULL) certain_nodes_->::proto::NodeList::Clear();
     }
+    if (has_deadline()) {
+      if (deadline_ != NULL) deadline_->::proto::Timestamp::Clear();
+    }
+    restarts_ = 0;
   }
 
 #undef OFFSET_OF_FIELD_
 #undef ZR_
 
+  intents_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -4244,6 +4596,48 @@ bool Transaction::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(106)) goto parse_deadline;
+        break;
+      }
+
+      // optional .proto.Timestamp deadline = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_deadline:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_deadline()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(112)) goto parse_restarts;
+        break;
+      }
+
+      // optional int32 restarts = 14;
+      case 14: {
+        if (tag == 112) {
+         parse_restarts:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &restarts_)));
+          set_has_restarts();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_intents;
+        break;
+      }
+
+      // repeated .proto.KeySpan intents = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_intents:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_intents()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_intents;
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -4347,6 +4741,23 @@ void Transaction::SerializeWithCachedSizes(
       12, this->certain_nodes(), output);
   }
 
+  // optional .proto.Timestamp deadline = 13;
+  if (has_deadline()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->deadline(), output);
+  }
+
+  // optional int32 restarts = 14;
+  if (has_restarts()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(14, this->restarts(), output);
+  }
+
+  // repeated .proto.KeySpan intents = 15;
+  for (int i = 0; i < this->intents_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, this->intents(i), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -4439,6 +4850,25 @@ void Transaction::SerializeWithCachedSizes(
         12, this->certain_nodes(), target);
   }
 
+  // optional .proto.Timestamp deadline = 13;
+  if (has_deadline()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->deadline(), target);
+  }
+
+  // optional int32 restarts = 14;
+  if (has_restarts()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(14, this->restarts(), target);
+  }
+
+  // repeated .proto.KeySpan intents = 15;
+  for (int i = 0; i < this->intents_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, this->intents(i), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -4535,7 +4965,29 @@ int Transaction::ByteSize() const {
           this->certain_nodes());
     }
 
+    // optional .proto.Timestamp deadline = 13;
+    if (has_deadline()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->deadline());
+    }
+
+    // optional int32 restarts = 14;
+    if (has_restarts()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->restarts());
+    }
+
+  }
+  // repeated .proto.KeySpan intents = 15;
+  total_size += 1 * this->intents_size();
+  for (int i = 0; i < this->intents_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->intents(i));
   }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -4561,6 +5013,7 @@ void Transaction::MergeFrom(const ::google::protobuf::Message& from) {
 
 void Transaction::MergeFrom(const Transaction& from) {
   GOOGLE_CHECK_NE(&from, this);
+  intents_.MergeFrom(from.intents_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_name()) {
       set_name(from.name());
@@ -4600,6 +5053,12 @@ void Transaction::MergeFrom(const Transaction& from) {
     if (from.has_certain_nodes()) {
       mutable_certain_nodes()->::proto::NodeList::MergeFrom(from.certain_nodes());
     }
+    if (from.has_deadline()) {
+      mutable_deadline()->::proto::Timestamp::MergeFrom(from.deadline());
+    }
+    if (from.has_restarts()) {
+      set_restarts(from.restarts());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -4635,6 +5094,9 @@ void Transaction::Swap(Transaction* other) {
     std::swap(orig_timestamp_, other->orig_timestamp_);
     std::swap(max_timestamp_, other->max_timestamp_);
     std::swap(certain_nodes_, other->certain_nodes_);
+    std::swap(deadline_, other->deadline_);
+    std::swap(restarts_, other->restarts_);
+    intents_.Swap(&other->intents_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -4659,6 +5121,8 @@ const int MVCCMetadata::kDeletedFieldNumber;
 const int MVCCMetadata::kKeyBytesFieldNumber;
 const int MVCCMetadata::kValBytesFieldNumber;
 const int MVCCMetadata::kValueFieldNumber;
+const int MVCCMetadata::kRawFieldNumber;
+const int MVCCMetadata::kLockFieldNumber;
 #endif  // !_MSC_VER
 
 MVCCMetadata::MVCCMetadata()
@@ -4688,6 +5152,8 @@ void MVCCMetadata::SharedCtor() {
   key_bytes_ = GOOGLE_LONGLONG(0);
   val_bytes_ = GOOGLE_LONGLONG(0);
   value_ = NULL;
+  raw_ = false;
+  lock_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -4736,15 +5202,15 @@ void MVCCMetadata::Clear() {
     ::memset(&first, 0, n);                                \
   } while (0)
 
-  if (_has_bits_[0 / 32] & 63) {
+  if (_has_bits_[0 / 32] & 255) {
     ZR_(key_bytes_, val_bytes_);
+    ZR_(deleted_, lock_);
     if (has_txn()) {
       if (txn_ != NULL) txn_->::proto::Transaction::Clear();
     }
     if (has_timestamp()) {
       if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
     }
-    deleted_ = false;
     if (has_value()) {
       if (value_ != NULL) value_->::proto::Value::Clear();
     }
@@ -4846,6 +5312,36 @@ bool MVCCMetadata::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(56)) goto parse_raw;
+        break;
+      }
+
+      // optional bool raw = 7;
+      case 7: {
+        if (tag == 56) {
+         parse_raw:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &raw_)));
+          set_has_raw();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(64)) goto parse_lock;
+        break;
+      }
+
+      // optional bool lock = 8;
+      case 8: {
+        if (tag == 64) {
+         parse_lock:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &lock_)));
+          set_has_lock();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -4908,6 +5404,16 @@ void MVCCMetadata::SerializeWithCachedSizes(
       6, this->value(), output);
   }
 
+  // optional bool raw = 7;
+  if (has_raw()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(7, this->raw(), output);
+  }
+
+  // optional bool lock = 8;
+  if (has_lock()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(8, this->lock(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -4954,6 +5460,16 @@ void MVCCMetadata::SerializeWithCachedSizes(
         6, this->value(), target);
   }
 
+  // optional bool raw = 7;
+  if (has_raw()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(7, this->raw(), target);
+  }
+
+  // optional bool lock = 8;
+  if (has_lock()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(8, this->lock(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -5006,6 +5522,16 @@ int MVCCMetadata::ByteSize() const {
           this->value());
     }
 
+    // optional bool raw = 7;
+    if (has_raw()) {
+      total_size += 1 + 1;
+    }
+
+    // optional bool lock = 8;
+    if (has_lock()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -5051,6 +5577,12 @@ void MVCCMetadata::MergeFrom(const MVCCMetadata& from) {
     if (from.has_value()) {
       mutable_value()->::proto::Value::MergeFrom(from.value());
     }
+    if (from.has_raw()) {
+      set_raw(from.raw());
+    }
+    if (from.has_lock()) {
+      set_lock(from.lock());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -5080,6 +5612,8 @@ void MVCCMetadata::Swap(MVCCMetadata* other) {
     std::swap(key_bytes_, other->key_bytes_);
     std::swap(val_bytes_, other->val_bytes_);
     std::swap(value_, other->value_);
+    std::swap(raw_, other->raw_);
+    std::swap(lock_, other->lock_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -5961,6 +6495,228 @@ void TimeSeriesData::Swap(TimeSeriesData* other) {
 }
 
 
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RangeTombstone::kGenerationFieldNumber;
+#endif  // !_MSC_VER
+
+RangeTombstone::RangeTombstone()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.RangeTombstone)
+}
+
+void RangeTombstone::InitAsDefaultInstance() {
+}
+
+RangeTombstone::RangeTombstone(const RangeTombstone& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.RangeTombstone)
+}
+
+void RangeTombstone::SharedCtor() {
+  _cached_size_ = 0;
+  generation_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RangeTombstone::~RangeTombstone() {
+  // @@protoc_insertion_point(destructor:proto.RangeTombstone)
+  SharedDtor();
+}
+
+void RangeTombstone::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void RangeTombstone::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RangeTombstone::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RangeTombstone_descriptor_;
+}
+
+const RangeTombstone& RangeTombstone::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_data_2eproto();
+  return *default_instance_;
+}
+
+RangeTombstone* RangeTombstone::default_instance_ = NULL;
+
+RangeTombstone* RangeTombstone::New() const {
+  return new RangeTombstone;
+}
+
+void RangeTombstone::Clear() {
+  generation_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool RangeTombstone::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.RangeTombstone)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 generation = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &generation_)));
+          set_has_generation();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.RangeTombstone)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.RangeTombstone)
+  return false;
+#undef DO_
+}
+
+void RangeTombstone::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.RangeTombstone)
+  // optional int64 generation = 1;
+  if (has_generation()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->generation(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.RangeTombstone)
+}
+
+::google::protobuf::uint8* RangeTombstone::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.RangeTombstone)
+  // optional int64 generation = 1;
+  if (has_generation()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->generation(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.RangeTombstone)
+  return target;
+}
+
+int RangeTombstone::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 generation = 1;
+    if (has_generation()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->generation());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RangeTombstone::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const RangeTombstone* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const RangeTombstone*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RangeTombstone::MergeFrom(const RangeTombstone& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_generation()) {
+      set_generation(from.generation());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void RangeTombstone::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RangeTombstone::CopyFrom(const RangeTombstone& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RangeTombstone::IsInitialized() const {
+
+  return true;
+}
+
+void RangeTombstone::Swap(RangeTombstone* other) {
+  if (other != this) {
+    std::swap(generation_, other->generation_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata RangeTombstone::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RangeTombstone_descriptor_;
+  metadata.reflection = RangeTombstone_reflection_;
+  return metadata;
+}
+
+
 // @@protoc_insertion_point(namespace_scope)
 
 }  // namespace proto
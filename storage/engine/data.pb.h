@@ -47,11 +47,13 @@ class MergeTrigger;
 class ChangeReplicasTrigger;
 class InternalCommitTrigger;
 class NodeList;
+class KeySpan;
 class Transaction;
 class MVCCMetadata;
 class GCMetadata;
 class TimeSeriesDatapoint;
 class TimeSeriesData;
+class RangeTombstone;
 
 enum ReplicaChangeType {
   ADD_REPLICA = 0,
@@ -1207,6 +1209,105 @@ class NodeList : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class KeySpan : public ::google::protobuf::Message {
+ public:
+  KeySpan();
+  virtual ~KeySpan();
+
+  KeySpan(const KeySpan& from);
+
+  inline KeySpan& operator=(const KeySpan& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const KeySpan& default_instance();
+
+  void Swap(KeySpan* other);
+
+  // implements Message ----------------------------------------------
+
+  KeySpan* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const KeySpan& from);
+  void MergeFrom(const KeySpan& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // optional bytes end_key = 2;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 2;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // @@protoc_insertion_point(class_scope:proto.KeySpan)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  ::std::string* end_key_;
+  friend void  protobuf_AddDesc_data_2eproto();
+  friend void protobuf_AssignDesc_data_2eproto();
+  friend void protobuf_ShutdownFile_data_2eproto();
+
+  void InitAsDefaultInstance();
+  static KeySpan* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class Transaction : public ::google::protobuf::Message {
  public:
   Transaction();
@@ -1369,6 +1470,34 @@ class Transaction : public ::google::protobuf::Message {
   inline ::proto::NodeList* release_certain_nodes();
   inline void set_allocated_certain_nodes(::proto::NodeList* certain_nodes);
 
+  // optional .proto.Timestamp deadline = 13;
+  inline bool has_deadline() const;
+  inline void clear_deadline();
+  static const int kDeadlineFieldNumber = 13;
+  inline const ::proto::Timestamp& deadline() const;
+  inline ::proto::Timestamp* mutable_deadline();
+  inline ::proto::Timestamp* release_deadline();
+  inline void set_allocated_deadline(::proto::Timestamp* deadline);
+
+  // optional int32 restarts = 14;
+  inline bool has_restarts() const;
+  inline void clear_restarts();
+  static const int kRestartsFieldNumber = 14;
+  inline ::google::protobuf::int32 restarts() const;
+  inline void set_restarts(::google::protobuf::int32 value);
+
+  // repeated .proto.KeySpan intents = 15;
+  inline int intents_size() const;
+  inline void clear_intents();
+  static const int kIntentsFieldNumber = 15;
+  inline const ::proto::KeySpan& intents(int index) const;
+  inline ::proto::KeySpan* mutable_intents(int index);
+  inline ::proto::KeySpan* add_intents();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >&
+      intents() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >*
+      mutable_intents();
+
   // @@protoc_insertion_point(class_scope:proto.Transaction)
  private:
   inline void set_has_name();
@@ -1395,6 +1524,10 @@ class Transaction : public ::google::protobuf::Message {
   inline void clear_has_max_timestamp();
   inline void set_has_certain_nodes();
   inline void clear_has_certain_nodes();
+  inline void set_has_deadline();
+  inline void clear_has_deadline();
+  inline void set_has_restarts();
+  inline void clear_has_restarts();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1412,6 +1545,9 @@ class Transaction : public ::google::protobuf::Message {
   ::proto::Timestamp* orig_timestamp_;
   ::proto::Timestamp* max_timestamp_;
   ::proto::NodeList* certain_nodes_;
+  ::proto::Timestamp* deadline_;
+  ::google::protobuf::RepeatedPtrField< ::proto::KeySpan > intents_;
+  ::google::protobuf::int32 restarts_;
   friend void  protobuf_AddDesc_data_2eproto();
   friend void protobuf_AssignDesc_data_2eproto();
   friend void protobuf_ShutdownFile_data_2eproto();
@@ -1522,6 +1658,20 @@ class MVCCMetadata : public ::google::protobuf::Message {
   inline ::proto::Value* release_value();
   inline void set_allocated_value(::proto::Value* value);
 
+  // optional bool raw = 7;
+  inline bool has_raw() const;
+  inline void clear_raw();
+  static const int kRawFieldNumber = 7;
+  inline bool raw() const;
+  inline void set_raw(bool value);
+
+  // optional bool lock = 8;
+  inline bool has_lock() const;
+  inline void clear_lock();
+  static const int kLockFieldNumber = 8;
+  inline bool lock() const;
+  inline void set_lock(bool value);
+
   // @@protoc_insertion_point(class_scope:proto.MVCCMetadata)
  private:
   inline void set_has_txn();
@@ -1536,6 +1686,10 @@ class MVCCMetadata : public ::google::protobuf::Message {
   inline void clear_has_val_bytes();
   inline void set_has_value();
   inline void clear_has_value();
+  inline void set_has_raw();
+  inline void clear_has_raw();
+  inline void set_has_lock();
+  inline void clear_has_lock();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -1547,6 +1701,8 @@ class MVCCMetadata : public ::google::protobuf::Message {
   ::google::protobuf::int64 val_bytes_;
   ::proto::Value* value_;
   bool deleted_;
+  bool raw_;
+  bool lock_;
   friend void  protobuf_AddDesc_data_2eproto();
   friend void protobuf_AssignDesc_data_2eproto();
   friend void protobuf_ShutdownFile_data_2eproto();
@@ -1839,6 +1995,85 @@ class TimeSeriesData : public ::google::protobuf::Message {
   void InitAsDefaultInstance();
   static TimeSeriesData* default_instance_;
 };
+// -------------------------------------------------------------------
+
+class RangeTombstone : public ::google::protobuf::Message {
+ public:
+  RangeTombstone();
+  virtual ~RangeTombstone();
+
+  RangeTombstone(const RangeTombstone& from);
+
+  inline RangeTombstone& operator=(const RangeTombstone& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RangeTombstone& default_instance();
+
+  void Swap(RangeTombstone* other);
+
+  // implements Message ----------------------------------------------
+
+  RangeTombstone* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RangeTombstone& from);
+  void MergeFrom(const RangeTombstone& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 generation = 1;
+  inline bool has_generation() const;
+  inline void clear_generation();
+  static const int kGenerationFieldNumber = 1;
+  inline ::google::protobuf::int64 generation() const;
+  inline void set_generation(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.RangeTombstone)
+ private:
+  inline void set_has_generation();
+  inline void clear_has_generation();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 generation_;
+  friend void  protobuf_AddDesc_data_2eproto();
+  friend void protobuf_AssignDesc_data_2eproto();
+  friend void protobuf_ShutdownFile_data_2eproto();
+
+  void InitAsDefaultInstance();
+  static RangeTombstone* default_instance_;
+};
 // ===================================================================
 
 
@@ -3038,6 +3273,162 @@ NodeList::mutable_nodes() {
 
 // -------------------------------------------------------------------
 
+// KeySpan
+
+// optional bytes key = 1;
+inline bool KeySpan::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void KeySpan::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void KeySpan::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void KeySpan::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& KeySpan::key() const {
+  // @@protoc_insertion_point(field_get:proto.KeySpan.key)
+  return *key_;
+}
+inline void KeySpan::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.KeySpan.key)
+}
+inline void KeySpan::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.KeySpan.key)
+}
+inline void KeySpan::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.KeySpan.key)
+}
+inline ::std::string* KeySpan::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.KeySpan.key)
+  return key_;
+}
+inline ::std::string* KeySpan::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void KeySpan::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.KeySpan.key)
+}
+
+// optional bytes end_key = 2;
+inline bool KeySpan::has_end_key() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void KeySpan::set_has_end_key() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void KeySpan::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void KeySpan::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
+  }
+  clear_has_end_key();
+}
+inline const ::std::string& KeySpan::end_key() const {
+  // @@protoc_insertion_point(field_get:proto.KeySpan.end_key)
+  return *end_key_;
+}
+inline void KeySpan::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.KeySpan.end_key)
+}
+inline void KeySpan::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.KeySpan.end_key)
+}
+inline void KeySpan::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.KeySpan.end_key)
+}
+inline ::std::string* KeySpan::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.KeySpan.end_key)
+  return end_key_;
+}
+inline ::std::string* KeySpan::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void KeySpan::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.KeySpan.end_key)
+}
+
+// -------------------------------------------------------------------
+
 // Transaction
 
 // optional string name = 1;
@@ -3571,6 +3962,101 @@ inline void Transaction::set_allocated_certain_nodes(::proto::NodeList* certain_
   // @@protoc_insertion_point(field_set_allocated:proto.Transaction.certain_nodes)
 }
 
+// optional .proto.Timestamp deadline = 13;
+inline bool Transaction::has_deadline() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void Transaction::set_has_deadline() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void Transaction::clear_has_deadline() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void Transaction::clear_deadline() {
+  if (deadline_ != NULL) deadline_->::proto::Timestamp::Clear();
+  clear_has_deadline();
+}
+inline const ::proto::Timestamp& Transaction::deadline() const {
+  // @@protoc_insertion_point(field_get:proto.Transaction.deadline)
+  return deadline_ != NULL ? *deadline_ : *default_instance_->deadline_;
+}
+inline ::proto::Timestamp* Transaction::mutable_deadline() {
+  set_has_deadline();
+  if (deadline_ == NULL) deadline_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.Transaction.deadline)
+  return deadline_;
+}
+inline ::proto::Timestamp* Transaction::release_deadline() {
+  clear_has_deadline();
+  ::proto::Timestamp* temp = deadline_;
+  deadline_ = NULL;
+  return temp;
+}
+inline void Transaction::set_allocated_deadline(::proto::Timestamp* deadline) {
+  delete deadline_;
+  deadline_ = deadline;
+  if (deadline) {
+    set_has_deadline();
+  } else {
+    clear_has_deadline();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Transaction.deadline)
+}
+
+// optional int32 restarts = 14;
+inline bool Transaction::has_restarts() const {
+  return (_has_bits_[0] & 0x00002000u) != 0;
+}
+inline void Transaction::set_has_restarts() {
+  _has_bits_[0] |= 0x00002000u;
+}
+inline void Transaction::clear_has_restarts() {
+  _has_bits_[0] &= ~0x00002000u;
+}
+inline void Transaction::clear_restarts() {
+  restarts_ = 0;
+  clear_has_restarts();
+}
+inline ::google::protobuf::int32 Transaction::restarts() const {
+  // @@protoc_insertion_point(field_get:proto.Transaction.restarts)
+  return restarts_;
+}
+inline void Transaction::set_restarts(::google::protobuf::int32 value) {
+  set_has_restarts();
+  restarts_ = value;
+  // @@protoc_insertion_point(field_set:proto.Transaction.restarts)
+}
+
+// repeated .proto.KeySpan intents = 15;
+inline int Transaction::intents_size() const {
+  return intents_.size();
+}
+inline void Transaction::clear_intents() {
+  intents_.Clear();
+}
+inline const ::proto::KeySpan& Transaction::intents(int index) const {
+  // @@protoc_insertion_point(field_get:proto.Transaction.intents)
+  return intents_.Get(index);
+}
+inline ::proto::KeySpan* Transaction::mutable_intents(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.Transaction.intents)
+  return intents_.Mutable(index);
+}
+inline ::proto::KeySpan* Transaction::add_intents() {
+  // @@protoc_insertion_point(field_add:proto.Transaction.intents)
+  return intents_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >&
+Transaction::intents() const {
+  // @@protoc_insertion_point(field_list:proto.Transaction.intents)
+  return intents_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::KeySpan >*
+Transaction::mutable_intents() {
+  // @@protoc_insertion_point(field_mutable_list:proto.Transaction.intents)
+  return &intents_;
+}
+
 // -------------------------------------------------------------------
 
 // MVCCMetadata
@@ -3770,6 +4256,54 @@ inline void MVCCMetadata::set_allocated_value(::proto::Value* value) {
   // @@protoc_insertion_point(field_set_allocated:proto.MVCCMetadata.value)
 }
 
+// optional bool raw = 7;
+inline bool MVCCMetadata::has_raw() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void MVCCMetadata::set_has_raw() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void MVCCMetadata::clear_has_raw() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void MVCCMetadata::clear_raw() {
+  raw_ = false;
+  clear_has_raw();
+}
+inline bool MVCCMetadata::raw() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCMetadata.raw)
+  return raw_;
+}
+inline void MVCCMetadata::set_raw(bool value) {
+  set_has_raw();
+  raw_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCMetadata.raw)
+}
+
+// optional bool lock = 8;
+inline bool MVCCMetadata::has_lock() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void MVCCMetadata::set_has_lock() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void MVCCMetadata::clear_has_lock() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void MVCCMetadata::clear_lock() {
+  lock_ = false;
+  clear_has_lock();
+}
+inline bool MVCCMetadata::lock() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCMetadata.lock)
+  return lock_;
+}
+inline void MVCCMetadata::set_lock(bool value) {
+  set_has_lock();
+  lock_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCMetadata.lock)
+}
+
 // -------------------------------------------------------------------
 
 // GCMetadata
@@ -4008,6 +4542,34 @@ TimeSeriesData::mutable_datapoints() {
   return &datapoints_;
 }
 
+// -------------------------------------------------------------------
+
+// RangeTombstone
+
+// optional int64 generation = 1;
+inline bool RangeTombstone::has_generation() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RangeTombstone::set_has_generation() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RangeTombstone::clear_has_generation() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RangeTombstone::clear_generation() {
+  generation_ = GOOGLE_LONGLONG(0);
+  clear_has_generation();
+}
+inline ::google::protobuf::int64 RangeTombstone::generation() const {
+  // @@protoc_insertion_point(field_get:proto.RangeTombstone.generation)
+  return generation_;
+}
+inline void RangeTombstone::set_generation(::google::protobuf::int64 value) {
+  set_has_generation();
+  generation_ = value;
+  // @@protoc_insertion_point(field_set:proto.RangeTombstone.generation)
+}
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -142,6 +142,20 @@ const proto::ResponseHeader* GetResponseHeader(const proto::ReadWriteCmdResponse
     return &rwResp.internal_merge().header();
   } else if (rwResp.has_internal_truncate_log()) {
     return &rwResp.internal_truncate_log().header();
+  } else if (rwResp.has_internal_begin_transaction()) {
+    return &rwResp.internal_begin_transaction().header();
+  } else if (rwResp.has_internal_put_if_absent()) {
+    return &rwResp.internal_put_if_absent().header();
+  } else if (rwResp.has_get()) {
+    return &rwResp.get().header();
+  } else if (rwResp.has_conditional_delete()) {
+    return &rwResp.conditional_delete().header();
+  } else if (rwResp.has_batch()) {
+    return &rwResp.batch().header();
+  } else if (rwResp.has_internal_resolve_intent_range()) {
+    return &rwResp.internal_resolve_intent_range().header();
+  } else if (rwResp.has_internal_leader_lease()) {
+    return &rwResp.internal_leader_lease().header();
   }
   return NULL;
 }
@@ -747,6 +761,22 @@ DBStatus DBFlush(DBEngine* db) {
   return ToDBStatus(db->rep->Flush(options));
 }
 
+uint64_t DBPendingBytes(DBEngine* db) {
+  uint64_t size = 0;
+  if (!db->rep->GetIntProperty("rocksdb.cur-size-all-mem-tables", &size)) {
+    return 0;
+  }
+  return size;
+}
+
+uint64_t DBPendingCompactionBytes(DBEngine* db) {
+  uint64_t size = 0;
+  if (!db->rep->GetIntProperty("rocksdb.estimate-pending-compaction-bytes", &size)) {
+    return 0;
+  }
+  return size;
+}
+
 void DBSetGCTimeouts(DBEngine * db, int64_t min_txn_ts, int64_t min_rcache_ts) {
   DBCompactionFilterFactory *db_cff =
       (DBCompactionFilterFactory*)db->rep->GetOptions().compaction_filter_factory.get();
@@ -808,8 +838,9 @@ DBStatus DBDelete(DBEngine* db, DBSlice key) {
   return ToDBStatus(db->rep->Delete(options, ToSlice(key)));
 }
 
-DBStatus DBWrite(DBEngine* db, DBBatch *batch) {
+DBStatus DBWrite(DBEngine* db, DBBatch *batch, bool sync) {
   rocksdb::WriteOptions options;
+  options.sync = sync;
   return ToDBStatus(db->rep->Write(options, &batch->rep));
 }
 
@@ -856,6 +887,10 @@ void DBIterNext(DBIterator* iter) {
   iter->rep->Next();
 }
 
+void DBIterPrev(DBIterator* iter) {
+  iter->rep->Prev();
+}
+
 DBSlice DBIterKey(DBIterator* iter) {
   return ToDBSlice(iter->rep->key());
 }
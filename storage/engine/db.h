@@ -70,6 +70,14 @@ void DBClose(DBEngine* db);
 // complete.
 DBStatus DBFlush(DBEngine* db);
 
+// Returns the approximate number of bytes in the memtables which have
+// not yet been flushed to disk.
+uint64_t DBPendingBytes(DBEngine* db);
+
+// Returns RocksDB's estimate of the number of bytes which need to be
+// compacted, or 0 if no estimate is available.
+uint64_t DBPendingCompactionBytes(DBEngine* db);
+
 // Sets GC timeouts.
 void DBSetGCTimeouts(DBEngine * db, int64_t min_txn_ts, int64_t min_rcache_ts);
 
@@ -99,8 +107,9 @@ DBStatus DBGet(DBEngine* db, DBSnapshot* snapshot, DBSlice key, DBString* value)
 DBStatus DBDelete(DBEngine* db, DBSlice key);
 
 // Applies a batch of operations (puts, merges and deletes) to the
-// database atomically.
-DBStatus DBWrite(DBEngine* db, DBBatch *batch);
+// database atomically. If sync is true, the write-ahead log is synced
+// before returning.
+DBStatus DBWrite(DBEngine* db, DBBatch *batch, bool sync);
 
 // Creates a new snapshot of the database for use in DBGet() and
 // DBNewIter(). It is the callers responsibility to call
@@ -137,6 +146,11 @@ int  DBIterValid(DBIterator* iter);
 // last key.
 void DBIterNext(DBIterator* iter);
 
+// Moves the iterator back to the previous key. After this call,
+// DBIterValid() returns 1 iff the iterator was not positioned at the
+// first key.
+void DBIterPrev(DBIterator* iter);
+
 // Returns the key at the current iterator position. Note that a slice
 // is returned and the memory does not have to be freed.
 DBSlice DBIterKey(DBIterator* iter);
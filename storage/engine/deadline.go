@@ -0,0 +1,223 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// deadlineEngine wraps an engine, abandoning operations which don't
+// complete before a deadline.
+type deadlineEngine struct {
+	Engine
+	deadline time.Time
+}
+
+// NewDeadlineEngine returns an engine which wraps e and abandons
+// reads, writes and iterator positioning which have not completed by
+// the specified deadline, returning a DeadlineExceededError. An
+// abandoned operation runs to completion against e in the background
+// and its result is discarded, so the deadline engine should only
+// be used where abandoning a write is safe.
+func NewDeadlineEngine(e Engine, deadline time.Time) Engine {
+	return &deadlineEngine{Engine: e, deadline: deadline}
+}
+
+// runWithDeadline invokes f and returns its error, or a
+// DeadlineExceededError if f doesn't complete before deadline. In the
+// latter case, the returned channel receives f's error once f
+// eventually completes; otherwise it is nil.
+func runWithDeadline(deadline time.Time, f func() error) (<-chan error, error) {
+	timeout := deadline.Sub(time.Now())
+	if timeout <= 0 {
+		return nil, &proto.DeadlineExceededError{Deadline: deadline.UnixNano()}
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- f()
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-errChan:
+		return nil, err
+	case <-timer.C:
+		return errChan, &proto.DeadlineExceededError{Deadline: deadline.UnixNano()}
+	}
+}
+
+// run invokes f, subject to the engine's deadline.
+func (d *deadlineEngine) run(f func() error) error {
+	_, err := runWithDeadline(d.deadline, f)
+	return err
+}
+
+// Put sets the given key to the value provided.
+func (d *deadlineEngine) Put(key proto.EncodedKey, value []byte) error {
+	return d.run(func() error { return d.Engine.Put(key, value) })
+}
+
+// Get returns the value for the given key, nil otherwise.
+func (d *deadlineEngine) Get(key proto.EncodedKey) ([]byte, error) {
+	var value []byte
+	if err := d.run(func() error {
+		var err error
+		value, err = d.Engine.Get(key)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Iterate scans from start to end keys, invoking f on each key value
+// pair. f is never invoked once Iterate has returned, even if the
+// underlying iteration was abandoned.
+func (d *deadlineEngine) Iterate(start, end proto.EncodedKey, f func(proto.RawKeyValue) (bool, error)) error {
+	var mu sync.Mutex
+	abandoned := false
+	err := d.run(func() error {
+		return d.Engine.Iterate(start, end, func(kv proto.RawKeyValue) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if abandoned {
+				return true, nil
+			}
+			return f(kv)
+		})
+	})
+	if _, ok := err.(*proto.DeadlineExceededError); ok {
+		mu.Lock()
+		abandoned = true
+		mu.Unlock()
+	}
+	return err
+}
+
+// Clear removes the item from the db with the given key.
+func (d *deadlineEngine) Clear(key proto.EncodedKey) error {
+	return d.run(func() error { return d.Engine.Clear(key) })
+}
+
+// ClearRange removes all items from the db with keys in the span from
+// start (inclusive) to end (exclusive).
+func (d *deadlineEngine) ClearRange(start, end proto.EncodedKey) error {
+	return d.run(func() error { return d.Engine.ClearRange(start, end) })
+}
+
+// WriteBatch atomically applies the specified writes, deletions and
+// merges.
+func (d *deadlineEngine) WriteBatch(cmds []interface{}) error {
+	return d.run(func() error { return d.Engine.WriteBatch(cmds) })
+}
+
+// SyncWriteBatch atomically applies the specified writes, deletions
+// and merges, syncing them to the write-ahead log.
+func (d *deadlineEngine) SyncWriteBatch(cmds []interface{}) error {
+	return d.run(func() error { return d.Engine.SyncWriteBatch(cmds) })
+}
+
+// Merge merges the value into the existing value at key.
+func (d *deadlineEngine) Merge(key proto.EncodedKey, value []byte) error {
+	return d.run(func() error { return d.Engine.Merge(key, value) })
+}
+
+// NewIterator returns a new instance of an Iterator over the wrapped
+// engine whose Seek and Next operations are subject to the deadline.
+func (d *deadlineEngine) NewIterator() Iterator {
+	return &deadlineIterator{Iterator: d.Engine.NewIterator(), deadline: d.deadline}
+}
+
+// NewSnapshot returns a new snapshot of the wrapped engine, subject to
+// the same deadline.
+func (d *deadlineEngine) NewSnapshot() Engine {
+	return NewDeadlineEngine(d.Engine.NewSnapshot(), d.deadline)
+}
+
+// NewBatch returns a new batch whose reads and commit are subject to
+// the deadline.
+func (d *deadlineEngine) NewBatch() Engine {
+	return NewBatch(d)
+}
+
+// deadlineIterator wraps an iterator, abandoning Seek and Next
+// operations which don't complete before a deadline. Once an
+// operation has been abandoned the iterator is invalid.
+type deadlineIterator struct {
+	Iterator
+	deadline time.Time
+	err      error
+	// Receives the result of an abandoned operation on completion.
+	abandoned <-chan error
+}
+
+// run invokes f, subject to the iterator's deadline.
+func (di *deadlineIterator) run(f func()) {
+	if di.err != nil {
+		return
+	}
+	abandoned, err := runWithDeadline(di.deadline, func() error {
+		f()
+		return nil
+	})
+	di.err, di.abandoned = err, abandoned
+}
+
+// Close frees up resources held by the iterator. If an operation was
+// abandoned, the wrapped iterator is closed once it completes.
+func (di *deadlineIterator) Close() {
+	if di.abandoned != nil {
+		go func() {
+			<-di.abandoned
+			di.Iterator.Close()
+		}()
+		return
+	}
+	di.Iterator.Close()
+}
+
+// Seek advances the iterator to the first key in the engine which
+// is >= the provided key.
+func (di *deadlineIterator) Seek(key []byte) {
+	di.run(func() { di.Iterator.Seek(key) })
+}
+
+// SeekReverse moves the iterator to the last key in the engine which
+// is < the provided key.
+func (di *deadlineIterator) SeekReverse(key []byte) {
+	di.run(func() { di.Iterator.SeekReverse(key) })
+}
+
+// Valid returns true if the iterator is currently valid.
+func (di *deadlineIterator) Valid() bool {
+	return di.err == nil && di.Iterator.Valid()
+}
+
+// Next advances the iterator to the next key/value in the iteration.
+func (di *deadlineIterator) Next() {
+	di.run(func() { di.Iterator.Next() })
+}
+
+// Error returns the error, if any, which the iterator encountered.
+func (di *deadlineIterator) Error() error {
+	if di.err != nil {
+		return di.err
+	}
+	return di.Iterator.Error()
+}
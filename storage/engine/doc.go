@@ -51,7 +51,10 @@ of the timestamp (8 bytes for the nanosecond wall time, followed by 4
 bytes for the logical time). The MVCC version value is a message of
 type proto.MVCCValue which indicates whether the version is a deletion
 timestamp and if not, contains a proto.Value object which holds the
-actual value. The decreasing encoding on the timestamp sorts the most
+actual value. Keys registered via RegisterRawValuePrefix instead store
+the value's bytes unwrapped, with a zero-length value marking a
+deletion tombstone; the metadata's raw flag records which encoding a
+key's versions use. The decreasing encoding on the timestamp sorts the most
 recent version directly after the metadata key. This increases the
 likelihood that an Engine.Get() of the MVCC metadata will get the same
 block containing the most recent version, even if there are many
@@ -21,6 +21,7 @@ package engine
 
 import (
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util"
@@ -39,6 +40,15 @@ func (sc StoreCapacity) PercentAvail() float64 {
 	return float64(sc.Available) / float64(sc.Capacity)
 }
 
+// CompactionStatus describes the progress of an engine's compactions.
+type CompactionStatus struct {
+	// PendingBytes is the estimated number of bytes awaiting compaction.
+	PendingBytes int64
+	// LastCompaction is the time at which the most recent call to
+	// CompactRange completed, or the zero time if there has been none.
+	LastCompaction time.Time
+}
+
 // Iterator is an interface for iterating over key/value pairs in an
 // engine. Iterator implementation are thread safe unless otherwise
 // noted.
@@ -48,6 +58,10 @@ type Iterator interface {
 	// Seek advances the iterator to the first key in the engine which
 	// is >= the provided key.
 	Seek(key []byte)
+	// SeekReverse moves the iterator to the last key in the engine
+	// which is < the provided key. Subsequent calls to Next() iterate
+	// forward from that key.
+	SeekReverse(key []byte)
 	// Valid returns true if the iterator is currently valid. An
 	// iterator which hasn't been seeked or has gone past the end of the
 	// key range is invalid.
@@ -87,10 +101,18 @@ type Engine interface {
 	// Note that clear actually removes entries from the storage
 	// engine, rather than inserting tombstones.
 	Clear(key proto.EncodedKey) error
+	// ClearRange removes all items from the db with keys in the span
+	// from start (inclusive) to end (exclusive) in a single
+	// operation. As with Clear, entries are removed from the storage
+	// engine rather than being marked with MVCC tombstones.
+	ClearRange(start, end proto.EncodedKey) error
 	// WriteBatch atomically applies the specified writes, deletions and
 	// merges. The list passed to WriteBatch must only contain elements
 	// of type Batch{Put,Merge,Delete}.
 	WriteBatch([]interface{}) error
+	// SyncWriteBatch is like WriteBatch, but returns only once the
+	// writes have been synced to the engine's write-ahead log.
+	SyncWriteBatch([]interface{}) error
 	// Merge is a high-performance write operation used for values which are
 	// accumulated over several writes. Multiple values can be merged
 	// sequentially into a single key; a subsequent read will return a "merged"
@@ -114,12 +136,23 @@ type Engine interface {
 	// Rows with timestamps less than the associated value will be GC'd
 	// during compaction.
 	SetGCTimeouts(minTxnTS, minRCacheTS int64)
+	// CompactRange compacts the specified key range, dropping rows
+	// eligible for GC according to the engine's GC timeouts. Nil start
+	// or end keys extend the compaction to the start or end of the
+	// engine respectively.
+	CompactRange(start, end proto.EncodedKey)
 	// ApproximateSize returns the approximate number of bytes the engine is
 	// using to store data for the given range of keys.
 	ApproximateSize(start, end proto.EncodedKey) (uint64, error)
 	// Flush causes the engine to write all in-memory data to disk
 	// immediately.
 	Flush() error
+	// PendingBytes returns the number of bytes of writes buffered by
+	// the engine which have not yet been flushed.
+	PendingBytes() int64
+	// CompactionStatus returns the engine's estimated pending
+	// compaction bytes and the time of its last compaction.
+	CompactionStatus() CompactionStatus
 	// NewIterator returns a new instance of an Iterator over this
 	// engine. The caller must invoke Iterator.Close() when finished with
 	// the iterator to free resources.
@@ -137,6 +170,10 @@ type Engine interface {
 	// Commit atomically applies any batched updates to the underlying
 	// engine. This is a noop unless the engine was created via NewBatch().
 	Commit() error
+	// CommitSync is like Commit, but returns only once the batched
+	// updates have been synced to the underlying engine's write-ahead
+	// log.
+	CommitSync() error
 }
 
 // A BatchDelete is a delete operation executed as part of an atomic batch.
@@ -483,6 +483,34 @@ func TestEngineDeleteRange(t *testing.T) {
 	}, t)
 }
 
+func TestEngineClearRange(t *testing.T) {
+	runWithAllEngines(func(engine Engine, t *testing.T) {
+		keys := []proto.EncodedKey{
+			proto.EncodedKey("a"),
+			proto.EncodedKey("aa"),
+			proto.EncodedKey("aaa"),
+			proto.EncodedKey("ab"),
+			proto.EncodedKey("abc"),
+			proto.EncodedKey("b"),
+		}
+		insertKeys(keys, engine, t)
+
+		if err := engine.ClearRange(proto.EncodedKey("aa"), proto.EncodedKey("abc")); err != nil {
+			t.Fatal(err)
+		}
+		// Verify that exactly the keys in the span were removed.
+		verifyScan(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 10,
+			[]proto.EncodedKey{proto.EncodedKey("a"), proto.EncodedKey("abc"), proto.EncodedKey("b")}, engine, t)
+
+		// Clearing an empty span is a noop.
+		if err := engine.ClearRange(proto.EncodedKey("aa"), proto.EncodedKey("ab")); err != nil {
+			t.Fatal(err)
+		}
+		verifyScan(proto.EncodedKey(KeyMin), proto.EncodedKey(KeyMax), 10,
+			[]proto.EncodedKey{proto.EncodedKey("a"), proto.EncodedKey("abc"), proto.EncodedKey("b")}, engine, t)
+	}, t)
+}
+
 func TestSnapshot(t *testing.T) {
 	runWithAllEngines(func(engine Engine, t *testing.T) {
 		key := []byte("a")
@@ -599,6 +627,9 @@ func TestSnapshotMethods(t *testing.T) {
 		if err := snap.WriteBatch([]interface{}{BatchDelete{proto.RawKeyValue{Key: keys[0]}}}); err == nil {
 			t.Error("expected error on WriteBatch to snapshot")
 		}
+		if err := snap.SyncWriteBatch([]interface{}{BatchDelete{proto.RawKeyValue{Key: keys[0]}}}); err == nil {
+			t.Error("expected error on SyncWriteBatch to snapshot")
+		}
 
 		// Verify Merge is error.
 		if err := snap.Merge([]byte("merge-key"), appender("x")); err == nil {
@@ -652,6 +683,9 @@ func TestSnapshotMethods(t *testing.T) {
 		if err := snap.Commit(); err == nil {
 			t.Error("expected error on Commit to snapshot")
 		}
+		if err := snap.CommitSync(); err == nil {
+			t.Error("expected error on CommitSync to snapshot")
+		}
 	}, t)
 }
 
@@ -59,6 +59,39 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* ConditionFailedError_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ConditionFailedError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ConflictTimeoutError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ConflictTimeoutError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RaftGroupDeletedError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RaftGroupDeletedError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* StoreOverloadedError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  StoreOverloadedError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* DeadlineExceededError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  DeadlineExceededError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ChecksumMismatchError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ChecksumMismatchError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ProtectedKeyError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ProtectedKeyError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* TooManyIntentsError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  TooManyIntentsError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* CommandTooLargeError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  CommandTooLargeError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ProposalBufferFullError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ProposalBufferFullError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* TimestampTooFarError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  TimestampTooFarError_reflection_ = NULL;
+const ::google::protobuf::Descriptor* CommandQueueTimeoutError_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  CommandQueueTimeoutError_reflection_ = NULL;
 const ::google::protobuf::Descriptor* Error_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   Error_reflection_ = NULL;
@@ -167,9 +200,10 @@ void protobuf_AssignDesc_errors_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(TransactionAbortedError));
   TransactionPushError_descriptor_ = file->message_type(6);
-  static const int TransactionPushError_offsets_[2] = {
+  static const int TransactionPushError_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TransactionPushError, txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TransactionPushError, pushee_txn_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TransactionPushError, pushee_priority_),
   };
   TransactionPushError_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -275,8 +309,182 @@ void protobuf_AssignDesc_errors_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ConditionFailedError));
-  Error_descriptor_ = file->message_type(13);
-  static const int Error_offsets_[13] = {
+  ConflictTimeoutError_descriptor_ = file->message_type(13);
+  static const int ConflictTimeoutError_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConflictTimeoutError, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConflictTimeoutError, txn_),
+  };
+  ConflictTimeoutError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ConflictTimeoutError_descriptor_,
+      ConflictTimeoutError::default_instance_,
+      ConflictTimeoutError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConflictTimeoutError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ConflictTimeoutError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ConflictTimeoutError));
+  RaftGroupDeletedError_descriptor_ = file->message_type(14);
+  static const int RaftGroupDeletedError_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftGroupDeletedError, raft_id_),
+  };
+  RaftGroupDeletedError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      RaftGroupDeletedError_descriptor_,
+      RaftGroupDeletedError::default_instance_,
+      RaftGroupDeletedError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftGroupDeletedError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RaftGroupDeletedError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(RaftGroupDeletedError));
+  StoreOverloadedError_descriptor_ = file->message_type(15);
+  static const int StoreOverloadedError_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreOverloadedError, method_),
+  };
+  StoreOverloadedError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      StoreOverloadedError_descriptor_,
+      StoreOverloadedError::default_instance_,
+      StoreOverloadedError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreOverloadedError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(StoreOverloadedError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(StoreOverloadedError));
+  DeadlineExceededError_descriptor_ = file->message_type(16);
+  static const int DeadlineExceededError_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeadlineExceededError, deadline_),
+  };
+  DeadlineExceededError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      DeadlineExceededError_descriptor_,
+      DeadlineExceededError::default_instance_,
+      DeadlineExceededError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeadlineExceededError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeadlineExceededError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(DeadlineExceededError));
+  ChecksumMismatchError_descriptor_ = file->message_type(17);
+  static const int ChecksumMismatchError_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChecksumMismatchError, key_),
+  };
+  ChecksumMismatchError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ChecksumMismatchError_descriptor_,
+      ChecksumMismatchError::default_instance_,
+      ChecksumMismatchError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChecksumMismatchError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ChecksumMismatchError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ChecksumMismatchError));
+  ProtectedKeyError_descriptor_ = file->message_type(18);
+  static const int ProtectedKeyError_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedKeyError, key_),
+  };
+  ProtectedKeyError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ProtectedKeyError_descriptor_,
+      ProtectedKeyError::default_instance_,
+      ProtectedKeyError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedKeyError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProtectedKeyError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ProtectedKeyError));
+  TooManyIntentsError_descriptor_ = file->message_type(19);
+  static const int TooManyIntentsError_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TooManyIntentsError, raft_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TooManyIntentsError, intent_count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TooManyIntentsError, max_intents_),
+  };
+  TooManyIntentsError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      TooManyIntentsError_descriptor_,
+      TooManyIntentsError::default_instance_,
+      TooManyIntentsError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TooManyIntentsError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TooManyIntentsError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(TooManyIntentsError));
+  CommandTooLargeError_descriptor_ = file->message_type(20);
+  static const int CommandTooLargeError_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandTooLargeError, size_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandTooLargeError, max_size_),
+  };
+  CommandTooLargeError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      CommandTooLargeError_descriptor_,
+      CommandTooLargeError::default_instance_,
+      CommandTooLargeError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandTooLargeError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandTooLargeError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(CommandTooLargeError));
+  ProposalBufferFullError_descriptor_ = file->message_type(21);
+  static const int ProposalBufferFullError_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProposalBufferFullError, raft_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProposalBufferFullError, pending_bytes_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProposalBufferFullError, max_bytes_),
+  };
+  ProposalBufferFullError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ProposalBufferFullError_descriptor_,
+      ProposalBufferFullError::default_instance_,
+      ProposalBufferFullError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProposalBufferFullError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ProposalBufferFullError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ProposalBufferFullError));
+  TimestampTooFarError_descriptor_ = file->message_type(22);
+  static const int TimestampTooFarError_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimestampTooFarError, timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimestampTooFarError, max_timestamp_),
+  };
+  TimestampTooFarError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      TimestampTooFarError_descriptor_,
+      TimestampTooFarError::default_instance_,
+      TimestampTooFarError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimestampTooFarError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TimestampTooFarError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(TimestampTooFarError));
+  CommandQueueTimeoutError_descriptor_ = file->message_type(23);
+  static const int CommandQueueTimeoutError_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandQueueTimeoutError, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandQueueTimeoutError, end_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandQueueTimeoutError, timeout_),
+  };
+  CommandQueueTimeoutError_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      CommandQueueTimeoutError_descriptor_,
+      CommandQueueTimeoutError::default_instance_,
+      CommandQueueTimeoutError_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandQueueTimeoutError, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(CommandQueueTimeoutError, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(CommandQueueTimeoutError));
+  Error_descriptor_ = file->message_type(24);
+  static const int Error_offsets_[24] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, generic_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, not_leader_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, range_not_found_),
@@ -290,6 +498,17 @@ void protobuf_AssignDesc_errors_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, write_too_old_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, op_requires_txn_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, condition_failed_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, conflict_timeout_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, raft_group_deleted_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, store_overloaded_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, deadline_exceeded_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, checksum_mismatch_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, protected_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, too_many_intents_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, command_too_large_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, proposal_buffer_full_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, timestamp_too_far_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Error, command_queue_timeout_),
   };
   Error_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -340,6 +559,28 @@ void protobuf_RegisterTypes(const ::std::string&) {
     OpRequiresTxnError_descriptor_, &OpRequiresTxnError::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ConditionFailedError_descriptor_, &ConditionFailedError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ConflictTimeoutError_descriptor_, &ConflictTimeoutError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    RaftGroupDeletedError_descriptor_, &RaftGroupDeletedError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    StoreOverloadedError_descriptor_, &StoreOverloadedError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    DeadlineExceededError_descriptor_, &DeadlineExceededError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ChecksumMismatchError_descriptor_, &ChecksumMismatchError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ProtectedKeyError_descriptor_, &ProtectedKeyError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    TooManyIntentsError_descriptor_, &TooManyIntentsError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    CommandTooLargeError_descriptor_, &CommandTooLargeError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ProposalBufferFullError_descriptor_, &ProposalBufferFullError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    TimestampTooFarError_descriptor_, &TimestampTooFarError::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    CommandQueueTimeoutError_descriptor_, &CommandQueueTimeoutError::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     Error_descriptor_, &Error::default_instance());
 }
@@ -373,6 +614,28 @@ void protobuf_ShutdownFile_errors_2eproto() {
   delete OpRequiresTxnError_reflection_;
   delete ConditionFailedError::default_instance_;
   delete ConditionFailedError_reflection_;
+  delete ConflictTimeoutError::default_instance_;
+  delete ConflictTimeoutError_reflection_;
+  delete RaftGroupDeletedError::default_instance_;
+  delete RaftGroupDeletedError_reflection_;
+  delete StoreOverloadedError::default_instance_;
+  delete StoreOverloadedError_reflection_;
+  delete DeadlineExceededError::default_instance_;
+  delete DeadlineExceededError_reflection_;
+  delete ChecksumMismatchError::default_instance_;
+  delete ChecksumMismatchError_reflection_;
+  delete ProtectedKeyError::default_instance_;
+  delete ProtectedKeyError_reflection_;
+  delete TooManyIntentsError::default_instance_;
+  delete TooManyIntentsError_reflection_;
+  delete CommandTooLargeError::default_instance_;
+  delete CommandTooLargeError_reflection_;
+  delete ProposalBufferFullError::default_instance_;
+  delete ProposalBufferFullError_reflection_;
+  delete TimestampTooFarError::default_instance_;
+  delete TimestampTooFarError_reflection_;
+  delete CommandQueueTimeoutError::default_instance_;
+  delete CommandQueueTimeoutError_reflection_;
   delete Error::default_instance_;
   delete Error_reflection_;
 }
@@ -402,39 +665,77 @@ void protobuf_AddDesc_errors_2eproto() {
     "imestampB\004\310\336\037\000\0222\n\022existing_timestamp\030\002 \001"
     "(\0132\020.proto.TimestampB\004\310\336\037\000\"@\n\027Transactio"
     "nAbortedError\022%\n\003txn\030\001 \001(\0132\022.proto.Trans"
-    "actionB\004\310\336\037\000\"e\n\024TransactionPushError\022\037\n\003"
-    "txn\030\001 \001(\0132\022.proto.Transaction\022,\n\npushee_"
-    "txn\030\002 \001(\0132\022.proto.TransactionB\004\310\336\037\000\">\n\025T"
-    "ransactionRetryError\022%\n\003txn\030\001 \001(\0132\022.prot"
-    "o.TransactionB\004\310\336\037\000\"R\n\026TransactionStatus"
-    "Error\022%\n\003txn\030\001 \001(\0132\022.proto.TransactionB\004"
-    "\310\336\037\000\022\021\n\003msg\030\002 \001(\tB\004\310\336\037\000\"k\n\020WriteIntentEr"
-    "ror\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022%\n\003txn\030\002 \001"
-    "(\0132\022.proto.TransactionB\004\310\336\037\000\022\026\n\010resolved"
-    "\030\003 \001(\010B\004\310\336\037\000\"q\n\020WriteTooOldError\022)\n\ttime"
-    "stamp\030\001 \001(\0132\020.proto.TimestampB\004\310\336\037\000\0222\n\022e"
-    "xisting_timestamp\030\002 \001(\0132\020.proto.Timestam"
-    "pB\004\310\336\037\000\"\024\n\022OpRequiresTxnError\":\n\024Conditi"
-    "onFailedError\022\"\n\014actual_value\030\001 \001(\0132\014.pr"
-    "oto.Value\"\323\005\n\005Error\022$\n\007generic\030\001 \001(\0132\023.p"
-    "roto.GenericError\022)\n\nnot_leader\030\002 \001(\0132\025."
-    "proto.NotLeaderError\0222\n\017range_not_found\030"
-    "\003 \001(\0132\031.proto.RangeNotFoundError\0228\n\022rang"
-    "e_key_mismatch\030\004 \001(\0132\034.proto.RangeKeyMis"
-    "matchError\022S\n read_within_uncertainty_in"
-    "terval\030\005 \001(\0132).proto.ReadWithinUncertain"
-    "tyIntervalError\022;\n\023transaction_aborted\030\006"
-    " \001(\0132\036.proto.TransactionAbortedError\0225\n\020"
-    "transaction_push\030\007 \001(\0132\033.proto.Transacti"
-    "onPushError\0227\n\021transaction_retry\030\010 \001(\0132\034"
-    ".proto.TransactionRetryError\0229\n\022transact"
-    "ion_status\030\t \001(\0132\035.proto.TransactionStat"
-    "usError\022-\n\014write_intent\030\n \001(\0132\027.proto.Wr"
-    "iteIntentError\022.\n\rwrite_too_old\030\013 \001(\0132\027."
-    "proto.WriteTooOldError\0222\n\017op_requires_tx"
-    "n\030\014 \001(\0132\031.proto.OpRequiresTxnError\0225\n\020co"
-    "ndition_failed\030\r \001(\0132\033.proto.ConditionFa"
-    "iledError:\004\310\240\037\001", 1895);
+    "actionB\004\310\336\037\000\"\204\001\n\024TransactionPushError\022\037\n"
+    "\003txn\030\001 \001(\0132\022.proto.Transaction\022,\n\npushee"
+    "_txn\030\002 \001(\0132\022.proto.TransactionB\004\310\336\037\000\022\035\n\017"
+    "pushee_priority\030\003 \001(\005B\004\310\336\037\000\">\n\025Transacti"
+    "onRetryError\022%\n\003txn\030\001 \001(\0132\022.proto.Transa"
+    "ctionB\004\310\336\037\000\"R\n\026TransactionStatusError\022%\n"
+    "\003txn\030\001 \001(\0132\022.proto.TransactionB\004\310\336\037\000\022\021\n\003"
+    "msg\030\002 \001(\tB\004\310\336\037\000\"k\n\020WriteIntentError\022\030\n\003k"
+    "ey\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022%\n\003txn\030\002 \001(\0132\022.pro"
+    "to.TransactionB\004\310\336\037\000\022\026\n\010resolved\030\003 \001(\010B\004"
+    "\310\336\037\000\"q\n\020WriteTooOldError\022)\n\ttimestamp\030\001 "
+    "\001(\0132\020.proto.TimestampB\004\310\336\037\000\0222\n\022existing_"
+    "timestamp\030\002 \001(\0132\020.proto.TimestampB\004\310\336\037\000\""
+    "\024\n\022OpRequiresTxnError\":\n\024ConditionFailed"
+    "Error\022\"\n\014actual_value\030\001 \001(\0132\014.proto.Valu"
+    "e\"W\n\024ConflictTimeoutError\022\030\n\003key\030\001 \001(\014B\013"
+    "\310\336\037\000\332\336\037\003Key\022%\n\003txn\030\002 \001(\0132\022.proto.Transac"
+    "tionB\004\310\336\037\000\"8\n\025RaftGroupDeletedError\022\037\n\007r"
+    "aft_id\030\001 \001(\003B\016\310\336\037\000\342\336\037\006RaftID\",\n\024StoreOve"
+    "rloadedError\022\024\n\006method\030\001 \001(\tB\004\310\336\037\000\"/\n\025De"
+    "adlineExceededError\022\026\n\010deadline\030\001 \001(\003B\004\310"
+    "\336\037\000\"1\n\025ChecksumMismatchError\022\030\n\003key\030\001 \001("
+    "\014B\013\310\336\037\000\332\336\037\003Key\"-\n\021ProtectedKeyError\022\030\n\003k"
+    "ey\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\"m\n\023TooManyIntentsE"
+    "rror\022\037\n\007raft_id\030\001 \001(\003B\016\310\336\037\000\342\336\037\006RaftID\022\032\n"
+    "\014intent_count\030\002 \001(\003B\004\310\336\037\000\022\031\n\013max_intents"
+    "\030\003 \001(\003B\004\310\336\037\000\"B\n\024CommandTooLargeError\022\022\n\004"
+    "size\030\001 \001(\003B\004\310\336\037\000\022\026\n\010max_size\030\002 \001(\003B\004\310\336\037\000"
+    "\"p\n\027ProposalBufferFullError\022\037\n\007raft_id\030\001"
+    " \001(\003B\016\310\336\037\000\342\336\037\006RaftID\022\033\n\rpending_bytes\030\002 "
+    "\001(\003B\004\310\336\037\000\022\027\n\tmax_bytes\030\003 \001(\003B\004\310\336\037\000\"p\n\024Ti"
+    "mestampTooFarError\022)\n\ttimestamp\030\001 \001(\0132\020."
+    "proto.TimestampB\004\310\336\037\000\022-\n\rmax_timestamp\030\002"
+    " \001(\0132\020.proto.TimestampB\004\310\336\037\000\"i\n\030CommandQ"
+    "ueueTimeoutError\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003K"
+    "ey\022\034\n\007end_key\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\025\n\007time"
+    "out\030\003 \001(\003B\004\310\336\037\000\"\302\n\n\005Error\022$\n\007generic\030\001 \001"
+    "(\0132\023.proto.GenericError\022)\n\nnot_leader\030\002 "
+    "\001(\0132\025.proto.NotLeaderError\0222\n\017range_not_"
+    "found\030\003 \001(\0132\031.proto.RangeNotFoundError\0228"
+    "\n\022range_key_mismatch\030\004 \001(\0132\034.proto.Range"
+    "KeyMismatchError\022S\n read_within_uncertai"
+    "nty_interval\030\005 \001(\0132).proto.ReadWithinUnc"
+    "ertaintyIntervalError\022;\n\023transaction_abo"
+    "rted\030\006 \001(\0132\036.proto.TransactionAbortedErr"
+    "or\0225\n\020transaction_push\030\007 \001(\0132\033.proto.Tra"
+    "nsactionPushError\0227\n\021transaction_retry\030\010"
+    " \001(\0132\034.proto.TransactionRetryError\0229\n\022tr"
+    "ansaction_status\030\t \001(\0132\035.proto.Transacti"
+    "onStatusError\022-\n\014write_intent\030\n \001(\0132\027.pr"
+    "oto.WriteIntentError\022.\n\rwrite_too_old\030\013 "
+    "\001(\0132\027.proto.WriteTooOldError\0222\n\017op_requi"
+    "res_txn\030\014 \001(\0132\031.proto.OpRequiresTxnError"
+    "\0225\n\020condition_failed\030\r \001(\0132\033.proto.Condi"
+    "tionFailedError\0225\n\020conflict_timeout\030\016 \001("
+    "\0132\033.proto.ConflictTimeoutError\0228\n\022raft_g"
+    "roup_deleted\030\017 \001(\0132\034.proto.RaftGroupDele"
+    "tedError\0225\n\020store_overloaded\030\020 \001(\0132\033.pro"
+    "to.StoreOverloadedError\0227\n\021deadline_exce"
+    "eded\030\021 \001(\0132\034.proto.DeadlineExceededError"
+    "\0227\n\021checksum_mismatch\030\022 \001(\0132\034.proto.Chec"
+    "ksumMismatchError\022/\n\rprotected_key\030\023 \001(\013"
+    "2\030.proto.ProtectedKeyError\0224\n\020too_many_i"
+    "ntents\030\024 \001(\0132\032.proto.TooManyIntentsError"
+    "\0226\n\021command_too_large\030\025 \001(\0132\033.proto.Comm"
+    "andTooLargeError\022<\n\024proposal_buffer_full"
+    "\030\026 \001(\0132\036.proto.ProposalBufferFullError\0226"
+    "\n\021timestamp_too_far\030\027 \001(\0132\033.proto.Timest"
+    "ampTooFarError\022>\n\025command_queue_timeout\030"
+    "\030 \001(\0132\037.proto.CommandQueueTimeoutError:\004"
+    "\310\240\037\001", 3404);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "errors.proto", &protobuf_RegisterTypes);
   GenericError::default_instance_ = new GenericError();
@@ -450,6 +751,17 @@ void protobuf_AddDesc_errors_2eproto() {
   WriteTooOldError::default_instance_ = new WriteTooOldError();
   OpRequiresTxnError::default_instance_ = new OpRequiresTxnError();
   ConditionFailedError::default_instance_ = new ConditionFailedError();
+  ConflictTimeoutError::default_instance_ = new ConflictTimeoutError();
+  RaftGroupDeletedError::default_instance_ = new RaftGroupDeletedError();
+  StoreOverloadedError::default_instance_ = new StoreOverloadedError();
+  DeadlineExceededError::default_instance_ = new DeadlineExceededError();
+  ChecksumMismatchError::default_instance_ = new ChecksumMismatchError();
+  ProtectedKeyError::default_instance_ = new ProtectedKeyError();
+  TooManyIntentsError::default_instance_ = new TooManyIntentsError();
+  CommandTooLargeError::default_instance_ = new CommandTooLargeError();
+  ProposalBufferFullError::default_instance_ = new ProposalBufferFullError();
+  TimestampTooFarError::default_instance_ = new TimestampTooFarError();
+  CommandQueueTimeoutError::default_instance_ = new CommandQueueTimeoutError();
   Error::default_instance_ = new Error();
   GenericError::default_instance_->InitAsDefaultInstance();
   NotLeaderError::default_instance_->InitAsDefaultInstance();
@@ -464,6 +776,17 @@ void protobuf_AddDesc_errors_2eproto() {
   WriteTooOldError::default_instance_->InitAsDefaultInstance();
   OpRequiresTxnError::default_instance_->InitAsDefaultInstance();
   ConditionFailedError::default_instance_->InitAsDefaultInstance();
+  ConflictTimeoutError::default_instance_->InitAsDefaultInstance();
+  RaftGroupDeletedError::default_instance_->InitAsDefaultInstance();
+  StoreOverloadedError::default_instance_->InitAsDefaultInstance();
+  DeadlineExceededError::default_instance_->InitAsDefaultInstance();
+  ChecksumMismatchError::default_instance_->InitAsDefaultInstance();
+  ProtectedKeyError::default_instance_->InitAsDefaultInstance();
+  TooManyIntentsError::default_instance_->InitAsDefaultInstance();
+  CommandTooLargeError::default_instance_->InitAsDefaultInstance();
+  ProposalBufferFullError::default_instance_->InitAsDefaultInstance();
+  TimestampTooFarError::default_instance_->InitAsDefaultInstance();
+  CommandQueueTimeoutError::default_instance_->InitAsDefaultInstance();
   Error::default_instance_->InitAsDefaultInstance();
   ::google::protobuf::internal::OnShutdown(&protobuf_ShutdownFile_errors_2eproto);
 }
@@ -2035,6 +2358,7 @@ void TransactionAbortedError::Swap(TransactionAbortedError* other) {
 #ifndef _MSC_VER
 const int TransactionPushError::kTxnFieldNumber;
 const int TransactionPushError::kPusheeTxnFieldNumber;
+const int TransactionPushError::kPusheePriorityFieldNumber;
 #endif  // !_MSC_VER
 
 TransactionPushError::TransactionPushError()
@@ -2059,6 +2383,7 @@ void TransactionPushError::SharedCtor() {
   _cached_size_ = 0;
   txn_ = NULL;
   pushee_txn_ = NULL;
+  pushee_priority_ = 0;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -2096,13 +2421,14 @@ TransactionPushError* TransactionPushError::New() const {
 }
 
 void TransactionPushError::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 7) {
     if (has_txn()) {
       if (txn_ != NULL) txn_->::proto::Transaction::Clear();
     }
     if (has_pushee_txn()) {
       if (pushee_txn_ != NULL) pushee_txn_->::proto::Transaction::Clear();
     }
+    pushee_priority_ = 0;
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -2139,6 +2465,21 @@ bool TransactionPushError::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(24)) goto parse_pushee_priority;
+        break;
+      }
+
+      // optional int32 pushee_priority = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_pushee_priority:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &pushee_priority_)));
+          set_has_pushee_priority();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -2180,6 +2521,11 @@ void TransactionPushError::SerializeWithCachedSizes(
       2, this->pushee_txn(), output);
   }
 
+  // optional int32 pushee_priority = 3;
+  if (has_pushee_priority()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(3, this->pushee_priority(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -2204,6 +2550,11 @@ void TransactionPushError::SerializeWithCachedSizes(
         2, this->pushee_txn(), target);
   }
 
+  // optional int32 pushee_priority = 3;
+  if (has_pushee_priority()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(3, this->pushee_priority(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -2230,6 +2581,13 @@ int TransactionPushError::ByteSize() const {
           this->pushee_txn());
     }
 
+    // optional int32 pushee_priority = 3;
+    if (has_pushee_priority()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->pushee_priority());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -2263,6 +2621,9 @@ void TransactionPushError::MergeFrom(const TransactionPushError& from) {
     if (from.has_pushee_txn()) {
       mutable_pushee_txn()->::proto::Transaction::MergeFrom(from.pushee_txn());
     }
+    if (from.has_pushee_priority()) {
+      set_pushee_priority(from.pushee_priority());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -2288,6 +2649,7 @@ void TransactionPushError::Swap(TransactionPushError* other) {
   if (other != this) {
     std::swap(txn_, other->txn_);
     std::swap(pushee_txn_, other->pushee_txn_);
+    std::swap(pushee_priority_, other->pushee_priority_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -3810,209 +4172,3204 @@ void ConditionFailedError::Swap(ConditionFailedError* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int Error::kGenericFieldNumber;
-const int Error::kNotLeaderFieldNumber;
-const int Error::kRangeNotFoundFieldNumber;
-const int Error::kRangeKeyMismatchFieldNumber;
-const int Error::kReadWithinUncertaintyIntervalFieldNumber;
-const int Error::kTransactionAbortedFieldNumber;
-const int Error::kTransactionPushFieldNumber;
-const int Error::kTransactionRetryFieldNumber;
-const int Error::kTransactionStatusFieldNumber;
-const int Error::kWriteIntentFieldNumber;
-const int Error::kWriteTooOldFieldNumber;
-const int Error::kOpRequiresTxnFieldNumber;
-const int Error::kConditionFailedFieldNumber;
+const int ConflictTimeoutError::kKeyFieldNumber;
+const int ConflictTimeoutError::kTxnFieldNumber;
 #endif  // !_MSC_VER
 
-Error::Error()
+ConflictTimeoutError::ConflictTimeoutError()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.Error)
+  // @@protoc_insertion_point(constructor:proto.ConflictTimeoutError)
 }
 
-void Error::InitAsDefaultInstance() {
-  generic_ = const_cast< ::proto::GenericError*>(&::proto::GenericError::default_instance());
-  not_leader_ = const_cast< ::proto::NotLeaderError*>(&::proto::NotLeaderError::default_instance());
-  range_not_found_ = const_cast< ::proto::RangeNotFoundError*>(&::proto::RangeNotFoundError::default_instance());
-  range_key_mismatch_ = const_cast< ::proto::RangeKeyMismatchError*>(&::proto::RangeKeyMismatchError::default_instance());
-  read_within_uncertainty_interval_ = const_cast< ::proto::ReadWithinUncertaintyIntervalError*>(&::proto::ReadWithinUncertaintyIntervalError::default_instance());
-  transaction_aborted_ = const_cast< ::proto::TransactionAbortedError*>(&::proto::TransactionAbortedError::default_instance());
-  transaction_push_ = const_cast< ::proto::TransactionPushError*>(&::proto::TransactionPushError::default_instance());
-  transaction_retry_ = const_cast< ::proto::TransactionRetryError*>(&::proto::TransactionRetryError::default_instance());
-  transaction_status_ = const_cast< ::proto::TransactionStatusError*>(&::proto::TransactionStatusError::default_instance());
-  write_intent_ = const_cast< ::proto::WriteIntentError*>(&::proto::WriteIntentError::default_instance());
-  write_too_old_ = const_cast< ::proto::WriteTooOldError*>(&::proto::WriteTooOldError::default_instance());
-  op_requires_txn_ = const_cast< ::proto::OpRequiresTxnError*>(&::proto::OpRequiresTxnError::default_instance());
-  condition_failed_ = const_cast< ::proto::ConditionFailedError*>(&::proto::ConditionFailedError::default_instance());
+void ConflictTimeoutError::InitAsDefaultInstance() {
+  txn_ = const_cast< ::proto::Transaction*>(&::proto::Transaction::default_instance());
 }
 
-Error::Error(const Error& from)
+ConflictTimeoutError::ConflictTimeoutError(const ConflictTimeoutError& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.Error)
+  // @@protoc_insertion_point(copy_constructor:proto.ConflictTimeoutError)
 }
 
-void Error::SharedCtor() {
+void ConflictTimeoutError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
-  generic_ = NULL;
-  not_leader_ = NULL;
-  range_not_found_ = NULL;
-  range_key_mismatch_ = NULL;
-  read_within_uncertainty_interval_ = NULL;
-  transaction_aborted_ = NULL;
-  transaction_push_ = NULL;
-  transaction_retry_ = NULL;
-  transaction_status_ = NULL;
-  write_intent_ = NULL;
-  write_too_old_ = NULL;
-  op_requires_txn_ = NULL;
-  condition_failed_ = NULL;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  txn_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-Error::~Error() {
-  // @@protoc_insertion_point(destructor:proto.Error)
+ConflictTimeoutError::~ConflictTimeoutError() {
+  // @@protoc_insertion_point(destructor:proto.ConflictTimeoutError)
   SharedDtor();
 }
 
-void Error::SharedDtor() {
+void ConflictTimeoutError::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
   if (this != default_instance_) {
-    delete generic_;
-    delete not_leader_;
-    delete range_not_found_;
-    delete range_key_mismatch_;
-    delete read_within_uncertainty_interval_;
-    delete transaction_aborted_;
-    delete transaction_push_;
-    delete transaction_retry_;
-    delete transaction_status_;
-    delete write_intent_;
-    delete write_too_old_;
-    delete op_requires_txn_;
-    delete condition_failed_;
+    delete txn_;
   }
 }
 
-void Error::SetCachedSize(int size) const {
+void ConflictTimeoutError::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* Error::descriptor() {
+const ::google::protobuf::Descriptor* ConflictTimeoutError::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return Error_descriptor_;
+  return ConflictTimeoutError_descriptor_;
 }
 
-const Error& Error::default_instance() {
+const ConflictTimeoutError& ConflictTimeoutError::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
   return *default_instance_;
 }
 
-Error* Error::default_instance_ = NULL;
+ConflictTimeoutError* ConflictTimeoutError::default_instance_ = NULL;
 
-Error* Error::New() const {
-  return new Error;
+ConflictTimeoutError* ConflictTimeoutError::New() const {
+  return new ConflictTimeoutError;
 }
 
-void Error::Clear() {
-  if (_has_bits_[0 / 32] & 255) {
-    if (has_generic()) {
-      if (generic_ != NULL) generic_->::proto::GenericError::Clear();
-    }
-    if (has_not_leader()) {
-      if (not_leader_ != NULL) not_leader_->::proto::NotLeaderError::Clear();
-    }
-    if (has_range_not_found()) {
-      if (range_not_found_ != NULL) range_not_found_->::proto::RangeNotFoundError::Clear();
-    }
-    if (has_range_key_mismatch()) {
-      if (range_key_mismatch_ != NULL) range_key_mismatch_->::proto::RangeKeyMismatchError::Clear();
-    }
-    if (has_read_within_uncertainty_interval()) {
-      if (read_within_uncertainty_interval_ != NULL) read_within_uncertainty_interval_->::proto::ReadWithinUncertaintyIntervalError::Clear();
-    }
-    if (has_transaction_aborted()) {
-      if (transaction_aborted_ != NULL) transaction_aborted_->::proto::TransactionAbortedError::Clear();
-    }
-    if (has_transaction_push()) {
-      if (transaction_push_ != NULL) transaction_push_->::proto::TransactionPushError::Clear();
-    }
-    if (has_transaction_retry()) {
-      if (transaction_retry_ != NULL) transaction_retry_->::proto::TransactionRetryError::Clear();
-    }
-  }
-  if (_has_bits_[8 / 32] & 7936) {
-    if (has_transaction_status()) {
-      if (transaction_status_ != NULL) transaction_status_->::proto::TransactionStatusError::Clear();
-    }
-    if (has_write_intent()) {
-      if (write_intent_ != NULL) write_intent_->::proto::WriteIntentError::Clear();
-    }
-    if (has_write_too_old()) {
-      if (write_too_old_ != NULL) write_too_old_->::proto::WriteTooOldError::Clear();
-    }
-    if (has_op_requires_txn()) {
-      if (op_requires_txn_ != NULL) op_requires_txn_->::proto::OpRequiresTxnError::Clear();
+void ConflictTimeoutError::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
     }
-    if (has_condition_failed()) {
-      if (condition_failed_ != NULL) condition_failed_->::proto::ConditionFailedError::Clear();
+    if (has_txn()) {
+      if (txn_ != NULL) txn_->::proto::Transaction::Clear();
     }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool Error::MergePartialFromCodedStream(
+bool ConflictTimeoutError::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.Error)
+  // @@protoc_insertion_point(parse_start:proto.ConflictTimeoutError)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.GenericError generic = 1;
+      // optional bytes key = 1;
       case 1: {
         if (tag == 10) {
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_generic()));
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_not_leader;
+        if (input->ExpectTag(18)) goto parse_txn;
         break;
       }
 
-      // optional .proto.NotLeaderError not_leader = 2;
+      // optional .proto.Transaction txn = 2;
       case 2: {
         if (tag == 18) {
-         parse_not_leader:
+         parse_txn:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_not_leader()));
+               input, mutable_txn()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(26)) goto parse_range_not_found;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .proto.RangeNotFoundError range_not_found = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_range_not_found:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_range_not_found()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(34)) goto parse_range_key_mismatch;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
-
-      // optional .proto.RangeKeyMismatchError range_key_mismatch = 4;
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ConflictTimeoutError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ConflictTimeoutError)
+  return false;
+#undef DO_
+}
+
+void ConflictTimeoutError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ConflictTimeoutError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional .proto.Transaction txn = 2;
+  if (has_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->txn(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ConflictTimeoutError)
+}
+
+::google::protobuf::uint8* ConflictTimeoutError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ConflictTimeoutError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional .proto.Transaction txn = 2;
+  if (has_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->txn(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ConflictTimeoutError)
+  return target;
+}
+
+int ConflictTimeoutError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional .proto.Transaction txn = 2;
+    if (has_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->txn());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ConflictTimeoutError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ConflictTimeoutError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ConflictTimeoutError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ConflictTimeoutError::MergeFrom(const ConflictTimeoutError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_txn()) {
+      mutable_txn()->::proto::Transaction::MergeFrom(from.txn());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ConflictTimeoutError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ConflictTimeoutError::CopyFrom(const ConflictTimeoutError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ConflictTimeoutError::IsInitialized() const {
+
+  return true;
+}
+
+void ConflictTimeoutError::Swap(ConflictTimeoutError* other) {
+  if (other != this) {
+    std::swap(key_, other->key_);
+    std::swap(txn_, other->txn_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ConflictTimeoutError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ConflictTimeoutError_descriptor_;
+  metadata.reflection = ConflictTimeoutError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RaftGroupDeletedError::kRaftIdFieldNumber;
+#endif  // !_MSC_VER
+
+RaftGroupDeletedError::RaftGroupDeletedError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.RaftGroupDeletedError)
+}
+
+void RaftGroupDeletedError::InitAsDefaultInstance() {
+}
+
+RaftGroupDeletedError::RaftGroupDeletedError(const RaftGroupDeletedError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.RaftGroupDeletedError)
+}
+
+void RaftGroupDeletedError::SharedCtor() {
+  _cached_size_ = 0;
+  raft_id_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RaftGroupDeletedError::~RaftGroupDeletedError() {
+  // @@protoc_insertion_point(destructor:proto.RaftGroupDeletedError)
+  SharedDtor();
+}
+
+void RaftGroupDeletedError::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void RaftGroupDeletedError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RaftGroupDeletedError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RaftGroupDeletedError_descriptor_;
+}
+
+const RaftGroupDeletedError& RaftGroupDeletedError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+RaftGroupDeletedError* RaftGroupDeletedError::default_instance_ = NULL;
+
+RaftGroupDeletedError* RaftGroupDeletedError::New() const {
+  return new RaftGroupDeletedError;
+}
+
+void RaftGroupDeletedError::Clear() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool RaftGroupDeletedError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.RaftGroupDeletedError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 raft_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &raft_id_)));
+          set_has_raft_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.RaftGroupDeletedError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.RaftGroupDeletedError)
+  return false;
+#undef DO_
+}
+
+void RaftGroupDeletedError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.RaftGroupDeletedError)
+  // optional int64 raft_id = 1;
+  if (has_raft_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->raft_id(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.RaftGroupDeletedError)
+}
+
+::google::protobuf::uint8* RaftGroupDeletedError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.RaftGroupDeletedError)
+  // optional int64 raft_id = 1;
+  if (has_raft_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->raft_id(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.RaftGroupDeletedError)
+  return target;
+}
+
+int RaftGroupDeletedError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 raft_id = 1;
+    if (has_raft_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->raft_id());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RaftGroupDeletedError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const RaftGroupDeletedError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const RaftGroupDeletedError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RaftGroupDeletedError::MergeFrom(const RaftGroupDeletedError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_raft_id()) {
+      set_raft_id(from.raft_id());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void RaftGroupDeletedError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RaftGroupDeletedError::CopyFrom(const RaftGroupDeletedError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RaftGroupDeletedError::IsInitialized() const {
+
+  return true;
+}
+
+void RaftGroupDeletedError::Swap(RaftGroupDeletedError* other) {
+  if (other != this) {
+    std::swap(raft_id_, other->raft_id_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata RaftGroupDeletedError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RaftGroupDeletedError_descriptor_;
+  metadata.reflection = RaftGroupDeletedError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int StoreOverloadedError::kMethodFieldNumber;
+#endif  // !_MSC_VER
+
+StoreOverloadedError::StoreOverloadedError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.StoreOverloadedError)
+}
+
+void StoreOverloadedError::InitAsDefaultInstance() {
+}
+
+StoreOverloadedError::StoreOverloadedError(const StoreOverloadedError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.StoreOverloadedError)
+}
+
+void StoreOverloadedError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+StoreOverloadedError::~StoreOverloadedError() {
+  // @@protoc_insertion_point(destructor:proto.StoreOverloadedError)
+  SharedDtor();
+}
+
+void StoreOverloadedError::SharedDtor() {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete method_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void StoreOverloadedError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* StoreOverloadedError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return StoreOverloadedError_descriptor_;
+}
+
+const StoreOverloadedError& StoreOverloadedError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+StoreOverloadedError* StoreOverloadedError::default_instance_ = NULL;
+
+StoreOverloadedError* StoreOverloadedError::New() const {
+  return new StoreOverloadedError;
+}
+
+void StoreOverloadedError::Clear() {
+  if (has_method()) {
+    if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+      method_->clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool StoreOverloadedError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.StoreOverloadedError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional string method = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_method()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->method().data(), this->method().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "method");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.StoreOverloadedError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.StoreOverloadedError)
+  return false;
+#undef DO_
+}
+
+void StoreOverloadedError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.StoreOverloadedError)
+  // optional string method = 1;
+  if (has_method()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->method().data(), this->method().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "method");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->method(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.StoreOverloadedError)
+}
+
+::google::protobuf::uint8* StoreOverloadedError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.StoreOverloadedError)
+  // optional string method = 1;
+  if (has_method()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->method().data(), this->method().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "method");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->method(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.StoreOverloadedError)
+  return target;
+}
+
+int StoreOverloadedError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional string method = 1;
+    if (has_method()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->method());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void StoreOverloadedError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const StoreOverloadedError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const StoreOverloadedError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void StoreOverloadedError::MergeFrom(const StoreOverloadedError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_method()) {
+      set_method(from.method());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void StoreOverloadedError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void StoreOverloadedError::CopyFrom(const StoreOverloadedError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool StoreOverloadedError::IsInitialized() const {
+
+  return true;
+}
+
+void StoreOverloadedError::Swap(StoreOverloadedError* other) {
+  if (other != this) {
+    std::swap(method_, other->method_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata StoreOverloadedError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = StoreOverloadedError_descriptor_;
+  metadata.reflection = StoreOverloadedError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int DeadlineExceededError::kDeadlineFieldNumber;
+#endif  // !_MSC_VER
+
+DeadlineExceededError::DeadlineExceededError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.DeadlineExceededError)
+}
+
+void DeadlineExceededError::InitAsDefaultInstance() {
+}
+
+DeadlineExceededError::DeadlineExceededError(const DeadlineExceededError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.DeadlineExceededError)
+}
+
+void DeadlineExceededError::SharedCtor() {
+  _cached_size_ = 0;
+  deadline_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+DeadlineExceededError::~DeadlineExceededError() {
+  // @@protoc_insertion_point(destructor:proto.DeadlineExceededError)
+  SharedDtor();
+}
+
+void DeadlineExceededError::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void DeadlineExceededError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* DeadlineExceededError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return DeadlineExceededError_descriptor_;
+}
+
+const DeadlineExceededError& DeadlineExceededError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+DeadlineExceededError* DeadlineExceededError::default_instance_ = NULL;
+
+DeadlineExceededError* DeadlineExceededError::New() const {
+  return new DeadlineExceededError;
+}
+
+void DeadlineExceededError::Clear() {
+  deadline_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool DeadlineExceededError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.DeadlineExceededError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 deadline = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &deadline_)));
+          set_has_deadline();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.DeadlineExceededError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.DeadlineExceededError)
+  return false;
+#undef DO_
+}
+
+void DeadlineExceededError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.DeadlineExceededError)
+  // optional int64 deadline = 1;
+  if (has_deadline()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->deadline(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.DeadlineExceededError)
+}
+
+::google::protobuf::uint8* DeadlineExceededError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.DeadlineExceededError)
+  // optional int64 deadline = 1;
+  if (has_deadline()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->deadline(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.DeadlineExceededError)
+  return target;
+}
+
+int DeadlineExceededError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 deadline = 1;
+    if (has_deadline()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->deadline());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void DeadlineExceededError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const DeadlineExceededError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const DeadlineExceededError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void DeadlineExceededError::MergeFrom(const DeadlineExceededError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_deadline()) {
+      set_deadline(from.deadline());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void DeadlineExceededError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void DeadlineExceededError::CopyFrom(const DeadlineExceededError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool DeadlineExceededError::IsInitialized() const {
+
+  return true;
+}
+
+void DeadlineExceededError::Swap(DeadlineExceededError* other) {
+  if (other != this) {
+    std::swap(deadline_, other->deadline_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata DeadlineExceededError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = DeadlineExceededError_descriptor_;
+  metadata.reflection = DeadlineExceededError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ChecksumMismatchError::kKeyFieldNumber;
+#endif  // !_MSC_VER
+
+ChecksumMismatchError::ChecksumMismatchError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ChecksumMismatchError)
+}
+
+void ChecksumMismatchError::InitAsDefaultInstance() {
+}
+
+ChecksumMismatchError::ChecksumMismatchError(const ChecksumMismatchError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ChecksumMismatchError)
+}
+
+void ChecksumMismatchError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ChecksumMismatchError::~ChecksumMismatchError() {
+  // @@protoc_insertion_point(destructor:proto.ChecksumMismatchError)
+  SharedDtor();
+}
+
+void ChecksumMismatchError::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void ChecksumMismatchError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ChecksumMismatchError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ChecksumMismatchError_descriptor_;
+}
+
+const ChecksumMismatchError& ChecksumMismatchError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+ChecksumMismatchError* ChecksumMismatchError::default_instance_ = NULL;
+
+ChecksumMismatchError* ChecksumMismatchError::New() const {
+  return new ChecksumMismatchError;
+}
+
+void ChecksumMismatchError::Clear() {
+  if (has_key()) {
+    if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+      key_->clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ChecksumMismatchError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ChecksumMismatchError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ChecksumMismatchError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ChecksumMismatchError)
+  return false;
+#undef DO_
+}
+
+void ChecksumMismatchError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ChecksumMismatchError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ChecksumMismatchError)
+}
+
+::google::protobuf::uint8* ChecksumMismatchError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ChecksumMismatchError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ChecksumMismatchError)
+  return target;
+}
+
+int ChecksumMismatchError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ChecksumMismatchError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ChecksumMismatchError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ChecksumMismatchError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ChecksumMismatchError::MergeFrom(const ChecksumMismatchError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ChecksumMismatchError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ChecksumMismatchError::CopyFrom(const ChecksumMismatchError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ChecksumMismatchError::IsInitialized() const {
+
+  return true;
+}
+
+void ChecksumMismatchError::Swap(ChecksumMismatchError* other) {
+  if (other != this) {
+    std::swap(key_, other->key_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ChecksumMismatchError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ChecksumMismatchError_descriptor_;
+  metadata.reflection = ChecksumMismatchError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ProtectedKeyError::kKeyFieldNumber;
+#endif  // !_MSC_VER
+
+ProtectedKeyError::ProtectedKeyError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ProtectedKeyError)
+}
+
+void ProtectedKeyError::InitAsDefaultInstance() {
+}
+
+ProtectedKeyError::ProtectedKeyError(const ProtectedKeyError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ProtectedKeyError)
+}
+
+void ProtectedKeyError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ProtectedKeyError::~ProtectedKeyError() {
+  // @@protoc_insertion_point(destructor:proto.ProtectedKeyError)
+  SharedDtor();
+}
+
+void ProtectedKeyError::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void ProtectedKeyError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ProtectedKeyError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ProtectedKeyError_descriptor_;
+}
+
+const ProtectedKeyError& ProtectedKeyError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+ProtectedKeyError* ProtectedKeyError::default_instance_ = NULL;
+
+ProtectedKeyError* ProtectedKeyError::New() const {
+  return new ProtectedKeyError;
+}
+
+void ProtectedKeyError::Clear() {
+  if (has_key()) {
+    if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+      key_->clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ProtectedKeyError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ProtectedKeyError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ProtectedKeyError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ProtectedKeyError)
+  return false;
+#undef DO_
+}
+
+void ProtectedKeyError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ProtectedKeyError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ProtectedKeyError)
+}
+
+::google::protobuf::uint8* ProtectedKeyError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ProtectedKeyError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ProtectedKeyError)
+  return target;
+}
+
+int ProtectedKeyError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ProtectedKeyError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ProtectedKeyError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ProtectedKeyError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ProtectedKeyError::MergeFrom(const ProtectedKeyError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ProtectedKeyError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ProtectedKeyError::CopyFrom(const ProtectedKeyError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ProtectedKeyError::IsInitialized() const {
+
+  return true;
+}
+
+void ProtectedKeyError::Swap(ProtectedKeyError* other) {
+  if (other != this) {
+    std::swap(key_, other->key_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ProtectedKeyError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ProtectedKeyError_descriptor_;
+  metadata.reflection = ProtectedKeyError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int TooManyIntentsError::kRaftIdFieldNumber;
+const int TooManyIntentsError::kIntentCountFieldNumber;
+const int TooManyIntentsError::kMaxIntentsFieldNumber;
+#endif  // !_MSC_VER
+
+TooManyIntentsError::TooManyIntentsError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.TooManyIntentsError)
+}
+
+void TooManyIntentsError::InitAsDefaultInstance() {
+}
+
+TooManyIntentsError::TooManyIntentsError(const TooManyIntentsError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.TooManyIntentsError)
+}
+
+void TooManyIntentsError::SharedCtor() {
+  _cached_size_ = 0;
+  raft_id_ = GOOGLE_LONGLONG(0);
+  intent_count_ = GOOGLE_LONGLONG(0);
+  max_intents_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+TooManyIntentsError::~TooManyIntentsError() {
+  // @@protoc_insertion_point(destructor:proto.TooManyIntentsError)
+  SharedDtor();
+}
+
+void TooManyIntentsError::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void TooManyIntentsError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* TooManyIntentsError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return TooManyIntentsError_descriptor_;
+}
+
+const TooManyIntentsError& TooManyIntentsError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+TooManyIntentsError* TooManyIntentsError::default_instance_ = NULL;
+
+TooManyIntentsError* TooManyIntentsError::New() const {
+  return new TooManyIntentsError;
+}
+
+void TooManyIntentsError::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<TooManyIntentsError*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  ZR_(raft_id_, max_intents_);
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool TooManyIntentsError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.TooManyIntentsError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 raft_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &raft_id_)));
+          set_has_raft_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_intent_count;
+        break;
+      }
+
+      // optional int64 intent_count = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_intent_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &intent_count_)));
+          set_has_intent_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_max_intents;
+        break;
+      }
+
+      // optional int64 max_intents = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_max_intents:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_intents_)));
+          set_has_max_intents();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.TooManyIntentsError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.TooManyIntentsError)
+  return false;
+#undef DO_
+}
+
+void TooManyIntentsError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.TooManyIntentsError)
+  // optional int64 raft_id = 1;
+  if (has_raft_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->raft_id(), output);
+  }
+
+  // optional int64 intent_count = 2;
+  if (has_intent_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->intent_count(), output);
+  }
+
+  // optional int64 max_intents = 3;
+  if (has_max_intents()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->max_intents(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.TooManyIntentsError)
+}
+
+::google::protobuf::uint8* TooManyIntentsError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.TooManyIntentsError)
+  // optional int64 raft_id = 1;
+  if (has_raft_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->raft_id(), target);
+  }
+
+  // optional int64 intent_count = 2;
+  if (has_intent_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->intent_count(), target);
+  }
+
+  // optional int64 max_intents = 3;
+  if (has_max_intents()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->max_intents(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.TooManyIntentsError)
+  return target;
+}
+
+int TooManyIntentsError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 raft_id = 1;
+    if (has_raft_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->raft_id());
+    }
+
+    // optional int64 intent_count = 2;
+    if (has_intent_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->intent_count());
+    }
+
+    // optional int64 max_intents = 3;
+    if (has_max_intents()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_intents());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void TooManyIntentsError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const TooManyIntentsError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const TooManyIntentsError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void TooManyIntentsError::MergeFrom(const TooManyIntentsError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_raft_id()) {
+      set_raft_id(from.raft_id());
+    }
+    if (from.has_intent_count()) {
+      set_intent_count(from.intent_count());
+    }
+    if (from.has_max_intents()) {
+      set_max_intents(from.max_intents());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void TooManyIntentsError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void TooManyIntentsError::CopyFrom(const TooManyIntentsError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool TooManyIntentsError::IsInitialized() const {
+
+  return true;
+}
+
+void TooManyIntentsError::Swap(TooManyIntentsError* other) {
+  if (other != this) {
+    std::swap(raft_id_, other->raft_id_);
+    std::swap(intent_count_, other->intent_count_);
+    std::swap(max_intents_, other->max_intents_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata TooManyIntentsError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = TooManyIntentsError_descriptor_;
+  metadata.reflection = TooManyIntentsError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int CommandTooLargeError::kSizeFieldNumber;
+const int CommandTooLargeError::kMaxSizeFieldNumber;
+#endif  // !_MSC_VER
+
+CommandTooLargeError::CommandTooLargeError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.CommandTooLargeError)
+}
+
+void CommandTooLargeError::InitAsDefaultInstance() {
+}
+
+CommandTooLargeError::CommandTooLargeError(const CommandTooLargeError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.CommandTooLargeError)
+}
+
+void CommandTooLargeError::SharedCtor() {
+  _cached_size_ = 0;
+  size_ = GOOGLE_LONGLONG(0);
+  max_size_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+CommandTooLargeError::~CommandTooLargeError() {
+  // @@protoc_insertion_point(destructor:proto.CommandTooLargeError)
+  SharedDtor();
+}
+
+void CommandTooLargeError::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void CommandTooLargeError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* CommandTooLargeError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return CommandTooLargeError_descriptor_;
+}
+
+const CommandTooLargeError& CommandTooLargeError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+CommandTooLargeError* CommandTooLargeError::default_instance_ = NULL;
+
+CommandTooLargeError* CommandTooLargeError::New() const {
+  return new CommandTooLargeError;
+}
+
+void CommandTooLargeError::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<CommandTooLargeError*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  ZR_(size_, max_size_);
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool CommandTooLargeError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.CommandTooLargeError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 size = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &size_)));
+          set_has_size();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_max_size;
+        break;
+      }
+
+      // optional int64 max_size = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_size:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_size_)));
+          set_has_max_size();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.CommandTooLargeError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.CommandTooLargeError)
+  return false;
+#undef DO_
+}
+
+void CommandTooLargeError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.CommandTooLargeError)
+  // optional int64 size = 1;
+  if (has_size()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->size(), output);
+  }
+
+  // optional int64 max_size = 2;
+  if (has_max_size()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_size(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.CommandTooLargeError)
+}
+
+::google::protobuf::uint8* CommandTooLargeError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.CommandTooLargeError)
+  // optional int64 size = 1;
+  if (has_size()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->size(), target);
+  }
+
+  // optional int64 max_size = 2;
+  if (has_max_size()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_size(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.CommandTooLargeError)
+  return target;
+}
+
+int CommandTooLargeError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 size = 1;
+    if (has_size()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->size());
+    }
+
+    // optional int64 max_size = 2;
+    if (has_max_size()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_size());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void CommandTooLargeError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const CommandTooLargeError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const CommandTooLargeError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void CommandTooLargeError::MergeFrom(const CommandTooLargeError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_size()) {
+      set_size(from.size());
+    }
+    if (from.has_max_size()) {
+      set_max_size(from.max_size());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void CommandTooLargeError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void CommandTooLargeError::CopyFrom(const CommandTooLargeError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool CommandTooLargeError::IsInitialized() const {
+
+  return true;
+}
+
+void CommandTooLargeError::Swap(CommandTooLargeError* other) {
+  if (other != this) {
+    std::swap(size_, other->size_);
+    std::swap(max_size_, other->max_size_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata CommandTooLargeError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = CommandTooLargeError_descriptor_;
+  metadata.reflection = CommandTooLargeError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ProposalBufferFullError::kRaftIdFieldNumber;
+const int ProposalBufferFullError::kPendingBytesFieldNumber;
+const int ProposalBufferFullError::kMaxBytesFieldNumber;
+#endif  // !_MSC_VER
+
+ProposalBufferFullError::ProposalBufferFullError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ProposalBufferFullError)
+}
+
+void ProposalBufferFullError::InitAsDefaultInstance() {
+}
+
+ProposalBufferFullError::ProposalBufferFullError(const ProposalBufferFullError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ProposalBufferFullError)
+}
+
+void ProposalBufferFullError::SharedCtor() {
+  _cached_size_ = 0;
+  raft_id_ = GOOGLE_LONGLONG(0);
+  pending_bytes_ = GOOGLE_LONGLONG(0);
+  max_bytes_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ProposalBufferFullError::~ProposalBufferFullError() {
+  // @@protoc_insertion_point(destructor:proto.ProposalBufferFullError)
+  SharedDtor();
+}
+
+void ProposalBufferFullError::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void ProposalBufferFullError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ProposalBufferFullError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ProposalBufferFullError_descriptor_;
+}
+
+const ProposalBufferFullError& ProposalBufferFullError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+ProposalBufferFullError* ProposalBufferFullError::default_instance_ = NULL;
+
+ProposalBufferFullError* ProposalBufferFullError::New() const {
+  return new ProposalBufferFullError;
+}
+
+void ProposalBufferFullError::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<ProposalBufferFullError*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  ZR_(raft_id_, max_bytes_);
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ProposalBufferFullError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ProposalBufferFullError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional int64 raft_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &raft_id_)));
+          set_has_raft_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_pending_bytes;
+        break;
+      }
+
+      // optional int64 pending_bytes = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_pending_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &pending_bytes_)));
+          set_has_pending_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_max_bytes;
+        break;
+      }
+
+      // optional int64 max_bytes = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_max_bytes:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_bytes_)));
+          set_has_max_bytes();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ProposalBufferFullError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ProposalBufferFullError)
+  return false;
+#undef DO_
+}
+
+void ProposalBufferFullError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ProposalBufferFullError)
+  // optional int64 raft_id = 1;
+  if (has_raft_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(1, this->raft_id(), output);
+  }
+
+  // optional int64 pending_bytes = 2;
+  if (has_pending_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->pending_bytes(), output);
+  }
+
+  // optional int64 max_bytes = 3;
+  if (has_max_bytes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->max_bytes(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ProposalBufferFullError)
+}
+
+::google::protobuf::uint8* ProposalBufferFullError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ProposalBufferFullError)
+  // optional int64 raft_id = 1;
+  if (has_raft_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(1, this->raft_id(), target);
+  }
+
+  // optional int64 pending_bytes = 2;
+  if (has_pending_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->pending_bytes(), target);
+  }
+
+  // optional int64 max_bytes = 3;
+  if (has_max_bytes()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->max_bytes(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ProposalBufferFullError)
+  return target;
+}
+
+int ProposalBufferFullError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional int64 raft_id = 1;
+    if (has_raft_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->raft_id());
+    }
+
+    // optional int64 pending_bytes = 2;
+    if (has_pending_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->pending_bytes());
+    }
+
+    // optional int64 max_bytes = 3;
+    if (has_max_bytes()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_bytes());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ProposalBufferFullError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ProposalBufferFullError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ProposalBufferFullError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ProposalBufferFullError::MergeFrom(const ProposalBufferFullError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_raft_id()) {
+      set_raft_id(from.raft_id());
+    }
+    if (from.has_pending_bytes()) {
+      set_pending_bytes(from.pending_bytes());
+    }
+    if (from.has_max_bytes()) {
+      set_max_bytes(from.max_bytes());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ProposalBufferFullError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ProposalBufferFullError::CopyFrom(const ProposalBufferFullError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ProposalBufferFullError::IsInitialized() const {
+
+  return true;
+}
+
+void ProposalBufferFullError::Swap(ProposalBufferFullError* other) {
+  if (other != this) {
+    std::swap(raft_id_, other->raft_id_);
+    std::swap(pending_bytes_, other->pending_bytes_);
+    std::swap(max_bytes_, other->max_bytes_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ProposalBufferFullError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ProposalBufferFullError_descriptor_;
+  metadata.reflection = ProposalBufferFullError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int TimestampTooFarError::kTimestampFieldNumber;
+const int TimestampTooFarError::kMaxTimestampFieldNumber;
+#endif  // !_MSC_VER
+
+TimestampTooFarError::TimestampTooFarError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.TimestampTooFarError)
+}
+
+void TimestampTooFarError::InitAsDefaultInstance() {
+  timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+  max_timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+}
+
+TimestampTooFarError::TimestampTooFarError(const TimestampTooFarError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.TimestampTooFarError)
+}
+
+void TimestampTooFarError::SharedCtor() {
+  _cached_size_ = 0;
+  timestamp_ = NULL;
+  max_timestamp_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+TimestampTooFarError::~TimestampTooFarError() {
+  // @@protoc_insertion_point(destructor:proto.TimestampTooFarError)
+  SharedDtor();
+}
+
+void TimestampTooFarError::SharedDtor() {
+  if (this != default_instance_) {
+    delete timestamp_;
+    delete max_timestamp_;
+  }
+}
+
+void TimestampTooFarError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* TimestampTooFarError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return TimestampTooFarError_descriptor_;
+}
+
+const TimestampTooFarError& TimestampTooFarError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+TimestampTooFarError* TimestampTooFarError::default_instance_ = NULL;
+
+TimestampTooFarError* TimestampTooFarError::New() const {
+  return new TimestampTooFarError;
+}
+
+void TimestampTooFarError::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+    }
+    if (has_max_timestamp()) {
+      if (max_timestamp_ != NULL) max_timestamp_->::proto::Timestamp::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool TimestampTooFarError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.TimestampTooFarError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.Timestamp timestamp = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_max_timestamp;
+        break;
+      }
+
+      // optional .proto.Timestamp max_timestamp = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_max_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_max_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.TimestampTooFarError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.TimestampTooFarError)
+  return false;
+#undef DO_
+}
+
+void TimestampTooFarError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.TimestampTooFarError)
+  // optional .proto.Timestamp timestamp = 1;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->timestamp(), output);
+  }
+
+  // optional .proto.Timestamp max_timestamp = 2;
+  if (has_max_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->max_timestamp(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.TimestampTooFarError)
+}
+
+::google::protobuf::uint8* TimestampTooFarError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.TimestampTooFarError)
+  // optional .proto.Timestamp timestamp = 1;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->timestamp(), target);
+  }
+
+  // optional .proto.Timestamp max_timestamp = 2;
+  if (has_max_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->max_timestamp(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.TimestampTooFarError)
+  return target;
+}
+
+int TimestampTooFarError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.Timestamp timestamp = 1;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp());
+    }
+
+    // optional .proto.Timestamp max_timestamp = 2;
+    if (has_max_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->max_timestamp());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void TimestampTooFarError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const TimestampTooFarError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const TimestampTooFarError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void TimestampTooFarError::MergeFrom(const TimestampTooFarError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_max_timestamp()) {
+      mutable_max_timestamp()->::proto::Timestamp::MergeFrom(from.max_timestamp());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void TimestampTooFarError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void TimestampTooFarError::CopyFrom(const TimestampTooFarError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool TimestampTooFarError::IsInitialized() const {
+
+  return true;
+}
+
+void TimestampTooFarError::Swap(TimestampTooFarError* other) {
+  if (other != this) {
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(max_timestamp_, other->max_timestamp_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata TimestampTooFarError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = TimestampTooFarError_descriptor_;
+  metadata.reflection = TimestampTooFarError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int CommandQueueTimeoutError::kKeyFieldNumber;
+const int CommandQueueTimeoutError::kEndKeyFieldNumber;
+const int CommandQueueTimeoutError::kTimeoutFieldNumber;
+#endif  // !_MSC_VER
+
+CommandQueueTimeoutError::CommandQueueTimeoutError()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.CommandQueueTimeoutError)
+}
+
+void CommandQueueTimeoutError::InitAsDefaultInstance() {
+}
+
+CommandQueueTimeoutError::CommandQueueTimeoutError(const CommandQueueTimeoutError& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.CommandQueueTimeoutError)
+}
+
+void CommandQueueTimeoutError::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  timeout_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+CommandQueueTimeoutError::~CommandQueueTimeoutError() {
+  // @@protoc_insertion_point(destructor:proto.CommandQueueTimeoutError)
+  SharedDtor();
+}
+
+void CommandQueueTimeoutError::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void CommandQueueTimeoutError::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* CommandQueueTimeoutError::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return CommandQueueTimeoutError_descriptor_;
+}
+
+const CommandQueueTimeoutError& CommandQueueTimeoutError::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+CommandQueueTimeoutError* CommandQueueTimeoutError::default_instance_ = NULL;
+
+CommandQueueTimeoutError* CommandQueueTimeoutError::New() const {
+  return new CommandQueueTimeoutError;
+}
+
+void CommandQueueTimeoutError::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+    timeout_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool CommandQueueTimeoutError::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.CommandQueueTimeoutError)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_end_key;
+        break;
+      }
+
+      // optional bytes end_key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_timeout;
+        break;
+      }
+
+      // optional int64 timeout = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_timeout:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &timeout_)));
+          set_has_timeout();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.CommandQueueTimeoutError)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.CommandQueueTimeoutError)
+  return false;
+#undef DO_
+}
+
+void CommandQueueTimeoutError::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.CommandQueueTimeoutError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->end_key(), output);
+  }
+
+  // optional int64 timeout = 3;
+  if (has_timeout()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->timeout(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.CommandQueueTimeoutError)
+}
+
+::google::protobuf::uint8* CommandQueueTimeoutError::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.CommandQueueTimeoutError)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional bytes end_key = 2;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->end_key(), target);
+  }
+
+  // optional int64 timeout = 3;
+  if (has_timeout()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->timeout(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.CommandQueueTimeoutError)
+  return target;
+}
+
+int CommandQueueTimeoutError::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional bytes end_key = 2;
+    if (has_end_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+    // optional int64 timeout = 3;
+    if (has_timeout()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->timeout());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void CommandQueueTimeoutError::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const CommandQueueTimeoutError* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const CommandQueueTimeoutError*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void CommandQueueTimeoutError::MergeFrom(const CommandQueueTimeoutError& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+    if (from.has_timeout()) {
+      set_timeout(from.timeout());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void CommandQueueTimeoutError::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void CommandQueueTimeoutError::CopyFrom(const CommandQueueTimeoutError& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool CommandQueueTimeoutError::IsInitialized() const {
+
+  return true;
+}
+
+void CommandQueueTimeoutError::Swap(CommandQueueTimeoutError* other) {
+  if (other != this) {
+    std::swap(key_, other->key_);
+    std::swap(end_key_, other->end_key_);
+    std::swap(timeout_, other->timeout_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata CommandQueueTimeoutError::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = CommandQueueTimeoutError_descriptor_;
+  metadata.reflection = CommandQueueTimeoutError_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Error::kGenericFieldNumber;
+const int Error::kNotLeaderFieldNumber;
+const int Error::kRangeNotFoundFieldNumber;
+const int Error::kRangeKeyMismatchFieldNumber;
+const int Error::kReadWithinUncertaintyIntervalFieldNumber;
+const int Error::kTransactionAbortedFieldNumber;
+const int Error::kTransactionPushFieldNumber;
+const int Error::kTransactionRetryFieldNumber;
+const int Error::kTransactionStatusFieldNumber;
+const int Error::kWriteIntentFieldNumber;
+const int Error::kWriteTooOldFieldNumber;
+const int Error::kOpRequiresTxnFieldNumber;
+const int Error::kConditionFailedFieldNumber;
+const int Error::kConflictTimeoutFieldNumber;
+const int Error::kRaftGroupDeletedFieldNumber;
+const int Error::kStoreOverloadedFieldNumber;
+const int Error::kDeadlineExceededFieldNumber;
+const int Error::kChecksumMismatchFieldNumber;
+const int Error::kProtectedKeyFieldNumber;
+const int Error::kTooManyIntentsFieldNumber;
+const int Error::kCommandTooLargeFieldNumber;
+const int Error::kProposalBufferFullFieldNumber;
+const int Error::kTimestampTooFarFieldNumber;
+const int Error::kCommandQueueTimeoutFieldNumber;
+#endif  // !_MSC_VER
+
+Error::Error()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.Error)
+}
+
+void Error::InitAsDefaultInstance() {
+  generic_ = const_cast< ::proto::GenericError*>(&::proto::GenericError::default_instance());
+  not_leader_ = const_cast< ::proto::NotLeaderError*>(&::proto::NotLeaderError::default_instance());
+  range_not_found_ = const_cast< ::proto::RangeNotFoundError*>(&::proto::RangeNotFoundError::default_instance());
+  range_key_mismatch_ = const_cast< ::proto::RangeKeyMismatchError*>(&::proto::RangeKeyMismatchError::default_instance());
+  read_within_uncertainty_interval_ = const_cast< ::proto::ReadWithinUncertaintyIntervalError*>(&::proto::ReadWithinUncertaintyIntervalError::default_instance());
+  transaction_aborted_ = const_cast< ::proto::TransactionAbortedError*>(&::proto::TransactionAbortedError::default_instance());
+  transaction_push_ = const_cast< ::proto::TransactionPushError*>(&::proto::TransactionPushError::default_instance());
+  transaction_retry_ = const_cast< ::proto::TransactionRetryError*>(&::proto::TransactionRetryError::default_instance());
+  transaction_status_ = const_cast< ::proto::TransactionStatusError*>(&::proto::TransactionStatusError::default_instance());
+  write_intent_ = const_cast< ::proto::WriteIntentError*>(&::proto::WriteIntentError::default_instance());
+  write_too_old_ = const_cast< ::proto::WriteTooOldError*>(&::proto::WriteTooOldError::default_instance());
+  op_requires_txn_ = const_cast< ::proto::OpRequiresTxnError*>(&::proto::OpRequiresTxnError::default_instance());
+  condition_failed_ = const_cast< ::proto::ConditionFailedError*>(&::proto::ConditionFailedError::default_instance());
+  conflict_timeout_ = const_cast< ::proto::ConflictTimeoutError*>(&::proto::ConflictTimeoutError::default_instance());
+  raft_group_deleted_ = const_cast< ::proto::RaftGroupDeletedError*>(&::proto::RaftGroupDeletedError::default_instance());
+  store_overloaded_ = const_cast< ::proto::StoreOverloadedError*>(&::proto::StoreOverloadedError::default_instance());
+  deadline_exceeded_ = const_cast< ::proto::DeadlineExceededError*>(&::proto::DeadlineExceededError::default_instance());
+  checksum_mismatch_ = const_cast< ::proto::ChecksumMismatchError*>(&::proto::ChecksumMismatchError::default_instance());
+  protected_key_ = const_cast< ::proto::ProtectedKeyError*>(&::proto::ProtectedKeyError::default_instance());
+  too_many_intents_ = const_cast< ::proto::TooManyIntentsError*>(&::proto::TooManyIntentsError::default_instance());
+  command_too_large_ = const_cast< ::proto::CommandTooLargeError*>(&::proto::CommandTooLargeError::default_instance());
+  proposal_buffer_full_ = const_cast< ::proto::ProposalBufferFullError*>(&::proto::ProposalBufferFullError::default_instance());
+  timestamp_too_far_ = const_cast< ::proto::TimestampTooFarError*>(&::proto::TimestampTooFarError::default_instance());
+  command_queue_timeout_ = const_cast< ::proto::CommandQueueTimeoutError*>(&::proto::CommandQueueTimeoutError::default_instance());
+}
+
+Error::Error(const Error& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.Error)
+}
+
+void Error::SharedCtor() {
+  _cached_size_ = 0;
+  generic_ = NULL;
+  not_leader_ = NULL;
+  range_not_found_ = NULL;
+  range_key_mismatch_ = NULL;
+  read_within_uncertainty_interval_ = NULL;
+  transaction_aborted_ = NULL;
+  transaction_push_ = NULL;
+  transaction_retry_ = NULL;
+  transaction_status_ = NULL;
+  write_intent_ = NULL;
+  write_too_old_ = NULL;
+  op_requires_txn_ = NULL;
+  condition_failed_ = NULL;
+  conflict_timeout_ = NULL;
+  raft_group_deleted_ = NULL;
+  store_overloaded_ = NULL;
+  deadline_exceeded_ = NULL;
+  checksum_mismatch_ = NULL;
+  protected_key_ = NULL;
+  too_many_intents_ = NULL;
+  command_too_large_ = NULL;
+  proposal_buffer_full_ = NULL;
+  timestamp_too_far_ = NULL;
+  command_queue_timeout_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Error::~Error() {
+  // @@protoc_insertion_point(destructor:proto.Error)
+  SharedDtor();
+}
+
+void Error::SharedDtor() {
+  if (this != default_instance_) {
+    delete generic_;
+    delete not_leader_;
+    delete range_not_found_;
+    delete range_key_mismatch_;
+    delete read_within_uncertainty_interval_;
+    delete transaction_aborted_;
+    delete transaction_push_;
+    delete transaction_retry_;
+    delete transaction_status_;
+    delete write_intent_;
+    delete write_too_old_;
+    delete op_requires_txn_;
+    delete condition_failed_;
+    delete conflict_timeout_;
+    delete raft_group_deleted_;
+    delete store_overloaded_;
+    delete deadline_exceeded_;
+    delete checksum_mismatch_;
+    delete protected_key_;
+    delete too_many_intents_;
+    delete command_too_large_;
+    delete proposal_buffer_full_;
+    delete timestamp_too_far_;
+    delete command_queue_timeout_;
+  }
+}
+
+void Error::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Error::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Error_descriptor_;
+}
+
+const Error& Error::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_errors_2eproto();
+  return *default_instance_;
+}
+
+Error* Error::default_instance_ = NULL;
+
+Error* Error::New() const {
+  return new Error;
+}
+
+void Error::Clear() {
+  if (_has_bits_[0 / 32] & 255) {
+    if (has_generic()) {
+      if (generic_ != NULL) generic_->::proto::GenericError::Clear();
+    }
+    if (has_not_leader()) {
+      if (not_leader_ != NULL) not_leader_->::proto::NotLeaderError::Clear();
+    }
+    if (has_range_not_found()) {
+      if (range_not_found_ != NULL) range_not_found_->::proto::RangeNotFoundError::Clear();
+    }
+    if (has_range_key_mismatch()) {
+      if (range_key_mismatch_ != NULL) range_key_mismatch_->::proto::RangeKeyMismatchError::Clear();
+    }
+    if (has_read_within_uncertainty_interval()) {
+      if (read_within_uncertainty_interval_ != NULL) read_within_uncertainty_interval_->::proto::ReadWithinUncertaintyIntervalError::Clear();
+    }
+    if (has_transaction_aborted()) {
+      if (transaction_aborted_ != NULL) transaction_aborted_->::proto::TransactionAbortedError::Clear();
+    }
+    if (has_transaction_push()) {
+      if (transaction_push_ != NULL) transaction_push_->::proto::TransactionPushError::Clear();
+    }
+    if (has_transaction_retry()) {
+      if (transaction_retry_ != NULL) transaction_retry_->::proto::TransactionRetryError::Clear();
+    }
+  }
+  if (_has_bits_[8 / 32] & 65280) {
+    if (has_transaction_status()) {
+      if (transaction_status_ != NULL) transaction_status_->::proto::TransactionStatusError::Clear();
+    }
+    if (has_write_intent()) {
+      if (write_intent_ != NULL) write_intent_->::proto::WriteIntentError::Clear();
+    }
+    if (has_write_too_old()) {
+      if (write_too_old_ != NULL) write_too_old_->::proto::WriteTooOldError::Clear();
+    }
+    if (has_op_requires_txn()) {
+      if (op_requires_txn_ != NULL) op_requires_txn_->::proto::OpRequiresTxnError::Clear();
+    }
+    if (has_condition_failed()) {
+      if (condition_failed_ != NULL) condition_failed_->::proto::ConditionFailedError::Clear();
+    }
+    if (has_conflict_timeout()) {
+      if (conflict_timeout_ != NULL) conflict_timeout_->::proto::ConflictTimeoutError::Clear();
+    }
+    if (has_raft_group_deleted()) {
+      if (raft_group_deleted_ != NULL) raft_group_deleted_->::proto::RaftGroupDeletedError::Clear();
+    }
+    if (has_store_overloaded()) {
+      if (store_overloaded_ != NULL) store_overloaded_->::proto::StoreOverloadedError::Clear();
+    }
+  }
+  if (_has_bits_[16 / 32] & 16711680) {
+    if (has_deadline_exceeded()) {
+      if (deadline_exceeded_ != NULL) deadline_exceeded_->::proto::DeadlineExceededError::Clear();
+    }
+    if (has_checksum_mismatch()) {
+      if (checksum_mismatch_ != NULL) checksum_mismatch_->::proto::ChecksumMismatchError::Clear();
+    }
+    if (has_protected_key()) {
+      if (protected_key_ != NULL) protected_key_->::proto::ProtectedKeyError::Clear();
+    }
+    if (has_too_many_intents()) {
+      if (too_many_intents_ != NULL) too_many_intents_->::proto::TooManyIntentsError::Clear();
+    }
+    if (has_command_too_large()) {
+      if (command_too_large_ != NULL) command_too_large_->::proto::CommandTooLargeError::Clear();
+    }
+    if (has_proposal_buffer_full()) {
+      if (proposal_buffer_full_ != NULL) proposal_buffer_full_->::proto::ProposalBufferFullError::Clear();
+    }
+    if (has_timestamp_too_far()) {
+      if (timestamp_too_far_ != NULL) timestamp_too_far_->::proto::TimestampTooFarError::Clear();
+    }
+    if (has_command_queue_timeout()) {
+      if (command_queue_timeout_ != NULL) command_queue_timeout_->::proto::CommandQueueTimeoutError::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool Error::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.Error)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.GenericError generic = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_generic()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_not_leader;
+        break;
+      }
+
+      // optional .proto.NotLeaderError not_leader = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_not_leader:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_not_leader()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_range_not_found;
+        break;
+      }
+
+      // optional .proto.RangeNotFoundError range_not_found = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_range_not_found:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_range_not_found()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_range_key_mismatch;
+        break;
+      }
+
+      // optional .proto.RangeKeyMismatchError range_key_mismatch = 4;
       case 4: {
         if (tag == 34) {
          parse_range_key_mismatch:
@@ -4138,6 +7495,149 @@ bool Error::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(114)) goto parse_conflict_timeout;
+        break;
+      }
+
+      // optional .proto.ConflictTimeoutError conflict_timeout = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_conflict_timeout:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conflict_timeout()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_raft_group_deleted;
+        break;
+      }
+
+      // optional .proto.RaftGroupDeletedError raft_group_deleted = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_raft_group_deleted:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_raft_group_deleted()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(130)) goto parse_store_overloaded;
+        break;
+      }
+
+      // optional .proto.StoreOverloadedError store_overloaded = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_store_overloaded:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_store_overloaded()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(138)) goto parse_deadline_exceeded;
+        break;
+      }
+
+      // optional .proto.DeadlineExceededError deadline_exceeded = 17;
+      case 17: {
+        if (tag == 138) {
+         parse_deadline_exceeded:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_deadline_exceeded()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(146)) goto parse_checksum_mismatch;
+        break;
+      }
+
+      // optional .proto.ChecksumMismatchError checksum_mismatch = 18;
+      case 18: {
+        if (tag == 146) {
+         parse_checksum_mismatch:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_checksum_mismatch()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(154)) goto parse_protected_key;
+        break;
+      }
+
+      // optional .proto.ProtectedKeyError protected_key = 19;
+      case 19: {
+        if (tag == 154) {
+         parse_protected_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_protected_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(162)) goto parse_too_many_intents;
+        break;
+      }
+
+      // optional .proto.TooManyIntentsError too_many_intents = 20;
+      case 20: {
+        if (tag == 162) {
+         parse_too_many_intents:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_too_many_intents()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(170)) goto parse_command_too_large;
+        break;
+      }
+
+      // optional .proto.CommandTooLargeError command_too_large = 21;
+      case 21: {
+        if (tag == 170) {
+         parse_command_too_large:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_command_too_large()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(178)) goto parse_proposal_buffer_full;
+        break;
+      }
+
+      // optional .proto.ProposalBufferFullError proposal_buffer_full = 22;
+      case 22: {
+        if (tag == 178) {
+         parse_proposal_buffer_full:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_proposal_buffer_full()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(186)) goto parse_timestamp_too_far;
+        break;
+      }
+
+      // optional .proto.TimestampTooFarError timestamp_too_far = 23;
+      case 23: {
+        if (tag == 186) {
+         parse_timestamp_too_far:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp_too_far()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(194)) goto parse_command_queue_timeout;
+        break;
+      }
+
+      // optional .proto.CommandQueueTimeoutError command_queue_timeout = 24;
+      case 24: {
+        if (tag == 194) {
+         parse_command_queue_timeout:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_command_queue_timeout()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -4245,6 +7745,72 @@ void Error::SerializeWithCachedSizes(
       13, this->condition_failed(), output);
   }
 
+  // optional .proto.ConflictTimeoutError conflict_timeout = 14;
+  if (has_conflict_timeout()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->conflict_timeout(), output);
+  }
+
+  // optional .proto.RaftGroupDeletedError raft_group_deleted = 15;
+  if (has_raft_group_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, this->raft_group_deleted(), output);
+  }
+
+  // optional .proto.StoreOverloadedError store_overloaded = 16;
+  if (has_store_overloaded()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, this->store_overloaded(), output);
+  }
+
+  // optional .proto.DeadlineExceededError deadline_exceeded = 17;
+  if (has_deadline_exceeded()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      17, this->deadline_exceeded(), output);
+  }
+
+  // optional .proto.ChecksumMismatchError checksum_mismatch = 18;
+  if (has_checksum_mismatch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      18, this->checksum_mismatch(), output);
+  }
+
+  // optional .proto.ProtectedKeyError protected_key = 19;
+  if (has_protected_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      19, this->protected_key(), output);
+  }
+
+  // optional .proto.TooManyIntentsError too_many_intents = 20;
+  if (has_too_many_intents()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      20, this->too_many_intents(), output);
+  }
+
+  // optional .proto.CommandTooLargeError command_too_large = 21;
+  if (has_command_too_large()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      21, this->command_too_large(), output);
+  }
+
+  // optional .proto.ProposalBufferFullError proposal_buffer_full = 22;
+  if (has_proposal_buffer_full()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      22, this->proposal_buffer_full(), output);
+  }
+
+  // optional .proto.TimestampTooFarError timestamp_too_far = 23;
+  if (has_timestamp_too_far()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      23, this->timestamp_too_far(), output);
+  }
+
+  // optional .proto.CommandQueueTimeoutError command_queue_timeout = 24;
+  if (has_command_queue_timeout()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      24, this->command_queue_timeout(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -4346,6 +7912,83 @@ void Error::SerializeWithCachedSizes(
         13, this->condition_failed(), target);
   }
 
+  // optional .proto.ConflictTimeoutError conflict_timeout = 14;
+  if (has_conflict_timeout()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->conflict_timeout(), target);
+  }
+
+  // optional .proto.RaftGroupDeletedError raft_group_deleted = 15;
+  if (has_raft_group_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, this->raft_group_deleted(), target);
+  }
+
+  // optional .proto.StoreOverloadedError store_overloaded = 16;
+  if (has_store_overloaded()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, this->store_overloaded(), target);
+  }
+
+  // optional .proto.DeadlineExceededError deadline_exceeded = 17;
+  if (has_deadline_exceeded()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        17, this->deadline_exceeded(), target);
+  }
+
+  // optional .proto.ChecksumMismatchError checksum_mismatch = 18;
+  if (has_checksum_mismatch()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        18, this->checksum_mismatch(), target);
+  }
+
+  // optional .proto.ProtectedKeyError protected_key = 19;
+  if (has_protected_key()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        19, this->protected_key(), target);
+  }
+
+  // optional .proto.TooManyIntentsError too_many_intents = 20;
+  if (has_too_many_intents()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        20, this->too_many_intents(), target);
+  }
+
+  // optional .proto.CommandTooLargeError command_too_large = 21;
+  if (has_command_too_large()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        21, this->command_too_large(), target);
+  }
+
+  // optional .proto.ProposalBufferFullError proposal_buffer_full = 22;
+  if (has_proposal_buffer_full()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        22, this->proposal_buffer_full(), target);
+  }
+
+  // optional .proto.TimestampTooFarError timestamp_too_far = 23;
+  if (has_timestamp_too_far()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        23, this->timestamp_too_far(), target);
+  }
+
+  // optional .proto.CommandQueueTimeoutError command_queue_timeout = 24;
+  if (has_command_queue_timeout()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        24, this->command_queue_timeout(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -4451,6 +8094,85 @@ int Error::ByteSize() const {
           this->condition_failed());
     }
 
+    // optional .proto.ConflictTimeoutError conflict_timeout = 14;
+    if (has_conflict_timeout()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conflict_timeout());
+    }
+
+    // optional .proto.RaftGroupDeletedError raft_group_deleted = 15;
+    if (has_raft_group_deleted()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->raft_group_deleted());
+    }
+
+    // optional .proto.StoreOverloadedError store_overloaded = 16;
+    if (has_store_overloaded()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->store_overloaded());
+    }
+
+  }
+  if (_has_bits_[16 / 32] & (0xffu << (16 % 32))) {
+    // optional .proto.DeadlineExceededError deadline_exceeded = 17;
+    if (has_deadline_exceeded()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->deadline_exceeded());
+    }
+
+    // optional .proto.ChecksumMismatchError checksum_mismatch = 18;
+    if (has_checksum_mismatch()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->checksum_mismatch());
+    }
+
+    // optional .proto.ProtectedKeyError protected_key = 19;
+    if (has_protected_key()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->protected_key());
+    }
+
+    // optional .proto.TooManyIntentsError too_many_intents = 20;
+    if (has_too_many_intents()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->too_many_intents());
+    }
+
+    // optional .proto.CommandTooLargeError command_too_large = 21;
+    if (has_command_too_large()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->command_too_large());
+    }
+
+    // optional .proto.ProposalBufferFullError proposal_buffer_full = 22;
+    if (has_proposal_buffer_full()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->proposal_buffer_full());
+    }
+
+    // optional .proto.TimestampTooFarError timestamp_too_far = 23;
+    if (has_timestamp_too_far()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp_too_far());
+    }
+
+    // optional .proto.CommandQueueTimeoutError command_queue_timeout = 24;
+    if (has_command_queue_timeout()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->command_queue_timeout());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -4519,6 +8241,41 @@ void Error::MergeFrom(const Error& from) {
     if (from.has_condition_failed()) {
       mutable_condition_failed()->::proto::ConditionFailedError::MergeFrom(from.condition_failed());
     }
+    if (from.has_conflict_timeout()) {
+      mutable_conflict_timeout()->::proto::ConflictTimeoutError::MergeFrom(from.conflict_timeout());
+    }
+    if (from.has_raft_group_deleted()) {
+      mutable_raft_group_deleted()->::proto::RaftGroupDeletedError::MergeFrom(from.raft_group_deleted());
+    }
+    if (from.has_store_overloaded()) {
+      mutable_store_overloaded()->::proto::StoreOverloadedError::MergeFrom(from.store_overloaded());
+    }
+  }
+  if (from._has_bits_[16 / 32] & (0xffu << (16 % 32))) {
+    if (from.has_deadline_exceeded()) {
+      mutable_deadline_exceeded()->::proto::DeadlineExceededError::MergeFrom(from.deadline_exceeded());
+    }
+    if (from.has_checksum_mismatch()) {
+      mutable_checksum_mismatch()->::proto::ChecksumMismatchError::MergeFrom(from.checksum_mismatch());
+    }
+    if (from.has_protected_key()) {
+      mutable_protected_key()->::proto::ProtectedKeyError::MergeFrom(from.protected_key());
+    }
+    if (from.has_too_many_intents()) {
+      mutable_too_many_intents()->::proto::TooManyIntentsError::MergeFrom(from.too_many_intents());
+    }
+    if (from.has_command_too_large()) {
+      mutable_command_too_large()->::proto::CommandTooLargeError::MergeFrom(from.command_too_large());
+    }
+    if (from.has_proposal_buffer_full()) {
+      mutable_proposal_buffer_full()->::proto::ProposalBufferFullError::MergeFrom(from.proposal_buffer_full());
+    }
+    if (from.has_timestamp_too_far()) {
+      mutable_timestamp_too_far()->::proto::TimestampTooFarError::MergeFrom(from.timestamp_too_far());
+    }
+    if (from.has_command_queue_timeout()) {
+      mutable_command_queue_timeout()->::proto::CommandQueueTimeoutError::MergeFrom(from.command_queue_timeout());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -4555,6 +8312,17 @@ void Error::Swap(Error* other) {
     std::swap(write_too_old_, other->write_too_old_);
     std::swap(op_requires_txn_, other->op_requires_txn_);
     std::swap(condition_failed_, other->condition_failed_);
+    std::swap(conflict_timeout_, other->conflict_timeout_);
+    std::swap(raft_group_deleted_, other->raft_group_deleted_);
+    std::swap(store_overloaded_, other->store_overloaded_);
+    std::swap(deadline_exceeded_, other->deadline_exceeded_);
+    std::swap(checksum_mismatch_, other->checksum_mismatch_);
+    std::swap(protected_key_, other->protected_key_);
+    std::swap(too_many_intents_, other->too_many_intents_);
+    std::swap(command_too_large_, other->command_too_large_);
+    std::swap(proposal_buffer_full_, other->proposal_buffer_full_);
+    std::swap(timestamp_too_far_, other->timestamp_too_far_);
+    std::swap(command_queue_timeout_, other->command_queue_timeout_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -49,6 +49,17 @@ class WriteIntentError;
 class WriteTooOldError;
 class OpRequiresTxnError;
 class ConditionFailedError;
+class ConflictTimeoutError;
+class RaftGroupDeletedError;
+class StoreOverloadedError;
+class DeadlineExceededError;
+class ChecksumMismatchError;
+class ProtectedKeyError;
+class TooManyIntentsError;
+class CommandTooLargeError;
+class ProposalBufferFullError;
+class TimestampTooFarError;
+class CommandQueueTimeoutError;
 class Error;
 
 // ===================================================================
@@ -663,12 +674,21 @@ class TransactionPushError : public ::google::protobuf::Message {
   inline ::proto::Transaction* release_pushee_txn();
   inline void set_allocated_pushee_txn(::proto::Transaction* pushee_txn);
 
+  // optional int32 pushee_priority = 3;
+  inline bool has_pushee_priority() const;
+  inline void clear_pushee_priority();
+  static const int kPusheePriorityFieldNumber = 3;
+  inline ::google::protobuf::int32 pushee_priority() const;
+  inline void set_pushee_priority(::google::protobuf::int32 value);
+
   // @@protoc_insertion_point(class_scope:proto.TransactionPushError)
  private:
   inline void set_has_txn();
   inline void clear_has_txn();
   inline void set_has_pushee_txn();
   inline void clear_has_pushee_txn();
+  inline void set_has_pushee_priority();
+  inline void clear_has_pushee_priority();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -676,6 +696,7 @@ class TransactionPushError : public ::google::protobuf::Message {
   mutable int _cached_size_;
   ::proto::Transaction* txn_;
   ::proto::Transaction* pushee_txn_;
+  ::google::protobuf::int32 pushee_priority_;
   friend void  protobuf_AddDesc_errors_2eproto();
   friend void protobuf_AssignDesc_errors_2eproto();
   friend void protobuf_ShutdownFile_errors_2eproto();
@@ -1211,14 +1232,14 @@ class ConditionFailedError : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class Error : public ::google::protobuf::Message {
+class ConflictTimeoutError : public ::google::protobuf::Message {
  public:
-  Error();
-  virtual ~Error();
+  ConflictTimeoutError();
+  virtual ~ConflictTimeoutError();
 
-  Error(const Error& from);
+  ConflictTimeoutError(const ConflictTimeoutError& from);
 
-  inline Error& operator=(const Error& from) {
+  inline ConflictTimeoutError& operator=(const ConflictTimeoutError& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1232,17 +1253,17 @@ class Error : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const Error& default_instance();
+  static const ConflictTimeoutError& default_instance();
 
-  void Swap(Error* other);
+  void Swap(ConflictTimeoutError* other);
 
   // implements Message ----------------------------------------------
 
-  Error* New() const;
+  ConflictTimeoutError* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const Error& from);
-  void MergeFrom(const Error& from);
+  void CopyFrom(const ConflictTimeoutError& from);
+  void MergeFrom(const ConflictTimeoutError& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1264,588 +1285,2267 @@ class Error : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .proto.GenericError generic = 1;
-  inline bool has_generic() const;
-  inline void clear_generic();
-  static const int kGenericFieldNumber = 1;
-  inline const ::proto::GenericError& generic() const;
-  inline ::proto::GenericError* mutable_generic();
-  inline ::proto::GenericError* release_generic();
-  inline void set_allocated_generic(::proto::GenericError* generic);
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
 
-  // optional .proto.NotLeaderError not_leader = 2;
-  inline bool has_not_leader() const;
-  inline void clear_not_leader();
-  static const int kNotLeaderFieldNumber = 2;
-  inline const ::proto::NotLeaderError& not_leader() const;
-  inline ::proto::NotLeaderError* mutable_not_leader();
-  inline ::proto::NotLeaderError* release_not_leader();
-  inline void set_allocated_not_leader(::proto::NotLeaderError* not_leader);
+  // optional .proto.Transaction txn = 2;
+  inline bool has_txn() const;
+  inline void clear_txn();
+  static const int kTxnFieldNumber = 2;
+  inline const ::proto::Transaction& txn() const;
+  inline ::proto::Transaction* mutable_txn();
+  inline ::proto::Transaction* release_txn();
+  inline void set_allocated_txn(::proto::Transaction* txn);
 
-  // optional .proto.RangeNotFoundError range_not_found = 3;
-  inline bool has_range_not_found() const;
-  inline void clear_range_not_found();
-  static const int kRangeNotFoundFieldNumber = 3;
-  inline const ::proto::RangeNotFoundError& range_not_found() const;
-  inline ::proto::RangeNotFoundError* mutable_range_not_found();
-  inline ::proto::RangeNotFoundError* release_range_not_found();
-  inline void set_allocated_range_not_found(::proto::RangeNotFoundError* range_not_found);
+  // @@protoc_insertion_point(class_scope:proto.ConflictTimeoutError)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_txn();
+  inline void clear_has_txn();
 
-  // optional .proto.RangeKeyMismatchError range_key_mismatch = 4;
-  inline bool has_range_key_mismatch() const;
-  inline void clear_range_key_mismatch();
-  static const int kRangeKeyMismatchFieldNumber = 4;
-  inline const ::proto::RangeKeyMismatchError& range_key_mismatch() const;
-  inline ::proto::RangeKeyMismatchError* mutable_range_key_mismatch();
-  inline ::proto::RangeKeyMismatchError* release_range_key_mismatch();
-  inline void set_allocated_range_key_mismatch(::proto::RangeKeyMismatchError* range_key_mismatch);
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
-  // optional .proto.ReadWithinUncertaintyIntervalError read_within_uncertainty_interval = 5;
-  inline bool has_read_within_uncertainty_interval() const;
-  inline void clear_read_within_uncertainty_interval();
-  static const int kReadWithinUncertaintyIntervalFieldNumber = 5;
-  inline const ::proto::ReadWithinUncertaintyIntervalError& read_within_uncertainty_interval() const;
-  inline ::proto::ReadWithinUncertaintyIntervalError* mutable_read_within_uncertainty_interval();
-  inline ::proto::ReadWithinUncertaintyIntervalError* release_read_within_uncertainty_interval();
-  inline void set_allocated_read_within_uncertainty_interval(::proto::ReadWithinUncertaintyIntervalError* read_within_uncertainty_interval);
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  ::proto::Transaction* txn_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
 
-  // optional .proto.TransactionAbortedError transaction_aborted = 6;
-  inline bool has_transaction_aborted() const;
-  inline void clear_transaction_aborted();
-  static const int kTransactionAbortedFieldNumber = 6;
-  inline const ::proto::TransactionAbortedError& transaction_aborted() const;
-  inline ::proto::TransactionAbortedError* mutable_transaction_aborted();
-  inline ::proto::TransactionAbortedError* release_transaction_aborted();
-  inline void set_allocated_transaction_aborted(::proto::TransactionAbortedError* transaction_aborted);
+  void InitAsDefaultInstance();
+  static ConflictTimeoutError* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional .proto.TransactionPushError transaction_push = 7;
-  inline bool has_transaction_push() const;
-  inline void clear_transaction_push();
-  static const int kTransactionPushFieldNumber = 7;
-  inline const ::proto::TransactionPushError& transaction_push() const;
-  inline ::proto::TransactionPushError* mutable_transaction_push();
-  inline ::proto::TransactionPushError* release_transaction_push();
-  inline void set_allocated_transaction_push(::proto::TransactionPushError* transaction_push);
+class RaftGroupDeletedError : public ::google::protobuf::Message {
+ public:
+  RaftGroupDeletedError();
+  virtual ~RaftGroupDeletedError();
 
-  // optional .proto.TransactionRetryError transaction_retry = 8;
-  inline bool has_transaction_retry() const;
-  inline void clear_transaction_retry();
-  static const int kTransactionRetryFieldNumber = 8;
-  inline const ::proto::TransactionRetryError& transaction_retry() const;
-  inline ::proto::TransactionRetryError* mutable_transaction_retry();
-  inline ::proto::TransactionRetryError* release_transaction_retry();
-  inline void set_allocated_transaction_retry(::proto::TransactionRetryError* transaction_retry);
+  RaftGroupDeletedError(const RaftGroupDeletedError& from);
 
-  // optional .proto.TransactionStatusError transaction_status = 9;
-  inline bool has_transaction_status() const;
-  inline void clear_transaction_status();
-  static const int kTransactionStatusFieldNumber = 9;
-  inline const ::proto::TransactionStatusError& transaction_status() const;
-  inline ::proto::TransactionStatusError* mutable_transaction_status();
-  inline ::proto::TransactionStatusError* release_transaction_status();
-  inline void set_allocated_transaction_status(::proto::TransactionStatusError* transaction_status);
+  inline RaftGroupDeletedError& operator=(const RaftGroupDeletedError& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional .proto.WriteIntentError write_intent = 10;
-  inline bool has_write_intent() const;
-  inline void clear_write_intent();
-  static const int kWriteIntentFieldNumber = 10;
-  inline const ::proto::WriteIntentError& write_intent() const;
-  inline ::proto::WriteIntentError* mutable_write_intent();
-  inline ::proto::WriteIntentError* release_write_intent();
-  inline void set_allocated_write_intent(::proto::WriteIntentError* write_intent);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
 
-  // optional .proto.WriteTooOldError write_too_old = 11;
-  inline bool has_write_too_old() const;
-  inline void clear_write_too_old();
-  static const int kWriteTooOldFieldNumber = 11;
-  inline const ::proto::WriteTooOldError& write_too_old() const;
-  inline ::proto::WriteTooOldError* mutable_write_too_old();
-  inline ::proto::WriteTooOldError* release_write_too_old();
-  inline void set_allocated_write_too_old(::proto::WriteTooOldError* write_too_old);
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
 
-  // optional .proto.OpRequiresTxnError op_requires_txn = 12;
-  inline bool has_op_requires_txn() const;
-  inline void clear_op_requires_txn();
-  static const int kOpRequiresTxnFieldNumber = 12;
-  inline const ::proto::OpRequiresTxnError& op_requires_txn() const;
-  inline ::proto::OpRequiresTxnError* mutable_op_requires_txn();
-  inline ::proto::OpRequiresTxnError* release_op_requires_txn();
-  inline void set_allocated_op_requires_txn(::proto::OpRequiresTxnError* op_requires_txn);
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RaftGroupDeletedError& default_instance();
 
-  // optional .proto.ConditionFailedError condition_failed = 13;
-  inline bool has_condition_failed() const;
-  inline void clear_condition_failed();
-  static const int kConditionFailedFieldNumber = 13;
-  inline const ::proto::ConditionFailedError& condition_failed() const;
-  inline ::proto::ConditionFailedError* mutable_condition_failed();
-  inline ::proto::ConditionFailedError* release_condition_failed();
-  inline void set_allocated_condition_failed(::proto::ConditionFailedError* condition_failed);
+  void Swap(RaftGroupDeletedError* other);
 
-  // @@protoc_insertion_point(class_scope:proto.Error)
+  // implements Message ----------------------------------------------
+
+  RaftGroupDeletedError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RaftGroupDeletedError& from);
+  void MergeFrom(const RaftGroupDeletedError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 raft_id = 1;
+  inline bool has_raft_id() const;
+  inline void clear_raft_id();
+  static const int kRaftIdFieldNumber = 1;
+  inline ::google::protobuf::int64 raft_id() const;
+  inline void set_raft_id(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.RaftGroupDeletedError)
  private:
-  inline void set_has_generic();
-  inline void clear_has_generic();
-  inline void set_has_not_leader();
-  inline void clear_has_not_leader();
-  inline void set_has_range_not_found();
-  inline void clear_has_range_not_found();
-  inline void set_has_range_key_mismatch();
-  inline void clear_has_range_key_mismatch();
-  inline void set_has_read_within_uncertainty_interval();
-  inline void clear_has_read_within_uncertainty_interval();
-  inline void set_has_transaction_aborted();
-  inline void clear_has_transaction_aborted();
-  inline void set_has_transaction_push();
-  inline void clear_has_transaction_push();
-  inline void set_has_transaction_retry();
-  inline void clear_has_transaction_retry();
-  inline void set_has_transaction_status();
-  inline void clear_has_transaction_status();
-  inline void set_has_write_intent();
-  inline void clear_has_write_intent();
-  inline void set_has_write_too_old();
-  inline void clear_has_write_too_old();
-  inline void set_has_op_requires_txn();
-  inline void clear_has_op_requires_txn();
-  inline void set_has_condition_failed();
-  inline void clear_has_condition_failed();
+  inline void set_has_raft_id();
+  inline void clear_has_raft_id();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::proto::GenericError* generic_;
-  ::proto::NotLeaderError* not_leader_;
-  ::proto::RangeNotFoundError* range_not_found_;
-  ::proto::RangeKeyMismatchError* range_key_mismatch_;
-  ::proto::ReadWithinUncertaintyIntervalError* read_within_uncertainty_interval_;
-  ::proto::TransactionAbortedError* transaction_aborted_;
-  ::proto::TransactionPushError* transaction_push_;
-  ::proto::TransactionRetryError* transaction_retry_;
-  ::proto::TransactionStatusError* transaction_status_;
-  ::proto::WriteIntentError* write_intent_;
-  ::proto::WriteTooOldError* write_too_old_;
-  ::proto::OpRequiresTxnError* op_requires_txn_;
-  ::proto::ConditionFailedError* condition_failed_;
+  ::google::protobuf::int64 raft_id_;
   friend void  protobuf_AddDesc_errors_2eproto();
   friend void protobuf_AssignDesc_errors_2eproto();
   friend void protobuf_ShutdownFile_errors_2eproto();
 
   void InitAsDefaultInstance();
-  static Error* default_instance_;
+  static RaftGroupDeletedError* default_instance_;
 };
-// ===================================================================
+// -------------------------------------------------------------------
 
+class StoreOverloadedError : public ::google::protobuf::Message {
+ public:
+  StoreOverloadedError();
+  virtual ~StoreOverloadedError();
 
-// ===================================================================
+  StoreOverloadedError(const StoreOverloadedError& from);
 
-// GenericError
+  inline StoreOverloadedError& operator=(const StoreOverloadedError& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-// optional string message = 1;
-inline bool GenericError::has_message() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void GenericError::set_has_message() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void GenericError::clear_has_message() {
-  _has_bits_[0] &= ~0x00000001u;
-}
-inline void GenericError::clear_message() {
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const StoreOverloadedError& default_instance();
+
+  void Swap(StoreOverloadedError* other);
+
+  // implements Message ----------------------------------------------
+
+  StoreOverloadedError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const StoreOverloadedError& from);
+  void MergeFrom(const StoreOverloadedError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional string method = 1;
+  inline bool has_method() const;
+  inline void clear_method();
+  static const int kMethodFieldNumber = 1;
+  inline const ::std::string& method() const;
+  inline void set_method(const ::std::string& value);
+  inline void set_method(const char* value);
+  inline void set_method(const char* value, size_t size);
+  inline ::std::string* mutable_method();
+  inline ::std::string* release_method();
+  inline void set_allocated_method(::std::string* method);
+
+  // @@protoc_insertion_point(class_scope:proto.StoreOverloadedError)
+ private:
+  inline void set_has_method();
+  inline void clear_has_method();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* method_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static StoreOverloadedError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class DeadlineExceededError : public ::google::protobuf::Message {
+ public:
+  DeadlineExceededError();
+  virtual ~DeadlineExceededError();
+
+  DeadlineExceededError(const DeadlineExceededError& from);
+
+  inline DeadlineExceededError& operator=(const DeadlineExceededError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const DeadlineExceededError& default_instance();
+
+  void Swap(DeadlineExceededError* other);
+
+  // implements Message ----------------------------------------------
+
+  DeadlineExceededError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const DeadlineExceededError& from);
+  void MergeFrom(const DeadlineExceededError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 deadline = 1;
+  inline bool has_deadline() const;
+  inline void clear_deadline();
+  static const int kDeadlineFieldNumber = 1;
+  inline ::google::protobuf::int64 deadline() const;
+  inline void set_deadline(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.DeadlineExceededError)
+ private:
+  inline void set_has_deadline();
+  inline void clear_has_deadline();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 deadline_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static DeadlineExceededError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ChecksumMismatchError : public ::google::protobuf::Message {
+ public:
+  ChecksumMismatchError();
+  virtual ~ChecksumMismatchError();
+
+  ChecksumMismatchError(const ChecksumMismatchError& from);
+
+  inline ChecksumMismatchError& operator=(const ChecksumMismatchError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ChecksumMismatchError& default_instance();
+
+  void Swap(ChecksumMismatchError* other);
+
+  // implements Message ----------------------------------------------
+
+  ChecksumMismatchError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ChecksumMismatchError& from);
+  void MergeFrom(const ChecksumMismatchError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // @@protoc_insertion_point(class_scope:proto.ChecksumMismatchError)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static ChecksumMismatchError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ProtectedKeyError : public ::google::protobuf::Message {
+ public:
+  ProtectedKeyError();
+  virtual ~ProtectedKeyError();
+
+  ProtectedKeyError(const ProtectedKeyError& from);
+
+  inline ProtectedKeyError& operator=(const ProtectedKeyError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ProtectedKeyError& default_instance();
+
+  void Swap(ProtectedKeyError* other);
+
+  // implements Message ----------------------------------------------
+
+  ProtectedKeyError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ProtectedKeyError& from);
+  void MergeFrom(const ProtectedKeyError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // @@protoc_insertion_point(class_scope:proto.ProtectedKeyError)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static ProtectedKeyError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class TooManyIntentsError : public ::google::protobuf::Message {
+ public:
+  TooManyIntentsError();
+  virtual ~TooManyIntentsError();
+
+  TooManyIntentsError(const TooManyIntentsError& from);
+
+  inline TooManyIntentsError& operator=(const TooManyIntentsError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const TooManyIntentsError& default_instance();
+
+  void Swap(TooManyIntentsError* other);
+
+  // implements Message ----------------------------------------------
+
+  TooManyIntentsError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const TooManyIntentsError& from);
+  void MergeFrom(const TooManyIntentsError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 raft_id = 1;
+  inline bool has_raft_id() const;
+  inline void clear_raft_id();
+  static const int kRaftIdFieldNumber = 1;
+  inline ::google::protobuf::int64 raft_id() const;
+  inline void set_raft_id(::google::protobuf::int64 value);
+
+  // optional int64 intent_count = 2;
+  inline bool has_intent_count() const;
+  inline void clear_intent_count();
+  static const int kIntentCountFieldNumber = 2;
+  inline ::google::protobuf::int64 intent_count() const;
+  inline void set_intent_count(::google::protobuf::int64 value);
+
+  // optional int64 max_intents = 3;
+  inline bool has_max_intents() const;
+  inline void clear_max_intents();
+  static const int kMaxIntentsFieldNumber = 3;
+  inline ::google::protobuf::int64 max_intents() const;
+  inline void set_max_intents(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.TooManyIntentsError)
+ private:
+  inline void set_has_raft_id();
+  inline void clear_has_raft_id();
+  inline void set_has_intent_count();
+  inline void clear_has_intent_count();
+  inline void set_has_max_intents();
+  inline void clear_has_max_intents();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 raft_id_;
+  ::google::protobuf::int64 intent_count_;
+  ::google::protobuf::int64 max_intents_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static TooManyIntentsError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class CommandTooLargeError : public ::google::protobuf::Message {
+ public:
+  CommandTooLargeError();
+  virtual ~CommandTooLargeError();
+
+  CommandTooLargeError(const CommandTooLargeError& from);
+
+  inline CommandTooLargeError& operator=(const CommandTooLargeError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const CommandTooLargeError& default_instance();
+
+  void Swap(CommandTooLargeError* other);
+
+  // implements Message ----------------------------------------------
+
+  CommandTooLargeError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const CommandTooLargeError& from);
+  void MergeFrom(const CommandTooLargeError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 size = 1;
+  inline bool has_size() const;
+  inline void clear_size();
+  static const int kSizeFieldNumber = 1;
+  inline ::google::protobuf::int64 size() const;
+  inline void set_size(::google::protobuf::int64 value);
+
+  // optional int64 max_size = 2;
+  inline bool has_max_size() const;
+  inline void clear_max_size();
+  static const int kMaxSizeFieldNumber = 2;
+  inline ::google::protobuf::int64 max_size() const;
+  inline void set_max_size(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.CommandTooLargeError)
+ private:
+  inline void set_has_size();
+  inline void clear_has_size();
+  inline void set_has_max_size();
+  inline void clear_has_max_size();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 size_;
+  ::google::protobuf::int64 max_size_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static CommandTooLargeError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ProposalBufferFullError : public ::google::protobuf::Message {
+ public:
+  ProposalBufferFullError();
+  virtual ~ProposalBufferFullError();
+
+  ProposalBufferFullError(const ProposalBufferFullError& from);
+
+  inline ProposalBufferFullError& operator=(const ProposalBufferFullError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ProposalBufferFullError& default_instance();
+
+  void Swap(ProposalBufferFullError* other);
+
+  // implements Message ----------------------------------------------
+
+  ProposalBufferFullError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ProposalBufferFullError& from);
+  void MergeFrom(const ProposalBufferFullError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 raft_id = 1;
+  inline bool has_raft_id() const;
+  inline void clear_raft_id();
+  static const int kRaftIdFieldNumber = 1;
+  inline ::google::protobuf::int64 raft_id() const;
+  inline void set_raft_id(::google::protobuf::int64 value);
+
+  // optional int64 pending_bytes = 2;
+  inline bool has_pending_bytes() const;
+  inline void clear_pending_bytes();
+  static const int kPendingBytesFieldNumber = 2;
+  inline ::google::protobuf::int64 pending_bytes() const;
+  inline void set_pending_bytes(::google::protobuf::int64 value);
+
+  // optional int64 max_bytes = 3;
+  inline bool has_max_bytes() const;
+  inline void clear_max_bytes();
+  static const int kMaxBytesFieldNumber = 3;
+  inline ::google::protobuf::int64 max_bytes() const;
+  inline void set_max_bytes(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.ProposalBufferFullError)
+ private:
+  inline void set_has_raft_id();
+  inline void clear_has_raft_id();
+  inline void set_has_pending_bytes();
+  inline void clear_has_pending_bytes();
+  inline void set_has_max_bytes();
+  inline void clear_has_max_bytes();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 raft_id_;
+  ::google::protobuf::int64 pending_bytes_;
+  ::google::protobuf::int64 max_bytes_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static ProposalBufferFullError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class TimestampTooFarError : public ::google::protobuf::Message {
+ public:
+  TimestampTooFarError();
+  virtual ~TimestampTooFarError();
+
+  TimestampTooFarError(const TimestampTooFarError& from);
+
+  inline TimestampTooFarError& operator=(const TimestampTooFarError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const TimestampTooFarError& default_instance();
+
+  void Swap(TimestampTooFarError* other);
+
+  // implements Message ----------------------------------------------
+
+  TimestampTooFarError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const TimestampTooFarError& from);
+  void MergeFrom(const TimestampTooFarError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.Timestamp timestamp = 1;
+  inline bool has_timestamp() const;
+  inline void clear_timestamp();
+  static const int kTimestampFieldNumber = 1;
+  inline const ::proto::Timestamp& timestamp() const;
+  inline ::proto::Timestamp* mutable_timestamp();
+  inline ::proto::Timestamp* release_timestamp();
+  inline void set_allocated_timestamp(::proto::Timestamp* timestamp);
+
+  // optional .proto.Timestamp max_timestamp = 2;
+  inline bool has_max_timestamp() const;
+  inline void clear_max_timestamp();
+  static const int kMaxTimestampFieldNumber = 2;
+  inline const ::proto::Timestamp& max_timestamp() const;
+  inline ::proto::Timestamp* mutable_max_timestamp();
+  inline ::proto::Timestamp* release_max_timestamp();
+  inline void set_allocated_max_timestamp(::proto::Timestamp* max_timestamp);
+
+  // @@protoc_insertion_point(class_scope:proto.TimestampTooFarError)
+ private:
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_max_timestamp();
+  inline void clear_has_max_timestamp();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::Timestamp* timestamp_;
+  ::proto::Timestamp* max_timestamp_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static TimestampTooFarError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class CommandQueueTimeoutError : public ::google::protobuf::Message {
+ public:
+  CommandQueueTimeoutError();
+  virtual ~CommandQueueTimeoutError();
+
+  CommandQueueTimeoutError(const CommandQueueTimeoutError& from);
+
+  inline CommandQueueTimeoutError& operator=(const CommandQueueTimeoutError& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const CommandQueueTimeoutError& default_instance();
+
+  void Swap(CommandQueueTimeoutError* other);
+
+  // implements Message ----------------------------------------------
+
+  CommandQueueTimeoutError* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const CommandQueueTimeoutError& from);
+  void MergeFrom(const CommandQueueTimeoutError& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // optional bytes end_key = 2;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 2;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // optional int64 timeout = 3;
+  inline bool has_timeout() const;
+  inline void clear_timeout();
+  static const int kTimeoutFieldNumber = 3;
+  inline ::google::protobuf::int64 timeout() const;
+  inline void set_timeout(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.CommandQueueTimeoutError)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+  inline void set_has_timeout();
+  inline void clear_has_timeout();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  ::std::string* end_key_;
+  ::google::protobuf::int64 timeout_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static CommandQueueTimeoutError* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class Error : public ::google::protobuf::Message {
+ public:
+  Error();
+  virtual ~Error();
+
+  Error(const Error& from);
+
+  inline Error& operator=(const Error& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const Error& default_instance();
+
+  void Swap(Error* other);
+
+  // implements Message ----------------------------------------------
+
+  Error* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const Error& from);
+  void MergeFrom(const Error& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.GenericError generic = 1;
+  inline bool has_generic() const;
+  inline void clear_generic();
+  static const int kGenericFieldNumber = 1;
+  inline const ::proto::GenericError& generic() const;
+  inline ::proto::GenericError* mutable_generic();
+  inline ::proto::GenericError* release_generic();
+  inline void set_allocated_generic(::proto::GenericError* generic);
+
+  // optional .proto.NotLeaderError not_leader = 2;
+  inline bool has_not_leader() const;
+  inline void clear_not_leader();
+  static const int kNotLeaderFieldNumber = 2;
+  inline const ::proto::NotLeaderError& not_leader() const;
+  inline ::proto::NotLeaderError* mutable_not_leader();
+  inline ::proto::NotLeaderError* release_not_leader();
+  inline void set_allocated_not_leader(::proto::NotLeaderError* not_leader);
+
+  // optional .proto.RangeNotFoundError range_not_found = 3;
+  inline bool has_range_not_found() const;
+  inline void clear_range_not_found();
+  static const int kRangeNotFoundFieldNumber = 3;
+  inline const ::proto::RangeNotFoundError& range_not_found() const;
+  inline ::proto::RangeNotFoundError* mutable_range_not_found();
+  inline ::proto::RangeNotFoundError* release_range_not_found();
+  inline void set_allocated_range_not_found(::proto::RangeNotFoundError* range_not_found);
+
+  // optional .proto.RangeKeyMismatchError range_key_mismatch = 4;
+  inline bool has_range_key_mismatch() const;
+  inline void clear_range_key_mismatch();
+  static const int kRangeKeyMismatchFieldNumber = 4;
+  inline const ::proto::RangeKeyMismatchError& range_key_mismatch() const;
+  inline ::proto::RangeKeyMismatchError* mutable_range_key_mismatch();
+  inline ::proto::RangeKeyMismatchError* release_range_key_mismatch();
+  inline void set_allocated_range_key_mismatch(::proto::RangeKeyMismatchError* range_key_mismatch);
+
+  // optional .proto.ReadWithinUncertaintyIntervalError read_within_uncertainty_interval = 5;
+  inline bool has_read_within_uncertainty_interval() const;
+  inline void clear_read_within_uncertainty_interval();
+  static const int kReadWithinUncertaintyIntervalFieldNumber = 5;
+  inline const ::proto::ReadWithinUncertaintyIntervalError& read_within_uncertainty_interval() const;
+  inline ::proto::ReadWithinUncertaintyIntervalError* mutable_read_within_uncertainty_interval();
+  inline ::proto::ReadWithinUncertaintyIntervalError* release_read_within_uncertainty_interval();
+  inline void set_allocated_read_within_uncertainty_interval(::proto::ReadWithinUncertaintyIntervalError* read_within_uncertainty_interval);
+
+  // optional .proto.TransactionAbortedError transaction_aborted = 6;
+  inline bool has_transaction_aborted() const;
+  inline void clear_transaction_aborted();
+  static const int kTransactionAbortedFieldNumber = 6;
+  inline const ::proto::TransactionAbortedError& transaction_aborted() const;
+  inline ::proto::TransactionAbortedError* mutable_transaction_aborted();
+  inline ::proto::TransactionAbortedError* release_transaction_aborted();
+  inline void set_allocated_transaction_aborted(::proto::TransactionAbortedError* transaction_aborted);
+
+  // optional .proto.TransactionPushError transaction_push = 7;
+  inline bool has_transaction_push() const;
+  inline void clear_transaction_push();
+  static const int kTransactionPushFieldNumber = 7;
+  inline const ::proto::TransactionPushError& transaction_push() const;
+  inline ::proto::TransactionPushError* mutable_transaction_push();
+  inline ::proto::TransactionPushError* release_transaction_push();
+  inline void set_allocated_transaction_push(::proto::TransactionPushError* transaction_push);
+
+  // optional .proto.TransactionRetryError transaction_retry = 8;
+  inline bool has_transaction_retry() const;
+  inline void clear_transaction_retry();
+  static const int kTransactionRetryFieldNumber = 8;
+  inline const ::proto::TransactionRetryError& transaction_retry() const;
+  inline ::proto::TransactionRetryError* mutable_transaction_retry();
+  inline ::proto::TransactionRetryError* release_transaction_retry();
+  inline void set_allocated_transaction_retry(::proto::TransactionRetryError* transaction_retry);
+
+  // optional .proto.TransactionStatusError transaction_status = 9;
+  inline bool has_transaction_status() const;
+  inline void clear_transaction_status();
+  static const int kTransactionStatusFieldNumber = 9;
+  inline const ::proto::TransactionStatusError& transaction_status() const;
+  inline ::proto::TransactionStatusError* mutable_transaction_status();
+  inline ::proto::TransactionStatusError* release_transaction_status();
+  inline void set_allocated_transaction_status(::proto::TransactionStatusError* transaction_status);
+
+  // optional .proto.WriteIntentError write_intent = 10;
+  inline bool has_write_intent() const;
+  inline void clear_write_intent();
+  static const int kWriteIntentFieldNumber = 10;
+  inline const ::proto::WriteIntentError& write_intent() const;
+  inline ::proto::WriteIntentError* mutable_write_intent();
+  inline ::proto::WriteIntentError* release_write_intent();
+  inline void set_allocated_write_intent(::proto::WriteIntentError* write_intent);
+
+  // optional .proto.WriteTooOldError write_too_old = 11;
+  inline bool has_write_too_old() const;
+  inline void clear_write_too_old();
+  static const int kWriteTooOldFieldNumber = 11;
+  inline const ::proto::WriteTooOldError& write_too_old() const;
+  inline ::proto::WriteTooOldError* mutable_write_too_old();
+  inline ::proto::WriteTooOldError* release_write_too_old();
+  inline void set_allocated_write_too_old(::proto::WriteTooOldError* write_too_old);
+
+  // optional .proto.OpRequiresTxnError op_requires_txn = 12;
+  inline bool has_op_requires_txn() const;
+  inline void clear_op_requires_txn();
+  static const int kOpRequiresTxnFieldNumber = 12;
+  inline const ::proto::OpRequiresTxnError& op_requires_txn() const;
+  inline ::proto::OpRequiresTxnError* mutable_op_requires_txn();
+  inline ::proto::OpRequiresTxnError* release_op_requires_txn();
+  inline void set_allocated_op_requires_txn(::proto::OpRequiresTxnError* op_requires_txn);
+
+  // optional .proto.ConditionFailedError condition_failed = 13;
+  inline bool has_condition_failed() const;
+  inline void clear_condition_failed();
+  static const int kConditionFailedFieldNumber = 13;
+  inline const ::proto::ConditionFailedError& condition_failed() const;
+  inline ::proto::ConditionFailedError* mutable_condition_failed();
+  inline ::proto::ConditionFailedError* release_condition_failed();
+  inline void set_allocated_condition_failed(::proto::ConditionFailedError* condition_failed);
+
+  // optional .proto.ConflictTimeoutError conflict_timeout = 14;
+  inline bool has_conflict_timeout() const;
+  inline void clear_conflict_timeout();
+  static const int kConflictTimeoutFieldNumber = 14;
+  inline const ::proto::ConflictTimeoutError& conflict_timeout() const;
+  inline ::proto::ConflictTimeoutError* mutable_conflict_timeout();
+  inline ::proto::ConflictTimeoutError* release_conflict_timeout();
+  inline void set_allocated_conflict_timeout(::proto::ConflictTimeoutError* conflict_timeout);
+
+  // optional .proto.RaftGroupDeletedError raft_group_deleted = 15;
+  inline bool has_raft_group_deleted() const;
+  inline void clear_raft_group_deleted();
+  static const int kRaftGroupDeletedFieldNumber = 15;
+  inline const ::proto::RaftGroupDeletedError& raft_group_deleted() const;
+  inline ::proto::RaftGroupDeletedError* mutable_raft_group_deleted();
+  inline ::proto::RaftGroupDeletedError* release_raft_group_deleted();
+  inline void set_allocated_raft_group_deleted(::proto::RaftGroupDeletedError* raft_group_deleted);
+
+  // optional .proto.StoreOverloadedError store_overloaded = 16;
+  inline bool has_store_overloaded() const;
+  inline void clear_store_overloaded();
+  static const int kStoreOverloadedFieldNumber = 16;
+  inline const ::proto::StoreOverloadedError& store_overloaded() const;
+  inline ::proto::StoreOverloadedError* mutable_store_overloaded();
+  inline ::proto::StoreOverloadedError* release_store_overloaded();
+  inline void set_allocated_store_overloaded(::proto::StoreOverloadedError* store_overloaded);
+
+  // optional .proto.DeadlineExceededError deadline_exceeded = 17;
+  inline bool has_deadline_exceeded() const;
+  inline void clear_deadline_exceeded();
+  static const int kDeadlineExceededFieldNumber = 17;
+  inline const ::proto::DeadlineExceededError& deadline_exceeded() const;
+  inline ::proto::DeadlineExceededError* mutable_deadline_exceeded();
+  inline ::proto::DeadlineExceededError* release_deadline_exceeded();
+  inline void set_allocated_deadline_exceeded(::proto::DeadlineExceededError* deadline_exceeded);
+
+  // optional .proto.ChecksumMismatchError checksum_mismatch = 18;
+  inline bool has_checksum_mismatch() const;
+  inline void clear_checksum_mismatch();
+  static const int kChecksumMismatchFieldNumber = 18;
+  inline const ::proto::ChecksumMismatchError& checksum_mismatch() const;
+  inline ::proto::ChecksumMismatchError* mutable_checksum_mismatch();
+  inline ::proto::ChecksumMismatchError* release_checksum_mismatch();
+  inline void set_allocated_checksum_mismatch(::proto::ChecksumMismatchError* checksum_mismatch);
+
+  // optional .proto.ProtectedKeyError protected_key = 19;
+  inline bool has_protected_key() const;
+  inline void clear_protected_key();
+  static const int kProtectedKeyFieldNumber = 19;
+  inline const ::proto::ProtectedKeyError& protected_key() const;
+  inline ::proto::ProtectedKeyError* mutable_protected_key();
+  inline ::proto::ProtectedKeyError* release_protected_key();
+  inline void set_allocated_protected_key(::proto::ProtectedKeyError* protected_key);
+
+  // optional .proto.TooManyIntentsError too_many_intents = 20;
+  inline bool has_too_many_intents() const;
+  inline void clear_too_many_intents();
+  static const int kTooManyIntentsFieldNumber = 20;
+  inline const ::proto::TooManyIntentsError& too_many_intents() const;
+  inline ::proto::TooManyIntentsError* mutable_too_many_intents();
+  inline ::proto::TooManyIntentsError* release_too_many_intents();
+  inline void set_allocated_too_many_intents(::proto::TooManyIntentsError* too_many_intents);
+
+  // optional .proto.CommandTooLargeError command_too_large = 21;
+  inline bool has_command_too_large() const;
+  inline void clear_command_too_large();
+  static const int kCommandTooLargeFieldNumber = 21;
+  inline const ::proto::CommandTooLargeError& command_too_large() const;
+  inline ::proto::CommandTooLargeError* mutable_command_too_large();
+  inline ::proto::CommandTooLargeError* release_command_too_large();
+  inline void set_allocated_command_too_large(::proto::CommandTooLargeError* command_too_large);
+
+  // optional .proto.ProposalBufferFullError proposal_buffer_full = 22;
+  inline bool has_proposal_buffer_full() const;
+  inline void clear_proposal_buffer_full();
+  static const int kProposalBufferFullFieldNumber = 22;
+  inline const ::proto::ProposalBufferFullError& proposal_buffer_full() const;
+  inline ::proto::ProposalBufferFullError* mutable_proposal_buffer_full();
+  inline ::proto::ProposalBufferFullError* release_proposal_buffer_full();
+  inline void set_allocated_proposal_buffer_full(::proto::ProposalBufferFullError* proposal_buffer_full);
+
+  // optional .proto.TimestampTooFarError timestamp_too_far = 23;
+  inline bool has_timestamp_too_far() const;
+  inline void clear_timestamp_too_far();
+  static const int kTimestampTooFarFieldNumber = 23;
+  inline const ::proto::TimestampTooFarError& timestamp_too_far() const;
+  inline ::proto::TimestampTooFarError* mutable_timestamp_too_far();
+  inline ::proto::TimestampTooFarError* release_timestamp_too_far();
+  inline void set_allocated_timestamp_too_far(::proto::TimestampTooFarError* timestamp_too_far);
+
+  // optional .proto.CommandQueueTimeoutError command_queue_timeout = 24;
+  inline bool has_command_queue_timeout() const;
+  inline void clear_command_queue_timeout();
+  static const int kCommandQueueTimeoutFieldNumber = 24;
+  inline const ::proto::CommandQueueTimeoutError& command_queue_timeout() const;
+  inline ::proto::CommandQueueTimeoutError* mutable_command_queue_timeout();
+  inline ::proto::CommandQueueTimeoutError* release_command_queue_timeout();
+  inline void set_allocated_command_queue_timeout(::proto::CommandQueueTimeoutError* command_queue_timeout);
+
+  // @@protoc_insertion_point(class_scope:proto.Error)
+ private:
+  inline void set_has_generic();
+  inline void clear_has_generic();
+  inline void set_has_not_leader();
+  inline void clear_has_not_leader();
+  inline void set_has_range_not_found();
+  inline void clear_has_range_not_found();
+  inline void set_has_range_key_mismatch();
+  inline void clear_has_range_key_mismatch();
+  inline void set_has_read_within_uncertainty_interval();
+  inline void clear_has_read_within_uncertainty_interval();
+  inline void set_has_transaction_aborted();
+  inline void clear_has_transaction_aborted();
+  inline void set_has_transaction_push();
+  inline void clear_has_transaction_push();
+  inline void set_has_transaction_retry();
+  inline void clear_has_transaction_retry();
+  inline void set_has_transaction_status();
+  inline void clear_has_transaction_status();
+  inline void set_has_write_intent();
+  inline void clear_has_write_intent();
+  inline void set_has_write_too_old();
+  inline void clear_has_write_too_old();
+  inline void set_has_op_requires_txn();
+  inline void clear_has_op_requires_txn();
+  inline void set_has_condition_failed();
+  inline void clear_has_condition_failed();
+  inline void set_has_conflict_timeout();
+  inline void clear_has_conflict_timeout();
+  inline void set_has_raft_group_deleted();
+  inline void clear_has_raft_group_deleted();
+  inline void set_has_store_overloaded();
+  inline void clear_has_store_overloaded();
+  inline void set_has_deadline_exceeded();
+  inline void clear_has_deadline_exceeded();
+  inline void set_has_checksum_mismatch();
+  inline void clear_has_checksum_mismatch();
+  inline void set_has_protected_key();
+  inline void clear_has_protected_key();
+  inline void set_has_too_many_intents();
+  inline void clear_has_too_many_intents();
+  inline void set_has_command_too_large();
+  inline void clear_has_command_too_large();
+  inline void set_has_proposal_buffer_full();
+  inline void clear_has_proposal_buffer_full();
+  inline void set_has_timestamp_too_far();
+  inline void clear_has_timestamp_too_far();
+  inline void set_has_command_queue_timeout();
+  inline void clear_has_command_queue_timeout();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::GenericError* generic_;
+  ::proto::NotLeaderError* not_leader_;
+  ::proto::RangeNotFoundError* range_not_found_;
+  ::proto::RangeKeyMismatchError* range_key_mismatch_;
+  ::proto::ReadWithinUncertaintyIntervalError* read_within_uncertainty_interval_;
+  ::proto::TransactionAbortedError* transaction_aborted_;
+  ::proto::TransactionPushError* transaction_push_;
+  ::proto::TransactionRetryError* transaction_retry_;
+  ::proto::TransactionStatusError* transaction_status_;
+  ::proto::WriteIntentError* write_intent_;
+  ::proto::WriteTooOldError* write_too_old_;
+  ::proto::OpRequiresTxnError* op_requires_txn_;
+  ::proto::ConditionFailedError* condition_failed_;
+  ::proto::ConflictTimeoutError* conflict_timeout_;
+  ::proto::RaftGroupDeletedError* raft_group_deleted_;
+  ::proto::StoreOverloadedError* store_overloaded_;
+  ::proto::DeadlineExceededError* deadline_exceeded_;
+  ::proto::ChecksumMismatchError* checksum_mismatch_;
+  ::proto::ProtectedKeyError* protected_key_;
+  ::proto::TooManyIntentsError* too_many_intents_;
+  ::proto::CommandTooLargeError* command_too_large_;
+  ::proto::ProposalBufferFullError* proposal_buffer_full_;
+  ::proto::TimestampTooFarError* timestamp_too_far_;
+  ::proto::CommandQueueTimeoutError* command_queue_timeout_;
+  friend void  protobuf_AddDesc_errors_2eproto();
+  friend void protobuf_AssignDesc_errors_2eproto();
+  friend void protobuf_ShutdownFile_errors_2eproto();
+
+  void InitAsDefaultInstance();
+  static Error* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+// GenericError
+
+// optional string message = 1;
+inline bool GenericError::has_message() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GenericError::set_has_message() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GenericError::clear_has_message() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GenericError::clear_message() {
   if (message_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     message_->clear();
   }
-  clear_has_message();
+  clear_has_message();
+}
+inline const ::std::string& GenericError::message() const {
+  // @@protoc_insertion_point(field_get:proto.GenericError.message)
+  return *message_;
+}
+inline void GenericError::set_message(const ::std::string& value) {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  message_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.GenericError.message)
+}
+inline void GenericError::set_message(const char* value) {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  message_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.GenericError.message)
+}
+inline void GenericError::set_message(const char* value, size_t size) {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  message_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.GenericError.message)
+}
+inline ::std::string* GenericError::mutable_message() {
+  set_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    message_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.GenericError.message)
+  return message_;
+}
+inline ::std::string* GenericError::release_message() {
+  clear_has_message();
+  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = message_;
+    message_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void GenericError::set_allocated_message(::std::string* message) {
+  if (message_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete message_;
+  }
+  if (message) {
+    set_has_message();
+    message_ = message;
+  } else {
+    clear_has_message();
+    message_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.GenericError.message)
+}
+
+// optional bool retryable = 2;
+inline bool GenericError::has_retryable() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void GenericError::set_has_retryable() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void GenericError::clear_has_retryable() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void GenericError::clear_retryable() {
+  retryable_ = false;
+  clear_has_retryable();
+}
+inline bool GenericError::retryable() const {
+  // @@protoc_insertion_point(field_get:proto.GenericError.retryable)
+  return retryable_;
+}
+inline void GenericError::set_retryable(bool value) {
+  set_has_retryable();
+  retryable_ = value;
+  // @@protoc_insertion_point(field_set:proto.GenericError.retryable)
+}
+
+// -------------------------------------------------------------------
+
+// NotLeaderError
+
+// optional .proto.Replica leader = 1;
+inline bool NotLeaderError::has_leader() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void NotLeaderError::set_has_leader() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void NotLeaderError::clear_has_leader() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void NotLeaderError::clear_leader() {
+  if (leader_ != NULL) leader_->::proto::Replica::Clear();
+  clear_has_leader();
+}
+inline const ::proto::Replica& NotLeaderError::leader() const {
+  // @@protoc_insertion_point(field_get:proto.NotLeaderError.leader)
+  return leader_ != NULL ? *leader_ : *default_instance_->leader_;
+}
+inline ::proto::Replica* NotLeaderError::mutable_leader() {
+  set_has_leader();
+  if (leader_ == NULL) leader_ = new ::proto::Replica;
+  // @@protoc_insertion_point(field_mutable:proto.NotLeaderError.leader)
+  return leader_;
+}
+inline ::proto::Replica* NotLeaderError::release_leader() {
+  clear_has_leader();
+  ::proto::Replica* temp = leader_;
+  leader_ = NULL;
+  return temp;
+}
+inline void NotLeaderError::set_allocated_leader(::proto::Replica* leader) {
+  delete leader_;
+  leader_ = leader;
+  if (leader) {
+    set_has_leader();
+  } else {
+    clear_has_leader();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.NotLeaderError.leader)
+}
+
+// -------------------------------------------------------------------
+
+// RangeNotFoundError
+
+// optional int64 raft_id = 1;
+inline bool RangeNotFoundError::has_raft_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RangeNotFoundError::set_has_raft_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RangeNotFoundError::clear_has_raft_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RangeNotFoundError::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
+}
+inline ::google::protobuf::int64 RangeNotFoundError::raft_id() const {
+  // @@protoc_insertion_point(field_get:proto.RangeNotFoundError.raft_id)
+  return raft_id_;
+}
+inline void RangeNotFoundError::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:proto.RangeNotFoundError.raft_id)
+}
+
+// -------------------------------------------------------------------
+
+// RangeKeyMismatchError
+
+// optional bytes request_start_key = 1;
+inline bool RangeKeyMismatchError::has_request_start_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RangeKeyMismatchError::set_has_request_start_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RangeKeyMismatchError::clear_has_request_start_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RangeKeyMismatchError::clear_request_start_key() {
+  if (request_start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_start_key_->clear();
+  }
+  clear_has_request_start_key();
+}
+inline const ::std::string& RangeKeyMismatchError::request_start_key() const {
+  // @@protoc_insertion_point(field_get:proto.RangeKeyMismatchError.request_start_key)
+  return *request_start_key_;
+}
+inline void RangeKeyMismatchError::set_request_start_key(const ::std::string& value) {
+  set_has_request_start_key();
+  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_start_key_ = new ::std::string;
+  }
+  request_start_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.RangeKeyMismatchError.request_start_key)
+}
+inline void RangeKeyMismatchError::set_request_start_key(const char* value) {
+  set_has_request_start_key();
+  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_start_key_ = new ::std::string;
+  }
+  request_start_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.RangeKeyMismatchError.request_start_key)
+}
+inline void RangeKeyMismatchError::set_request_start_key(const void* value, size_t size) {
+  set_has_request_start_key();
+  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_start_key_ = new ::std::string;
+  }
+  request_start_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.RangeKeyMismatchError.request_start_key)
+}
+inline ::std::string* RangeKeyMismatchError::mutable_request_start_key() {
+  set_has_request_start_key();
+  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_start_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.RangeKeyMismatchError.request_start_key)
+  return request_start_key_;
+}
+inline ::std::string* RangeKeyMismatchError::release_request_start_key() {
+  clear_has_request_start_key();
+  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = request_start_key_;
+    request_start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void RangeKeyMismatchError::set_allocated_request_start_key(::std::string* request_start_key) {
+  if (request_start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete request_start_key_;
+  }
+  if (request_start_key) {
+    set_has_request_start_key();
+    request_start_key_ = request_start_key;
+  } else {
+    clear_has_request_start_key();
+    request_start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.RangeKeyMismatchError.request_start_key)
+}
+
+// optional bytes request_end_key = 2;
+inline bool RangeKeyMismatchError::has_request_end_key() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RangeKeyMismatchError::set_has_request_end_key() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RangeKeyMismatchError::clear_has_request_end_key() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RangeKeyMismatchError::clear_request_end_key() {
+  if (request_end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_end_key_->clear();
+  }
+  clear_has_request_end_key();
+}
+inline const ::std::string& RangeKeyMismatchError::request_end_key() const {
+  // @@protoc_insertion_point(field_get:proto.RangeKeyMismatchError.request_end_key)
+  return *request_end_key_;
+}
+inline void RangeKeyMismatchError::set_request_end_key(const ::std::string& value) {
+  set_has_request_end_key();
+  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_end_key_ = new ::std::string;
+  }
+  request_end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.RangeKeyMismatchError.request_end_key)
+}
+inline void RangeKeyMismatchError::set_request_end_key(const char* value) {
+  set_has_request_end_key();
+  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_end_key_ = new ::std::string;
+  }
+  request_end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.RangeKeyMismatchError.request_end_key)
+}
+inline void RangeKeyMismatchError::set_request_end_key(const void* value, size_t size) {
+  set_has_request_end_key();
+  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_end_key_ = new ::std::string;
+  }
+  request_end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.RangeKeyMismatchError.request_end_key)
+}
+inline ::std::string* RangeKeyMismatchError::mutable_request_end_key() {
+  set_has_request_end_key();
+  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    request_end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.RangeKeyMismatchError.request_end_key)
+  return request_end_key_;
+}
+inline ::std::string* RangeKeyMismatchError::release_request_end_key() {
+  clear_has_request_end_key();
+  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = request_end_key_;
+    request_end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void RangeKeyMismatchError::set_allocated_request_end_key(::std::string* request_end_key) {
+  if (request_end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete request_end_key_;
+  }
+  if (request_end_key) {
+    set_has_request_end_key();
+    request_end_key_ = request_end_key;
+  } else {
+    clear_has_request_end_key();
+    request_end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.RangeKeyMismatchError.request_end_key)
+}
+
+// optional .proto.RangeDescriptor range = 3;
+inline bool RangeKeyMismatchError::has_range() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RangeKeyMismatchError::set_has_range() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RangeKeyMismatchError::clear_has_range() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RangeKeyMismatchError::clear_range() {
+  if (range_ != NULL) range_->::proto::RangeDescriptor::Clear();
+  clear_has_range();
+}
+inline const ::proto::RangeDescriptor& RangeKeyMismatchError::range() const {
+  // @@protoc_insertion_point(field_get:proto.RangeKeyMismatchError.range)
+  return range_ != NULL ? *range_ : *default_instance_->range_;
+}
+inline ::proto::RangeDescriptor* RangeKeyMismatchError::mutable_range() {
+  set_has_range();
+  if (range_ == NULL) range_ = new ::proto::RangeDescriptor;
+  // @@protoc_insertion_point(field_mutable:proto.RangeKeyMismatchError.range)
+  return range_;
+}
+inline ::proto::RangeDescriptor* RangeKeyMismatchError::release_range() {
+  clear_has_range();
+  ::proto::RangeDescriptor* temp = range_;
+  range_ = NULL;
+  return temp;
+}
+inline void RangeKeyMismatchError::set_allocated_range(::proto::RangeDescriptor* range) {
+  delete range_;
+  range_ = range;
+  if (range) {
+    set_has_range();
+  } else {
+    clear_has_range();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.RangeKeyMismatchError.range)
+}
+
+// -------------------------------------------------------------------
+
+// ReadWithinUncertaintyIntervalError
+
+// optional .proto.Timestamp timestamp = 1;
+inline bool ReadWithinUncertaintyIntervalError::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ReadWithinUncertaintyIntervalError::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ReadWithinUncertaintyIntervalError::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ReadWithinUncertaintyIntervalError::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::proto::Timestamp& ReadWithinUncertaintyIntervalError::timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWithinUncertaintyIntervalError.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWithinUncertaintyIntervalError.timestamp)
+  return timestamp_;
+}
+inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::release_timestamp() {
+  clear_has_timestamp();
+  ::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void ReadWithinUncertaintyIntervalError::set_allocated_timestamp(::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWithinUncertaintyIntervalError.timestamp)
 }
-inline const ::std::string& GenericError::message() const {
-  // @@protoc_insertion_point(field_get:proto.GenericError.message)
-  return *message_;
+
+// optional .proto.Timestamp existing_timestamp = 2;
+inline bool ReadWithinUncertaintyIntervalError::has_existing_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void GenericError::set_message(const ::std::string& value) {
-  set_has_message();
-  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    message_ = new ::std::string;
-  }
-  message_->assign(value);
-  // @@protoc_insertion_point(field_set:proto.GenericError.message)
+inline void ReadWithinUncertaintyIntervalError::set_has_existing_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void GenericError::set_message(const char* value) {
-  set_has_message();
-  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    message_ = new ::std::string;
-  }
-  message_->assign(value);
-  // @@protoc_insertion_point(field_set_char:proto.GenericError.message)
+inline void ReadWithinUncertaintyIntervalError::clear_has_existing_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void GenericError::set_message(const char* value, size_t size) {
-  set_has_message();
-  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    message_ = new ::std::string;
-  }
-  message_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:proto.GenericError.message)
+inline void ReadWithinUncertaintyIntervalError::clear_existing_timestamp() {
+  if (existing_timestamp_ != NULL) existing_timestamp_->::proto::Timestamp::Clear();
+  clear_has_existing_timestamp();
 }
-inline ::std::string* GenericError::mutable_message() {
-  set_has_message();
-  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    message_ = new ::std::string;
-  }
-  // @@protoc_insertion_point(field_mutable:proto.GenericError.message)
-  return message_;
+inline const ::proto::Timestamp& ReadWithinUncertaintyIntervalError::existing_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWithinUncertaintyIntervalError.existing_timestamp)
+  return existing_timestamp_ != NULL ? *existing_timestamp_ : *default_instance_->existing_timestamp_;
 }
-inline ::std::string* GenericError::release_message() {
-  clear_has_message();
-  if (message_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    return NULL;
+inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::mutable_existing_timestamp() {
+  set_has_existing_timestamp();
+  if (existing_timestamp_ == NULL) existing_timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWithinUncertaintyIntervalError.existing_timestamp)
+  return existing_timestamp_;
+}
+inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::release_existing_timestamp() {
+  clear_has_existing_timestamp();
+  ::proto::Timestamp* temp = existing_timestamp_;
+  existing_timestamp_ = NULL;
+  return temp;
+}
+inline void ReadWithinUncertaintyIntervalError::set_allocated_existing_timestamp(::proto::Timestamp* existing_timestamp) {
+  delete existing_timestamp_;
+  existing_timestamp_ = existing_timestamp;
+  if (existing_timestamp) {
+    set_has_existing_timestamp();
   } else {
-    ::std::string* temp = message_;
-    message_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-    return temp;
+    clear_has_existing_timestamp();
   }
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWithinUncertaintyIntervalError.existing_timestamp)
 }
-inline void GenericError::set_allocated_message(::std::string* message) {
-  if (message_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete message_;
+
+// -------------------------------------------------------------------
+
+// TransactionAbortedError
+
+// optional .proto.Transaction txn = 1;
+inline bool TransactionAbortedError::has_txn() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void TransactionAbortedError::set_has_txn() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void TransactionAbortedError::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void TransactionAbortedError::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::proto::Transaction& TransactionAbortedError::txn() const {
+  // @@protoc_insertion_point(field_get:proto.TransactionAbortedError.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::proto::Transaction* TransactionAbortedError::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.TransactionAbortedError.txn)
+  return txn_;
+}
+inline ::proto::Transaction* TransactionAbortedError::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void TransactionAbortedError::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
   }
-  if (message) {
-    set_has_message();
-    message_ = message;
+  // @@protoc_insertion_point(field_set_allocated:proto.TransactionAbortedError.txn)
+}
+
+// -------------------------------------------------------------------
+
+// TransactionPushError
+
+// optional .proto.Transaction txn = 1;
+inline bool TransactionPushError::has_txn() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void TransactionPushError::set_has_txn() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void TransactionPushError::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void TransactionPushError::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::proto::Transaction& TransactionPushError::txn() const {
+  // @@protoc_insertion_point(field_get:proto.TransactionPushError.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::proto::Transaction* TransactionPushError::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.TransactionPushError.txn)
+  return txn_;
+}
+inline ::proto::Transaction* TransactionPushError::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void TransactionPushError::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
   } else {
-    clear_has_message();
-    message_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.GenericError.message)
+  // @@protoc_insertion_point(field_set_allocated:proto.TransactionPushError.txn)
 }
 
-// optional bool retryable = 2;
-inline bool GenericError::has_retryable() const {
+// optional .proto.Transaction pushee_txn = 2;
+inline bool TransactionPushError::has_pushee_txn() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void GenericError::set_has_retryable() {
+inline void TransactionPushError::set_has_pushee_txn() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void GenericError::clear_has_retryable() {
+inline void TransactionPushError::clear_has_pushee_txn() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void GenericError::clear_retryable() {
-  retryable_ = false;
-  clear_has_retryable();
+inline void TransactionPushError::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::proto::Transaction::Clear();
+  clear_has_pushee_txn();
 }
-inline bool GenericError::retryable() const {
-  // @@protoc_insertion_point(field_get:proto.GenericError.retryable)
-  return retryable_;
+inline const ::proto::Transaction& TransactionPushError::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:proto.TransactionPushError.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
 }
-inline void GenericError::set_retryable(bool value) {
-  set_has_retryable();
-  retryable_ = value;
-  // @@protoc_insertion_point(field_set:proto.GenericError.retryable)
+inline ::proto::Transaction* TransactionPushError::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) pushee_txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.TransactionPushError.pushee_txn)
+  return pushee_txn_;
+}
+inline ::proto::Transaction* TransactionPushError::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::proto::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+inline void TransactionPushError::set_allocated_pushee_txn(::proto::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.TransactionPushError.pushee_txn)
+}
+
+// optional int32 pushee_priority = 3;
+inline bool TransactionPushError::has_pushee_priority() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void TransactionPushError::set_has_pushee_priority() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void TransactionPushError::clear_has_pushee_priority() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void TransactionPushError::clear_pushee_priority() {
+  pushee_priority_ = 0;
+  clear_has_pushee_priority();
+}
+inline ::google::protobuf::int32 TransactionPushError::pushee_priority() const {
+  // @@protoc_insertion_point(field_get:proto.TransactionPushError.pushee_priority)
+  return pushee_priority_;
+}
+inline void TransactionPushError::set_pushee_priority(::google::protobuf::int32 value) {
+  set_has_pushee_priority();
+  pushee_priority_ = value;
+  // @@protoc_insertion_point(field_set:proto.TransactionPushError.pushee_priority)
 }
 
 // -------------------------------------------------------------------
 
-// NotLeaderError
+// TransactionRetryError
 
-// optional .proto.Replica leader = 1;
-inline bool NotLeaderError::has_leader() const {
+// optional .proto.Transaction txn = 1;
+inline bool TransactionRetryError::has_txn() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void NotLeaderError::set_has_leader() {
+inline void TransactionRetryError::set_has_txn() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void NotLeaderError::clear_has_leader() {
+inline void TransactionRetryError::clear_has_txn() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void NotLeaderError::clear_leader() {
-  if (leader_ != NULL) leader_->::proto::Replica::Clear();
-  clear_has_leader();
+inline void TransactionRetryError::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
 }
-inline const ::proto::Replica& NotLeaderError::leader() const {
-  // @@protoc_insertion_point(field_get:proto.NotLeaderError.leader)
-  return leader_ != NULL ? *leader_ : *default_instance_->leader_;
+inline const ::proto::Transaction& TransactionRetryError::txn() const {
+  // @@protoc_insertion_point(field_get:proto.TransactionRetryError.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
 }
-inline ::proto::Replica* NotLeaderError::mutable_leader() {
-  set_has_leader();
-  if (leader_ == NULL) leader_ = new ::proto::Replica;
-  // @@protoc_insertion_point(field_mutable:proto.NotLeaderError.leader)
-  return leader_;
+inline ::proto::Transaction* TransactionRetryError::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.TransactionRetryError.txn)
+  return txn_;
 }
-inline ::proto::Replica* NotLeaderError::release_leader() {
-  clear_has_leader();
-  ::proto::Replica* temp = leader_;
-  leader_ = NULL;
+inline ::proto::Transaction* TransactionRetryError::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
   return temp;
 }
-inline void NotLeaderError::set_allocated_leader(::proto::Replica* leader) {
-  delete leader_;
-  leader_ = leader;
-  if (leader) {
-    set_has_leader();
+inline void TransactionRetryError::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
   } else {
-    clear_has_leader();
+    clear_has_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.NotLeaderError.leader)
+  // @@protoc_insertion_point(field_set_allocated:proto.TransactionRetryError.txn)
 }
 
 // -------------------------------------------------------------------
 
-// RangeNotFoundError
+// TransactionStatusError
 
-// optional int64 raft_id = 1;
-inline bool RangeNotFoundError::has_raft_id() const {
+// optional .proto.Transaction txn = 1;
+inline bool TransactionStatusError::has_txn() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RangeNotFoundError::set_has_raft_id() {
+inline void TransactionStatusError::set_has_txn() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void RangeNotFoundError::clear_has_raft_id() {
+inline void TransactionStatusError::clear_has_txn() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void RangeNotFoundError::clear_raft_id() {
-  raft_id_ = GOOGLE_LONGLONG(0);
-  clear_has_raft_id();
+inline void TransactionStatusError::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
 }
-inline ::google::protobuf::int64 RangeNotFoundError::raft_id() const {
-  // @@protoc_insertion_point(field_get:proto.RangeNotFoundError.raft_id)
-  return raft_id_;
+inline const ::proto::Transaction& TransactionStatusError::txn() const {
+  // @@protoc_insertion_point(field_get:proto.TransactionStatusError.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
 }
-inline void RangeNotFoundError::set_raft_id(::google::protobuf::int64 value) {
-  set_has_raft_id();
-  raft_id_ = value;
-  // @@protoc_insertion_point(field_set:proto.RangeNotFoundError.raft_id)
+inline ::proto::Transaction* TransactionStatusError::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.TransactionStatusError.txn)
+  return txn_;
+}
+inline ::proto::Transaction* TransactionStatusError::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void TransactionStatusError::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.TransactionStatusError.txn)
 }
 
-// -------------------------------------------------------------------
-
-// RangeKeyMismatchError
-
-// optional bytes request_start_key = 1;
-inline bool RangeKeyMismatchError::has_request_start_key() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional string msg = 2;
+inline bool TransactionStatusError::has_msg() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RangeKeyMismatchError::set_has_request_start_key() {
-  _has_bits_[0] |= 0x00000001u;
+inline void TransactionStatusError::set_has_msg() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RangeKeyMismatchError::clear_has_request_start_key() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void TransactionStatusError::clear_has_msg() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RangeKeyMismatchError::clear_request_start_key() {
-  if (request_start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_start_key_->clear();
+inline void TransactionStatusError::clear_msg() {
+  if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    msg_->clear();
   }
-  clear_has_request_start_key();
+  clear_has_msg();
 }
-inline const ::std::string& RangeKeyMismatchError::request_start_key() const {
-  // @@protoc_insertion_point(field_get:proto.RangeKeyMismatchError.request_start_key)
-  return *request_start_key_;
+inline const ::std::string& TransactionStatusError::msg() const {
+  // @@protoc_insertion_point(field_get:proto.TransactionStatusError.msg)
+  return *msg_;
 }
-inline void RangeKeyMismatchError::set_request_start_key(const ::std::string& value) {
-  set_has_request_start_key();
-  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_start_key_ = new ::std::string;
+inline void TransactionStatusError::set_msg(const ::std::string& value) {
+  set_has_msg();
+  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    msg_ = new ::std::string;
   }
-  request_start_key_->assign(value);
-  // @@protoc_insertion_point(field_set:proto.RangeKeyMismatchError.request_start_key)
+  msg_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.TransactionStatusError.msg)
 }
-inline void RangeKeyMismatchError::set_request_start_key(const char* value) {
-  set_has_request_start_key();
-  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_start_key_ = new ::std::string;
+inline void TransactionStatusError::set_msg(const char* value) {
+  set_has_msg();
+  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    msg_ = new ::std::string;
   }
-  request_start_key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:proto.RangeKeyMismatchError.request_start_key)
+  msg_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.TransactionStatusError.msg)
 }
-inline void RangeKeyMismatchError::set_request_start_key(const void* value, size_t size) {
-  set_has_request_start_key();
-  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_start_key_ = new ::std::string;
+inline void TransactionStatusError::set_msg(const char* value, size_t size) {
+  set_has_msg();
+  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    msg_ = new ::std::string;
   }
-  request_start_key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:proto.RangeKeyMismatchError.request_start_key)
+  msg_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.TransactionStatusError.msg)
 }
-inline ::std::string* RangeKeyMismatchError::mutable_request_start_key() {
-  set_has_request_start_key();
-  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_start_key_ = new ::std::string;
+inline ::std::string* TransactionStatusError::mutable_msg() {
+  set_has_msg();
+  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    msg_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:proto.RangeKeyMismatchError.request_start_key)
-  return request_start_key_;
+  // @@protoc_insertion_point(field_mutable:proto.TransactionStatusError.msg)
+  return msg_;
 }
-inline ::std::string* RangeKeyMismatchError::release_request_start_key() {
-  clear_has_request_start_key();
-  if (request_start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* TransactionStatusError::release_msg() {
+  clear_has_msg();
+  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = request_start_key_;
-    request_start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = msg_;
+    msg_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void RangeKeyMismatchError::set_allocated_request_start_key(::std::string* request_start_key) {
-  if (request_start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete request_start_key_;
+inline void TransactionStatusError::set_allocated_msg(::std::string* msg) {
+  if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete msg_;
   }
-  if (request_start_key) {
-    set_has_request_start_key();
-    request_start_key_ = request_start_key;
+  if (msg) {
+    set_has_msg();
+    msg_ = msg;
   } else {
-    clear_has_request_start_key();
-    request_start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_msg();
+    msg_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.RangeKeyMismatchError.request_start_key)
+  // @@protoc_insertion_point(field_set_allocated:proto.TransactionStatusError.msg)
 }
 
-// optional bytes request_end_key = 2;
-inline bool RangeKeyMismatchError::has_request_end_key() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// WriteIntentError
+
+// optional bytes key = 1;
+inline bool WriteIntentError::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RangeKeyMismatchError::set_has_request_end_key() {
-  _has_bits_[0] |= 0x00000002u;
+inline void WriteIntentError::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RangeKeyMismatchError::clear_has_request_end_key() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void WriteIntentError::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RangeKeyMismatchError::clear_request_end_key() {
-  if (request_end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_end_key_->clear();
+inline void WriteIntentError::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
   }
-  clear_has_request_end_key();
+  clear_has_key();
 }
-inline const ::std::string& RangeKeyMismatchError::request_end_key() const {
-  // @@protoc_insertion_point(field_get:proto.RangeKeyMismatchError.request_end_key)
-  return *request_end_key_;
+inline const ::std::string& WriteIntentError::key() const {
+  // @@protoc_insertion_point(field_get:proto.WriteIntentError.key)
+  return *key_;
 }
-inline void RangeKeyMismatchError::set_request_end_key(const ::std::string& value) {
-  set_has_request_end_key();
-  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_end_key_ = new ::std::string;
+inline void WriteIntentError::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  request_end_key_->assign(value);
-  // @@protoc_insertion_point(field_set:proto.RangeKeyMismatchError.request_end_key)
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.WriteIntentError.key)
 }
-inline void RangeKeyMismatchError::set_request_end_key(const char* value) {
-  set_has_request_end_key();
-  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_end_key_ = new ::std::string;
+inline void WriteIntentError::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  request_end_key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:proto.RangeKeyMismatchError.request_end_key)
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.WriteIntentError.key)
 }
-inline void RangeKeyMismatchError::set_request_end_key(const void* value, size_t size) {
-  set_has_request_end_key();
-  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_end_key_ = new ::std::string;
+inline void WriteIntentError::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  request_end_key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:proto.RangeKeyMismatchError.request_end_key)
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.WriteIntentError.key)
 }
-inline ::std::string* RangeKeyMismatchError::mutable_request_end_key() {
-  set_has_request_end_key();
-  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    request_end_key_ = new ::std::string;
+inline ::std::string* WriteIntentError::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:proto.RangeKeyMismatchError.request_end_key)
-  return request_end_key_;
+  // @@protoc_insertion_point(field_mutable:proto.WriteIntentError.key)
+  return key_;
 }
-inline ::std::string* RangeKeyMismatchError::release_request_end_key() {
-  clear_has_request_end_key();
-  if (request_end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* WriteIntentError::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = request_end_key_;
-    request_end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void RangeKeyMismatchError::set_allocated_request_end_key(::std::string* request_end_key) {
-  if (request_end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete request_end_key_;
+inline void WriteIntentError::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
   }
-  if (request_end_key) {
-    set_has_request_end_key();
-    request_end_key_ = request_end_key;
+  if (key) {
+    set_has_key();
+    key_ = key;
   } else {
-    clear_has_request_end_key();
-    request_end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.RangeKeyMismatchError.request_end_key)
+  // @@protoc_insertion_point(field_set_allocated:proto.WriteIntentError.key)
 }
 
-// optional .proto.RangeDescriptor range = 3;
-inline bool RangeKeyMismatchError::has_range() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional .proto.Transaction txn = 2;
+inline bool WriteIntentError::has_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RangeKeyMismatchError::set_has_range() {
-  _has_bits_[0] |= 0x00000004u;
+inline void WriteIntentError::set_has_txn() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RangeKeyMismatchError::clear_has_range() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void WriteIntentError::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RangeKeyMismatchError::clear_range() {
-  if (range_ != NULL) range_->::proto::RangeDescriptor::Clear();
-  clear_has_range();
+inline void WriteIntentError::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
 }
-inline const ::proto::RangeDescriptor& RangeKeyMismatchError::range() const {
-  // @@protoc_insertion_point(field_get:proto.RangeKeyMismatchError.range)
-  return range_ != NULL ? *range_ : *default_instance_->range_;
+inline const ::proto::Transaction& WriteIntentError::txn() const {
+  // @@protoc_insertion_point(field_get:proto.WriteIntentError.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
 }
-inline ::proto::RangeDescriptor* RangeKeyMismatchError::mutable_range() {
-  set_has_range();
-  if (range_ == NULL) range_ = new ::proto::RangeDescriptor;
-  // @@protoc_insertion_point(field_mutable:proto.RangeKeyMismatchError.range)
-  return range_;
+inline ::proto::Transaction* WriteIntentError::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.WriteIntentError.txn)
+  return txn_;
 }
-inline ::proto::RangeDescriptor* RangeKeyMismatchError::release_range() {
-  clear_has_range();
-  ::proto::RangeDescriptor* temp = range_;
-  range_ = NULL;
+inline ::proto::Transaction* WriteIntentError::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
   return temp;
 }
-inline void RangeKeyMismatchError::set_allocated_range(::proto::RangeDescriptor* range) {
-  delete range_;
-  range_ = range;
-  if (range) {
-    set_has_range();
+inline void WriteIntentError::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
   } else {
-    clear_has_range();
+    clear_has_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.RangeKeyMismatchError.range)
+  // @@protoc_insertion_point(field_set_allocated:proto.WriteIntentError.txn)
+}
+
+// optional bool resolved = 3;
+inline bool WriteIntentError::has_resolved() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void WriteIntentError::set_has_resolved() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void WriteIntentError::clear_has_resolved() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void WriteIntentError::clear_resolved() {
+  resolved_ = false;
+  clear_has_resolved();
+}
+inline bool WriteIntentError::resolved() const {
+  // @@protoc_insertion_point(field_get:proto.WriteIntentError.resolved)
+  return resolved_;
+}
+inline void WriteIntentError::set_resolved(bool value) {
+  set_has_resolved();
+  resolved_ = value;
+  // @@protoc_insertion_point(field_set:proto.WriteIntentError.resolved)
 }
 
 // -------------------------------------------------------------------
 
-// ReadWithinUncertaintyIntervalError
+// WriteTooOldError
 
 // optional .proto.Timestamp timestamp = 1;
-inline bool ReadWithinUncertaintyIntervalError::has_timestamp() const {
+inline bool WriteTooOldError::has_timestamp() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ReadWithinUncertaintyIntervalError::set_has_timestamp() {
+inline void WriteTooOldError::set_has_timestamp() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ReadWithinUncertaintyIntervalError::clear_has_timestamp() {
+inline void WriteTooOldError::clear_has_timestamp() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ReadWithinUncertaintyIntervalError::clear_timestamp() {
+inline void WriteTooOldError::clear_timestamp() {
   if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
   clear_has_timestamp();
 }
-inline const ::proto::Timestamp& ReadWithinUncertaintyIntervalError::timestamp() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWithinUncertaintyIntervalError.timestamp)
+inline const ::proto::Timestamp& WriteTooOldError::timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.WriteTooOldError.timestamp)
   return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
 }
-inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::mutable_timestamp() {
+inline ::proto::Timestamp* WriteTooOldError::mutable_timestamp() {
   set_has_timestamp();
   if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWithinUncertaintyIntervalError.timestamp)
+  // @@protoc_insertion_point(field_mutable:proto.WriteTooOldError.timestamp)
   return timestamp_;
 }
-inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::release_timestamp() {
+inline ::proto::Timestamp* WriteTooOldError::release_timestamp() {
   clear_has_timestamp();
   ::proto::Timestamp* temp = timestamp_;
   timestamp_ = NULL;
   return temp;
 }
-inline void ReadWithinUncertaintyIntervalError::set_allocated_timestamp(::proto::Timestamp* timestamp) {
+inline void WriteTooOldError::set_allocated_timestamp(::proto::Timestamp* timestamp) {
   delete timestamp_;
   timestamp_ = timestamp;
   if (timestamp) {
@@ -1853,85 +3553,210 @@ inline void ReadWithinUncertaintyIntervalError::set_allocated_timestamp(::proto:
   } else {
     clear_has_timestamp();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWithinUncertaintyIntervalError.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:proto.WriteTooOldError.timestamp)
 }
 
 // optional .proto.Timestamp existing_timestamp = 2;
-inline bool ReadWithinUncertaintyIntervalError::has_existing_timestamp() const {
+inline bool WriteTooOldError::has_existing_timestamp() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ReadWithinUncertaintyIntervalError::set_has_existing_timestamp() {
+inline void WriteTooOldError::set_has_existing_timestamp() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ReadWithinUncertaintyIntervalError::clear_has_existing_timestamp() {
+inline void WriteTooOldError::clear_has_existing_timestamp() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ReadWithinUncertaintyIntervalError::clear_existing_timestamp() {
+inline void WriteTooOldError::clear_existing_timestamp() {
   if (existing_timestamp_ != NULL) existing_timestamp_->::proto::Timestamp::Clear();
   clear_has_existing_timestamp();
 }
-inline const ::proto::Timestamp& ReadWithinUncertaintyIntervalError::existing_timestamp() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWithinUncertaintyIntervalError.existing_timestamp)
+inline const ::proto::Timestamp& WriteTooOldError::existing_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.WriteTooOldError.existing_timestamp)
   return existing_timestamp_ != NULL ? *existing_timestamp_ : *default_instance_->existing_timestamp_;
 }
-inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::mutable_existing_timestamp() {
+inline ::proto::Timestamp* WriteTooOldError::mutable_existing_timestamp() {
   set_has_existing_timestamp();
   if (existing_timestamp_ == NULL) existing_timestamp_ = new ::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWithinUncertaintyIntervalError.existing_timestamp)
+  // @@protoc_insertion_point(field_mutable:proto.WriteTooOldError.existing_timestamp)
   return existing_timestamp_;
 }
-inline ::proto::Timestamp* ReadWithinUncertaintyIntervalError::release_existing_timestamp() {
-  clear_has_existing_timestamp();
-  ::proto::Timestamp* temp = existing_timestamp_;
-  existing_timestamp_ = NULL;
-  return temp;
+inline ::proto::Timestamp* WriteTooOldError::release_existing_timestamp() {
+  clear_has_existing_timestamp();
+  ::proto::Timestamp* temp = existing_timestamp_;
+  existing_timestamp_ = NULL;
+  return temp;
+}
+inline void WriteTooOldError::set_allocated_existing_timestamp(::proto::Timestamp* existing_timestamp) {
+  delete existing_timestamp_;
+  existing_timestamp_ = existing_timestamp;
+  if (existing_timestamp) {
+    set_has_existing_timestamp();
+  } else {
+    clear_has_existing_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.WriteTooOldError.existing_timestamp)
+}
+
+// -------------------------------------------------------------------
+
+// OpRequiresTxnError
+
+// -------------------------------------------------------------------
+
+// ConditionFailedError
+
+// optional .proto.Value actual_value = 1;
+inline bool ConditionFailedError::has_actual_value() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ConditionFailedError::set_has_actual_value() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ConditionFailedError::clear_has_actual_value() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ConditionFailedError::clear_actual_value() {
+  if (actual_value_ != NULL) actual_value_->::proto::Value::Clear();
+  clear_has_actual_value();
+}
+inline const ::proto::Value& ConditionFailedError::actual_value() const {
+  // @@protoc_insertion_point(field_get:proto.ConditionFailedError.actual_value)
+  return actual_value_ != NULL ? *actual_value_ : *default_instance_->actual_value_;
+}
+inline ::proto::Value* ConditionFailedError::mutable_actual_value() {
+  set_has_actual_value();
+  if (actual_value_ == NULL) actual_value_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.ConditionFailedError.actual_value)
+  return actual_value_;
+}
+inline ::proto::Value* ConditionFailedError::release_actual_value() {
+  clear_has_actual_value();
+  ::proto::Value* temp = actual_value_;
+  actual_value_ = NULL;
+  return temp;
+}
+inline void ConditionFailedError::set_allocated_actual_value(::proto::Value* actual_value) {
+  delete actual_value_;
+  actual_value_ = actual_value;
+  if (actual_value) {
+    set_has_actual_value();
+  } else {
+    clear_has_actual_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ConditionFailedError.actual_value)
+}
+
+// -------------------------------------------------------------------
+
+// ConflictTimeoutError
+
+// optional bytes key = 1;
+inline bool ConflictTimeoutError::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ConflictTimeoutError::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ConflictTimeoutError::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ConflictTimeoutError::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& ConflictTimeoutError::key() const {
+  // @@protoc_insertion_point(field_get:proto.ConflictTimeoutError.key)
+  return *key_;
+}
+inline void ConflictTimeoutError::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.ConflictTimeoutError.key)
+}
+inline void ConflictTimeoutError::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.ConflictTimeoutError.key)
+}
+inline void ConflictTimeoutError::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.ConflictTimeoutError.key)
+}
+inline ::std::string* ConflictTimeoutError::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.ConflictTimeoutError.key)
+  return key_;
+}
+inline ::std::string* ConflictTimeoutError::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
 }
-inline void ReadWithinUncertaintyIntervalError::set_allocated_existing_timestamp(::proto::Timestamp* existing_timestamp) {
-  delete existing_timestamp_;
-  existing_timestamp_ = existing_timestamp;
-  if (existing_timestamp) {
-    set_has_existing_timestamp();
+inline void ConflictTimeoutError::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
   } else {
-    clear_has_existing_timestamp();
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWithinUncertaintyIntervalError.existing_timestamp)
+  // @@protoc_insertion_point(field_set_allocated:proto.ConflictTimeoutError.key)
 }
 
-// -------------------------------------------------------------------
-
-// TransactionAbortedError
-
-// optional .proto.Transaction txn = 1;
-inline bool TransactionAbortedError::has_txn() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.Transaction txn = 2;
+inline bool ConflictTimeoutError::has_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void TransactionAbortedError::set_has_txn() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ConflictTimeoutError::set_has_txn() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void TransactionAbortedError::clear_has_txn() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ConflictTimeoutError::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void TransactionAbortedError::clear_txn() {
+inline void ConflictTimeoutError::clear_txn() {
   if (txn_ != NULL) txn_->::proto::Transaction::Clear();
   clear_has_txn();
 }
-inline const ::proto::Transaction& TransactionAbortedError::txn() const {
-  // @@protoc_insertion_point(field_get:proto.TransactionAbortedError.txn)
+inline const ::proto::Transaction& ConflictTimeoutError::txn() const {
+  // @@protoc_insertion_point(field_get:proto.ConflictTimeoutError.txn)
   return txn_ != NULL ? *txn_ : *default_instance_->txn_;
 }
-inline ::proto::Transaction* TransactionAbortedError::mutable_txn() {
+inline ::proto::Transaction* ConflictTimeoutError::mutable_txn() {
   set_has_txn();
   if (txn_ == NULL) txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.TransactionAbortedError.txn)
+  // @@protoc_insertion_point(field_mutable:proto.ConflictTimeoutError.txn)
   return txn_;
 }
-inline ::proto::Transaction* TransactionAbortedError::release_txn() {
+inline ::proto::Transaction* ConflictTimeoutError::release_txn() {
   clear_has_txn();
   ::proto::Transaction* temp = txn_;
   txn_ = NULL;
   return temp;
 }
-inline void TransactionAbortedError::set_allocated_txn(::proto::Transaction* txn) {
+inline void ConflictTimeoutError::set_allocated_txn(::proto::Transaction* txn) {
   delete txn_;
   txn_ = txn;
   if (txn) {
@@ -1939,318 +3764,282 @@ inline void TransactionAbortedError::set_allocated_txn(::proto::Transaction* txn
   } else {
     clear_has_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.TransactionAbortedError.txn)
+  // @@protoc_insertion_point(field_set_allocated:proto.ConflictTimeoutError.txn)
 }
 
 // -------------------------------------------------------------------
 
-// TransactionPushError
+// RaftGroupDeletedError
 
-// optional .proto.Transaction txn = 1;
-inline bool TransactionPushError::has_txn() const {
+// optional int64 raft_id = 1;
+inline bool RaftGroupDeletedError::has_raft_id() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void TransactionPushError::set_has_txn() {
+inline void RaftGroupDeletedError::set_has_raft_id() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void TransactionPushError::clear_has_txn() {
+inline void RaftGroupDeletedError::clear_has_raft_id() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void TransactionPushError::clear_txn() {
-  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
-  clear_has_txn();
+inline void RaftGroupDeletedError::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
 }
-inline const ::proto::Transaction& TransactionPushError::txn() const {
-  // @@protoc_insertion_point(field_get:proto.TransactionPushError.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline ::google::protobuf::int64 RaftGroupDeletedError::raft_id() const {
+  // @@protoc_insertion_point(field_get:proto.RaftGroupDeletedError.raft_id)
+  return raft_id_;
 }
-inline ::proto::Transaction* TransactionPushError::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.TransactionPushError.txn)
-  return txn_;
+inline void RaftGroupDeletedError::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:proto.RaftGroupDeletedError.raft_id)
 }
-inline ::proto::Transaction* TransactionPushError::release_txn() {
-  clear_has_txn();
-  ::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+
+// -------------------------------------------------------------------
+
+// StoreOverloadedError
+
+// optional string method = 1;
+inline bool StoreOverloadedError::has_method() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void TransactionPushError::set_allocated_txn(::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:proto.TransactionPushError.txn)
+inline void StoreOverloadedError::set_has_method() {
+  _has_bits_[0] |= 0x00000001u;
 }
-
-// optional .proto.Transaction pushee_txn = 2;
-inline bool TransactionPushError::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+inline void StoreOverloadedError::clear_has_method() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void TransactionPushError::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000002u;
+inline void StoreOverloadedError::clear_method() {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_->clear();
+  }
+  clear_has_method();
 }
-inline void TransactionPushError::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000002u;
+inline const ::std::string& StoreOverloadedError::method() const {
+  // @@protoc_insertion_point(field_get:proto.StoreOverloadedError.method)
+  return *method_;
 }
-inline void TransactionPushError::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::proto::Transaction::Clear();
-  clear_has_pushee_txn();
+inline void StoreOverloadedError::set_method(const ::std::string& value) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.StoreOverloadedError.method)
 }
-inline const ::proto::Transaction& TransactionPushError::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:proto.TransactionPushError.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+inline void StoreOverloadedError::set_method(const char* value) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.StoreOverloadedError.method)
 }
-inline ::proto::Transaction* TransactionPushError::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) pushee_txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.TransactionPushError.pushee_txn)
-  return pushee_txn_;
+inline void StoreOverloadedError::set_method(const char* value, size_t size) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.StoreOverloadedError.method)
 }
-inline ::proto::Transaction* TransactionPushError::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::proto::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
-  return temp;
+inline ::std::string* StoreOverloadedError::mutable_method() {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.StoreOverloadedError.method)
+  return method_;
 }
-inline void TransactionPushError::set_allocated_pushee_txn(::proto::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
+inline ::std::string* StoreOverloadedError::release_method() {
+  clear_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
   } else {
-    clear_has_pushee_txn();
+    ::std::string* temp = method_;
+    method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.TransactionPushError.pushee_txn)
+}
+inline void StoreOverloadedError::set_allocated_method(::std::string* method) {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete method_;
+  }
+  if (method) {
+    set_has_method();
+    method_ = method;
+  } else {
+    clear_has_method();
+    method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.StoreOverloadedError.method)
 }
 
 // -------------------------------------------------------------------
 
-// TransactionRetryError
+// DeadlineExceededError
 
-// optional .proto.Transaction txn = 1;
-inline bool TransactionRetryError::has_txn() const {
+// optional int64 deadline = 1;
+inline bool DeadlineExceededError::has_deadline() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void TransactionRetryError::set_has_txn() {
+inline void DeadlineExceededError::set_has_deadline() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void TransactionRetryError::clear_has_txn() {
+inline void DeadlineExceededError::clear_has_deadline() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void TransactionRetryError::clear_txn() {
-  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
-  clear_has_txn();
-}
-inline const ::proto::Transaction& TransactionRetryError::txn() const {
-  // @@protoc_insertion_point(field_get:proto.TransactionRetryError.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
-}
-inline ::proto::Transaction* TransactionRetryError::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.TransactionRetryError.txn)
-  return txn_;
+inline void DeadlineExceededError::clear_deadline() {
+  deadline_ = GOOGLE_LONGLONG(0);
+  clear_has_deadline();
 }
-inline ::proto::Transaction* TransactionRetryError::release_txn() {
-  clear_has_txn();
-  ::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 DeadlineExceededError::deadline() const {
+  // @@protoc_insertion_point(field_get:proto.DeadlineExceededError.deadline)
+  return deadline_;
 }
-inline void TransactionRetryError::set_allocated_txn(::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:proto.TransactionRetryError.txn)
+inline void DeadlineExceededError::set_deadline(::google::protobuf::int64 value) {
+  set_has_deadline();
+  deadline_ = value;
+  // @@protoc_insertion_point(field_set:proto.DeadlineExceededError.deadline)
 }
 
 // -------------------------------------------------------------------
 
-// TransactionStatusError
+// ChecksumMismatchError
 
-// optional .proto.Transaction txn = 1;
-inline bool TransactionStatusError::has_txn() const {
+// optional bytes key = 1;
+inline bool ChecksumMismatchError::has_key() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void TransactionStatusError::set_has_txn() {
+inline void ChecksumMismatchError::set_has_key() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void TransactionStatusError::clear_has_txn() {
+inline void ChecksumMismatchError::clear_has_key() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void TransactionStatusError::clear_txn() {
-  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
-  clear_has_txn();
-}
-inline const ::proto::Transaction& TransactionStatusError::txn() const {
-  // @@protoc_insertion_point(field_get:proto.TransactionStatusError.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
-}
-inline ::proto::Transaction* TransactionStatusError::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.TransactionStatusError.txn)
-  return txn_;
-}
-inline ::proto::Transaction* TransactionStatusError::release_txn() {
-  clear_has_txn();
-  ::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
-}
-inline void TransactionStatusError::set_allocated_txn(::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:proto.TransactionStatusError.txn)
-}
-
-// optional string msg = 2;
-inline bool TransactionStatusError::has_msg() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void TransactionStatusError::set_has_msg() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void TransactionStatusError::clear_has_msg() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void TransactionStatusError::clear_msg() {
-  if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    msg_->clear();
+inline void ChecksumMismatchError::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
   }
-  clear_has_msg();
+  clear_has_key();
 }
-inline const ::std::string& TransactionStatusError::msg() const {
-  // @@protoc_insertion_point(field_get:proto.TransactionStatusError.msg)
-  return *msg_;
+inline const ::std::string& ChecksumMismatchError::key() const {
+  // @@protoc_insertion_point(field_get:proto.ChecksumMismatchError.key)
+  return *key_;
 }
-inline void TransactionStatusError::set_msg(const ::std::string& value) {
-  set_has_msg();
-  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    msg_ = new ::std::string;
+inline void ChecksumMismatchError::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  msg_->assign(value);
-  // @@protoc_insertion_point(field_set:proto.TransactionStatusError.msg)
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.ChecksumMismatchError.key)
 }
-inline void TransactionStatusError::set_msg(const char* value) {
-  set_has_msg();
-  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    msg_ = new ::std::string;
+inline void ChecksumMismatchError::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  msg_->assign(value);
-  // @@protoc_insertion_point(field_set_char:proto.TransactionStatusError.msg)
-}
-inline void TransactionStatusError::set_msg(const char* value, size_t size) {
-  set_has_msg();
-  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    msg_ = new ::std::string;
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.ChecksumMismatchError.key)
+}
+inline void ChecksumMismatchError::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  msg_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:proto.TransactionStatusError.msg)
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.ChecksumMismatchError.key)
 }
-inline ::std::string* TransactionStatusError::mutable_msg() {
-  set_has_msg();
-  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    msg_ = new ::std::string;
+inline ::std::string* ChecksumMismatchError::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:proto.TransactionStatusError.msg)
-  return msg_;
+  // @@protoc_insertion_point(field_mutable:proto.ChecksumMismatchError.key)
+  return key_;
 }
-inline ::std::string* TransactionStatusError::release_msg() {
-  clear_has_msg();
-  if (msg_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* ChecksumMismatchError::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = msg_;
-    msg_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void TransactionStatusError::set_allocated_msg(::std::string* msg) {
-  if (msg_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete msg_;
+inline void ChecksumMismatchError::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
   }
-  if (msg) {
-    set_has_msg();
-    msg_ = msg;
+  if (key) {
+    set_has_key();
+    key_ = key;
   } else {
-    clear_has_msg();
-    msg_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.TransactionStatusError.msg)
+  // @@protoc_insertion_point(field_set_allocated:proto.ChecksumMismatchError.key)
 }
 
 // -------------------------------------------------------------------
 
-// WriteIntentError
+// ProtectedKeyError
 
 // optional bytes key = 1;
-inline bool WriteIntentError::has_key() const {
+inline bool ProtectedKeyError::has_key() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void WriteIntentError::set_has_key() {
+inline void ProtectedKeyError::set_has_key() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void WriteIntentError::clear_has_key() {
+inline void ProtectedKeyError::clear_has_key() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void WriteIntentError::clear_key() {
+inline void ProtectedKeyError::clear_key() {
   if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_->clear();
   }
   clear_has_key();
 }
-inline const ::std::string& WriteIntentError::key() const {
-  // @@protoc_insertion_point(field_get:proto.WriteIntentError.key)
+inline const ::std::string& ProtectedKeyError::key() const {
+  // @@protoc_insertion_point(field_get:proto.ProtectedKeyError.key)
   return *key_;
 }
-inline void WriteIntentError::set_key(const ::std::string& value) {
+inline void ProtectedKeyError::set_key(const ::std::string& value) {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
   key_->assign(value);
-  // @@protoc_insertion_point(field_set:proto.WriteIntentError.key)
+  // @@protoc_insertion_point(field_set:proto.ProtectedKeyError.key)
 }
-inline void WriteIntentError::set_key(const char* value) {
+inline void ProtectedKeyError::set_key(const char* value) {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
   key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:proto.WriteIntentError.key)
+  // @@protoc_insertion_point(field_set_char:proto.ProtectedKeyError.key)
 }
-inline void WriteIntentError::set_key(const void* value, size_t size) {
+inline void ProtectedKeyError::set_key(const void* value, size_t size) {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
   key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:proto.WriteIntentError.key)
+  // @@protoc_insertion_point(field_set_pointer:proto.ProtectedKeyError.key)
 }
-inline ::std::string* WriteIntentError::mutable_key() {
+inline ::std::string* ProtectedKeyError::mutable_key() {
   set_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     key_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:proto.WriteIntentError.key)
+  // @@protoc_insertion_point(field_mutable:proto.ProtectedKeyError.key)
   return key_;
 }
-inline ::std::string* WriteIntentError::release_key() {
+inline ::std::string* ProtectedKeyError::release_key() {
   clear_has_key();
   if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
@@ -2260,7 +4049,7 @@ inline ::std::string* WriteIntentError::release_key() {
     return temp;
   }
 }
-inline void WriteIntentError::set_allocated_key(::std::string* key) {
+inline void ProtectedKeyError::set_allocated_key(::std::string* key) {
   if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     delete key_;
   }
@@ -2271,207 +4060,477 @@ inline void WriteIntentError::set_allocated_key(::std::string* key) {
     clear_has_key();
     key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.WriteIntentError.key)
+  // @@protoc_insertion_point(field_set_allocated:proto.ProtectedKeyError.key)
 }
 
-// optional .proto.Transaction txn = 2;
-inline bool WriteIntentError::has_txn() const {
+// -------------------------------------------------------------------
+
+// TooManyIntentsError
+
+// optional int64 raft_id = 1;
+inline bool TooManyIntentsError::has_raft_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void TooManyIntentsError::set_has_raft_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void TooManyIntentsError::clear_has_raft_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void TooManyIntentsError::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
+}
+inline ::google::protobuf::int64 TooManyIntentsError::raft_id() const {
+  // @@protoc_insertion_point(field_get:proto.TooManyIntentsError.raft_id)
+  return raft_id_;
+}
+inline void TooManyIntentsError::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:proto.TooManyIntentsError.raft_id)
+}
+
+// optional int64 intent_count = 2;
+inline bool TooManyIntentsError::has_intent_count() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void WriteIntentError::set_has_txn() {
+inline void TooManyIntentsError::set_has_intent_count() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void WriteIntentError::clear_has_txn() {
+inline void TooManyIntentsError::clear_has_intent_count() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void WriteIntentError::clear_txn() {
-  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
-  clear_has_txn();
+inline void TooManyIntentsError::clear_intent_count() {
+  intent_count_ = GOOGLE_LONGLONG(0);
+  clear_has_intent_count();
 }
-inline const ::proto::Transaction& WriteIntentError::txn() const {
-  // @@protoc_insertion_point(field_get:proto.WriteIntentError.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline ::google::protobuf::int64 TooManyIntentsError::intent_count() const {
+  // @@protoc_insertion_point(field_get:proto.TooManyIntentsError.intent_count)
+  return intent_count_;
 }
-inline ::proto::Transaction* WriteIntentError::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.WriteIntentError.txn)
-  return txn_;
+inline void TooManyIntentsError::set_intent_count(::google::protobuf::int64 value) {
+  set_has_intent_count();
+  intent_count_ = value;
+  // @@protoc_insertion_point(field_set:proto.TooManyIntentsError.intent_count)
 }
-inline ::proto::Transaction* WriteIntentError::release_txn() {
-  clear_has_txn();
-  ::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+
+// optional int64 max_intents = 3;
+inline bool TooManyIntentsError::has_max_intents() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void WriteIntentError::set_allocated_txn(::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:proto.WriteIntentError.txn)
+inline void TooManyIntentsError::set_has_max_intents() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void TooManyIntentsError::clear_has_max_intents() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void TooManyIntentsError::clear_max_intents() {
+  max_intents_ = GOOGLE_LONGLONG(0);
+  clear_has_max_intents();
+}
+inline ::google::protobuf::int64 TooManyIntentsError::max_intents() const {
+  // @@protoc_insertion_point(field_get:proto.TooManyIntentsError.max_intents)
+  return max_intents_;
+}
+inline void TooManyIntentsError::set_max_intents(::google::protobuf::int64 value) {
+  set_has_max_intents();
+  max_intents_ = value;
+  // @@protoc_insertion_point(field_set:proto.TooManyIntentsError.max_intents)
 }
 
-// optional bool resolved = 3;
-inline bool WriteIntentError::has_resolved() const {
+// -------------------------------------------------------------------
+
+// CommandTooLargeError
+
+// optional int64 size = 1;
+inline bool CommandTooLargeError::has_size() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void CommandTooLargeError::set_has_size() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void CommandTooLargeError::clear_has_size() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void CommandTooLargeError::clear_size() {
+  size_ = GOOGLE_LONGLONG(0);
+  clear_has_size();
+}
+inline ::google::protobuf::int64 CommandTooLargeError::size() const {
+  // @@protoc_insertion_point(field_get:proto.CommandTooLargeError.size)
+  return size_;
+}
+inline void CommandTooLargeError::set_size(::google::protobuf::int64 value) {
+  set_has_size();
+  size_ = value;
+  // @@protoc_insertion_point(field_set:proto.CommandTooLargeError.size)
+}
+
+// optional int64 max_size = 2;
+inline bool CommandTooLargeError::has_max_size() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void CommandTooLargeError::set_has_max_size() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void CommandTooLargeError::clear_has_max_size() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void CommandTooLargeError::clear_max_size() {
+  max_size_ = GOOGLE_LONGLONG(0);
+  clear_has_max_size();
+}
+inline ::google::protobuf::int64 CommandTooLargeError::max_size() const {
+  // @@protoc_insertion_point(field_get:proto.CommandTooLargeError.max_size)
+  return max_size_;
+}
+inline void CommandTooLargeError::set_max_size(::google::protobuf::int64 value) {
+  set_has_max_size();
+  max_size_ = value;
+  // @@protoc_insertion_point(field_set:proto.CommandTooLargeError.max_size)
+}
+
+// -------------------------------------------------------------------
+
+// ProposalBufferFullError
+
+// optional int64 raft_id = 1;
+inline bool ProposalBufferFullError::has_raft_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ProposalBufferFullError::set_has_raft_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ProposalBufferFullError::clear_has_raft_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ProposalBufferFullError::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
+}
+inline ::google::protobuf::int64 ProposalBufferFullError::raft_id() const {
+  // @@protoc_insertion_point(field_get:proto.ProposalBufferFullError.raft_id)
+  return raft_id_;
+}
+inline void ProposalBufferFullError::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:proto.ProposalBufferFullError.raft_id)
+}
+
+// optional int64 pending_bytes = 2;
+inline bool ProposalBufferFullError::has_pending_bytes() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ProposalBufferFullError::set_has_pending_bytes() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ProposalBufferFullError::clear_has_pending_bytes() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ProposalBufferFullError::clear_pending_bytes() {
+  pending_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_pending_bytes();
+}
+inline ::google::protobuf::int64 ProposalBufferFullError::pending_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.ProposalBufferFullError.pending_bytes)
+  return pending_bytes_;
+}
+inline void ProposalBufferFullError::set_pending_bytes(::google::protobuf::int64 value) {
+  set_has_pending_bytes();
+  pending_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.ProposalBufferFullError.pending_bytes)
+}
+
+// optional int64 max_bytes = 3;
+inline bool ProposalBufferFullError::has_max_bytes() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void WriteIntentError::set_has_resolved() {
+inline void ProposalBufferFullError::set_has_max_bytes() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void WriteIntentError::clear_has_resolved() {
+inline void ProposalBufferFullError::clear_has_max_bytes() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void WriteIntentError::clear_resolved() {
-  resolved_ = false;
-  clear_has_resolved();
+inline void ProposalBufferFullError::clear_max_bytes() {
+  max_bytes_ = GOOGLE_LONGLONG(0);
+  clear_has_max_bytes();
 }
-inline bool WriteIntentError::resolved() const {
-  // @@protoc_insertion_point(field_get:proto.WriteIntentError.resolved)
-  return resolved_;
+inline ::google::protobuf::int64 ProposalBufferFullError::max_bytes() const {
+  // @@protoc_insertion_point(field_get:proto.ProposalBufferFullError.max_bytes)
+  return max_bytes_;
 }
-inline void WriteIntentError::set_resolved(bool value) {
-  set_has_resolved();
-  resolved_ = value;
-  // @@protoc_insertion_point(field_set:proto.WriteIntentError.resolved)
+inline void ProposalBufferFullError::set_max_bytes(::google::protobuf::int64 value) {
+  set_has_max_bytes();
+  max_bytes_ = value;
+  // @@protoc_insertion_point(field_set:proto.ProposalBufferFullError.max_bytes)
 }
 
 // -------------------------------------------------------------------
 
-// WriteTooOldError
+// TimestampTooFarError
 
 // optional .proto.Timestamp timestamp = 1;
-inline bool WriteTooOldError::has_timestamp() const {
+inline bool TimestampTooFarError::has_timestamp() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void WriteTooOldError::set_has_timestamp() {
+inline void TimestampTooFarError::set_has_timestamp() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void WriteTooOldError::clear_has_timestamp() {
+inline void TimestampTooFarError::clear_has_timestamp() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void WriteTooOldError::clear_timestamp() {
+inline void TimestampTooFarError::clear_timestamp() {
   if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
   clear_has_timestamp();
 }
-inline const ::proto::Timestamp& WriteTooOldError::timestamp() const {
-  // @@protoc_insertion_point(field_get:proto.WriteTooOldError.timestamp)
+inline const ::proto::Timestamp& TimestampTooFarError::timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.TimestampTooFarError.timestamp)
   return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
 }
-inline ::proto::Timestamp* WriteTooOldError::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:proto.WriteTooOldError.timestamp)
-  return timestamp_;
+inline ::proto::Timestamp* TimestampTooFarError::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.TimestampTooFarError.timestamp)
+  return timestamp_;
+}
+inline ::proto::Timestamp* TimestampTooFarError::release_timestamp() {
+  clear_has_timestamp();
+  ::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void TimestampTooFarError::set_allocated_timestamp(::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.TimestampTooFarError.timestamp)
+}
+
+// optional .proto.Timestamp max_timestamp = 2;
+inline bool TimestampTooFarError::has_max_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void TimestampTooFarError::set_has_max_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void TimestampTooFarError::clear_has_max_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void TimestampTooFarError::clear_max_timestamp() {
+  if (max_timestamp_ != NULL) max_timestamp_->::proto::Timestamp::Clear();
+  clear_has_max_timestamp();
+}
+inline const ::proto::Timestamp& TimestampTooFarError::max_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.TimestampTooFarError.max_timestamp)
+  return max_timestamp_ != NULL ? *max_timestamp_ : *default_instance_->max_timestamp_;
+}
+inline ::proto::Timestamp* TimestampTooFarError::mutable_max_timestamp() {
+  set_has_max_timestamp();
+  if (max_timestamp_ == NULL) max_timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.TimestampTooFarError.max_timestamp)
+  return max_timestamp_;
+}
+inline ::proto::Timestamp* TimestampTooFarError::release_max_timestamp() {
+  clear_has_max_timestamp();
+  ::proto::Timestamp* temp = max_timestamp_;
+  max_timestamp_ = NULL;
+  return temp;
+}
+inline void TimestampTooFarError::set_allocated_max_timestamp(::proto::Timestamp* max_timestamp) {
+  delete max_timestamp_;
+  max_timestamp_ = max_timestamp;
+  if (max_timestamp) {
+    set_has_max_timestamp();
+  } else {
+    clear_has_max_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.TimestampTooFarError.max_timestamp)
+}
+
+// -------------------------------------------------------------------
+
+// CommandQueueTimeoutError
+
+// optional bytes key = 1;
+inline bool CommandQueueTimeoutError::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void CommandQueueTimeoutError::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void CommandQueueTimeoutError::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void CommandQueueTimeoutError::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& CommandQueueTimeoutError::key() const {
+  // @@protoc_insertion_point(field_get:proto.CommandQueueTimeoutError.key)
+  return *key_;
+}
+inline void CommandQueueTimeoutError::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.CommandQueueTimeoutError.key)
+}
+inline void CommandQueueTimeoutError::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.CommandQueueTimeoutError.key)
+}
+inline void CommandQueueTimeoutError::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.CommandQueueTimeoutError.key)
+}
+inline ::std::string* CommandQueueTimeoutError::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.CommandQueueTimeoutError.key)
+  return key_;
 }
-inline ::proto::Timestamp* WriteTooOldError::release_timestamp() {
-  clear_has_timestamp();
-  ::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
-  return temp;
+inline ::std::string* CommandQueueTimeoutError::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
 }
-inline void WriteTooOldError::set_allocated_timestamp(::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void CommandQueueTimeoutError::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
   } else {
-    clear_has_timestamp();
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.WriteTooOldError.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:proto.CommandQueueTimeoutError.key)
 }
 
-// optional .proto.Timestamp existing_timestamp = 2;
-inline bool WriteTooOldError::has_existing_timestamp() const {
+// optional bytes end_key = 2;
+inline bool CommandQueueTimeoutError::has_end_key() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void WriteTooOldError::set_has_existing_timestamp() {
+inline void CommandQueueTimeoutError::set_has_end_key() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void WriteTooOldError::clear_has_existing_timestamp() {
+inline void CommandQueueTimeoutError::clear_has_end_key() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void WriteTooOldError::clear_existing_timestamp() {
-  if (existing_timestamp_ != NULL) existing_timestamp_->::proto::Timestamp::Clear();
-  clear_has_existing_timestamp();
+inline void CommandQueueTimeoutError::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
+  }
+  clear_has_end_key();
 }
-inline const ::proto::Timestamp& WriteTooOldError::existing_timestamp() const {
-  // @@protoc_insertion_point(field_get:proto.WriteTooOldError.existing_timestamp)
-  return existing_timestamp_ != NULL ? *existing_timestamp_ : *default_instance_->existing_timestamp_;
+inline const ::std::string& CommandQueueTimeoutError::end_key() const {
+  // @@protoc_insertion_point(field_get:proto.CommandQueueTimeoutError.end_key)
+  return *end_key_;
 }
-inline ::proto::Timestamp* WriteTooOldError::mutable_existing_timestamp() {
-  set_has_existing_timestamp();
-  if (existing_timestamp_ == NULL) existing_timestamp_ = new ::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:proto.WriteTooOldError.existing_timestamp)
-  return existing_timestamp_;
+inline void CommandQueueTimeoutError::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.CommandQueueTimeoutError.end_key)
 }
-inline ::proto::Timestamp* WriteTooOldError::release_existing_timestamp() {
-  clear_has_existing_timestamp();
-  ::proto::Timestamp* temp = existing_timestamp_;
-  existing_timestamp_ = NULL;
-  return temp;
+inline void CommandQueueTimeoutError::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.CommandQueueTimeoutError.end_key)
 }
-inline void WriteTooOldError::set_allocated_existing_timestamp(::proto::Timestamp* existing_timestamp) {
-  delete existing_timestamp_;
-  existing_timestamp_ = existing_timestamp;
-  if (existing_timestamp) {
-    set_has_existing_timestamp();
-  } else {
-    clear_has_existing_timestamp();
+inline void CommandQueueTimeoutError::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.WriteTooOldError.existing_timestamp)
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.CommandQueueTimeoutError.end_key)
 }
-
-// -------------------------------------------------------------------
-
-// OpRequiresTxnError
-
-// -------------------------------------------------------------------
-
-// ConditionFailedError
-
-// optional .proto.Value actual_value = 1;
-inline bool ConditionFailedError::has_actual_value() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+inline ::std::string* CommandQueueTimeoutError::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.CommandQueueTimeoutError.end_key)
+  return end_key_;
 }
-inline void ConditionFailedError::set_has_actual_value() {
-  _has_bits_[0] |= 0x00000001u;
+inline ::std::string* CommandQueueTimeoutError::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
 }
-inline void ConditionFailedError::clear_has_actual_value() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void CommandQueueTimeoutError::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.CommandQueueTimeoutError.end_key)
 }
-inline void ConditionFailedError::clear_actual_value() {
-  if (actual_value_ != NULL) actual_value_->::proto::Value::Clear();
-  clear_has_actual_value();
+
+// optional int64 timeout = 3;
+inline bool CommandQueueTimeoutError::has_timeout() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline const ::proto::Value& ConditionFailedError::actual_value() const {
-  // @@protoc_insertion_point(field_get:proto.ConditionFailedError.actual_value)
-  return actual_value_ != NULL ? *actual_value_ : *default_instance_->actual_value_;
+inline void CommandQueueTimeoutError::set_has_timeout() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline ::proto::Value* ConditionFailedError::mutable_actual_value() {
-  set_has_actual_value();
-  if (actual_value_ == NULL) actual_value_ = new ::proto::Value;
-  // @@protoc_insertion_point(field_mutable:proto.ConditionFailedError.actual_value)
-  return actual_value_;
+inline void CommandQueueTimeoutError::clear_has_timeout() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline ::proto::Value* ConditionFailedError::release_actual_value() {
-  clear_has_actual_value();
-  ::proto::Value* temp = actual_value_;
-  actual_value_ = NULL;
-  return temp;
+inline void CommandQueueTimeoutError::clear_timeout() {
+  timeout_ = GOOGLE_LONGLONG(0);
+  clear_has_timeout();
 }
-inline void ConditionFailedError::set_allocated_actual_value(::proto::Value* actual_value) {
-  delete actual_value_;
-  actual_value_ = actual_value;
-  if (actual_value) {
-    set_has_actual_value();
-  } else {
-    clear_has_actual_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:proto.ConditionFailedError.actual_value)
+inline ::google::protobuf::int64 CommandQueueTimeoutError::timeout() const {
+  // @@protoc_insertion_point(field_get:proto.CommandQueueTimeoutError.timeout)
+  return timeout_;
+}
+inline void CommandQueueTimeoutError::set_timeout(::google::protobuf::int64 value) {
+  set_has_timeout();
+  timeout_ = value;
+  // @@protoc_insertion_point(field_set:proto.CommandQueueTimeoutError.timeout)
 }
 
 // -------------------------------------------------------------------
@@ -3011,6 +5070,457 @@ inline void Error::set_allocated_condition_failed(::proto::ConditionFailedError*
   // @@protoc_insertion_point(field_set_allocated:proto.Error.condition_failed)
 }
 
+// optional .proto.ConflictTimeoutError conflict_timeout = 14;
+inline bool Error::has_conflict_timeout() const {
+  return (_has_bits_[0] & 0x00002000u) != 0;
+}
+inline void Error::set_has_conflict_timeout() {
+  _has_bits_[0] |= 0x00002000u;
+}
+inline void Error::clear_has_conflict_timeout() {
+  _has_bits_[0] &= ~0x00002000u;
+}
+inline void Error::clear_conflict_timeout() {
+  if (conflict_timeout_ != NULL) conflict_timeout_->::proto::ConflictTimeoutError::Clear();
+  clear_has_conflict_timeout();
+}
+inline const ::proto::ConflictTimeoutError& Error::conflict_timeout() const {
+  // @@protoc_insertion_point(field_get:proto.Error.conflict_timeout)
+  return conflict_timeout_ != NULL ? *conflict_timeout_ : *default_instance_->conflict_timeout_;
+}
+inline ::proto::ConflictTimeoutError* Error::mutable_conflict_timeout() {
+  set_has_conflict_timeout();
+  if (conflict_timeout_ == NULL) conflict_timeout_ = new ::proto::ConflictTimeoutError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.conflict_timeout)
+  return conflict_timeout_;
+}
+inline ::proto::ConflictTimeoutError* Error::release_conflict_timeout() {
+  clear_has_conflict_timeout();
+  ::proto::ConflictTimeoutError* temp = conflict_timeout_;
+  conflict_timeout_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_conflict_timeout(::proto::ConflictTimeoutError* conflict_timeout) {
+  delete conflict_timeout_;
+  conflict_timeout_ = conflict_timeout;
+  if (conflict_timeout) {
+    set_has_conflict_timeout();
+  } else {
+    clear_has_conflict_timeout();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.conflict_timeout)
+}
+
+// optional .proto.RaftGroupDeletedError raft_group_deleted = 15;
+inline bool Error::has_raft_group_deleted() const {
+  return (_has_bits_[0] & 0x00004000u) != 0;
+}
+inline void Error::set_has_raft_group_deleted() {
+  _has_bits_[0] |= 0x00004000u;
+}
+inline void Error::clear_has_raft_group_deleted() {
+  _has_bits_[0] &= ~0x00004000u;
+}
+inline void Error::clear_raft_group_deleted() {
+  if (raft_group_deleted_ != NULL) raft_group_deleted_->::proto::RaftGroupDeletedError::Clear();
+  clear_has_raft_group_deleted();
+}
+inline const ::proto::RaftGroupDeletedError& Error::raft_group_deleted() const {
+  // @@protoc_insertion_point(field_get:proto.Error.raft_group_deleted)
+  return raft_group_deleted_ != NULL ? *raft_group_deleted_ : *default_instance_->raft_group_deleted_;
+}
+inline ::proto::RaftGroupDeletedError* Error::mutable_raft_group_deleted() {
+  set_has_raft_group_deleted();
+  if (raft_group_deleted_ == NULL) raft_group_deleted_ = new ::proto::RaftGroupDeletedError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.raft_group_deleted)
+  return raft_group_deleted_;
+}
+inline ::proto::RaftGroupDeletedError* Error::release_raft_group_deleted() {
+  clear_has_raft_group_deleted();
+  ::proto::RaftGroupDeletedError* temp = raft_group_deleted_;
+  raft_group_deleted_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_raft_group_deleted(::proto::RaftGroupDeletedError* raft_group_deleted) {
+  delete raft_group_deleted_;
+  raft_group_deleted_ = raft_group_deleted;
+  if (raft_group_deleted) {
+    set_has_raft_group_deleted();
+  } else {
+    clear_has_raft_group_deleted();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.raft_group_deleted)
+}
+
+// optional .proto.StoreOverloadedError store_overloaded = 16;
+inline bool Error::has_store_overloaded() const {
+  return (_has_bits_[0] & 0x00008000u) != 0;
+}
+inline void Error::set_has_store_overloaded() {
+  _has_bits_[0] |= 0x00008000u;
+}
+inline void Error::clear_has_store_overloaded() {
+  _has_bits_[0] &= ~0x00008000u;
+}
+inline void Error::clear_store_overloaded() {
+  if (store_overloaded_ != NULL) store_overloaded_->::proto::StoreOverloadedError::Clear();
+  clear_has_store_overloaded();
+}
+inline const ::proto::StoreOverloadedError& Error::store_overloaded() const {
+  // @@protoc_insertion_point(field_get:proto.Error.store_overloaded)
+  return store_overloaded_ != NULL ? *store_overloaded_ : *default_instance_->store_overloaded_;
+}
+inline ::proto::StoreOverloadedError* Error::mutable_store_overloaded() {
+  set_has_store_overloaded();
+  if (store_overloaded_ == NULL) store_overloaded_ = new ::proto::StoreOverloadedError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.store_overloaded)
+  return store_overloaded_;
+}
+inline ::proto::StoreOverloadedError* Error::release_store_overloaded() {
+  clear_has_store_overloaded();
+  ::proto::StoreOverloadedError* temp = store_overloaded_;
+  store_overloaded_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_store_overloaded(::proto::StoreOverloadedError* store_overloaded) {
+  delete store_overloaded_;
+  store_overloaded_ = store_overloaded;
+  if (store_overloaded) {
+    set_has_store_overloaded();
+  } else {
+    clear_has_store_overloaded();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.store_overloaded)
+}
+
+// optional .proto.DeadlineExceededError deadline_exceeded = 17;
+inline bool Error::has_deadline_exceeded() const {
+  return (_has_bits_[0] & 0x00010000u) != 0;
+}
+inline void Error::set_has_deadline_exceeded() {
+  _has_bits_[0] |= 0x00010000u;
+}
+inline void Error::clear_has_deadline_exceeded() {
+  _has_bits_[0] &= ~0x00010000u;
+}
+inline void Error::clear_deadline_exceeded() {
+  if (deadline_exceeded_ != NULL) deadline_exceeded_->::proto::DeadlineExceededError::Clear();
+  clear_has_deadline_exceeded();
+}
+inline const ::proto::DeadlineExceededError& Error::deadline_exceeded() const {
+  // @@protoc_insertion_point(field_get:proto.Error.deadline_exceeded)
+  return deadline_exceeded_ != NULL ? *deadline_exceeded_ : *default_instance_->deadline_exceeded_;
+}
+inline ::proto::DeadlineExceededError* Error::mutable_deadline_exceeded() {
+  set_has_deadline_exceeded();
+  if (deadline_exceeded_ == NULL) deadline_exceeded_ = new ::proto::DeadlineExceededError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.deadline_exceeded)
+  return deadline_exceeded_;
+}
+inline ::proto::DeadlineExceededError* Error::release_deadline_exceeded() {
+  clear_has_deadline_exceeded();
+  ::proto::DeadlineExceededError* temp = deadline_exceeded_;
+  deadline_exceeded_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_deadline_exceeded(::proto::DeadlineExceededError* deadline_exceeded) {
+  delete deadline_exceeded_;
+  deadline_exceeded_ = deadline_exceeded;
+  if (deadline_exceeded) {
+    set_has_deadline_exceeded();
+  } else {
+    clear_has_deadline_exceeded();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.deadline_exceeded)
+}
+
+// optional .proto.ChecksumMismatchError checksum_mismatch = 18;
+inline bool Error::has_checksum_mismatch() const {
+  return (_has_bits_[0] & 0x00020000u) != 0;
+}
+inline void Error::set_has_checksum_mismatch() {
+  _has_bits_[0] |= 0x00020000u;
+}
+inline void Error::clear_has_checksum_mismatch() {
+  _has_bits_[0] &= ~0x00020000u;
+}
+inline void Error::clear_checksum_mismatch() {
+  if (checksum_mismatch_ != NULL) checksum_mismatch_->::proto::ChecksumMismatchError::Clear();
+  clear_has_checksum_mismatch();
+}
+inline const ::proto::ChecksumMismatchError& Error::checksum_mismatch() const {
+  // @@protoc_insertion_point(field_get:proto.Error.checksum_mismatch)
+  return checksum_mismatch_ != NULL ? *checksum_mismatch_ : *default_instance_->checksum_mismatch_;
+}
+inline ::proto::ChecksumMismatchError* Error::mutable_checksum_mismatch() {
+  set_has_checksum_mismatch();
+  if (checksum_mismatch_ == NULL) checksum_mismatch_ = new ::proto::ChecksumMismatchError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.checksum_mismatch)
+  return checksum_mismatch_;
+}
+inline ::proto::ChecksumMismatchError* Error::release_checksum_mismatch() {
+  clear_has_checksum_mismatch();
+  ::proto::ChecksumMismatchError* temp = checksum_mismatch_;
+  checksum_mismatch_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_checksum_mismatch(::proto::ChecksumMismatchError* checksum_mismatch) {
+  delete checksum_mismatch_;
+  checksum_mismatch_ = checksum_mismatch;
+  if (checksum_mismatch) {
+    set_has_checksum_mismatch();
+  } else {
+    clear_has_checksum_mismatch();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.checksum_mismatch)
+}
+
+// optional .proto.ProtectedKeyError protected_key = 19;
+inline bool Error::has_protected_key() const {
+  return (_has_bits_[0] & 0x00040000u) != 0;
+}
+inline void Error::set_has_protected_key() {
+  _has_bits_[0] |= 0x00040000u;
+}
+inline void Error::clear_has_protected_key() {
+  _has_bits_[0] &= ~0x00040000u;
+}
+inline void Error::clear_protected_key() {
+  if (protected_key_ != NULL) protected_key_->::proto::ProtectedKeyError::Clear();
+  clear_has_protected_key();
+}
+inline const ::proto::ProtectedKeyError& Error::protected_key() const {
+  // @@protoc_insertion_point(field_get:proto.Error.protected_key)
+  return protected_key_ != NULL ? *protected_key_ : *default_instance_->protected_key_;
+}
+inline ::proto::ProtectedKeyError* Error::mutable_protected_key() {
+  set_has_protected_key();
+  if (protected_key_ == NULL) protected_key_ = new ::proto::ProtectedKeyError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.protected_key)
+  return protected_key_;
+}
+inline ::proto::ProtectedKeyError* Error::release_protected_key() {
+  clear_has_protected_key();
+  ::proto::ProtectedKeyError* temp = protected_key_;
+  protected_key_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_protected_key(::proto::ProtectedKeyError* protected_key) {
+  delete protected_key_;
+  protected_key_ = protected_key;
+  if (protected_key) {
+    set_has_protected_key();
+  } else {
+    clear_has_protected_key();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.protected_key)
+}
+
+// optional .proto.TooManyIntentsError too_many_intents = 20;
+inline bool Error::has_too_many_intents() const {
+  return (_has_bits_[0] & 0x00080000u) != 0;
+}
+inline void Error::set_has_too_many_intents() {
+  _has_bits_[0] |= 0x00080000u;
+}
+inline void Error::clear_has_too_many_intents() {
+  _has_bits_[0] &= ~0x00080000u;
+}
+inline void Error::clear_too_many_intents() {
+  if (too_many_intents_ != NULL) too_many_intents_->::proto::TooManyIntentsError::Clear();
+  clear_has_too_many_intents();
+}
+inline const ::proto::TooManyIntentsError& Error::too_many_intents() const {
+  // @@protoc_insertion_point(field_get:proto.Error.too_many_intents)
+  return too_many_intents_ != NULL ? *too_many_intents_ : *default_instance_->too_many_intents_;
+}
+inline ::proto::TooManyIntentsError* Error::mutable_too_many_intents() {
+  set_has_too_many_intents();
+  if (too_many_intents_ == NULL) too_many_intents_ = new ::proto::TooManyIntentsError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.too_many_intents)
+  return too_many_intents_;
+}
+inline ::proto::TooManyIntentsError* Error::release_too_many_intents() {
+  clear_has_too_many_intents();
+  ::proto::TooManyIntentsError* temp = too_many_intents_;
+  too_many_intents_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_too_many_intents(::proto::TooManyIntentsError* too_many_intents) {
+  delete too_many_intents_;
+  too_many_intents_ = too_many_intents;
+  if (too_many_intents) {
+    set_has_too_many_intents();
+  } else {
+    clear_has_too_many_intents();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.too_many_intents)
+}
+
+// optional .proto.CommandTooLargeError command_too_large = 21;
+inline bool Error::has_command_too_large() const {
+  return (_has_bits_[0] & 0x00100000u) != 0;
+}
+inline void Error::set_has_command_too_large() {
+  _has_bits_[0] |= 0x00100000u;
+}
+inline void Error::clear_has_command_too_large() {
+  _has_bits_[0] &= ~0x00100000u;
+}
+inline void Error::clear_command_too_large() {
+  if (command_too_large_ != NULL) command_too_large_->::proto::CommandTooLargeError::Clear();
+  clear_has_command_too_large();
+}
+inline const ::proto::CommandTooLargeError& Error::command_too_large() const {
+  // @@protoc_insertion_point(field_get:proto.Error.command_too_large)
+  return command_too_large_ != NULL ? *command_too_large_ : *default_instance_->command_too_large_;
+}
+inline ::proto::CommandTooLargeError* Error::mutable_command_too_large() {
+  set_has_command_too_large();
+  if (command_too_large_ == NULL) command_too_large_ = new ::proto::CommandTooLargeError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.command_too_large)
+  return command_too_large_;
+}
+inline ::proto::CommandTooLargeError* Error::release_command_too_large() {
+  clear_has_command_too_large();
+  ::proto::CommandTooLargeError* temp = command_too_large_;
+  command_too_large_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_command_too_large(::proto::CommandTooLargeError* command_too_large) {
+  delete command_too_large_;
+  command_too_large_ = command_too_large;
+  if (command_too_large) {
+    set_has_command_too_large();
+  } else {
+    clear_has_command_too_large();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.command_too_large)
+}
+
+// optional .proto.ProposalBufferFullError proposal_buffer_full = 22;
+inline bool Error::has_proposal_buffer_full() const {
+  return (_has_bits_[0] & 0x00200000u) != 0;
+}
+inline void Error::set_has_proposal_buffer_full() {
+  _has_bits_[0] |= 0x00200000u;
+}
+inline void Error::clear_has_proposal_buffer_full() {
+  _has_bits_[0] &= ~0x00200000u;
+}
+inline void Error::clear_proposal_buffer_full() {
+  if (proposal_buffer_full_ != NULL) proposal_buffer_full_->::proto::ProposalBufferFullError::Clear();
+  clear_has_proposal_buffer_full();
+}
+inline const ::proto::ProposalBufferFullError& Error::proposal_buffer_full() const {
+  // @@protoc_insertion_point(field_get:proto.Error.proposal_buffer_full)
+  return proposal_buffer_full_ != NULL ? *proposal_buffer_full_ : *default_instance_->proposal_buffer_full_;
+}
+inline ::proto::ProposalBufferFullError* Error::mutable_proposal_buffer_full() {
+  set_has_proposal_buffer_full();
+  if (proposal_buffer_full_ == NULL) proposal_buffer_full_ = new ::proto::ProposalBufferFullError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.proposal_buffer_full)
+  return proposal_buffer_full_;
+}
+inline ::proto::ProposalBufferFullError* Error::release_proposal_buffer_full() {
+  clear_has_proposal_buffer_full();
+  ::proto::ProposalBufferFullError* temp = proposal_buffer_full_;
+  proposal_buffer_full_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_proposal_buffer_full(::proto::ProposalBufferFullError* proposal_buffer_full) {
+  delete proposal_buffer_full_;
+  proposal_buffer_full_ = proposal_buffer_full;
+  if (proposal_buffer_full) {
+    set_has_proposal_buffer_full();
+  } else {
+    clear_has_proposal_buffer_full();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.proposal_buffer_full)
+}
+
+// optional .proto.TimestampTooFarError timestamp_too_far = 23;
+inline bool Error::has_timestamp_too_far() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+inline void Error::set_has_timestamp_too_far() {
+  _has_bits_[0] |= 0x00400000u;
+}
+inline void Error::clear_has_timestamp_too_far() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+inline void Error::clear_timestamp_too_far() {
+  if (timestamp_too_far_ != NULL) timestamp_too_far_->::proto::TimestampTooFarError::Clear();
+  clear_has_timestamp_too_far();
+}
+inline const ::proto::TimestampTooFarError& Error::timestamp_too_far() const {
+  // @@protoc_insertion_point(field_get:proto.Error.timestamp_too_far)
+  return timestamp_too_far_ != NULL ? *timestamp_too_far_ : *default_instance_->timestamp_too_far_;
+}
+inline ::proto::TimestampTooFarError* Error::mutable_timestamp_too_far() {
+  set_has_timestamp_too_far();
+  if (timestamp_too_far_ == NULL) timestamp_too_far_ = new ::proto::TimestampTooFarError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.timestamp_too_far)
+  return timestamp_too_far_;
+}
+inline ::proto::TimestampTooFarError* Error::release_timestamp_too_far() {
+  clear_has_timestamp_too_far();
+  ::proto::TimestampTooFarError* temp = timestamp_too_far_;
+  timestamp_too_far_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_timestamp_too_far(::proto::TimestampTooFarError* timestamp_too_far) {
+  delete timestamp_too_far_;
+  timestamp_too_far_ = timestamp_too_far;
+  if (timestamp_too_far) {
+    set_has_timestamp_too_far();
+  } else {
+    clear_has_timestamp_too_far();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.timestamp_too_far)
+}
+
+// optional .proto.CommandQueueTimeoutError command_queue_timeout = 24;
+inline bool Error::has_command_queue_timeout() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+inline void Error::set_has_command_queue_timeout() {
+  _has_bits_[0] |= 0x00800000u;
+}
+inline void Error::clear_has_command_queue_timeout() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+inline void Error::clear_command_queue_timeout() {
+  if (command_queue_timeout_ != NULL) command_queue_timeout_->::proto::CommandQueueTimeoutError::Clear();
+  clear_has_command_queue_timeout();
+}
+inline const ::proto::CommandQueueTimeoutError& Error::command_queue_timeout() const {
+  // @@protoc_insertion_point(field_get:proto.Error.command_queue_timeout)
+  return command_queue_timeout_ != NULL ? *command_queue_timeout_ : *default_instance_->command_queue_timeout_;
+}
+inline ::proto::CommandQueueTimeoutError* Error::mutable_command_queue_timeout() {
+  set_has_command_queue_timeout();
+  if (command_queue_timeout_ == NULL) command_queue_timeout_ = new ::proto::CommandQueueTimeoutError;
+  // @@protoc_insertion_point(field_mutable:proto.Error.command_queue_timeout)
+  return command_queue_timeout_;
+}
+inline ::proto::CommandQueueTimeoutError* Error::release_command_queue_timeout() {
+  clear_has_command_queue_timeout();
+  ::proto::CommandQueueTimeoutError* temp = command_queue_timeout_;
+  command_queue_timeout_ = NULL;
+  return temp;
+}
+inline void Error::set_allocated_command_queue_timeout(::proto::CommandQueueTimeoutError* command_queue_timeout) {
+  delete command_queue_timeout_;
+  command_queue_timeout_ = command_queue_timeout;
+  if (command_queue_timeout) {
+    set_has_command_queue_timeout();
+  } else {
+    clear_has_command_queue_timeout();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.Error.command_queue_timeout)
+}
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -20,7 +20,6 @@ package engine
 import (
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util/log"
-	gogoproto "github.com/gogo/protobuf/proto"
 )
 
 // GarbageCollector GCs MVCC key/values using a zone-specific GC
@@ -58,13 +57,13 @@ func (gc *GarbageCollector) Filter(keys []proto.EncodedKey, values [][]byte) pro
 	delTS := proto.ZeroTimestamp
 	survivors := false
 	for i, key := range keys {
-		_, ts, isValue := MVCCDecodeKey(key)
+		decKey, ts, isValue := MVCCDecodeKey(key)
 		if !isValue {
 			log.Errorf("unexpected MVCC metadata encountered: %q", key)
 			return proto.ZeroTimestamp
 		}
 		mvccVal := proto.MVCCValue{}
-		if err := gogoproto.Unmarshal(values[i], &mvccVal); err != nil {
+		if err := decodeMVCCValue(values[i], isRawValueKey(decKey), &mvccVal); err != nil {
 			log.Errorf("unable to unmarshal MVCC value %q: %v", key, err)
 			return proto.ZeroTimestamp
 		}
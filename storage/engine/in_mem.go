@@ -34,3 +34,9 @@ func NewInMem(attrs proto.Attributes, cacheSize int64) *InMem {
 	}
 	return db
 }
+
+// PendingBytes always returns 0 as an in-memory engine has nowhere
+// to flush its writes to.
+func (db *InMem) PendingBytes() int64 {
+	return 0
+}
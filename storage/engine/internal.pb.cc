@@ -65,9 +65,105 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* InternalTruncateLogResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   InternalTruncateLogResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalBeginTransactionRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalBeginTransactionRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalBeginTransactionResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalBeginTransactionResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalTxnIntents_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalTxnIntents_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalScanIntentsRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalScanIntentsRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalScanIntentsResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalScanIntentsResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalInspectTimestampCacheRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalInspectTimestampCacheRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalInspectTimestampCacheResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalInspectTimestampCacheResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalGetTransactionRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalGetTransactionRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalGetTransactionResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalGetTransactionResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalPutIfAbsentRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalPutIfAbsentRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalPutIfAbsentResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalPutIfAbsentResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ScanResumeToken_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ScanResumeToken_reflection_ = NULL;
+const ::google::protobuf::Descriptor* AuditEntry_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  AuditEntry_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalRangeKeyBoundsRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalRangeKeyBoundsRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalRangeKeyBoundsResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalRangeKeyBoundsResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalVerifyRangeRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalVerifyRangeRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalVerifyRangeResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalVerifyRangeResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalResolveIntentRangeRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalResolveIntentRangeRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalResolveIntentRangeResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalResolveIntentRangeResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalLeaderLeaseRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalLeaderLeaseRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalLeaderLeaseResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalLeaderLeaseResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalComputeChecksumRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalComputeChecksumRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalComputeChecksumResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalComputeChecksumResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* MVCCVersion_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  MVCCVersion_reflection_ = NULL;
+const ::google::protobuf::Descriptor* BatchGetValue_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  BatchGetValue_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalGetHistoryRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalGetHistoryRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalGetHistoryResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalGetHistoryResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalBatchGetRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalBatchGetRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* InternalBatchGetResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  InternalBatchGetResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ReadWriteCmdResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ReadWriteCmdResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ResponseCacheEntry_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ResponseCacheEntry_reflection_ = NULL;
+const ::google::protobuf::Descriptor* Lease_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  Lease_reflection_ = NULL;
+const ::google::protobuf::Descriptor* LeaseTransfer_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  LeaseTransfer_reflection_ = NULL;
 const ::google::protobuf::Descriptor* InternalRaftCommandUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   InternalRaftCommandUnion_reflection_ = NULL;
@@ -154,10 +250,12 @@ void protobuf_AssignDesc_internal_2eproto() {
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalHeartbeatTxnResponse));
   InternalGCRequest_descriptor_ = file->message_type(4);
-  static const int InternalGCRequest_offsets_[3] = {
+  static const int InternalGCRequest_offsets_[5] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, gc_meta_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, keys_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, txn_keys_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGCRequest, txn_threshold_),
   };
   InternalGCRequest_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -326,8 +424,476 @@ void protobuf_AssignDesc_internal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalTruncateLogResponse));
-  ReadWriteCmdResponse_descriptor_ = file->message_type(14);
-  static const int ReadWriteCmdResponse_offsets_[15] = {
+  InternalBeginTransactionRequest_descriptor_ = file->message_type(14);
+  static const int InternalBeginTransactionRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBeginTransactionRequest, header_),
+  };
+  InternalBeginTransactionRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalBeginTransactionRequest_descriptor_,
+      InternalBeginTransactionRequest::default_instance_,
+      InternalBeginTransactionRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBeginTransactionRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBeginTransactionRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalBeginTransactionRequest));
+  InternalBeginTransactionResponse_descriptor_ = file->message_type(15);
+  static const int InternalBeginTransactionResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBeginTransactionResponse, header_),
+  };
+  InternalBeginTransactionResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalBeginTransactionResponse_descriptor_,
+      InternalBeginTransactionResponse::default_instance_,
+      InternalBeginTransactionResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBeginTransactionResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBeginTransactionResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalBeginTransactionResponse));
+  InternalTxnIntents_descriptor_ = file->message_type(16);
+  static const int InternalTxnIntents_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTxnIntents, txn_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTxnIntents, keys_),
+  };
+  InternalTxnIntents_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalTxnIntents_descriptor_,
+      InternalTxnIntents::default_instance_,
+      InternalTxnIntents_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTxnIntents, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTxnIntents, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalTxnIntents));
+  InternalScanIntentsRequest_descriptor_ = file->message_type(17);
+  static const int InternalScanIntentsRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalScanIntentsRequest, header_),
+  };
+  InternalScanIntentsRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalScanIntentsRequest_descriptor_,
+      InternalScanIntentsRequest::default_instance_,
+      InternalScanIntentsRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalScanIntentsRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalScanIntentsRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalScanIntentsRequest));
+  InternalScanIntentsResponse_descriptor_ = file->message_type(18);
+  static const int InternalScanIntentsResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalScanIntentsResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalScanIntentsResponse, txn_intents_),
+  };
+  InternalScanIntentsResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalScanIntentsResponse_descriptor_,
+      InternalScanIntentsResponse::default_instance_,
+      InternalScanIntentsResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalScanIntentsResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalScanIntentsResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalScanIntentsResponse));
+  InternalInspectTimestampCacheRequest_descriptor_ = file->message_type(19);
+  static const int InternalInspectTimestampCacheRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheRequest, header_),
+  };
+  InternalInspectTimestampCacheRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalInspectTimestampCacheRequest_descriptor_,
+      InternalInspectTimestampCacheRequest::default_instance_,
+      InternalInspectTimestampCacheRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalInspectTimestampCacheRequest));
+  InternalInspectTimestampCacheResponse_descriptor_ = file->message_type(20);
+  static const int InternalInspectTimestampCacheResponse_offsets_[5] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheResponse, read_timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheResponse, write_timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheResponse, read_low_water_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheResponse, write_low_water_),
+  };
+  InternalInspectTimestampCacheResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalInspectTimestampCacheResponse_descriptor_,
+      InternalInspectTimestampCacheResponse::default_instance_,
+      InternalInspectTimestampCacheResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalInspectTimestampCacheResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalInspectTimestampCacheResponse));
+  InternalGetTransactionRequest_descriptor_ = file->message_type(21);
+  static const int InternalGetTransactionRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionRequest, txn_id_),
+  };
+  InternalGetTransactionRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalGetTransactionRequest_descriptor_,
+      InternalGetTransactionRequest::default_instance_,
+      InternalGetTransactionRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalGetTransactionRequest));
+  InternalGetTransactionResponse_descriptor_ = file->message_type(22);
+  static const int InternalGetTransactionResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionResponse, txn_),
+  };
+  InternalGetTransactionResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalGetTransactionResponse_descriptor_,
+      InternalGetTransactionResponse::default_instance_,
+      InternalGetTransactionResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetTransactionResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalGetTransactionResponse));
+  InternalPutIfAbsentRequest_descriptor_ = file->message_type(23);
+  static const int InternalPutIfAbsentRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentRequest, value_),
+  };
+  InternalPutIfAbsentRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalPutIfAbsentRequest_descriptor_,
+      InternalPutIfAbsentRequest::default_instance_,
+      InternalPutIfAbsentRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalPutIfAbsentRequest));
+  InternalPutIfAbsentResponse_descriptor_ = file->message_type(24);
+  static const int InternalPutIfAbsentResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentResponse, inserted_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentResponse, value_),
+  };
+  InternalPutIfAbsentResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalPutIfAbsentResponse_descriptor_,
+      InternalPutIfAbsentResponse::default_instance_,
+      InternalPutIfAbsentResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalPutIfAbsentResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalPutIfAbsentResponse));
+  ScanResumeToken_descriptor_ = file->message_type(25);
+  static const int ScanResumeToken_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResumeToken, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResumeToken, raft_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResumeToken, start_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResumeToken, end_key_),
+  };
+  ScanResumeToken_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ScanResumeToken_descriptor_,
+      ScanResumeToken::default_instance_,
+      ScanResumeToken_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResumeToken, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResumeToken, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ScanResumeToken));
+  AuditEntry_descriptor_ = file->message_type(26);
+  static const int AuditEntry_offsets_[7] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, user_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, method_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, end_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, old_value_hash_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, new_value_hash_),
+  };
+  AuditEntry_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      AuditEntry_descriptor_,
+      AuditEntry::default_instance_,
+      AuditEntry_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AuditEntry, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(AuditEntry));
+  InternalRangeKeyBoundsRequest_descriptor_ = file->message_type(27);
+  static const int InternalRangeKeyBoundsRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsRequest, header_),
+  };
+  InternalRangeKeyBoundsRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalRangeKeyBoundsRequest_descriptor_,
+      InternalRangeKeyBoundsRequest::default_instance_,
+      InternalRangeKeyBoundsRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalRangeKeyBoundsRequest));
+  InternalRangeKeyBoundsResponse_descriptor_ = file->message_type(28);
+  static const int InternalRangeKeyBoundsResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsResponse, min_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsResponse, max_key_),
+  };
+  InternalRangeKeyBoundsResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalRangeKeyBoundsResponse_descriptor_,
+      InternalRangeKeyBoundsResponse::default_instance_,
+      InternalRangeKeyBoundsResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeKeyBoundsResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalRangeKeyBoundsResponse));
+  InternalVerifyRangeRequest_descriptor_ = file->message_type(29);
+  static const int InternalVerifyRangeRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeRequest, max_keys_),
+  };
+  InternalVerifyRangeRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalVerifyRangeRequest_descriptor_,
+      InternalVerifyRangeRequest::default_instance_,
+      InternalVerifyRangeRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalVerifyRangeRequest));
+  InternalVerifyRangeResponse_descriptor_ = file->message_type(30);
+  static const int InternalVerifyRangeResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeResponse, out_of_bounds_keys_),
+  };
+  InternalVerifyRangeResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalVerifyRangeResponse_descriptor_,
+      InternalVerifyRangeResponse::default_instance_,
+      InternalVerifyRangeResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalVerifyRangeResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalVerifyRangeResponse));
+  InternalResolveIntentRangeRequest_descriptor_ = file->message_type(31);
+  static const int InternalResolveIntentRangeRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, header_),
+  };
+  InternalResolveIntentRangeRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalResolveIntentRangeRequest_descriptor_,
+      InternalResolveIntentRangeRequest::default_instance_,
+      InternalResolveIntentRangeRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalResolveIntentRangeRequest));
+  InternalResolveIntentRangeResponse_descriptor_ = file->message_type(32);
+  static const int InternalResolveIntentRangeResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeResponse, header_),
+  };
+  InternalResolveIntentRangeResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalResolveIntentRangeResponse_descriptor_,
+      InternalResolveIntentRangeResponse::default_instance_,
+      InternalResolveIntentRangeResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalResolveIntentRangeResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalResolveIntentRangeResponse));
+  InternalLeaderLeaseRequest_descriptor_ = file->message_type(33);
+  static const int InternalLeaderLeaseRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, lease_),
+  };
+  InternalLeaderLeaseRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalLeaderLeaseRequest_descriptor_,
+      InternalLeaderLeaseRequest::default_instance_,
+      InternalLeaderLeaseRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalLeaderLeaseRequest));
+  InternalLeaderLeaseResponse_descriptor_ = file->message_type(34);
+  static const int InternalLeaderLeaseResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseResponse, header_),
+  };
+  InternalLeaderLeaseResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalLeaderLeaseResponse_descriptor_,
+      InternalLeaderLeaseResponse::default_instance_,
+      InternalLeaderLeaseResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalLeaderLeaseResponse));
+  InternalComputeChecksumRequest_descriptor_ = file->message_type(35);
+  static const int InternalComputeChecksumRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalComputeChecksumRequest, header_),
+  };
+  InternalComputeChecksumRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalComputeChecksumRequest_descriptor_,
+      InternalComputeChecksumRequest::default_instance_,
+      InternalComputeChecksumRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalComputeChecksumRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalComputeChecksumRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalComputeChecksumRequest));
+  InternalComputeChecksumResponse_descriptor_ = file->message_type(36);
+  static const int InternalComputeChecksumResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalComputeChecksumResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalComputeChecksumResponse, checksum_),
+  };
+  InternalComputeChecksumResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalComputeChecksumResponse_descriptor_,
+      InternalComputeChecksumResponse::default_instance_,
+      InternalComputeChecksumResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalComputeChecksumResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalComputeChecksumResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalComputeChecksumResponse));
+  MVCCVersion_descriptor_ = file->message_type(37);
+  static const int MVCCVersion_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCVersion, timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCVersion, deleted_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCVersion, value_),
+  };
+  MVCCVersion_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      MVCCVersion_descriptor_,
+      MVCCVersion::default_instance_,
+      MVCCVersion_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCVersion, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MVCCVersion, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(MVCCVersion));
+  BatchGetValue_descriptor_ = file->message_type(38);
+  static const int BatchGetValue_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchGetValue, value_),
+  };
+  BatchGetValue_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      BatchGetValue_descriptor_,
+      BatchGetValue::default_instance_,
+      BatchGetValue_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchGetValue, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchGetValue, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(BatchGetValue));
+  InternalGetHistoryRequest_descriptor_ = file->message_type(39);
+  static const int InternalGetHistoryRequest_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryRequest, before_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryRequest, max_versions_),
+  };
+  InternalGetHistoryRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalGetHistoryRequest_descriptor_,
+      InternalGetHistoryRequest::default_instance_,
+      InternalGetHistoryRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalGetHistoryRequest));
+  InternalGetHistoryResponse_descriptor_ = file->message_type(40);
+  static const int InternalGetHistoryResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryResponse, versions_),
+  };
+  InternalGetHistoryResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalGetHistoryResponse_descriptor_,
+      InternalGetHistoryResponse::default_instance_,
+      InternalGetHistoryResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalGetHistoryResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalGetHistoryResponse));
+  InternalBatchGetRequest_descriptor_ = file->message_type(41);
+  static const int InternalBatchGetRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetRequest, keys_),
+  };
+  InternalBatchGetRequest_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalBatchGetRequest_descriptor_,
+      InternalBatchGetRequest::default_instance_,
+      InternalBatchGetRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetRequest, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetRequest, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalBatchGetRequest));
+  InternalBatchGetResponse_descriptor_ = file->message_type(42);
+  static const int InternalBatchGetResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetResponse, values_),
+  };
+  InternalBatchGetResponse_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      InternalBatchGetResponse_descriptor_,
+      InternalBatchGetResponse::default_instance_,
+      InternalBatchGetResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetResponse, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchGetResponse, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(InternalBatchGetResponse));
+  ReadWriteCmdResponse_descriptor_ = file->message_type(43);
+  static const int ReadWriteCmdResponse_offsets_[22] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, put_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, conditional_put_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, increment_),
@@ -343,6 +909,13 @@ void protobuf_AssignDesc_internal_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, internal_merge_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, internal_truncate_log_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, internal_gc_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, internal_begin_transaction_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, internal_put_if_absent_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, get_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, conditional_delete_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, batch_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, internal_resolve_intent_range_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, internal_leader_lease_),
   };
   ReadWriteCmdResponse_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -355,8 +928,59 @@ void protobuf_AssignDesc_internal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(ReadWriteCmdResponse));
-  InternalRaftCommandUnion_descriptor_ = file->message_type(15);
-  static const int InternalRaftCommandUnion_offsets_[20] = {
+  ResponseCacheEntry_descriptor_ = file->message_type(44);
+  static const int ResponseCacheEntry_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseCacheEntry, cmd_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseCacheEntry, response_),
+  };
+  ResponseCacheEntry_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      ResponseCacheEntry_descriptor_,
+      ResponseCacheEntry::default_instance_,
+      ResponseCacheEntry_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseCacheEntry, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseCacheEntry, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(ResponseCacheEntry));
+  Lease_descriptor_ = file->message_type(45);
+  static const int Lease_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, start_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, expiration_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, replica_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, epoch_),
+  };
+  Lease_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      Lease_descriptor_,
+      Lease::default_instance_,
+      Lease_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Lease, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(Lease));
+  LeaseTransfer_descriptor_ = file->message_type(46);
+  static const int LeaseTransfer_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaseTransfer, fence_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaseTransfer, response_cache_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaseTransfer, holder_),
+  };
+  LeaseTransfer_reflection_ =
+    new ::google::protobuf::internal::GeneratedMessageReflection(
+      LeaseTransfer_descriptor_,
+      LeaseTransfer::default_instance_,
+      LeaseTransfer_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaseTransfer, _has_bits_[0]),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaseTransfer, _unknown_fields_),
+      -1,
+      ::google::protobuf::DescriptorPool::generated_pool(),
+      ::google::protobuf::MessageFactory::generated_factory(),
+      sizeof(LeaseTransfer));
+  InternalRaftCommandUnion_descriptor_ = file->message_type(47);
+  static const int InternalRaftCommandUnion_offsets_[34] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, contains_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, get_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, put_),
@@ -369,6 +993,8 @@ void protobuf_AssignDesc_internal_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, reap_queue_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, enqueue_update_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, enqueue_message_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, reverse_scan_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, conditional_delete_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, batch_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_range_lookup_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_heartbeat_txn_),
@@ -377,6 +1003,18 @@ void protobuf_AssignDesc_internal_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_merge_response_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_truncate_log_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_gc_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_begin_transaction_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_scan_intents_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_inspect_timestamp_cache_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_get_transaction_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_put_if_absent_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_range_key_bounds_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_verify_range_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_resolve_intent_range_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_leader_lease_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_compute_checksum_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_get_history_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommandUnion, internal_batch_get_),
   };
   InternalRaftCommandUnion_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -389,10 +1027,12 @@ void protobuf_AssignDesc_internal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalRaftCommandUnion));
-  InternalRaftCommand_descriptor_ = file->message_type(16);
-  static const int InternalRaftCommand_offsets_[2] = {
+  InternalRaftCommand_descriptor_ = file->message_type(48);
+  static const int InternalRaftCommand_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, raft_id_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, cmd_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, generation_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRaftCommand, lease_epoch_),
   };
   InternalRaftCommand_reflection_ =
     new ::google::protobuf::internal::GeneratedMessageReflection(
@@ -405,7 +1045,7 @@ void protobuf_AssignDesc_internal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalRaftCommand));
-  InternalTimeSeriesData_descriptor_ = file->message_type(17);
+  InternalTimeSeriesData_descriptor_ = file->message_type(49);
   static const int InternalTimeSeriesData_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, start_timestamp_nanos_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesData, sample_duration_nanos_),
@@ -422,7 +1062,7 @@ void protobuf_AssignDesc_internal_2eproto() {
       ::google::protobuf::DescriptorPool::generated_pool(),
       ::google::protobuf::MessageFactory::generated_factory(),
       sizeof(InternalTimeSeriesData));
-  InternalTimeSeriesSample_descriptor_ = file->message_type(18);
+  InternalTimeSeriesSample_descriptor_ = file->message_type(50);
   static const int InternalTimeSeriesSample_offsets_[9] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, offset_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalTimeSeriesSample, int_count_),
@@ -488,8 +1128,72 @@ void protobuf_RegisterTypes(const ::std::string&) {
     InternalTruncateLogRequest_descriptor_, &InternalTruncateLogRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     InternalTruncateLogResponse_descriptor_, &InternalTruncateLogResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalBeginTransactionRequest_descriptor_, &InternalBeginTransactionRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalBeginTransactionResponse_descriptor_, &InternalBeginTransactionResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalTxnIntents_descriptor_, &InternalTxnIntents::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalScanIntentsRequest_descriptor_, &InternalScanIntentsRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalScanIntentsResponse_descriptor_, &InternalScanIntentsResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalInspectTimestampCacheRequest_descriptor_, &InternalInspectTimestampCacheRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalInspectTimestampCacheResponse_descriptor_, &InternalInspectTimestampCacheResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalGetTransactionRequest_descriptor_, &InternalGetTransactionRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalGetTransactionResponse_descriptor_, &InternalGetTransactionResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalPutIfAbsentRequest_descriptor_, &InternalPutIfAbsentRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalPutIfAbsentResponse_descriptor_, &InternalPutIfAbsentResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ScanResumeToken_descriptor_, &ScanResumeToken::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    AuditEntry_descriptor_, &AuditEntry::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalRangeKeyBoundsRequest_descriptor_, &InternalRangeKeyBoundsRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalRangeKeyBoundsResponse_descriptor_, &InternalRangeKeyBoundsResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalVerifyRangeRequest_descriptor_, &InternalVerifyRangeRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalVerifyRangeResponse_descriptor_, &InternalVerifyRangeResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalResolveIntentRangeRequest_descriptor_, &InternalResolveIntentRangeRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalResolveIntentRangeResponse_descriptor_, &InternalResolveIntentRangeResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalLeaderLeaseRequest_descriptor_, &InternalLeaderLeaseRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalLeaderLeaseResponse_descriptor_, &InternalLeaderLeaseResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalComputeChecksumRequest_descriptor_, &InternalComputeChecksumRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalComputeChecksumResponse_descriptor_, &InternalComputeChecksumResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    MVCCVersion_descriptor_, &MVCCVersion::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    BatchGetValue_descriptor_, &BatchGetValue::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalGetHistoryRequest_descriptor_, &InternalGetHistoryRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalGetHistoryResponse_descriptor_, &InternalGetHistoryResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalBatchGetRequest_descriptor_, &InternalBatchGetRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    InternalBatchGetResponse_descriptor_, &InternalBatchGetResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     ReadWriteCmdResponse_descriptor_, &ReadWriteCmdResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    ResponseCacheEntry_descriptor_, &ResponseCacheEntry::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    Lease_descriptor_, &Lease::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+    LeaseTransfer_descriptor_, &LeaseTransfer::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
     InternalRaftCommandUnion_descriptor_, &InternalRaftCommandUnion::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -533,8 +1237,72 @@ void protobuf_ShutdownFile_internal_2eproto() {
   delete InternalTruncateLogRequest_reflection_;
   delete InternalTruncateLogResponse::default_instance_;
   delete InternalTruncateLogResponse_reflection_;
+  delete InternalBeginTransactionRequest::default_instance_;
+  delete InternalBeginTransactionRequest_reflection_;
+  delete InternalBeginTransactionResponse::default_instance_;
+  delete InternalBeginTransactionResponse_reflection_;
+  delete InternalTxnIntents::default_instance_;
+  delete InternalTxnIntents_reflection_;
+  delete InternalScanIntentsRequest::default_instance_;
+  delete InternalScanIntentsRequest_reflection_;
+  delete InternalScanIntentsResponse::default_instance_;
+  delete InternalScanIntentsResponse_reflection_;
+  delete InternalInspectTimestampCacheRequest::default_instance_;
+  delete InternalInspectTimestampCacheRequest_reflection_;
+  delete InternalInspectTimestampCacheResponse::default_instance_;
+  delete InternalInspectTimestampCacheResponse_reflection_;
+  delete InternalGetTransactionRequest::default_instance_;
+  delete InternalGetTransactionRequest_reflection_;
+  delete InternalGetTransactionResponse::default_instance_;
+  delete InternalGetTransactionResponse_reflection_;
+  delete InternalPutIfAbsentRequest::default_instance_;
+  delete InternalPutIfAbsentRequest_reflection_;
+  delete InternalPutIfAbsentResponse::default_instance_;
+  delete InternalPutIfAbsentResponse_reflection_;
+  delete ScanResumeToken::default_instance_;
+  delete ScanResumeToken_reflection_;
+  delete AuditEntry::default_instance_;
+  delete AuditEntry_reflection_;
+  delete InternalRangeKeyBoundsRequest::default_instance_;
+  delete InternalRangeKeyBoundsRequest_reflection_;
+  delete InternalRangeKeyBoundsResponse::default_instance_;
+  delete InternalRangeKeyBoundsResponse_reflection_;
+  delete InternalVerifyRangeRequest::default_instance_;
+  delete InternalVerifyRangeRequest_reflection_;
+  delete InternalVerifyRangeResponse::default_instance_;
+  delete InternalVerifyRangeResponse_reflection_;
+  delete InternalResolveIntentRangeRequest::default_instance_;
+  delete InternalResolveIntentRangeRequest_reflection_;
+  delete InternalResolveIntentRangeResponse::default_instance_;
+  delete InternalResolveIntentRangeResponse_reflection_;
+  delete InternalLeaderLeaseRequest::default_instance_;
+  delete InternalLeaderLeaseRequest_reflection_;
+  delete InternalLeaderLeaseResponse::default_instance_;
+  delete InternalLeaderLeaseResponse_reflection_;
+  delete InternalComputeChecksumRequest::default_instance_;
+  delete InternalComputeChecksumRequest_reflection_;
+  delete InternalComputeChecksumResponse::default_instance_;
+  delete InternalComputeChecksumResponse_reflection_;
+  delete MVCCVersion::default_instance_;
+  delete MVCCVersion_reflection_;
+  delete BatchGetValue::default_instance_;
+  delete BatchGetValue_reflection_;
+  delete InternalGetHistoryRequest::default_instance_;
+  delete InternalGetHistoryRequest_reflection_;
+  delete InternalGetHistoryResponse::default_instance_;
+  delete InternalGetHistoryResponse_reflection_;
+  delete InternalBatchGetRequest::default_instance_;
+  delete InternalBatchGetRequest_reflection_;
+  delete InternalBatchGetResponse::default_instance_;
+  delete InternalBatchGetResponse_reflection_;
   delete ReadWriteCmdResponse::default_instance_;
   delete ReadWriteCmdResponse_reflection_;
+  delete ResponseCacheEntry::default_instance_;
+  delete ResponseCacheEntry_reflection_;
+  delete Lease::default_instance_;
+  delete Lease_reflection_;
+  delete LeaseTransfer::default_instance_;
+  delete LeaseTransfer_reflection_;
   delete InternalRaftCommandUnion::default_instance_;
   delete InternalRaftCommandUnion_reflection_;
   delete InternalRaftCommand::default_instance_;
@@ -568,94 +1336,225 @@ void protobuf_AddDesc_internal_2eproto() {
     "nRequest\022.\n\006header\030\001 \001(\0132\024.proto.Request"
     "HeaderB\010\310\336\037\000\320\336\037\001\"O\n\034InternalHeartbeatTxn"
     "Response\022/\n\006header\030\001 \001(\0132\025.proto.Respons"
-    "eHeaderB\010\310\336\037\000\320\336\037\001\"\371\001\n\021InternalGCRequest\022"
+    "eHeaderB\010\310\336\037\000\320\336\037\001\"\307\002\n\021InternalGCRequest\022"
     ".\n\006header\030\001 \001(\0132\024.proto.RequestHeaderB\010\310"
     "\336\037\000\320\336\037\001\0222\n\007gc_meta\030\002 \001(\0132\021.proto.GCMetad"
     "ataB\016\310\336\037\000\342\336\037\006GCMeta\0222\n\004keys\030\003 \003(\0132\036.prot"
-    "o.InternalGCRequest.GCKeyB\004\310\336\037\000\032L\n\005GCKey"
-    "\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022)\n\ttimestamp\030"
-    "\002 \001(\0132\020.proto.TimestampB\004\310\336\037\000\"E\n\022Interna"
-    "lGCResponse\022/\n\006header\030\001 \001(\0132\025.proto.Resp"
-    "onseHeaderB\010\310\336\037\000\320\336\037\001\"\213\001\n\026InternalPushTxn"
+    "o.InternalGCRequest.GCKeyB\004\310\336\037\000\022\035\n\010txn_k"
+    "eys\030\004 \003(\014B\013\310\336\037\000\332\336\037\003Key\022-\n\rtxn_threshold\030"
+    "\005 \001(\0132\020.proto.TimestampB\004\310\336\037\000\032L\n\005GCKey\022\030"
+    "\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336\037\003Key\022)\n\ttimestamp\030\002 "
+    "\001(\0132\020.proto.TimestampB\004\310\336\037\000\"E\n\022InternalG"
+    "CResponse\022/\n\006header\030\001 \001(\0132\025.proto.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001\"\213\001\n\026InternalPushTxnRe"
+    "quest\022.\n\006header\030\001 \001(\0132\024.proto.RequestHea"
+    "derB\010\310\336\037\000\320\336\037\001\022,\n\npushee_txn\030\002 \001(\0132\022.prot"
+    "o.TransactionB\004\310\336\037\000\022\023\n\005Abort\030\003 \001(\010B\004\310\336\037\000"
+    "\"r\n\027InternalPushTxnResponse\022/\n\006header\030\001 "
+    "\001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022&\n\n"
+    "pushee_txn\030\002 \001(\0132\022.proto.Transaction\"N\n\034"
+    "InternalResolveIntentRequest\022.\n\006header\030\001"
+    " \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"P\n\035"
+    "InternalResolveIntentResponse\022/\n\006header\030"
+    "\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"i"
+    "\n\024InternalMergeRequest\022.\n\006header\030\001 \001(\0132\024"
+    ".proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022!\n\005value\030"
+    "\002 \001(\0132\014.proto.ValueB\004\310\336\037\000\"H\n\025InternalMer"
+    "geResponse\022/\n\006header\030\001 \001(\0132\025.proto.Respo"
+    "nseHeaderB\010\310\336\037\000\320\336\037\001\"a\n\032InternalTruncateL"
+    "ogRequest\022.\n\006header\030\001 \001(\0132\024.proto.Reques"
+    "tHeaderB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030\002 \001(\004B\004\310\336\037\000\"N"
+    "\n\033InternalTruncateLogResponse\022/\n\006header\030"
+    "\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"Q"
+    "\n\037InternalBeginTransactionRequest\022.\n\006hea"
+    "der\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037"
+    "\001\"S\n InternalBeginTransactionResponse\022/\n"
+    "\006header\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336"
+    "\037\000\320\336\037\001\"V\n\022InternalTxnIntents\022%\n\003txn\030\001 \001("
+    "\0132\022.proto.TransactionB\004\310\336\037\000\022\031\n\004keys\030\002 \003("
+    "\014B\013\310\336\037\000\332\336\037\003Key\"L\n\032InternalScanIntentsReq"
+    "uest\022.\n\006header\030\001 \001(\0132\024.proto.RequestHead"
+    "erB\010\310\336\037\000\320\336\037\001\"\204\001\n\033InternalScanIntentsResp"
+    "onse\022/\n\006header\030\001 \001(\0132\025.proto.ResponseHea"
+    "derB\010\310\336\037\000\320\336\037\001\0224\n\013txn_intents\030\002 \003(\0132\031.pro"
+    "to.InternalTxnIntentsB\004\310\336\037\000\"V\n$InternalI"
+    "nspectTimestampCacheRequest\022.\n\006header\030\001 "
+    "\001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"\366\001\n%"
+    "InternalInspectTimestampCacheResponse\022/\n"
+    "\006header\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336"
+    "\037\000\320\336\037\001\022.\n\016read_timestamp\030\002 \001(\0132\020.proto.T"
+    "imestampB\004\310\336\037\000\022/\n\017write_timestamp\030\003 \001(\0132"
+    "\020.proto.TimestampB\004\310\336\037\000\022\034\n\016read_low_wate"
+    "r\030\004 \001(\010B\004\310\336\037\000\022\035\n\017write_low_water\030\005 \001(\010B\004"
+    "\310\336\037\000\"n\n\035InternalGetTransactionRequest\022.\n"
+    "\006header\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037"
+    "\000\320\336\037\001\022\035\n\006txn_id\030\002 \001(\014B\r\310\336\037\000\342\336\037\005TxnID\"r\n\036"
+    "InternalGetTransactionResponse\022/\n\006header"
+    "\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022"
+    "\037\n\003txn\030\002 \001(\0132\022.proto.Transaction\"o\n\032Inte"
+    "rnalPutIfAbsentRequest\022.\n\006header\030\001 \001(\0132\024"
+    ".proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022!\n\005value\030"
+    "\002 \001(\0132\014.proto.ValueB\004\310\336\037\000\"\203\001\n\033InternalPu"
+    "tIfAbsentResponse\022/\n\006header\030\001 \001(\0132\025.prot"
+    "o.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\026\n\010inserted\030\002"
+    " \001(\010B\004\310\336\037\000\022\033\n\005value\030\003 \001(\0132\014.proto.Value\""
+    "\212\001\n\017ScanResumeToken\022\030\n\003key\030\001 \001(\014B\013\310\336\037\000\332\336"
+    "\037\003Key\022\037\n\007raft_id\030\002 \001(\003B\016\310\336\037\000\342\336\037\006RaftID\022\036"
+    "\n\tstart_key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034\n\007end_ke"
+    "y\030\004 \001(\014B\013\310\336\037\000\332\336\037\003Key\"\311\001\n\nAuditEntry\022\022\n\004u"
+    "ser\030\001 \001(\tB\004\310\336\037\000\022\024\n\006method\030\002 \001(\tB\004\310\336\037\000\022\030\n"
+    "\003key\030\003 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034\n\007end_key\030\004 \001(\014"
+    "B\013\310\336\037\000\332\336\037\003Key\022)\n\ttimestamp\030\005 \001(\0132\020.proto"
+    ".TimestampB\004\310\336\037\000\022\026\n\016old_value_hash\030\006 \001(\014"
+    "\022\026\n\016new_value_hash\030\007 \001(\014\"O\n\035InternalRang"
+    "eKeyBoundsRequest\022.\n\006header\030\001 \001(\0132\024.prot"
+    "o.RequestHeaderB\010\310\336\037\000\320\336\037\001\"\215\001\n\036InternalRa"
+    "ngeKeyBoundsResponse\022/\n\006header\030\001 \001(\0132\025.p"
+    "roto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\034\n\007min_key"
+    "\030\002 \001(\014B\013\310\336\037\000\332\336\037\003Key\022\034\n\007max_key\030\003 \001(\014B\013\310\336"
+    "\037\000\332\336\037\003Key\"d\n\032InternalVerifyRangeRequest\022"
+    ".\n\006header\030\001 \001(\0132\024.proto.RequestHeaderB\010\310"
+    "\336\037\000\320\336\037\001\022\026\n\010max_keys\030\002 \001(\003B\004\310\336\037\000\"w\n\033Inter"
+    "nalVerifyRangeResponse\022/\n\006header\030\001 \001(\0132\025"
+    ".proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\'\n\022out_o"
+    "f_bounds_keys\030\002 \003(\014B\013\310\336\037\000\332\336\037\003Key\"S\n!Inte"
+    "rnalResolveIntentRangeRequest\022.\n\006header\030"
+    "\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"U\n"
+    "\"InternalResolveIntentRangeResponse\022/\n\006h"
+    "eader\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\"o\n\032InternalLeaderLeaseRequest\022.\n\006he"
+    "ader\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336"
+    "\037\001\022!\n\005lease\030\002 \001(\0132\014.proto.LeaseB\004\310\336\037\000\"N\n"
+    "\033InternalLeaderLeaseResponse\022/\n\006header\030\001"
+    " \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"P\n"
+    "\036InternalComputeChecksumRequest\022.\n\006heade"
+    "r\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\""
+    "d\n\037InternalComputeChecksumResponse\022/\n\006he"
+    "ader\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320"
+    "\336\037\001\022\020\n\010checksum\030\002 \001(\014\"l\n\013MVCCVersion\022)\n\t"
+    "timestamp\030\001 \001(\0132\020.proto.TimestampB\004\310\336\037\000\022"
+    "\025\n\007deleted\030\002 \001(\010B\004\310\336\037\000\022\033\n\005value\030\003 \001(\0132\014."
+    "proto.Value\",\n\rBatchGetValue\022\033\n\005value\030\001 "
+    "\001(\0132\014.proto.Value\"\217\001\n\031InternalGetHistory"
     "Request\022.\n\006header\030\001 \001(\0132\024.proto.RequestH"
-    "eaderB\010\310\336\037\000\320\336\037\001\022,\n\npushee_txn\030\002 \001(\0132\022.pr"
-    "oto.TransactionB\004\310\336\037\000\022\023\n\005Abort\030\003 \001(\010B\004\310\336"
-    "\037\000\"r\n\027InternalPushTxnResponse\022/\n\006header\030"
-    "\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022&"
-    "\n\npushee_txn\030\002 \001(\0132\022.proto.Transaction\"N"
-    "\n\034InternalResolveIntentRequest\022.\n\006header"
-    "\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"P"
-    "\n\035InternalResolveIntentResponse\022/\n\006heade"
-    "r\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001"
-    "\"i\n\024InternalMergeRequest\022.\n\006header\030\001 \001(\013"
-    "2\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022!\n\005valu"
-    "e\030\002 \001(\0132\014.proto.ValueB\004\310\336\037\000\"H\n\025InternalM"
-    "ergeResponse\022/\n\006header\030\001 \001(\0132\025.proto.Res"
-    "ponseHeaderB\010\310\336\037\000\320\336\037\001\"a\n\032InternalTruncat"
-    "eLogRequest\022.\n\006header\030\001 \001(\0132\024.proto.Requ"
-    "estHeaderB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030\002 \001(\004B\004\310\336\037\000"
-    "\"N\n\033InternalTruncateLogResponse\022/\n\006heade"
-    "r\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001"
-    "\"\277\006\n\024ReadWriteCmdResponse\022\037\n\003put\030\001 \001(\0132\022"
-    ".proto.PutResponse\0226\n\017conditional_put\030\002 "
-    "\001(\0132\035.proto.ConditionalPutResponse\022+\n\tin"
-    "crement\030\003 \001(\0132\030.proto.IncrementResponse\022"
-    "%\n\006delete\030\004 \001(\0132\025.proto.DeleteResponse\0220"
-    "\n\014delete_range\030\005 \001(\0132\032.proto.DeleteRange"
-    "Response\0226\n\017end_transaction\030\006 \001(\0132\035.prot"
-    "o.EndTransactionResponse\022,\n\nreap_queue\030\007"
-    " \001(\0132\030.proto.ReapQueueResponse\0224\n\016enqueu"
-    "e_update\030\010 \001(\0132\034.proto.EnqueueUpdateResp"
-    "onse\0226\n\017enqueue_message\030\t \001(\0132\035.proto.En"
-    "queueMessageResponse\022C\n\026internal_heartbe"
-    "at_txn\030\n \001(\0132#.proto.InternalHeartbeatTx"
-    "nResponse\0229\n\021internal_push_txn\030\013 \001(\0132\036.p"
-    "roto.InternalPushTxnResponse\022E\n\027internal"
-    "_resolve_intent\030\014 \001(\0132$.proto.InternalRe"
-    "solveIntentResponse\0224\n\016internal_merge\030\r "
-    "\001(\0132\034.proto.InternalMergeResponse\022A\n\025int"
-    "ernal_truncate_log\030\016 \001(\0132\".proto.Interna"
-    "lTruncateLogResponse\022.\n\013internal_gc\030\017 \001("
-    "\0132\031.proto.InternalGCResponse:\004\310\240\037\001\"\217\010\n\030I"
-    "nternalRaftCommandUnion\022(\n\010contains\030\001 \001("
-    "\0132\026.proto.ContainsRequest\022\036\n\003get\030\002 \001(\0132\021"
-    ".proto.GetRequest\022\036\n\003put\030\003 \001(\0132\021.proto.P"
-    "utRequest\0225\n\017conditional_put\030\004 \001(\0132\034.pro"
-    "to.ConditionalPutRequest\022*\n\tincrement\030\005 "
-    "\001(\0132\027.proto.IncrementRequest\022$\n\006delete\030\006"
-    " \001(\0132\024.proto.DeleteRequest\022/\n\014delete_ran"
-    "ge\030\007 \001(\0132\031.proto.DeleteRangeRequest\022 \n\004s"
-    "can\030\010 \001(\0132\022.proto.ScanRequest\0225\n\017end_tra"
-    "nsaction\030\t \001(\0132\034.proto.EndTransactionReq"
-    "uest\022+\n\nreap_queue\030\n \001(\0132\027.proto.ReapQue"
-    "ueRequest\0223\n\016enqueue_update\030\013 \001(\0132\033.prot"
-    "o.EnqueueUpdateRequest\0225\n\017enqueue_messag"
-    "e\030\014 \001(\0132\034.proto.EnqueueMessageRequest\022\"\n"
-    "\005batch\030\036 \001(\0132\023.proto.BatchRequest\022@\n\025int"
-    "ernal_range_lookup\030\037 \001(\0132!.proto.Interna"
-    "lRangeLookupRequest\022B\n\026internal_heartbea"
-    "t_txn\030  \001(\0132\".proto.InternalHeartbeatTxn"
-    "Request\0228\n\021internal_push_txn\030! \001(\0132\035.pro"
-    "to.InternalPushTxnRequest\022D\n\027internal_re"
-    "solve_intent\030\" \001(\0132#.proto.InternalResol"
-    "veIntentRequest\022<\n\027internal_merge_respon"
-    "se\030# \001(\0132\033.proto.InternalMergeRequest\022@\n"
-    "\025internal_truncate_log\030$ \001(\0132!.proto.Int"
-    "ernalTruncateLogRequest\022-\n\013internal_gc\030%"
-    " \001(\0132\030.proto.InternalGCRequest:\004\310\240\037\001\"j\n\023"
-    "InternalRaftCommand\022\037\n\007raft_id\030\002 \001(\003B\016\310\336"
-    "\037\000\342\336\037\006RaftID\0222\n\003cmd\030\003 \001(\0132\037.proto.Intern"
-    "alRaftCommandUnionB\004\310\336\037\000\"\224\001\n\026InternalTim"
-    "eSeriesData\022#\n\025start_timestamp_nanos\030\001 \001"
-    "(\003B\004\310\336\037\000\022#\n\025sample_duration_nanos\030\002 \001(\003B"
-    "\004\310\336\037\000\0220\n\007samples\030\003 \003(\0132\037.proto.InternalT"
-    "imeSeriesSample\"\320\001\n\030InternalTimeSeriesSa"
-    "mple\022\024\n\006offset\030\001 \001(\005B\004\310\336\037\000\022\027\n\tint_count\030"
-    "\002 \001(\rB\004\310\336\037\000\022\017\n\007int_sum\030\003 \001(\003\022\017\n\007int_max\030"
-    "\004 \001(\003\022\017\n\007int_min\030\005 \001(\003\022\031\n\013float_count\030\006 "
-    "\001(\rB\004\310\336\037\000\022\021\n\tfloat_sum\030\007 \001(\002\022\021\n\tfloat_ma"
-    "x\030\010 \001(\002\022\021\n\tfloat_min\030\t \001(\002*%\n\021InternalVa"
-    "lueType\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000", 3985);
+    "eaderB\010\310\336\037\000\320\336\037\001\022&\n\006before\030\002 \001(\0132\020.proto."
+    "TimestampB\004\310\336\037\000\022\032\n\014max_versions\030\003 \001(\003B\004\310"
+    "\336\037\000\"y\n\032InternalGetHistoryResponse\022/\n\006hea"
+    "der\030\001 \001(\0132\025.proto.ResponseHeaderB\010\310\336\037\000\320\336"
+    "\037\001\022*\n\010versions\030\002 \003(\0132\022.proto.MVCCVersion"
+    "B\004\310\336\037\000\"d\n\027InternalBatchGetRequest\022.\n\006hea"
+    "der\030\001 \001(\0132\024.proto.RequestHeaderB\010\310\336\037\000\320\336\037"
+    "\001\022\031\n\004keys\030\002 \003(\014B\013\310\336\037\000\332\336\037\003Key\"w\n\030Internal"
+    "BatchGetResponse\022/\n\006header\030\001 \001(\0132\025.proto"
+    ".ResponseHeaderB\010\310\336\037\000\320\336\037\001\022*\n\006values\030\002 \003("
+    "\0132\024.proto.BatchGetValueB\004\310\336\037\000\"\351\t\n\024ReadWr"
+    "iteCmdResponse\022\037\n\003put\030\001 \001(\0132\022.proto.PutR"
+    "esponse\0226\n\017conditional_put\030\002 \001(\0132\035.proto"
+    ".ConditionalPutResponse\022+\n\tincrement\030\003 \001"
+    "(\0132\030.proto.IncrementResponse\022%\n\006delete\030\004"
+    " \001(\0132\025.proto.DeleteResponse\0220\n\014delete_ra"
+    "nge\030\005 \001(\0132\032.proto.DeleteRangeResponse\0226\n"
+    "\017end_transaction\030\006 \001(\0132\035.proto.EndTransa"
+    "ctionResponse\022,\n\nreap_queue\030\007 \001(\0132\030.prot"
+    "o.ReapQueueResponse\0224\n\016enqueue_update\030\010 "
+    "\001(\0132\034.proto.EnqueueUpdateResponse\0226\n\017enq"
+    "ueue_message\030\t \001(\0132\035.proto.EnqueueMessag"
+    "eResponse\022C\n\026internal_heartbeat_txn\030\n \001("
+    "\0132#.proto.InternalHeartbeatTxnResponse\0229"
+    "\n\021internal_push_txn\030\013 \001(\0132\036.proto.Intern"
+    "alPushTxnResponse\022E\n\027internal_resolve_in"
+    "tent\030\014 \001(\0132$.proto.InternalResolveIntent"
+    "Response\0224\n\016internal_merge\030\r \001(\0132\034.proto"
+    ".InternalMergeResponse\022A\n\025internal_trunc"
+    "ate_log\030\016 \001(\0132\".proto.InternalTruncateLo"
+    "gResponse\022.\n\013internal_gc\030\017 \001(\0132\031.proto.I"
+    "nternalGCResponse\022K\n\032internal_begin_tran"
+    "saction\030\020 \001(\0132\'.proto.InternalBeginTrans"
+    "actionResponse\022B\n\026internal_put_if_absent"
+    "\030\021 \001(\0132\".proto.InternalPutIfAbsentRespon"
+    "se\022\037\n\003get\030\022 \001(\0132\022.proto.GetResponse\022<\n\022c"
+    "onditional_delete\030\023 \001(\0132 .proto.Conditio"
+    "nalDeleteResponse\022#\n\005batch\030\024 \001(\0132\024.proto"
+    ".BatchResponse\022P\n\035internal_resolve_inten"
+    "t_range\030\025 \001(\0132).proto.InternalResolveInt"
+    "entRangeResponse\022A\n\025internal_leader_leas"
+    "e\030\026 \001(\0132\".proto.InternalLeaderLeaseRespo"
+    "nse:\004\310\240\037\001\"|\n\022ResponseCacheEntry\0221\n\006cmd_i"
+    "d\030\001 \001(\0132\022.proto.ClientCmdIDB\r\310\336\037\000\342\336\037\005Cmd"
+    "ID\0223\n\010response\030\002 \001(\0132\033.proto.ReadWriteCm"
+    "dResponseB\004\310\336\037\000\"\226\001\n\005Lease\022%\n\005start\030\001 \001(\013"
+    "2\020.proto.TimestampB\004\310\336\037\000\022*\n\nexpiration\030\002"
+    " \001(\0132\020.proto.TimestampB\004\310\336\037\000\022%\n\007replica\030"
+    "\003 \001(\0132\016.proto.ReplicaB\004\310\336\037\000\022\023\n\005epoch\030\004 \001"
+    "(\003B\004\310\336\037\000\"\225\001\n\rLeaseTransfer\022%\n\005fence\030\001 \001("
+    "\0132\020.proto.TimestampB\004\310\336\037\000\0227\n\016response_ca"
+    "che\030\002 \003(\0132\031.proto.ResponseCacheEntryB\004\310\336"
+    "\037\000\022$\n\006holder\030\003 \001(\0132\016.proto.ReplicaB\004\310\336\037\000"
+    "\"\321\017\n\030InternalRaftCommandUnion\022(\n\010contain"
+    "s\030\001 \001(\0132\026.proto.ContainsRequest\022\036\n\003get\030\002"
+    " \001(\0132\021.proto.GetRequest\022\036\n\003put\030\003 \001(\0132\021.p"
+    "roto.PutRequest\0225\n\017conditional_put\030\004 \001(\013"
+    "2\034.proto.ConditionalPutRequest\022*\n\tincrem"
+    "ent\030\005 \001(\0132\027.proto.IncrementRequest\022$\n\006de"
+    "lete\030\006 \001(\0132\024.proto.DeleteRequest\022/\n\014dele"
+    "te_range\030\007 \001(\0132\031.proto.DeleteRangeReques"
+    "t\022 \n\004scan\030\010 \001(\0132\022.proto.ScanRequest\0225\n\017e"
+    "nd_transaction\030\t \001(\0132\034.proto.EndTransact"
+    "ionRequest\022+\n\nreap_queue\030\n \001(\0132\027.proto.R"
+    "eapQueueRequest\0223\n\016enqueue_update\030\013 \001(\0132"
+    "\033.proto.EnqueueUpdateRequest\0225\n\017enqueue_"
+    "message\030\014 \001(\0132\034.proto.EnqueueMessageRequ"
+    "est\022/\n\014reverse_scan\030\r \001(\0132\031.proto.Revers"
+    "eScanRequest\022;\n\022conditional_delete\030\016 \001(\013"
+    "2\037.proto.ConditionalDeleteRequest\022\"\n\005bat"
+    "ch\030\036 \001(\0132\023.proto.BatchRequest\022@\n\025interna"
+    "l_range_lookup\030\037 \001(\0132!.proto.InternalRan"
+    "geLookupRequest\022B\n\026internal_heartbeat_tx"
+    "n\030  \001(\0132\".proto.InternalHeartbeatTxnRequ"
+    "est\0228\n\021internal_push_txn\030! \001(\0132\035.proto.I"
+    "nternalPushTxnRequest\022D\n\027internal_resolv"
+    "e_intent\030\" \001(\0132#.proto.InternalResolveIn"
+    "tentRequest\022<\n\027internal_merge_response\030#"
+    " \001(\0132\033.proto.InternalMergeRequest\022@\n\025int"
+    "ernal_truncate_log\030$ \001(\0132!.proto.Interna"
+    "lTruncateLogRequest\022-\n\013internal_gc\030% \001(\013"
+    "2\030.proto.InternalGCRequest\022J\n\032internal_b"
+    "egin_transaction\030& \001(\0132&.proto.InternalB"
+    "eginTransactionRequest\022@\n\025internal_scan_"
+    "intents\030\' \001(\0132!.proto.InternalScanIntent"
+    "sRequest\022U\n internal_inspect_timestamp_c"
+    "ache\030( \001(\0132+.proto.InternalInspectTimest"
+    "ampCacheRequest\022F\n\030internal_get_transact"
+    "ion\030) \001(\0132$.proto.InternalGetTransaction"
+    "Request\022A\n\026internal_put_if_absent\030* \001(\0132"
+    "!.proto.InternalPutIfAbsentRequest\022G\n\031in"
+    "ternal_range_key_bounds\030+ \001(\0132$.proto.In"
+    "ternalRangeKeyBoundsRequest\022@\n\025internal_"
+    "verify_range\030, \001(\0132!.proto.InternalVerif"
+    "yRangeRequest\022O\n\035internal_resolve_intent"
+    "_range\030- \001(\0132(.proto.InternalResolveInte"
+    "ntRangeRequest\022@\n\025internal_leader_lease\030"
+    ". \001(\0132!.proto.InternalLeaderLeaseRequest"
+    "\022H\n\031internal_compute_checksum\030/ \001(\0132%.pr"
+    "oto.InternalComputeChecksumRequest\022>\n\024in"
+    "ternal_get_history\0300 \001(\0132 .proto.Interna"
+    "lGetHistoryRequest\022:\n\022internal_batch_get"
+    "\0301 \001(\0132\036.proto.InternalBatchGetRequest:\004"
+    "\310\240\037\001\"\237\001\n\023InternalRaftCommand\022\037\n\007raft_id\030"
+    "\002 \001(\003B\016\310\336\037\000\342\336\037\006RaftID\0222\n\003cmd\030\003 \001(\0132\037.pro"
+    "to.InternalRaftCommandUnionB\004\310\336\037\000\022\030\n\ngen"
+    "eration\030\004 \001(\003B\004\310\336\037\000\022\031\n\013lease_epoch\030\005 \001(\003"
+    "B\004\310\336\037\000\"\224\001\n\026InternalTimeSeriesData\022#\n\025sta"
+    "rt_timestamp_nanos\030\001 \001(\003B\004\310\336\037\000\022#\n\025sample"
+    "_duration_nanos\030\002 \001(\003B\004\310\336\037\000\0220\n\007samples\030\003"
+    " \003(\0132\037.proto.InternalTimeSeriesSample\"\320\001"
+    "\n\030InternalTimeSeriesSample\022\024\n\006offset\030\001 \001"
+    "(\005B\004\310\336\037\000\022\027\n\tint_count\030\002 \001(\rB\004\310\336\037\000\022\017\n\007int"
+    "_sum\030\003 \001(\003\022\017\n\007int_max\030\004 \001(\003\022\017\n\007int_min\030\005"
+    " \001(\003\022\031\n\013float_count\030\006 \001(\rB\004\310\336\037\000\022\021\n\tfloat"
+    "_sum\030\007 \001(\002\022\021\n\tfloat_max\030\010 \001(\002\022\021\n\tfloat_m"
+    "in\030\t \001(\002*%\n\021InternalValueType\022\n\n\006_CR_TS\020"
+    "\001\032\004\210\243\036\000", 9207);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "internal.proto", &protobuf_RegisterTypes);
   InternalRangeLookupRequest::default_instance_ = new InternalRangeLookupRequest();
@@ -673,7 +1572,39 @@ void protobuf_AddDesc_internal_2eproto() {
   InternalMergeResponse::default_instance_ = new InternalMergeResponse();
   InternalTruncateLogRequest::default_instance_ = new InternalTruncateLogRequest();
   InternalTruncateLogResponse::default_instance_ = new InternalTruncateLogResponse();
+  InternalBeginTransactionRequest::default_instance_ = new InternalBeginTransactionRequest();
+  InternalBeginTransactionResponse::default_instance_ = new InternalBeginTransactionResponse();
+  InternalTxnIntents::default_instance_ = new InternalTxnIntents();
+  InternalScanIntentsRequest::default_instance_ = new InternalScanIntentsRequest();
+  InternalScanIntentsResponse::default_instance_ = new InternalScanIntentsResponse();
+  InternalInspectTimestampCacheRequest::default_instance_ = new InternalInspectTimestampCacheRequest();
+  InternalInspectTimestampCacheResponse::default_instance_ = new InternalInspectTimestampCacheResponse();
+  InternalGetTransactionRequest::default_instance_ = new InternalGetTransactionRequest();
+  InternalGetTransactionResponse::default_instance_ = new InternalGetTransactionResponse();
+  InternalPutIfAbsentRequest::default_instance_ = new InternalPutIfAbsentRequest();
+  InternalPutIfAbsentResponse::default_instance_ = new InternalPutIfAbsentResponse();
+  ScanResumeToken::default_instance_ = new ScanResumeToken();
+  AuditEntry::default_instance_ = new AuditEntry();
+  InternalRangeKeyBoundsRequest::default_instance_ = new InternalRangeKeyBoundsRequest();
+  InternalRangeKeyBoundsResponse::default_instance_ = new InternalRangeKeyBoundsResponse();
+  InternalVerifyRangeRequest::default_instance_ = new InternalVerifyRangeRequest();
+  InternalVerifyRangeResponse::default_instance_ = new InternalVerifyRangeResponse();
+  InternalResolveIntentRangeRequest::default_instance_ = new InternalResolveIntentRangeRequest();
+  InternalResolveIntentRangeResponse::default_instance_ = new InternalResolveIntentRangeResponse();
+  InternalLeaderLeaseRequest::default_instance_ = new InternalLeaderLeaseRequest();
+  InternalLeaderLeaseResponse::default_instance_ = new InternalLeaderLeaseResponse();
+  InternalComputeChecksumRequest::default_instance_ = new InternalComputeChecksumRequest();
+  InternalComputeChecksumResponse::default_instance_ = new InternalComputeChecksumResponse();
+  MVCCVersion::default_instance_ = new MVCCVersion();
+  BatchGetValue::default_instance_ = new BatchGetValue();
+  InternalGetHistoryRequest::default_instance_ = new InternalGetHistoryRequest();
+  InternalGetHistoryResponse::default_instance_ = new InternalGetHistoryResponse();
+  InternalBatchGetRequest::default_instance_ = new InternalBatchGetRequest();
+  InternalBatchGetResponse::default_instance_ = new InternalBatchGetResponse();
   ReadWriteCmdResponse::default_instance_ = new ReadWriteCmdResponse();
+  ResponseCacheEntry::default_instance_ = new ResponseCacheEntry();
+  Lease::default_instance_ = new Lease();
+  LeaseTransfer::default_instance_ = new LeaseTransfer();
   InternalRaftCommandUnion::default_instance_ = new InternalRaftCommandUnion();
   InternalRaftCommand::default_instance_ = new InternalRaftCommand();
   InternalTimeSeriesData::default_instance_ = new InternalTimeSeriesData();
@@ -693,7 +1624,39 @@ void protobuf_AddDesc_internal_2eproto() {
   InternalMergeResponse::default_instance_->InitAsDefaultInstance();
   InternalTruncateLogRequest::default_instance_->InitAsDefaultInstance();
   InternalTruncateLogResponse::default_instance_->InitAsDefaultInstance();
+  InternalBeginTransactionRequest::default_instance_->InitAsDefaultInstance();
+  InternalBeginTransactionResponse::default_instance_->InitAsDefaultInstance();
+  InternalTxnIntents::default_instance_->InitAsDefaultInstance();
+  InternalScanIntentsRequest::default_instance_->InitAsDefaultInstance();
+  InternalScanIntentsResponse::default_instance_->InitAsDefaultInstance();
+  InternalInspectTimestampCacheRequest::default_instance_->InitAsDefaultInstance();
+  InternalInspectTimestampCacheResponse::default_instance_->InitAsDefaultInstance();
+  InternalGetTransactionRequest::default_instance_->InitAsDefaultInstance();
+  InternalGetTransactionResponse::default_instance_->InitAsDefaultInstance();
+  InternalPutIfAbsentRequest::default_instance_->InitAsDefaultInstance();
+  InternalPutIfAbsentResponse::default_instance_->InitAsDefaultInstance();
+  ScanResumeToken::default_instance_->InitAsDefaultInstance();
+  AuditEntry::default_instance_->InitAsDefaultInstance();
+  InternalRangeKeyBoundsRequest::default_instance_->InitAsDefaultInstance();
+  InternalRangeKeyBoundsResponse::default_instance_->InitAsDefaultInstance();
+  InternalVerifyRangeRequest::default_instance_->InitAsDefaultInstance();
+  InternalVerifyRangeResponse::default_instance_->InitAsDefaultInstance();
+  InternalResolveIntentRangeRequest::default_instance_->InitAsDefaultInstance();
+  InternalResolveIntentRangeResponse::default_instance_->InitAsDefaultInstance();
+  InternalLeaderLeaseRequest::default_instance_->InitAsDefaultInstance();
+  InternalLeaderLeaseResponse::default_instance_->InitAsDefaultInstance();
+  InternalComputeChecksumRequest::default_instance_->InitAsDefaultInstance();
+  InternalComputeChecksumResponse::default_instance_->InitAsDefaultInstance();
+  MVCCVersion::default_instance_->InitAsDefaultInstance();
+  BatchGetValue::default_instance_->InitAsDefaultInstance();
+  InternalGetHistoryRequest::default_instance_->InitAsDefaultInstance();
+  InternalGetHistoryResponse::default_instance_->InitAsDefaultInstance();
+  InternalBatchGetRequest::default_instance_->InitAsDefaultInstance();
+  InternalBatchGetResponse::default_instance_->InitAsDefaultInstance();
   ReadWriteCmdResponse::default_instance_->InitAsDefaultInstance();
+  ResponseCacheEntry::default_instance_->InitAsDefaultInstance();
+  Lease::default_instance_->InitAsDefaultInstance();
+  LeaseTransfer::default_instance_->InitAsDefaultInstance();
   InternalRaftCommandUnion::default_instance_->InitAsDefaultInstance();
   InternalRaftCommand::default_instance_->InitAsDefaultInstance();
   InternalTimeSeriesData::default_instance_->InitAsDefaultInstance();
@@ -1992,6 +2955,8 @@ void InternalGCRequest_GCKey::Swap(InternalGCRequest_GCKey* other) {
 const int InternalGCRequest::kHeaderFieldNumber;
 const int InternalGCRequest::kGcMetaFieldNumber;
 const int InternalGCRequest::kKeysFieldNumber;
+const int InternalGCRequest::kTxnKeysFieldNumber;
+const int InternalGCRequest::kTxnThresholdFieldNumber;
 #endif  // !_MSC_VER
 
 InternalGCRequest::InternalGCRequest()
@@ -2003,6 +2968,7 @@ InternalGCRequest::InternalGCRequest()
 void InternalGCRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
   gc_meta_ = const_cast< ::proto::GCMetadata*>(&::proto::GCMetadata::default_instance());
+  txn_threshold_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
 }
 
 InternalGCRequest::InternalGCRequest(const InternalGCRequest& from)
@@ -2013,9 +2979,11 @@ InternalGCRequest::InternalGCRequest(const InternalGCRequest& from)
 }
 
 void InternalGCRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   header_ = NULL;
   gc_meta_ = NULL;
+  txn_threshold_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -2028,6 +2996,7 @@ void InternalGCRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
     delete gc_meta_;
+    delete txn_threshold_;
   }
 }
 
@@ -2053,15 +3022,19 @@ InternalGCRequest* InternalGCRequest::New() const {
 }
 
 void InternalGCRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 19) {
     if (has_header()) {
       if (header_ != NULL) header_->::proto::RequestHeader::Clear();
     }
     if (has_gc_meta()) {
       if (gc_meta_ != NULL) gc_meta_->::proto::GCMetadata::Clear();
     }
+    if (has_txn_threshold()) {
+      if (txn_threshold_ != NULL) txn_threshold_->::proto::Timestamp::Clear();
+    }
   }
   keys_.Clear();
+  txn_keys_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -2111,6 +3084,33 @@ bool InternalGCRequest::MergePartialFromCodedStream(
           goto handle_unusual;
         }
         if (input->ExpectTag(26)) goto parse_keys;
+        if (input->ExpectTag(34)) goto parse_txn_keys;
+        break;
+      }
+
+      // repeated bytes txn_keys = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_txn_keys:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_txn_keys()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_txn_keys;
+        if (input->ExpectTag(42)) goto parse_txn_threshold;
+        break;
+      }
+
+      // optional .proto.Timestamp txn_threshold = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_txn_threshold:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_txn_threshold()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -2158,6 +3158,18 @@ void InternalGCRequest::SerializeWithCachedSizes(
       3, this->keys(i), output);
   }
 
+  // repeated bytes txn_keys = 4;
+  for (int i = 0; i < this->txn_keys_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      4, this->txn_keys(i), output);
+  }
+
+  // optional .proto.Timestamp txn_threshold = 5;
+  if (has_txn_threshold()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->txn_threshold(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -2189,11 +3201,24 @@ void InternalGCRequest::SerializeWithCachedSizes(
         3, this->keys(i), target);
   }
 
-  if (!unknown_fields().empty()) {
-    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
-        unknown_fields(), target);
+  // repeated bytes txn_keys = 4;
+  for (int i = 0; i < this->txn_keys_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(4, this->txn_keys(i), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalGCRequest)
+
+  // optional .proto.Timestamp txn_threshold = 5;
+  if (has_txn_threshold()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->txn_threshold(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalGCRequest)
   return target;
 }
 
@@ -2215,6 +3240,13 @@ int InternalGCRequest::ByteSize() const {
           this->gc_meta());
     }
 
+    // optional .proto.Timestamp txn_threshold = 5;
+    if (has_txn_threshold()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->txn_threshold());
+    }
+
   }
   // repeated .proto.InternalGCRequest.GCKey keys = 3;
   total_size += 1 * this->keys_size();
@@ -2224,6 +3256,13 @@ int InternalGCRequest::ByteSize() const {
         this->keys(i));
   }
 
+  // repeated bytes txn_keys = 4;
+  total_size += 1 * this->txn_keys_size();
+  for (int i = 0; i < this->txn_keys_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->txn_keys(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -2250,6 +3289,7 @@ void InternalGCRequest::MergeFrom(const ::google::protobuf::Message& from) {
 void InternalGCRequest::MergeFrom(const InternalGCRequest& from) {
   GOOGLE_CHECK_NE(&from, this);
   keys_.MergeFrom(from.keys_);
+  txn_keys_.MergeFrom(from.txn_keys_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
@@ -2257,6 +3297,9 @@ void InternalGCRequest::MergeFrom(const InternalGCRequest& from) {
     if (from.has_gc_meta()) {
       mutable_gc_meta()->::proto::GCMetadata::MergeFrom(from.gc_meta());
     }
+    if (from.has_txn_threshold()) {
+      mutable_txn_threshold()->::proto::Timestamp::MergeFrom(from.txn_threshold());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -2283,6 +3326,8 @@ void InternalGCRequest::Swap(InternalGCRequest* other) {
     std::swap(header_, other->header_);
     std::swap(gc_meta_, other->gc_meta_);
     keys_.Swap(&other->keys_);
+    txn_keys_.Swap(&other->txn_keys_);
+    std::swap(txn_threshold_, other->txn_threshold_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -4560,371 +5605,9651 @@ void InternalTruncateLogResponse::Swap(InternalTruncateLogResponse* other) {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ReadWriteCmdResponse::kPutFieldNumber;
-const int ReadWriteCmdResponse::kConditionalPutFieldNumber;
-const int ReadWriteCmdResponse::kIncrementFieldNumber;
-const int ReadWriteCmdResponse::kDeleteFieldNumber;
-const int ReadWriteCmdResponse::kDeleteRangeFieldNumber;
-const int ReadWriteCmdResponse::kEndTransactionFieldNumber;
-const int ReadWriteCmdResponse::kReapQueueFieldNumber;
-const int ReadWriteCmdResponse::kEnqueueUpdateFieldNumber;
-const int ReadWriteCmdResponse::kEnqueueMessageFieldNumber;
-const int ReadWriteCmdResponse::kInternalHeartbeatTxnFieldNumber;
-const int ReadWriteCmdResponse::kInternalPushTxnFieldNumber;
-const int ReadWriteCmdResponse::kInternalResolveIntentFieldNumber;
-const int ReadWriteCmdResponse::kInternalMergeFieldNumber;
-const int ReadWriteCmdResponse::kInternalTruncateLogFieldNumber;
-const int ReadWriteCmdResponse::kInternalGcFieldNumber;
+const int InternalBeginTransactionRequest::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-ReadWriteCmdResponse::ReadWriteCmdResponse()
+InternalBeginTransactionRequest::InternalBeginTransactionRequest()
   : ::google::protobuf::Message() {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(constructor:proto.InternalBeginTransactionRequest)
 }
 
-void ReadWriteCmdResponse::InitAsDefaultInstance() {
-  put_ = const_cast< ::proto::PutResponse*>(&::proto::PutResponse::default_instance());
-  conditional_put_ = const_cast< ::proto::ConditionalPutResponse*>(&::proto::ConditionalPutResponse::default_instance());
-  increment_ = const_cast< ::proto::IncrementResponse*>(&::proto::IncrementResponse::default_instance());
-  delete__ = const_cast< ::proto::DeleteResponse*>(&::proto::DeleteResponse::default_instance());
-  delete_range_ = const_cast< ::proto::DeleteRangeResponse*>(&::proto::DeleteRangeResponse::default_instance());
-  end_transaction_ = const_cast< ::proto::EndTransactionResponse*>(&::proto::EndTransactionResponse::default_instance());
-  reap_queue_ = const_cast< ::proto::ReapQueueResponse*>(&::proto::ReapQueueResponse::default_instance());
-  enqueue_update_ = const_cast< ::proto::EnqueueUpdateResponse*>(&::proto::EnqueueUpdateResponse::default_instance());
-  enqueue_message_ = const_cast< ::proto::EnqueueMessageResponse*>(&::proto::EnqueueMessageResponse::default_instance());
-  internal_heartbeat_txn_ = const_cast< ::proto::InternalHeartbeatTxnResponse*>(&::proto::InternalHeartbeatTxnResponse::default_instance());
-  internal_push_txn_ = const_cast< ::proto::InternalPushTxnResponse*>(&::proto::InternalPushTxnResponse::default_instance());
-  internal_resolve_intent_ = const_cast< ::proto::InternalResolveIntentResponse*>(&::proto::InternalResolveIntentResponse::default_instance());
-  internal_merge_ = const_cast< ::proto::InternalMergeResponse*>(&::proto::InternalMergeResponse::default_instance());
-  internal_truncate_log_ = const_cast< ::proto::InternalTruncateLogResponse*>(&::proto::InternalTruncateLogResponse::default_instance());
-  internal_gc_ = const_cast< ::proto::InternalGCResponse*>(&::proto::InternalGCResponse::default_instance());
+void InternalBeginTransactionRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
 }
 
-ReadWriteCmdResponse::ReadWriteCmdResponse(const ReadWriteCmdResponse& from)
+InternalBeginTransactionRequest::InternalBeginTransactionRequest(const InternalBeginTransactionRequest& from)
   : ::google::protobuf::Message() {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(copy_constructor:proto.InternalBeginTransactionRequest)
 }
 
-void ReadWriteCmdResponse::SharedCtor() {
+void InternalBeginTransactionRequest::SharedCtor() {
   _cached_size_ = 0;
-  put_ = NULL;
-  conditional_put_ = NULL;
-  increment_ = NULL;
-  delete__ = NULL;
-  delete_range_ = NULL;
-  end_transaction_ = NULL;
-  reap_queue_ = NULL;
-  enqueue_update_ = NULL;
-  enqueue_message_ = NULL;
-  internal_heartbeat_txn_ = NULL;
-  internal_push_txn_ = NULL;
-  internal_resolve_intent_ = NULL;
-  internal_merge_ = NULL;
-  internal_truncate_log_ = NULL;
-  internal_gc_ = NULL;
+  header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ReadWriteCmdResponse::~ReadWriteCmdResponse() {
-  // @@protoc_insertion_point(destructor:proto.ReadWriteCmdResponse)
+InternalBeginTransactionRequest::~InternalBeginTransactionRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalBeginTransactionRequest)
   SharedDtor();
 }
 
-void ReadWriteCmdResponse::SharedDtor() {
+void InternalBeginTransactionRequest::SharedDtor() {
   if (this != default_instance_) {
-    delete put_;
-    delete conditional_put_;
-    delete increment_;
-    delete delete__;
-    delete delete_range_;
-    delete end_transaction_;
-    delete reap_queue_;
-    delete enqueue_update_;
-    delete enqueue_message_;
-    delete internal_heartbeat_txn_;
-    delete internal_push_txn_;
-    delete internal_resolve_intent_;
-    delete internal_merge_;
-    delete internal_truncate_log_;
-    delete internal_gc_;
+    delete header_;
   }
 }
 
-void ReadWriteCmdResponse::SetCachedSize(int size) const {
+void InternalBeginTransactionRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ReadWriteCmdResponse::descriptor() {
+const ::google::protobuf::Descriptor* InternalBeginTransactionRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ReadWriteCmdResponse_descriptor_;
+  return InternalBeginTransactionRequest_descriptor_;
 }
 
-const ReadWriteCmdResponse& ReadWriteCmdResponse::default_instance() {
+const InternalBeginTransactionRequest& InternalBeginTransactionRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
   return *default_instance_;
 }
 
-ReadWriteCmdResponse* ReadWriteCmdResponse::default_instance_ = NULL;
+InternalBeginTransactionRequest* InternalBeginTransactionRequest::default_instance_ = NULL;
 
-ReadWriteCmdResponse* ReadWriteCmdResponse::New() const {
-  return new ReadWriteCmdResponse;
+InternalBeginTransactionRequest* InternalBeginTransactionRequest::New() const {
+  return new InternalBeginTransactionRequest;
 }
 
-void ReadWriteCmdResponse::Clear() {
-  if (_has_bits_[0 / 32] & 255) {
-    if (has_put()) {
-      if (put_ != NULL) put_->::proto::PutResponse::Clear();
-    }
-    if (has_conditional_put()) {
-      if (conditional_put_ != NULL) conditional_put_->::proto::ConditionalPutResponse::Clear();
-    }
-    if (has_increment()) {
-      if (increment_ != NULL) increment_->::proto::IncrementResponse::Clear();
-    }
-    if (has_delete_()) {
-      if (delete__ != NULL) delete__->::proto::DeleteResponse::Clear();
-    }
-    if (has_delete_range()) {
-      if (delete_range_ != NULL) delete_range_->::proto::DeleteRangeResponse::Clear();
-    }
-    if (has_end_transaction()) {
-      if (end_transaction_ != NULL) end_transaction_->::proto::EndTransactionResponse::Clear();
-    }
-    if (has_reap_queue()) {
-      if (reap_queue_ != NULL) reap_queue_->::proto::ReapQueueResponse::Clear();
-    }
-    if (has_enqueue_update()) {
-      if (enqueue_update_ != NULL) enqueue_update_->::proto::EnqueueUpdateResponse::Clear();
-    }
-  }
-  if (_has_bits_[8 / 32] & 32512) {
-    if (has_enqueue_message()) {
-      if (enqueue_message_ != NULL) enqueue_message_->::proto::EnqueueMessageResponse::Clear();
-    }
-    if (has_internal_heartbeat_txn()) {
-      if (internal_heartbeat_txn_ != NULL) internal_heartbeat_txn_->::proto::InternalHeartbeatTxnResponse::Clear();
-    }
-    if (has_internal_push_txn()) {
-      if (internal_push_txn_ != NULL) internal_push_txn_->::proto::InternalPushTxnResponse::Clear();
-    }
-    if (has_internal_resolve_intent()) {
-      if (internal_resolve_intent_ != NULL) internal_resolve_intent_->::proto::InternalResolveIntentResponse::Clear();
-    }
-    if (has_internal_merge()) {
-      if (internal_merge_ != NULL) internal_merge_->::proto::InternalMergeResponse::Clear();
-    }
-    if (has_internal_truncate_log()) {
-      if (internal_truncate_log_ != NULL) internal_truncate_log_->::proto::InternalTruncateLogResponse::Clear();
-    }
-    if (has_internal_gc()) {
-      if (internal_gc_ != NULL) internal_gc_->::proto::InternalGCResponse::Clear();
-    }
+void InternalBeginTransactionRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
 
-bool ReadWriteCmdResponse::MergePartialFromCodedStream(
+bool InternalBeginTransactionRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(parse_start:proto.InternalBeginTransactionRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // optional .proto.PutResponse put = 1;
+      // optional .proto.RequestHeader header = 1;
       case 1: {
         if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_put()));
+               input, mutable_header()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_conditional_put;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .proto.ConditionalPutResponse conditional_put = 2;
-      case 2: {
-        if (tag == 18) {
-         parse_conditional_put:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_conditional_put()));
-        } else {
-          goto handle_unusual;
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
         }
-        if (input->ExpectTag(26)) goto parse_increment;
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
         break;
       }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalBeginTransactionRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalBeginTransactionRequest)
+  return false;
+#undef DO_
+}
 
-      // optional .proto.IncrementResponse increment = 3;
-      case 3: {
-        if (tag == 26) {
-         parse_increment:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(34)) goto parse_delete;
-        break;
-      }
+void InternalBeginTransactionRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalBeginTransactionRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
 
-      // optional .proto.DeleteResponse delete = 4;
-      case 4: {
-        if (tag == 34) {
-         parse_delete:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(42)) goto parse_delete_range;
-        break;
-      }
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalBeginTransactionRequest)
+}
 
-      // optional .proto.DeleteRangeResponse delete_range = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_delete_range:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(50)) goto parse_end_transaction;
-        break;
-      }
+::google::protobuf::uint8* InternalBeginTransactionRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalBeginTransactionRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
 
-      // optional .proto.EndTransactionResponse end_transaction = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_end_transaction:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(58)) goto parse_reap_queue;
-        break;
-      }
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalBeginTransactionRequest)
+  return target;
+}
 
-      // optional .proto.ReapQueueResponse reap_queue = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_reap_queue:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+int InternalBeginTransactionRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalBeginTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalBeginTransactionRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalBeginTransactionRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalBeginTransactionRequest::MergeFrom(const InternalBeginTransactionRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalBeginTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalBeginTransactionRequest::CopyFrom(const InternalBeginTransactionRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalBeginTransactionRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalBeginTransactionRequest::Swap(InternalBeginTransactionRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalBeginTransactionRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalBeginTransactionRequest_descriptor_;
+  metadata.reflection = InternalBeginTransactionRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalBeginTransactionResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalBeginTransactionResponse::InternalBeginTransactionResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalBeginTransactionResponse)
+}
+
+void InternalBeginTransactionResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalBeginTransactionResponse::InternalBeginTransactionResponse(const InternalBeginTransactionResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalBeginTransactionResponse)
+}
+
+void InternalBeginTransactionResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalBeginTransactionResponse::~InternalBeginTransactionResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalBeginTransactionResponse)
+  SharedDtor();
+}
+
+void InternalBeginTransactionResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalBeginTransactionResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalBeginTransactionResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalBeginTransactionResponse_descriptor_;
+}
+
+const InternalBeginTransactionResponse& InternalBeginTransactionResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalBeginTransactionResponse* InternalBeginTransactionResponse::default_instance_ = NULL;
+
+InternalBeginTransactionResponse* InternalBeginTransactionResponse::New() const {
+  return new InternalBeginTransactionResponse;
+}
+
+void InternalBeginTransactionResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalBeginTransactionResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalBeginTransactionResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalBeginTransactionResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalBeginTransactionResponse)
+  return false;
+#undef DO_
+}
+
+void InternalBeginTransactionResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalBeginTransactionResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalBeginTransactionResponse)
+}
+
+::google::protobuf::uint8* InternalBeginTransactionResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalBeginTransactionResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalBeginTransactionResponse)
+  return target;
+}
+
+int InternalBeginTransactionResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalBeginTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalBeginTransactionResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalBeginTransactionResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalBeginTransactionResponse::MergeFrom(const InternalBeginTransactionResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalBeginTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalBeginTransactionResponse::CopyFrom(const InternalBeginTransactionResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalBeginTransactionResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalBeginTransactionResponse::Swap(InternalBeginTransactionResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalBeginTransactionResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalBeginTransactionResponse_descriptor_;
+  metadata.reflection = InternalBeginTransactionResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalTxnIntents::kTxnFieldNumber;
+const int InternalTxnIntents::kKeysFieldNumber;
+#endif  // !_MSC_VER
+
+InternalTxnIntents::InternalTxnIntents()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalTxnIntents)
+}
+
+void InternalTxnIntents::InitAsDefaultInstance() {
+  txn_ = const_cast< ::proto::Transaction*>(&::proto::Transaction::default_instance());
+}
+
+InternalTxnIntents::InternalTxnIntents(const InternalTxnIntents& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalTxnIntents)
+}
+
+void InternalTxnIntents::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  txn_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalTxnIntents::~InternalTxnIntents() {
+  // @@protoc_insertion_point(destructor:proto.InternalTxnIntents)
+  SharedDtor();
+}
+
+void InternalTxnIntents::SharedDtor() {
+  if (this != default_instance_) {
+    delete txn_;
+  }
+}
+
+void InternalTxnIntents::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalTxnIntents::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalTxnIntents_descriptor_;
+}
+
+const InternalTxnIntents& InternalTxnIntents::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalTxnIntents* InternalTxnIntents::default_instance_ = NULL;
+
+InternalTxnIntents* InternalTxnIntents::New() const {
+  return new InternalTxnIntents;
+}
+
+void InternalTxnIntents::Clear() {
+  if (has_txn()) {
+    if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  }
+  keys_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalTxnIntents::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalTxnIntents)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.Transaction txn = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_keys;
+        break;
+      }
+
+      // repeated bytes keys = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_keys:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_keys()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_keys;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalTxnIntents)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalTxnIntents)
+  return false;
+#undef DO_
+}
+
+void InternalTxnIntents::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalTxnIntents)
+  // optional .proto.Transaction txn = 1;
+  if (has_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->txn(), output);
+  }
+
+  // repeated bytes keys = 2;
+  for (int i = 0; i < this->keys_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      2, this->keys(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalTxnIntents)
+}
+
+::google::protobuf::uint8* InternalTxnIntents::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalTxnIntents)
+  // optional .proto.Transaction txn = 1;
+  if (has_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->txn(), target);
+  }
+
+  // repeated bytes keys = 2;
+  for (int i = 0; i < this->keys_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(2, this->keys(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalTxnIntents)
+  return target;
+}
+
+int InternalTxnIntents::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.Transaction txn = 1;
+    if (has_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->txn());
+    }
+
+  }
+  // repeated bytes keys = 2;
+  total_size += 1 * this->keys_size();
+  for (int i = 0; i < this->keys_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->keys(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalTxnIntents::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalTxnIntents* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalTxnIntents*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalTxnIntents::MergeFrom(const InternalTxnIntents& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  keys_.MergeFrom(from.keys_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_txn()) {
+      mutable_txn()->::proto::Transaction::MergeFrom(from.txn());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalTxnIntents::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalTxnIntents::CopyFrom(const InternalTxnIntents& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalTxnIntents::IsInitialized() const {
+
+  return true;
+}
+
+void InternalTxnIntents::Swap(InternalTxnIntents* other) {
+  if (other != this) {
+    std::swap(txn_, other->txn_);
+    keys_.Swap(&other->keys_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalTxnIntents::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalTxnIntents_descriptor_;
+  metadata.reflection = InternalTxnIntents_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalScanIntentsRequest::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalScanIntentsRequest::InternalScanIntentsRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalScanIntentsRequest)
+}
+
+void InternalScanIntentsRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalScanIntentsRequest::InternalScanIntentsRequest(const InternalScanIntentsRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalScanIntentsRequest)
+}
+
+void InternalScanIntentsRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalScanIntentsRequest::~InternalScanIntentsRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalScanIntentsRequest)
+  SharedDtor();
+}
+
+void InternalScanIntentsRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalScanIntentsRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalScanIntentsRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalScanIntentsRequest_descriptor_;
+}
+
+const InternalScanIntentsRequest& InternalScanIntentsRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalScanIntentsRequest* InternalScanIntentsRequest::default_instance_ = NULL;
+
+InternalScanIntentsRequest* InternalScanIntentsRequest::New() const {
+  return new InternalScanIntentsRequest;
+}
+
+void InternalScanIntentsRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalScanIntentsRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalScanIntentsRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalScanIntentsRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalScanIntentsRequest)
+  return false;
+#undef DO_
+}
+
+void InternalScanIntentsRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalScanIntentsRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalScanIntentsRequest)
+}
+
+::google::protobuf::uint8* InternalScanIntentsRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalScanIntentsRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalScanIntentsRequest)
+  return target;
+}
+
+int InternalScanIntentsRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalScanIntentsRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalScanIntentsRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalScanIntentsRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalScanIntentsRequest::MergeFrom(const InternalScanIntentsRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalScanIntentsRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalScanIntentsRequest::CopyFrom(const InternalScanIntentsRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalScanIntentsRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalScanIntentsRequest::Swap(InternalScanIntentsRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalScanIntentsRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalScanIntentsRequest_descriptor_;
+  metadata.reflection = InternalScanIntentsRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalScanIntentsResponse::kHeaderFieldNumber;
+const int InternalScanIntentsResponse::kTxnIntentsFieldNumber;
+#endif  // !_MSC_VER
+
+InternalScanIntentsResponse::InternalScanIntentsResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalScanIntentsResponse)
+}
+
+void InternalScanIntentsResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalScanIntentsResponse::InternalScanIntentsResponse(const InternalScanIntentsResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalScanIntentsResponse)
+}
+
+void InternalScanIntentsResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalScanIntentsResponse::~InternalScanIntentsResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalScanIntentsResponse)
+  SharedDtor();
+}
+
+void InternalScanIntentsResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalScanIntentsResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalScanIntentsResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalScanIntentsResponse_descriptor_;
+}
+
+const InternalScanIntentsResponse& InternalScanIntentsResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalScanIntentsResponse* InternalScanIntentsResponse::default_instance_ = NULL;
+
+InternalScanIntentsResponse* InternalScanIntentsResponse::New() const {
+  return new InternalScanIntentsResponse;
+}
+
+void InternalScanIntentsResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  txn_intents_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalScanIntentsResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalScanIntentsResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_txn_intents;
+        break;
+      }
+
+      // repeated .proto.InternalTxnIntents txn_intents = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_txn_intents:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_txn_intents()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_txn_intents;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalScanIntentsResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalScanIntentsResponse)
+  return false;
+#undef DO_
+}
+
+void InternalScanIntentsResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalScanIntentsResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .proto.InternalTxnIntents txn_intents = 2;
+  for (int i = 0; i < this->txn_intents_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->txn_intents(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalScanIntentsResponse)
+}
+
+::google::protobuf::uint8* InternalScanIntentsResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalScanIntentsResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .proto.InternalTxnIntents txn_intents = 2;
+  for (int i = 0; i < this->txn_intents_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->txn_intents(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalScanIntentsResponse)
+  return target;
+}
+
+int InternalScanIntentsResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated .proto.InternalTxnIntents txn_intents = 2;
+  total_size += 1 * this->txn_intents_size();
+  for (int i = 0; i < this->txn_intents_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->txn_intents(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalScanIntentsResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalScanIntentsResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalScanIntentsResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalScanIntentsResponse::MergeFrom(const InternalScanIntentsResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  txn_intents_.MergeFrom(from.txn_intents_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalScanIntentsResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalScanIntentsResponse::CopyFrom(const InternalScanIntentsResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalScanIntentsResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalScanIntentsResponse::Swap(InternalScanIntentsResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    txn_intents_.Swap(&other->txn_intents_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalScanIntentsResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalScanIntentsResponse_descriptor_;
+  metadata.reflection = InternalScanIntentsResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalInspectTimestampCacheRequest::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalInspectTimestampCacheRequest::InternalInspectTimestampCacheRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalInspectTimestampCacheRequest)
+}
+
+void InternalInspectTimestampCacheRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalInspectTimestampCacheRequest::InternalInspectTimestampCacheRequest(const InternalInspectTimestampCacheRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalInspectTimestampCacheRequest)
+}
+
+void InternalInspectTimestampCacheRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalInspectTimestampCacheRequest::~InternalInspectTimestampCacheRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalInspectTimestampCacheRequest)
+  SharedDtor();
+}
+
+void InternalInspectTimestampCacheRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalInspectTimestampCacheRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalInspectTimestampCacheRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalInspectTimestampCacheRequest_descriptor_;
+}
+
+const InternalInspectTimestampCacheRequest& InternalInspectTimestampCacheRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalInspectTimestampCacheRequest* InternalInspectTimestampCacheRequest::default_instance_ = NULL;
+
+InternalInspectTimestampCacheRequest* InternalInspectTimestampCacheRequest::New() const {
+  return new InternalInspectTimestampCacheRequest;
+}
+
+void InternalInspectTimestampCacheRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalInspectTimestampCacheRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalInspectTimestampCacheRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalInspectTimestampCacheRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalInspectTimestampCacheRequest)
+  return false;
+#undef DO_
+}
+
+void InternalInspectTimestampCacheRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalInspectTimestampCacheRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalInspectTimestampCacheRequest)
+}
+
+::google::protobuf::uint8* InternalInspectTimestampCacheRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalInspectTimestampCacheRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalInspectTimestampCacheRequest)
+  return target;
+}
+
+int InternalInspectTimestampCacheRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalInspectTimestampCacheRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalInspectTimestampCacheRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalInspectTimestampCacheRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalInspectTimestampCacheRequest::MergeFrom(const InternalInspectTimestampCacheRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalInspectTimestampCacheRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalInspectTimestampCacheRequest::CopyFrom(const InternalInspectTimestampCacheRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalInspectTimestampCacheRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalInspectTimestampCacheRequest::Swap(InternalInspectTimestampCacheRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalInspectTimestampCacheRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalInspectTimestampCacheRequest_descriptor_;
+  metadata.reflection = InternalInspectTimestampCacheRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalInspectTimestampCacheResponse::kHeaderFieldNumber;
+const int InternalInspectTimestampCacheResponse::kReadTimestampFieldNumber;
+const int InternalInspectTimestampCacheResponse::kWriteTimestampFieldNumber;
+const int InternalInspectTimestampCacheResponse::kReadLowWaterFieldNumber;
+const int InternalInspectTimestampCacheResponse::kWriteLowWaterFieldNumber;
+#endif  // !_MSC_VER
+
+InternalInspectTimestampCacheResponse::InternalInspectTimestampCacheResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalInspectTimestampCacheResponse)
+}
+
+void InternalInspectTimestampCacheResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+  read_timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+  write_timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+}
+
+InternalInspectTimestampCacheResponse::InternalInspectTimestampCacheResponse(const InternalInspectTimestampCacheResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalInspectTimestampCacheResponse)
+}
+
+void InternalInspectTimestampCacheResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  read_timestamp_ = NULL;
+  write_timestamp_ = NULL;
+  read_low_water_ = false;
+  write_low_water_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalInspectTimestampCacheResponse::~InternalInspectTimestampCacheResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalInspectTimestampCacheResponse)
+  SharedDtor();
+}
+
+void InternalInspectTimestampCacheResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete read_timestamp_;
+    delete write_timestamp_;
+  }
+}
+
+void InternalInspectTimestampCacheResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalInspectTimestampCacheResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalInspectTimestampCacheResponse_descriptor_;
+}
+
+const InternalInspectTimestampCacheResponse& InternalInspectTimestampCacheResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalInspectTimestampCacheResponse* InternalInspectTimestampCacheResponse::default_instance_ = NULL;
+
+InternalInspectTimestampCacheResponse* InternalInspectTimestampCacheResponse::New() const {
+  return new InternalInspectTimestampCacheResponse;
+}
+
+void InternalInspectTimestampCacheResponse::Clear() {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<InternalInspectTimestampCacheResponse*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 31) {
+    ZR_(read_low_water_, write_low_water_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    if (has_read_timestamp()) {
+      if (read_timestamp_ != NULL) read_timestamp_->::proto::Timestamp::Clear();
+    }
+    if (has_write_timestamp()) {
+      if (write_timestamp_ != NULL) write_timestamp_->::proto::Timestamp::Clear();
+    }
+  }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalInspectTimestampCacheResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalInspectTimestampCacheResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_read_timestamp;
+        break;
+      }
+
+      // optional .proto.Timestamp read_timestamp = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_read_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_read_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_write_timestamp;
+        break;
+      }
+
+      // optional .proto.Timestamp write_timestamp = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_write_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_write_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_read_low_water;
+        break;
+      }
+
+      // optional bool read_low_water = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_read_low_water:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &read_low_water_)));
+          set_has_read_low_water();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_write_low_water;
+        break;
+      }
+
+      // optional bool write_low_water = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_write_low_water:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &write_low_water_)));
+          set_has_write_low_water();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalInspectTimestampCacheResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalInspectTimestampCacheResponse)
+  return false;
+#undef DO_
+}
+
+void InternalInspectTimestampCacheResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalInspectTimestampCacheResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .proto.Timestamp read_timestamp = 2;
+  if (has_read_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->read_timestamp(), output);
+  }
+
+  // optional .proto.Timestamp write_timestamp = 3;
+  if (has_write_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->write_timestamp(), output);
+  }
+
+  // optional bool read_low_water = 4;
+  if (has_read_low_water()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->read_low_water(), output);
+  }
+
+  // optional bool write_low_water = 5;
+  if (has_write_low_water()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(5, this->write_low_water(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalInspectTimestampCacheResponse)
+}
+
+::google::protobuf::uint8* InternalInspectTimestampCacheResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalInspectTimestampCacheResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .proto.Timestamp read_timestamp = 2;
+  if (has_read_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->read_timestamp(), target);
+  }
+
+  // optional .proto.Timestamp write_timestamp = 3;
+  if (has_write_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->write_timestamp(), target);
+  }
+
+  // optional bool read_low_water = 4;
+  if (has_read_low_water()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->read_low_water(), target);
+  }
+
+  // optional bool write_low_water = 5;
+  if (has_write_low_water()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(5, this->write_low_water(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalInspectTimestampCacheResponse)
+  return target;
+}
+
+int InternalInspectTimestampCacheResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .proto.Timestamp read_timestamp = 2;
+    if (has_read_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->read_timestamp());
+    }
+
+    // optional .proto.Timestamp write_timestamp = 3;
+    if (has_write_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->write_timestamp());
+    }
+
+    // optional bool read_low_water = 4;
+    if (has_read_low_water()) {
+      total_size += 1 + 1;
+    }
+
+    // optional bool write_low_water = 5;
+    if (has_write_low_water()) {
+      total_size += 1 + 1;
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalInspectTimestampCacheResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalInspectTimestampCacheResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalInspectTimestampCacheResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalInspectTimestampCacheResponse::MergeFrom(const InternalInspectTimestampCacheResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_read_timestamp()) {
+      mutable_read_timestamp()->::proto::Timestamp::MergeFrom(from.read_timestamp());
+    }
+    if (from.has_write_timestamp()) {
+      mutable_write_timestamp()->::proto::Timestamp::MergeFrom(from.write_timestamp());
+    }
+    if (from.has_read_low_water()) {
+      set_read_low_water(from.read_low_water());
+    }
+    if (from.has_write_low_water()) {
+      set_write_low_water(from.write_low_water());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalInspectTimestampCacheResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalInspectTimestampCacheResponse::CopyFrom(const InternalInspectTimestampCacheResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalInspectTimestampCacheResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalInspectTimestampCacheResponse::Swap(InternalInspectTimestampCacheResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(read_timestamp_, other->read_timestamp_);
+    std::swap(write_timestamp_, other->write_timestamp_);
+    std::swap(read_low_water_, other->read_low_water_);
+    std::swap(write_low_water_, other->write_low_water_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalInspectTimestampCacheResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalInspectTimestampCacheResponse_descriptor_;
+  metadata.reflection = InternalInspectTimestampCacheResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalGetTransactionRequest::kHeaderFieldNumber;
+const int InternalGetTransactionRequest::kTxnIdFieldNumber;
+#endif  // !_MSC_VER
+
+InternalGetTransactionRequest::InternalGetTransactionRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalGetTransactionRequest)
+}
+
+void InternalGetTransactionRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalGetTransactionRequest::InternalGetTransactionRequest(const InternalGetTransactionRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalGetTransactionRequest)
+}
+
+void InternalGetTransactionRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  txn_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalGetTransactionRequest::~InternalGetTransactionRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalGetTransactionRequest)
+  SharedDtor();
+}
+
+void InternalGetTransactionRequest::SharedDtor() {
+  if (txn_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete txn_id_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalGetTransactionRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalGetTransactionRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalGetTransactionRequest_descriptor_;
+}
+
+const InternalGetTransactionRequest& InternalGetTransactionRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalGetTransactionRequest* InternalGetTransactionRequest::default_instance_ = NULL;
+
+InternalGetTransactionRequest* InternalGetTransactionRequest::New() const {
+  return new InternalGetTransactionRequest;
+}
+
+void InternalGetTransactionRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    if (has_txn_id()) {
+      if (txn_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        txn_id_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalGetTransactionRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalGetTransactionRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_txn_id;
+        break;
+      }
+
+      // optional bytes txn_id = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_txn_id:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_txn_id()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalGetTransactionRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalGetTransactionRequest)
+  return false;
+#undef DO_
+}
+
+void InternalGetTransactionRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalGetTransactionRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional bytes txn_id = 2;
+  if (has_txn_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->txn_id(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalGetTransactionRequest)
+}
+
+::google::protobuf::uint8* InternalGetTransactionRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalGetTransactionRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional bytes txn_id = 2;
+  if (has_txn_id()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->txn_id(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalGetTransactionRequest)
+  return target;
+}
+
+int InternalGetTransactionRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bytes txn_id = 2;
+    if (has_txn_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->txn_id());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalGetTransactionRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalGetTransactionRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalGetTransactionRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalGetTransactionRequest::MergeFrom(const InternalGetTransactionRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_txn_id()) {
+      set_txn_id(from.txn_id());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalGetTransactionRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalGetTransactionRequest::CopyFrom(const InternalGetTransactionRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalGetTransactionRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalGetTransactionRequest::Swap(InternalGetTransactionRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(txn_id_, other->txn_id_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalGetTransactionRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalGetTransactionRequest_descriptor_;
+  metadata.reflection = InternalGetTransactionRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalGetTransactionResponse::kHeaderFieldNumber;
+const int InternalGetTransactionResponse::kTxnFieldNumber;
+#endif  // !_MSC_VER
+
+InternalGetTransactionResponse::InternalGetTransactionResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalGetTransactionResponse)
+}
+
+void InternalGetTransactionResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+  txn_ = const_cast< ::proto::Transaction*>(&::proto::Transaction::default_instance());
+}
+
+InternalGetTransactionResponse::InternalGetTransactionResponse(const InternalGetTransactionResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalGetTransactionResponse)
+}
+
+void InternalGetTransactionResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  txn_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalGetTransactionResponse::~InternalGetTransactionResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalGetTransactionResponse)
+  SharedDtor();
+}
+
+void InternalGetTransactionResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete txn_;
+  }
+}
+
+void InternalGetTransactionResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalGetTransactionResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalGetTransactionResponse_descriptor_;
+}
+
+const InternalGetTransactionResponse& InternalGetTransactionResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalGetTransactionResponse* InternalGetTransactionResponse::default_instance_ = NULL;
+
+InternalGetTransactionResponse* InternalGetTransactionResponse::New() const {
+  return new InternalGetTransactionResponse;
+}
+
+void InternalGetTransactionResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    if (has_txn()) {
+      if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalGetTransactionResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalGetTransactionResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_txn;
+        break;
+      }
+
+      // optional .proto.Transaction txn = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalGetTransactionResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalGetTransactionResponse)
+  return false;
+#undef DO_
+}
+
+void InternalGetTransactionResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalGetTransactionResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .proto.Transaction txn = 2;
+  if (has_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->txn(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalGetTransactionResponse)
+}
+
+::google::protobuf::uint8* InternalGetTransactionResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalGetTransactionResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .proto.Transaction txn = 2;
+  if (has_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->txn(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalGetTransactionResponse)
+  return target;
+}
+
+int InternalGetTransactionResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .proto.Transaction txn = 2;
+    if (has_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->txn());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalGetTransactionResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalGetTransactionResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalGetTransactionResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalGetTransactionResponse::MergeFrom(const InternalGetTransactionResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_txn()) {
+      mutable_txn()->::proto::Transaction::MergeFrom(from.txn());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalGetTransactionResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalGetTransactionResponse::CopyFrom(const InternalGetTransactionResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalGetTransactionResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalGetTransactionResponse::Swap(InternalGetTransactionResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(txn_, other->txn_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalGetTransactionResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalGetTransactionResponse_descriptor_;
+  metadata.reflection = InternalGetTransactionResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalPutIfAbsentRequest::kHeaderFieldNumber;
+const int InternalPutIfAbsentRequest::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+InternalPutIfAbsentRequest::InternalPutIfAbsentRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalPutIfAbsentRequest)
+}
+
+void InternalPutIfAbsentRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+}
+
+InternalPutIfAbsentRequest::InternalPutIfAbsentRequest(const InternalPutIfAbsentRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalPutIfAbsentRequest)
+}
+
+void InternalPutIfAbsentRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalPutIfAbsentRequest::~InternalPutIfAbsentRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalPutIfAbsentRequest)
+  SharedDtor();
+}
+
+void InternalPutIfAbsentRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete value_;
+  }
+}
+
+void InternalPutIfAbsentRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalPutIfAbsentRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalPutIfAbsentRequest_descriptor_;
+}
+
+const InternalPutIfAbsentRequest& InternalPutIfAbsentRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalPutIfAbsentRequest* InternalPutIfAbsentRequest::default_instance_ = NULL;
+
+InternalPutIfAbsentRequest* InternalPutIfAbsentRequest::New() const {
+  return new InternalPutIfAbsentRequest;
+}
+
+void InternalPutIfAbsentRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    if (has_value()) {
+      if (value_ != NULL) value_->::proto::Value::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalPutIfAbsentRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalPutIfAbsentRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .proto.Value value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalPutIfAbsentRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalPutIfAbsentRequest)
+  return false;
+#undef DO_
+}
+
+void InternalPutIfAbsentRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalPutIfAbsentRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .proto.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->value(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalPutIfAbsentRequest)
+}
+
+::google::protobuf::uint8* InternalPutIfAbsentRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalPutIfAbsentRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .proto.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->value(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalPutIfAbsentRequest)
+  return target;
+}
+
+int InternalPutIfAbsentRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .proto.Value value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalPutIfAbsentRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalPutIfAbsentRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalPutIfAbsentRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalPutIfAbsentRequest::MergeFrom(const InternalPutIfAbsentRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_value()) {
+      mutable_value()->::proto::Value::MergeFrom(from.value());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalPutIfAbsentRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalPutIfAbsentRequest::CopyFrom(const InternalPutIfAbsentRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalPutIfAbsentRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalPutIfAbsentRequest::Swap(InternalPutIfAbsentRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(value_, other->value_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalPutIfAbsentRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalPutIfAbsentRequest_descriptor_;
+  metadata.reflection = InternalPutIfAbsentRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalPutIfAbsentResponse::kHeaderFieldNumber;
+const int InternalPutIfAbsentResponse::kInsertedFieldNumber;
+const int InternalPutIfAbsentResponse::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+InternalPutIfAbsentResponse::InternalPutIfAbsentResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalPutIfAbsentResponse)
+}
+
+void InternalPutIfAbsentResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+}
+
+InternalPutIfAbsentResponse::InternalPutIfAbsentResponse(const InternalPutIfAbsentResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalPutIfAbsentResponse)
+}
+
+void InternalPutIfAbsentResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  inserted_ = false;
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalPutIfAbsentResponse::~InternalPutIfAbsentResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalPutIfAbsentResponse)
+  SharedDtor();
+}
+
+void InternalPutIfAbsentResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete value_;
+  }
+}
+
+void InternalPutIfAbsentResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalPutIfAbsentResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalPutIfAbsentResponse_descriptor_;
+}
+
+const InternalPutIfAbsentResponse& InternalPutIfAbsentResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalPutIfAbsentResponse* InternalPutIfAbsentResponse::default_instance_ = NULL;
+
+InternalPutIfAbsentResponse* InternalPutIfAbsentResponse::New() const {
+  return new InternalPutIfAbsentResponse;
+}
+
+void InternalPutIfAbsentResponse::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    inserted_ = false;
+    if (has_value()) {
+      if (value_ != NULL) value_->::proto::Value::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalPutIfAbsentResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalPutIfAbsentResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_inserted;
+        break;
+      }
+
+      // optional bool inserted = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_inserted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &inserted_)));
+          set_has_inserted();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_value;
+        break;
+      }
+
+      // optional .proto.Value value = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalPutIfAbsentResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalPutIfAbsentResponse)
+  return false;
+#undef DO_
+}
+
+void InternalPutIfAbsentResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalPutIfAbsentResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional bool inserted = 2;
+  if (has_inserted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->inserted(), output);
+  }
+
+  // optional .proto.Value value = 3;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->value(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalPutIfAbsentResponse)
+}
+
+::google::protobuf::uint8* InternalPutIfAbsentResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalPutIfAbsentResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional bool inserted = 2;
+  if (has_inserted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->inserted(), target);
+  }
+
+  // optional .proto.Value value = 3;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->value(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalPutIfAbsentResponse)
+  return target;
+}
+
+int InternalPutIfAbsentResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bool inserted = 2;
+    if (has_inserted()) {
+      total_size += 1 + 1;
+    }
+
+    // optional .proto.Value value = 3;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalPutIfAbsentResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalPutIfAbsentResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalPutIfAbsentResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalPutIfAbsentResponse::MergeFrom(const InternalPutIfAbsentResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_inserted()) {
+      set_inserted(from.inserted());
+    }
+    if (from.has_value()) {
+      mutable_value()->::proto::Value::MergeFrom(from.value());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalPutIfAbsentResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalPutIfAbsentResponse::CopyFrom(const InternalPutIfAbsentResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalPutIfAbsentResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalPutIfAbsentResponse::Swap(InternalPutIfAbsentResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(inserted_, other->inserted_);
+    std::swap(value_, other->value_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalPutIfAbsentResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalPutIfAbsentResponse_descriptor_;
+  metadata.reflection = InternalPutIfAbsentResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanResumeToken::kKeyFieldNumber;
+const int ScanResumeToken::kRaftIdFieldNumber;
+const int ScanResumeToken::kStartKeyFieldNumber;
+const int ScanResumeToken::kEndKeyFieldNumber;
+#endif  // !_MSC_VER
+
+ScanResumeToken::ScanResumeToken()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ScanResumeToken)
+}
+
+void ScanResumeToken::InitAsDefaultInstance() {
+}
+
+ScanResumeToken::ScanResumeToken(const ScanResumeToken& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ScanResumeToken)
+}
+
+void ScanResumeToken::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  raft_id_ = GOOGLE_LONGLONG(0);
+  start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanResumeToken::~ScanResumeToken() {
+  // @@protoc_insertion_point(destructor:proto.ScanResumeToken)
+  SharedDtor();
+}
+
+void ScanResumeToken::SharedDtor() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete start_key_;
+  }
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void ScanResumeToken::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanResumeToken::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanResumeToken_descriptor_;
+}
+
+const ScanResumeToken& ScanResumeToken::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+ScanResumeToken* ScanResumeToken::default_instance_ = NULL;
+
+ScanResumeToken* ScanResumeToken::New() const {
+  return new ScanResumeToken;
+}
+
+void ScanResumeToken::Clear() {
+  if (_has_bits_[0 / 32] & 15) {
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    raft_id_ = GOOGLE_LONGLONG(0);
+    if (has_start_key()) {
+      if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        start_key_->clear();
+      }
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ScanResumeToken::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ScanResumeToken)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_raft_id;
+        break;
+      }
+
+      // optional int64 raft_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_raft_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &raft_id_)));
+          set_has_raft_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_start_key;
+        break;
+      }
+
+      // optional bytes start_key = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_start_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_start_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_end_key;
+        break;
+      }
+
+      // optional bytes end_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ScanResumeToken)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ScanResumeToken)
+  return false;
+#undef DO_
+}
+
+void ScanResumeToken::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ScanResumeToken)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional int64 raft_id = 2;
+  if (has_raft_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->raft_id(), output);
+  }
+
+  // optional bytes start_key = 3;
+  if (has_start_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->start_key(), output);
+  }
+
+  // optional bytes end_key = 4;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->end_key(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ScanResumeToken)
+}
+
+::google::protobuf::uint8* ScanResumeToken::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ScanResumeToken)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional int64 raft_id = 2;
+  if (has_raft_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->raft_id(), target);
+  }
+
+  // optional bytes start_key = 3;
+  if (has_start_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->start_key(), target);
+  }
+
+  // optional bytes end_key = 4;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->end_key(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ScanResumeToken)
+  return target;
+}
+
+int ScanResumeToken::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional int64 raft_id = 2;
+    if (has_raft_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->raft_id());
+    }
+
+    // optional bytes start_key = 3;
+    if (has_start_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->start_key());
+    }
+
+    // optional bytes end_key = 4;
+    if (has_end_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanResumeToken::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ScanResumeToken* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ScanResumeToken*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanResumeToken::MergeFrom(const ScanResumeToken& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_raft_id()) {
+      set_raft_id(from.raft_id());
+    }
+    if (from.has_start_key()) {
+      set_start_key(from.start_key());
+    }
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ScanResumeToken::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanResumeToken::CopyFrom(const ScanResumeToken& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanResumeToken::IsInitialized() const {
+
+  return true;
+}
+
+void ScanResumeToken::Swap(ScanResumeToken* other) {
+  if (other != this) {
+    std::swap(key_, other->key_);
+    std::swap(raft_id_, other->raft_id_);
+    std::swap(start_key_, other->start_key_);
+    std::swap(end_key_, other->end_key_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ScanResumeToken::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanResumeToken_descriptor_;
+  metadata.reflection = ScanResumeToken_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int AuditEntry::kUserFieldNumber;
+const int AuditEntry::kMethodFieldNumber;
+const int AuditEntry::kKeyFieldNumber;
+const int AuditEntry::kEndKeyFieldNumber;
+const int AuditEntry::kTimestampFieldNumber;
+const int AuditEntry::kOldValueHashFieldNumber;
+const int AuditEntry::kNewValueHashFieldNumber;
+#endif  // !_MSC_VER
+
+AuditEntry::AuditEntry()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.AuditEntry)
+}
+
+void AuditEntry::InitAsDefaultInstance() {
+  timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+}
+
+AuditEntry::AuditEntry(const AuditEntry& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.AuditEntry)
+}
+
+void AuditEntry::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  user_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  timestamp_ = NULL;
+  old_value_hash_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  new_value_hash_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+AuditEntry::~AuditEntry() {
+  // @@protoc_insertion_point(destructor:proto.AuditEntry)
+  SharedDtor();
+}
+
+void AuditEntry::SharedDtor() {
+  if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete user_;
+  }
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete method_;
+  }
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (old_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete old_value_hash_;
+  }
+  if (new_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete new_value_hash_;
+  }
+  if (this != default_instance_) {
+    delete timestamp_;
+  }
+}
+
+void AuditEntry::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* AuditEntry::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return AuditEntry_descriptor_;
+}
+
+const AuditEntry& AuditEntry::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+AuditEntry* AuditEntry::default_instance_ = NULL;
+
+AuditEntry* AuditEntry::New() const {
+  return new AuditEntry;
+}
+
+void AuditEntry::Clear() {
+  if (_has_bits_[0 / 32] & 127) {
+    if (has_user()) {
+      if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        user_->clear();
+      }
+    }
+    if (has_method()) {
+      if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        method_->clear();
+      }
+    }
+    if (has_key()) {
+      if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        key_->clear();
+      }
+    }
+    if (has_end_key()) {
+      if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        end_key_->clear();
+      }
+    }
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+    }
+    if (has_old_value_hash()) {
+      if (old_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        old_value_hash_->clear();
+      }
+    }
+    if (has_new_value_hash()) {
+      if (new_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        new_value_hash_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool AuditEntry::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.AuditEntry)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional string user = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_user()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->user().data(), this->user().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "user");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_method;
+        break;
+      }
+
+      // optional string method = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_method:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadString(
+                input, this->mutable_method()));
+          ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+            this->method().data(), this->method().length(),
+            ::google::protobuf::internal::WireFormat::PARSE,
+            "method");
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_key;
+        break;
+      }
+
+      // optional bytes key = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_end_key;
+        break;
+      }
+
+      // optional bytes end_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_end_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_end_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_timestamp;
+        break;
+      }
+
+      // optional .proto.Timestamp timestamp = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_old_value_hash;
+        break;
+      }
+
+      // optional bytes old_value_hash = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_old_value_hash:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_old_value_hash()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_new_value_hash;
+        break;
+      }
+
+      // optional bytes new_value_hash = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_new_value_hash:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_new_value_hash()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.AuditEntry)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.AuditEntry)
+  return false;
+#undef DO_
+}
+
+void AuditEntry::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.AuditEntry)
+  // optional string user = 1;
+  if (has_user()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->user().data(), this->user().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "user");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      1, this->user(), output);
+  }
+
+  // optional string method = 2;
+  if (has_method()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->method().data(), this->method().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "method");
+    ::google::protobuf::internal::WireFormatLite::WriteStringMaybeAliased(
+      2, this->method(), output);
+  }
+
+  // optional bytes key = 3;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->key(), output);
+  }
+
+  // optional bytes end_key = 4;
+  if (has_end_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->end_key(), output);
+  }
+
+  // optional .proto.Timestamp timestamp = 5;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->timestamp(), output);
+  }
+
+  // optional bytes old_value_hash = 6;
+  if (has_old_value_hash()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      6, this->old_value_hash(), output);
+  }
+
+  // optional bytes new_value_hash = 7;
+  if (has_new_value_hash()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      7, this->new_value_hash(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.AuditEntry)
+}
+
+::google::protobuf::uint8* AuditEntry::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.AuditEntry)
+  // optional string user = 1;
+  if (has_user()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->user().data(), this->user().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "user");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        1, this->user(), target);
+  }
+
+  // optional string method = 2;
+  if (has_method()) {
+    ::google::protobuf::internal::WireFormat::VerifyUTF8StringNamedField(
+      this->method().data(), this->method().length(),
+      ::google::protobuf::internal::WireFormat::SERIALIZE,
+      "method");
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteStringToArray(
+        2, this->method(), target);
+  }
+
+  // optional bytes key = 3;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->key(), target);
+  }
+
+  // optional bytes end_key = 4;
+  if (has_end_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->end_key(), target);
+  }
+
+  // optional .proto.Timestamp timestamp = 5;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->timestamp(), target);
+  }
+
+  // optional bytes old_value_hash = 6;
+  if (has_old_value_hash()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        6, this->old_value_hash(), target);
+  }
+
+  // optional bytes new_value_hash = 7;
+  if (has_new_value_hash()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        7, this->new_value_hash(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.AuditEntry)
+  return target;
+}
+
+int AuditEntry::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional string user = 1;
+    if (has_user()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->user());
+    }
+
+    // optional string method = 2;
+    if (has_method()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::StringSize(
+          this->method());
+    }
+
+    // optional bytes key = 3;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional bytes end_key = 4;
+    if (has_end_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->end_key());
+    }
+
+    // optional .proto.Timestamp timestamp = 5;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp());
+    }
+
+    // optional bytes old_value_hash = 6;
+    if (has_old_value_hash()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->old_value_hash());
+    }
+
+    // optional bytes new_value_hash = 7;
+    if (has_new_value_hash()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->new_value_hash());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void AuditEntry::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const AuditEntry* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const AuditEntry*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void AuditEntry::MergeFrom(const AuditEntry& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_user()) {
+      set_user(from.user());
+    }
+    if (from.has_method()) {
+      set_method(from.method());
+    }
+    if (from.has_key()) {
+      set_key(from.key());
+    }
+    if (from.has_end_key()) {
+      set_end_key(from.end_key());
+    }
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_old_value_hash()) {
+      set_old_value_hash(from.old_value_hash());
+    }
+    if (from.has_new_value_hash()) {
+      set_new_value_hash(from.new_value_hash());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void AuditEntry::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void AuditEntry::CopyFrom(const AuditEntry& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool AuditEntry::IsInitialized() const {
+
+  return true;
+}
+
+void AuditEntry::Swap(AuditEntry* other) {
+  if (other != this) {
+    std::swap(user_, other->user_);
+    std::swap(method_, other->method_);
+    std::swap(key_, other->key_);
+    std::swap(end_key_, other->end_key_);
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(old_value_hash_, other->old_value_hash_);
+    std::swap(new_value_hash_, other->new_value_hash_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata AuditEntry::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = AuditEntry_descriptor_;
+  metadata.reflection = AuditEntry_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRangeKeyBoundsRequest::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRangeKeyBoundsRequest::InternalRangeKeyBoundsRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalRangeKeyBoundsRequest)
+}
+
+void InternalRangeKeyBoundsRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalRangeKeyBoundsRequest::InternalRangeKeyBoundsRequest(const InternalRangeKeyBoundsRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalRangeKeyBoundsRequest)
+}
+
+void InternalRangeKeyBoundsRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalRangeKeyBoundsRequest::~InternalRangeKeyBoundsRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalRangeKeyBoundsRequest)
+  SharedDtor();
+}
+
+void InternalRangeKeyBoundsRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalRangeKeyBoundsRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRangeKeyBoundsRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRangeKeyBoundsRequest_descriptor_;
+}
+
+const InternalRangeKeyBoundsRequest& InternalRangeKeyBoundsRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalRangeKeyBoundsRequest* InternalRangeKeyBoundsRequest::default_instance_ = NULL;
+
+InternalRangeKeyBoundsRequest* InternalRangeKeyBoundsRequest::New() const {
+  return new InternalRangeKeyBoundsRequest;
+}
+
+void InternalRangeKeyBoundsRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRangeKeyBoundsRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalRangeKeyBoundsRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalRangeKeyBoundsRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalRangeKeyBoundsRequest)
+  return false;
+#undef DO_
+}
+
+void InternalRangeKeyBoundsRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalRangeKeyBoundsRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalRangeKeyBoundsRequest)
+}
+
+::google::protobuf::uint8* InternalRangeKeyBoundsRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalRangeKeyBoundsRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalRangeKeyBoundsRequest)
+  return target;
+}
+
+int InternalRangeKeyBoundsRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalRangeKeyBoundsRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRangeKeyBoundsRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRangeKeyBoundsRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalRangeKeyBoundsRequest::MergeFrom(const InternalRangeKeyBoundsRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalRangeKeyBoundsRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRangeKeyBoundsRequest::CopyFrom(const InternalRangeKeyBoundsRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRangeKeyBoundsRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRangeKeyBoundsRequest::Swap(InternalRangeKeyBoundsRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalRangeKeyBoundsRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRangeKeyBoundsRequest_descriptor_;
+  metadata.reflection = InternalRangeKeyBoundsRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalRangeKeyBoundsResponse::kHeaderFieldNumber;
+const int InternalRangeKeyBoundsResponse::kMinKeyFieldNumber;
+const int InternalRangeKeyBoundsResponse::kMaxKeyFieldNumber;
+#endif  // !_MSC_VER
+
+InternalRangeKeyBoundsResponse::InternalRangeKeyBoundsResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalRangeKeyBoundsResponse)
+}
+
+void InternalRangeKeyBoundsResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalRangeKeyBoundsResponse::InternalRangeKeyBoundsResponse(const InternalRangeKeyBoundsResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalRangeKeyBoundsResponse)
+}
+
+void InternalRangeKeyBoundsResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  min_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  max_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalRangeKeyBoundsResponse::~InternalRangeKeyBoundsResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalRangeKeyBoundsResponse)
+  SharedDtor();
+}
+
+void InternalRangeKeyBoundsResponse::SharedDtor() {
+  if (min_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete min_key_;
+  }
+  if (max_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete max_key_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalRangeKeyBoundsResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalRangeKeyBoundsResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalRangeKeyBoundsResponse_descriptor_;
+}
+
+const InternalRangeKeyBoundsResponse& InternalRangeKeyBoundsResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalRangeKeyBoundsResponse* InternalRangeKeyBoundsResponse::default_instance_ = NULL;
+
+InternalRangeKeyBoundsResponse* InternalRangeKeyBoundsResponse::New() const {
+  return new InternalRangeKeyBoundsResponse;
+}
+
+void InternalRangeKeyBoundsResponse::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    if (has_min_key()) {
+      if (min_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        min_key_->clear();
+      }
+    }
+    if (has_max_key()) {
+      if (max_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        max_key_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalRangeKeyBoundsResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalRangeKeyBoundsResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_min_key;
+        break;
+      }
+
+      // optional bytes min_key = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_min_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_min_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_max_key;
+        break;
+      }
+
+      // optional bytes max_key = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_max_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_max_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalRangeKeyBoundsResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalRangeKeyBoundsResponse)
+  return false;
+#undef DO_
+}
+
+void InternalRangeKeyBoundsResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalRangeKeyBoundsResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional bytes min_key = 2;
+  if (has_min_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->min_key(), output);
+  }
+
+  // optional bytes max_key = 3;
+  if (has_max_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->max_key(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalRangeKeyBoundsResponse)
+}
+
+::google::protobuf::uint8* InternalRangeKeyBoundsResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalRangeKeyBoundsResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional bytes min_key = 2;
+  if (has_min_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->min_key(), target);
+  }
+
+  // optional bytes max_key = 3;
+  if (has_max_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->max_key(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalRangeKeyBoundsResponse)
+  return target;
+}
+
+int InternalRangeKeyBoundsResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bytes min_key = 2;
+    if (has_min_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->min_key());
+    }
+
+    // optional bytes max_key = 3;
+    if (has_max_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->max_key());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalRangeKeyBoundsResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalRangeKeyBoundsResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalRangeKeyBoundsResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalRangeKeyBoundsResponse::MergeFrom(const InternalRangeKeyBoundsResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_min_key()) {
+      set_min_key(from.min_key());
+    }
+    if (from.has_max_key()) {
+      set_max_key(from.max_key());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalRangeKeyBoundsResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalRangeKeyBoundsResponse::CopyFrom(const InternalRangeKeyBoundsResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalRangeKeyBoundsResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalRangeKeyBoundsResponse::Swap(InternalRangeKeyBoundsResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(min_key_, other->min_key_);
+    std::swap(max_key_, other->max_key_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalRangeKeyBoundsResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalRangeKeyBoundsResponse_descriptor_;
+  metadata.reflection = InternalRangeKeyBoundsResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalVerifyRangeRequest::kHeaderFieldNumber;
+const int InternalVerifyRangeRequest::kMaxKeysFieldNumber;
+#endif  // !_MSC_VER
+
+InternalVerifyRangeRequest::InternalVerifyRangeRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalVerifyRangeRequest)
+}
+
+void InternalVerifyRangeRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalVerifyRangeRequest::InternalVerifyRangeRequest(const InternalVerifyRangeRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalVerifyRangeRequest)
+}
+
+void InternalVerifyRangeRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  max_keys_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalVerifyRangeRequest::~InternalVerifyRangeRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalVerifyRangeRequest)
+  SharedDtor();
+}
+
+void InternalVerifyRangeRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalVerifyRangeRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalVerifyRangeRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalVerifyRangeRequest_descriptor_;
+}
+
+const InternalVerifyRangeRequest& InternalVerifyRangeRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalVerifyRangeRequest* InternalVerifyRangeRequest::default_instance_ = NULL;
+
+InternalVerifyRangeRequest* InternalVerifyRangeRequest::New() const {
+  return new InternalVerifyRangeRequest;
+}
+
+void InternalVerifyRangeRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    max_keys_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalVerifyRangeRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalVerifyRangeRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_max_keys;
+        break;
+      }
+
+      // optional int64 max_keys = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_keys:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_keys_)));
+          set_has_max_keys();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalVerifyRangeRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalVerifyRangeRequest)
+  return false;
+#undef DO_
+}
+
+void InternalVerifyRangeRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalVerifyRangeRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional int64 max_keys = 2;
+  if (has_max_keys()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_keys(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalVerifyRangeRequest)
+}
+
+::google::protobuf::uint8* InternalVerifyRangeRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalVerifyRangeRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional int64 max_keys = 2;
+  if (has_max_keys()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_keys(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalVerifyRangeRequest)
+  return target;
+}
+
+int InternalVerifyRangeRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional int64 max_keys = 2;
+    if (has_max_keys()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_keys());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalVerifyRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalVerifyRangeRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalVerifyRangeRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalVerifyRangeRequest::MergeFrom(const InternalVerifyRangeRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_max_keys()) {
+      set_max_keys(from.max_keys());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalVerifyRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalVerifyRangeRequest::CopyFrom(const InternalVerifyRangeRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalVerifyRangeRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalVerifyRangeRequest::Swap(InternalVerifyRangeRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(max_keys_, other->max_keys_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalVerifyRangeRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalVerifyRangeRequest_descriptor_;
+  metadata.reflection = InternalVerifyRangeRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalVerifyRangeResponse::kHeaderFieldNumber;
+const int InternalVerifyRangeResponse::kOutOfBoundsKeysFieldNumber;
+#endif  // !_MSC_VER
+
+InternalVerifyRangeResponse::InternalVerifyRangeResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalVerifyRangeResponse)
+}
+
+void InternalVerifyRangeResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalVerifyRangeResponse::InternalVerifyRangeResponse(const InternalVerifyRangeResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalVerifyRangeResponse)
+}
+
+void InternalVerifyRangeResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalVerifyRangeResponse::~InternalVerifyRangeResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalVerifyRangeResponse)
+  SharedDtor();
+}
+
+void InternalVerifyRangeResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalVerifyRangeResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalVerifyRangeResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalVerifyRangeResponse_descriptor_;
+}
+
+const InternalVerifyRangeResponse& InternalVerifyRangeResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalVerifyRangeResponse* InternalVerifyRangeResponse::default_instance_ = NULL;
+
+InternalVerifyRangeResponse* InternalVerifyRangeResponse::New() const {
+  return new InternalVerifyRangeResponse;
+}
+
+void InternalVerifyRangeResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  out_of_bounds_keys_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalVerifyRangeResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalVerifyRangeResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_out_of_bounds_keys;
+        break;
+      }
+
+      // repeated bytes out_of_bounds_keys = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_out_of_bounds_keys:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_out_of_bounds_keys()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_out_of_bounds_keys;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalVerifyRangeResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalVerifyRangeResponse)
+  return false;
+#undef DO_
+}
+
+void InternalVerifyRangeResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalVerifyRangeResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated bytes out_of_bounds_keys = 2;
+  for (int i = 0; i < this->out_of_bounds_keys_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      2, this->out_of_bounds_keys(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalVerifyRangeResponse)
+}
+
+::google::protobuf::uint8* InternalVerifyRangeResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalVerifyRangeResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated bytes out_of_bounds_keys = 2;
+  for (int i = 0; i < this->out_of_bounds_keys_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(2, this->out_of_bounds_keys(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalVerifyRangeResponse)
+  return target;
+}
+
+int InternalVerifyRangeResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated bytes out_of_bounds_keys = 2;
+  total_size += 1 * this->out_of_bounds_keys_size();
+  for (int i = 0; i < this->out_of_bounds_keys_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->out_of_bounds_keys(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalVerifyRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalVerifyRangeResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalVerifyRangeResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalVerifyRangeResponse::MergeFrom(const InternalVerifyRangeResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  out_of_bounds_keys_.MergeFrom(from.out_of_bounds_keys_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalVerifyRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalVerifyRangeResponse::CopyFrom(const InternalVerifyRangeResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalVerifyRangeResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalVerifyRangeResponse::Swap(InternalVerifyRangeResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    out_of_bounds_keys_.Swap(&other->out_of_bounds_keys_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalVerifyRangeResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalVerifyRangeResponse_descriptor_;
+  metadata.reflection = InternalVerifyRangeResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalResolveIntentRangeRequest::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalResolveIntentRangeRequest::InternalResolveIntentRangeRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalResolveIntentRangeRequest)
+}
+
+void InternalResolveIntentRangeRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalResolveIntentRangeRequest::InternalResolveIntentRangeRequest(const InternalResolveIntentRangeRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalResolveIntentRangeRequest)
+}
+
+void InternalResolveIntentRangeRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalResolveIntentRangeRequest::~InternalResolveIntentRangeRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalResolveIntentRangeRequest)
+  SharedDtor();
+}
+
+void InternalResolveIntentRangeRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalResolveIntentRangeRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalResolveIntentRangeRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalResolveIntentRangeRequest_descriptor_;
+}
+
+const InternalResolveIntentRangeRequest& InternalResolveIntentRangeRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalResolveIntentRangeRequest* InternalResolveIntentRangeRequest::default_instance_ = NULL;
+
+InternalResolveIntentRangeRequest* InternalResolveIntentRangeRequest::New() const {
+  return new InternalResolveIntentRangeRequest;
+}
+
+void InternalResolveIntentRangeRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalResolveIntentRangeRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalResolveIntentRangeRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalResolveIntentRangeRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalResolveIntentRangeRequest)
+  return false;
+#undef DO_
+}
+
+void InternalResolveIntentRangeRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalResolveIntentRangeRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalResolveIntentRangeRequest)
+}
+
+::google::protobuf::uint8* InternalResolveIntentRangeRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalResolveIntentRangeRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalResolveIntentRangeRequest)
+  return target;
+}
+
+int InternalResolveIntentRangeRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalResolveIntentRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalResolveIntentRangeRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalResolveIntentRangeRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalResolveIntentRangeRequest::MergeFrom(const InternalResolveIntentRangeRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalResolveIntentRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalResolveIntentRangeRequest::CopyFrom(const InternalResolveIntentRangeRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalResolveIntentRangeRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalResolveIntentRangeRequest::Swap(InternalResolveIntentRangeRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalResolveIntentRangeRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalResolveIntentRangeRequest_descriptor_;
+  metadata.reflection = InternalResolveIntentRangeRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalResolveIntentRangeResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalResolveIntentRangeResponse::InternalResolveIntentRangeResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalResolveIntentRangeResponse)
+}
+
+void InternalResolveIntentRangeResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalResolveIntentRangeResponse::InternalResolveIntentRangeResponse(const InternalResolveIntentRangeResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalResolveIntentRangeResponse)
+}
+
+void InternalResolveIntentRangeResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalResolveIntentRangeResponse::~InternalResolveIntentRangeResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalResolveIntentRangeResponse)
+  SharedDtor();
+}
+
+void InternalResolveIntentRangeResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalResolveIntentRangeResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalResolveIntentRangeResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalResolveIntentRangeResponse_descriptor_;
+}
+
+const InternalResolveIntentRangeResponse& InternalResolveIntentRangeResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalResolveIntentRangeResponse* InternalResolveIntentRangeResponse::default_instance_ = NULL;
+
+InternalResolveIntentRangeResponse* InternalResolveIntentRangeResponse::New() const {
+  return new InternalResolveIntentRangeResponse;
+}
+
+void InternalResolveIntentRangeResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalResolveIntentRangeResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalResolveIntentRangeResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalResolveIntentRangeResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalResolveIntentRangeResponse)
+  return false;
+#undef DO_
+}
+
+void InternalResolveIntentRangeResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalResolveIntentRangeResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalResolveIntentRangeResponse)
+}
+
+::google::protobuf::uint8* InternalResolveIntentRangeResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalResolveIntentRangeResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalResolveIntentRangeResponse)
+  return target;
+}
+
+int InternalResolveIntentRangeResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalResolveIntentRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalResolveIntentRangeResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalResolveIntentRangeResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalResolveIntentRangeResponse::MergeFrom(const InternalResolveIntentRangeResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalResolveIntentRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalResolveIntentRangeResponse::CopyFrom(const InternalResolveIntentRangeResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalResolveIntentRangeResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalResolveIntentRangeResponse::Swap(InternalResolveIntentRangeResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalResolveIntentRangeResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalResolveIntentRangeResponse_descriptor_;
+  metadata.reflection = InternalResolveIntentRangeResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalLeaderLeaseRequest::kHeaderFieldNumber;
+const int InternalLeaderLeaseRequest::kLeaseFieldNumber;
+#endif  // !_MSC_VER
+
+InternalLeaderLeaseRequest::InternalLeaderLeaseRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalLeaderLeaseRequest)
+}
+
+void InternalLeaderLeaseRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+  lease_ = const_cast< ::proto::Lease*>(&::proto::Lease::default_instance());
+}
+
+InternalLeaderLeaseRequest::InternalLeaderLeaseRequest(const InternalLeaderLeaseRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalLeaderLeaseRequest)
+}
+
+void InternalLeaderLeaseRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  lease_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalLeaderLeaseRequest::~InternalLeaderLeaseRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalLeaderLeaseRequest)
+  SharedDtor();
+}
+
+void InternalLeaderLeaseRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete lease_;
+  }
+}
+
+void InternalLeaderLeaseRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalLeaderLeaseRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalLeaderLeaseRequest_descriptor_;
+}
+
+const InternalLeaderLeaseRequest& InternalLeaderLeaseRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalLeaderLeaseRequest* InternalLeaderLeaseRequest::default_instance_ = NULL;
+
+InternalLeaderLeaseRequest* InternalLeaderLeaseRequest::New() const {
+  return new InternalLeaderLeaseRequest;
+}
+
+void InternalLeaderLeaseRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    if (has_lease()) {
+      if (lease_ != NULL) lease_->::proto::Lease::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalLeaderLeaseRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalLeaderLeaseRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_lease;
+        break;
+      }
+
+      // optional .proto.Lease lease = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_lease:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_lease()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalLeaderLeaseRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalLeaderLeaseRequest)
+  return false;
+#undef DO_
+}
+
+void InternalLeaderLeaseRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalLeaderLeaseRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .proto.Lease lease = 2;
+  if (has_lease()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->lease(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalLeaderLeaseRequest)
+}
+
+::google::protobuf::uint8* InternalLeaderLeaseRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalLeaderLeaseRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .proto.Lease lease = 2;
+  if (has_lease()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->lease(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalLeaderLeaseRequest)
+  return target;
+}
+
+int InternalLeaderLeaseRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .proto.Lease lease = 2;
+    if (has_lease()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->lease());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalLeaderLeaseRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalLeaderLeaseRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalLeaderLeaseRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalLeaderLeaseRequest::MergeFrom(const InternalLeaderLeaseRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_lease()) {
+      mutable_lease()->::proto::Lease::MergeFrom(from.lease());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalLeaderLeaseRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalLeaderLeaseRequest::CopyFrom(const InternalLeaderLeaseRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalLeaderLeaseRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalLeaderLeaseRequest::Swap(InternalLeaderLeaseRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(lease_, other->lease_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalLeaderLeaseRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalLeaderLeaseRequest_descriptor_;
+  metadata.reflection = InternalLeaderLeaseRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalLeaderLeaseResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalLeaderLeaseResponse::InternalLeaderLeaseResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalLeaderLeaseResponse)
+}
+
+void InternalLeaderLeaseResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalLeaderLeaseResponse::InternalLeaderLeaseResponse(const InternalLeaderLeaseResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalLeaderLeaseResponse)
+}
+
+void InternalLeaderLeaseResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalLeaderLeaseResponse::~InternalLeaderLeaseResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalLeaderLeaseResponse)
+  SharedDtor();
+}
+
+void InternalLeaderLeaseResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalLeaderLeaseResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalLeaderLeaseResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalLeaderLeaseResponse_descriptor_;
+}
+
+const InternalLeaderLeaseResponse& InternalLeaderLeaseResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalLeaderLeaseResponse* InternalLeaderLeaseResponse::default_instance_ = NULL;
+
+InternalLeaderLeaseResponse* InternalLeaderLeaseResponse::New() const {
+  return new InternalLeaderLeaseResponse;
+}
+
+void InternalLeaderLeaseResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalLeaderLeaseResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalLeaderLeaseResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalLeaderLeaseResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalLeaderLeaseResponse)
+  return false;
+#undef DO_
+}
+
+void InternalLeaderLeaseResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalLeaderLeaseResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalLeaderLeaseResponse)
+}
+
+::google::protobuf::uint8* InternalLeaderLeaseResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalLeaderLeaseResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalLeaderLeaseResponse)
+  return target;
+}
+
+int InternalLeaderLeaseResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalLeaderLeaseResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalLeaderLeaseResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalLeaderLeaseResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalLeaderLeaseResponse::MergeFrom(const InternalLeaderLeaseResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalLeaderLeaseResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalLeaderLeaseResponse::CopyFrom(const InternalLeaderLeaseResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalLeaderLeaseResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalLeaderLeaseResponse::Swap(InternalLeaderLeaseResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalLeaderLeaseResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalLeaderLeaseResponse_descriptor_;
+  metadata.reflection = InternalLeaderLeaseResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalComputeChecksumRequest::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+InternalComputeChecksumRequest::InternalComputeChecksumRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalComputeChecksumRequest)
+}
+
+void InternalComputeChecksumRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalComputeChecksumRequest::InternalComputeChecksumRequest(const InternalComputeChecksumRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalComputeChecksumRequest)
+}
+
+void InternalComputeChecksumRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalComputeChecksumRequest::~InternalComputeChecksumRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalComputeChecksumRequest)
+  SharedDtor();
+}
+
+void InternalComputeChecksumRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalComputeChecksumRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalComputeChecksumRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalComputeChecksumRequest_descriptor_;
+}
+
+const InternalComputeChecksumRequest& InternalComputeChecksumRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalComputeChecksumRequest* InternalComputeChecksumRequest::default_instance_ = NULL;
+
+InternalComputeChecksumRequest* InternalComputeChecksumRequest::New() const {
+  return new InternalComputeChecksumRequest;
+}
+
+void InternalComputeChecksumRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalComputeChecksumRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalComputeChecksumRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalComputeChecksumRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalComputeChecksumRequest)
+  return false;
+#undef DO_
+}
+
+void InternalComputeChecksumRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalComputeChecksumRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalComputeChecksumRequest)
+}
+
+::google::protobuf::uint8* InternalComputeChecksumRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalComputeChecksumRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalComputeChecksumRequest)
+  return target;
+}
+
+int InternalComputeChecksumRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalComputeChecksumRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalComputeChecksumRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalComputeChecksumRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalComputeChecksumRequest::MergeFrom(const InternalComputeChecksumRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalComputeChecksumRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalComputeChecksumRequest::CopyFrom(const InternalComputeChecksumRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalComputeChecksumRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalComputeChecksumRequest::Swap(InternalComputeChecksumRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalComputeChecksumRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalComputeChecksumRequest_descriptor_;
+  metadata.reflection = InternalComputeChecksumRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalComputeChecksumResponse::kHeaderFieldNumber;
+const int InternalComputeChecksumResponse::kChecksumFieldNumber;
+#endif  // !_MSC_VER
+
+InternalComputeChecksumResponse::InternalComputeChecksumResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalComputeChecksumResponse)
+}
+
+void InternalComputeChecksumResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalComputeChecksumResponse::InternalComputeChecksumResponse(const InternalComputeChecksumResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalComputeChecksumResponse)
+}
+
+void InternalComputeChecksumResponse::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalComputeChecksumResponse::~InternalComputeChecksumResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalComputeChecksumResponse)
+  SharedDtor();
+}
+
+void InternalComputeChecksumResponse::SharedDtor() {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
+  }
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalComputeChecksumResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalComputeChecksumResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalComputeChecksumResponse_descriptor_;
+}
+
+const InternalComputeChecksumResponse& InternalComputeChecksumResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalComputeChecksumResponse* InternalComputeChecksumResponse::default_instance_ = NULL;
+
+InternalComputeChecksumResponse* InternalComputeChecksumResponse::New() const {
+  return new InternalComputeChecksumResponse;
+}
+
+void InternalComputeChecksumResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+    }
+    if (has_checksum()) {
+      if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+        checksum_->clear();
+      }
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalComputeChecksumResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalComputeChecksumResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_checksum;
+        break;
+      }
+
+      // optional bytes checksum = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_checksum:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_checksum()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalComputeChecksumResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalComputeChecksumResponse)
+  return false;
+#undef DO_
+}
+
+void InternalComputeChecksumResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalComputeChecksumResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional bytes checksum = 2;
+  if (has_checksum()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->checksum(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalComputeChecksumResponse)
+}
+
+::google::protobuf::uint8* InternalComputeChecksumResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalComputeChecksumResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional bytes checksum = 2;
+  if (has_checksum()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->checksum(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalComputeChecksumResponse)
+  return target;
+}
+
+int InternalComputeChecksumResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional bytes checksum = 2;
+    if (has_checksum()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->checksum());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalComputeChecksumResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalComputeChecksumResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalComputeChecksumResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalComputeChecksumResponse::MergeFrom(const InternalComputeChecksumResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_checksum()) {
+      set_checksum(from.checksum());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalComputeChecksumResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalComputeChecksumResponse::CopyFrom(const InternalComputeChecksumResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalComputeChecksumResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalComputeChecksumResponse::Swap(InternalComputeChecksumResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(checksum_, other->checksum_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalComputeChecksumResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalComputeChecksumResponse_descriptor_;
+  metadata.reflection = InternalComputeChecksumResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int MVCCVersion::kTimestampFieldNumber;
+const int MVCCVersion::kDeletedFieldNumber;
+const int MVCCVersion::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+MVCCVersion::MVCCVersion()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.MVCCVersion)
+}
+
+void MVCCVersion::InitAsDefaultInstance() {
+  timestamp_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+}
+
+MVCCVersion::MVCCVersion(const MVCCVersion& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.MVCCVersion)
+}
+
+void MVCCVersion::SharedCtor() {
+  _cached_size_ = 0;
+  timestamp_ = NULL;
+  deleted_ = false;
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+MVCCVersion::~MVCCVersion() {
+  // @@protoc_insertion_point(destructor:proto.MVCCVersion)
+  SharedDtor();
+}
+
+void MVCCVersion::SharedDtor() {
+  if (this != default_instance_) {
+    delete timestamp_;
+    delete value_;
+  }
+}
+
+void MVCCVersion::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* MVCCVersion::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return MVCCVersion_descriptor_;
+}
+
+const MVCCVersion& MVCCVersion::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+MVCCVersion* MVCCVersion::default_instance_ = NULL;
+
+MVCCVersion* MVCCVersion::New() const {
+  return new MVCCVersion;
+}
+
+void MVCCVersion::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_timestamp()) {
+      if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+    }
+    deleted_ = false;
+    if (has_value()) {
+      if (value_ != NULL) value_->::proto::Value::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool MVCCVersion::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.MVCCVersion)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.Timestamp timestamp = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_deleted;
+        break;
+      }
+
+      // optional bool deleted = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_deleted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &deleted_)));
+          set_has_deleted();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_value;
+        break;
+      }
+
+      // optional .proto.Value value = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.MVCCVersion)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.MVCCVersion)
+  return false;
+#undef DO_
+}
+
+void MVCCVersion::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.MVCCVersion)
+  // optional .proto.Timestamp timestamp = 1;
+  if (has_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->timestamp(), output);
+  }
+
+  // optional bool deleted = 2;
+  if (has_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->deleted(), output);
+  }
+
+  // optional .proto.Value value = 3;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->value(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.MVCCVersion)
+}
+
+::google::protobuf::uint8* MVCCVersion::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.MVCCVersion)
+  // optional .proto.Timestamp timestamp = 1;
+  if (has_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->timestamp(), target);
+  }
+
+  // optional bool deleted = 2;
+  if (has_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->deleted(), target);
+  }
+
+  // optional .proto.Value value = 3;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->value(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.MVCCVersion)
+  return target;
+}
+
+int MVCCVersion::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.Timestamp timestamp = 1;
+    if (has_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->timestamp());
+    }
+
+    // optional bool deleted = 2;
+    if (has_deleted()) {
+      total_size += 1 + 1;
+    }
+
+    // optional .proto.Value value = 3;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void MVCCVersion::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const MVCCVersion* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const MVCCVersion*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void MVCCVersion::MergeFrom(const MVCCVersion& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_timestamp()) {
+      mutable_timestamp()->::proto::Timestamp::MergeFrom(from.timestamp());
+    }
+    if (from.has_deleted()) {
+      set_deleted(from.deleted());
+    }
+    if (from.has_value()) {
+      mutable_value()->::proto::Value::MergeFrom(from.value());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void MVCCVersion::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void MVCCVersion::CopyFrom(const MVCCVersion& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool MVCCVersion::IsInitialized() const {
+
+  return true;
+}
+
+void MVCCVersion::Swap(MVCCVersion* other) {
+  if (other != this) {
+    std::swap(timestamp_, other->timestamp_);
+    std::swap(deleted_, other->deleted_);
+    std::swap(value_, other->value_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata MVCCVersion::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = MVCCVersion_descriptor_;
+  metadata.reflection = MVCCVersion_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int BatchGetValue::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+BatchGetValue::BatchGetValue()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.BatchGetValue)
+}
+
+void BatchGetValue::InitAsDefaultInstance() {
+  value_ = const_cast< ::proto::Value*>(&::proto::Value::default_instance());
+}
+
+BatchGetValue::BatchGetValue(const BatchGetValue& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.BatchGetValue)
+}
+
+void BatchGetValue::SharedCtor() {
+  _cached_size_ = 0;
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+BatchGetValue::~BatchGetValue() {
+  // @@protoc_insertion_point(destructor:proto.BatchGetValue)
+  SharedDtor();
+}
+
+void BatchGetValue::SharedDtor() {
+  if (this != default_instance_) {
+    delete value_;
+  }
+}
+
+void BatchGetValue::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* BatchGetValue::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return BatchGetValue_descriptor_;
+}
+
+const BatchGetValue& BatchGetValue::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+BatchGetValue* BatchGetValue::default_instance_ = NULL;
+
+BatchGetValue* BatchGetValue::New() const {
+  return new BatchGetValue;
+}
+
+void BatchGetValue::Clear() {
+  if (has_value()) {
+    if (value_ != NULL) value_->::proto::Value::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool BatchGetValue::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.BatchGetValue)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.Value value = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.BatchGetValue)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.BatchGetValue)
+  return false;
+#undef DO_
+}
+
+void BatchGetValue::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.BatchGetValue)
+  // optional .proto.Value value = 1;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->value(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.BatchGetValue)
+}
+
+::google::protobuf::uint8* BatchGetValue::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.BatchGetValue)
+  // optional .proto.Value value = 1;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->value(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.BatchGetValue)
+  return target;
+}
+
+int BatchGetValue::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.Value value = 1;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->value());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void BatchGetValue::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const BatchGetValue* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const BatchGetValue*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void BatchGetValue::MergeFrom(const BatchGetValue& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_value()) {
+      mutable_value()->::proto::Value::MergeFrom(from.value());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void BatchGetValue::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void BatchGetValue::CopyFrom(const BatchGetValue& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool BatchGetValue::IsInitialized() const {
+
+  return true;
+}
+
+void BatchGetValue::Swap(BatchGetValue* other) {
+  if (other != this) {
+    std::swap(value_, other->value_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata BatchGetValue::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = BatchGetValue_descriptor_;
+  metadata.reflection = BatchGetValue_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalGetHistoryRequest::kHeaderFieldNumber;
+const int InternalGetHistoryRequest::kBeforeFieldNumber;
+const int InternalGetHistoryRequest::kMaxVersionsFieldNumber;
+#endif  // !_MSC_VER
+
+InternalGetHistoryRequest::InternalGetHistoryRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalGetHistoryRequest)
+}
+
+void InternalGetHistoryRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+  before_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+}
+
+InternalGetHistoryRequest::InternalGetHistoryRequest(const InternalGetHistoryRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalGetHistoryRequest)
+}
+
+void InternalGetHistoryRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  before_ = NULL;
+  max_versions_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalGetHistoryRequest::~InternalGetHistoryRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalGetHistoryRequest)
+  SharedDtor();
+}
+
+void InternalGetHistoryRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete before_;
+  }
+}
+
+void InternalGetHistoryRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalGetHistoryRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalGetHistoryRequest_descriptor_;
+}
+
+const InternalGetHistoryRequest& InternalGetHistoryRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalGetHistoryRequest* InternalGetHistoryRequest::default_instance_ = NULL;
+
+InternalGetHistoryRequest* InternalGetHistoryRequest::New() const {
+  return new InternalGetHistoryRequest;
+}
+
+void InternalGetHistoryRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+    }
+    if (has_before()) {
+      if (before_ != NULL) before_->::proto::Timestamp::Clear();
+    }
+    max_versions_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalGetHistoryRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalGetHistoryRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_before;
+        break;
+      }
+
+      // optional .proto.Timestamp before = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_before:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_before()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_max_versions;
+        break;
+      }
+
+      // optional int64 max_versions = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_max_versions:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_versions_)));
+          set_has_max_versions();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalGetHistoryRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalGetHistoryRequest)
+  return false;
+#undef DO_
+}
+
+void InternalGetHistoryRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalGetHistoryRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // optional .proto.Timestamp before = 2;
+  if (has_before()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->before(), output);
+  }
+
+  // optional int64 max_versions = 3;
+  if (has_max_versions()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->max_versions(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalGetHistoryRequest)
+}
+
+::google::protobuf::uint8* InternalGetHistoryRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalGetHistoryRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // optional .proto.Timestamp before = 2;
+  if (has_before()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->before(), target);
+  }
+
+  // optional int64 max_versions = 3;
+  if (has_max_versions()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->max_versions(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalGetHistoryRequest)
+  return target;
+}
+
+int InternalGetHistoryRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+    // optional .proto.Timestamp before = 2;
+    if (has_before()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->before());
+    }
+
+    // optional int64 max_versions = 3;
+    if (has_max_versions()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_versions());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalGetHistoryRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalGetHistoryRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalGetHistoryRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalGetHistoryRequest::MergeFrom(const InternalGetHistoryRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_before()) {
+      mutable_before()->::proto::Timestamp::MergeFrom(from.before());
+    }
+    if (from.has_max_versions()) {
+      set_max_versions(from.max_versions());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalGetHistoryRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalGetHistoryRequest::CopyFrom(const InternalGetHistoryRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalGetHistoryRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalGetHistoryRequest::Swap(InternalGetHistoryRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    std::swap(before_, other->before_);
+    std::swap(max_versions_, other->max_versions_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalGetHistoryRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalGetHistoryRequest_descriptor_;
+  metadata.reflection = InternalGetHistoryRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalGetHistoryResponse::kHeaderFieldNumber;
+const int InternalGetHistoryResponse::kVersionsFieldNumber;
+#endif  // !_MSC_VER
+
+InternalGetHistoryResponse::InternalGetHistoryResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalGetHistoryResponse)
+}
+
+void InternalGetHistoryResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalGetHistoryResponse::InternalGetHistoryResponse(const InternalGetHistoryResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalGetHistoryResponse)
+}
+
+void InternalGetHistoryResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalGetHistoryResponse::~InternalGetHistoryResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalGetHistoryResponse)
+  SharedDtor();
+}
+
+void InternalGetHistoryResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalGetHistoryResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalGetHistoryResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalGetHistoryResponse_descriptor_;
+}
+
+const InternalGetHistoryResponse& InternalGetHistoryResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalGetHistoryResponse* InternalGetHistoryResponse::default_instance_ = NULL;
+
+InternalGetHistoryResponse* InternalGetHistoryResponse::New() const {
+  return new InternalGetHistoryResponse;
+}
+
+void InternalGetHistoryResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  versions_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalGetHistoryResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalGetHistoryResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_versions;
+        break;
+      }
+
+      // repeated .proto.MVCCVersion versions = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_versions:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_versions()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_versions;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalGetHistoryResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalGetHistoryResponse)
+  return false;
+#undef DO_
+}
+
+void InternalGetHistoryResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalGetHistoryResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .proto.MVCCVersion versions = 2;
+  for (int i = 0; i < this->versions_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->versions(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalGetHistoryResponse)
+}
+
+::google::protobuf::uint8* InternalGetHistoryResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalGetHistoryResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .proto.MVCCVersion versions = 2;
+  for (int i = 0; i < this->versions_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->versions(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalGetHistoryResponse)
+  return target;
+}
+
+int InternalGetHistoryResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated .proto.MVCCVersion versions = 2;
+  total_size += 1 * this->versions_size();
+  for (int i = 0; i < this->versions_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->versions(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalGetHistoryResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalGetHistoryResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalGetHistoryResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalGetHistoryResponse::MergeFrom(const InternalGetHistoryResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  versions_.MergeFrom(from.versions_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalGetHistoryResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalGetHistoryResponse::CopyFrom(const InternalGetHistoryResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalGetHistoryResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalGetHistoryResponse::Swap(InternalGetHistoryResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    versions_.Swap(&other->versions_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalGetHistoryResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalGetHistoryResponse_descriptor_;
+  metadata.reflection = InternalGetHistoryResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalBatchGetRequest::kHeaderFieldNumber;
+const int InternalBatchGetRequest::kKeysFieldNumber;
+#endif  // !_MSC_VER
+
+InternalBatchGetRequest::InternalBatchGetRequest()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalBatchGetRequest)
+}
+
+void InternalBatchGetRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::RequestHeader*>(&::proto::RequestHeader::default_instance());
+}
+
+InternalBatchGetRequest::InternalBatchGetRequest(const InternalBatchGetRequest& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalBatchGetRequest)
+}
+
+void InternalBatchGetRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalBatchGetRequest::~InternalBatchGetRequest() {
+  // @@protoc_insertion_point(destructor:proto.InternalBatchGetRequest)
+  SharedDtor();
+}
+
+void InternalBatchGetRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalBatchGetRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalBatchGetRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalBatchGetRequest_descriptor_;
+}
+
+const InternalBatchGetRequest& InternalBatchGetRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalBatchGetRequest* InternalBatchGetRequest::default_instance_ = NULL;
+
+InternalBatchGetRequest* InternalBatchGetRequest::New() const {
+  return new InternalBatchGetRequest;
+}
+
+void InternalBatchGetRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  }
+  keys_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalBatchGetRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalBatchGetRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_keys;
+        break;
+      }
+
+      // repeated bytes keys = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_keys:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->add_keys()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_keys;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalBatchGetRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalBatchGetRequest)
+  return false;
+#undef DO_
+}
+
+void InternalBatchGetRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalBatchGetRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated bytes keys = 2;
+  for (int i = 0; i < this->keys_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytes(
+      2, this->keys(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalBatchGetRequest)
+}
+
+::google::protobuf::uint8* InternalBatchGetRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalBatchGetRequest)
+  // optional .proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated bytes keys = 2;
+  for (int i = 0; i < this->keys_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteBytesToArray(2, this->keys(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalBatchGetRequest)
+  return target;
+}
+
+int InternalBatchGetRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated bytes keys = 2;
+  total_size += 1 * this->keys_size();
+  for (int i = 0; i < this->keys_size(); i++) {
+    total_size += ::google::protobuf::internal::WireFormatLite::BytesSize(
+      this->keys(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalBatchGetRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalBatchGetRequest* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalBatchGetRequest*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalBatchGetRequest::MergeFrom(const InternalBatchGetRequest& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  keys_.MergeFrom(from.keys_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::RequestHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalBatchGetRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalBatchGetRequest::CopyFrom(const InternalBatchGetRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalBatchGetRequest::IsInitialized() const {
+
+  return true;
+}
+
+void InternalBatchGetRequest::Swap(InternalBatchGetRequest* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    keys_.Swap(&other->keys_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalBatchGetRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalBatchGetRequest_descriptor_;
+  metadata.reflection = InternalBatchGetRequest_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int InternalBatchGetResponse::kHeaderFieldNumber;
+const int InternalBatchGetResponse::kValuesFieldNumber;
+#endif  // !_MSC_VER
+
+InternalBatchGetResponse::InternalBatchGetResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.InternalBatchGetResponse)
+}
+
+void InternalBatchGetResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::proto::ResponseHeader*>(&::proto::ResponseHeader::default_instance());
+}
+
+InternalBatchGetResponse::InternalBatchGetResponse(const InternalBatchGetResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.InternalBatchGetResponse)
+}
+
+void InternalBatchGetResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+InternalBatchGetResponse::~InternalBatchGetResponse() {
+  // @@protoc_insertion_point(destructor:proto.InternalBatchGetResponse)
+  SharedDtor();
+}
+
+void InternalBatchGetResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void InternalBatchGetResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* InternalBatchGetResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return InternalBatchGetResponse_descriptor_;
+}
+
+const InternalBatchGetResponse& InternalBatchGetResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+InternalBatchGetResponse* InternalBatchGetResponse::default_instance_ = NULL;
+
+InternalBatchGetResponse* InternalBatchGetResponse::New() const {
+  return new InternalBatchGetResponse;
+}
+
+void InternalBatchGetResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  }
+  values_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool InternalBatchGetResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.InternalBatchGetResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_values;
+        break;
+      }
+
+      // repeated .proto.BatchGetValue values = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_values:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_values()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_values;
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.InternalBatchGetResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.InternalBatchGetResponse)
+  return false;
+#undef DO_
+}
+
+void InternalBatchGetResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.InternalBatchGetResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->header(), output);
+  }
+
+  // repeated .proto.BatchGetValue values = 2;
+  for (int i = 0; i < this->values_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->values(i), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.InternalBatchGetResponse)
+}
+
+::google::protobuf::uint8* InternalBatchGetResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.InternalBatchGetResponse)
+  // optional .proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->header(), target);
+  }
+
+  // repeated .proto.BatchGetValue values = 2;
+  for (int i = 0; i < this->values_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->values(i), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.InternalBatchGetResponse)
+  return target;
+}
+
+int InternalBatchGetResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->header());
+    }
+
+  }
+  // repeated .proto.BatchGetValue values = 2;
+  total_size += 1 * this->values_size();
+  for (int i = 0; i < this->values_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->values(i));
+  }
+
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void InternalBatchGetResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const InternalBatchGetResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const InternalBatchGetResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void InternalBatchGetResponse::MergeFrom(const InternalBatchGetResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  values_.MergeFrom(from.values_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void InternalBatchGetResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void InternalBatchGetResponse::CopyFrom(const InternalBatchGetResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool InternalBatchGetResponse::IsInitialized() const {
+
+  return true;
+}
+
+void InternalBatchGetResponse::Swap(InternalBatchGetResponse* other) {
+  if (other != this) {
+    std::swap(header_, other->header_);
+    values_.Swap(&other->values_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata InternalBatchGetResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = InternalBatchGetResponse_descriptor_;
+  metadata.reflection = InternalBatchGetResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ReadWriteCmdResponse::kPutFieldNumber;
+const int ReadWriteCmdResponse::kConditionalPutFieldNumber;
+const int ReadWriteCmdResponse::kIncrementFieldNumber;
+const int ReadWriteCmdResponse::kDeleteFieldNumber;
+const int ReadWriteCmdResponse::kDeleteRangeFieldNumber;
+const int ReadWriteCmdResponse::kEndTransactionFieldNumber;
+const int ReadWriteCmdResponse::kReapQueueFieldNumber;
+const int ReadWriteCmdResponse::kEnqueueUpdateFieldNumber;
+const int ReadWriteCmdResponse::kEnqueueMessageFieldNumber;
+const int ReadWriteCmdResponse::kInternalHeartbeatTxnFieldNumber;
+const int ReadWriteCmdResponse::kInternalPushTxnFieldNumber;
+const int ReadWriteCmdResponse::kInternalResolveIntentFieldNumber;
+const int ReadWriteCmdResponse::kInternalMergeFieldNumber;
+const int ReadWriteCmdResponse::kInternalTruncateLogFieldNumber;
+const int ReadWriteCmdResponse::kInternalGcFieldNumber;
+const int ReadWriteCmdResponse::kInternalBeginTransactionFieldNumber;
+const int ReadWriteCmdResponse::kInternalPutIfAbsentFieldNumber;
+const int ReadWriteCmdResponse::kGetFieldNumber;
+const int ReadWriteCmdResponse::kConditionalDeleteFieldNumber;
+const int ReadWriteCmdResponse::kBatchFieldNumber;
+const int ReadWriteCmdResponse::kInternalResolveIntentRangeFieldNumber;
+const int ReadWriteCmdResponse::kInternalLeaderLeaseFieldNumber;
+#endif  // !_MSC_VER
+
+ReadWriteCmdResponse::ReadWriteCmdResponse()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ReadWriteCmdResponse)
+}
+
+void ReadWriteCmdResponse::InitAsDefaultInstance() {
+  put_ = const_cast< ::proto::PutResponse*>(&::proto::PutResponse::default_instance());
+  conditional_put_ = const_cast< ::proto::ConditionalPutResponse*>(&::proto::ConditionalPutResponse::default_instance());
+  increment_ = const_cast< ::proto::IncrementResponse*>(&::proto::IncrementResponse::default_instance());
+  delete__ = const_cast< ::proto::DeleteResponse*>(&::proto::DeleteResponse::default_instance());
+  delete_range_ = const_cast< ::proto::DeleteRangeResponse*>(&::proto::DeleteRangeResponse::default_instance());
+  end_transaction_ = const_cast< ::proto::EndTransactionResponse*>(&::proto::EndTransactionResponse::default_instance());
+  reap_queue_ = const_cast< ::proto::ReapQueueResponse*>(&::proto::ReapQueueResponse::default_instance());
+  enqueue_update_ = const_cast< ::proto::EnqueueUpdateResponse*>(&::proto::EnqueueUpdateResponse::default_instance());
+  enqueue_message_ = const_cast< ::proto::EnqueueMessageResponse*>(&::proto::EnqueueMessageResponse::default_instance());
+  internal_heartbeat_txn_ = const_cast< ::proto::InternalHeartbeatTxnResponse*>(&::proto::InternalHeartbeatTxnResponse::default_instance());
+  internal_push_txn_ = const_cast< ::proto::InternalPushTxnResponse*>(&::proto::InternalPushTxnResponse::default_instance());
+  internal_resolve_intent_ = const_cast< ::proto::InternalResolveIntentResponse*>(&::proto::InternalResolveIntentResponse::default_instance());
+  internal_merge_ = const_cast< ::proto::InternalMergeResponse*>(&::proto::InternalMergeResponse::default_instance());
+  internal_truncate_log_ = const_cast< ::proto::InternalTruncateLogResponse*>(&::proto::InternalTruncateLogResponse::default_instance());
+  internal_gc_ = const_cast< ::proto::InternalGCResponse*>(&::proto::InternalGCResponse::default_instance());
+  internal_begin_transaction_ = const_cast< ::proto::InternalBeginTransactionResponse*>(&::proto::InternalBeginTransactionResponse::default_instance());
+  internal_put_if_absent_ = const_cast< ::proto::InternalPutIfAbsentResponse*>(&::proto::InternalPutIfAbsentResponse::default_instance());
+  get_ = const_cast< ::proto::GetResponse*>(&::proto::GetResponse::default_instance());
+  conditional_delete_ = const_cast< ::proto::ConditionalDeleteResponse*>(&::proto::ConditionalDeleteResponse::default_instance());
+  batch_ = const_cast< ::proto::BatchResponse*>(&::proto::BatchResponse::default_instance());
+  internal_resolve_intent_range_ = const_cast< ::proto::InternalResolveIntentRangeResponse*>(&::proto::InternalResolveIntentRangeResponse::default_instance());
+  internal_leader_lease_ = const_cast< ::proto::InternalLeaderLeaseResponse*>(&::proto::InternalLeaderLeaseResponse::default_instance());
+}
+
+ReadWriteCmdResponse::ReadWriteCmdResponse(const ReadWriteCmdResponse& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ReadWriteCmdResponse)
+}
+
+void ReadWriteCmdResponse::SharedCtor() {
+  _cached_size_ = 0;
+  put_ = NULL;
+  conditional_put_ = NULL;
+  increment_ = NULL;
+  delete__ = NULL;
+  delete_range_ = NULL;
+  end_transaction_ = NULL;
+  reap_queue_ = NULL;
+  enqueue_update_ = NULL;
+  enqueue_message_ = NULL;
+  internal_heartbeat_txn_ = NULL;
+  internal_push_txn_ = NULL;
+  internal_resolve_intent_ = NULL;
+  internal_merge_ = NULL;
+  internal_truncate_log_ = NULL;
+  internal_gc_ = NULL;
+  internal_begin_transaction_ = NULL;
+  internal_put_if_absent_ = NULL;
+  get_ = NULL;
+  conditional_delete_ = NULL;
+  batch_ = NULL;
+  internal_resolve_intent_range_ = NULL;
+  internal_leader_lease_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ReadWriteCmdResponse::~ReadWriteCmdResponse() {
+  // @@protoc_insertion_point(destructor:proto.ReadWriteCmdResponse)
+  SharedDtor();
+}
+
+void ReadWriteCmdResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete put_;
+    delete conditional_put_;
+    delete increment_;
+    delete delete__;
+    delete delete_range_;
+    delete end_transaction_;
+    delete reap_queue_;
+    delete enqueue_update_;
+    delete enqueue_message_;
+    delete internal_heartbeat_txn_;
+    delete internal_push_txn_;
+    delete internal_resolve_intent_;
+    delete internal_merge_;
+    delete internal_truncate_log_;
+    delete internal_gc_;
+    delete internal_begin_transaction_;
+    delete internal_put_if_absent_;
+    delete get_;
+    delete conditional_delete_;
+    delete batch_;
+    delete internal_resolve_intent_range_;
+    delete internal_leader_lease_;
+  }
+}
+
+void ReadWriteCmdResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ReadWriteCmdResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ReadWriteCmdResponse_descriptor_;
+}
+
+const ReadWriteCmdResponse& ReadWriteCmdResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+ReadWriteCmdResponse* ReadWriteCmdResponse::default_instance_ = NULL;
+
+ReadWriteCmdResponse* ReadWriteCmdResponse::New() const {
+  return new ReadWriteCmdResponse;
+}
+
+void ReadWriteCmdResponse::Clear() {
+  if (_has_bits_[0 / 32] & 255) {
+    if (has_put()) {
+      if (put_ != NULL) put_->::proto::PutResponse::Clear();
+    }
+    if (has_conditional_put()) {
+      if (conditional_put_ != NULL) conditional_put_->::proto::ConditionalPutResponse::Clear();
+    }
+    if (has_increment()) {
+      if (increment_ != NULL) increment_->::proto::IncrementResponse::Clear();
+    }
+    if (has_delete_()) {
+      if (delete__ != NULL) delete__->::proto::DeleteResponse::Clear();
+    }
+    if (has_delete_range()) {
+      if (delete_range_ != NULL) delete_range_->::proto::DeleteRangeResponse::Clear();
+    }
+    if (has_end_transaction()) {
+      if (end_transaction_ != NULL) end_transaction_->::proto::EndTransactionResponse::Clear();
+    }
+    if (has_reap_queue()) {
+      if (reap_queue_ != NULL) reap_queue_->::proto::ReapQueueResponse::Clear();
+    }
+    if (has_enqueue_update()) {
+      if (enqueue_update_ != NULL) enqueue_update_->::proto::EnqueueUpdateResponse::Clear();
+    }
+  }
+  if (_has_bits_[8 / 32] & 65280) {
+    if (has_enqueue_message()) {
+      if (enqueue_message_ != NULL) enqueue_message_->::proto::EnqueueMessageResponse::Clear();
+    }
+    if (has_internal_heartbeat_txn()) {
+      if (internal_heartbeat_txn_ != NULL) internal_heartbeat_txn_->::proto::InternalHeartbeatTxnResponse::Clear();
+    }
+    if (has_internal_push_txn()) {
+      if (internal_push_txn_ != NULL) internal_push_txn_->::proto::InternalPushTxnResponse::Clear();
+    }
+    if (has_internal_resolve_intent()) {
+      if (internal_resolve_intent_ != NULL) internal_resolve_intent_->::proto::InternalResolveIntentResponse::Clear();
+    }
+    if (has_internal_merge()) {
+      if (internal_merge_ != NULL) internal_merge_->::proto::InternalMergeResponse::Clear();
+    }
+    if (has_internal_truncate_log()) {
+      if (internal_truncate_log_ != NULL) internal_truncate_log_->::proto::InternalTruncateLogResponse::Clear();
+    }
+    if (has_internal_gc()) {
+      if (internal_gc_ != NULL) internal_gc_->::proto::InternalGCResponse::Clear();
+    }
+    if (has_internal_begin_transaction()) {
+      if (internal_begin_transaction_ != NULL) internal_begin_transaction_->::proto::InternalBeginTransactionResponse::Clear();
+    }
+  }
+  if (_has_bits_[16 / 32] & 4128768) {
+    if (has_internal_put_if_absent()) {
+      if (internal_put_if_absent_ != NULL) internal_put_if_absent_->::proto::InternalPutIfAbsentResponse::Clear();
+    }
+    if (has_get()) {
+      if (get_ != NULL) get_->::proto::GetResponse::Clear();
+    }
+    if (has_conditional_delete()) {
+      if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteResponse::Clear();
+    }
+    if (has_batch()) {
+      if (batch_ != NULL) batch_->::proto::BatchResponse::Clear();
+    }
+    if (has_internal_resolve_intent_range()) {
+      if (internal_resolve_intent_range_ != NULL) internal_resolve_intent_range_->::proto::InternalResolveIntentRangeResponse::Clear();
+    }
+    if (has_internal_leader_lease()) {
+      if (internal_leader_lease_ != NULL) internal_leader_lease_->::proto::InternalLeaderLeaseResponse::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ReadWriteCmdResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ReadWriteCmdResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.PutResponse put = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_conditional_put;
+        break;
+      }
+
+      // optional .proto.ConditionalPutResponse conditional_put = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_conditional_put:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_increment;
+        break;
+      }
+
+      // optional .proto.IncrementResponse increment = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_increment:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_delete;
+        break;
+      }
+
+      // optional .proto.DeleteResponse delete = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .proto.DeleteRangeResponse delete_range = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .proto.EndTransactionResponse end_transaction = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_reap_queue;
+        break;
+      }
+
+      // optional .proto.ReapQueueResponse reap_queue = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_reap_queue:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
                input, mutable_reap_queue()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_enqueue_update;
+        if (input->ExpectTag(66)) goto parse_enqueue_update;
+        break;
+      }
+
+      // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_enqueue_update:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_update()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_enqueue_message;
+        break;
+      }
+
+      // optional .proto.EnqueueMessageResponse enqueue_message = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_enqueue_message:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_enqueue_message()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_internal_heartbeat_txn;
+        break;
+      }
+
+      // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_internal_heartbeat_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_heartbeat_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_internal_push_txn;
+        break;
+      }
+
+      // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_internal_push_txn:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_push_txn()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_internal_resolve_intent;
+        break;
+      }
+
+      // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_internal_resolve_intent:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_resolve_intent()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_internal_merge;
+        break;
+      }
+
+      // optional .proto.InternalMergeResponse internal_merge = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_internal_merge:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_merge()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_internal_truncate_log;
+        break;
+      }
+
+      // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_internal_truncate_log:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_truncate_log()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_internal_gc;
+        break;
+      }
+
+      // optional .proto.InternalGCResponse internal_gc = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_internal_gc:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_gc()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(130)) goto parse_internal_begin_transaction;
+        break;
+      }
+
+      // optional .proto.InternalBeginTransactionResponse internal_begin_transaction = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_internal_begin_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_begin_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(138)) goto parse_internal_put_if_absent;
+        break;
+      }
+
+      // optional .proto.InternalPutIfAbsentResponse internal_put_if_absent = 17;
+      case 17: {
+        if (tag == 138) {
+         parse_internal_put_if_absent:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_put_if_absent()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(146)) goto parse_get;
+        break;
+      }
+
+      // optional .proto.GetResponse get = 18;
+      case 18: {
+        if (tag == 146) {
+         parse_get:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(154)) goto parse_conditional_delete;
+        break;
+      }
+
+      // optional .proto.ConditionalDeleteResponse conditional_delete = 19;
+      case 19: {
+        if (tag == 154) {
+         parse_conditional_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_delete()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(162)) goto parse_batch;
+        break;
+      }
+
+      // optional .proto.BatchResponse batch = 20;
+      case 20: {
+        if (tag == 162) {
+         parse_batch:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_batch()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(170)) goto parse_internal_resolve_intent_range;
+        break;
+      }
+
+      // optional .proto.InternalResolveIntentRangeResponse internal_resolve_intent_range = 21;
+      case 21: {
+        if (tag == 170) {
+         parse_internal_resolve_intent_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_resolve_intent_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(178)) goto parse_internal_leader_lease;
+        break;
+      }
+
+      // optional .proto.InternalLeaderLeaseResponse internal_leader_lease = 22;
+      case 22: {
+        if (tag == 178) {
+         parse_internal_leader_lease:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_leader_lease()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ReadWriteCmdResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ReadWriteCmdResponse)
+  return false;
+#undef DO_
+}
+
+void ReadWriteCmdResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ReadWriteCmdResponse)
+  // optional .proto.PutResponse put = 1;
+  if (has_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->put(), output);
+  }
+
+  // optional .proto.ConditionalPutResponse conditional_put = 2;
+  if (has_conditional_put()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->conditional_put(), output);
+  }
+
+  // optional .proto.IncrementResponse increment = 3;
+  if (has_increment()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->increment(), output);
+  }
+
+  // optional .proto.DeleteResponse delete = 4;
+  if (has_delete_()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      4, this->delete_(), output);
+  }
+
+  // optional .proto.DeleteRangeResponse delete_range = 5;
+  if (has_delete_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->delete_range(), output);
+  }
+
+  // optional .proto.EndTransactionResponse end_transaction = 6;
+  if (has_end_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->end_transaction(), output);
+  }
+
+  // optional .proto.ReapQueueResponse reap_queue = 7;
+  if (has_reap_queue()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->reap_queue(), output);
+  }
+
+  // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
+  if (has_enqueue_update()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, this->enqueue_update(), output);
+  }
+
+  // optional .proto.EnqueueMessageResponse enqueue_message = 9;
+  if (has_enqueue_message()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, this->enqueue_message(), output);
+  }
+
+  // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+  if (has_internal_heartbeat_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, this->internal_heartbeat_txn(), output);
+  }
+
+  // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
+  if (has_internal_push_txn()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, this->internal_push_txn(), output);
+  }
+
+  // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+  if (has_internal_resolve_intent()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, this->internal_resolve_intent(), output);
+  }
+
+  // optional .proto.InternalMergeResponse internal_merge = 13;
+  if (has_internal_merge()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->internal_merge(), output);
+  }
+
+  // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
+  if (has_internal_truncate_log()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->internal_truncate_log(), output);
+  }
+
+  // optional .proto.InternalGCResponse internal_gc = 15;
+  if (has_internal_gc()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, this->internal_gc(), output);
+  }
+
+  // optional .proto.InternalBeginTransactionResponse internal_begin_transaction = 16;
+  if (has_internal_begin_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, this->internal_begin_transaction(), output);
+  }
+
+  // optional .proto.InternalPutIfAbsentResponse internal_put_if_absent = 17;
+  if (has_internal_put_if_absent()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      17, this->internal_put_if_absent(), output);
+  }
+
+  // optional .proto.GetResponse get = 18;
+  if (has_get()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      18, this->get(), output);
+  }
+
+  // optional .proto.ConditionalDeleteResponse conditional_delete = 19;
+  if (has_conditional_delete()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      19, this->conditional_delete(), output);
+  }
+
+  // optional .proto.BatchResponse batch = 20;
+  if (has_batch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      20, this->batch(), output);
+  }
+
+  // optional .proto.InternalResolveIntentRangeResponse internal_resolve_intent_range = 21;
+  if (has_internal_resolve_intent_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      21, this->internal_resolve_intent_range(), output);
+  }
+
+  // optional .proto.InternalLeaderLeaseResponse internal_leader_lease = 22;
+  if (has_internal_leader_lease()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      22, this->internal_leader_lease(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ReadWriteCmdResponse)
+}
+
+::google::protobuf::uint8* ReadWriteCmdResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ReadWriteCmdResponse)
+  // optional .proto.PutResponse put = 1;
+  if (has_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->put(), target);
+  }
+
+  // optional .proto.ConditionalPutResponse conditional_put = 2;
+  if (has_conditional_put()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->conditional_put(), target);
+  }
+
+  // optional .proto.IncrementResponse increment = 3;
+  if (has_increment()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->increment(), target);
+  }
+
+  // optional .proto.DeleteResponse delete = 4;
+  if (has_delete_()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        4, this->delete_(), target);
+  }
+
+  // optional .proto.DeleteRangeResponse delete_range = 5;
+  if (has_delete_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->delete_range(), target);
+  }
+
+  // optional .proto.EndTransactionResponse end_transaction = 6;
+  if (has_end_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->end_transaction(), target);
+  }
+
+  // optional .proto.ReapQueueResponse reap_queue = 7;
+  if (has_reap_queue()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->reap_queue(), target);
+  }
+
+  // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
+  if (has_enqueue_update()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, this->enqueue_update(), target);
+  }
+
+  // optional .proto.EnqueueMessageResponse enqueue_message = 9;
+  if (has_enqueue_message()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, this->enqueue_message(), target);
+  }
+
+  // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+  if (has_internal_heartbeat_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, this->internal_heartbeat_txn(), target);
+  }
+
+  // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
+  if (has_internal_push_txn()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, this->internal_push_txn(), target);
+  }
+
+  // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+  if (has_internal_resolve_intent()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, this->internal_resolve_intent(), target);
+  }
+
+  // optional .proto.InternalMergeResponse internal_merge = 13;
+  if (has_internal_merge()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->internal_merge(), target);
+  }
+
+  // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
+  if (has_internal_truncate_log()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->internal_truncate_log(), target);
+  }
+
+  // optional .proto.InternalGCResponse internal_gc = 15;
+  if (has_internal_gc()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, this->internal_gc(), target);
+  }
+
+  // optional .proto.InternalBeginTransactionResponse internal_begin_transaction = 16;
+  if (has_internal_begin_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, this->internal_begin_transaction(), target);
+  }
+
+  // optional .proto.InternalPutIfAbsentResponse internal_put_if_absent = 17;
+  if (has_internal_put_if_absent()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        17, this->internal_put_if_absent(), target);
+  }
+
+  // optional .proto.GetResponse get = 18;
+  if (has_get()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        18, this->get(), target);
+  }
+
+  // optional .proto.ConditionalDeleteResponse conditional_delete = 19;
+  if (has_conditional_delete()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        19, this->conditional_delete(), target);
+  }
+
+  // optional .proto.BatchResponse batch = 20;
+  if (has_batch()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        20, this->batch(), target);
+  }
+
+  // optional .proto.InternalResolveIntentRangeResponse internal_resolve_intent_range = 21;
+  if (has_internal_resolve_intent_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        21, this->internal_resolve_intent_range(), target);
+  }
+
+  // optional .proto.InternalLeaderLeaseResponse internal_leader_lease = 22;
+  if (has_internal_leader_lease()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        22, this->internal_leader_lease(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ReadWriteCmdResponse)
+  return target;
+}
+
+int ReadWriteCmdResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.PutResponse put = 1;
+    if (has_put()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->put());
+    }
+
+    // optional .proto.ConditionalPutResponse conditional_put = 2;
+    if (has_conditional_put()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_put());
+    }
+
+    // optional .proto.IncrementResponse increment = 3;
+    if (has_increment()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->increment());
+    }
+
+    // optional .proto.DeleteResponse delete = 4;
+    if (has_delete_()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_());
+    }
+
+    // optional .proto.DeleteRangeResponse delete_range = 5;
+    if (has_delete_range()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->delete_range());
+    }
+
+    // optional .proto.EndTransactionResponse end_transaction = 6;
+    if (has_end_transaction()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->end_transaction());
+    }
+
+    // optional .proto.ReapQueueResponse reap_queue = 7;
+    if (has_reap_queue()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->reap_queue());
+    }
+
+    // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
+    if (has_enqueue_update()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->enqueue_update());
+    }
+
+  }
+  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    // optional .proto.EnqueueMessageResponse enqueue_message = 9;
+    if (has_enqueue_message()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->enqueue_message());
+    }
+
+    // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+    if (has_internal_heartbeat_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_heartbeat_txn());
+    }
+
+    // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
+    if (has_internal_push_txn()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_push_txn());
+    }
+
+    // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+    if (has_internal_resolve_intent()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_resolve_intent());
+    }
+
+    // optional .proto.InternalMergeResponse internal_merge = 13;
+    if (has_internal_merge()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_merge());
+    }
+
+    // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
+    if (has_internal_truncate_log()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_truncate_log());
+    }
+
+    // optional .proto.InternalGCResponse internal_gc = 15;
+    if (has_internal_gc()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_gc());
+    }
+
+    // optional .proto.InternalBeginTransactionResponse internal_begin_transaction = 16;
+    if (has_internal_begin_transaction()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_begin_transaction());
+    }
+
+  }
+  if (_has_bits_[16 / 32] & (0xffu << (16 % 32))) {
+    // optional .proto.InternalPutIfAbsentResponse internal_put_if_absent = 17;
+    if (has_internal_put_if_absent()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_put_if_absent());
+    }
+
+    // optional .proto.GetResponse get = 18;
+    if (has_get()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->get());
+    }
+
+    // optional .proto.ConditionalDeleteResponse conditional_delete = 19;
+    if (has_conditional_delete()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_delete());
+    }
+
+    // optional .proto.BatchResponse batch = 20;
+    if (has_batch()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->batch());
+    }
+
+    // optional .proto.InternalResolveIntentRangeResponse internal_resolve_intent_range = 21;
+    if (has_internal_resolve_intent_range()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_resolve_intent_range());
+    }
+
+    // optional .proto.InternalLeaderLeaseResponse internal_leader_lease = 22;
+    if (has_internal_leader_lease()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_leader_lease());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ReadWriteCmdResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ReadWriteCmdResponse* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ReadWriteCmdResponse*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_put()) {
+      mutable_put()->::proto::PutResponse::MergeFrom(from.put());
+    }
+    if (from.has_conditional_put()) {
+      mutable_conditional_put()->::proto::ConditionalPutResponse::MergeFrom(from.conditional_put());
+    }
+    if (from.has_increment()) {
+      mutable_increment()->::proto::IncrementResponse::MergeFrom(from.increment());
+    }
+    if (from.has_delete_()) {
+      mutable_delete_()->::proto::DeleteResponse::MergeFrom(from.delete_());
+    }
+    if (from.has_delete_range()) {
+      mutable_delete_range()->::proto::DeleteRangeResponse::MergeFrom(from.delete_range());
+    }
+    if (from.has_end_transaction()) {
+      mutable_end_transaction()->::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
+    }
+    if (from.has_reap_queue()) {
+      mutable_reap_queue()->::proto::ReapQueueResponse::MergeFrom(from.reap_queue());
+    }
+    if (from.has_enqueue_update()) {
+      mutable_enqueue_update()->::proto::EnqueueUpdateResponse::MergeFrom(from.enqueue_update());
+    }
+  }
+  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
+    if (from.has_enqueue_message()) {
+      mutable_enqueue_message()->::proto::EnqueueMessageResponse::MergeFrom(from.enqueue_message());
+    }
+    if (from.has_internal_heartbeat_txn()) {
+      mutable_internal_heartbeat_txn()->::proto::InternalHeartbeatTxnResponse::MergeFrom(from.internal_heartbeat_txn());
+    }
+    if (from.has_internal_push_txn()) {
+      mutable_internal_push_txn()->::proto::InternalPushTxnResponse::MergeFrom(from.internal_push_txn());
+    }
+    if (from.has_internal_resolve_intent()) {
+      mutable_internal_resolve_intent()->::proto::InternalResolveIntentResponse::MergeFrom(from.internal_resolve_intent());
+    }
+    if (from.has_internal_merge()) {
+      mutable_internal_merge()->::proto::InternalMergeResponse::MergeFrom(from.internal_merge());
+    }
+    if (from.has_internal_truncate_log()) {
+      mutable_internal_truncate_log()->::proto::InternalTruncateLogResponse::MergeFrom(from.internal_truncate_log());
+    }
+    if (from.has_internal_gc()) {
+      mutable_internal_gc()->::proto::InternalGCResponse::MergeFrom(from.internal_gc());
+    }
+    if (from.has_internal_begin_transaction()) {
+      mutable_internal_begin_transaction()->::proto::InternalBeginTransactionResponse::MergeFrom(from.internal_begin_transaction());
+    }
+  }
+  if (from._has_bits_[16 / 32] & (0xffu << (16 % 32))) {
+    if (from.has_internal_put_if_absent()) {
+      mutable_internal_put_if_absent()->::proto::InternalPutIfAbsentResponse::MergeFrom(from.internal_put_if_absent());
+    }
+    if (from.has_get()) {
+      mutable_get()->::proto::GetResponse::MergeFrom(from.get());
+    }
+    if (from.has_conditional_delete()) {
+      mutable_conditional_delete()->::proto::ConditionalDeleteResponse::MergeFrom(from.conditional_delete());
+    }
+    if (from.has_batch()) {
+      mutable_batch()->::proto::BatchResponse::MergeFrom(from.batch());
+    }
+    if (from.has_internal_resolve_intent_range()) {
+      mutable_internal_resolve_intent_range()->::proto::InternalResolveIntentRangeResponse::MergeFrom(from.internal_resolve_intent_range());
+    }
+    if (from.has_internal_leader_lease()) {
+      mutable_internal_leader_lease()->::proto::InternalLeaderLeaseResponse::MergeFrom(from.internal_leader_lease());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ReadWriteCmdResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ReadWriteCmdResponse::CopyFrom(const ReadWriteCmdResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ReadWriteCmdResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ReadWriteCmdResponse::Swap(ReadWriteCmdResponse* other) {
+  if (other != this) {
+    std::swap(put_, other->put_);
+    std::swap(conditional_put_, other->conditional_put_);
+    std::swap(increment_, other->increment_);
+    std::swap(delete__, other->delete__);
+    std::swap(delete_range_, other->delete_range_);
+    std::swap(end_transaction_, other->end_transaction_);
+    std::swap(reap_queue_, other->reap_queue_);
+    std::swap(enqueue_update_, other->enqueue_update_);
+    std::swap(enqueue_message_, other->enqueue_message_);
+    std::swap(internal_heartbeat_txn_, other->internal_heartbeat_txn_);
+    std::swap(internal_push_txn_, other->internal_push_txn_);
+    std::swap(internal_resolve_intent_, other->internal_resolve_intent_);
+    std::swap(internal_merge_, other->internal_merge_);
+    std::swap(internal_truncate_log_, other->internal_truncate_log_);
+    std::swap(internal_gc_, other->internal_gc_);
+    std::swap(internal_begin_transaction_, other->internal_begin_transaction_);
+    std::swap(internal_put_if_absent_, other->internal_put_if_absent_);
+    std::swap(get_, other->get_);
+    std::swap(conditional_delete_, other->conditional_delete_);
+    std::swap(batch_, other->batch_);
+    std::swap(internal_resolve_intent_range_, other->internal_resolve_intent_range_);
+    std::swap(internal_leader_lease_, other->internal_leader_lease_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ReadWriteCmdResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ReadWriteCmdResponse_descriptor_;
+  metadata.reflection = ReadWriteCmdResponse_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ResponseCacheEntry::kCmdIdFieldNumber;
+const int ResponseCacheEntry::kResponseFieldNumber;
+#endif  // !_MSC_VER
+
+ResponseCacheEntry::ResponseCacheEntry()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.ResponseCacheEntry)
+}
+
+void ResponseCacheEntry::InitAsDefaultInstance() {
+  cmd_id_ = const_cast< ::proto::ClientCmdID*>(&::proto::ClientCmdID::default_instance());
+  response_ = const_cast< ::proto::ReadWriteCmdResponse*>(&::proto::ReadWriteCmdResponse::default_instance());
+}
+
+ResponseCacheEntry::ResponseCacheEntry(const ResponseCacheEntry& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.ResponseCacheEntry)
+}
+
+void ResponseCacheEntry::SharedCtor() {
+  _cached_size_ = 0;
+  cmd_id_ = NULL;
+  response_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ResponseCacheEntry::~ResponseCacheEntry() {
+  // @@protoc_insertion_point(destructor:proto.ResponseCacheEntry)
+  SharedDtor();
+}
+
+void ResponseCacheEntry::SharedDtor() {
+  if (this != default_instance_) {
+    delete cmd_id_;
+    delete response_;
+  }
+}
+
+void ResponseCacheEntry::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ResponseCacheEntry::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ResponseCacheEntry_descriptor_;
+}
+
+const ResponseCacheEntry& ResponseCacheEntry::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+ResponseCacheEntry* ResponseCacheEntry::default_instance_ = NULL;
+
+ResponseCacheEntry* ResponseCacheEntry::New() const {
+  return new ResponseCacheEntry;
+}
+
+void ResponseCacheEntry::Clear() {
+  if (_has_bits_[0 / 32] & 3) {
+    if (has_cmd_id()) {
+      if (cmd_id_ != NULL) cmd_id_->::proto::ClientCmdID::Clear();
+    }
+    if (has_response()) {
+      if (response_ != NULL) response_->::proto::ReadWriteCmdResponse::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool ResponseCacheEntry::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.ResponseCacheEntry)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.ClientCmdID cmd_id = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_cmd_id()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_response;
         break;
       }
 
-      // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_enqueue_update:
+      // optional .proto.ReadWriteCmdResponse response = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_response:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_enqueue_update()));
+               input, mutable_response()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(74)) goto parse_enqueue_message;
+        if (input->ExpectAtEnd()) goto success;
         break;
       }
 
-      // optional .proto.EnqueueMessageResponse enqueue_message = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_enqueue_message:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_enqueue_message()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(82)) goto parse_internal_heartbeat_txn;
-        break;
-      }
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.ResponseCacheEntry)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.ResponseCacheEntry)
+  return false;
+#undef DO_
+}
+
+void ResponseCacheEntry::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.ResponseCacheEntry)
+  // optional .proto.ClientCmdID cmd_id = 1;
+  if (has_cmd_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->cmd_id(), output);
+  }
+
+  // optional .proto.ReadWriteCmdResponse response = 2;
+  if (has_response()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->response(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.ResponseCacheEntry)
+}
+
+::google::protobuf::uint8* ResponseCacheEntry::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.ResponseCacheEntry)
+  // optional .proto.ClientCmdID cmd_id = 1;
+  if (has_cmd_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->cmd_id(), target);
+  }
+
+  // optional .proto.ReadWriteCmdResponse response = 2;
+  if (has_response()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->response(), target);
+  }
+
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.ResponseCacheEntry)
+  return target;
+}
+
+int ResponseCacheEntry::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.ClientCmdID cmd_id = 1;
+    if (has_cmd_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->cmd_id());
+    }
+
+    // optional .proto.ReadWriteCmdResponse response = 2;
+    if (has_response()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->response());
+    }
+
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ResponseCacheEntry::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const ResponseCacheEntry* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const ResponseCacheEntry*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ResponseCacheEntry::MergeFrom(const ResponseCacheEntry& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_cmd_id()) {
+      mutable_cmd_id()->::proto::ClientCmdID::MergeFrom(from.cmd_id());
+    }
+    if (from.has_response()) {
+      mutable_response()->::proto::ReadWriteCmdResponse::MergeFrom(from.response());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
+
+void ResponseCacheEntry::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ResponseCacheEntry::CopyFrom(const ResponseCacheEntry& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ResponseCacheEntry::IsInitialized() const {
+
+  return true;
+}
+
+void ResponseCacheEntry::Swap(ResponseCacheEntry* other) {
+  if (other != this) {
+    std::swap(cmd_id_, other->cmd_id_);
+    std::swap(response_, other->response_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
+  }
+}
+
+::google::protobuf::Metadata ResponseCacheEntry::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ResponseCacheEntry_descriptor_;
+  metadata.reflection = ResponseCacheEntry_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Lease::kStartFieldNumber;
+const int Lease::kExpirationFieldNumber;
+const int Lease::kReplicaFieldNumber;
+const int Lease::kEpochFieldNumber;
+#endif  // !_MSC_VER
+
+Lease::Lease()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.Lease)
+}
+
+void Lease::InitAsDefaultInstance() {
+  start_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+  expiration_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+  replica_ = const_cast< ::proto::Replica*>(&::proto::Replica::default_instance());
+}
+
+Lease::Lease(const Lease& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.Lease)
+}
+
+void Lease::SharedCtor() {
+  _cached_size_ = 0;
+  start_ = NULL;
+  expiration_ = NULL;
+  replica_ = NULL;
+  epoch_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Lease::~Lease() {
+  // @@protoc_insertion_point(destructor:proto.Lease)
+  SharedDtor();
+}
+
+void Lease::SharedDtor() {
+  if (this != default_instance_) {
+    delete start_;
+    delete expiration_;
+    delete replica_;
+  }
+}
+
+void Lease::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Lease::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Lease_descriptor_;
+}
+
+const Lease& Lease::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
 
-      // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-      case 10: {
-        if (tag == 82) {
-         parse_internal_heartbeat_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_heartbeat_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(90)) goto parse_internal_push_txn;
-        break;
-      }
+Lease* Lease::default_instance_ = NULL;
 
-      // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
-      case 11: {
-        if (tag == 90) {
-         parse_internal_push_txn:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_push_txn()));
-        } else {
-          goto handle_unusual;
-        }
-        if (input->ExpectTag(98)) goto parse_internal_resolve_intent;
-        break;
-      }
+Lease* Lease::New() const {
+  return new Lease;
+}
 
-      // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-      case 12: {
-        if (tag == 98) {
-         parse_internal_resolve_intent:
+void Lease::Clear() {
+  if (_has_bits_[0 / 32] & 15) {
+    if (has_start()) {
+      if (start_ != NULL) start_->::proto::Timestamp::Clear();
+    }
+    if (has_expiration()) {
+      if (expiration_ != NULL) expiration_->::proto::Timestamp::Clear();
+    }
+    if (has_replica()) {
+      if (replica_ != NULL) replica_->::proto::Replica::Clear();
+    }
+    epoch_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
+
+bool Lease::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.Lease)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.Timestamp start = 1;
+      case 1: {
+        if (tag == 10) {
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_resolve_intent()));
+               input, mutable_start()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(106)) goto parse_internal_merge;
+        if (input->ExpectTag(18)) goto parse_expiration;
         break;
       }
 
-      // optional .proto.InternalMergeResponse internal_merge = 13;
-      case 13: {
-        if (tag == 106) {
-         parse_internal_merge:
+      // optional .proto.Timestamp expiration = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_expiration:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_merge()));
+               input, mutable_expiration()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(114)) goto parse_internal_truncate_log;
+        if (input->ExpectTag(26)) goto parse_replica;
         break;
       }
 
-      // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
-      case 14: {
-        if (tag == 114) {
-         parse_internal_truncate_log:
+      // optional .proto.Replica replica = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_replica:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_truncate_log()));
+               input, mutable_replica()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(122)) goto parse_internal_gc;
+        if (input->ExpectTag(32)) goto parse_epoch;
         break;
       }
 
-      // optional .proto.InternalGCResponse internal_gc = 15;
-      case 15: {
-        if (tag == 122) {
-         parse_internal_gc:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_gc()));
+      // optional int64 epoch = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_epoch:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &epoch_)));
+          set_has_epoch();
         } else {
           goto handle_unusual;
         }
@@ -4946,342 +15271,435 @@ bool ReadWriteCmdResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(parse_success:proto.Lease)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(parse_failure:proto.Lease)
   return false;
 #undef DO_
 }
 
-void ReadWriteCmdResponse::SerializeWithCachedSizes(
+void Lease::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:proto.ReadWriteCmdResponse)
-  // optional .proto.PutResponse put = 1;
-  if (has_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, this->put(), output);
-  }
-
-  // optional .proto.ConditionalPutResponse conditional_put = 2;
-  if (has_conditional_put()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->conditional_put(), output);
-  }
-
-  // optional .proto.IncrementResponse increment = 3;
-  if (has_increment()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, this->increment(), output);
-  }
-
-  // optional .proto.DeleteResponse delete = 4;
-  if (has_delete_()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      4, this->delete_(), output);
-  }
-
-  // optional .proto.DeleteRangeResponse delete_range = 5;
-  if (has_delete_range()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      5, this->delete_range(), output);
-  }
-
-  // optional .proto.EndTransactionResponse end_transaction = 6;
-  if (has_end_transaction()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      6, this->end_transaction(), output);
-  }
-
-  // optional .proto.ReapQueueResponse reap_queue = 7;
-  if (has_reap_queue()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      7, this->reap_queue(), output);
-  }
-
-  // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
-  if (has_enqueue_update()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      8, this->enqueue_update(), output);
-  }
-
-  // optional .proto.EnqueueMessageResponse enqueue_message = 9;
-  if (has_enqueue_message()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      9, this->enqueue_message(), output);
-  }
-
-  // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-  if (has_internal_heartbeat_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      10, this->internal_heartbeat_txn(), output);
-  }
-
-  // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
-  if (has_internal_push_txn()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      11, this->internal_push_txn(), output);
-  }
-
-  // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-  if (has_internal_resolve_intent()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      12, this->internal_resolve_intent(), output);
-  }
-
-  // optional .proto.InternalMergeResponse internal_merge = 13;
-  if (has_internal_merge()) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      13, this->internal_merge(), output);
-  }
-
-  // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
-  if (has_internal_truncate_log()) {
+  // @@protoc_insertion_point(serialize_start:proto.Lease)
+  // optional .proto.Timestamp start = 1;
+  if (has_start()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      14, this->internal_truncate_log(), output);
+      1, this->start(), output);
   }
 
-  // optional .proto.InternalGCResponse internal_gc = 15;
-  if (has_internal_gc()) {
+  // optional .proto.Timestamp expiration = 2;
+  if (has_expiration()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      15, this->internal_gc(), output);
-  }
-
-  if (!unknown_fields().empty()) {
-    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
-        unknown_fields(), output);
-  }
-  // @@protoc_insertion_point(serialize_end:proto.ReadWriteCmdResponse)
-}
-
-::google::protobuf::uint8* ReadWriteCmdResponse::SerializeWithCachedSizesToArray(
-    ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:proto.ReadWriteCmdResponse)
-  // optional .proto.PutResponse put = 1;
-  if (has_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        1, this->put(), target);
-  }
-
-  // optional .proto.ConditionalPutResponse conditional_put = 2;
-  if (has_conditional_put()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->conditional_put(), target);
-  }
-
-  // optional .proto.IncrementResponse increment = 3;
-  if (has_increment()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        3, this->increment(), target);
-  }
-
-  // optional .proto.DeleteResponse delete = 4;
-  if (has_delete_()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        4, this->delete_(), target);
-  }
-
-  // optional .proto.DeleteRangeResponse delete_range = 5;
-  if (has_delete_range()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        5, this->delete_range(), target);
-  }
-
-  // optional .proto.EndTransactionResponse end_transaction = 6;
-  if (has_end_transaction()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        6, this->end_transaction(), target);
-  }
-
-  // optional .proto.ReapQueueResponse reap_queue = 7;
-  if (has_reap_queue()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        7, this->reap_queue(), target);
-  }
-
-  // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
-  if (has_enqueue_update()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        8, this->enqueue_update(), target);
+      2, this->expiration(), output);
   }
-
-  // optional .proto.EnqueueMessageResponse enqueue_message = 9;
-  if (has_enqueue_message()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        9, this->enqueue_message(), target);
+
+  // optional .proto.Replica replica = 3;
+  if (has_replica()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->replica(), output);
   }
 
-  // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-  if (has_internal_heartbeat_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        10, this->internal_heartbeat_txn(), target);
+  // optional int64 epoch = 4;
+  if (has_epoch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->epoch(), output);
   }
 
-  // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
-  if (has_internal_push_txn()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        11, this->internal_push_txn(), target);
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
   }
+  // @@protoc_insertion_point(serialize_end:proto.Lease)
+}
 
-  // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-  if (has_internal_resolve_intent()) {
+::google::protobuf::uint8* Lease::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.Lease)
+  // optional .proto.Timestamp start = 1;
+  if (has_start()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        12, this->internal_resolve_intent(), target);
+        1, this->start(), target);
   }
 
-  // optional .proto.InternalMergeResponse internal_merge = 13;
-  if (has_internal_merge()) {
+  // optional .proto.Timestamp expiration = 2;
+  if (has_expiration()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        13, this->internal_merge(), target);
+        2, this->expiration(), target);
   }
 
-  // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
-  if (has_internal_truncate_log()) {
+  // optional .proto.Replica replica = 3;
+  if (has_replica()) {
     target = ::google::protobuf::internal::WireFormatLite::
       WriteMessageNoVirtualToArray(
-        14, this->internal_truncate_log(), target);
+        3, this->replica(), target);
   }
 
-  // optional .proto.InternalGCResponse internal_gc = 15;
-  if (has_internal_gc()) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        15, this->internal_gc(), target);
+  // optional int64 epoch = 4;
+  if (has_epoch()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->epoch(), target);
   }
 
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:proto.ReadWriteCmdResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:proto.Lease)
   return target;
 }
 
-int ReadWriteCmdResponse::ByteSize() const {
+int Lease::ByteSize() const {
   int total_size = 0;
 
   if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    // optional .proto.PutResponse put = 1;
-    if (has_put()) {
+    // optional .proto.Timestamp start = 1;
+    if (has_start()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->put());
+          this->start());
     }
 
-    // optional .proto.ConditionalPutResponse conditional_put = 2;
-    if (has_conditional_put()) {
+    // optional .proto.Timestamp expiration = 2;
+    if (has_expiration()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->conditional_put());
+          this->expiration());
     }
 
-    // optional .proto.IncrementResponse increment = 3;
-    if (has_increment()) {
+    // optional .proto.Replica replica = 3;
+    if (has_replica()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->increment());
+          this->replica());
     }
 
-    // optional .proto.DeleteResponse delete = 4;
-    if (has_delete_()) {
+    // optional int64 epoch = 4;
+    if (has_epoch()) {
       total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_());
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->epoch());
     }
 
-    // optional .proto.DeleteRangeResponse delete_range = 5;
-    if (has_delete_range()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->delete_range());
-    }
+  }
+  if (!unknown_fields().empty()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
 
-    // optional .proto.EndTransactionResponse end_transaction = 6;
-    if (has_end_transaction()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->end_transaction());
-    }
+void Lease::MergeFrom(const ::google::protobuf::Message& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  const Lease* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const Lease*>(
+      &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
 
-    // optional .proto.ReapQueueResponse reap_queue = 7;
-    if (has_reap_queue()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->reap_queue());
+void Lease::MergeFrom(const Lease& from) {
+  GOOGLE_CHECK_NE(&from, this);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_start()) {
+      mutable_start()->::proto::Timestamp::MergeFrom(from.start());
     }
-
-    // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
-    if (has_enqueue_update()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->enqueue_update());
+    if (from.has_expiration()) {
+      mutable_expiration()->::proto::Timestamp::MergeFrom(from.expiration());
+    }
+    if (from.has_replica()) {
+      mutable_replica()->::proto::Replica::MergeFrom(from.replica());
     }
+    if (from.has_epoch()) {
+      set_epoch(from.epoch());
+    }
+  }
+  mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+}
 
+void Lease::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void Lease::CopyFrom(const Lease& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Lease::IsInitialized() const {
+
+  return true;
+}
+
+void Lease::Swap(Lease* other) {
+  if (other != this) {
+    std::swap(start_, other->start_);
+    std::swap(expiration_, other->expiration_);
+    std::swap(replica_, other->replica_);
+    std::swap(epoch_, other->epoch_);
+    std::swap(_has_bits_[0], other->_has_bits_[0]);
+    _unknown_fields_.Swap(&other->_unknown_fields_);
+    std::swap(_cached_size_, other->_cached_size_);
   }
-  if (_has_bits_[8 / 32] & (0xffu << (8 % 32))) {
-    // optional .proto.EnqueueMessageResponse enqueue_message = 9;
-    if (has_enqueue_message()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->enqueue_message());
+}
+
+::google::protobuf::Metadata Lease::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = Lease_descriptor_;
+  metadata.reflection = Lease_reflection_;
+  return metadata;
+}
+
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int LeaseTransfer::kFenceFieldNumber;
+const int LeaseTransfer::kResponseCacheFieldNumber;
+const int LeaseTransfer::kHolderFieldNumber;
+#endif  // !_MSC_VER
+
+LeaseTransfer::LeaseTransfer()
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:proto.LeaseTransfer)
+}
+
+void LeaseTransfer::InitAsDefaultInstance() {
+  fence_ = const_cast< ::proto::Timestamp*>(&::proto::Timestamp::default_instance());
+  holder_ = const_cast< ::proto::Replica*>(&::proto::Replica::default_instance());
+}
+
+LeaseTransfer::LeaseTransfer(const LeaseTransfer& from)
+  : ::google::protobuf::Message() {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:proto.LeaseTransfer)
+}
+
+void LeaseTransfer::SharedCtor() {
+  _cached_size_ = 0;
+  fence_ = NULL;
+  holder_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+LeaseTransfer::~LeaseTransfer() {
+  // @@protoc_insertion_point(destructor:proto.LeaseTransfer)
+  SharedDtor();
+}
+
+void LeaseTransfer::SharedDtor() {
+  if (this != default_instance_) {
+    delete fence_;
+    delete holder_;
+  }
+}
+
+void LeaseTransfer::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* LeaseTransfer::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return LeaseTransfer_descriptor_;
+}
+
+const LeaseTransfer& LeaseTransfer::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_internal_2eproto();
+  return *default_instance_;
+}
+
+LeaseTransfer* LeaseTransfer::default_instance_ = NULL;
+
+LeaseTransfer* LeaseTransfer::New() const {
+  return new LeaseTransfer;
+}
+
+void LeaseTransfer::Clear() {
+  if (_has_bits_[0 / 32] & 5) {
+    if (has_fence()) {
+      if (fence_ != NULL) fence_->::proto::Timestamp::Clear();
+    }
+    if (has_holder()) {
+      if (holder_ != NULL) holder_->::proto::Replica::Clear();
     }
+  }
+  response_cache_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  mutable_unknown_fields()->Clear();
+}
 
-    // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-    if (has_internal_heartbeat_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_heartbeat_txn());
+bool LeaseTransfer::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:proto.LeaseTransfer)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .proto.Timestamp fence = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_fence()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_response_cache;
+        break;
+      }
+
+      // repeated .proto.ResponseCacheEntry response_cache = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_response_cache:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+                input, add_response_cache()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_response_cache;
+        if (input->ExpectTag(26)) goto parse_holder;
+        break;
+      }
+
+      // optional .proto.Replica holder = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_holder:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_holder()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
     }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:proto.LeaseTransfer)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:proto.LeaseTransfer)
+  return false;
+#undef DO_
+}
+
+void LeaseTransfer::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:proto.LeaseTransfer)
+  // optional .proto.Timestamp fence = 1;
+  if (has_fence()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, this->fence(), output);
+  }
+
+  // repeated .proto.ResponseCacheEntry response_cache = 2;
+  for (int i = 0; i < this->response_cache_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->response_cache(i), output);
+  }
+
+  // optional .proto.Replica holder = 3;
+  if (has_holder()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->holder(), output);
+  }
+
+  if (!unknown_fields().empty()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:proto.LeaseTransfer)
+}
+
+::google::protobuf::uint8* LeaseTransfer::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:proto.LeaseTransfer)
+  // optional .proto.Timestamp fence = 1;
+  if (has_fence()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, this->fence(), target);
+  }
+
+  // repeated .proto.ResponseCacheEntry response_cache = 2;
+  for (int i = 0; i < this->response_cache_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->response_cache(i), target);
+  }
 
-    // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
-    if (has_internal_push_txn()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_push_txn());
-    }
+  // optional .proto.Replica holder = 3;
+  if (has_holder()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->holder(), target);
+  }
 
-    // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-    if (has_internal_resolve_intent()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_resolve_intent());
-    }
+  if (!unknown_fields().empty()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:proto.LeaseTransfer)
+  return target;
+}
 
-    // optional .proto.InternalMergeResponse internal_merge = 13;
-    if (has_internal_merge()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_merge());
-    }
+int LeaseTransfer::ByteSize() const {
+  int total_size = 0;
 
-    // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
-    if (has_internal_truncate_log()) {
+  if (_has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    // optional .proto.Timestamp fence = 1;
+    if (has_fence()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_truncate_log());
+          this->fence());
     }
 
-    // optional .proto.InternalGCResponse internal_gc = 15;
-    if (has_internal_gc()) {
+    // optional .proto.Replica holder = 3;
+    if (has_holder()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          this->internal_gc());
+          this->holder());
     }
 
   }
+  // repeated .proto.ResponseCacheEntry response_cache = 2;
+  total_size += 1 * this->response_cache_size();
+  for (int i = 0; i < this->response_cache_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->response_cache(i));
+  }
+
   if (!unknown_fields().empty()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -5293,10 +15711,10 @@ int ReadWriteCmdResponse::ByteSize() const {
   return total_size;
 }
 
-void ReadWriteCmdResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void LeaseTransfer::MergeFrom(const ::google::protobuf::Message& from) {
   GOOGLE_CHECK_NE(&from, this);
-  const ReadWriteCmdResponse* source =
-    ::google::protobuf::internal::dynamic_cast_if_available<const ReadWriteCmdResponse*>(
+  const LeaseTransfer* source =
+    ::google::protobuf::internal::dynamic_cast_if_available<const LeaseTransfer*>(
       &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -5305,105 +15723,53 @@ void ReadWriteCmdResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
+void LeaseTransfer::MergeFrom(const LeaseTransfer& from) {
   GOOGLE_CHECK_NE(&from, this);
+  response_cache_.MergeFrom(from.response_cache_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
-    if (from.has_put()) {
-      mutable_put()->::proto::PutResponse::MergeFrom(from.put());
-    }
-    if (from.has_conditional_put()) {
-      mutable_conditional_put()->::proto::ConditionalPutResponse::MergeFrom(from.conditional_put());
-    }
-    if (from.has_increment()) {
-      mutable_increment()->::proto::IncrementResponse::MergeFrom(from.increment());
-    }
-    if (from.has_delete_()) {
-      mutable_delete_()->::proto::DeleteResponse::MergeFrom(from.delete_());
-    }
-    if (from.has_delete_range()) {
-      mutable_delete_range()->::proto::DeleteRangeResponse::MergeFrom(from.delete_range());
-    }
-    if (from.has_end_transaction()) {
-      mutable_end_transaction()->::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
-    }
-    if (from.has_reap_queue()) {
-      mutable_reap_queue()->::proto::ReapQueueResponse::MergeFrom(from.reap_queue());
-    }
-    if (from.has_enqueue_update()) {
-      mutable_enqueue_update()->::proto::EnqueueUpdateResponse::MergeFrom(from.enqueue_update());
-    }
-  }
-  if (from._has_bits_[8 / 32] & (0xffu << (8 % 32))) {
-    if (from.has_enqueue_message()) {
-      mutable_enqueue_message()->::proto::EnqueueMessageResponse::MergeFrom(from.enqueue_message());
-    }
-    if (from.has_internal_heartbeat_txn()) {
-      mutable_internal_heartbeat_txn()->::proto::InternalHeartbeatTxnResponse::MergeFrom(from.internal_heartbeat_txn());
-    }
-    if (from.has_internal_push_txn()) {
-      mutable_internal_push_txn()->::proto::InternalPushTxnResponse::MergeFrom(from.internal_push_txn());
-    }
-    if (from.has_internal_resolve_intent()) {
-      mutable_internal_resolve_intent()->::proto::InternalResolveIntentResponse::MergeFrom(from.internal_resolve_intent());
-    }
-    if (from.has_internal_merge()) {
-      mutable_internal_merge()->::proto::InternalMergeResponse::MergeFrom(from.internal_merge());
-    }
-    if (from.has_internal_truncate_log()) {
-      mutable_internal_truncate_log()->::proto::InternalTruncateLogResponse::MergeFrom(from.internal_truncate_log());
+    if (from.has_fence()) {
+      mutable_fence()->::proto::Timestamp::MergeFrom(from.fence());
     }
-    if (from.has_internal_gc()) {
-      mutable_internal_gc()->::proto::InternalGCResponse::MergeFrom(from.internal_gc());
+    if (from.has_holder()) {
+      mutable_holder()->::proto::Replica::MergeFrom(from.holder());
     }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
 
-void ReadWriteCmdResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void LeaseTransfer::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ReadWriteCmdResponse::CopyFrom(const ReadWriteCmdResponse& from) {
+void LeaseTransfer::CopyFrom(const LeaseTransfer& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ReadWriteCmdResponse::IsInitialized() const {
+bool LeaseTransfer::IsInitialized() const {
 
   return true;
 }
 
-void ReadWriteCmdResponse::Swap(ReadWriteCmdResponse* other) {
+void LeaseTransfer::Swap(LeaseTransfer* other) {
   if (other != this) {
-    std::swap(put_, other->put_);
-    std::swap(conditional_put_, other->conditional_put_);
-    std::swap(increment_, other->increment_);
-    std::swap(delete__, other->delete__);
-    std::swap(delete_range_, other->delete_range_);
-    std::swap(end_transaction_, other->end_transaction_);
-    std::swap(reap_queue_, other->reap_queue_);
-    std::swap(enqueue_update_, other->enqueue_update_);
-    std::swap(enqueue_message_, other->enqueue_message_);
-    std::swap(internal_heartbeat_txn_, other->internal_heartbeat_txn_);
-    std::swap(internal_push_txn_, other->internal_push_txn_);
-    std::swap(internal_resolve_intent_, other->internal_resolve_intent_);
-    std::swap(internal_merge_, other->internal_merge_);
-    std::swap(internal_truncate_log_, other->internal_truncate_log_);
-    std::swap(internal_gc_, other->internal_gc_);
+    std::swap(fence_, other->fence_);
+    response_cache_.Swap(&other->response_cache_);
+    std::swap(holder_, other->holder_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
 }
 
-::google::protobuf::Metadata ReadWriteCmdResponse::GetMetadata() const {
+::google::protobuf::Metadata LeaseTransfer::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ReadWriteCmdResponse_descriptor_;
-  metadata.reflection = ReadWriteCmdResponse_reflection_;
+  metadata.descriptor = LeaseTransfer_descriptor_;
+  metadata.reflection = LeaseTransfer_reflection_;
   return metadata;
 }
 
@@ -5423,6 +15789,8 @@ const int InternalRaftCommandUnion::kEndTransactionFieldNumber;
 const int InternalRaftCommandUnion::kReapQueueFieldNumber;
 const int InternalRaftCommandUnion::kEnqueueUpdateFieldNumber;
 const int InternalRaftCommandUnion::kEnqueueMessageFieldNumber;
+const int InternalRaftCommandUnion::kReverseScanFieldNumber;
+const int InternalRaftCommandUnion::kConditionalDeleteFieldNumber;
 const int InternalRaftCommandUnion::kBatchFieldNumber;
 const int InternalRaftCommandUnion::kInternalRangeLookupFieldNumber;
 const int InternalRaftCommandUnion::kInternalHeartbeatTxnFieldNumber;
@@ -5431,6 +15799,18 @@ const int InternalRaftCommandUnion::kInternalResolveIntentFieldNumber;
 const int InternalRaftCommandUnion::kInternalMergeResponseFieldNumber;
 const int InternalRaftCommandUnion::kInternalTruncateLogFieldNumber;
 const int InternalRaftCommandUnion::kInternalGcFieldNumber;
+const int InternalRaftCommandUnion::kInternalBeginTransactionFieldNumber;
+const int InternalRaftCommandUnion::kInternalScanIntentsFieldNumber;
+const int InternalRaftCommandUnion::kInternalInspectTimestampCacheFieldNumber;
+const int InternalRaftCommandUnion::kInternalGetTransactionFieldNumber;
+const int InternalRaftCommandUnion::kInternalPutIfAbsentFieldNumber;
+const int InternalRaftCommandUnion::kInternalRangeKeyBoundsFieldNumber;
+const int InternalRaftCommandUnion::kInternalVerifyRangeFieldNumber;
+const int InternalRaftCommandUnion::kInternalResolveIntentRangeFieldNumber;
+const int InternalRaftCommandUnion::kInternalLeaderLeaseFieldNumber;
+const int InternalRaftCommandUnion::kInternalComputeChecksumFieldNumber;
+const int InternalRaftCommandUnion::kInternalGetHistoryFieldNumber;
+const int InternalRaftCommandUnion::kInternalBatchGetFieldNumber;
 #endif  // !_MSC_VER
 
 InternalRaftCommandUnion::InternalRaftCommandUnion()
@@ -5452,6 +15832,8 @@ void InternalRaftCommandUnion::InitAsDefaultInstance() {
   reap_queue_ = const_cast< ::proto::ReapQueueRequest*>(&::proto::ReapQueueRequest::default_instance());
   enqueue_update_ = const_cast< ::proto::EnqueueUpdateRequest*>(&::proto::EnqueueUpdateRequest::default_instance());
   enqueue_message_ = const_cast< ::proto::EnqueueMessageRequest*>(&::proto::EnqueueMessageRequest::default_instance());
+  reverse_scan_ = const_cast< ::proto::ReverseScanRequest*>(&::proto::ReverseScanRequest::default_instance());
+  conditional_delete_ = const_cast< ::proto::ConditionalDeleteRequest*>(&::proto::ConditionalDeleteRequest::default_instance());
   batch_ = const_cast< ::proto::BatchRequest*>(&::proto::BatchRequest::default_instance());
   internal_range_lookup_ = const_cast< ::proto::InternalRangeLookupRequest*>(&::proto::InternalRangeLookupRequest::default_instance());
   internal_heartbeat_txn_ = const_cast< ::proto::InternalHeartbeatTxnRequest*>(&::proto::InternalHeartbeatTxnRequest::default_instance());
@@ -5460,6 +15842,18 @@ void InternalRaftCommandUnion::InitAsDefaultInstance() {
   internal_merge_response_ = const_cast< ::proto::InternalMergeRequest*>(&::proto::InternalMergeRequest::default_instance());
   internal_truncate_log_ = const_cast< ::proto::InternalTruncateLogRequest*>(&::proto::InternalTruncateLogRequest::default_instance());
   internal_gc_ = const_cast< ::proto::InternalGCRequest*>(&::proto::InternalGCRequest::default_instance());
+  internal_begin_transaction_ = const_cast< ::proto::InternalBeginTransactionRequest*>(&::proto::InternalBeginTransactionRequest::default_instance());
+  internal_scan_intents_ = const_cast< ::proto::InternalScanIntentsRequest*>(&::proto::InternalScanIntentsRequest::default_instance());
+  internal_inspect_timestamp_cache_ = const_cast< ::proto::InternalInspectTimestampCacheRequest*>(&::proto::InternalInspectTimestampCacheRequest::default_instance());
+  internal_get_transaction_ = const_cast< ::proto::InternalGetTransactionRequest*>(&::proto::InternalGetTransactionRequest::default_instance());
+  internal_put_if_absent_ = const_cast< ::proto::InternalPutIfAbsentRequest*>(&::proto::InternalPutIfAbsentRequest::default_instance());
+  internal_range_key_bounds_ = const_cast< ::proto::InternalRangeKeyBoundsRequest*>(&::proto::InternalRangeKeyBoundsRequest::default_instance());
+  internal_verify_range_ = const_cast< ::proto::InternalVerifyRangeRequest*>(&::proto::InternalVerifyRangeRequest::default_instance());
+  internal_resolve_intent_range_ = const_cast< ::proto::InternalResolveIntentRangeRequest*>(&::proto::InternalResolveIntentRangeRequest::default_instance());
+  internal_leader_lease_ = const_cast< ::proto::InternalLeaderLeaseRequest*>(&::proto::InternalLeaderLeaseRequest::default_instance());
+  internal_compute_checksum_ = const_cast< ::proto::InternalComputeChecksumRequest*>(&::proto::InternalComputeChecksumRequest::default_instance());
+  internal_get_history_ = const_cast< ::proto::InternalGetHistoryRequest*>(&::proto::InternalGetHistoryRequest::default_instance());
+  internal_batch_get_ = const_cast< ::proto::InternalBatchGetRequest*>(&::proto::InternalBatchGetRequest::default_instance());
 }
 
 InternalRaftCommandUnion::InternalRaftCommandUnion(const InternalRaftCommandUnion& from)
@@ -5483,6 +15877,8 @@ void InternalRaftCommandUnion::SharedCtor() {
   reap_queue_ = NULL;
   enqueue_update_ = NULL;
   enqueue_message_ = NULL;
+  reverse_scan_ = NULL;
+  conditional_delete_ = NULL;
   batch_ = NULL;
   internal_range_lookup_ = NULL;
   internal_heartbeat_txn_ = NULL;
@@ -5491,6 +15887,18 @@ void InternalRaftCommandUnion::SharedCtor() {
   internal_merge_response_ = NULL;
   internal_truncate_log_ = NULL;
   internal_gc_ = NULL;
+  internal_begin_transaction_ = NULL;
+  internal_scan_intents_ = NULL;
+  internal_inspect_timestamp_cache_ = NULL;
+  internal_get_transaction_ = NULL;
+  internal_put_if_absent_ = NULL;
+  internal_range_key_bounds_ = NULL;
+  internal_verify_range_ = NULL;
+  internal_resolve_intent_range_ = NULL;
+  internal_leader_lease_ = NULL;
+  internal_compute_checksum_ = NULL;
+  internal_get_history_ = NULL;
+  internal_batch_get_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -5513,6 +15921,8 @@ void InternalRaftCommandUnion::SharedDtor() {
     delete reap_queue_;
     delete enqueue_update_;
     delete enqueue_message_;
+    delete reverse_scan_;
+    delete conditional_delete_;
     delete batch_;
     delete internal_range_lookup_;
     delete internal_heartbeat_txn_;
@@ -5521,6 +15931,18 @@ void InternalRaftCommandUnion::SharedDtor() {
     delete internal_merge_response_;
     delete internal_truncate_log_;
     delete internal_gc_;
+    delete internal_begin_transaction_;
+    delete internal_scan_intents_;
+    delete internal_inspect_timestamp_cache_;
+    delete internal_get_transaction_;
+    delete internal_put_if_absent_;
+    delete internal_range_key_bounds_;
+    delete internal_verify_range_;
+    delete internal_resolve_intent_range_;
+    delete internal_leader_lease_;
+    delete internal_compute_checksum_;
+    delete internal_get_history_;
+    delete internal_batch_get_;
   }
 }
 
@@ -5585,20 +16007,26 @@ void InternalRaftCommandUnion::Clear() {
     if (has_enqueue_message()) {
       if (enqueue_message_ != NULL) enqueue_message_->::proto::EnqueueMessageRequest::Clear();
     }
+    if (has_reverse_scan()) {
+      if (reverse_scan_ != NULL) reverse_scan_->::proto::ReverseScanRequest::Clear();
+    }
+    if (has_conditional_delete()) {
+      if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteRequest::Clear();
+    }
     if (has_batch()) {
       if (batch_ != NULL) batch_->::proto::BatchRequest::Clear();
     }
     if (has_internal_range_lookup()) {
       if (internal_range_lookup_ != NULL) internal_range_lookup_->::proto::InternalRangeLookupRequest::Clear();
     }
+  }
+  if (_has_bits_[16 / 32] & 16711680) {
     if (has_internal_heartbeat_txn()) {
       if (internal_heartbeat_txn_ != NULL) internal_heartbeat_txn_->::proto::InternalHeartbeatTxnRequest::Clear();
     }
     if (has_internal_push_txn()) {
       if (internal_push_txn_ != NULL) internal_push_txn_->::proto::InternalPushTxnRequest::Clear();
     }
-  }
-  if (_has_bits_[16 / 32] & 983040) {
     if (has_internal_resolve_intent()) {
       if (internal_resolve_intent_ != NULL) internal_resolve_intent_->::proto::InternalResolveIntentRequest::Clear();
     }
@@ -5611,6 +16039,46 @@ void InternalRaftCommandUnion::Clear() {
     if (has_internal_gc()) {
       if (internal_gc_ != NULL) internal_gc_->::proto::InternalGCRequest::Clear();
     }
+    if (has_internal_begin_transaction()) {
+      if (internal_begin_transaction_ != NULL) internal_begin_transaction_->::proto::InternalBeginTransactionRequest::Clear();
+    }
+    if (has_internal_scan_intents()) {
+      if (internal_scan_intents_ != NULL) internal_scan_intents_->::proto::InternalScanIntentsRequest::Clear();
+    }
+  }
+  if (_has_bits_[24 / 32] & 4278190080) {
+    if (has_internal_inspect_timestamp_cache()) {
+      if (internal_inspect_timestamp_cache_ != NULL) internal_inspect_timestamp_cache_->::proto::InternalInspectTimestampCacheRequest::Clear();
+    }
+    if (has_internal_get_transaction()) {
+      if (internal_get_transaction_ != NULL) internal_get_transaction_->::proto::InternalGetTransactionRequest::Clear();
+    }
+    if (has_internal_put_if_absent()) {
+      if (internal_put_if_absent_ != NULL) internal_put_if_absent_->::proto::InternalPutIfAbsentRequest::Clear();
+    }
+    if (has_internal_range_key_bounds()) {
+      if (internal_range_key_bounds_ != NULL) internal_range_key_bounds_->::proto::InternalRangeKeyBoundsRequest::Clear();
+    }
+    if (has_internal_verify_range()) {
+      if (internal_verify_range_ != NULL) internal_verify_range_->::proto::InternalVerifyRangeRequest::Clear();
+    }
+    if (has_internal_resolve_intent_range()) {
+      if (internal_resolve_intent_range_ != NULL) internal_resolve_intent_range_->::proto::InternalResolveIntentRangeRequest::Clear();
+    }
+    if (has_internal_leader_lease()) {
+      if (internal_leader_lease_ != NULL) internal_leader_lease_->::proto::InternalLeaderLeaseRequest::Clear();
+    }
+    if (has_internal_compute_checksum()) {
+      if (internal_compute_checksum_ != NULL) internal_compute_checksum_->::proto::InternalComputeChecksumRequest::Clear();
+    }
+  }
+  if (_has_bits_[32 / 32] & 3) {
+    if (has_internal_get_history()) {
+      if (internal_get_history_ != NULL) internal_get_history_->::proto::InternalGetHistoryRequest::Clear();
+    }
+    if (has_internal_batch_get()) {
+      if (internal_batch_get_ != NULL) internal_batch_get_->::proto::InternalBatchGetRequest::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
@@ -5777,6 +16245,32 @@ bool InternalRaftCommandUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(106)) goto parse_reverse_scan;
+        break;
+      }
+
+      // optional .proto.ReverseScanRequest reverse_scan = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_reverse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_reverse_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_conditional_delete;
+        break;
+      }
+
+      // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_conditional_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_conditional_delete()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectTag(242)) goto parse_batch;
         break;
       }
@@ -5829,55 +16323,211 @@ bool InternalRaftCommandUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(274)) goto parse_internal_resolve_intent;
+        if (input->ExpectTag(274)) goto parse_internal_resolve_intent;
+        break;
+      }
+
+      // optional .proto.InternalResolveIntentRequest internal_resolve_intent = 34;
+      case 34: {
+        if (tag == 274) {
+         parse_internal_resolve_intent:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_resolve_intent()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(282)) goto parse_internal_merge_response;
+        break;
+      }
+
+      // optional .proto.InternalMergeRequest internal_merge_response = 35;
+      case 35: {
+        if (tag == 282) {
+         parse_internal_merge_response:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_merge_response()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(290)) goto parse_internal_truncate_log;
+        break;
+      }
+
+      // optional .proto.InternalTruncateLogRequest internal_truncate_log = 36;
+      case 36: {
+        if (tag == 290) {
+         parse_internal_truncate_log:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_truncate_log()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(298)) goto parse_internal_gc;
+        break;
+      }
+
+      // optional .proto.InternalGCRequest internal_gc = 37;
+      case 37: {
+        if (tag == 298) {
+         parse_internal_gc:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_gc()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(306)) goto parse_internal_begin_transaction;
+        break;
+      }
+
+      // optional .proto.InternalBeginTransactionRequest internal_begin_transaction = 38;
+      case 38: {
+        if (tag == 306) {
+         parse_internal_begin_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_begin_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(314)) goto parse_internal_scan_intents;
+        break;
+      }
+
+      // optional .proto.InternalScanIntentsRequest internal_scan_intents = 39;
+      case 39: {
+        if (tag == 314) {
+         parse_internal_scan_intents:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_scan_intents()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(322)) goto parse_internal_inspect_timestamp_cache;
+        break;
+      }
+
+      // optional .proto.InternalInspectTimestampCacheRequest internal_inspect_timestamp_cache = 40;
+      case 40: {
+        if (tag == 322) {
+         parse_internal_inspect_timestamp_cache:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_inspect_timestamp_cache()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(330)) goto parse_internal_get_transaction;
+        break;
+      }
+
+      // optional .proto.InternalGetTransactionRequest internal_get_transaction = 41;
+      case 41: {
+        if (tag == 330) {
+         parse_internal_get_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_get_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(338)) goto parse_internal_put_if_absent;
+        break;
+      }
+
+      // optional .proto.InternalPutIfAbsentRequest internal_put_if_absent = 42;
+      case 42: {
+        if (tag == 338) {
+         parse_internal_put_if_absent:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_put_if_absent()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(346)) goto parse_internal_range_key_bounds;
+        break;
+      }
+
+      // optional .proto.InternalRangeKeyBoundsRequest internal_range_key_bounds = 43;
+      case 43: {
+        if (tag == 346) {
+         parse_internal_range_key_bounds:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_range_key_bounds()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(354)) goto parse_internal_verify_range;
+        break;
+      }
+
+      // optional .proto.InternalVerifyRangeRequest internal_verify_range = 44;
+      case 44: {
+        if (tag == 354) {
+         parse_internal_verify_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_verify_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(362)) goto parse_internal_resolve_intent_range;
+        break;
+      }
+
+      // optional .proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 45;
+      case 45: {
+        if (tag == 362) {
+         parse_internal_resolve_intent_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_internal_resolve_intent_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(370)) goto parse_internal_leader_lease;
         break;
       }
 
-      // optional .proto.InternalResolveIntentRequest internal_resolve_intent = 34;
-      case 34: {
-        if (tag == 274) {
-         parse_internal_resolve_intent:
+      // optional .proto.InternalLeaderLeaseRequest internal_leader_lease = 46;
+      case 46: {
+        if (tag == 370) {
+         parse_internal_leader_lease:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_resolve_intent()));
+               input, mutable_internal_leader_lease()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(282)) goto parse_internal_merge_response;
+        if (input->ExpectTag(378)) goto parse_internal_compute_checksum;
         break;
       }
 
-      // optional .proto.InternalMergeRequest internal_merge_response = 35;
-      case 35: {
-        if (tag == 282) {
-         parse_internal_merge_response:
+      // optional .proto.InternalComputeChecksumRequest internal_compute_checksum = 47;
+      case 47: {
+        if (tag == 378) {
+         parse_internal_compute_checksum:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_merge_response()));
+               input, mutable_internal_compute_checksum()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(290)) goto parse_internal_truncate_log;
+        if (input->ExpectTag(386)) goto parse_internal_get_history;
         break;
       }
 
-      // optional .proto.InternalTruncateLogRequest internal_truncate_log = 36;
-      case 36: {
-        if (tag == 290) {
-         parse_internal_truncate_log:
+      // optional .proto.InternalGetHistoryRequest internal_get_history = 48;
+      case 48: {
+        if (tag == 386) {
+         parse_internal_get_history:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_truncate_log()));
+               input, mutable_internal_get_history()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(298)) goto parse_internal_gc;
+        if (input->ExpectTag(394)) goto parse_internal_batch_get;
         break;
       }
 
-      // optional .proto.InternalGCRequest internal_gc = 37;
-      case 37: {
-        if (tag == 298) {
-         parse_internal_gc:
+      // optional .proto.InternalBatchGetRequest internal_batch_get = 49;
+      case 49: {
+        if (tag == 394) {
+         parse_internal_batch_get:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_internal_gc()));
+               input, mutable_internal_batch_get()));
         } else {
           goto handle_unusual;
         }
@@ -5982,6 +16632,18 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
       12, this->enqueue_message(), output);
   }
 
+  // optional .proto.ReverseScanRequest reverse_scan = 13;
+  if (has_reverse_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, this->reverse_scan(), output);
+  }
+
+  // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+  if (has_conditional_delete()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, this->conditional_delete(), output);
+  }
+
   // optional .proto.BatchRequest batch = 30;
   if (has_batch()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -6030,6 +16692,78 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
       37, this->internal_gc(), output);
   }
 
+  // optional .proto.InternalBeginTransactionRequest internal_begin_transaction = 38;
+  if (has_internal_begin_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      38, this->internal_begin_transaction(), output);
+  }
+
+  // optional .proto.InternalScanIntentsRequest internal_scan_intents = 39;
+  if (has_internal_scan_intents()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      39, this->internal_scan_intents(), output);
+  }
+
+  // optional .proto.InternalInspectTimestampCacheRequest internal_inspect_timestamp_cache = 40;
+  if (has_internal_inspect_timestamp_cache()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      40, this->internal_inspect_timestamp_cache(), output);
+  }
+
+  // optional .proto.InternalGetTransactionRequest internal_get_transaction = 41;
+  if (has_internal_get_transaction()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      41, this->internal_get_transaction(), output);
+  }
+
+  // optional .proto.InternalPutIfAbsentRequest internal_put_if_absent = 42;
+  if (has_internal_put_if_absent()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      42, this->internal_put_if_absent(), output);
+  }
+
+  // optional .proto.InternalRangeKeyBoundsRequest internal_range_key_bounds = 43;
+  if (has_internal_range_key_bounds()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      43, this->internal_range_key_bounds(), output);
+  }
+
+  // optional .proto.InternalVerifyRangeRequest internal_verify_range = 44;
+  if (has_internal_verify_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      44, this->internal_verify_range(), output);
+  }
+
+  // optional .proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 45;
+  if (has_internal_resolve_intent_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      45, this->internal_resolve_intent_range(), output);
+  }
+
+  // optional .proto.InternalLeaderLeaseRequest internal_leader_lease = 46;
+  if (has_internal_leader_lease()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      46, this->internal_leader_lease(), output);
+  }
+
+  // optional .proto.InternalComputeChecksumRequest internal_compute_checksum = 47;
+  if (has_internal_compute_checksum()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      47, this->internal_compute_checksum(), output);
+  }
+
+  // optional .proto.InternalGetHistoryRequest internal_get_history = 48;
+  if (has_internal_get_history()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      48, this->internal_get_history(), output);
+  }
+
+  // optional .proto.InternalBatchGetRequest internal_batch_get = 49;
+  if (has_internal_batch_get()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      49, this->internal_batch_get(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -6124,6 +16858,20 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
         12, this->enqueue_message(), target);
   }
 
+  // optional .proto.ReverseScanRequest reverse_scan = 13;
+  if (has_reverse_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, this->reverse_scan(), target);
+  }
+
+  // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+  if (has_conditional_delete()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, this->conditional_delete(), target);
+  }
+
   // optional .proto.BatchRequest batch = 30;
   if (has_batch()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -6180,6 +16928,90 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
         37, this->internal_gc(), target);
   }
 
+  // optional .proto.InternalBeginTransactionRequest internal_begin_transaction = 38;
+  if (has_internal_begin_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        38, this->internal_begin_transaction(), target);
+  }
+
+  // optional .proto.InternalScanIntentsRequest internal_scan_intents = 39;
+  if (has_internal_scan_intents()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        39, this->internal_scan_intents(), target);
+  }
+
+  // optional .proto.InternalInspectTimestampCacheRequest internal_inspect_timestamp_cache = 40;
+  if (has_internal_inspect_timestamp_cache()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        40, this->internal_inspect_timestamp_cache(), target);
+  }
+
+  // optional .proto.InternalGetTransactionRequest internal_get_transaction = 41;
+  if (has_internal_get_transaction()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        41, this->internal_get_transaction(), target);
+  }
+
+  // optional .proto.InternalPutIfAbsentRequest internal_put_if_absent = 42;
+  if (has_internal_put_if_absent()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        42, this->internal_put_if_absent(), target);
+  }
+
+  // optional .proto.InternalRangeKeyBoundsRequest internal_range_key_bounds = 43;
+  if (has_internal_range_key_bounds()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        43, this->internal_range_key_bounds(), target);
+  }
+
+  // optional .proto.InternalVerifyRangeRequest internal_verify_range = 44;
+  if (has_internal_verify_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        44, this->internal_verify_range(), target);
+  }
+
+  // optional .proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 45;
+  if (has_internal_resolve_intent_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        45, this->internal_resolve_intent_range(), target);
+  }
+
+  // optional .proto.InternalLeaderLeaseRequest internal_leader_lease = 46;
+  if (has_internal_leader_lease()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        46, this->internal_leader_lease(), target);
+  }
+
+  // optional .proto.InternalComputeChecksumRequest internal_compute_checksum = 47;
+  if (has_internal_compute_checksum()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        47, this->internal_compute_checksum(), target);
+  }
+
+  // optional .proto.InternalGetHistoryRequest internal_get_history = 48;
+  if (has_internal_get_history()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        48, this->internal_get_history(), target);
+  }
+
+  // optional .proto.InternalBatchGetRequest internal_batch_get = 49;
+  if (has_internal_batch_get()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        49, this->internal_batch_get(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -6278,6 +17110,20 @@ int InternalRaftCommandUnion::ByteSize() const {
           this->enqueue_message());
     }
 
+    // optional .proto.ReverseScanRequest reverse_scan = 13;
+    if (has_reverse_scan()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->reverse_scan());
+    }
+
+    // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+    if (has_conditional_delete()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->conditional_delete());
+    }
+
     // optional .proto.BatchRequest batch = 30;
     if (has_batch()) {
       total_size += 2 +
@@ -6292,6 +17138,8 @@ int InternalRaftCommandUnion::ByteSize() const {
           this->internal_range_lookup());
     }
 
+  }
+  if (_has_bits_[16 / 32] & (0xffu << (16 % 32))) {
     // optional .proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
     if (has_internal_heartbeat_txn()) {
       total_size += 2 +
@@ -6306,8 +17154,6 @@ int InternalRaftCommandUnion::ByteSize() const {
           this->internal_push_txn());
     }
 
-  }
-  if (_has_bits_[16 / 32] & (0xffu << (16 % 32))) {
     // optional .proto.InternalResolveIntentRequest internal_resolve_intent = 34;
     if (has_internal_resolve_intent()) {
       total_size += 2 +
@@ -6336,6 +17182,94 @@ int InternalRaftCommandUnion::ByteSize() const {
           this->internal_gc());
     }
 
+    // optional .proto.InternalBeginTransactionRequest internal_begin_transaction = 38;
+    if (has_internal_begin_transaction()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_begin_transaction());
+    }
+
+    // optional .proto.InternalScanIntentsRequest internal_scan_intents = 39;
+    if (has_internal_scan_intents()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_scan_intents());
+    }
+
+  }
+  if (_has_bits_[24 / 32] & (0xffu << (24 % 32))) {
+    // optional .proto.InternalInspectTimestampCacheRequest internal_inspect_timestamp_cache = 40;
+    if (has_internal_inspect_timestamp_cache()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_inspect_timestamp_cache());
+    }
+
+    // optional .proto.InternalGetTransactionRequest internal_get_transaction = 41;
+    if (has_internal_get_transaction()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_get_transaction());
+    }
+
+    // optional .proto.InternalPutIfAbsentRequest internal_put_if_absent = 42;
+    if (has_internal_put_if_absent()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_put_if_absent());
+    }
+
+    // optional .proto.InternalRangeKeyBoundsRequest internal_range_key_bounds = 43;
+    if (has_internal_range_key_bounds()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_range_key_bounds());
+    }
+
+    // optional .proto.InternalVerifyRangeRequest internal_verify_range = 44;
+    if (has_internal_verify_range()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_verify_range());
+    }
+
+    // optional .proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 45;
+    if (has_internal_resolve_intent_range()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_resolve_intent_range());
+    }
+
+    // optional .proto.InternalLeaderLeaseRequest internal_leader_lease = 46;
+    if (has_internal_leader_lease()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_leader_lease());
+    }
+
+    // optional .proto.InternalComputeChecksumRequest internal_compute_checksum = 47;
+    if (has_internal_compute_checksum()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_compute_checksum());
+    }
+
+  }
+  if (_has_bits_[32 / 32] & (0xffu << (32 % 32))) {
+    // optional .proto.InternalGetHistoryRequest internal_get_history = 48;
+    if (has_internal_get_history()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_get_history());
+    }
+
+    // optional .proto.InternalBatchGetRequest internal_batch_get = 49;
+    if (has_internal_batch_get()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          this->internal_batch_get());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -6401,20 +17335,26 @@ void InternalRaftCommandUnion::MergeFrom(const InternalRaftCommandUnion& from) {
     if (from.has_enqueue_message()) {
       mutable_enqueue_message()->::proto::EnqueueMessageRequest::MergeFrom(from.enqueue_message());
     }
+    if (from.has_reverse_scan()) {
+      mutable_reverse_scan()->::proto::ReverseScanRequest::MergeFrom(from.reverse_scan());
+    }
+    if (from.has_conditional_delete()) {
+      mutable_conditional_delete()->::proto::ConditionalDeleteRequest::MergeFrom(from.conditional_delete());
+    }
     if (from.has_batch()) {
       mutable_batch()->::proto::BatchRequest::MergeFrom(from.batch());
     }
     if (from.has_internal_range_lookup()) {
       mutable_internal_range_lookup()->::proto::InternalRangeLookupRequest::MergeFrom(from.internal_range_lookup());
     }
+  }
+  if (from._has_bits_[16 / 32] & (0xffu << (16 % 32))) {
     if (from.has_internal_heartbeat_txn()) {
       mutable_internal_heartbeat_txn()->::proto::InternalHeartbeatTxnRequest::MergeFrom(from.internal_heartbeat_txn());
     }
     if (from.has_internal_push_txn()) {
       mutable_internal_push_txn()->::proto::InternalPushTxnRequest::MergeFrom(from.internal_push_txn());
     }
-  }
-  if (from._has_bits_[16 / 32] & (0xffu << (16 % 32))) {
     if (from.has_internal_resolve_intent()) {
       mutable_internal_resolve_intent()->::proto::InternalResolveIntentRequest::MergeFrom(from.internal_resolve_intent());
     }
@@ -6427,6 +17367,46 @@ void InternalRaftCommandUnion::MergeFrom(const InternalRaftCommandUnion& from) {
     if (from.has_internal_gc()) {
       mutable_internal_gc()->::proto::InternalGCRequest::MergeFrom(from.internal_gc());
     }
+    if (from.has_internal_begin_transaction()) {
+      mutable_internal_begin_transaction()->::proto::InternalBeginTransactionRequest::MergeFrom(from.internal_begin_transaction());
+    }
+    if (from.has_internal_scan_intents()) {
+      mutable_internal_scan_intents()->::proto::InternalScanIntentsRequest::MergeFrom(from.internal_scan_intents());
+    }
+  }
+  if (from._has_bits_[24 / 32] & (0xffu << (24 % 32))) {
+    if (from.has_internal_inspect_timestamp_cache()) {
+      mutable_internal_inspect_timestamp_cache()->::proto::InternalInspectTimestampCacheRequest::MergeFrom(from.internal_inspect_timestamp_cache());
+    }
+    if (from.has_internal_get_transaction()) {
+      mutable_internal_get_transaction()->::proto::InternalGetTransactionRequest::MergeFrom(from.internal_get_transaction());
+    }
+    if (from.has_internal_put_if_absent()) {
+      mutable_internal_put_if_absent()->::proto::InternalPutIfAbsentRequest::MergeFrom(from.internal_put_if_absent());
+    }
+    if (from.has_internal_range_key_bounds()) {
+      mutable_internal_range_key_bounds()->::proto::InternalRangeKeyBoundsRequest::MergeFrom(from.internal_range_key_bounds());
+    }
+    if (from.has_internal_verify_range()) {
+      mutable_internal_verify_range()->::proto::InternalVerifyRangeRequest::MergeFrom(from.internal_verify_range());
+    }
+    if (from.has_internal_resolve_intent_range()) {
+      mutable_internal_resolve_intent_range()->::proto::InternalResolveIntentRangeRequest::MergeFrom(from.internal_resolve_intent_range());
+    }
+    if (from.has_internal_leader_lease()) {
+      mutable_internal_leader_lease()->::proto::InternalLeaderLeaseRequest::MergeFrom(from.internal_leader_lease());
+    }
+    if (from.has_internal_compute_checksum()) {
+      mutable_internal_compute_checksum()->::proto::InternalComputeChecksumRequest::MergeFrom(from.internal_compute_checksum());
+    }
+  }
+  if (from._has_bits_[32 / 32] & (0xffu << (32 % 32))) {
+    if (from.has_internal_get_history()) {
+      mutable_internal_get_history()->::proto::InternalGetHistoryRequest::MergeFrom(from.internal_get_history());
+    }
+    if (from.has_internal_batch_get()) {
+      mutable_internal_batch_get()->::proto::InternalBatchGetRequest::MergeFrom(from.internal_batch_get());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -6462,6 +17442,8 @@ void InternalRaftCommandUnion::Swap(InternalRaftCommandUnion* other) {
     std::swap(reap_queue_, other->reap_queue_);
     std::swap(enqueue_update_, other->enqueue_update_);
     std::swap(enqueue_message_, other->enqueue_message_);
+    std::swap(reverse_scan_, other->reverse_scan_);
+    std::swap(conditional_delete_, other->conditional_delete_);
     std::swap(batch_, other->batch_);
     std::swap(internal_range_lookup_, other->internal_range_lookup_);
     std::swap(internal_heartbeat_txn_, other->internal_heartbeat_txn_);
@@ -6470,7 +17452,20 @@ void InternalRaftCommandUnion::Swap(InternalRaftCommandUnion* other) {
     std::swap(internal_merge_response_, other->internal_merge_response_);
     std::swap(internal_truncate_log_, other->internal_truncate_log_);
     std::swap(internal_gc_, other->internal_gc_);
+    std::swap(internal_begin_transaction_, other->internal_begin_transaction_);
+    std::swap(internal_scan_intents_, other->internal_scan_intents_);
+    std::swap(internal_inspect_timestamp_cache_, other->internal_inspect_timestamp_cache_);
+    std::swap(internal_get_transaction_, other->internal_get_transaction_);
+    std::swap(internal_put_if_absent_, other->internal_put_if_absent_);
+    std::swap(internal_range_key_bounds_, other->internal_range_key_bounds_);
+    std::swap(internal_verify_range_, other->internal_verify_range_);
+    std::swap(internal_resolve_intent_range_, other->internal_resolve_intent_range_);
+    std::swap(internal_leader_lease_, other->internal_leader_lease_);
+    std::swap(internal_compute_checksum_, other->internal_compute_checksum_);
+    std::swap(internal_get_history_, other->internal_get_history_);
+    std::swap(internal_batch_get_, other->internal_batch_get_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
+    std::swap(_has_bits_[1], other->_has_bits_[1]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
   }
@@ -6490,6 +17485,8 @@ void InternalRaftCommandUnion::Swap(InternalRaftCommandUnion* other) {
 #ifndef _MSC_VER
 const int InternalRaftCommand::kRaftIdFieldNumber;
 const int InternalRaftCommand::kCmdFieldNumber;
+const int InternalRaftCommand::kGenerationFieldNumber;
+const int InternalRaftCommand::kLeaseEpochFieldNumber;
 #endif  // !_MSC_VER
 
 InternalRaftCommand::InternalRaftCommand()
@@ -6513,6 +17510,8 @@ void InternalRaftCommand::SharedCtor() {
   _cached_size_ = 0;
   raft_id_ = GOOGLE_LONGLONG(0);
   cmd_ = NULL;
+  generation_ = GOOGLE_LONGLONG(0);
+  lease_epoch_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -6549,12 +17548,27 @@ InternalRaftCommand* InternalRaftCommand::New() const {
 }
 
 void InternalRaftCommand::Clear() {
-  if (_has_bits_[0 / 32] & 3) {
+#define OFFSET_OF_FIELD_(f) (reinterpret_cast<char*>(      \
+  &reinterpret_cast<InternalRaftCommand*>(16)->f) - \
+   reinterpret_cast<char*>(16))
+
+#define ZR_(first, last) do {                              \
+    size_t f = OFFSET_OF_FIELD_(first);                    \
+    size_t n = OFFSET_OF_FIELD_(last) - f + sizeof(last);  \
+    ::memset(&first, 0, n);                                \
+  } while (0)
+
+  if (_has_bits_[0 / 32] & 15) {
+    ZR_(generation_, lease_epoch_);
     raft_id_ = GOOGLE_LONGLONG(0);
     if (has_cmd()) {
       if (cmd_ != NULL) cmd_->::proto::InternalRaftCommandUnion::Clear();
     }
   }
+
+#undef OFFSET_OF_FIELD_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   mutable_unknown_fields()->Clear();
 }
@@ -6592,6 +17606,36 @@ bool InternalRaftCommand::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(32)) goto parse_generation;
+        break;
+      }
+
+      // optional int64 generation = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_generation:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &generation_)));
+          set_has_generation();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_lease_epoch;
+        break;
+      }
+
+      // optional int64 lease_epoch = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_lease_epoch:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &lease_epoch_)));
+          set_has_lease_epoch();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6632,6 +17676,16 @@ void InternalRaftCommand::SerializeWithCachedSizes(
       3, this->cmd(), output);
   }
 
+  // optional int64 generation = 4;
+  if (has_generation()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(4, this->generation(), output);
+  }
+
+  // optional int64 lease_epoch = 5;
+  if (has_lease_epoch()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->lease_epoch(), output);
+  }
+
   if (!unknown_fields().empty()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -6654,6 +17708,16 @@ void InternalRaftCommand::SerializeWithCachedSizes(
         3, this->cmd(), target);
   }
 
+  // optional int64 generation = 4;
+  if (has_generation()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(4, this->generation(), target);
+  }
+
+  // optional int64 lease_epoch = 5;
+  if (has_lease_epoch()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->lease_epoch(), target);
+  }
+
   if (!unknown_fields().empty()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -6680,6 +17744,20 @@ int InternalRaftCommand::ByteSize() const {
           this->cmd());
     }
 
+    // optional int64 generation = 4;
+    if (has_generation()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->generation());
+    }
+
+    // optional int64 lease_epoch = 5;
+    if (has_lease_epoch()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->lease_epoch());
+    }
+
   }
   if (!unknown_fields().empty()) {
     total_size +=
@@ -6713,6 +17791,12 @@ void InternalRaftCommand::MergeFrom(const InternalRaftCommand& from) {
     if (from.has_cmd()) {
       mutable_cmd()->::proto::InternalRaftCommandUnion::MergeFrom(from.cmd());
     }
+    if (from.has_generation()) {
+      set_generation(from.generation());
+    }
+    if (from.has_lease_epoch()) {
+      set_lease_epoch(from.lease_epoch());
+    }
   }
   mutable_unknown_fields()->MergeFrom(from.unknown_fields());
 }
@@ -6738,6 +17822,8 @@ void InternalRaftCommand::Swap(InternalRaftCommand* other) {
   if (other != this) {
     std::swap(raft_id_, other->raft_id_);
     std::swap(cmd_, other->cmd_);
+    std::swap(generation_, other->generation_);
+    std::swap(lease_epoch_, other->lease_epoch_);
     std::swap(_has_bits_[0], other->_has_bits_[0]);
     _unknown_fields_.Swap(&other->_unknown_fields_);
     std::swap(_cached_size_, other->_cached_size_);
@@ -53,7 +53,39 @@ class InternalMergeRequest;
 class InternalMergeResponse;
 class InternalTruncateLogRequest;
 class InternalTruncateLogResponse;
+class InternalBeginTransactionRequest;
+class InternalBeginTransactionResponse;
+class InternalTxnIntents;
+class InternalScanIntentsRequest;
+class InternalScanIntentsResponse;
+class InternalInspectTimestampCacheRequest;
+class InternalInspectTimestampCacheResponse;
+class InternalGetTransactionRequest;
+class InternalGetTransactionResponse;
+class InternalPutIfAbsentRequest;
+class InternalPutIfAbsentResponse;
+class ScanResumeToken;
+class AuditEntry;
+class InternalRangeKeyBoundsRequest;
+class InternalRangeKeyBoundsResponse;
+class InternalVerifyRangeRequest;
+class InternalVerifyRangeResponse;
+class InternalResolveIntentRangeRequest;
+class InternalResolveIntentRangeResponse;
+class InternalLeaderLeaseRequest;
+class InternalLeaderLeaseResponse;
+class InternalComputeChecksumRequest;
+class InternalComputeChecksumResponse;
+class MVCCVersion;
+class BatchGetValue;
+class InternalGetHistoryRequest;
+class InternalGetHistoryResponse;
+class InternalBatchGetRequest;
+class InternalBatchGetResponse;
 class ReadWriteCmdResponse;
+class ResponseCacheEntry;
+class Lease;
+class LeaseTransfer;
 class InternalRaftCommandUnion;
 class InternalRaftCommand;
 class InternalTimeSeriesData;
@@ -607,12 +639,39 @@ class InternalGCRequest : public ::google::protobuf::Message {
   inline ::google::protobuf::RepeatedPtrField< ::proto::InternalGCRequest_GCKey >*
       mutable_keys();
 
+  // repeated bytes txn_keys = 4;
+  inline int txn_keys_size() const;
+  inline void clear_txn_keys();
+  static const int kTxnKeysFieldNumber = 4;
+  inline const ::std::string& txn_keys(int index) const;
+  inline ::std::string* mutable_txn_keys(int index);
+  inline void set_txn_keys(int index, const ::std::string& value);
+  inline void set_txn_keys(int index, const char* value);
+  inline void set_txn_keys(int index, const void* value, size_t size);
+  inline ::std::string* add_txn_keys();
+  inline void add_txn_keys(const ::std::string& value);
+  inline void add_txn_keys(const char* value);
+  inline void add_txn_keys(const void* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& txn_keys() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_txn_keys();
+
+  // optional .proto.Timestamp txn_threshold = 5;
+  inline bool has_txn_threshold() const;
+  inline void clear_txn_threshold();
+  static const int kTxnThresholdFieldNumber = 5;
+  inline const ::proto::Timestamp& txn_threshold() const;
+  inline ::proto::Timestamp* mutable_txn_threshold();
+  inline ::proto::Timestamp* release_txn_threshold();
+  inline void set_allocated_txn_threshold(::proto::Timestamp* txn_threshold);
+
   // @@protoc_insertion_point(class_scope:proto.InternalGCRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_gc_meta();
   inline void clear_has_gc_meta();
+  inline void set_has_txn_threshold();
+  inline void clear_has_txn_threshold();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
@@ -621,6 +680,8 @@ class InternalGCRequest : public ::google::protobuf::Message {
   ::proto::RequestHeader* header_;
   ::proto::GCMetadata* gc_meta_;
   ::google::protobuf::RepeatedPtrField< ::proto::InternalGCRequest_GCKey > keys_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> txn_keys_;
+  ::proto::Timestamp* txn_threshold_;
   friend void  protobuf_AddDesc_internal_2eproto();
   friend void protobuf_AssignDesc_internal_2eproto();
   friend void protobuf_ShutdownFile_internal_2eproto();
@@ -1415,14 +1476,14 @@ class InternalTruncateLogResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class ReadWriteCmdResponse : public ::google::protobuf::Message {
+class InternalBeginTransactionRequest : public ::google::protobuf::Message {
  public:
-  ReadWriteCmdResponse();
-  virtual ~ReadWriteCmdResponse();
+  InternalBeginTransactionRequest();
+  virtual ~InternalBeginTransactionRequest();
 
-  ReadWriteCmdResponse(const ReadWriteCmdResponse& from);
+  InternalBeginTransactionRequest(const InternalBeginTransactionRequest& from);
 
-  inline ReadWriteCmdResponse& operator=(const ReadWriteCmdResponse& from) {
+  inline InternalBeginTransactionRequest& operator=(const InternalBeginTransactionRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1436,17 +1497,17 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ReadWriteCmdResponse& default_instance();
+  static const InternalBeginTransactionRequest& default_instance();
 
-  void Swap(ReadWriteCmdResponse* other);
+  void Swap(InternalBeginTransactionRequest* other);
 
   // implements Message ----------------------------------------------
 
-  ReadWriteCmdResponse* New() const;
+  InternalBeginTransactionRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ReadWriteCmdResponse& from);
-  void MergeFrom(const ReadWriteCmdResponse& from);
+  void CopyFrom(const InternalBeginTransactionRequest& from);
+  void MergeFrom(const InternalBeginTransactionRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1468,210 +1529,123 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .proto.PutResponse put = 1;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 1;
-  inline const ::proto::PutResponse& put() const;
-  inline ::proto::PutResponse* mutable_put();
-  inline ::proto::PutResponse* release_put();
-  inline void set_allocated_put(::proto::PutResponse* put);
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
 
-  // optional .proto.ConditionalPutResponse conditional_put = 2;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 2;
-  inline const ::proto::ConditionalPutResponse& conditional_put() const;
-  inline ::proto::ConditionalPutResponse* mutable_conditional_put();
-  inline ::proto::ConditionalPutResponse* release_conditional_put();
-  inline void set_allocated_conditional_put(::proto::ConditionalPutResponse* conditional_put);
+  // @@protoc_insertion_point(class_scope:proto.InternalBeginTransactionRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
 
-  // optional .proto.IncrementResponse increment = 3;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 3;
-  inline const ::proto::IncrementResponse& increment() const;
-  inline ::proto::IncrementResponse* mutable_increment();
-  inline ::proto::IncrementResponse* release_increment();
-  inline void set_allocated_increment(::proto::IncrementResponse* increment);
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
-  // optional .proto.DeleteResponse delete = 4;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 4;
-  inline const ::proto::DeleteResponse& delete_() const;
-  inline ::proto::DeleteResponse* mutable_delete_();
-  inline ::proto::DeleteResponse* release_delete_();
-  inline void set_allocated_delete_(::proto::DeleteResponse* delete_);
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
 
-  // optional .proto.DeleteRangeResponse delete_range = 5;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 5;
-  inline const ::proto::DeleteRangeResponse& delete_range() const;
-  inline ::proto::DeleteRangeResponse* mutable_delete_range();
-  inline ::proto::DeleteRangeResponse* release_delete_range();
-  inline void set_allocated_delete_range(::proto::DeleteRangeResponse* delete_range);
+  void InitAsDefaultInstance();
+  static InternalBeginTransactionRequest* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional .proto.EndTransactionResponse end_transaction = 6;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 6;
-  inline const ::proto::EndTransactionResponse& end_transaction() const;
-  inline ::proto::EndTransactionResponse* mutable_end_transaction();
-  inline ::proto::EndTransactionResponse* release_end_transaction();
-  inline void set_allocated_end_transaction(::proto::EndTransactionResponse* end_transaction);
+class InternalBeginTransactionResponse : public ::google::protobuf::Message {
+ public:
+  InternalBeginTransactionResponse();
+  virtual ~InternalBeginTransactionResponse();
 
-  // optional .proto.ReapQueueResponse reap_queue = 7;
-  inline bool has_reap_queue() const;
-  inline void clear_reap_queue();
-  static const int kReapQueueFieldNumber = 7;
-  inline const ::proto::ReapQueueResponse& reap_queue() const;
-  inline ::proto::ReapQueueResponse* mutable_reap_queue();
-  inline ::proto::ReapQueueResponse* release_reap_queue();
-  inline void set_allocated_reap_queue(::proto::ReapQueueResponse* reap_queue);
+  InternalBeginTransactionResponse(const InternalBeginTransactionResponse& from);
 
-  // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
-  inline bool has_enqueue_update() const;
-  inline void clear_enqueue_update();
-  static const int kEnqueueUpdateFieldNumber = 8;
-  inline const ::proto::EnqueueUpdateResponse& enqueue_update() const;
-  inline ::proto::EnqueueUpdateResponse* mutable_enqueue_update();
-  inline ::proto::EnqueueUpdateResponse* release_enqueue_update();
-  inline void set_allocated_enqueue_update(::proto::EnqueueUpdateResponse* enqueue_update);
+  inline InternalBeginTransactionResponse& operator=(const InternalBeginTransactionResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional .proto.EnqueueMessageResponse enqueue_message = 9;
-  inline bool has_enqueue_message() const;
-  inline void clear_enqueue_message();
-  static const int kEnqueueMessageFieldNumber = 9;
-  inline const ::proto::EnqueueMessageResponse& enqueue_message() const;
-  inline ::proto::EnqueueMessageResponse* mutable_enqueue_message();
-  inline ::proto::EnqueueMessageResponse* release_enqueue_message();
-  inline void set_allocated_enqueue_message(::proto::EnqueueMessageResponse* enqueue_message);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
 
-  // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-  inline bool has_internal_heartbeat_txn() const;
-  inline void clear_internal_heartbeat_txn();
-  static const int kInternalHeartbeatTxnFieldNumber = 10;
-  inline const ::proto::InternalHeartbeatTxnResponse& internal_heartbeat_txn() const;
-  inline ::proto::InternalHeartbeatTxnResponse* mutable_internal_heartbeat_txn();
-  inline ::proto::InternalHeartbeatTxnResponse* release_internal_heartbeat_txn();
-  inline void set_allocated_internal_heartbeat_txn(::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn);
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
 
-  // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
-  inline bool has_internal_push_txn() const;
-  inline void clear_internal_push_txn();
-  static const int kInternalPushTxnFieldNumber = 11;
-  inline const ::proto::InternalPushTxnResponse& internal_push_txn() const;
-  inline ::proto::InternalPushTxnResponse* mutable_internal_push_txn();
-  inline ::proto::InternalPushTxnResponse* release_internal_push_txn();
-  inline void set_allocated_internal_push_txn(::proto::InternalPushTxnResponse* internal_push_txn);
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalBeginTransactionResponse& default_instance();
 
-  // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-  inline bool has_internal_resolve_intent() const;
-  inline void clear_internal_resolve_intent();
-  static const int kInternalResolveIntentFieldNumber = 12;
-  inline const ::proto::InternalResolveIntentResponse& internal_resolve_intent() const;
-  inline ::proto::InternalResolveIntentResponse* mutable_internal_resolve_intent();
-  inline ::proto::InternalResolveIntentResponse* release_internal_resolve_intent();
-  inline void set_allocated_internal_resolve_intent(::proto::InternalResolveIntentResponse* internal_resolve_intent);
+  void Swap(InternalBeginTransactionResponse* other);
 
-  // optional .proto.InternalMergeResponse internal_merge = 13;
-  inline bool has_internal_merge() const;
-  inline void clear_internal_merge();
-  static const int kInternalMergeFieldNumber = 13;
-  inline const ::proto::InternalMergeResponse& internal_merge() const;
-  inline ::proto::InternalMergeResponse* mutable_internal_merge();
-  inline ::proto::InternalMergeResponse* release_internal_merge();
-  inline void set_allocated_internal_merge(::proto::InternalMergeResponse* internal_merge);
+  // implements Message ----------------------------------------------
 
-  // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
-  inline bool has_internal_truncate_log() const;
-  inline void clear_internal_truncate_log();
-  static const int kInternalTruncateLogFieldNumber = 14;
-  inline const ::proto::InternalTruncateLogResponse& internal_truncate_log() const;
-  inline ::proto::InternalTruncateLogResponse* mutable_internal_truncate_log();
-  inline ::proto::InternalTruncateLogResponse* release_internal_truncate_log();
-  inline void set_allocated_internal_truncate_log(::proto::InternalTruncateLogResponse* internal_truncate_log);
+  InternalBeginTransactionResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalBeginTransactionResponse& from);
+  void MergeFrom(const InternalBeginTransactionResponse& from);
+  void Clear();
+  bool IsInitialized() const;
 
-  // optional .proto.InternalGCResponse internal_gc = 15;
-  inline bool has_internal_gc() const;
-  inline void clear_internal_gc();
-  static const int kInternalGcFieldNumber = 15;
-  inline const ::proto::InternalGCResponse& internal_gc() const;
-  inline ::proto::InternalGCResponse* mutable_internal_gc();
-  inline ::proto::InternalGCResponse* release_internal_gc();
-  inline void set_allocated_internal_gc(::proto::InternalGCResponse* internal_gc);
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
 
-  // @@protoc_insertion_point(class_scope:proto.ReadWriteCmdResponse)
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalBeginTransactionResponse)
  private:
-  inline void set_has_put();
-  inline void clear_has_put();
-  inline void set_has_conditional_put();
-  inline void clear_has_conditional_put();
-  inline void set_has_increment();
-  inline void clear_has_increment();
-  inline void set_has_delete_();
-  inline void clear_has_delete_();
-  inline void set_has_delete_range();
-  inline void clear_has_delete_range();
-  inline void set_has_end_transaction();
-  inline void clear_has_end_transaction();
-  inline void set_has_reap_queue();
-  inline void clear_has_reap_queue();
-  inline void set_has_enqueue_update();
-  inline void clear_has_enqueue_update();
-  inline void set_has_enqueue_message();
-  inline void clear_has_enqueue_message();
-  inline void set_has_internal_heartbeat_txn();
-  inline void clear_has_internal_heartbeat_txn();
-  inline void set_has_internal_push_txn();
-  inline void clear_has_internal_push_txn();
-  inline void set_has_internal_resolve_intent();
-  inline void clear_has_internal_resolve_intent();
-  inline void set_has_internal_merge();
-  inline void clear_has_internal_merge();
-  inline void set_has_internal_truncate_log();
-  inline void clear_has_internal_truncate_log();
-  inline void set_has_internal_gc();
-  inline void clear_has_internal_gc();
+  inline void set_has_header();
+  inline void clear_has_header();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::proto::PutResponse* put_;
-  ::proto::ConditionalPutResponse* conditional_put_;
-  ::proto::IncrementResponse* increment_;
-  ::proto::DeleteResponse* delete__;
-  ::proto::DeleteRangeResponse* delete_range_;
-  ::proto::EndTransactionResponse* end_transaction_;
-  ::proto::ReapQueueResponse* reap_queue_;
-  ::proto::EnqueueUpdateResponse* enqueue_update_;
-  ::proto::EnqueueMessageResponse* enqueue_message_;
-  ::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
-  ::proto::InternalPushTxnResponse* internal_push_txn_;
-  ::proto::InternalResolveIntentResponse* internal_resolve_intent_;
-  ::proto::InternalMergeResponse* internal_merge_;
-  ::proto::InternalTruncateLogResponse* internal_truncate_log_;
-  ::proto::InternalGCResponse* internal_gc_;
+  ::proto::ResponseHeader* header_;
   friend void  protobuf_AddDesc_internal_2eproto();
   friend void protobuf_AssignDesc_internal_2eproto();
   friend void protobuf_ShutdownFile_internal_2eproto();
 
   void InitAsDefaultInstance();
-  static ReadWriteCmdResponse* default_instance_;
+  static InternalBeginTransactionResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalRaftCommandUnion : public ::google::protobuf::Message {
+class InternalTxnIntents : public ::google::protobuf::Message {
  public:
-  InternalRaftCommandUnion();
-  virtual ~InternalRaftCommandUnion();
+  InternalTxnIntents();
+  virtual ~InternalTxnIntents();
 
-  InternalRaftCommandUnion(const InternalRaftCommandUnion& from);
+  InternalTxnIntents(const InternalTxnIntents& from);
 
-  inline InternalRaftCommandUnion& operator=(const InternalRaftCommandUnion& from) {
+  inline InternalTxnIntents& operator=(const InternalTxnIntents& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1685,17 +1659,17 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalRaftCommandUnion& default_instance();
+  static const InternalTxnIntents& default_instance();
 
-  void Swap(InternalRaftCommandUnion* other);
+  void Swap(InternalTxnIntents* other);
 
   // implements Message ----------------------------------------------
 
-  InternalRaftCommandUnion* New() const;
+  InternalTxnIntents* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalRaftCommandUnion& from);
-  void MergeFrom(const InternalRaftCommandUnion& from);
+  void CopyFrom(const InternalTxnIntents& from);
+  void MergeFrom(const InternalTxnIntents& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -1717,270 +1691,59 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .proto.ContainsRequest contains = 1;
-  inline bool has_contains() const;
-  inline void clear_contains();
-  static const int kContainsFieldNumber = 1;
-  inline const ::proto::ContainsRequest& contains() const;
-  inline ::proto::ContainsRequest* mutable_contains();
-  inline ::proto::ContainsRequest* release_contains();
-  inline void set_allocated_contains(::proto::ContainsRequest* contains);
-
-  // optional .proto.GetRequest get = 2;
-  inline bool has_get() const;
-  inline void clear_get();
-  static const int kGetFieldNumber = 2;
-  inline const ::proto::GetRequest& get() const;
-  inline ::proto::GetRequest* mutable_get();
-  inline ::proto::GetRequest* release_get();
-  inline void set_allocated_get(::proto::GetRequest* get);
-
-  // optional .proto.PutRequest put = 3;
-  inline bool has_put() const;
-  inline void clear_put();
-  static const int kPutFieldNumber = 3;
-  inline const ::proto::PutRequest& put() const;
-  inline ::proto::PutRequest* mutable_put();
-  inline ::proto::PutRequest* release_put();
-  inline void set_allocated_put(::proto::PutRequest* put);
-
-  // optional .proto.ConditionalPutRequest conditional_put = 4;
-  inline bool has_conditional_put() const;
-  inline void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  inline const ::proto::ConditionalPutRequest& conditional_put() const;
-  inline ::proto::ConditionalPutRequest* mutable_conditional_put();
-  inline ::proto::ConditionalPutRequest* release_conditional_put();
-  inline void set_allocated_conditional_put(::proto::ConditionalPutRequest* conditional_put);
-
-  // optional .proto.IncrementRequest increment = 5;
-  inline bool has_increment() const;
-  inline void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  inline const ::proto::IncrementRequest& increment() const;
-  inline ::proto::IncrementRequest* mutable_increment();
-  inline ::proto::IncrementRequest* release_increment();
-  inline void set_allocated_increment(::proto::IncrementRequest* increment);
-
-  // optional .proto.DeleteRequest delete = 6;
-  inline bool has_delete_() const;
-  inline void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  inline const ::proto::DeleteRequest& delete_() const;
-  inline ::proto::DeleteRequest* mutable_delete_();
-  inline ::proto::DeleteRequest* release_delete_();
-  inline void set_allocated_delete_(::proto::DeleteRequest* delete_);
-
-  // optional .proto.DeleteRangeRequest delete_range = 7;
-  inline bool has_delete_range() const;
-  inline void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  inline const ::proto::DeleteRangeRequest& delete_range() const;
-  inline ::proto::DeleteRangeRequest* mutable_delete_range();
-  inline ::proto::DeleteRangeRequest* release_delete_range();
-  inline void set_allocated_delete_range(::proto::DeleteRangeRequest* delete_range);
-
-  // optional .proto.ScanRequest scan = 8;
-  inline bool has_scan() const;
-  inline void clear_scan();
-  static const int kScanFieldNumber = 8;
-  inline const ::proto::ScanRequest& scan() const;
-  inline ::proto::ScanRequest* mutable_scan();
-  inline ::proto::ScanRequest* release_scan();
-  inline void set_allocated_scan(::proto::ScanRequest* scan);
-
-  // optional .proto.EndTransactionRequest end_transaction = 9;
-  inline bool has_end_transaction() const;
-  inline void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  inline const ::proto::EndTransactionRequest& end_transaction() const;
-  inline ::proto::EndTransactionRequest* mutable_end_transaction();
-  inline ::proto::EndTransactionRequest* release_end_transaction();
-  inline void set_allocated_end_transaction(::proto::EndTransactionRequest* end_transaction);
-
-  // optional .proto.ReapQueueRequest reap_queue = 10;
-  inline bool has_reap_queue() const;
-  inline void clear_reap_queue();
-  static const int kReapQueueFieldNumber = 10;
-  inline const ::proto::ReapQueueRequest& reap_queue() const;
-  inline ::proto::ReapQueueRequest* mutable_reap_queue();
-  inline ::proto::ReapQueueRequest* release_reap_queue();
-  inline void set_allocated_reap_queue(::proto::ReapQueueRequest* reap_queue);
-
-  // optional .proto.EnqueueUpdateRequest enqueue_update = 11;
-  inline bool has_enqueue_update() const;
-  inline void clear_enqueue_update();
-  static const int kEnqueueUpdateFieldNumber = 11;
-  inline const ::proto::EnqueueUpdateRequest& enqueue_update() const;
-  inline ::proto::EnqueueUpdateRequest* mutable_enqueue_update();
-  inline ::proto::EnqueueUpdateRequest* release_enqueue_update();
-  inline void set_allocated_enqueue_update(::proto::EnqueueUpdateRequest* enqueue_update);
-
-  // optional .proto.EnqueueMessageRequest enqueue_message = 12;
-  inline bool has_enqueue_message() const;
-  inline void clear_enqueue_message();
-  static const int kEnqueueMessageFieldNumber = 12;
-  inline const ::proto::EnqueueMessageRequest& enqueue_message() const;
-  inline ::proto::EnqueueMessageRequest* mutable_enqueue_message();
-  inline ::proto::EnqueueMessageRequest* release_enqueue_message();
-  inline void set_allocated_enqueue_message(::proto::EnqueueMessageRequest* enqueue_message);
-
-  // optional .proto.BatchRequest batch = 30;
-  inline bool has_batch() const;
-  inline void clear_batch();
-  static const int kBatchFieldNumber = 30;
-  inline const ::proto::BatchRequest& batch() const;
-  inline ::proto::BatchRequest* mutable_batch();
-  inline ::proto::BatchRequest* release_batch();
-  inline void set_allocated_batch(::proto::BatchRequest* batch);
-
-  // optional .proto.InternalRangeLookupRequest internal_range_lookup = 31;
-  inline bool has_internal_range_lookup() const;
-  inline void clear_internal_range_lookup();
-  static const int kInternalRangeLookupFieldNumber = 31;
-  inline const ::proto::InternalRangeLookupRequest& internal_range_lookup() const;
-  inline ::proto::InternalRangeLookupRequest* mutable_internal_range_lookup();
-  inline ::proto::InternalRangeLookupRequest* release_internal_range_lookup();
-  inline void set_allocated_internal_range_lookup(::proto::InternalRangeLookupRequest* internal_range_lookup);
-
-  // optional .proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
-  inline bool has_internal_heartbeat_txn() const;
-  inline void clear_internal_heartbeat_txn();
-  static const int kInternalHeartbeatTxnFieldNumber = 32;
-  inline const ::proto::InternalHeartbeatTxnRequest& internal_heartbeat_txn() const;
-  inline ::proto::InternalHeartbeatTxnRequest* mutable_internal_heartbeat_txn();
-  inline ::proto::InternalHeartbeatTxnRequest* release_internal_heartbeat_txn();
-  inline void set_allocated_internal_heartbeat_txn(::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn);
-
-  // optional .proto.InternalPushTxnRequest internal_push_txn = 33;
-  inline bool has_internal_push_txn() const;
-  inline void clear_internal_push_txn();
-  static const int kInternalPushTxnFieldNumber = 33;
-  inline const ::proto::InternalPushTxnRequest& internal_push_txn() const;
-  inline ::proto::InternalPushTxnRequest* mutable_internal_push_txn();
-  inline ::proto::InternalPushTxnRequest* release_internal_push_txn();
-  inline void set_allocated_internal_push_txn(::proto::InternalPushTxnRequest* internal_push_txn);
-
-  // optional .proto.InternalResolveIntentRequest internal_resolve_intent = 34;
-  inline bool has_internal_resolve_intent() const;
-  inline void clear_internal_resolve_intent();
-  static const int kInternalResolveIntentFieldNumber = 34;
-  inline const ::proto::InternalResolveIntentRequest& internal_resolve_intent() const;
-  inline ::proto::InternalResolveIntentRequest* mutable_internal_resolve_intent();
-  inline ::proto::InternalResolveIntentRequest* release_internal_resolve_intent();
-  inline void set_allocated_internal_resolve_intent(::proto::InternalResolveIntentRequest* internal_resolve_intent);
-
-  // optional .proto.InternalMergeRequest internal_merge_response = 35;
-  inline bool has_internal_merge_response() const;
-  inline void clear_internal_merge_response();
-  static const int kInternalMergeResponseFieldNumber = 35;
-  inline const ::proto::InternalMergeRequest& internal_merge_response() const;
-  inline ::proto::InternalMergeRequest* mutable_internal_merge_response();
-  inline ::proto::InternalMergeRequest* release_internal_merge_response();
-  inline void set_allocated_internal_merge_response(::proto::InternalMergeRequest* internal_merge_response);
-
-  // optional .proto.InternalTruncateLogRequest internal_truncate_log = 36;
-  inline bool has_internal_truncate_log() const;
-  inline void clear_internal_truncate_log();
-  static const int kInternalTruncateLogFieldNumber = 36;
-  inline const ::proto::InternalTruncateLogRequest& internal_truncate_log() const;
-  inline ::proto::InternalTruncateLogRequest* mutable_internal_truncate_log();
-  inline ::proto::InternalTruncateLogRequest* release_internal_truncate_log();
-  inline void set_allocated_internal_truncate_log(::proto::InternalTruncateLogRequest* internal_truncate_log);
-
-  // optional .proto.InternalGCRequest internal_gc = 37;
-  inline bool has_internal_gc() const;
-  inline void clear_internal_gc();
-  static const int kInternalGcFieldNumber = 37;
-  inline const ::proto::InternalGCRequest& internal_gc() const;
-  inline ::proto::InternalGCRequest* mutable_internal_gc();
-  inline ::proto::InternalGCRequest* release_internal_gc();
-  inline void set_allocated_internal_gc(::proto::InternalGCRequest* internal_gc);
+  // optional .proto.Transaction txn = 1;
+  inline bool has_txn() const;
+  inline void clear_txn();
+  static const int kTxnFieldNumber = 1;
+  inline const ::proto::Transaction& txn() const;
+  inline ::proto::Transaction* mutable_txn();
+  inline ::proto::Transaction* release_txn();
+  inline void set_allocated_txn(::proto::Transaction* txn);
 
-  // @@protoc_insertion_point(class_scope:proto.InternalRaftCommandUnion)
+  // repeated bytes keys = 2;
+  inline int keys_size() const;
+  inline void clear_keys();
+  static const int kKeysFieldNumber = 2;
+  inline const ::std::string& keys(int index) const;
+  inline ::std::string* mutable_keys(int index);
+  inline void set_keys(int index, const ::std::string& value);
+  inline void set_keys(int index, const char* value);
+  inline void set_keys(int index, const void* value, size_t size);
+  inline ::std::string* add_keys();
+  inline void add_keys(const ::std::string& value);
+  inline void add_keys(const char* value);
+  inline void add_keys(const void* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& keys() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_keys();
+
+  // @@protoc_insertion_point(class_scope:proto.InternalTxnIntents)
  private:
-  inline void set_has_contains();
-  inline void clear_has_contains();
-  inline void set_has_get();
-  inline void clear_has_get();
-  inline void set_has_put();
-  inline void clear_has_put();
-  inline void set_has_conditional_put();
-  inline void clear_has_conditional_put();
-  inline void set_has_increment();
-  inline void clear_has_increment();
-  inline void set_has_delete_();
-  inline void clear_has_delete_();
-  inline void set_has_delete_range();
-  inline void clear_has_delete_range();
-  inline void set_has_scan();
-  inline void clear_has_scan();
-  inline void set_has_end_transaction();
-  inline void clear_has_end_transaction();
-  inline void set_has_reap_queue();
-  inline void clear_has_reap_queue();
-  inline void set_has_enqueue_update();
-  inline void clear_has_enqueue_update();
-  inline void set_has_enqueue_message();
-  inline void clear_has_enqueue_message();
-  inline void set_has_batch();
-  inline void clear_has_batch();
-  inline void set_has_internal_range_lookup();
-  inline void clear_has_internal_range_lookup();
-  inline void set_has_internal_heartbeat_txn();
-  inline void clear_has_internal_heartbeat_txn();
-  inline void set_has_internal_push_txn();
-  inline void clear_has_internal_push_txn();
-  inline void set_has_internal_resolve_intent();
-  inline void clear_has_internal_resolve_intent();
-  inline void set_has_internal_merge_response();
-  inline void clear_has_internal_merge_response();
-  inline void set_has_internal_truncate_log();
-  inline void clear_has_internal_truncate_log();
-  inline void set_has_internal_gc();
-  inline void clear_has_internal_gc();
+  inline void set_has_txn();
+  inline void clear_has_txn();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::proto::ContainsRequest* contains_;
-  ::proto::GetRequest* get_;
-  ::proto::PutRequest* put_;
-  ::proto::ConditionalPutRequest* conditional_put_;
-  ::proto::IncrementRequest* increment_;
-  ::proto::DeleteRequest* delete__;
-  ::proto::DeleteRangeRequest* delete_range_;
-  ::proto::ScanRequest* scan_;
-  ::proto::EndTransactionRequest* end_transaction_;
-  ::proto::ReapQueueRequest* reap_queue_;
-  ::proto::EnqueueUpdateRequest* enqueue_update_;
-  ::proto::EnqueueMessageRequest* enqueue_message_;
-  ::proto::BatchRequest* batch_;
-  ::proto::InternalRangeLookupRequest* internal_range_lookup_;
-  ::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
-  ::proto::InternalPushTxnRequest* internal_push_txn_;
-  ::proto::InternalResolveIntentRequest* internal_resolve_intent_;
-  ::proto::InternalMergeRequest* internal_merge_response_;
-  ::proto::InternalTruncateLogRequest* internal_truncate_log_;
-  ::proto::InternalGCRequest* internal_gc_;
+  ::proto::Transaction* txn_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> keys_;
   friend void  protobuf_AddDesc_internal_2eproto();
   friend void protobuf_AssignDesc_internal_2eproto();
   friend void protobuf_ShutdownFile_internal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalRaftCommandUnion* default_instance_;
+  static InternalTxnIntents* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalRaftCommand : public ::google::protobuf::Message {
+class InternalScanIntentsRequest : public ::google::protobuf::Message {
  public:
-  InternalRaftCommand();
-  virtual ~InternalRaftCommand();
+  InternalScanIntentsRequest();
+  virtual ~InternalScanIntentsRequest();
 
-  InternalRaftCommand(const InternalRaftCommand& from);
+  InternalScanIntentsRequest(const InternalScanIntentsRequest& from);
 
-  inline InternalRaftCommand& operator=(const InternalRaftCommand& from) {
+  inline InternalScanIntentsRequest& operator=(const InternalScanIntentsRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -1994,17 +1757,17 @@ class InternalRaftCommand : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalRaftCommand& default_instance();
+  static const InternalScanIntentsRequest& default_instance();
 
-  void Swap(InternalRaftCommand* other);
+  void Swap(InternalScanIntentsRequest* other);
 
   // implements Message ----------------------------------------------
 
-  InternalRaftCommand* New() const;
+  InternalScanIntentsRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalRaftCommand& from);
-  void MergeFrom(const InternalRaftCommand& from);
+  void CopyFrom(const InternalScanIntentsRequest& from);
+  void MergeFrom(const InternalScanIntentsRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2026,52 +1789,42 @@ class InternalRaftCommand : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int64 raft_id = 2;
-  inline bool has_raft_id() const;
-  inline void clear_raft_id();
-  static const int kRaftIdFieldNumber = 2;
-  inline ::google::protobuf::int64 raft_id() const;
-  inline void set_raft_id(::google::protobuf::int64 value);
-
-  // optional .proto.InternalRaftCommandUnion cmd = 3;
-  inline bool has_cmd() const;
-  inline void clear_cmd();
-  static const int kCmdFieldNumber = 3;
-  inline const ::proto::InternalRaftCommandUnion& cmd() const;
-  inline ::proto::InternalRaftCommandUnion* mutable_cmd();
-  inline ::proto::InternalRaftCommandUnion* release_cmd();
-  inline void set_allocated_cmd(::proto::InternalRaftCommandUnion* cmd);
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
 
-  // @@protoc_insertion_point(class_scope:proto.InternalRaftCommand)
+  // @@protoc_insertion_point(class_scope:proto.InternalScanIntentsRequest)
  private:
-  inline void set_has_raft_id();
-  inline void clear_has_raft_id();
-  inline void set_has_cmd();
-  inline void clear_has_cmd();
+  inline void set_has_header();
+  inline void clear_has_header();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int64 raft_id_;
-  ::proto::InternalRaftCommandUnion* cmd_;
+  ::proto::RequestHeader* header_;
   friend void  protobuf_AddDesc_internal_2eproto();
   friend void protobuf_AssignDesc_internal_2eproto();
   friend void protobuf_ShutdownFile_internal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalRaftCommand* default_instance_;
+  static InternalScanIntentsRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalTimeSeriesData : public ::google::protobuf::Message {
+class InternalScanIntentsResponse : public ::google::protobuf::Message {
  public:
-  InternalTimeSeriesData();
-  virtual ~InternalTimeSeriesData();
+  InternalScanIntentsResponse();
+  virtual ~InternalScanIntentsResponse();
 
-  InternalTimeSeriesData(const InternalTimeSeriesData& from);
+  InternalScanIntentsResponse(const InternalScanIntentsResponse& from);
 
-  inline InternalTimeSeriesData& operator=(const InternalTimeSeriesData& from) {
+  inline InternalScanIntentsResponse& operator=(const InternalScanIntentsResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2085,17 +1838,17 @@ class InternalTimeSeriesData : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalTimeSeriesData& default_instance();
+  static const InternalScanIntentsResponse& default_instance();
 
-  void Swap(InternalTimeSeriesData* other);
+  void Swap(InternalScanIntentsResponse* other);
 
   // implements Message ----------------------------------------------
 
-  InternalTimeSeriesData* New() const;
+  InternalScanIntentsResponse* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalTimeSeriesData& from);
-  void MergeFrom(const InternalTimeSeriesData& from);
+  void CopyFrom(const InternalScanIntentsResponse& from);
+  void MergeFrom(const InternalScanIntentsResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2117,63 +1870,55 @@ class InternalTimeSeriesData : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int64 start_timestamp_nanos = 1;
-  inline bool has_start_timestamp_nanos() const;
-  inline void clear_start_timestamp_nanos();
-  static const int kStartTimestampNanosFieldNumber = 1;
-  inline ::google::protobuf::int64 start_timestamp_nanos() const;
-  inline void set_start_timestamp_nanos(::google::protobuf::int64 value);
-
-  // optional int64 sample_duration_nanos = 2;
-  inline bool has_sample_duration_nanos() const;
-  inline void clear_sample_duration_nanos();
-  static const int kSampleDurationNanosFieldNumber = 2;
-  inline ::google::protobuf::int64 sample_duration_nanos() const;
-  inline void set_sample_duration_nanos(::google::protobuf::int64 value);
-
-  // repeated .proto.InternalTimeSeriesSample samples = 3;
-  inline int samples_size() const;
-  inline void clear_samples();
-  static const int kSamplesFieldNumber = 3;
-  inline const ::proto::InternalTimeSeriesSample& samples(int index) const;
-  inline ::proto::InternalTimeSeriesSample* mutable_samples(int index);
-  inline ::proto::InternalTimeSeriesSample* add_samples();
-  inline const ::google::protobuf::RepeatedPtrField< ::proto::InternalTimeSeriesSample >&
-      samples() const;
-  inline ::google::protobuf::RepeatedPtrField< ::proto::InternalTimeSeriesSample >*
-      mutable_samples();
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
 
-  // @@protoc_insertion_point(class_scope:proto.InternalTimeSeriesData)
+  // repeated .proto.InternalTxnIntents txn_intents = 2;
+  inline int txn_intents_size() const;
+  inline void clear_txn_intents();
+  static const int kTxnIntentsFieldNumber = 2;
+  inline const ::proto::InternalTxnIntents& txn_intents(int index) const;
+  inline ::proto::InternalTxnIntents* mutable_txn_intents(int index);
+  inline ::proto::InternalTxnIntents* add_txn_intents();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::InternalTxnIntents >&
+      txn_intents() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::InternalTxnIntents >*
+      mutable_txn_intents();
+
+  // @@protoc_insertion_point(class_scope:proto.InternalScanIntentsResponse)
  private:
-  inline void set_has_start_timestamp_nanos();
-  inline void clear_has_start_timestamp_nanos();
-  inline void set_has_sample_duration_nanos();
-  inline void clear_has_sample_duration_nanos();
+  inline void set_has_header();
+  inline void clear_has_header();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int64 start_timestamp_nanos_;
-  ::google::protobuf::int64 sample_duration_nanos_;
-  ::google::protobuf::RepeatedPtrField< ::proto::InternalTimeSeriesSample > samples_;
+  ::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::proto::InternalTxnIntents > txn_intents_;
   friend void  protobuf_AddDesc_internal_2eproto();
   friend void protobuf_AssignDesc_internal_2eproto();
   friend void protobuf_ShutdownFile_internal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalTimeSeriesData* default_instance_;
+  static InternalScanIntentsResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class InternalTimeSeriesSample : public ::google::protobuf::Message {
+class InternalInspectTimestampCacheRequest : public ::google::protobuf::Message {
  public:
-  InternalTimeSeriesSample();
-  virtual ~InternalTimeSeriesSample();
+  InternalInspectTimestampCacheRequest();
+  virtual ~InternalInspectTimestampCacheRequest();
 
-  InternalTimeSeriesSample(const InternalTimeSeriesSample& from);
+  InternalInspectTimestampCacheRequest(const InternalInspectTimestampCacheRequest& from);
 
-  inline InternalTimeSeriesSample& operator=(const InternalTimeSeriesSample& from) {
+  inline InternalInspectTimestampCacheRequest& operator=(const InternalInspectTimestampCacheRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2187,17 +1932,17 @@ class InternalTimeSeriesSample : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const InternalTimeSeriesSample& default_instance();
+  static const InternalInspectTimestampCacheRequest& default_instance();
 
-  void Swap(InternalTimeSeriesSample* other);
+  void Swap(InternalInspectTimestampCacheRequest* other);
 
   // implements Message ----------------------------------------------
 
-  InternalTimeSeriesSample* New() const;
+  InternalInspectTimestampCacheRequest* New() const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const InternalTimeSeriesSample& from);
-  void MergeFrom(const InternalTimeSeriesSample& from);
+  void CopyFrom(const InternalInspectTimestampCacheRequest& from);
+  void MergeFrom(const InternalInspectTimestampCacheRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2219,148 +1964,7169 @@ class InternalTimeSeriesSample : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional int32 offset = 1;
-  inline bool has_offset() const;
-  inline void clear_offset();
-  static const int kOffsetFieldNumber = 1;
-  inline ::google::protobuf::int32 offset() const;
-  inline void set_offset(::google::protobuf::int32 value);
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
 
-  // optional uint32 int_count = 2;
-  inline bool has_int_count() const;
-  inline void clear_int_count();
-  static const int kIntCountFieldNumber = 2;
-  inline ::google::protobuf::uint32 int_count() const;
-  inline void set_int_count(::google::protobuf::uint32 value);
+  // @@protoc_insertion_point(class_scope:proto.InternalInspectTimestampCacheRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
 
-  // optional int64 int_sum = 3;
-  inline bool has_int_sum() const;
-  inline void clear_int_sum();
-  static const int kIntSumFieldNumber = 3;
-  inline ::google::protobuf::int64 int_sum() const;
-  inline void set_int_sum(::google::protobuf::int64 value);
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
-  // optional int64 int_max = 4;
-  inline bool has_int_max() const;
-  inline void clear_int_max();
-  static const int kIntMaxFieldNumber = 4;
-  inline ::google::protobuf::int64 int_max() const;
-  inline void set_int_max(::google::protobuf::int64 value);
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
 
-  // optional int64 int_min = 5;
-  inline bool has_int_min() const;
-  inline void clear_int_min();
-  static const int kIntMinFieldNumber = 5;
-  inline ::google::protobuf::int64 int_min() const;
-  inline void set_int_min(::google::protobuf::int64 value);
+  void InitAsDefaultInstance();
+  static InternalInspectTimestampCacheRequest* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional uint32 float_count = 6;
-  inline bool has_float_count() const;
-  inline void clear_float_count();
-  static const int kFloatCountFieldNumber = 6;
-  inline ::google::protobuf::uint32 float_count() const;
-  inline void set_float_count(::google::protobuf::uint32 value);
+class InternalInspectTimestampCacheResponse : public ::google::protobuf::Message {
+ public:
+  InternalInspectTimestampCacheResponse();
+  virtual ~InternalInspectTimestampCacheResponse();
 
-  // optional float float_sum = 7;
-  inline bool has_float_sum() const;
-  inline void clear_float_sum();
-  static const int kFloatSumFieldNumber = 7;
-  inline float float_sum() const;
-  inline void set_float_sum(float value);
+  InternalInspectTimestampCacheResponse(const InternalInspectTimestampCacheResponse& from);
 
-  // optional float float_max = 8;
-  inline bool has_float_max() const;
-  inline void clear_float_max();
-  static const int kFloatMaxFieldNumber = 8;
-  inline float float_max() const;
-  inline void set_float_max(float value);
+  inline InternalInspectTimestampCacheResponse& operator=(const InternalInspectTimestampCacheResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional float float_min = 9;
-  inline bool has_float_min() const;
-  inline void clear_float_min();
-  static const int kFloatMinFieldNumber = 9;
-  inline float float_min() const;
-  inline void set_float_min(float value);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
 
-  // @@protoc_insertion_point(class_scope:proto.InternalTimeSeriesSample)
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalInspectTimestampCacheResponse& default_instance();
+
+  void Swap(InternalInspectTimestampCacheResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalInspectTimestampCacheResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalInspectTimestampCacheResponse& from);
+  void MergeFrom(const InternalInspectTimestampCacheResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // optional .proto.Timestamp read_timestamp = 2;
+  inline bool has_read_timestamp() const;
+  inline void clear_read_timestamp();
+  static const int kReadTimestampFieldNumber = 2;
+  inline const ::proto::Timestamp& read_timestamp() const;
+  inline ::proto::Timestamp* mutable_read_timestamp();
+  inline ::proto::Timestamp* release_read_timestamp();
+  inline void set_allocated_read_timestamp(::proto::Timestamp* read_timestamp);
+
+  // optional .proto.Timestamp write_timestamp = 3;
+  inline bool has_write_timestamp() const;
+  inline void clear_write_timestamp();
+  static const int kWriteTimestampFieldNumber = 3;
+  inline const ::proto::Timestamp& write_timestamp() const;
+  inline ::proto::Timestamp* mutable_write_timestamp();
+  inline ::proto::Timestamp* release_write_timestamp();
+  inline void set_allocated_write_timestamp(::proto::Timestamp* write_timestamp);
+
+  // optional bool read_low_water = 4;
+  inline bool has_read_low_water() const;
+  inline void clear_read_low_water();
+  static const int kReadLowWaterFieldNumber = 4;
+  inline bool read_low_water() const;
+  inline void set_read_low_water(bool value);
+
+  // optional bool write_low_water = 5;
+  inline bool has_write_low_water() const;
+  inline void clear_write_low_water();
+  static const int kWriteLowWaterFieldNumber = 5;
+  inline bool write_low_water() const;
+  inline void set_write_low_water(bool value);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalInspectTimestampCacheResponse)
  private:
-  inline void set_has_offset();
-  inline void clear_has_offset();
-  inline void set_has_int_count();
-  inline void clear_has_int_count();
-  inline void set_has_int_sum();
-  inline void clear_has_int_sum();
-  inline void set_has_int_max();
-  inline void clear_has_int_max();
-  inline void set_has_int_min();
-  inline void clear_has_int_min();
-  inline void set_has_float_count();
-  inline void clear_has_float_count();
-  inline void set_has_float_sum();
-  inline void clear_has_float_sum();
-  inline void set_has_float_max();
-  inline void clear_has_float_max();
-  inline void set_has_float_min();
-  inline void clear_has_float_min();
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_read_timestamp();
+  inline void clear_has_read_timestamp();
+  inline void set_has_write_timestamp();
+  inline void clear_has_write_timestamp();
+  inline void set_has_read_low_water();
+  inline void clear_has_read_low_water();
+  inline void set_has_write_low_water();
+  inline void clear_has_write_low_water();
 
   ::google::protobuf::UnknownFieldSet _unknown_fields_;
 
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  ::google::protobuf::int32 offset_;
-  ::google::protobuf::uint32 int_count_;
-  ::google::protobuf::int64 int_sum_;
-  ::google::protobuf::int64 int_max_;
-  ::google::protobuf::int64 int_min_;
-  ::google::protobuf::uint32 float_count_;
-  float float_sum_;
-  float float_max_;
-  float float_min_;
+  ::proto::ResponseHeader* header_;
+  ::proto::Timestamp* read_timestamp_;
+  ::proto::Timestamp* write_timestamp_;
+  bool read_low_water_;
+  bool write_low_water_;
   friend void  protobuf_AddDesc_internal_2eproto();
   friend void protobuf_AssignDesc_internal_2eproto();
   friend void protobuf_ShutdownFile_internal_2eproto();
 
   void InitAsDefaultInstance();
-  static InternalTimeSeriesSample* default_instance_;
+  static InternalInspectTimestampCacheResponse* default_instance_;
 };
-// ===================================================================
-
+// -------------------------------------------------------------------
 
-// ===================================================================
+class InternalGetTransactionRequest : public ::google::protobuf::Message {
+ public:
+  InternalGetTransactionRequest();
+  virtual ~InternalGetTransactionRequest();
 
-// InternalRangeLookupRequest
+  InternalGetTransactionRequest(const InternalGetTransactionRequest& from);
 
-// optional .proto.RequestHeader header = 1;
+  inline InternalGetTransactionRequest& operator=(const InternalGetTransactionRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalGetTransactionRequest& default_instance();
+
+  void Swap(InternalGetTransactionRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalGetTransactionRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalGetTransactionRequest& from);
+  void MergeFrom(const InternalGetTransactionRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // optional bytes txn_id = 2;
+  inline bool has_txn_id() const;
+  inline void clear_txn_id();
+  static const int kTxnIdFieldNumber = 2;
+  inline const ::std::string& txn_id() const;
+  inline void set_txn_id(const ::std::string& value);
+  inline void set_txn_id(const char* value);
+  inline void set_txn_id(const void* value, size_t size);
+  inline ::std::string* mutable_txn_id();
+  inline ::std::string* release_txn_id();
+  inline void set_allocated_txn_id(::std::string* txn_id);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalGetTransactionRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_txn_id();
+  inline void clear_has_txn_id();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  ::std::string* txn_id_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalGetTransactionRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalGetTransactionResponse : public ::google::protobuf::Message {
+ public:
+  InternalGetTransactionResponse();
+  virtual ~InternalGetTransactionResponse();
+
+  InternalGetTransactionResponse(const InternalGetTransactionResponse& from);
+
+  inline InternalGetTransactionResponse& operator=(const InternalGetTransactionResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalGetTransactionResponse& default_instance();
+
+  void Swap(InternalGetTransactionResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalGetTransactionResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalGetTransactionResponse& from);
+  void MergeFrom(const InternalGetTransactionResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // optional .proto.Transaction txn = 2;
+  inline bool has_txn() const;
+  inline void clear_txn();
+  static const int kTxnFieldNumber = 2;
+  inline const ::proto::Transaction& txn() const;
+  inline ::proto::Transaction* mutable_txn();
+  inline ::proto::Transaction* release_txn();
+  inline void set_allocated_txn(::proto::Transaction* txn);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalGetTransactionResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_txn();
+  inline void clear_has_txn();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::proto::Transaction* txn_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalGetTransactionResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalPutIfAbsentRequest : public ::google::protobuf::Message {
+ public:
+  InternalPutIfAbsentRequest();
+  virtual ~InternalPutIfAbsentRequest();
+
+  InternalPutIfAbsentRequest(const InternalPutIfAbsentRequest& from);
+
+  inline InternalPutIfAbsentRequest& operator=(const InternalPutIfAbsentRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalPutIfAbsentRequest& default_instance();
+
+  void Swap(InternalPutIfAbsentRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalPutIfAbsentRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalPutIfAbsentRequest& from);
+  void MergeFrom(const InternalPutIfAbsentRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // optional .proto.Value value = 2;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 2;
+  inline const ::proto::Value& value() const;
+  inline ::proto::Value* mutable_value();
+  inline ::proto::Value* release_value();
+  inline void set_allocated_value(::proto::Value* value);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalPutIfAbsentRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  ::proto::Value* value_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalPutIfAbsentRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalPutIfAbsentResponse : public ::google::protobuf::Message {
+ public:
+  InternalPutIfAbsentResponse();
+  virtual ~InternalPutIfAbsentResponse();
+
+  InternalPutIfAbsentResponse(const InternalPutIfAbsentResponse& from);
+
+  inline InternalPutIfAbsentResponse& operator=(const InternalPutIfAbsentResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalPutIfAbsentResponse& default_instance();
+
+  void Swap(InternalPutIfAbsentResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalPutIfAbsentResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalPutIfAbsentResponse& from);
+  void MergeFrom(const InternalPutIfAbsentResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // optional bool inserted = 2;
+  inline bool has_inserted() const;
+  inline void clear_inserted();
+  static const int kInsertedFieldNumber = 2;
+  inline bool inserted() const;
+  inline void set_inserted(bool value);
+
+  // optional .proto.Value value = 3;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 3;
+  inline const ::proto::Value& value() const;
+  inline ::proto::Value* mutable_value();
+  inline ::proto::Value* release_value();
+  inline void set_allocated_value(::proto::Value* value);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalPutIfAbsentResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_inserted();
+  inline void clear_has_inserted();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::proto::Value* value_;
+  bool inserted_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalPutIfAbsentResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ScanResumeToken : public ::google::protobuf::Message {
+ public:
+  ScanResumeToken();
+  virtual ~ScanResumeToken();
+
+  ScanResumeToken(const ScanResumeToken& from);
+
+  inline ScanResumeToken& operator=(const ScanResumeToken& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ScanResumeToken& default_instance();
+
+  void Swap(ScanResumeToken* other);
+
+  // implements Message ----------------------------------------------
+
+  ScanResumeToken* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ScanResumeToken& from);
+  void MergeFrom(const ScanResumeToken& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 1;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // optional int64 raft_id = 2;
+  inline bool has_raft_id() const;
+  inline void clear_raft_id();
+  static const int kRaftIdFieldNumber = 2;
+  inline ::google::protobuf::int64 raft_id() const;
+  inline void set_raft_id(::google::protobuf::int64 value);
+
+  // optional bytes start_key = 3;
+  inline bool has_start_key() const;
+  inline void clear_start_key();
+  static const int kStartKeyFieldNumber = 3;
+  inline const ::std::string& start_key() const;
+  inline void set_start_key(const ::std::string& value);
+  inline void set_start_key(const char* value);
+  inline void set_start_key(const void* value, size_t size);
+  inline ::std::string* mutable_start_key();
+  inline ::std::string* release_start_key();
+  inline void set_allocated_start_key(::std::string* start_key);
+
+  // optional bytes end_key = 4;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 4;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // @@protoc_insertion_point(class_scope:proto.ScanResumeToken)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_raft_id();
+  inline void clear_has_raft_id();
+  inline void set_has_start_key();
+  inline void clear_has_start_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* key_;
+  ::google::protobuf::int64 raft_id_;
+  ::std::string* start_key_;
+  ::std::string* end_key_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static ScanResumeToken* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AuditEntry : public ::google::protobuf::Message {
+ public:
+  AuditEntry();
+  virtual ~AuditEntry();
+
+  AuditEntry(const AuditEntry& from);
+
+  inline AuditEntry& operator=(const AuditEntry& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AuditEntry& default_instance();
+
+  void Swap(AuditEntry* other);
+
+  // implements Message ----------------------------------------------
+
+  AuditEntry* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AuditEntry& from);
+  void MergeFrom(const AuditEntry& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional string user = 1;
+  inline bool has_user() const;
+  inline void clear_user();
+  static const int kUserFieldNumber = 1;
+  inline const ::std::string& user() const;
+  inline void set_user(const ::std::string& value);
+  inline void set_user(const char* value);
+  inline void set_user(const char* value, size_t size);
+  inline ::std::string* mutable_user();
+  inline ::std::string* release_user();
+  inline void set_allocated_user(::std::string* user);
+
+  // optional string method = 2;
+  inline bool has_method() const;
+  inline void clear_method();
+  static const int kMethodFieldNumber = 2;
+  inline const ::std::string& method() const;
+  inline void set_method(const ::std::string& value);
+  inline void set_method(const char* value);
+  inline void set_method(const char* value, size_t size);
+  inline ::std::string* mutable_method();
+  inline ::std::string* release_method();
+  inline void set_allocated_method(::std::string* method);
+
+  // optional bytes key = 3;
+  inline bool has_key() const;
+  inline void clear_key();
+  static const int kKeyFieldNumber = 3;
+  inline const ::std::string& key() const;
+  inline void set_key(const ::std::string& value);
+  inline void set_key(const char* value);
+  inline void set_key(const void* value, size_t size);
+  inline ::std::string* mutable_key();
+  inline ::std::string* release_key();
+  inline void set_allocated_key(::std::string* key);
+
+  // optional bytes end_key = 4;
+  inline bool has_end_key() const;
+  inline void clear_end_key();
+  static const int kEndKeyFieldNumber = 4;
+  inline const ::std::string& end_key() const;
+  inline void set_end_key(const ::std::string& value);
+  inline void set_end_key(const char* value);
+  inline void set_end_key(const void* value, size_t size);
+  inline ::std::string* mutable_end_key();
+  inline ::std::string* release_end_key();
+  inline void set_allocated_end_key(::std::string* end_key);
+
+  // optional .proto.Timestamp timestamp = 5;
+  inline bool has_timestamp() const;
+  inline void clear_timestamp();
+  static const int kTimestampFieldNumber = 5;
+  inline const ::proto::Timestamp& timestamp() const;
+  inline ::proto::Timestamp* mutable_timestamp();
+  inline ::proto::Timestamp* release_timestamp();
+  inline void set_allocated_timestamp(::proto::Timestamp* timestamp);
+
+  // optional bytes old_value_hash = 6;
+  inline bool has_old_value_hash() const;
+  inline void clear_old_value_hash();
+  static const int kOldValueHashFieldNumber = 6;
+  inline const ::std::string& old_value_hash() const;
+  inline void set_old_value_hash(const ::std::string& value);
+  inline void set_old_value_hash(const char* value);
+  inline void set_old_value_hash(const void* value, size_t size);
+  inline ::std::string* mutable_old_value_hash();
+  inline ::std::string* release_old_value_hash();
+  inline void set_allocated_old_value_hash(::std::string* old_value_hash);
+
+  // optional bytes new_value_hash = 7;
+  inline bool has_new_value_hash() const;
+  inline void clear_new_value_hash();
+  static const int kNewValueHashFieldNumber = 7;
+  inline const ::std::string& new_value_hash() const;
+  inline void set_new_value_hash(const ::std::string& value);
+  inline void set_new_value_hash(const char* value);
+  inline void set_new_value_hash(const void* value, size_t size);
+  inline ::std::string* mutable_new_value_hash();
+  inline ::std::string* release_new_value_hash();
+  inline void set_allocated_new_value_hash(::std::string* new_value_hash);
+
+  // @@protoc_insertion_point(class_scope:proto.AuditEntry)
+ private:
+  inline void set_has_user();
+  inline void clear_has_user();
+  inline void set_has_method();
+  inline void clear_has_method();
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_end_key();
+  inline void clear_has_end_key();
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_old_value_hash();
+  inline void clear_has_old_value_hash();
+  inline void set_has_new_value_hash();
+  inline void clear_has_new_value_hash();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::std::string* user_;
+  ::std::string* method_;
+  ::std::string* key_;
+  ::std::string* end_key_;
+  ::proto::Timestamp* timestamp_;
+  ::std::string* old_value_hash_;
+  ::std::string* new_value_hash_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static AuditEntry* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalRangeKeyBoundsRequest : public ::google::protobuf::Message {
+ public:
+  InternalRangeKeyBoundsRequest();
+  virtual ~InternalRangeKeyBoundsRequest();
+
+  InternalRangeKeyBoundsRequest(const InternalRangeKeyBoundsRequest& from);
+
+  inline InternalRangeKeyBoundsRequest& operator=(const InternalRangeKeyBoundsRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalRangeKeyBoundsRequest& default_instance();
+
+  void Swap(InternalRangeKeyBoundsRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalRangeKeyBoundsRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalRangeKeyBoundsRequest& from);
+  void MergeFrom(const InternalRangeKeyBoundsRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalRangeKeyBoundsRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalRangeKeyBoundsRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalRangeKeyBoundsResponse : public ::google::protobuf::Message {
+ public:
+  InternalRangeKeyBoundsResponse();
+  virtual ~InternalRangeKeyBoundsResponse();
+
+  InternalRangeKeyBoundsResponse(const InternalRangeKeyBoundsResponse& from);
+
+  inline InternalRangeKeyBoundsResponse& operator=(const InternalRangeKeyBoundsResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalRangeKeyBoundsResponse& default_instance();
+
+  void Swap(InternalRangeKeyBoundsResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalRangeKeyBoundsResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalRangeKeyBoundsResponse& from);
+  void MergeFrom(const InternalRangeKeyBoundsResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // optional bytes min_key = 2;
+  inline bool has_min_key() const;
+  inline void clear_min_key();
+  static const int kMinKeyFieldNumber = 2;
+  inline const ::std::string& min_key() const;
+  inline void set_min_key(const ::std::string& value);
+  inline void set_min_key(const char* value);
+  inline void set_min_key(const void* value, size_t size);
+  inline ::std::string* mutable_min_key();
+  inline ::std::string* release_min_key();
+  inline void set_allocated_min_key(::std::string* min_key);
+
+  // optional bytes max_key = 3;
+  inline bool has_max_key() const;
+  inline void clear_max_key();
+  static const int kMaxKeyFieldNumber = 3;
+  inline const ::std::string& max_key() const;
+  inline void set_max_key(const ::std::string& value);
+  inline void set_max_key(const char* value);
+  inline void set_max_key(const void* value, size_t size);
+  inline ::std::string* mutable_max_key();
+  inline ::std::string* release_max_key();
+  inline void set_allocated_max_key(::std::string* max_key);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalRangeKeyBoundsResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_min_key();
+  inline void clear_has_min_key();
+  inline void set_has_max_key();
+  inline void clear_has_max_key();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::std::string* min_key_;
+  ::std::string* max_key_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalRangeKeyBoundsResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalVerifyRangeRequest : public ::google::protobuf::Message {
+ public:
+  InternalVerifyRangeRequest();
+  virtual ~InternalVerifyRangeRequest();
+
+  InternalVerifyRangeRequest(const InternalVerifyRangeRequest& from);
+
+  inline InternalVerifyRangeRequest& operator=(const InternalVerifyRangeRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalVerifyRangeRequest& default_instance();
+
+  void Swap(InternalVerifyRangeRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalVerifyRangeRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalVerifyRangeRequest& from);
+  void MergeFrom(const InternalVerifyRangeRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // optional int64 max_keys = 2;
+  inline bool has_max_keys() const;
+  inline void clear_max_keys();
+  static const int kMaxKeysFieldNumber = 2;
+  inline ::google::protobuf::int64 max_keys() const;
+  inline void set_max_keys(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalVerifyRangeRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_max_keys();
+  inline void clear_has_max_keys();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  ::google::protobuf::int64 max_keys_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalVerifyRangeRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalVerifyRangeResponse : public ::google::protobuf::Message {
+ public:
+  InternalVerifyRangeResponse();
+  virtual ~InternalVerifyRangeResponse();
+
+  InternalVerifyRangeResponse(const InternalVerifyRangeResponse& from);
+
+  inline InternalVerifyRangeResponse& operator=(const InternalVerifyRangeResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalVerifyRangeResponse& default_instance();
+
+  void Swap(InternalVerifyRangeResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalVerifyRangeResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalVerifyRangeResponse& from);
+  void MergeFrom(const InternalVerifyRangeResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // repeated bytes out_of_bounds_keys = 2;
+  inline int out_of_bounds_keys_size() const;
+  inline void clear_out_of_bounds_keys();
+  static const int kOutOfBoundsKeysFieldNumber = 2;
+  inline const ::std::string& out_of_bounds_keys(int index) const;
+  inline ::std::string* mutable_out_of_bounds_keys(int index);
+  inline void set_out_of_bounds_keys(int index, const ::std::string& value);
+  inline void set_out_of_bounds_keys(int index, const char* value);
+  inline void set_out_of_bounds_keys(int index, const void* value, size_t size);
+  inline ::std::string* add_out_of_bounds_keys();
+  inline void add_out_of_bounds_keys(const ::std::string& value);
+  inline void add_out_of_bounds_keys(const char* value);
+  inline void add_out_of_bounds_keys(const void* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& out_of_bounds_keys() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_out_of_bounds_keys();
+
+  // @@protoc_insertion_point(class_scope:proto.InternalVerifyRangeResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> out_of_bounds_keys_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalVerifyRangeResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalResolveIntentRangeRequest : public ::google::protobuf::Message {
+ public:
+  InternalResolveIntentRangeRequest();
+  virtual ~InternalResolveIntentRangeRequest();
+
+  InternalResolveIntentRangeRequest(const InternalResolveIntentRangeRequest& from);
+
+  inline InternalResolveIntentRangeRequest& operator=(const InternalResolveIntentRangeRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalResolveIntentRangeRequest& default_instance();
+
+  void Swap(InternalResolveIntentRangeRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalResolveIntentRangeRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalResolveIntentRangeRequest& from);
+  void MergeFrom(const InternalResolveIntentRangeRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalResolveIntentRangeRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalResolveIntentRangeRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalResolveIntentRangeResponse : public ::google::protobuf::Message {
+ public:
+  InternalResolveIntentRangeResponse();
+  virtual ~InternalResolveIntentRangeResponse();
+
+  InternalResolveIntentRangeResponse(const InternalResolveIntentRangeResponse& from);
+
+  inline InternalResolveIntentRangeResponse& operator=(const InternalResolveIntentRangeResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalResolveIntentRangeResponse& default_instance();
+
+  void Swap(InternalResolveIntentRangeResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalResolveIntentRangeResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalResolveIntentRangeResponse& from);
+  void MergeFrom(const InternalResolveIntentRangeResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalResolveIntentRangeResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalResolveIntentRangeResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalLeaderLeaseRequest : public ::google::protobuf::Message {
+ public:
+  InternalLeaderLeaseRequest();
+  virtual ~InternalLeaderLeaseRequest();
+
+  InternalLeaderLeaseRequest(const InternalLeaderLeaseRequest& from);
+
+  inline InternalLeaderLeaseRequest& operator=(const InternalLeaderLeaseRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalLeaderLeaseRequest& default_instance();
+
+  void Swap(InternalLeaderLeaseRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalLeaderLeaseRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalLeaderLeaseRequest& from);
+  void MergeFrom(const InternalLeaderLeaseRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // optional .proto.Lease lease = 2;
+  inline bool has_lease() const;
+  inline void clear_lease();
+  static const int kLeaseFieldNumber = 2;
+  inline const ::proto::Lease& lease() const;
+  inline ::proto::Lease* mutable_lease();
+  inline ::proto::Lease* release_lease();
+  inline void set_allocated_lease(::proto::Lease* lease);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalLeaderLeaseRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_lease();
+  inline void clear_has_lease();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  ::proto::Lease* lease_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalLeaderLeaseRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalLeaderLeaseResponse : public ::google::protobuf::Message {
+ public:
+  InternalLeaderLeaseResponse();
+  virtual ~InternalLeaderLeaseResponse();
+
+  InternalLeaderLeaseResponse(const InternalLeaderLeaseResponse& from);
+
+  inline InternalLeaderLeaseResponse& operator=(const InternalLeaderLeaseResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalLeaderLeaseResponse& default_instance();
+
+  void Swap(InternalLeaderLeaseResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalLeaderLeaseResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalLeaderLeaseResponse& from);
+  void MergeFrom(const InternalLeaderLeaseResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalLeaderLeaseResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalLeaderLeaseResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalComputeChecksumRequest : public ::google::protobuf::Message {
+ public:
+  InternalComputeChecksumRequest();
+  virtual ~InternalComputeChecksumRequest();
+
+  InternalComputeChecksumRequest(const InternalComputeChecksumRequest& from);
+
+  inline InternalComputeChecksumRequest& operator=(const InternalComputeChecksumRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalComputeChecksumRequest& default_instance();
+
+  void Swap(InternalComputeChecksumRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalComputeChecksumRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalComputeChecksumRequest& from);
+  void MergeFrom(const InternalComputeChecksumRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalComputeChecksumRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalComputeChecksumRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalComputeChecksumResponse : public ::google::protobuf::Message {
+ public:
+  InternalComputeChecksumResponse();
+  virtual ~InternalComputeChecksumResponse();
+
+  InternalComputeChecksumResponse(const InternalComputeChecksumResponse& from);
+
+  inline InternalComputeChecksumResponse& operator=(const InternalComputeChecksumResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalComputeChecksumResponse& default_instance();
+
+  void Swap(InternalComputeChecksumResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalComputeChecksumResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalComputeChecksumResponse& from);
+  void MergeFrom(const InternalComputeChecksumResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // optional bytes checksum = 2;
+  inline bool has_checksum() const;
+  inline void clear_checksum();
+  static const int kChecksumFieldNumber = 2;
+  inline const ::std::string& checksum() const;
+  inline void set_checksum(const ::std::string& value);
+  inline void set_checksum(const char* value);
+  inline void set_checksum(const void* value, size_t size);
+  inline ::std::string* mutable_checksum();
+  inline ::std::string* release_checksum();
+  inline void set_allocated_checksum(::std::string* checksum);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalComputeChecksumResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_checksum();
+  inline void clear_has_checksum();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::std::string* checksum_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalComputeChecksumResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class MVCCVersion : public ::google::protobuf::Message {
+ public:
+  MVCCVersion();
+  virtual ~MVCCVersion();
+
+  MVCCVersion(const MVCCVersion& from);
+
+  inline MVCCVersion& operator=(const MVCCVersion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const MVCCVersion& default_instance();
+
+  void Swap(MVCCVersion* other);
+
+  // implements Message ----------------------------------------------
+
+  MVCCVersion* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const MVCCVersion& from);
+  void MergeFrom(const MVCCVersion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.Timestamp timestamp = 1;
+  inline bool has_timestamp() const;
+  inline void clear_timestamp();
+  static const int kTimestampFieldNumber = 1;
+  inline const ::proto::Timestamp& timestamp() const;
+  inline ::proto::Timestamp* mutable_timestamp();
+  inline ::proto::Timestamp* release_timestamp();
+  inline void set_allocated_timestamp(::proto::Timestamp* timestamp);
+
+  // optional bool deleted = 2;
+  inline bool has_deleted() const;
+  inline void clear_deleted();
+  static const int kDeletedFieldNumber = 2;
+  inline bool deleted() const;
+  inline void set_deleted(bool value);
+
+  // optional .proto.Value value = 3;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 3;
+  inline const ::proto::Value& value() const;
+  inline ::proto::Value* mutable_value();
+  inline ::proto::Value* release_value();
+  inline void set_allocated_value(::proto::Value* value);
+
+  // @@protoc_insertion_point(class_scope:proto.MVCCVersion)
+ private:
+  inline void set_has_timestamp();
+  inline void clear_has_timestamp();
+  inline void set_has_deleted();
+  inline void clear_has_deleted();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::Timestamp* timestamp_;
+  ::proto::Value* value_;
+  bool deleted_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static MVCCVersion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchGetValue : public ::google::protobuf::Message {
+ public:
+  BatchGetValue();
+  virtual ~BatchGetValue();
+
+  BatchGetValue(const BatchGetValue& from);
+
+  inline BatchGetValue& operator=(const BatchGetValue& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchGetValue& default_instance();
+
+  void Swap(BatchGetValue* other);
+
+  // implements Message ----------------------------------------------
+
+  BatchGetValue* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchGetValue& from);
+  void MergeFrom(const BatchGetValue& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.Value value = 1;
+  inline bool has_value() const;
+  inline void clear_value();
+  static const int kValueFieldNumber = 1;
+  inline const ::proto::Value& value() const;
+  inline ::proto::Value* mutable_value();
+  inline ::proto::Value* release_value();
+  inline void set_allocated_value(::proto::Value* value);
+
+  // @@protoc_insertion_point(class_scope:proto.BatchGetValue)
+ private:
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::Value* value_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchGetValue* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalGetHistoryRequest : public ::google::protobuf::Message {
+ public:
+  InternalGetHistoryRequest();
+  virtual ~InternalGetHistoryRequest();
+
+  InternalGetHistoryRequest(const InternalGetHistoryRequest& from);
+
+  inline InternalGetHistoryRequest& operator=(const InternalGetHistoryRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalGetHistoryRequest& default_instance();
+
+  void Swap(InternalGetHistoryRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalGetHistoryRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalGetHistoryRequest& from);
+  void MergeFrom(const InternalGetHistoryRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // optional .proto.Timestamp before = 2;
+  inline bool has_before() const;
+  inline void clear_before();
+  static const int kBeforeFieldNumber = 2;
+  inline const ::proto::Timestamp& before() const;
+  inline ::proto::Timestamp* mutable_before();
+  inline ::proto::Timestamp* release_before();
+  inline void set_allocated_before(::proto::Timestamp* before);
+
+  // optional int64 max_versions = 3;
+  inline bool has_max_versions() const;
+  inline void clear_max_versions();
+  static const int kMaxVersionsFieldNumber = 3;
+  inline ::google::protobuf::int64 max_versions() const;
+  inline void set_max_versions(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalGetHistoryRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_before();
+  inline void clear_has_before();
+  inline void set_has_max_versions();
+  inline void clear_has_max_versions();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  ::proto::Timestamp* before_;
+  ::google::protobuf::int64 max_versions_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalGetHistoryRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalGetHistoryResponse : public ::google::protobuf::Message {
+ public:
+  InternalGetHistoryResponse();
+  virtual ~InternalGetHistoryResponse();
+
+  InternalGetHistoryResponse(const InternalGetHistoryResponse& from);
+
+  inline InternalGetHistoryResponse& operator=(const InternalGetHistoryResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalGetHistoryResponse& default_instance();
+
+  void Swap(InternalGetHistoryResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalGetHistoryResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalGetHistoryResponse& from);
+  void MergeFrom(const InternalGetHistoryResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // repeated .proto.MVCCVersion versions = 2;
+  inline int versions_size() const;
+  inline void clear_versions();
+  static const int kVersionsFieldNumber = 2;
+  inline const ::proto::MVCCVersion& versions(int index) const;
+  inline ::proto::MVCCVersion* mutable_versions(int index);
+  inline ::proto::MVCCVersion* add_versions();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::MVCCVersion >&
+      versions() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::MVCCVersion >*
+      mutable_versions();
+
+  // @@protoc_insertion_point(class_scope:proto.InternalGetHistoryResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::proto::MVCCVersion > versions_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalGetHistoryResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalBatchGetRequest : public ::google::protobuf::Message {
+ public:
+  InternalBatchGetRequest();
+  virtual ~InternalBatchGetRequest();
+
+  InternalBatchGetRequest(const InternalBatchGetRequest& from);
+
+  inline InternalBatchGetRequest& operator=(const InternalBatchGetRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalBatchGetRequest& default_instance();
+
+  void Swap(InternalBatchGetRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalBatchGetRequest* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalBatchGetRequest& from);
+  void MergeFrom(const InternalBatchGetRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.RequestHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::RequestHeader& header() const;
+  inline ::proto::RequestHeader* mutable_header();
+  inline ::proto::RequestHeader* release_header();
+  inline void set_allocated_header(::proto::RequestHeader* header);
+
+  // repeated bytes keys = 2;
+  inline int keys_size() const;
+  inline void clear_keys();
+  static const int kKeysFieldNumber = 2;
+  inline const ::std::string& keys(int index) const;
+  inline ::std::string* mutable_keys(int index);
+  inline void set_keys(int index, const ::std::string& value);
+  inline void set_keys(int index, const char* value);
+  inline void set_keys(int index, const void* value, size_t size);
+  inline ::std::string* add_keys();
+  inline void add_keys(const ::std::string& value);
+  inline void add_keys(const char* value);
+  inline void add_keys(const void* value, size_t size);
+  inline const ::google::protobuf::RepeatedPtrField< ::std::string>& keys() const;
+  inline ::google::protobuf::RepeatedPtrField< ::std::string>* mutable_keys();
+
+  // @@protoc_insertion_point(class_scope:proto.InternalBatchGetRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::RequestHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::std::string> keys_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalBatchGetRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalBatchGetResponse : public ::google::protobuf::Message {
+ public:
+  InternalBatchGetResponse();
+  virtual ~InternalBatchGetResponse();
+
+  InternalBatchGetResponse(const InternalBatchGetResponse& from);
+
+  inline InternalBatchGetResponse& operator=(const InternalBatchGetResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalBatchGetResponse& default_instance();
+
+  void Swap(InternalBatchGetResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalBatchGetResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalBatchGetResponse& from);
+  void MergeFrom(const InternalBatchGetResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ResponseHeader header = 1;
+  inline bool has_header() const;
+  inline void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  inline const ::proto::ResponseHeader& header() const;
+  inline ::proto::ResponseHeader* mutable_header();
+  inline ::proto::ResponseHeader* release_header();
+  inline void set_allocated_header(::proto::ResponseHeader* header);
+
+  // repeated .proto.BatchGetValue values = 2;
+  inline int values_size() const;
+  inline void clear_values();
+  static const int kValuesFieldNumber = 2;
+  inline const ::proto::BatchGetValue& values(int index) const;
+  inline ::proto::BatchGetValue* mutable_values(int index);
+  inline ::proto::BatchGetValue* add_values();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::BatchGetValue >&
+      values() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::BatchGetValue >*
+      mutable_values();
+
+  // @@protoc_insertion_point(class_scope:proto.InternalBatchGetResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::proto::BatchGetValue > values_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalBatchGetResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ReadWriteCmdResponse : public ::google::protobuf::Message {
+ public:
+  ReadWriteCmdResponse();
+  virtual ~ReadWriteCmdResponse();
+
+  ReadWriteCmdResponse(const ReadWriteCmdResponse& from);
+
+  inline ReadWriteCmdResponse& operator=(const ReadWriteCmdResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ReadWriteCmdResponse& default_instance();
+
+  void Swap(ReadWriteCmdResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  ReadWriteCmdResponse* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ReadWriteCmdResponse& from);
+  void MergeFrom(const ReadWriteCmdResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.PutResponse put = 1;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 1;
+  inline const ::proto::PutResponse& put() const;
+  inline ::proto::PutResponse* mutable_put();
+  inline ::proto::PutResponse* release_put();
+  inline void set_allocated_put(::proto::PutResponse* put);
+
+  // optional .proto.ConditionalPutResponse conditional_put = 2;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 2;
+  inline const ::proto::ConditionalPutResponse& conditional_put() const;
+  inline ::proto::ConditionalPutResponse* mutable_conditional_put();
+  inline ::proto::ConditionalPutResponse* release_conditional_put();
+  inline void set_allocated_conditional_put(::proto::ConditionalPutResponse* conditional_put);
+
+  // optional .proto.IncrementResponse increment = 3;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 3;
+  inline const ::proto::IncrementResponse& increment() const;
+  inline ::proto::IncrementResponse* mutable_increment();
+  inline ::proto::IncrementResponse* release_increment();
+  inline void set_allocated_increment(::proto::IncrementResponse* increment);
+
+  // optional .proto.DeleteResponse delete = 4;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 4;
+  inline const ::proto::DeleteResponse& delete_() const;
+  inline ::proto::DeleteResponse* mutable_delete_();
+  inline ::proto::DeleteResponse* release_delete_();
+  inline void set_allocated_delete_(::proto::DeleteResponse* delete_);
+
+  // optional .proto.DeleteRangeResponse delete_range = 5;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 5;
+  inline const ::proto::DeleteRangeResponse& delete_range() const;
+  inline ::proto::DeleteRangeResponse* mutable_delete_range();
+  inline ::proto::DeleteRangeResponse* release_delete_range();
+  inline void set_allocated_delete_range(::proto::DeleteRangeResponse* delete_range);
+
+  // optional .proto.EndTransactionResponse end_transaction = 6;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 6;
+  inline const ::proto::EndTransactionResponse& end_transaction() const;
+  inline ::proto::EndTransactionResponse* mutable_end_transaction();
+  inline ::proto::EndTransactionResponse* release_end_transaction();
+  inline void set_allocated_end_transaction(::proto::EndTransactionResponse* end_transaction);
+
+  // optional .proto.ReapQueueResponse reap_queue = 7;
+  inline bool has_reap_queue() const;
+  inline void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 7;
+  inline const ::proto::ReapQueueResponse& reap_queue() const;
+  inline ::proto::ReapQueueResponse* mutable_reap_queue();
+  inline ::proto::ReapQueueResponse* release_reap_queue();
+  inline void set_allocated_reap_queue(::proto::ReapQueueResponse* reap_queue);
+
+  // optional .proto.EnqueueUpdateResponse enqueue_update = 8;
+  inline bool has_enqueue_update() const;
+  inline void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 8;
+  inline const ::proto::EnqueueUpdateResponse& enqueue_update() const;
+  inline ::proto::EnqueueUpdateResponse* mutable_enqueue_update();
+  inline ::proto::EnqueueUpdateResponse* release_enqueue_update();
+  inline void set_allocated_enqueue_update(::proto::EnqueueUpdateResponse* enqueue_update);
+
+  // optional .proto.EnqueueMessageResponse enqueue_message = 9;
+  inline bool has_enqueue_message() const;
+  inline void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 9;
+  inline const ::proto::EnqueueMessageResponse& enqueue_message() const;
+  inline ::proto::EnqueueMessageResponse* mutable_enqueue_message();
+  inline ::proto::EnqueueMessageResponse* release_enqueue_message();
+  inline void set_allocated_enqueue_message(::proto::EnqueueMessageResponse* enqueue_message);
+
+  // optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+  inline bool has_internal_heartbeat_txn() const;
+  inline void clear_internal_heartbeat_txn();
+  static const int kInternalHeartbeatTxnFieldNumber = 10;
+  inline const ::proto::InternalHeartbeatTxnResponse& internal_heartbeat_txn() const;
+  inline ::proto::InternalHeartbeatTxnResponse* mutable_internal_heartbeat_txn();
+  inline ::proto::InternalHeartbeatTxnResponse* release_internal_heartbeat_txn();
+  inline void set_allocated_internal_heartbeat_txn(::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn);
+
+  // optional .proto.InternalPushTxnResponse internal_push_txn = 11;
+  inline bool has_internal_push_txn() const;
+  inline void clear_internal_push_txn();
+  static const int kInternalPushTxnFieldNumber = 11;
+  inline const ::proto::InternalPushTxnResponse& internal_push_txn() const;
+  inline ::proto::InternalPushTxnResponse* mutable_internal_push_txn();
+  inline ::proto::InternalPushTxnResponse* release_internal_push_txn();
+  inline void set_allocated_internal_push_txn(::proto::InternalPushTxnResponse* internal_push_txn);
+
+  // optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+  inline bool has_internal_resolve_intent() const;
+  inline void clear_internal_resolve_intent();
+  static const int kInternalResolveIntentFieldNumber = 12;
+  inline const ::proto::InternalResolveIntentResponse& internal_resolve_intent() const;
+  inline ::proto::InternalResolveIntentResponse* mutable_internal_resolve_intent();
+  inline ::proto::InternalResolveIntentResponse* release_internal_resolve_intent();
+  inline void set_allocated_internal_resolve_intent(::proto::InternalResolveIntentResponse* internal_resolve_intent);
+
+  // optional .proto.InternalMergeResponse internal_merge = 13;
+  inline bool has_internal_merge() const;
+  inline void clear_internal_merge();
+  static const int kInternalMergeFieldNumber = 13;
+  inline const ::proto::InternalMergeResponse& internal_merge() const;
+  inline ::proto::InternalMergeResponse* mutable_internal_merge();
+  inline ::proto::InternalMergeResponse* release_internal_merge();
+  inline void set_allocated_internal_merge(::proto::InternalMergeResponse* internal_merge);
+
+  // optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
+  inline bool has_internal_truncate_log() const;
+  inline void clear_internal_truncate_log();
+  static const int kInternalTruncateLogFieldNumber = 14;
+  inline const ::proto::InternalTruncateLogResponse& internal_truncate_log() const;
+  inline ::proto::InternalTruncateLogResponse* mutable_internal_truncate_log();
+  inline ::proto::InternalTruncateLogResponse* release_internal_truncate_log();
+  inline void set_allocated_internal_truncate_log(::proto::InternalTruncateLogResponse* internal_truncate_log);
+
+  // optional .proto.InternalGCResponse internal_gc = 15;
+  inline bool has_internal_gc() const;
+  inline void clear_internal_gc();
+  static const int kInternalGcFieldNumber = 15;
+  inline const ::proto::InternalGCResponse& internal_gc() const;
+  inline ::proto::InternalGCResponse* mutable_internal_gc();
+  inline ::proto::InternalGCResponse* release_internal_gc();
+  inline void set_allocated_internal_gc(::proto::InternalGCResponse* internal_gc);
+
+  // optional .proto.InternalBeginTransactionResponse internal_begin_transaction = 16;
+  inline bool has_internal_begin_transaction() const;
+  inline void clear_internal_begin_transaction();
+  static const int kInternalBeginTransactionFieldNumber = 16;
+  inline const ::proto::InternalBeginTransactionResponse& internal_begin_transaction() const;
+  inline ::proto::InternalBeginTransactionResponse* mutable_internal_begin_transaction();
+  inline ::proto::InternalBeginTransactionResponse* release_internal_begin_transaction();
+  inline void set_allocated_internal_begin_transaction(::proto::InternalBeginTransactionResponse* internal_begin_transaction);
+
+  // optional .proto.InternalPutIfAbsentResponse internal_put_if_absent = 17;
+  inline bool has_internal_put_if_absent() const;
+  inline void clear_internal_put_if_absent();
+  static const int kInternalPutIfAbsentFieldNumber = 17;
+  inline const ::proto::InternalPutIfAbsentResponse& internal_put_if_absent() const;
+  inline ::proto::InternalPutIfAbsentResponse* mutable_internal_put_if_absent();
+  inline ::proto::InternalPutIfAbsentResponse* release_internal_put_if_absent();
+  inline void set_allocated_internal_put_if_absent(::proto::InternalPutIfAbsentResponse* internal_put_if_absent);
+
+  // optional .proto.GetResponse get = 18;
+  inline bool has_get() const;
+  inline void clear_get();
+  static const int kGetFieldNumber = 18;
+  inline const ::proto::GetResponse& get() const;
+  inline ::proto::GetResponse* mutable_get();
+  inline ::proto::GetResponse* release_get();
+  inline void set_allocated_get(::proto::GetResponse* get);
+
+  // optional .proto.ConditionalDeleteResponse conditional_delete = 19;
+  inline bool has_conditional_delete() const;
+  inline void clear_conditional_delete();
+  static const int kConditionalDeleteFieldNumber = 19;
+  inline const ::proto::ConditionalDeleteResponse& conditional_delete() const;
+  inline ::proto::ConditionalDeleteResponse* mutable_conditional_delete();
+  inline ::proto::ConditionalDeleteResponse* release_conditional_delete();
+  inline void set_allocated_conditional_delete(::proto::ConditionalDeleteResponse* conditional_delete);
+
+  // optional .proto.BatchResponse batch = 20;
+  inline bool has_batch() const;
+  inline void clear_batch();
+  static const int kBatchFieldNumber = 20;
+  inline const ::proto::BatchResponse& batch() const;
+  inline ::proto::BatchResponse* mutable_batch();
+  inline ::proto::BatchResponse* release_batch();
+  inline void set_allocated_batch(::proto::BatchResponse* batch);
+
+  // optional .proto.InternalResolveIntentRangeResponse internal_resolve_intent_range = 21;
+  inline bool has_internal_resolve_intent_range() const;
+  inline void clear_internal_resolve_intent_range();
+  static const int kInternalResolveIntentRangeFieldNumber = 21;
+  inline const ::proto::InternalResolveIntentRangeResponse& internal_resolve_intent_range() const;
+  inline ::proto::InternalResolveIntentRangeResponse* mutable_internal_resolve_intent_range();
+  inline ::proto::InternalResolveIntentRangeResponse* release_internal_resolve_intent_range();
+  inline void set_allocated_internal_resolve_intent_range(::proto::InternalResolveIntentRangeResponse* internal_resolve_intent_range);
+
+  // optional .proto.InternalLeaderLeaseResponse internal_leader_lease = 22;
+  inline bool has_internal_leader_lease() const;
+  inline void clear_internal_leader_lease();
+  static const int kInternalLeaderLeaseFieldNumber = 22;
+  inline const ::proto::InternalLeaderLeaseResponse& internal_leader_lease() const;
+  inline ::proto::InternalLeaderLeaseResponse* mutable_internal_leader_lease();
+  inline ::proto::InternalLeaderLeaseResponse* release_internal_leader_lease();
+  inline void set_allocated_internal_leader_lease(::proto::InternalLeaderLeaseResponse* internal_leader_lease);
+
+  // @@protoc_insertion_point(class_scope:proto.ReadWriteCmdResponse)
+ private:
+  inline void set_has_put();
+  inline void clear_has_put();
+  inline void set_has_conditional_put();
+  inline void clear_has_conditional_put();
+  inline void set_has_increment();
+  inline void clear_has_increment();
+  inline void set_has_delete_();
+  inline void clear_has_delete_();
+  inline void set_has_delete_range();
+  inline void clear_has_delete_range();
+  inline void set_has_end_transaction();
+  inline void clear_has_end_transaction();
+  inline void set_has_reap_queue();
+  inline void clear_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void clear_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void clear_has_enqueue_message();
+  inline void set_has_internal_heartbeat_txn();
+  inline void clear_has_internal_heartbeat_txn();
+  inline void set_has_internal_push_txn();
+  inline void clear_has_internal_push_txn();
+  inline void set_has_internal_resolve_intent();
+  inline void clear_has_internal_resolve_intent();
+  inline void set_has_internal_merge();
+  inline void clear_has_internal_merge();
+  inline void set_has_internal_truncate_log();
+  inline void clear_has_internal_truncate_log();
+  inline void set_has_internal_gc();
+  inline void clear_has_internal_gc();
+  inline void set_has_internal_begin_transaction();
+  inline void clear_has_internal_begin_transaction();
+  inline void set_has_internal_put_if_absent();
+  inline void clear_has_internal_put_if_absent();
+  inline void set_has_get();
+  inline void clear_has_get();
+  inline void set_has_conditional_delete();
+  inline void clear_has_conditional_delete();
+  inline void set_has_batch();
+  inline void clear_has_batch();
+  inline void set_has_internal_resolve_intent_range();
+  inline void clear_has_internal_resolve_intent_range();
+  inline void set_has_internal_leader_lease();
+  inline void clear_has_internal_leader_lease();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::PutResponse* put_;
+  ::proto::ConditionalPutResponse* conditional_put_;
+  ::proto::IncrementResponse* increment_;
+  ::proto::DeleteResponse* delete__;
+  ::proto::DeleteRangeResponse* delete_range_;
+  ::proto::EndTransactionResponse* end_transaction_;
+  ::proto::ReapQueueResponse* reap_queue_;
+  ::proto::EnqueueUpdateResponse* enqueue_update_;
+  ::proto::EnqueueMessageResponse* enqueue_message_;
+  ::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
+  ::proto::InternalPushTxnResponse* internal_push_txn_;
+  ::proto::InternalResolveIntentResponse* internal_resolve_intent_;
+  ::proto::InternalMergeResponse* internal_merge_;
+  ::proto::InternalTruncateLogResponse* internal_truncate_log_;
+  ::proto::InternalGCResponse* internal_gc_;
+  ::proto::InternalBeginTransactionResponse* internal_begin_transaction_;
+  ::proto::InternalPutIfAbsentResponse* internal_put_if_absent_;
+  ::proto::GetResponse* get_;
+  ::proto::ConditionalDeleteResponse* conditional_delete_;
+  ::proto::BatchResponse* batch_;
+  ::proto::InternalResolveIntentRangeResponse* internal_resolve_intent_range_;
+  ::proto::InternalLeaderLeaseResponse* internal_leader_lease_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static ReadWriteCmdResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ResponseCacheEntry : public ::google::protobuf::Message {
+ public:
+  ResponseCacheEntry();
+  virtual ~ResponseCacheEntry();
+
+  ResponseCacheEntry(const ResponseCacheEntry& from);
+
+  inline ResponseCacheEntry& operator=(const ResponseCacheEntry& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ResponseCacheEntry& default_instance();
+
+  void Swap(ResponseCacheEntry* other);
+
+  // implements Message ----------------------------------------------
+
+  ResponseCacheEntry* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ResponseCacheEntry& from);
+  void MergeFrom(const ResponseCacheEntry& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ClientCmdID cmd_id = 1;
+  inline bool has_cmd_id() const;
+  inline void clear_cmd_id();
+  static const int kCmdIdFieldNumber = 1;
+  inline const ::proto::ClientCmdID& cmd_id() const;
+  inline ::proto::ClientCmdID* mutable_cmd_id();
+  inline ::proto::ClientCmdID* release_cmd_id();
+  inline void set_allocated_cmd_id(::proto::ClientCmdID* cmd_id);
+
+  // optional .proto.ReadWriteCmdResponse response = 2;
+  inline bool has_response() const;
+  inline void clear_response();
+  static const int kResponseFieldNumber = 2;
+  inline const ::proto::ReadWriteCmdResponse& response() const;
+  inline ::proto::ReadWriteCmdResponse* mutable_response();
+  inline ::proto::ReadWriteCmdResponse* release_response();
+  inline void set_allocated_response(::proto::ReadWriteCmdResponse* response);
+
+  // @@protoc_insertion_point(class_scope:proto.ResponseCacheEntry)
+ private:
+  inline void set_has_cmd_id();
+  inline void clear_has_cmd_id();
+  inline void set_has_response();
+  inline void clear_has_response();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::ClientCmdID* cmd_id_;
+  ::proto::ReadWriteCmdResponse* response_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static ResponseCacheEntry* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class Lease : public ::google::protobuf::Message {
+ public:
+  Lease();
+  virtual ~Lease();
+
+  Lease(const Lease& from);
+
+  inline Lease& operator=(const Lease& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const Lease& default_instance();
+
+  void Swap(Lease* other);
+
+  // implements Message ----------------------------------------------
+
+  Lease* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const Lease& from);
+  void MergeFrom(const Lease& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.Timestamp start = 1;
+  inline bool has_start() const;
+  inline void clear_start();
+  static const int kStartFieldNumber = 1;
+  inline const ::proto::Timestamp& start() const;
+  inline ::proto::Timestamp* mutable_start();
+  inline ::proto::Timestamp* release_start();
+  inline void set_allocated_start(::proto::Timestamp* start);
+
+  // optional .proto.Timestamp expiration = 2;
+  inline bool has_expiration() const;
+  inline void clear_expiration();
+  static const int kExpirationFieldNumber = 2;
+  inline const ::proto::Timestamp& expiration() const;
+  inline ::proto::Timestamp* mutable_expiration();
+  inline ::proto::Timestamp* release_expiration();
+  inline void set_allocated_expiration(::proto::Timestamp* expiration);
+
+  // optional .proto.Replica replica = 3;
+  inline bool has_replica() const;
+  inline void clear_replica();
+  static const int kReplicaFieldNumber = 3;
+  inline const ::proto::Replica& replica() const;
+  inline ::proto::Replica* mutable_replica();
+  inline ::proto::Replica* release_replica();
+  inline void set_allocated_replica(::proto::Replica* replica);
+
+  // optional int64 epoch = 4;
+  inline bool has_epoch() const;
+  inline void clear_epoch();
+  static const int kEpochFieldNumber = 4;
+  inline ::google::protobuf::int64 epoch() const;
+  inline void set_epoch(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.Lease)
+ private:
+  inline void set_has_start();
+  inline void clear_has_start();
+  inline void set_has_expiration();
+  inline void clear_has_expiration();
+  inline void set_has_replica();
+  inline void clear_has_replica();
+  inline void set_has_epoch();
+  inline void clear_has_epoch();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::Timestamp* start_;
+  ::proto::Timestamp* expiration_;
+  ::proto::Replica* replica_;
+  ::google::protobuf::int64 epoch_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static Lease* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class LeaseTransfer : public ::google::protobuf::Message {
+ public:
+  LeaseTransfer();
+  virtual ~LeaseTransfer();
+
+  LeaseTransfer(const LeaseTransfer& from);
+
+  inline LeaseTransfer& operator=(const LeaseTransfer& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const LeaseTransfer& default_instance();
+
+  void Swap(LeaseTransfer* other);
+
+  // implements Message ----------------------------------------------
+
+  LeaseTransfer* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const LeaseTransfer& from);
+  void MergeFrom(const LeaseTransfer& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.Timestamp fence = 1;
+  inline bool has_fence() const;
+  inline void clear_fence();
+  static const int kFenceFieldNumber = 1;
+  inline const ::proto::Timestamp& fence() const;
+  inline ::proto::Timestamp* mutable_fence();
+  inline ::proto::Timestamp* release_fence();
+  inline void set_allocated_fence(::proto::Timestamp* fence);
+
+  // repeated .proto.ResponseCacheEntry response_cache = 2;
+  inline int response_cache_size() const;
+  inline void clear_response_cache();
+  static const int kResponseCacheFieldNumber = 2;
+  inline const ::proto::ResponseCacheEntry& response_cache(int index) const;
+  inline ::proto::ResponseCacheEntry* mutable_response_cache(int index);
+  inline ::proto::ResponseCacheEntry* add_response_cache();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::ResponseCacheEntry >&
+      response_cache() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::ResponseCacheEntry >*
+      mutable_response_cache();
+
+  // optional .proto.Replica holder = 3;
+  inline bool has_holder() const;
+  inline void clear_holder();
+  static const int kHolderFieldNumber = 3;
+  inline const ::proto::Replica& holder() const;
+  inline ::proto::Replica* mutable_holder();
+  inline ::proto::Replica* release_holder();
+  inline void set_allocated_holder(::proto::Replica* holder);
+
+  // @@protoc_insertion_point(class_scope:proto.LeaseTransfer)
+ private:
+  inline void set_has_fence();
+  inline void clear_has_fence();
+  inline void set_has_holder();
+  inline void clear_has_holder();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::proto::Timestamp* fence_;
+  ::google::protobuf::RepeatedPtrField< ::proto::ResponseCacheEntry > response_cache_;
+  ::proto::Replica* holder_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static LeaseTransfer* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalRaftCommandUnion : public ::google::protobuf::Message {
+ public:
+  InternalRaftCommandUnion();
+  virtual ~InternalRaftCommandUnion();
+
+  InternalRaftCommandUnion(const InternalRaftCommandUnion& from);
+
+  inline InternalRaftCommandUnion& operator=(const InternalRaftCommandUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalRaftCommandUnion& default_instance();
+
+  void Swap(InternalRaftCommandUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalRaftCommandUnion* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalRaftCommandUnion& from);
+  void MergeFrom(const InternalRaftCommandUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .proto.ContainsRequest contains = 1;
+  inline bool has_contains() const;
+  inline void clear_contains();
+  static const int kContainsFieldNumber = 1;
+  inline const ::proto::ContainsRequest& contains() const;
+  inline ::proto::ContainsRequest* mutable_contains();
+  inline ::proto::ContainsRequest* release_contains();
+  inline void set_allocated_contains(::proto::ContainsRequest* contains);
+
+  // optional .proto.GetRequest get = 2;
+  inline bool has_get() const;
+  inline void clear_get();
+  static const int kGetFieldNumber = 2;
+  inline const ::proto::GetRequest& get() const;
+  inline ::proto::GetRequest* mutable_get();
+  inline ::proto::GetRequest* release_get();
+  inline void set_allocated_get(::proto::GetRequest* get);
+
+  // optional .proto.PutRequest put = 3;
+  inline bool has_put() const;
+  inline void clear_put();
+  static const int kPutFieldNumber = 3;
+  inline const ::proto::PutRequest& put() const;
+  inline ::proto::PutRequest* mutable_put();
+  inline ::proto::PutRequest* release_put();
+  inline void set_allocated_put(::proto::PutRequest* put);
+
+  // optional .proto.ConditionalPutRequest conditional_put = 4;
+  inline bool has_conditional_put() const;
+  inline void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  inline const ::proto::ConditionalPutRequest& conditional_put() const;
+  inline ::proto::ConditionalPutRequest* mutable_conditional_put();
+  inline ::proto::ConditionalPutRequest* release_conditional_put();
+  inline void set_allocated_conditional_put(::proto::ConditionalPutRequest* conditional_put);
+
+  // optional .proto.IncrementRequest increment = 5;
+  inline bool has_increment() const;
+  inline void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  inline const ::proto::IncrementRequest& increment() const;
+  inline ::proto::IncrementRequest* mutable_increment();
+  inline ::proto::IncrementRequest* release_increment();
+  inline void set_allocated_increment(::proto::IncrementRequest* increment);
+
+  // optional .proto.DeleteRequest delete = 6;
+  inline bool has_delete_() const;
+  inline void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  inline const ::proto::DeleteRequest& delete_() const;
+  inline ::proto::DeleteRequest* mutable_delete_();
+  inline ::proto::DeleteRequest* release_delete_();
+  inline void set_allocated_delete_(::proto::DeleteRequest* delete_);
+
+  // optional .proto.DeleteRangeRequest delete_range = 7;
+  inline bool has_delete_range() const;
+  inline void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  inline const ::proto::DeleteRangeRequest& delete_range() const;
+  inline ::proto::DeleteRangeRequest* mutable_delete_range();
+  inline ::proto::DeleteRangeRequest* release_delete_range();
+  inline void set_allocated_delete_range(::proto::DeleteRangeRequest* delete_range);
+
+  // optional .proto.ScanRequest scan = 8;
+  inline bool has_scan() const;
+  inline void clear_scan();
+  static const int kScanFieldNumber = 8;
+  inline const ::proto::ScanRequest& scan() const;
+  inline ::proto::ScanRequest* mutable_scan();
+  inline ::proto::ScanRequest* release_scan();
+  inline void set_allocated_scan(::proto::ScanRequest* scan);
+
+  // optional .proto.EndTransactionRequest end_transaction = 9;
+  inline bool has_end_transaction() const;
+  inline void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  inline const ::proto::EndTransactionRequest& end_transaction() const;
+  inline ::proto::EndTransactionRequest* mutable_end_transaction();
+  inline ::proto::EndTransactionRequest* release_end_transaction();
+  inline void set_allocated_end_transaction(::proto::EndTransactionRequest* end_transaction);
+
+  // optional .proto.ReapQueueRequest reap_queue = 10;
+  inline bool has_reap_queue() const;
+  inline void clear_reap_queue();
+  static const int kReapQueueFieldNumber = 10;
+  inline const ::proto::ReapQueueRequest& reap_queue() const;
+  inline ::proto::ReapQueueRequest* mutable_reap_queue();
+  inline ::proto::ReapQueueRequest* release_reap_queue();
+  inline void set_allocated_reap_queue(::proto::ReapQueueRequest* reap_queue);
+
+  // optional .proto.EnqueueUpdateRequest enqueue_update = 11;
+  inline bool has_enqueue_update() const;
+  inline void clear_enqueue_update();
+  static const int kEnqueueUpdateFieldNumber = 11;
+  inline const ::proto::EnqueueUpdateRequest& enqueue_update() const;
+  inline ::proto::EnqueueUpdateRequest* mutable_enqueue_update();
+  inline ::proto::EnqueueUpdateRequest* release_enqueue_update();
+  inline void set_allocated_enqueue_update(::proto::EnqueueUpdateRequest* enqueue_update);
+
+  // optional .proto.EnqueueMessageRequest enqueue_message = 12;
+  inline bool has_enqueue_message() const;
+  inline void clear_enqueue_message();
+  static const int kEnqueueMessageFieldNumber = 12;
+  inline const ::proto::EnqueueMessageRequest& enqueue_message() const;
+  inline ::proto::EnqueueMessageRequest* mutable_enqueue_message();
+  inline ::proto::EnqueueMessageRequest* release_enqueue_message();
+  inline void set_allocated_enqueue_message(::proto::EnqueueMessageRequest* enqueue_message);
+
+  // optional .proto.ReverseScanRequest reverse_scan = 13;
+  inline bool has_reverse_scan() const;
+  inline void clear_reverse_scan();
+  static const int kReverseScanFieldNumber = 13;
+  inline const ::proto::ReverseScanRequest& reverse_scan() const;
+  inline ::proto::ReverseScanRequest* mutable_reverse_scan();
+  inline ::proto::ReverseScanRequest* release_reverse_scan();
+  inline void set_allocated_reverse_scan(::proto::ReverseScanRequest* reverse_scan);
+
+  // optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+  inline bool has_conditional_delete() const;
+  inline void clear_conditional_delete();
+  static const int kConditionalDeleteFieldNumber = 14;
+  inline const ::proto::ConditionalDeleteRequest& conditional_delete() const;
+  inline ::proto::ConditionalDeleteRequest* mutable_conditional_delete();
+  inline ::proto::ConditionalDeleteRequest* release_conditional_delete();
+  inline void set_allocated_conditional_delete(::proto::ConditionalDeleteRequest* conditional_delete);
+
+  // optional .proto.BatchRequest batch = 30;
+  inline bool has_batch() const;
+  inline void clear_batch();
+  static const int kBatchFieldNumber = 30;
+  inline const ::proto::BatchRequest& batch() const;
+  inline ::proto::BatchRequest* mutable_batch();
+  inline ::proto::BatchRequest* release_batch();
+  inline void set_allocated_batch(::proto::BatchRequest* batch);
+
+  // optional .proto.InternalRangeLookupRequest internal_range_lookup = 31;
+  inline bool has_internal_range_lookup() const;
+  inline void clear_internal_range_lookup();
+  static const int kInternalRangeLookupFieldNumber = 31;
+  inline const ::proto::InternalRangeLookupRequest& internal_range_lookup() const;
+  inline ::proto::InternalRangeLookupRequest* mutable_internal_range_lookup();
+  inline ::proto::InternalRangeLookupRequest* release_internal_range_lookup();
+  inline void set_allocated_internal_range_lookup(::proto::InternalRangeLookupRequest* internal_range_lookup);
+
+  // optional .proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
+  inline bool has_internal_heartbeat_txn() const;
+  inline void clear_internal_heartbeat_txn();
+  static const int kInternalHeartbeatTxnFieldNumber = 32;
+  inline const ::proto::InternalHeartbeatTxnRequest& internal_heartbeat_txn() const;
+  inline ::proto::InternalHeartbeatTxnRequest* mutable_internal_heartbeat_txn();
+  inline ::proto::InternalHeartbeatTxnRequest* release_internal_heartbeat_txn();
+  inline void set_allocated_internal_heartbeat_txn(::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn);
+
+  // optional .proto.InternalPushTxnRequest internal_push_txn = 33;
+  inline bool has_internal_push_txn() const;
+  inline void clear_internal_push_txn();
+  static const int kInternalPushTxnFieldNumber = 33;
+  inline const ::proto::InternalPushTxnRequest& internal_push_txn() const;
+  inline ::proto::InternalPushTxnRequest* mutable_internal_push_txn();
+  inline ::proto::InternalPushTxnRequest* release_internal_push_txn();
+  inline void set_allocated_internal_push_txn(::proto::InternalPushTxnRequest* internal_push_txn);
+
+  // optional .proto.InternalResolveIntentRequest internal_resolve_intent = 34;
+  inline bool has_internal_resolve_intent() const;
+  inline void clear_internal_resolve_intent();
+  static const int kInternalResolveIntentFieldNumber = 34;
+  inline const ::proto::InternalResolveIntentRequest& internal_resolve_intent() const;
+  inline ::proto::InternalResolveIntentRequest* mutable_internal_resolve_intent();
+  inline ::proto::InternalResolveIntentRequest* release_internal_resolve_intent();
+  inline void set_allocated_internal_resolve_intent(::proto::InternalResolveIntentRequest* internal_resolve_intent);
+
+  // optional .proto.InternalMergeRequest internal_merge_response = 35;
+  inline bool has_internal_merge_response() const;
+  inline void clear_internal_merge_response();
+  static const int kInternalMergeResponseFieldNumber = 35;
+  inline const ::proto::InternalMergeRequest& internal_merge_response() const;
+  inline ::proto::InternalMergeRequest* mutable_internal_merge_response();
+  inline ::proto::InternalMergeRequest* release_internal_merge_response();
+  inline void set_allocated_internal_merge_response(::proto::InternalMergeRequest* internal_merge_response);
+
+  // optional .proto.InternalTruncateLogRequest internal_truncate_log = 36;
+  inline bool has_internal_truncate_log() const;
+  inline void clear_internal_truncate_log();
+  static const int kInternalTruncateLogFieldNumber = 36;
+  inline const ::proto::InternalTruncateLogRequest& internal_truncate_log() const;
+  inline ::proto::InternalTruncateLogRequest* mutable_internal_truncate_log();
+  inline ::proto::InternalTruncateLogRequest* release_internal_truncate_log();
+  inline void set_allocated_internal_truncate_log(::proto::InternalTruncateLogRequest* internal_truncate_log);
+
+  // optional .proto.InternalGCRequest internal_gc = 37;
+  inline bool has_internal_gc() const;
+  inline void clear_internal_gc();
+  static const int kInternalGcFieldNumber = 37;
+  inline const ::proto::InternalGCRequest& internal_gc() const;
+  inline ::proto::InternalGCRequest* mutable_internal_gc();
+  inline ::proto::InternalGCRequest* release_internal_gc();
+  inline void set_allocated_internal_gc(::proto::InternalGCRequest* internal_gc);
+
+  // optional .proto.InternalBeginTransactionRequest internal_begin_transaction = 38;
+  inline bool has_internal_begin_transaction() const;
+  inline void clear_internal_begin_transaction();
+  static const int kInternalBeginTransactionFieldNumber = 38;
+  inline const ::proto::InternalBeginTransactionRequest& internal_begin_transaction() const;
+  inline ::proto::InternalBeginTransactionRequest* mutable_internal_begin_transaction();
+  inline ::proto::InternalBeginTransactionRequest* release_internal_begin_transaction();
+  inline void set_allocated_internal_begin_transaction(::proto::InternalBeginTransactionRequest* internal_begin_transaction);
+
+  // optional .proto.InternalScanIntentsRequest internal_scan_intents = 39;
+  inline bool has_internal_scan_intents() const;
+  inline void clear_internal_scan_intents();
+  static const int kInternalScanIntentsFieldNumber = 39;
+  inline const ::proto::InternalScanIntentsRequest& internal_scan_intents() const;
+  inline ::proto::InternalScanIntentsRequest* mutable_internal_scan_intents();
+  inline ::proto::InternalScanIntentsRequest* release_internal_scan_intents();
+  inline void set_allocated_internal_scan_intents(::proto::InternalScanIntentsRequest* internal_scan_intents);
+
+  // optional .proto.InternalInspectTimestampCacheRequest internal_inspect_timestamp_cache = 40;
+  inline bool has_internal_inspect_timestamp_cache() const;
+  inline void clear_internal_inspect_timestamp_cache();
+  static const int kInternalInspectTimestampCacheFieldNumber = 40;
+  inline const ::proto::InternalInspectTimestampCacheRequest& internal_inspect_timestamp_cache() const;
+  inline ::proto::InternalInspectTimestampCacheRequest* mutable_internal_inspect_timestamp_cache();
+  inline ::proto::InternalInspectTimestampCacheRequest* release_internal_inspect_timestamp_cache();
+  inline void set_allocated_internal_inspect_timestamp_cache(::proto::InternalInspectTimestampCacheRequest* internal_inspect_timestamp_cache);
+
+  // optional .proto.InternalGetTransactionRequest internal_get_transaction = 41;
+  inline bool has_internal_get_transaction() const;
+  inline void clear_internal_get_transaction();
+  static const int kInternalGetTransactionFieldNumber = 41;
+  inline const ::proto::InternalGetTransactionRequest& internal_get_transaction() const;
+  inline ::proto::InternalGetTransactionRequest* mutable_internal_get_transaction();
+  inline ::proto::InternalGetTransactionRequest* release_internal_get_transaction();
+  inline void set_allocated_internal_get_transaction(::proto::InternalGetTransactionRequest* internal_get_transaction);
+
+  // optional .proto.InternalPutIfAbsentRequest internal_put_if_absent = 42;
+  inline bool has_internal_put_if_absent() const;
+  inline void clear_internal_put_if_absent();
+  static const int kInternalPutIfAbsentFieldNumber = 42;
+  inline const ::proto::InternalPutIfAbsentRequest& internal_put_if_absent() const;
+  inline ::proto::InternalPutIfAbsentRequest* mutable_internal_put_if_absent();
+  inline ::proto::InternalPutIfAbsentRequest* release_internal_put_if_absent();
+  inline void set_allocated_internal_put_if_absent(::proto::InternalPutIfAbsentRequest* internal_put_if_absent);
+
+  // optional .proto.InternalRangeKeyBoundsRequest internal_range_key_bounds = 43;
+  inline bool has_internal_range_key_bounds() const;
+  inline void clear_internal_range_key_bounds();
+  static const int kInternalRangeKeyBoundsFieldNumber = 43;
+  inline const ::proto::InternalRangeKeyBoundsRequest& internal_range_key_bounds() const;
+  inline ::proto::InternalRangeKeyBoundsRequest* mutable_internal_range_key_bounds();
+  inline ::proto::InternalRangeKeyBoundsRequest* release_internal_range_key_bounds();
+  inline void set_allocated_internal_range_key_bounds(::proto::InternalRangeKeyBoundsRequest* internal_range_key_bounds);
+
+  // optional .proto.InternalVerifyRangeRequest internal_verify_range = 44;
+  inline bool has_internal_verify_range() const;
+  inline void clear_internal_verify_range();
+  static const int kInternalVerifyRangeFieldNumber = 44;
+  inline const ::proto::InternalVerifyRangeRequest& internal_verify_range() const;
+  inline ::proto::InternalVerifyRangeRequest* mutable_internal_verify_range();
+  inline ::proto::InternalVerifyRangeRequest* release_internal_verify_range();
+  inline void set_allocated_internal_verify_range(::proto::InternalVerifyRangeRequest* internal_verify_range);
+
+  // optional .proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 45;
+  inline bool has_internal_resolve_intent_range() const;
+  inline void clear_internal_resolve_intent_range();
+  static const int kInternalResolveIntentRangeFieldNumber = 45;
+  inline const ::proto::InternalResolveIntentRangeRequest& internal_resolve_intent_range() const;
+  inline ::proto::InternalResolveIntentRangeRequest* mutable_internal_resolve_intent_range();
+  inline ::proto::InternalResolveIntentRangeRequest* release_internal_resolve_intent_range();
+  inline void set_allocated_internal_resolve_intent_range(::proto::InternalResolveIntentRangeRequest* internal_resolve_intent_range);
+
+  // optional .proto.InternalLeaderLeaseRequest internal_leader_lease = 46;
+  inline bool has_internal_leader_lease() const;
+  inline void clear_internal_leader_lease();
+  static const int kInternalLeaderLeaseFieldNumber = 46;
+  inline const ::proto::InternalLeaderLeaseRequest& internal_leader_lease() const;
+  inline ::proto::InternalLeaderLeaseRequest* mutable_internal_leader_lease();
+  inline ::proto::InternalLeaderLeaseRequest* release_internal_leader_lease();
+  inline void set_allocated_internal_leader_lease(::proto::InternalLeaderLeaseRequest* internal_leader_lease);
+
+  // optional .proto.InternalComputeChecksumRequest internal_compute_checksum = 47;
+  inline bool has_internal_compute_checksum() const;
+  inline void clear_internal_compute_checksum();
+  static const int kInternalComputeChecksumFieldNumber = 47;
+  inline const ::proto::InternalComputeChecksumRequest& internal_compute_checksum() const;
+  inline ::proto::InternalComputeChecksumRequest* mutable_internal_compute_checksum();
+  inline ::proto::InternalComputeChecksumRequest* release_internal_compute_checksum();
+  inline void set_allocated_internal_compute_checksum(::proto::InternalComputeChecksumRequest* internal_compute_checksum);
+
+  // optional .proto.InternalGetHistoryRequest internal_get_history = 48;
+  inline bool has_internal_get_history() const;
+  inline void clear_internal_get_history();
+  static const int kInternalGetHistoryFieldNumber = 48;
+  inline const ::proto::InternalGetHistoryRequest& internal_get_history() const;
+  inline ::proto::InternalGetHistoryRequest* mutable_internal_get_history();
+  inline ::proto::InternalGetHistoryRequest* release_internal_get_history();
+  inline void set_allocated_internal_get_history(::proto::InternalGetHistoryRequest* internal_get_history);
+
+  // optional .proto.InternalBatchGetRequest internal_batch_get = 49;
+  inline bool has_internal_batch_get() const;
+  inline void clear_internal_batch_get();
+  static const int kInternalBatchGetFieldNumber = 49;
+  inline const ::proto::InternalBatchGetRequest& internal_batch_get() const;
+  inline ::proto::InternalBatchGetRequest* mutable_internal_batch_get();
+  inline ::proto::InternalBatchGetRequest* release_internal_batch_get();
+  inline void set_allocated_internal_batch_get(::proto::InternalBatchGetRequest* internal_batch_get);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalRaftCommandUnion)
+ private:
+  inline void set_has_contains();
+  inline void clear_has_contains();
+  inline void set_has_get();
+  inline void clear_has_get();
+  inline void set_has_put();
+  inline void clear_has_put();
+  inline void set_has_conditional_put();
+  inline void clear_has_conditional_put();
+  inline void set_has_increment();
+  inline void clear_has_increment();
+  inline void set_has_delete_();
+  inline void clear_has_delete_();
+  inline void set_has_delete_range();
+  inline void clear_has_delete_range();
+  inline void set_has_scan();
+  inline void clear_has_scan();
+  inline void set_has_end_transaction();
+  inline void clear_has_end_transaction();
+  inline void set_has_reap_queue();
+  inline void clear_has_reap_queue();
+  inline void set_has_enqueue_update();
+  inline void clear_has_enqueue_update();
+  inline void set_has_enqueue_message();
+  inline void clear_has_enqueue_message();
+  inline void set_has_reverse_scan();
+  inline void clear_has_reverse_scan();
+  inline void set_has_conditional_delete();
+  inline void clear_has_conditional_delete();
+  inline void set_has_batch();
+  inline void clear_has_batch();
+  inline void set_has_internal_range_lookup();
+  inline void clear_has_internal_range_lookup();
+  inline void set_has_internal_heartbeat_txn();
+  inline void clear_has_internal_heartbeat_txn();
+  inline void set_has_internal_push_txn();
+  inline void clear_has_internal_push_txn();
+  inline void set_has_internal_resolve_intent();
+  inline void clear_has_internal_resolve_intent();
+  inline void set_has_internal_merge_response();
+  inline void clear_has_internal_merge_response();
+  inline void set_has_internal_truncate_log();
+  inline void clear_has_internal_truncate_log();
+  inline void set_has_internal_gc();
+  inline void clear_has_internal_gc();
+  inline void set_has_internal_begin_transaction();
+  inline void clear_has_internal_begin_transaction();
+  inline void set_has_internal_scan_intents();
+  inline void clear_has_internal_scan_intents();
+  inline void set_has_internal_inspect_timestamp_cache();
+  inline void clear_has_internal_inspect_timestamp_cache();
+  inline void set_has_internal_get_transaction();
+  inline void clear_has_internal_get_transaction();
+  inline void set_has_internal_put_if_absent();
+  inline void clear_has_internal_put_if_absent();
+  inline void set_has_internal_range_key_bounds();
+  inline void clear_has_internal_range_key_bounds();
+  inline void set_has_internal_verify_range();
+  inline void clear_has_internal_verify_range();
+  inline void set_has_internal_resolve_intent_range();
+  inline void clear_has_internal_resolve_intent_range();
+  inline void set_has_internal_leader_lease();
+  inline void clear_has_internal_leader_lease();
+  inline void set_has_internal_compute_checksum();
+  inline void clear_has_internal_compute_checksum();
+  inline void set_has_internal_get_history();
+  inline void clear_has_internal_get_history();
+  inline void set_has_internal_batch_get();
+  inline void clear_has_internal_batch_get();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[2];
+  ::proto::ContainsRequest* contains_;
+  ::proto::GetRequest* get_;
+  ::proto::PutRequest* put_;
+  ::proto::ConditionalPutRequest* conditional_put_;
+  ::proto::IncrementRequest* increment_;
+  ::proto::DeleteRequest* delete__;
+  ::proto::DeleteRangeRequest* delete_range_;
+  ::proto::ScanRequest* scan_;
+  ::proto::EndTransactionRequest* end_transaction_;
+  ::proto::ReapQueueRequest* reap_queue_;
+  ::proto::EnqueueUpdateRequest* enqueue_update_;
+  ::proto::EnqueueMessageRequest* enqueue_message_;
+  ::proto::ReverseScanRequest* reverse_scan_;
+  ::proto::ConditionalDeleteRequest* conditional_delete_;
+  ::proto::BatchRequest* batch_;
+  ::proto::InternalRangeLookupRequest* internal_range_lookup_;
+  ::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
+  ::proto::InternalPushTxnRequest* internal_push_txn_;
+  ::proto::InternalResolveIntentRequest* internal_resolve_intent_;
+  ::proto::InternalMergeRequest* internal_merge_response_;
+  ::proto::InternalTruncateLogRequest* internal_truncate_log_;
+  ::proto::InternalGCRequest* internal_gc_;
+  ::proto::InternalBeginTransactionRequest* internal_begin_transaction_;
+  ::proto::InternalScanIntentsRequest* internal_scan_intents_;
+  ::proto::InternalInspectTimestampCacheRequest* internal_inspect_timestamp_cache_;
+  ::proto::InternalGetTransactionRequest* internal_get_transaction_;
+  ::proto::InternalPutIfAbsentRequest* internal_put_if_absent_;
+  ::proto::InternalRangeKeyBoundsRequest* internal_range_key_bounds_;
+  ::proto::InternalVerifyRangeRequest* internal_verify_range_;
+  ::proto::InternalResolveIntentRangeRequest* internal_resolve_intent_range_;
+  ::proto::InternalLeaderLeaseRequest* internal_leader_lease_;
+  ::proto::InternalComputeChecksumRequest* internal_compute_checksum_;
+  ::proto::InternalGetHistoryRequest* internal_get_history_;
+  ::proto::InternalBatchGetRequest* internal_batch_get_;
+  mutable int _cached_size_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalRaftCommandUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalRaftCommand : public ::google::protobuf::Message {
+ public:
+  InternalRaftCommand();
+  virtual ~InternalRaftCommand();
+
+  InternalRaftCommand(const InternalRaftCommand& from);
+
+  inline InternalRaftCommand& operator=(const InternalRaftCommand& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalRaftCommand& default_instance();
+
+  void Swap(InternalRaftCommand* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalRaftCommand* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalRaftCommand& from);
+  void MergeFrom(const InternalRaftCommand& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 raft_id = 2;
+  inline bool has_raft_id() const;
+  inline void clear_raft_id();
+  static const int kRaftIdFieldNumber = 2;
+  inline ::google::protobuf::int64 raft_id() const;
+  inline void set_raft_id(::google::protobuf::int64 value);
+
+  // optional .proto.InternalRaftCommandUnion cmd = 3;
+  inline bool has_cmd() const;
+  inline void clear_cmd();
+  static const int kCmdFieldNumber = 3;
+  inline const ::proto::InternalRaftCommandUnion& cmd() const;
+  inline ::proto::InternalRaftCommandUnion* mutable_cmd();
+  inline ::proto::InternalRaftCommandUnion* release_cmd();
+  inline void set_allocated_cmd(::proto::InternalRaftCommandUnion* cmd);
+
+  // optional int64 generation = 4;
+  inline bool has_generation() const;
+  inline void clear_generation();
+  static const int kGenerationFieldNumber = 4;
+  inline ::google::protobuf::int64 generation() const;
+  inline void set_generation(::google::protobuf::int64 value);
+
+  // optional int64 lease_epoch = 5;
+  inline bool has_lease_epoch() const;
+  inline void clear_lease_epoch();
+  static const int kLeaseEpochFieldNumber = 5;
+  inline ::google::protobuf::int64 lease_epoch() const;
+  inline void set_lease_epoch(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalRaftCommand)
+ private:
+  inline void set_has_raft_id();
+  inline void clear_has_raft_id();
+  inline void set_has_cmd();
+  inline void clear_has_cmd();
+  inline void set_has_generation();
+  inline void clear_has_generation();
+  inline void set_has_lease_epoch();
+  inline void clear_has_lease_epoch();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 raft_id_;
+  ::proto::InternalRaftCommandUnion* cmd_;
+  ::google::protobuf::int64 generation_;
+  ::google::protobuf::int64 lease_epoch_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalRaftCommand* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalTimeSeriesData : public ::google::protobuf::Message {
+ public:
+  InternalTimeSeriesData();
+  virtual ~InternalTimeSeriesData();
+
+  InternalTimeSeriesData(const InternalTimeSeriesData& from);
+
+  inline InternalTimeSeriesData& operator=(const InternalTimeSeriesData& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalTimeSeriesData& default_instance();
+
+  void Swap(InternalTimeSeriesData* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalTimeSeriesData* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalTimeSeriesData& from);
+  void MergeFrom(const InternalTimeSeriesData& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int64 start_timestamp_nanos = 1;
+  inline bool has_start_timestamp_nanos() const;
+  inline void clear_start_timestamp_nanos();
+  static const int kStartTimestampNanosFieldNumber = 1;
+  inline ::google::protobuf::int64 start_timestamp_nanos() const;
+  inline void set_start_timestamp_nanos(::google::protobuf::int64 value);
+
+  // optional int64 sample_duration_nanos = 2;
+  inline bool has_sample_duration_nanos() const;
+  inline void clear_sample_duration_nanos();
+  static const int kSampleDurationNanosFieldNumber = 2;
+  inline ::google::protobuf::int64 sample_duration_nanos() const;
+  inline void set_sample_duration_nanos(::google::protobuf::int64 value);
+
+  // repeated .proto.InternalTimeSeriesSample samples = 3;
+  inline int samples_size() const;
+  inline void clear_samples();
+  static const int kSamplesFieldNumber = 3;
+  inline const ::proto::InternalTimeSeriesSample& samples(int index) const;
+  inline ::proto::InternalTimeSeriesSample* mutable_samples(int index);
+  inline ::proto::InternalTimeSeriesSample* add_samples();
+  inline const ::google::protobuf::RepeatedPtrField< ::proto::InternalTimeSeriesSample >&
+      samples() const;
+  inline ::google::protobuf::RepeatedPtrField< ::proto::InternalTimeSeriesSample >*
+      mutable_samples();
+
+  // @@protoc_insertion_point(class_scope:proto.InternalTimeSeriesData)
+ private:
+  inline void set_has_start_timestamp_nanos();
+  inline void clear_has_start_timestamp_nanos();
+  inline void set_has_sample_duration_nanos();
+  inline void clear_has_sample_duration_nanos();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int64 start_timestamp_nanos_;
+  ::google::protobuf::int64 sample_duration_nanos_;
+  ::google::protobuf::RepeatedPtrField< ::proto::InternalTimeSeriesSample > samples_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalTimeSeriesData* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class InternalTimeSeriesSample : public ::google::protobuf::Message {
+ public:
+  InternalTimeSeriesSample();
+  virtual ~InternalTimeSeriesSample();
+
+  InternalTimeSeriesSample(const InternalTimeSeriesSample& from);
+
+  inline InternalTimeSeriesSample& operator=(const InternalTimeSeriesSample& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _unknown_fields_;
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return &_unknown_fields_;
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const InternalTimeSeriesSample& default_instance();
+
+  void Swap(InternalTimeSeriesSample* other);
+
+  // implements Message ----------------------------------------------
+
+  InternalTimeSeriesSample* New() const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const InternalTimeSeriesSample& from);
+  void MergeFrom(const InternalTimeSeriesSample& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  public:
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional int32 offset = 1;
+  inline bool has_offset() const;
+  inline void clear_offset();
+  static const int kOffsetFieldNumber = 1;
+  inline ::google::protobuf::int32 offset() const;
+  inline void set_offset(::google::protobuf::int32 value);
+
+  // optional uint32 int_count = 2;
+  inline bool has_int_count() const;
+  inline void clear_int_count();
+  static const int kIntCountFieldNumber = 2;
+  inline ::google::protobuf::uint32 int_count() const;
+  inline void set_int_count(::google::protobuf::uint32 value);
+
+  // optional int64 int_sum = 3;
+  inline bool has_int_sum() const;
+  inline void clear_int_sum();
+  static const int kIntSumFieldNumber = 3;
+  inline ::google::protobuf::int64 int_sum() const;
+  inline void set_int_sum(::google::protobuf::int64 value);
+
+  // optional int64 int_max = 4;
+  inline bool has_int_max() const;
+  inline void clear_int_max();
+  static const int kIntMaxFieldNumber = 4;
+  inline ::google::protobuf::int64 int_max() const;
+  inline void set_int_max(::google::protobuf::int64 value);
+
+  // optional int64 int_min = 5;
+  inline bool has_int_min() const;
+  inline void clear_int_min();
+  static const int kIntMinFieldNumber = 5;
+  inline ::google::protobuf::int64 int_min() const;
+  inline void set_int_min(::google::protobuf::int64 value);
+
+  // optional uint32 float_count = 6;
+  inline bool has_float_count() const;
+  inline void clear_float_count();
+  static const int kFloatCountFieldNumber = 6;
+  inline ::google::protobuf::uint32 float_count() const;
+  inline void set_float_count(::google::protobuf::uint32 value);
+
+  // optional float float_sum = 7;
+  inline bool has_float_sum() const;
+  inline void clear_float_sum();
+  static const int kFloatSumFieldNumber = 7;
+  inline float float_sum() const;
+  inline void set_float_sum(float value);
+
+  // optional float float_max = 8;
+  inline bool has_float_max() const;
+  inline void clear_float_max();
+  static const int kFloatMaxFieldNumber = 8;
+  inline float float_max() const;
+  inline void set_float_max(float value);
+
+  // optional float float_min = 9;
+  inline bool has_float_min() const;
+  inline void clear_float_min();
+  static const int kFloatMinFieldNumber = 9;
+  inline float float_min() const;
+  inline void set_float_min(float value);
+
+  // @@protoc_insertion_point(class_scope:proto.InternalTimeSeriesSample)
+ private:
+  inline void set_has_offset();
+  inline void clear_has_offset();
+  inline void set_has_int_count();
+  inline void clear_has_int_count();
+  inline void set_has_int_sum();
+  inline void clear_has_int_sum();
+  inline void set_has_int_max();
+  inline void clear_has_int_max();
+  inline void set_has_int_min();
+  inline void clear_has_int_min();
+  inline void set_has_float_count();
+  inline void clear_has_float_count();
+  inline void set_has_float_sum();
+  inline void clear_has_float_sum();
+  inline void set_has_float_max();
+  inline void clear_has_float_max();
+  inline void set_has_float_min();
+  inline void clear_has_float_min();
+
+  ::google::protobuf::UnknownFieldSet _unknown_fields_;
+
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::int32 offset_;
+  ::google::protobuf::uint32 int_count_;
+  ::google::protobuf::int64 int_sum_;
+  ::google::protobuf::int64 int_max_;
+  ::google::protobuf::int64 int_min_;
+  ::google::protobuf::uint32 float_count_;
+  float float_sum_;
+  float float_max_;
+  float float_min_;
+  friend void  protobuf_AddDesc_internal_2eproto();
+  friend void protobuf_AssignDesc_internal_2eproto();
+  friend void protobuf_ShutdownFile_internal_2eproto();
+
+  void InitAsDefaultInstance();
+  static InternalTimeSeriesSample* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+// InternalRangeLookupRequest
+
+// optional .proto.RequestHeader header = 1;
 inline bool InternalRangeLookupRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalRangeLookupRequest::set_has_header() {
+inline void InternalRangeLookupRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalRangeLookupRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalRangeLookupRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalRangeLookupRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalRangeLookupRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRangeLookupRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalRangeLookupRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalRangeLookupRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeLookupRequest.header)
+}
+
+// optional int32 max_ranges = 2;
+inline bool InternalRangeLookupRequest::has_max_ranges() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalRangeLookupRequest::set_has_max_ranges() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalRangeLookupRequest::clear_has_max_ranges() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalRangeLookupRequest::clear_max_ranges() {
+  max_ranges_ = 0;
+  clear_has_max_ranges();
+}
+inline ::google::protobuf::int32 InternalRangeLookupRequest::max_ranges() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupRequest.max_ranges)
+  return max_ranges_;
+}
+inline void InternalRangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
+  set_has_max_ranges();
+  max_ranges_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalRangeLookupRequest.max_ranges)
+}
+
+// -------------------------------------------------------------------
+
+// InternalRangeLookupResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalRangeLookupResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalRangeLookupResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalRangeLookupResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalRangeLookupResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalRangeLookupResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalRangeLookupResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRangeLookupResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalRangeLookupResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalRangeLookupResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeLookupResponse.header)
+}
+
+// repeated .proto.RangeDescriptor ranges = 2;
+inline int InternalRangeLookupResponse::ranges_size() const {
+  return ranges_.size();
+}
+inline void InternalRangeLookupResponse::clear_ranges() {
+  ranges_.Clear();
+}
+inline const ::proto::RangeDescriptor& InternalRangeLookupResponse::ranges(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupResponse.ranges)
+  return ranges_.Get(index);
+}
+inline ::proto::RangeDescriptor* InternalRangeLookupResponse::mutable_ranges(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalRangeLookupResponse.ranges)
+  return ranges_.Mutable(index);
+}
+inline ::proto::RangeDescriptor* InternalRangeLookupResponse::add_ranges() {
+  // @@protoc_insertion_point(field_add:proto.InternalRangeLookupResponse.ranges)
+  return ranges_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::RangeDescriptor >&
+InternalRangeLookupResponse::ranges() const {
+  // @@protoc_insertion_point(field_list:proto.InternalRangeLookupResponse.ranges)
+  return ranges_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::RangeDescriptor >*
+InternalRangeLookupResponse::mutable_ranges() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalRangeLookupResponse.ranges)
+  return &ranges_;
+}
+
+// -------------------------------------------------------------------
+
+// InternalHeartbeatTxnRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalHeartbeatTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalHeartbeatTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalHeartbeatTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalHeartbeatTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalHeartbeatTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalHeartbeatTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalHeartbeatTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalHeartbeatTxnRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalHeartbeatTxnRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalHeartbeatTxnRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalHeartbeatTxnRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalHeartbeatTxnResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalHeartbeatTxnResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalHeartbeatTxnResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalHeartbeatTxnResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalHeartbeatTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalHeartbeatTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalHeartbeatTxnResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalHeartbeatTxnResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalHeartbeatTxnResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalHeartbeatTxnResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalHeartbeatTxnResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalHeartbeatTxnResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalGCRequest_GCKey
+
+// optional bytes key = 1;
+inline bool InternalGCRequest_GCKey::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalGCRequest_GCKey::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalGCRequest_GCKey::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalGCRequest_GCKey::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& InternalGCRequest_GCKey::key() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.GCKey.key)
+  return *key_;
+}
+inline void InternalGCRequest_GCKey::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.InternalGCRequest.GCKey.key)
+}
+inline void InternalGCRequest_GCKey::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalGCRequest.GCKey.key)
+}
+inline void InternalGCRequest_GCKey::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalGCRequest.GCKey.key)
+}
+inline ::std::string* InternalGCRequest_GCKey::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.GCKey.key)
+  return key_;
+}
+inline ::std::string* InternalGCRequest_GCKey::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void InternalGCRequest_GCKey::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.GCKey.key)
+}
+
+// optional .proto.Timestamp timestamp = 2;
+inline bool InternalGCRequest_GCKey::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalGCRequest_GCKey::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalGCRequest_GCKey::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalGCRequest_GCKey::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::proto::Timestamp& InternalGCRequest_GCKey::timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.GCKey.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::proto::Timestamp* InternalGCRequest_GCKey::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.GCKey.timestamp)
+  return timestamp_;
+}
+inline ::proto::Timestamp* InternalGCRequest_GCKey::release_timestamp() {
+  clear_has_timestamp();
+  ::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void InternalGCRequest_GCKey::set_allocated_timestamp(::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.GCKey.timestamp)
+}
+
+// -------------------------------------------------------------------
+
+// InternalGCRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalGCRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalGCRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalGCRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalGCRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalGCRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalGCRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalGCRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalGCRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.header)
+}
+
+// optional .proto.GCMetadata gc_meta = 2;
+inline bool InternalGCRequest::has_gc_meta() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalGCRequest::set_has_gc_meta() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalGCRequest::clear_has_gc_meta() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalGCRequest::clear_gc_meta() {
+  if (gc_meta_ != NULL) gc_meta_->::proto::GCMetadata::Clear();
+  clear_has_gc_meta();
+}
+inline const ::proto::GCMetadata& InternalGCRequest::gc_meta() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.gc_meta)
+  return gc_meta_ != NULL ? *gc_meta_ : *default_instance_->gc_meta_;
+}
+inline ::proto::GCMetadata* InternalGCRequest::mutable_gc_meta() {
+  set_has_gc_meta();
+  if (gc_meta_ == NULL) gc_meta_ = new ::proto::GCMetadata;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.gc_meta)
+  return gc_meta_;
+}
+inline ::proto::GCMetadata* InternalGCRequest::release_gc_meta() {
+  clear_has_gc_meta();
+  ::proto::GCMetadata* temp = gc_meta_;
+  gc_meta_ = NULL;
+  return temp;
+}
+inline void InternalGCRequest::set_allocated_gc_meta(::proto::GCMetadata* gc_meta) {
+  delete gc_meta_;
+  gc_meta_ = gc_meta;
+  if (gc_meta) {
+    set_has_gc_meta();
+  } else {
+    clear_has_gc_meta();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.gc_meta)
+}
+
+// repeated .proto.InternalGCRequest.GCKey keys = 3;
+inline int InternalGCRequest::keys_size() const {
+  return keys_.size();
+}
+inline void InternalGCRequest::clear_keys() {
+  keys_.Clear();
+}
+inline const ::proto::InternalGCRequest_GCKey& InternalGCRequest::keys(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.keys)
+  return keys_.Get(index);
+}
+inline ::proto::InternalGCRequest_GCKey* InternalGCRequest::mutable_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.keys)
+  return keys_.Mutable(index);
+}
+inline ::proto::InternalGCRequest_GCKey* InternalGCRequest::add_keys() {
+  // @@protoc_insertion_point(field_add:proto.InternalGCRequest.keys)
+  return keys_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::InternalGCRequest_GCKey >&
+InternalGCRequest::keys() const {
+  // @@protoc_insertion_point(field_list:proto.InternalGCRequest.keys)
+  return keys_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::InternalGCRequest_GCKey >*
+InternalGCRequest::mutable_keys() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalGCRequest.keys)
+  return &keys_;
+}
+
+// repeated bytes txn_keys = 4;
+inline int InternalGCRequest::txn_keys_size() const {
+  return txn_keys_.size();
+}
+inline void InternalGCRequest::clear_txn_keys() {
+  txn_keys_.Clear();
+}
+inline const ::std::string& InternalGCRequest::txn_keys(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.txn_keys)
+  return txn_keys_.Get(index);
+}
+inline ::std::string* InternalGCRequest::mutable_txn_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.txn_keys)
+  return txn_keys_.Mutable(index);
+}
+inline void InternalGCRequest::set_txn_keys(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:proto.InternalGCRequest.txn_keys)
+  txn_keys_.Mutable(index)->assign(value);
+}
+inline void InternalGCRequest::set_txn_keys(int index, const char* value) {
+  txn_keys_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalGCRequest.txn_keys)
+}
+inline void InternalGCRequest::set_txn_keys(int index, const void* value, size_t size) {
+  txn_keys_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalGCRequest.txn_keys)
+}
+inline ::std::string* InternalGCRequest::add_txn_keys() {
+  return txn_keys_.Add();
+}
+inline void InternalGCRequest::add_txn_keys(const ::std::string& value) {
+  txn_keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:proto.InternalGCRequest.txn_keys)
+}
+inline void InternalGCRequest::add_txn_keys(const char* value) {
+  txn_keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:proto.InternalGCRequest.txn_keys)
+}
+inline void InternalGCRequest::add_txn_keys(const void* value, size_t size) {
+  txn_keys_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:proto.InternalGCRequest.txn_keys)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+InternalGCRequest::txn_keys() const {
+  // @@protoc_insertion_point(field_list:proto.InternalGCRequest.txn_keys)
+  return txn_keys_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+InternalGCRequest::mutable_txn_keys() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalGCRequest.txn_keys)
+  return &txn_keys_;
+}
+
+// optional .proto.Timestamp txn_threshold = 5;
+inline bool InternalGCRequest::has_txn_threshold() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void InternalGCRequest::set_has_txn_threshold() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void InternalGCRequest::clear_has_txn_threshold() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void InternalGCRequest::clear_txn_threshold() {
+  if (txn_threshold_ != NULL) txn_threshold_->::proto::Timestamp::Clear();
+  clear_has_txn_threshold();
+}
+inline const ::proto::Timestamp& InternalGCRequest::txn_threshold() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.txn_threshold)
+  return txn_threshold_ != NULL ? *txn_threshold_ : *default_instance_->txn_threshold_;
+}
+inline ::proto::Timestamp* InternalGCRequest::mutable_txn_threshold() {
+  set_has_txn_threshold();
+  if (txn_threshold_ == NULL) txn_threshold_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.txn_threshold)
+  return txn_threshold_;
+}
+inline ::proto::Timestamp* InternalGCRequest::release_txn_threshold() {
+  clear_has_txn_threshold();
+  ::proto::Timestamp* temp = txn_threshold_;
+  txn_threshold_ = NULL;
+  return temp;
+}
+inline void InternalGCRequest::set_allocated_txn_threshold(::proto::Timestamp* txn_threshold) {
+  delete txn_threshold_;
+  txn_threshold_ = txn_threshold;
+  if (txn_threshold) {
+    set_has_txn_threshold();
+  } else {
+    clear_has_txn_threshold();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.txn_threshold)
+}
+
+// -------------------------------------------------------------------
+
+// InternalGCResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalGCResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalGCResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalGCResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalGCResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalGCResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGCResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalGCResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGCResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalGCResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalGCResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalPushTxnRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalPushTxnRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalPushTxnRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalPushTxnRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalPushTxnRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalPushTxnRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPushTxnRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalPushTxnRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalPushTxnRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnRequest.header)
+}
+
+// optional .proto.Transaction pushee_txn = 2;
+inline bool InternalPushTxnRequest::has_pushee_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalPushTxnRequest::set_has_pushee_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalPushTxnRequest::clear_has_pushee_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalPushTxnRequest::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::proto::Transaction::Clear();
+  clear_has_pushee_txn();
+}
+inline const ::proto::Transaction& InternalPushTxnRequest::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPushTxnRequest.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+}
+inline ::proto::Transaction* InternalPushTxnRequest::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) pushee_txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnRequest.pushee_txn)
+  return pushee_txn_;
+}
+inline ::proto::Transaction* InternalPushTxnRequest::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::proto::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnRequest::set_allocated_pushee_txn(::proto::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnRequest.pushee_txn)
+}
+
+// optional bool Abort = 3;
+inline bool InternalPushTxnRequest::has_abort() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalPushTxnRequest::set_has_abort() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalPushTxnRequest::clear_has_abort() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalPushTxnRequest::clear_abort() {
+  abort_ = false;
+  clear_has_abort();
+}
+inline bool InternalPushTxnRequest::abort() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPushTxnRequest.Abort)
+  return abort_;
+}
+inline void InternalPushTxnRequest::set_abort(bool value) {
+  set_has_abort();
+  abort_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalPushTxnRequest.Abort)
+}
+
+// -------------------------------------------------------------------
+
+// InternalPushTxnResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalPushTxnResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalPushTxnResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalPushTxnResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalPushTxnResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalPushTxnResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPushTxnResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalPushTxnResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalPushTxnResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnResponse.header)
+}
+
+// optional .proto.Transaction pushee_txn = 2;
+inline bool InternalPushTxnResponse::has_pushee_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalPushTxnResponse::set_has_pushee_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalPushTxnResponse::clear_has_pushee_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalPushTxnResponse::clear_pushee_txn() {
+  if (pushee_txn_ != NULL) pushee_txn_->::proto::Transaction::Clear();
+  clear_has_pushee_txn();
+}
+inline const ::proto::Transaction& InternalPushTxnResponse::pushee_txn() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPushTxnResponse.pushee_txn)
+  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+}
+inline ::proto::Transaction* InternalPushTxnResponse::mutable_pushee_txn() {
+  set_has_pushee_txn();
+  if (pushee_txn_ == NULL) pushee_txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnResponse.pushee_txn)
+  return pushee_txn_;
+}
+inline ::proto::Transaction* InternalPushTxnResponse::release_pushee_txn() {
+  clear_has_pushee_txn();
+  ::proto::Transaction* temp = pushee_txn_;
+  pushee_txn_ = NULL;
+  return temp;
+}
+inline void InternalPushTxnResponse::set_allocated_pushee_txn(::proto::Transaction* pushee_txn) {
+  delete pushee_txn_;
+  pushee_txn_ = pushee_txn;
+  if (pushee_txn) {
+    set_has_pushee_txn();
+  } else {
+    clear_has_pushee_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnResponse.pushee_txn)
+}
+
+// -------------------------------------------------------------------
+
+// InternalResolveIntentRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalResolveIntentRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalResolveIntentRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalResolveIntentRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalResolveIntentRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalResolveIntentRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalResolveIntentRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalResolveIntentRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalResolveIntentRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalResolveIntentRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalResolveIntentResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalResolveIntentResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalResolveIntentResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalResolveIntentResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalResolveIntentResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalResolveIntentResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalResolveIntentResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalResolveIntentResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalResolveIntentResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalResolveIntentResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalResolveIntentResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalResolveIntentResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalMergeRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalMergeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalMergeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalMergeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalMergeRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalMergeRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalMergeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalMergeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalMergeRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalMergeRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalMergeRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalMergeRequest.header)
+}
+
+// optional .proto.Value value = 2;
+inline bool InternalMergeRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalMergeRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalMergeRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalMergeRequest::clear_value() {
+  if (value_ != NULL) value_->::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::proto::Value& InternalMergeRequest::value() const {
+  // @@protoc_insertion_point(field_get:proto.InternalMergeRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::proto::Value* InternalMergeRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.InternalMergeRequest.value)
+  return value_;
+}
+inline ::proto::Value* InternalMergeRequest::release_value() {
+  clear_has_value();
+  ::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void InternalMergeRequest::set_allocated_value(::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalMergeRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// InternalMergeResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalMergeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalMergeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalMergeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalMergeResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalMergeResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalMergeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalMergeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalMergeResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalMergeResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalMergeResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalMergeResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalTruncateLogRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalTruncateLogRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalTruncateLogRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalTruncateLogRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalTruncateLogRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalTruncateLogRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalTruncateLogRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalTruncateLogRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalTruncateLogRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalTruncateLogRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalTruncateLogRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalTruncateLogRequest.header)
+}
+
+// optional uint64 index = 2;
+inline bool InternalTruncateLogRequest::has_index() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalTruncateLogRequest::set_has_index() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalTruncateLogRequest::clear_has_index() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalTruncateLogRequest::clear_index() {
+  index_ = GOOGLE_ULONGLONG(0);
+  clear_has_index();
+}
+inline ::google::protobuf::uint64 InternalTruncateLogRequest::index() const {
+  // @@protoc_insertion_point(field_get:proto.InternalTruncateLogRequest.index)
+  return index_;
+}
+inline void InternalTruncateLogRequest::set_index(::google::protobuf::uint64 value) {
+  set_has_index();
+  index_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalTruncateLogRequest.index)
+}
+
+// -------------------------------------------------------------------
+
+// InternalTruncateLogResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalTruncateLogResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalTruncateLogResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalTruncateLogResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalTruncateLogResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalTruncateLogResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalTruncateLogResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalTruncateLogResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalTruncateLogResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalTruncateLogResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalTruncateLogResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalTruncateLogResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalBeginTransactionRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalBeginTransactionRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalBeginTransactionRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalBeginTransactionRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalBeginTransactionRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalBeginTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalBeginTransactionRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalBeginTransactionRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalBeginTransactionRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalBeginTransactionRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalBeginTransactionRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalBeginTransactionRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalBeginTransactionResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalBeginTransactionResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalBeginTransactionResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalBeginTransactionResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalBeginTransactionResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalBeginTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalBeginTransactionResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalBeginTransactionResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalBeginTransactionResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalBeginTransactionResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalBeginTransactionResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalBeginTransactionResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalTxnIntents
+
+// optional .proto.Transaction txn = 1;
+inline bool InternalTxnIntents::has_txn() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalTxnIntents::set_has_txn() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalTxnIntents::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalTxnIntents::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::proto::Transaction& InternalTxnIntents::txn() const {
+  // @@protoc_insertion_point(field_get:proto.InternalTxnIntents.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::proto::Transaction* InternalTxnIntents::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.InternalTxnIntents.txn)
+  return txn_;
+}
+inline ::proto::Transaction* InternalTxnIntents::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void InternalTxnIntents::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalTxnIntents.txn)
+}
+
+// repeated bytes keys = 2;
+inline int InternalTxnIntents::keys_size() const {
+  return keys_.size();
+}
+inline void InternalTxnIntents::clear_keys() {
+  keys_.Clear();
+}
+inline const ::std::string& InternalTxnIntents::keys(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalTxnIntents.keys)
+  return keys_.Get(index);
+}
+inline ::std::string* InternalTxnIntents::mutable_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalTxnIntents.keys)
+  return keys_.Mutable(index);
+}
+inline void InternalTxnIntents::set_keys(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:proto.InternalTxnIntents.keys)
+  keys_.Mutable(index)->assign(value);
+}
+inline void InternalTxnIntents::set_keys(int index, const char* value) {
+  keys_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalTxnIntents.keys)
+}
+inline void InternalTxnIntents::set_keys(int index, const void* value, size_t size) {
+  keys_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalTxnIntents.keys)
+}
+inline ::std::string* InternalTxnIntents::add_keys() {
+  return keys_.Add();
+}
+inline void InternalTxnIntents::add_keys(const ::std::string& value) {
+  keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:proto.InternalTxnIntents.keys)
+}
+inline void InternalTxnIntents::add_keys(const char* value) {
+  keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:proto.InternalTxnIntents.keys)
+}
+inline void InternalTxnIntents::add_keys(const void* value, size_t size) {
+  keys_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:proto.InternalTxnIntents.keys)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+InternalTxnIntents::keys() const {
+  // @@protoc_insertion_point(field_list:proto.InternalTxnIntents.keys)
+  return keys_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+InternalTxnIntents::mutable_keys() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalTxnIntents.keys)
+  return &keys_;
+}
+
+// -------------------------------------------------------------------
+
+// InternalScanIntentsRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalScanIntentsRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalScanIntentsRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalScanIntentsRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalScanIntentsRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalScanIntentsRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalScanIntentsRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalScanIntentsRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalScanIntentsRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalScanIntentsRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalScanIntentsRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalScanIntentsRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalScanIntentsResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalScanIntentsResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalScanIntentsResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalScanIntentsResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalScanIntentsResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalScanIntentsResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalScanIntentsResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalScanIntentsResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalScanIntentsResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalScanIntentsResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalScanIntentsResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalScanIntentsResponse.header)
+}
+
+// repeated .proto.InternalTxnIntents txn_intents = 2;
+inline int InternalScanIntentsResponse::txn_intents_size() const {
+  return txn_intents_.size();
+}
+inline void InternalScanIntentsResponse::clear_txn_intents() {
+  txn_intents_.Clear();
+}
+inline const ::proto::InternalTxnIntents& InternalScanIntentsResponse::txn_intents(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalScanIntentsResponse.txn_intents)
+  return txn_intents_.Get(index);
+}
+inline ::proto::InternalTxnIntents* InternalScanIntentsResponse::mutable_txn_intents(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalScanIntentsResponse.txn_intents)
+  return txn_intents_.Mutable(index);
+}
+inline ::proto::InternalTxnIntents* InternalScanIntentsResponse::add_txn_intents() {
+  // @@protoc_insertion_point(field_add:proto.InternalScanIntentsResponse.txn_intents)
+  return txn_intents_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::InternalTxnIntents >&
+InternalScanIntentsResponse::txn_intents() const {
+  // @@protoc_insertion_point(field_list:proto.InternalScanIntentsResponse.txn_intents)
+  return txn_intents_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::InternalTxnIntents >*
+InternalScanIntentsResponse::mutable_txn_intents() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalScanIntentsResponse.txn_intents)
+  return &txn_intents_;
+}
+
+// -------------------------------------------------------------------
+
+// InternalInspectTimestampCacheRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalInspectTimestampCacheRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalInspectTimestampCacheRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalInspectTimestampCacheRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalInspectTimestampCacheRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalInspectTimestampCacheRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalInspectTimestampCacheRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalInspectTimestampCacheRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalInspectTimestampCacheRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalInspectTimestampCacheRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalInspectTimestampCacheRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalInspectTimestampCacheRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalInspectTimestampCacheResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalInspectTimestampCacheResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalInspectTimestampCacheResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalInspectTimestampCacheResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalInspectTimestampCacheResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalInspectTimestampCacheResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalInspectTimestampCacheResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalInspectTimestampCacheResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalInspectTimestampCacheResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalInspectTimestampCacheResponse.header)
+}
+
+// optional .proto.Timestamp read_timestamp = 2;
+inline bool InternalInspectTimestampCacheResponse::has_read_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalInspectTimestampCacheResponse::set_has_read_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_has_read_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_read_timestamp() {
+  if (read_timestamp_ != NULL) read_timestamp_->::proto::Timestamp::Clear();
+  clear_has_read_timestamp();
+}
+inline const ::proto::Timestamp& InternalInspectTimestampCacheResponse::read_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.InternalInspectTimestampCacheResponse.read_timestamp)
+  return read_timestamp_ != NULL ? *read_timestamp_ : *default_instance_->read_timestamp_;
+}
+inline ::proto::Timestamp* InternalInspectTimestampCacheResponse::mutable_read_timestamp() {
+  set_has_read_timestamp();
+  if (read_timestamp_ == NULL) read_timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.InternalInspectTimestampCacheResponse.read_timestamp)
+  return read_timestamp_;
+}
+inline ::proto::Timestamp* InternalInspectTimestampCacheResponse::release_read_timestamp() {
+  clear_has_read_timestamp();
+  ::proto::Timestamp* temp = read_timestamp_;
+  read_timestamp_ = NULL;
+  return temp;
+}
+inline void InternalInspectTimestampCacheResponse::set_allocated_read_timestamp(::proto::Timestamp* read_timestamp) {
+  delete read_timestamp_;
+  read_timestamp_ = read_timestamp;
+  if (read_timestamp) {
+    set_has_read_timestamp();
+  } else {
+    clear_has_read_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalInspectTimestampCacheResponse.read_timestamp)
+}
+
+// optional .proto.Timestamp write_timestamp = 3;
+inline bool InternalInspectTimestampCacheResponse::has_write_timestamp() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalInspectTimestampCacheResponse::set_has_write_timestamp() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_has_write_timestamp() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_write_timestamp() {
+  if (write_timestamp_ != NULL) write_timestamp_->::proto::Timestamp::Clear();
+  clear_has_write_timestamp();
+}
+inline const ::proto::Timestamp& InternalInspectTimestampCacheResponse::write_timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.InternalInspectTimestampCacheResponse.write_timestamp)
+  return write_timestamp_ != NULL ? *write_timestamp_ : *default_instance_->write_timestamp_;
+}
+inline ::proto::Timestamp* InternalInspectTimestampCacheResponse::mutable_write_timestamp() {
+  set_has_write_timestamp();
+  if (write_timestamp_ == NULL) write_timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.InternalInspectTimestampCacheResponse.write_timestamp)
+  return write_timestamp_;
+}
+inline ::proto::Timestamp* InternalInspectTimestampCacheResponse::release_write_timestamp() {
+  clear_has_write_timestamp();
+  ::proto::Timestamp* temp = write_timestamp_;
+  write_timestamp_ = NULL;
+  return temp;
+}
+inline void InternalInspectTimestampCacheResponse::set_allocated_write_timestamp(::proto::Timestamp* write_timestamp) {
+  delete write_timestamp_;
+  write_timestamp_ = write_timestamp;
+  if (write_timestamp) {
+    set_has_write_timestamp();
+  } else {
+    clear_has_write_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalInspectTimestampCacheResponse.write_timestamp)
+}
+
+// optional bool read_low_water = 4;
+inline bool InternalInspectTimestampCacheResponse::has_read_low_water() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalInspectTimestampCacheResponse::set_has_read_low_water() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_has_read_low_water() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_read_low_water() {
+  read_low_water_ = false;
+  clear_has_read_low_water();
+}
+inline bool InternalInspectTimestampCacheResponse::read_low_water() const {
+  // @@protoc_insertion_point(field_get:proto.InternalInspectTimestampCacheResponse.read_low_water)
+  return read_low_water_;
+}
+inline void InternalInspectTimestampCacheResponse::set_read_low_water(bool value) {
+  set_has_read_low_water();
+  read_low_water_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalInspectTimestampCacheResponse.read_low_water)
+}
+
+// optional bool write_low_water = 5;
+inline bool InternalInspectTimestampCacheResponse::has_write_low_water() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void InternalInspectTimestampCacheResponse::set_has_write_low_water() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_has_write_low_water() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void InternalInspectTimestampCacheResponse::clear_write_low_water() {
+  write_low_water_ = false;
+  clear_has_write_low_water();
+}
+inline bool InternalInspectTimestampCacheResponse::write_low_water() const {
+  // @@protoc_insertion_point(field_get:proto.InternalInspectTimestampCacheResponse.write_low_water)
+  return write_low_water_;
+}
+inline void InternalInspectTimestampCacheResponse::set_write_low_water(bool value) {
+  set_has_write_low_water();
+  write_low_water_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalInspectTimestampCacheResponse.write_low_water)
+}
+
+// -------------------------------------------------------------------
+
+// InternalGetTransactionRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalGetTransactionRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalGetTransactionRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalGetTransactionRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalGetTransactionRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalGetTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetTransactionRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalGetTransactionRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetTransactionRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalGetTransactionRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalGetTransactionRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGetTransactionRequest.header)
+}
+
+// optional bytes txn_id = 2;
+inline bool InternalGetTransactionRequest::has_txn_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalGetTransactionRequest::set_has_txn_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalGetTransactionRequest::clear_has_txn_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalGetTransactionRequest::clear_txn_id() {
+  if (txn_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    txn_id_->clear();
+  }
+  clear_has_txn_id();
+}
+inline const ::std::string& InternalGetTransactionRequest::txn_id() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetTransactionRequest.txn_id)
+  return *txn_id_;
+}
+inline void InternalGetTransactionRequest::set_txn_id(const ::std::string& value) {
+  set_has_txn_id();
+  if (txn_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    txn_id_ = new ::std::string;
+  }
+  txn_id_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.InternalGetTransactionRequest.txn_id)
+}
+inline void InternalGetTransactionRequest::set_txn_id(const char* value) {
+  set_has_txn_id();
+  if (txn_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    txn_id_ = new ::std::string;
+  }
+  txn_id_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalGetTransactionRequest.txn_id)
+}
+inline void InternalGetTransactionRequest::set_txn_id(const void* value, size_t size) {
+  set_has_txn_id();
+  if (txn_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    txn_id_ = new ::std::string;
+  }
+  txn_id_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalGetTransactionRequest.txn_id)
+}
+inline ::std::string* InternalGetTransactionRequest::mutable_txn_id() {
+  set_has_txn_id();
+  if (txn_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    txn_id_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetTransactionRequest.txn_id)
+  return txn_id_;
+}
+inline ::std::string* InternalGetTransactionRequest::release_txn_id() {
+  clear_has_txn_id();
+  if (txn_id_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = txn_id_;
+    txn_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void InternalGetTransactionRequest::set_allocated_txn_id(::std::string* txn_id) {
+  if (txn_id_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete txn_id_;
+  }
+  if (txn_id) {
+    set_has_txn_id();
+    txn_id_ = txn_id;
+  } else {
+    clear_has_txn_id();
+    txn_id_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGetTransactionRequest.txn_id)
+}
+
+// -------------------------------------------------------------------
+
+// InternalGetTransactionResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalGetTransactionResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalGetTransactionResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalGetTransactionResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalGetTransactionResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalGetTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetTransactionResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalGetTransactionResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetTransactionResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalGetTransactionResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalGetTransactionResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGetTransactionResponse.header)
+}
+
+// optional .proto.Transaction txn = 2;
+inline bool InternalGetTransactionResponse::has_txn() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalGetTransactionResponse::set_has_txn() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalGetTransactionResponse::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalGetTransactionResponse::clear_txn() {
+  if (txn_ != NULL) txn_->::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::proto::Transaction& InternalGetTransactionResponse::txn() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetTransactionResponse.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::proto::Transaction* InternalGetTransactionResponse::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) txn_ = new ::proto::Transaction;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetTransactionResponse.txn)
+  return txn_;
+}
+inline ::proto::Transaction* InternalGetTransactionResponse::release_txn() {
+  clear_has_txn();
+  ::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void InternalGetTransactionResponse::set_allocated_txn(::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGetTransactionResponse.txn)
+}
+
+// -------------------------------------------------------------------
+
+// InternalPutIfAbsentRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalPutIfAbsentRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalPutIfAbsentRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalPutIfAbsentRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalPutIfAbsentRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalPutIfAbsentRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPutIfAbsentRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalPutIfAbsentRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPutIfAbsentRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalPutIfAbsentRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalPutIfAbsentRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPutIfAbsentRequest.header)
+}
+
+// optional .proto.Value value = 2;
+inline bool InternalPutIfAbsentRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalPutIfAbsentRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalPutIfAbsentRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalPutIfAbsentRequest::clear_value() {
+  if (value_ != NULL) value_->::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::proto::Value& InternalPutIfAbsentRequest::value() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPutIfAbsentRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::proto::Value* InternalPutIfAbsentRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPutIfAbsentRequest.value)
+  return value_;
+}
+inline ::proto::Value* InternalPutIfAbsentRequest::release_value() {
+  clear_has_value();
+  ::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void InternalPutIfAbsentRequest::set_allocated_value(::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPutIfAbsentRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// InternalPutIfAbsentResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalPutIfAbsentResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalPutIfAbsentResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalPutIfAbsentResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalPutIfAbsentResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalPutIfAbsentResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPutIfAbsentResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalPutIfAbsentResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPutIfAbsentResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalPutIfAbsentResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalPutIfAbsentResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPutIfAbsentResponse.header)
+}
+
+// optional bool inserted = 2;
+inline bool InternalPutIfAbsentResponse::has_inserted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalPutIfAbsentResponse::set_has_inserted() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalPutIfAbsentResponse::clear_has_inserted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalPutIfAbsentResponse::clear_inserted() {
+  inserted_ = false;
+  clear_has_inserted();
+}
+inline bool InternalPutIfAbsentResponse::inserted() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPutIfAbsentResponse.inserted)
+  return inserted_;
+}
+inline void InternalPutIfAbsentResponse::set_inserted(bool value) {
+  set_has_inserted();
+  inserted_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalPutIfAbsentResponse.inserted)
+}
+
+// optional .proto.Value value = 3;
+inline bool InternalPutIfAbsentResponse::has_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalPutIfAbsentResponse::set_has_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalPutIfAbsentResponse::clear_has_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalPutIfAbsentResponse::clear_value() {
+  if (value_ != NULL) value_->::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::proto::Value& InternalPutIfAbsentResponse::value() const {
+  // @@protoc_insertion_point(field_get:proto.InternalPutIfAbsentResponse.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::proto::Value* InternalPutIfAbsentResponse::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.InternalPutIfAbsentResponse.value)
+  return value_;
+}
+inline ::proto::Value* InternalPutIfAbsentResponse::release_value() {
+  clear_has_value();
+  ::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void InternalPutIfAbsentResponse::set_allocated_value(::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalPutIfAbsentResponse.value)
+}
+
+// -------------------------------------------------------------------
+
+// ScanResumeToken
+
+// optional bytes key = 1;
+inline bool ScanResumeToken::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ScanResumeToken::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ScanResumeToken::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ScanResumeToken::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& ScanResumeToken::key() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResumeToken.key)
+  return *key_;
+}
+inline void ScanResumeToken::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.ScanResumeToken.key)
+}
+inline void ScanResumeToken::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.ScanResumeToken.key)
+}
+inline void ScanResumeToken::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.ScanResumeToken.key)
+}
+inline ::std::string* ScanResumeToken::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.ScanResumeToken.key)
+  return key_;
+}
+inline ::std::string* ScanResumeToken::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void ScanResumeToken::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ScanResumeToken.key)
+}
+
+// optional int64 raft_id = 2;
+inline bool ScanResumeToken::has_raft_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ScanResumeToken::set_has_raft_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ScanResumeToken::clear_has_raft_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ScanResumeToken::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
+}
+inline ::google::protobuf::int64 ScanResumeToken::raft_id() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResumeToken.raft_id)
+  return raft_id_;
+}
+inline void ScanResumeToken::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:proto.ScanResumeToken.raft_id)
+}
+
+// optional bytes start_key = 3;
+inline bool ScanResumeToken::has_start_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ScanResumeToken::set_has_start_key() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ScanResumeToken::clear_has_start_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ScanResumeToken::clear_start_key() {
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_->clear();
+  }
+  clear_has_start_key();
+}
+inline const ::std::string& ScanResumeToken::start_key() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResumeToken.start_key)
+  return *start_key_;
+}
+inline void ScanResumeToken::set_start_key(const ::std::string& value) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  start_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.ScanResumeToken.start_key)
+}
+inline void ScanResumeToken::set_start_key(const char* value) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  start_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.ScanResumeToken.start_key)
+}
+inline void ScanResumeToken::set_start_key(const void* value, size_t size) {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  start_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.ScanResumeToken.start_key)
+}
+inline ::std::string* ScanResumeToken::mutable_start_key() {
+  set_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    start_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.ScanResumeToken.start_key)
+  return start_key_;
+}
+inline ::std::string* ScanResumeToken::release_start_key() {
+  clear_has_start_key();
+  if (start_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = start_key_;
+    start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void ScanResumeToken::set_allocated_start_key(::std::string* start_key) {
+  if (start_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete start_key_;
+  }
+  if (start_key) {
+    set_has_start_key();
+    start_key_ = start_key;
+  } else {
+    clear_has_start_key();
+    start_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ScanResumeToken.start_key)
+}
+
+// optional bytes end_key = 4;
+inline bool ScanResumeToken::has_end_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ScanResumeToken::set_has_end_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ScanResumeToken::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ScanResumeToken::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
+  }
+  clear_has_end_key();
+}
+inline const ::std::string& ScanResumeToken::end_key() const {
+  // @@protoc_insertion_point(field_get:proto.ScanResumeToken.end_key)
+  return *end_key_;
+}
+inline void ScanResumeToken::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.ScanResumeToken.end_key)
+}
+inline void ScanResumeToken::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.ScanResumeToken.end_key)
+}
+inline void ScanResumeToken::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.ScanResumeToken.end_key)
+}
+inline ::std::string* ScanResumeToken::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.ScanResumeToken.end_key)
+  return end_key_;
+}
+inline ::std::string* ScanResumeToken::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void ScanResumeToken::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ScanResumeToken.end_key)
+}
+
+// -------------------------------------------------------------------
+
+// AuditEntry
+
+// optional string user = 1;
+inline bool AuditEntry::has_user() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void AuditEntry::set_has_user() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void AuditEntry::clear_has_user() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void AuditEntry::clear_user() {
+  if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_->clear();
+  }
+  clear_has_user();
+}
+inline const ::std::string& AuditEntry::user() const {
+  // @@protoc_insertion_point(field_get:proto.AuditEntry.user)
+  return *user_;
+}
+inline void AuditEntry::set_user(const ::std::string& value) {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  user_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.AuditEntry.user)
+}
+inline void AuditEntry::set_user(const char* value) {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  user_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.AuditEntry.user)
+}
+inline void AuditEntry::set_user(const char* value, size_t size) {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  user_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.AuditEntry.user)
+}
+inline ::std::string* AuditEntry::mutable_user() {
+  set_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    user_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.AuditEntry.user)
+  return user_;
+}
+inline ::std::string* AuditEntry::release_user() {
+  clear_has_user();
+  if (user_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = user_;
+    user_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AuditEntry::set_allocated_user(::std::string* user) {
+  if (user_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete user_;
+  }
+  if (user) {
+    set_has_user();
+    user_ = user;
+  } else {
+    clear_has_user();
+    user_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AuditEntry.user)
+}
+
+// optional string method = 2;
+inline bool AuditEntry::has_method() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void AuditEntry::set_has_method() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void AuditEntry::clear_has_method() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void AuditEntry::clear_method() {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_->clear();
+  }
+  clear_has_method();
+}
+inline const ::std::string& AuditEntry::method() const {
+  // @@protoc_insertion_point(field_get:proto.AuditEntry.method)
+  return *method_;
+}
+inline void AuditEntry::set_method(const ::std::string& value) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.AuditEntry.method)
+}
+inline void AuditEntry::set_method(const char* value) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.AuditEntry.method)
+}
+inline void AuditEntry::set_method(const char* value, size_t size) {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  method_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.AuditEntry.method)
+}
+inline ::std::string* AuditEntry::mutable_method() {
+  set_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    method_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.AuditEntry.method)
+  return method_;
+}
+inline ::std::string* AuditEntry::release_method() {
+  clear_has_method();
+  if (method_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = method_;
+    method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AuditEntry::set_allocated_method(::std::string* method) {
+  if (method_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete method_;
+  }
+  if (method) {
+    set_has_method();
+    method_ = method;
+  } else {
+    clear_has_method();
+    method_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AuditEntry.method)
+}
+
+// optional bytes key = 3;
+inline bool AuditEntry::has_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void AuditEntry::set_has_key() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void AuditEntry::clear_has_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void AuditEntry::clear_key() {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_->clear();
+  }
+  clear_has_key();
+}
+inline const ::std::string& AuditEntry::key() const {
+  // @@protoc_insertion_point(field_get:proto.AuditEntry.key)
+  return *key_;
+}
+inline void AuditEntry::set_key(const ::std::string& value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.AuditEntry.key)
+}
+inline void AuditEntry::set_key(const char* value) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.AuditEntry.key)
+}
+inline void AuditEntry::set_key(const void* value, size_t size) {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.AuditEntry.key)
+}
+inline ::std::string* AuditEntry::mutable_key() {
+  set_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.AuditEntry.key)
+  return key_;
+}
+inline ::std::string* AuditEntry::release_key() {
+  clear_has_key();
+  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = key_;
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AuditEntry::set_allocated_key(::std::string* key) {
+  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete key_;
+  }
+  if (key) {
+    set_has_key();
+    key_ = key;
+  } else {
+    clear_has_key();
+    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AuditEntry.key)
+}
+
+// optional bytes end_key = 4;
+inline bool AuditEntry::has_end_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void AuditEntry::set_has_end_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void AuditEntry::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void AuditEntry::clear_end_key() {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_->clear();
+  }
+  clear_has_end_key();
+}
+inline const ::std::string& AuditEntry::end_key() const {
+  // @@protoc_insertion_point(field_get:proto.AuditEntry.end_key)
+  return *end_key_;
+}
+inline void AuditEntry::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.AuditEntry.end_key)
+}
+inline void AuditEntry::set_end_key(const char* value) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.AuditEntry.end_key)
+}
+inline void AuditEntry::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  end_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.AuditEntry.end_key)
+}
+inline ::std::string* AuditEntry::mutable_end_key() {
+  set_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    end_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.AuditEntry.end_key)
+  return end_key_;
+}
+inline ::std::string* AuditEntry::release_end_key() {
+  clear_has_end_key();
+  if (end_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = end_key_;
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AuditEntry::set_allocated_end_key(::std::string* end_key) {
+  if (end_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete end_key_;
+  }
+  if (end_key) {
+    set_has_end_key();
+    end_key_ = end_key;
+  } else {
+    clear_has_end_key();
+    end_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AuditEntry.end_key)
+}
+
+// optional .proto.Timestamp timestamp = 5;
+inline bool AuditEntry::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void AuditEntry::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void AuditEntry::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void AuditEntry::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::proto::Timestamp& AuditEntry::timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.AuditEntry.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::proto::Timestamp* AuditEntry::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.AuditEntry.timestamp)
+  return timestamp_;
+}
+inline ::proto::Timestamp* AuditEntry::release_timestamp() {
+  clear_has_timestamp();
+  ::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void AuditEntry::set_allocated_timestamp(::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AuditEntry.timestamp)
+}
+
+// optional bytes old_value_hash = 6;
+inline bool AuditEntry::has_old_value_hash() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void AuditEntry::set_has_old_value_hash() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void AuditEntry::clear_has_old_value_hash() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void AuditEntry::clear_old_value_hash() {
+  if (old_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    old_value_hash_->clear();
+  }
+  clear_has_old_value_hash();
+}
+inline const ::std::string& AuditEntry::old_value_hash() const {
+  // @@protoc_insertion_point(field_get:proto.AuditEntry.old_value_hash)
+  return *old_value_hash_;
+}
+inline void AuditEntry::set_old_value_hash(const ::std::string& value) {
+  set_has_old_value_hash();
+  if (old_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    old_value_hash_ = new ::std::string;
+  }
+  old_value_hash_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.AuditEntry.old_value_hash)
+}
+inline void AuditEntry::set_old_value_hash(const char* value) {
+  set_has_old_value_hash();
+  if (old_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    old_value_hash_ = new ::std::string;
+  }
+  old_value_hash_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.AuditEntry.old_value_hash)
+}
+inline void AuditEntry::set_old_value_hash(const void* value, size_t size) {
+  set_has_old_value_hash();
+  if (old_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    old_value_hash_ = new ::std::string;
+  }
+  old_value_hash_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.AuditEntry.old_value_hash)
+}
+inline ::std::string* AuditEntry::mutable_old_value_hash() {
+  set_has_old_value_hash();
+  if (old_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    old_value_hash_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.AuditEntry.old_value_hash)
+  return old_value_hash_;
+}
+inline ::std::string* AuditEntry::release_old_value_hash() {
+  clear_has_old_value_hash();
+  if (old_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = old_value_hash_;
+    old_value_hash_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AuditEntry::set_allocated_old_value_hash(::std::string* old_value_hash) {
+  if (old_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete old_value_hash_;
+  }
+  if (old_value_hash) {
+    set_has_old_value_hash();
+    old_value_hash_ = old_value_hash;
+  } else {
+    clear_has_old_value_hash();
+    old_value_hash_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AuditEntry.old_value_hash)
+}
+
+// optional bytes new_value_hash = 7;
+inline bool AuditEntry::has_new_value_hash() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void AuditEntry::set_has_new_value_hash() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void AuditEntry::clear_has_new_value_hash() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void AuditEntry::clear_new_value_hash() {
+  if (new_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    new_value_hash_->clear();
+  }
+  clear_has_new_value_hash();
+}
+inline const ::std::string& AuditEntry::new_value_hash() const {
+  // @@protoc_insertion_point(field_get:proto.AuditEntry.new_value_hash)
+  return *new_value_hash_;
+}
+inline void AuditEntry::set_new_value_hash(const ::std::string& value) {
+  set_has_new_value_hash();
+  if (new_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    new_value_hash_ = new ::std::string;
+  }
+  new_value_hash_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.AuditEntry.new_value_hash)
+}
+inline void AuditEntry::set_new_value_hash(const char* value) {
+  set_has_new_value_hash();
+  if (new_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    new_value_hash_ = new ::std::string;
+  }
+  new_value_hash_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.AuditEntry.new_value_hash)
+}
+inline void AuditEntry::set_new_value_hash(const void* value, size_t size) {
+  set_has_new_value_hash();
+  if (new_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    new_value_hash_ = new ::std::string;
+  }
+  new_value_hash_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.AuditEntry.new_value_hash)
+}
+inline ::std::string* AuditEntry::mutable_new_value_hash() {
+  set_has_new_value_hash();
+  if (new_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    new_value_hash_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.AuditEntry.new_value_hash)
+  return new_value_hash_;
+}
+inline ::std::string* AuditEntry::release_new_value_hash() {
+  clear_has_new_value_hash();
+  if (new_value_hash_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = new_value_hash_;
+    new_value_hash_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void AuditEntry::set_allocated_new_value_hash(::std::string* new_value_hash) {
+  if (new_value_hash_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete new_value_hash_;
+  }
+  if (new_value_hash) {
+    set_has_new_value_hash();
+    new_value_hash_ = new_value_hash;
+  } else {
+    clear_has_new_value_hash();
+    new_value_hash_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.AuditEntry.new_value_hash)
+}
+
+// -------------------------------------------------------------------
+
+// InternalRangeKeyBoundsRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalRangeKeyBoundsRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalRangeKeyBoundsRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalRangeKeyBoundsRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalRangeKeyBoundsRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalRangeKeyBoundsRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeKeyBoundsRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalRangeKeyBoundsRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRangeKeyBoundsRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalRangeKeyBoundsRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalRangeKeyBoundsRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeKeyBoundsRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalRangeKeyBoundsResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalRangeKeyBoundsResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalRangeKeyBoundsResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalRangeKeyBoundsResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalRangeKeyBoundsResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalRangeKeyBoundsResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeKeyBoundsResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalRangeKeyBoundsResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRangeKeyBoundsResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalRangeKeyBoundsResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalRangeKeyBoundsResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeKeyBoundsResponse.header)
+}
+
+// optional bytes min_key = 2;
+inline bool InternalRangeKeyBoundsResponse::has_min_key() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalRangeKeyBoundsResponse::set_has_min_key() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalRangeKeyBoundsResponse::clear_has_min_key() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalRangeKeyBoundsResponse::clear_min_key() {
+  if (min_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    min_key_->clear();
+  }
+  clear_has_min_key();
+}
+inline const ::std::string& InternalRangeKeyBoundsResponse::min_key() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeKeyBoundsResponse.min_key)
+  return *min_key_;
+}
+inline void InternalRangeKeyBoundsResponse::set_min_key(const ::std::string& value) {
+  set_has_min_key();
+  if (min_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    min_key_ = new ::std::string;
+  }
+  min_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.InternalRangeKeyBoundsResponse.min_key)
+}
+inline void InternalRangeKeyBoundsResponse::set_min_key(const char* value) {
+  set_has_min_key();
+  if (min_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    min_key_ = new ::std::string;
+  }
+  min_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalRangeKeyBoundsResponse.min_key)
+}
+inline void InternalRangeKeyBoundsResponse::set_min_key(const void* value, size_t size) {
+  set_has_min_key();
+  if (min_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    min_key_ = new ::std::string;
+  }
+  min_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalRangeKeyBoundsResponse.min_key)
+}
+inline ::std::string* InternalRangeKeyBoundsResponse::mutable_min_key() {
+  set_has_min_key();
+  if (min_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    min_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.InternalRangeKeyBoundsResponse.min_key)
+  return min_key_;
+}
+inline ::std::string* InternalRangeKeyBoundsResponse::release_min_key() {
+  clear_has_min_key();
+  if (min_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = min_key_;
+    min_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void InternalRangeKeyBoundsResponse::set_allocated_min_key(::std::string* min_key) {
+  if (min_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete min_key_;
+  }
+  if (min_key) {
+    set_has_min_key();
+    min_key_ = min_key;
+  } else {
+    clear_has_min_key();
+    min_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeKeyBoundsResponse.min_key)
+}
+
+// optional bytes max_key = 3;
+inline bool InternalRangeKeyBoundsResponse::has_max_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalRangeKeyBoundsResponse::set_has_max_key() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalRangeKeyBoundsResponse::clear_has_max_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalRangeKeyBoundsResponse::clear_max_key() {
+  if (max_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    max_key_->clear();
+  }
+  clear_has_max_key();
+}
+inline const ::std::string& InternalRangeKeyBoundsResponse::max_key() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRangeKeyBoundsResponse.max_key)
+  return *max_key_;
+}
+inline void InternalRangeKeyBoundsResponse::set_max_key(const ::std::string& value) {
+  set_has_max_key();
+  if (max_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    max_key_ = new ::std::string;
+  }
+  max_key_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.InternalRangeKeyBoundsResponse.max_key)
+}
+inline void InternalRangeKeyBoundsResponse::set_max_key(const char* value) {
+  set_has_max_key();
+  if (max_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    max_key_ = new ::std::string;
+  }
+  max_key_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalRangeKeyBoundsResponse.max_key)
+}
+inline void InternalRangeKeyBoundsResponse::set_max_key(const void* value, size_t size) {
+  set_has_max_key();
+  if (max_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    max_key_ = new ::std::string;
+  }
+  max_key_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalRangeKeyBoundsResponse.max_key)
+}
+inline ::std::string* InternalRangeKeyBoundsResponse::mutable_max_key() {
+  set_has_max_key();
+  if (max_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    max_key_ = new ::std::string;
+  }
+  // @@protoc_insertion_point(field_mutable:proto.InternalRangeKeyBoundsResponse.max_key)
+  return max_key_;
+}
+inline ::std::string* InternalRangeKeyBoundsResponse::release_max_key() {
+  clear_has_max_key();
+  if (max_key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    return NULL;
+  } else {
+    ::std::string* temp = max_key_;
+    max_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    return temp;
+  }
+}
+inline void InternalRangeKeyBoundsResponse::set_allocated_max_key(::std::string* max_key) {
+  if (max_key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete max_key_;
+  }
+  if (max_key) {
+    set_has_max_key();
+    max_key_ = max_key;
+  } else {
+    clear_has_max_key();
+    max_key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeKeyBoundsResponse.max_key)
+}
+
+// -------------------------------------------------------------------
+
+// InternalVerifyRangeRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalVerifyRangeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalVerifyRangeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalVerifyRangeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalVerifyRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalVerifyRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalVerifyRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalVerifyRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalVerifyRangeRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalVerifyRangeRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalVerifyRangeRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalVerifyRangeRequest.header)
+}
+
+// optional int64 max_keys = 2;
+inline bool InternalVerifyRangeRequest::has_max_keys() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void InternalVerifyRangeRequest::set_has_max_keys() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void InternalVerifyRangeRequest::clear_has_max_keys() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void InternalVerifyRangeRequest::clear_max_keys() {
+  max_keys_ = GOOGLE_LONGLONG(0);
+  clear_has_max_keys();
+}
+inline ::google::protobuf::int64 InternalVerifyRangeRequest::max_keys() const {
+  // @@protoc_insertion_point(field_get:proto.InternalVerifyRangeRequest.max_keys)
+  return max_keys_;
+}
+inline void InternalVerifyRangeRequest::set_max_keys(::google::protobuf::int64 value) {
+  set_has_max_keys();
+  max_keys_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalVerifyRangeRequest.max_keys)
+}
+
+// -------------------------------------------------------------------
+
+// InternalVerifyRangeResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalVerifyRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalVerifyRangeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalVerifyRangeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalVerifyRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalVerifyRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalVerifyRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalVerifyRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalVerifyRangeResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalVerifyRangeResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalVerifyRangeResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalVerifyRangeResponse.header)
+}
+
+// repeated bytes out_of_bounds_keys = 2;
+inline int InternalVerifyRangeResponse::out_of_bounds_keys_size() const {
+  return out_of_bounds_keys_.size();
+}
+inline void InternalVerifyRangeResponse::clear_out_of_bounds_keys() {
+  out_of_bounds_keys_.Clear();
+}
+inline const ::std::string& InternalVerifyRangeResponse::out_of_bounds_keys(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+  return out_of_bounds_keys_.Get(index);
+}
+inline ::std::string* InternalVerifyRangeResponse::mutable_out_of_bounds_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+  return out_of_bounds_keys_.Mutable(index);
+}
+inline void InternalVerifyRangeResponse::set_out_of_bounds_keys(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+  out_of_bounds_keys_.Mutable(index)->assign(value);
+}
+inline void InternalVerifyRangeResponse::set_out_of_bounds_keys(int index, const char* value) {
+  out_of_bounds_keys_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+}
+inline void InternalVerifyRangeResponse::set_out_of_bounds_keys(int index, const void* value, size_t size) {
+  out_of_bounds_keys_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+}
+inline ::std::string* InternalVerifyRangeResponse::add_out_of_bounds_keys() {
+  return out_of_bounds_keys_.Add();
+}
+inline void InternalVerifyRangeResponse::add_out_of_bounds_keys(const ::std::string& value) {
+  out_of_bounds_keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+}
+inline void InternalVerifyRangeResponse::add_out_of_bounds_keys(const char* value) {
+  out_of_bounds_keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+}
+inline void InternalVerifyRangeResponse::add_out_of_bounds_keys(const void* value, size_t size) {
+  out_of_bounds_keys_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+InternalVerifyRangeResponse::out_of_bounds_keys() const {
+  // @@protoc_insertion_point(field_list:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+  return out_of_bounds_keys_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+InternalVerifyRangeResponse::mutable_out_of_bounds_keys() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalVerifyRangeResponse.out_of_bounds_keys)
+  return &out_of_bounds_keys_;
+}
+
+// -------------------------------------------------------------------
+
+// InternalResolveIntentRangeRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalResolveIntentRangeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalResolveIntentRangeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalResolveIntentRangeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalResolveIntentRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::RequestHeader& InternalResolveIntentRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalResolveIntentRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::RequestHeader* InternalResolveIntentRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::RequestHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalResolveIntentRangeRequest.header)
+  return header_;
+}
+inline ::proto::RequestHeader* InternalResolveIntentRangeRequest::release_header() {
+  clear_has_header();
+  ::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalResolveIntentRangeRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalResolveIntentRangeRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalResolveIntentRangeResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalResolveIntentRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalResolveIntentRangeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalRangeLookupRequest::clear_has_header() {
+inline void InternalResolveIntentRangeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalRangeLookupRequest::clear_header() {
+inline void InternalResolveIntentRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalResolveIntentRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalResolveIntentRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalResolveIntentRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalResolveIntentRangeResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalResolveIntentRangeResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalResolveIntentRangeResponse::set_allocated_header(::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalResolveIntentRangeResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// InternalLeaderLeaseRequest
+
+// optional .proto.RequestHeader header = 1;
+inline bool InternalLeaderLeaseRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalLeaderLeaseRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalLeaderLeaseRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalLeaderLeaseRequest::clear_header() {
   if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::RequestHeader& InternalRangeLookupRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupRequest.header)
+inline const ::proto::RequestHeader& InternalLeaderLeaseRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalLeaderLeaseRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::RequestHeader* InternalRangeLookupRequest::mutable_header() {
+inline ::proto::RequestHeader* InternalLeaderLeaseRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalRangeLookupRequest.header)
+  // @@protoc_insertion_point(field_mutable:proto.InternalLeaderLeaseRequest.header)
   return header_;
 }
-inline ::proto::RequestHeader* InternalRangeLookupRequest::release_header() {
+inline ::proto::RequestHeader* InternalLeaderLeaseRequest::release_header() {
   clear_has_header();
   ::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalRangeLookupRequest::set_allocated_header(::proto::RequestHeader* header) {
+inline void InternalLeaderLeaseRequest::set_allocated_header(::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -2368,68 +9134,85 @@ inline void InternalRangeLookupRequest::set_allocated_header(::proto::RequestHea
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeLookupRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalLeaderLeaseRequest.header)
 }
 
-// optional int32 max_ranges = 2;
-inline bool InternalRangeLookupRequest::has_max_ranges() const {
+// optional .proto.Lease lease = 2;
+inline bool InternalLeaderLeaseRequest::has_lease() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalRangeLookupRequest::set_has_max_ranges() {
+inline void InternalLeaderLeaseRequest::set_has_lease() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalRangeLookupRequest::clear_has_max_ranges() {
+inline void InternalLeaderLeaseRequest::clear_has_lease() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalRangeLookupRequest::clear_max_ranges() {
-  max_ranges_ = 0;
-  clear_has_max_ranges();
+inline void InternalLeaderLeaseRequest::clear_lease() {
+  if (lease_ != NULL) lease_->::proto::Lease::Clear();
+  clear_has_lease();
 }
-inline ::google::protobuf::int32 InternalRangeLookupRequest::max_ranges() const {
-  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupRequest.max_ranges)
-  return max_ranges_;
+inline const ::proto::Lease& InternalLeaderLeaseRequest::lease() const {
+  // @@protoc_insertion_point(field_get:proto.InternalLeaderLeaseRequest.lease)
+  return lease_ != NULL ? *lease_ : *default_instance_->lease_;
 }
-inline void InternalRangeLookupRequest::set_max_ranges(::google::protobuf::int32 value) {
-  set_has_max_ranges();
-  max_ranges_ = value;
-  // @@protoc_insertion_point(field_set:proto.InternalRangeLookupRequest.max_ranges)
+inline ::proto::Lease* InternalLeaderLeaseRequest::mutable_lease() {
+  set_has_lease();
+  if (lease_ == NULL) lease_ = new ::proto::Lease;
+  // @@protoc_insertion_point(field_mutable:proto.InternalLeaderLeaseRequest.lease)
+  return lease_;
+}
+inline ::proto::Lease* InternalLeaderLeaseRequest::release_lease() {
+  clear_has_lease();
+  ::proto::Lease* temp = lease_;
+  lease_ = NULL;
+  return temp;
+}
+inline void InternalLeaderLeaseRequest::set_allocated_lease(::proto::Lease* lease) {
+  delete lease_;
+  lease_ = lease;
+  if (lease) {
+    set_has_lease();
+  } else {
+    clear_has_lease();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalLeaderLeaseRequest.lease)
 }
 
 // -------------------------------------------------------------------
 
-// InternalRangeLookupResponse
+// InternalLeaderLeaseResponse
 
 // optional .proto.ResponseHeader header = 1;
-inline bool InternalRangeLookupResponse::has_header() const {
+inline bool InternalLeaderLeaseResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalRangeLookupResponse::set_has_header() {
+inline void InternalLeaderLeaseResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalRangeLookupResponse::clear_has_header() {
+inline void InternalLeaderLeaseResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalRangeLookupResponse::clear_header() {
+inline void InternalLeaderLeaseResponse::clear_header() {
   if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::ResponseHeader& InternalRangeLookupResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupResponse.header)
+inline const ::proto::ResponseHeader& InternalLeaderLeaseResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalLeaderLeaseResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::ResponseHeader* InternalRangeLookupResponse::mutable_header() {
+inline ::proto::ResponseHeader* InternalLeaderLeaseResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalRangeLookupResponse.header)
+  // @@protoc_insertion_point(field_mutable:proto.InternalLeaderLeaseResponse.header)
   return header_;
 }
-inline ::proto::ResponseHeader* InternalRangeLookupResponse::release_header() {
+inline ::proto::ResponseHeader* InternalLeaderLeaseResponse::release_header() {
   clear_has_header();
   ::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalRangeLookupResponse::set_allocated_header(::proto::ResponseHeader* header) {
+inline void InternalLeaderLeaseResponse::set_allocated_header(::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -2437,74 +9220,44 @@ inline void InternalRangeLookupResponse::set_allocated_header(::proto::ResponseH
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalRangeLookupResponse.header)
-}
-
-// repeated .proto.RangeDescriptor ranges = 2;
-inline int InternalRangeLookupResponse::ranges_size() const {
-  return ranges_.size();
-}
-inline void InternalRangeLookupResponse::clear_ranges() {
-  ranges_.Clear();
-}
-inline const ::proto::RangeDescriptor& InternalRangeLookupResponse::ranges(int index) const {
-  // @@protoc_insertion_point(field_get:proto.InternalRangeLookupResponse.ranges)
-  return ranges_.Get(index);
-}
-inline ::proto::RangeDescriptor* InternalRangeLookupResponse::mutable_ranges(int index) {
-  // @@protoc_insertion_point(field_mutable:proto.InternalRangeLookupResponse.ranges)
-  return ranges_.Mutable(index);
-}
-inline ::proto::RangeDescriptor* InternalRangeLookupResponse::add_ranges() {
-  // @@protoc_insertion_point(field_add:proto.InternalRangeLookupResponse.ranges)
-  return ranges_.Add();
-}
-inline const ::google::protobuf::RepeatedPtrField< ::proto::RangeDescriptor >&
-InternalRangeLookupResponse::ranges() const {
-  // @@protoc_insertion_point(field_list:proto.InternalRangeLookupResponse.ranges)
-  return ranges_;
-}
-inline ::google::protobuf::RepeatedPtrField< ::proto::RangeDescriptor >*
-InternalRangeLookupResponse::mutable_ranges() {
-  // @@protoc_insertion_point(field_mutable_list:proto.InternalRangeLookupResponse.ranges)
-  return &ranges_;
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalLeaderLeaseResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalHeartbeatTxnRequest
+// InternalComputeChecksumRequest
 
 // optional .proto.RequestHeader header = 1;
-inline bool InternalHeartbeatTxnRequest::has_header() const {
+inline bool InternalComputeChecksumRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalHeartbeatTxnRequest::set_has_header() {
+inline void InternalComputeChecksumRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalHeartbeatTxnRequest::clear_has_header() {
+inline void InternalComputeChecksumRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalHeartbeatTxnRequest::clear_header() {
+inline void InternalComputeChecksumRequest::clear_header() {
   if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::RequestHeader& InternalHeartbeatTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalHeartbeatTxnRequest.header)
+inline const ::proto::RequestHeader& InternalComputeChecksumRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalComputeChecksumRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::RequestHeader* InternalHeartbeatTxnRequest::mutable_header() {
+inline ::proto::RequestHeader* InternalComputeChecksumRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalHeartbeatTxnRequest.header)
+  // @@protoc_insertion_point(field_mutable:proto.InternalComputeChecksumRequest.header)
   return header_;
 }
-inline ::proto::RequestHeader* InternalHeartbeatTxnRequest::release_header() {
+inline ::proto::RequestHeader* InternalComputeChecksumRequest::release_header() {
   clear_has_header();
   ::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalHeartbeatTxnRequest::set_allocated_header(::proto::RequestHeader* header) {
+inline void InternalComputeChecksumRequest::set_allocated_header(::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -2512,44 +9265,44 @@ inline void InternalHeartbeatTxnRequest::set_allocated_header(::proto::RequestHe
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalHeartbeatTxnRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalComputeChecksumRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// InternalHeartbeatTxnResponse
+// InternalComputeChecksumResponse
 
 // optional .proto.ResponseHeader header = 1;
-inline bool InternalHeartbeatTxnResponse::has_header() const {
+inline bool InternalComputeChecksumResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalHeartbeatTxnResponse::set_has_header() {
+inline void InternalComputeChecksumResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalHeartbeatTxnResponse::clear_has_header() {
+inline void InternalComputeChecksumResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalHeartbeatTxnResponse::clear_header() {
+inline void InternalComputeChecksumResponse::clear_header() {
   if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::ResponseHeader& InternalHeartbeatTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalHeartbeatTxnResponse.header)
+inline const ::proto::ResponseHeader& InternalComputeChecksumResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalComputeChecksumResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::ResponseHeader* InternalHeartbeatTxnResponse::mutable_header() {
+inline ::proto::ResponseHeader* InternalComputeChecksumResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalHeartbeatTxnResponse.header)
+  // @@protoc_insertion_point(field_mutable:proto.InternalComputeChecksumResponse.header)
   return header_;
 }
-inline ::proto::ResponseHeader* InternalHeartbeatTxnResponse::release_header() {
+inline ::proto::ResponseHeader* InternalComputeChecksumResponse::release_header() {
   clear_has_header();
   ::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalHeartbeatTxnResponse::set_allocated_header(::proto::ResponseHeader* header) {
+inline void InternalComputeChecksumResponse::set_allocated_header(::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -2557,165 +9310,275 @@ inline void InternalHeartbeatTxnResponse::set_allocated_header(::proto::Response
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalHeartbeatTxnResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalComputeChecksumResponse.header)
 }
 
-// -------------------------------------------------------------------
-
-// InternalGCRequest_GCKey
-
-// optional bytes key = 1;
-inline bool InternalGCRequest_GCKey::has_key() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional bytes checksum = 2;
+inline bool InternalComputeChecksumResponse::has_checksum() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalGCRequest_GCKey::set_has_key() {
-  _has_bits_[0] |= 0x00000001u;
+inline void InternalComputeChecksumResponse::set_has_checksum() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalGCRequest_GCKey::clear_has_key() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void InternalComputeChecksumResponse::clear_has_checksum() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalGCRequest_GCKey::clear_key() {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_->clear();
+inline void InternalComputeChecksumResponse::clear_checksum() {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_->clear();
   }
-  clear_has_key();
+  clear_has_checksum();
 }
-inline const ::std::string& InternalGCRequest_GCKey::key() const {
-  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.GCKey.key)
-  return *key_;
+inline const ::std::string& InternalComputeChecksumResponse::checksum() const {
+  // @@protoc_insertion_point(field_get:proto.InternalComputeChecksumResponse.checksum)
+  return *checksum_;
 }
-inline void InternalGCRequest_GCKey::set_key(const ::std::string& value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
+inline void InternalComputeChecksumResponse::set_checksum(const ::std::string& value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
   }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set:proto.InternalGCRequest.GCKey.key)
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set:proto.InternalComputeChecksumResponse.checksum)
 }
-inline void InternalGCRequest_GCKey::set_key(const char* value) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
+inline void InternalComputeChecksumResponse::set_checksum(const char* value) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
   }
-  key_->assign(value);
-  // @@protoc_insertion_point(field_set_char:proto.InternalGCRequest.GCKey.key)
+  checksum_->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalComputeChecksumResponse.checksum)
 }
-inline void InternalGCRequest_GCKey::set_key(const void* value, size_t size) {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
+inline void InternalComputeChecksumResponse::set_checksum(const void* value, size_t size) {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
   }
-  key_->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:proto.InternalGCRequest.GCKey.key)
+  checksum_->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalComputeChecksumResponse.checksum)
 }
-inline ::std::string* InternalGCRequest_GCKey::mutable_key() {
-  set_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    key_ = new ::std::string;
+inline ::std::string* InternalComputeChecksumResponse::mutable_checksum() {
+  set_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    checksum_ = new ::std::string;
   }
-  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.GCKey.key)
-  return key_;
+  // @@protoc_insertion_point(field_mutable:proto.InternalComputeChecksumResponse.checksum)
+  return checksum_;
 }
-inline ::std::string* InternalGCRequest_GCKey::release_key() {
-  clear_has_key();
-  if (key_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+inline ::std::string* InternalComputeChecksumResponse::release_checksum() {
+  clear_has_checksum();
+  if (checksum_ == &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
     return NULL;
   } else {
-    ::std::string* temp = key_;
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    ::std::string* temp = checksum_;
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     return temp;
   }
 }
-inline void InternalGCRequest_GCKey::set_allocated_key(::std::string* key) {
-  if (key_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
-    delete key_;
+inline void InternalComputeChecksumResponse::set_allocated_checksum(::std::string* checksum) {
+  if (checksum_ != &::google::protobuf::internal::GetEmptyStringAlreadyInited()) {
+    delete checksum_;
   }
-  if (key) {
-    set_has_key();
-    key_ = key;
+  if (checksum) {
+    set_has_checksum();
+    checksum_ = checksum;
+  } else {
+    clear_has_checksum();
+    checksum_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalComputeChecksumResponse.checksum)
+}
+
+// -------------------------------------------------------------------
+
+// MVCCVersion
+
+// optional .proto.Timestamp timestamp = 1;
+inline bool MVCCVersion::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void MVCCVersion::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void MVCCVersion::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void MVCCVersion::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::proto::Timestamp& MVCCVersion::timestamp() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCVersion.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::proto::Timestamp* MVCCVersion::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.MVCCVersion.timestamp)
+  return timestamp_;
+}
+inline ::proto::Timestamp* MVCCVersion::release_timestamp() {
+  clear_has_timestamp();
+  ::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void MVCCVersion::set_allocated_timestamp(::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.MVCCVersion.timestamp)
+}
+
+// optional bool deleted = 2;
+inline bool MVCCVersion::has_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void MVCCVersion::set_has_deleted() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void MVCCVersion::clear_has_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void MVCCVersion::clear_deleted() {
+  deleted_ = false;
+  clear_has_deleted();
+}
+inline bool MVCCVersion::deleted() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCVersion.deleted)
+  return deleted_;
+}
+inline void MVCCVersion::set_deleted(bool value) {
+  set_has_deleted();
+  deleted_ = value;
+  // @@protoc_insertion_point(field_set:proto.MVCCVersion.deleted)
+}
+
+// optional .proto.Value value = 3;
+inline bool MVCCVersion::has_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void MVCCVersion::set_has_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void MVCCVersion::clear_has_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void MVCCVersion::clear_value() {
+  if (value_ != NULL) value_->::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::proto::Value& MVCCVersion::value() const {
+  // @@protoc_insertion_point(field_get:proto.MVCCVersion.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::proto::Value* MVCCVersion::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.MVCCVersion.value)
+  return value_;
+}
+inline ::proto::Value* MVCCVersion::release_value() {
+  clear_has_value();
+  ::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void MVCCVersion::set_allocated_value(::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
   } else {
-    clear_has_key();
-    key_ = const_cast< ::std::string*>(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.GCKey.key)
+  // @@protoc_insertion_point(field_set_allocated:proto.MVCCVersion.value)
 }
 
-// optional .proto.Timestamp timestamp = 2;
-inline bool InternalGCRequest_GCKey::has_timestamp() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// BatchGetValue
+
+// optional .proto.Value value = 1;
+inline bool BatchGetValue::has_value() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalGCRequest_GCKey::set_has_timestamp() {
-  _has_bits_[0] |= 0x00000002u;
+inline void BatchGetValue::set_has_value() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalGCRequest_GCKey::clear_has_timestamp() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void BatchGetValue::clear_has_value() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalGCRequest_GCKey::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void BatchGetValue::clear_value() {
+  if (value_ != NULL) value_->::proto::Value::Clear();
+  clear_has_value();
 }
-inline const ::proto::Timestamp& InternalGCRequest_GCKey::timestamp() const {
-  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.GCKey.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline const ::proto::Value& BatchGetValue::value() const {
+  // @@protoc_insertion_point(field_get:proto.BatchGetValue.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline ::proto::Timestamp* InternalGCRequest_GCKey::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) timestamp_ = new ::proto::Timestamp;
-  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.GCKey.timestamp)
-  return timestamp_;
+inline ::proto::Value* BatchGetValue::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) value_ = new ::proto::Value;
+  // @@protoc_insertion_point(field_mutable:proto.BatchGetValue.value)
+  return value_;
 }
-inline ::proto::Timestamp* InternalGCRequest_GCKey::release_timestamp() {
-  clear_has_timestamp();
-  ::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::proto::Value* BatchGetValue::release_value() {
+  clear_has_value();
+  ::proto::Value* temp = value_;
+  value_ = NULL;
   return temp;
 }
-inline void InternalGCRequest_GCKey::set_allocated_timestamp(::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void BatchGetValue::set_allocated_value(::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
   } else {
-    clear_has_timestamp();
+    clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.GCKey.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:proto.BatchGetValue.value)
 }
 
 // -------------------------------------------------------------------
 
-// InternalGCRequest
+// InternalGetHistoryRequest
 
 // optional .proto.RequestHeader header = 1;
-inline bool InternalGCRequest::has_header() const {
+inline bool InternalGetHistoryRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalGCRequest::set_has_header() {
+inline void InternalGetHistoryRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalGCRequest::clear_has_header() {
+inline void InternalGetHistoryRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalGCRequest::clear_header() {
+inline void InternalGetHistoryRequest::clear_header() {
   if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::RequestHeader& InternalGCRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.header)
+inline const ::proto::RequestHeader& InternalGetHistoryRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetHistoryRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::RequestHeader* InternalGCRequest::mutable_header() {
+inline ::proto::RequestHeader* InternalGetHistoryRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.header)
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetHistoryRequest.header)
   return header_;
 }
-inline ::proto::RequestHeader* InternalGCRequest::release_header() {
+inline ::proto::RequestHeader* InternalGetHistoryRequest::release_header() {
   clear_has_header();
   ::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalGCRequest::set_allocated_header(::proto::RequestHeader* header) {
+inline void InternalGetHistoryRequest::set_allocated_header(::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -2723,115 +9586,109 @@ inline void InternalGCRequest::set_allocated_header(::proto::RequestHeader* head
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGetHistoryRequest.header)
 }
 
-// optional .proto.GCMetadata gc_meta = 2;
-inline bool InternalGCRequest::has_gc_meta() const {
+// optional .proto.Timestamp before = 2;
+inline bool InternalGetHistoryRequest::has_before() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalGCRequest::set_has_gc_meta() {
+inline void InternalGetHistoryRequest::set_has_before() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalGCRequest::clear_has_gc_meta() {
+inline void InternalGetHistoryRequest::clear_has_before() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalGCRequest::clear_gc_meta() {
-  if (gc_meta_ != NULL) gc_meta_->::proto::GCMetadata::Clear();
-  clear_has_gc_meta();
+inline void InternalGetHistoryRequest::clear_before() {
+  if (before_ != NULL) before_->::proto::Timestamp::Clear();
+  clear_has_before();
 }
-inline const ::proto::GCMetadata& InternalGCRequest::gc_meta() const {
-  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.gc_meta)
-  return gc_meta_ != NULL ? *gc_meta_ : *default_instance_->gc_meta_;
+inline const ::proto::Timestamp& InternalGetHistoryRequest::before() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetHistoryRequest.before)
+  return before_ != NULL ? *before_ : *default_instance_->before_;
 }
-inline ::proto::GCMetadata* InternalGCRequest::mutable_gc_meta() {
-  set_has_gc_meta();
-  if (gc_meta_ == NULL) gc_meta_ = new ::proto::GCMetadata;
-  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.gc_meta)
-  return gc_meta_;
+inline ::proto::Timestamp* InternalGetHistoryRequest::mutable_before() {
+  set_has_before();
+  if (before_ == NULL) before_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetHistoryRequest.before)
+  return before_;
 }
-inline ::proto::GCMetadata* InternalGCRequest::release_gc_meta() {
-  clear_has_gc_meta();
-  ::proto::GCMetadata* temp = gc_meta_;
-  gc_meta_ = NULL;
+inline ::proto::Timestamp* InternalGetHistoryRequest::release_before() {
+  clear_has_before();
+  ::proto::Timestamp* temp = before_;
+  before_ = NULL;
   return temp;
 }
-inline void InternalGCRequest::set_allocated_gc_meta(::proto::GCMetadata* gc_meta) {
-  delete gc_meta_;
-  gc_meta_ = gc_meta;
-  if (gc_meta) {
-    set_has_gc_meta();
+inline void InternalGetHistoryRequest::set_allocated_before(::proto::Timestamp* before) {
+  delete before_;
+  before_ = before;
+  if (before) {
+    set_has_before();
   } else {
-    clear_has_gc_meta();
+    clear_has_before();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCRequest.gc_meta)
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGetHistoryRequest.before)
 }
 
-// repeated .proto.InternalGCRequest.GCKey keys = 3;
-inline int InternalGCRequest::keys_size() const {
-  return keys_.size();
-}
-inline void InternalGCRequest::clear_keys() {
-  keys_.Clear();
+// optional int64 max_versions = 3;
+inline bool InternalGetHistoryRequest::has_max_versions() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline const ::proto::InternalGCRequest_GCKey& InternalGCRequest::keys(int index) const {
-  // @@protoc_insertion_point(field_get:proto.InternalGCRequest.keys)
-  return keys_.Get(index);
+inline void InternalGetHistoryRequest::set_has_max_versions() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline ::proto::InternalGCRequest_GCKey* InternalGCRequest::mutable_keys(int index) {
-  // @@protoc_insertion_point(field_mutable:proto.InternalGCRequest.keys)
-  return keys_.Mutable(index);
+inline void InternalGetHistoryRequest::clear_has_max_versions() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline ::proto::InternalGCRequest_GCKey* InternalGCRequest::add_keys() {
-  // @@protoc_insertion_point(field_add:proto.InternalGCRequest.keys)
-  return keys_.Add();
+inline void InternalGetHistoryRequest::clear_max_versions() {
+  max_versions_ = GOOGLE_LONGLONG(0);
+  clear_has_max_versions();
 }
-inline const ::google::protobuf::RepeatedPtrField< ::proto::InternalGCRequest_GCKey >&
-InternalGCRequest::keys() const {
-  // @@protoc_insertion_point(field_list:proto.InternalGCRequest.keys)
-  return keys_;
+inline ::google::protobuf::int64 InternalGetHistoryRequest::max_versions() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetHistoryRequest.max_versions)
+  return max_versions_;
 }
-inline ::google::protobuf::RepeatedPtrField< ::proto::InternalGCRequest_GCKey >*
-InternalGCRequest::mutable_keys() {
-  // @@protoc_insertion_point(field_mutable_list:proto.InternalGCRequest.keys)
-  return &keys_;
+inline void InternalGetHistoryRequest::set_max_versions(::google::protobuf::int64 value) {
+  set_has_max_versions();
+  max_versions_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalGetHistoryRequest.max_versions)
 }
 
 // -------------------------------------------------------------------
 
-// InternalGCResponse
+// InternalGetHistoryResponse
 
 // optional .proto.ResponseHeader header = 1;
-inline bool InternalGCResponse::has_header() const {
+inline bool InternalGetHistoryResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalGCResponse::set_has_header() {
+inline void InternalGetHistoryResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalGCResponse::clear_has_header() {
+inline void InternalGetHistoryResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalGCResponse::clear_header() {
+inline void InternalGetHistoryResponse::clear_header() {
   if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::ResponseHeader& InternalGCResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalGCResponse.header)
+inline const ::proto::ResponseHeader& InternalGetHistoryResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetHistoryResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::ResponseHeader* InternalGCResponse::mutable_header() {
+inline ::proto::ResponseHeader* InternalGetHistoryResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalGCResponse.header)
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetHistoryResponse.header)
   return header_;
 }
-inline ::proto::ResponseHeader* InternalGCResponse::release_header() {
+inline ::proto::ResponseHeader* InternalGetHistoryResponse::release_header() {
   clear_has_header();
   ::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalGCResponse::set_allocated_header(::proto::ResponseHeader* header) {
+inline void InternalGetHistoryResponse::set_allocated_header(::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -2839,1157 +9696,1470 @@ inline void InternalGCResponse::set_allocated_header(::proto::ResponseHeader* he
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalGCResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalGetHistoryResponse.header)
+}
+
+// repeated .proto.MVCCVersion versions = 2;
+inline int InternalGetHistoryResponse::versions_size() const {
+  return versions_.size();
+}
+inline void InternalGetHistoryResponse::clear_versions() {
+  versions_.Clear();
+}
+inline const ::proto::MVCCVersion& InternalGetHistoryResponse::versions(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalGetHistoryResponse.versions)
+  return versions_.Get(index);
+}
+inline ::proto::MVCCVersion* InternalGetHistoryResponse::mutable_versions(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalGetHistoryResponse.versions)
+  return versions_.Mutable(index);
+}
+inline ::proto::MVCCVersion* InternalGetHistoryResponse::add_versions() {
+  // @@protoc_insertion_point(field_add:proto.InternalGetHistoryResponse.versions)
+  return versions_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::MVCCVersion >&
+InternalGetHistoryResponse::versions() const {
+  // @@protoc_insertion_point(field_list:proto.InternalGetHistoryResponse.versions)
+  return versions_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::MVCCVersion >*
+InternalGetHistoryResponse::mutable_versions() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalGetHistoryResponse.versions)
+  return &versions_;
 }
 
 // -------------------------------------------------------------------
 
-// InternalPushTxnRequest
+// InternalBatchGetRequest
 
 // optional .proto.RequestHeader header = 1;
-inline bool InternalPushTxnRequest::has_header() const {
+inline bool InternalBatchGetRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void InternalPushTxnRequest::set_has_header() {
+inline void InternalBatchGetRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void InternalPushTxnRequest::clear_has_header() {
+inline void InternalBatchGetRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void InternalPushTxnRequest::clear_header() {
+inline void InternalBatchGetRequest::clear_header() {
   if (header_ != NULL) header_->::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::proto::RequestHeader& InternalPushTxnRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalPushTxnRequest.header)
+inline const ::proto::RequestHeader& InternalBatchGetRequest::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalBatchGetRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::proto::RequestHeader* InternalPushTxnRequest::mutable_header() {
+inline ::proto::RequestHeader* InternalBatchGetRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnRequest.header)
+  // @@protoc_insertion_point(field_mutable:proto.InternalBatchGetRequest.header)
   return header_;
 }
-inline ::proto::RequestHeader* InternalPushTxnRequest::release_header() {
+inline ::proto::RequestHeader* InternalBatchGetRequest::release_header() {
   clear_has_header();
   ::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void InternalPushTxnRequest::set_allocated_header(::proto::RequestHeader* header) {
+inline void InternalBatchGetRequest::set_allocated_header(::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalBatchGetRequest.header)
+}
+
+// repeated bytes keys = 2;
+inline int InternalBatchGetRequest::keys_size() const {
+  return keys_.size();
+}
+inline void InternalBatchGetRequest::clear_keys() {
+  keys_.Clear();
+}
+inline const ::std::string& InternalBatchGetRequest::keys(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalBatchGetRequest.keys)
+  return keys_.Get(index);
+}
+inline ::std::string* InternalBatchGetRequest::mutable_keys(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalBatchGetRequest.keys)
+  return keys_.Mutable(index);
+}
+inline void InternalBatchGetRequest::set_keys(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:proto.InternalBatchGetRequest.keys)
+  keys_.Mutable(index)->assign(value);
+}
+inline void InternalBatchGetRequest::set_keys(int index, const char* value) {
+  keys_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:proto.InternalBatchGetRequest.keys)
+}
+inline void InternalBatchGetRequest::set_keys(int index, const void* value, size_t size) {
+  keys_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:proto.InternalBatchGetRequest.keys)
+}
+inline ::std::string* InternalBatchGetRequest::add_keys() {
+  return keys_.Add();
+}
+inline void InternalBatchGetRequest::add_keys(const ::std::string& value) {
+  keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:proto.InternalBatchGetRequest.keys)
+}
+inline void InternalBatchGetRequest::add_keys(const char* value) {
+  keys_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:proto.InternalBatchGetRequest.keys)
+}
+inline void InternalBatchGetRequest::add_keys(const void* value, size_t size) {
+  keys_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:proto.InternalBatchGetRequest.keys)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+InternalBatchGetRequest::keys() const {
+  // @@protoc_insertion_point(field_list:proto.InternalBatchGetRequest.keys)
+  return keys_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+InternalBatchGetRequest::mutable_keys() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalBatchGetRequest.keys)
+  return &keys_;
+}
+
+// -------------------------------------------------------------------
+
+// InternalBatchGetResponse
+
+// optional .proto.ResponseHeader header = 1;
+inline bool InternalBatchGetResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void InternalBatchGetResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void InternalBatchGetResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void InternalBatchGetResponse::clear_header() {
+  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::proto::ResponseHeader& InternalBatchGetResponse::header() const {
+  // @@protoc_insertion_point(field_get:proto.InternalBatchGetResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::proto::ResponseHeader* InternalBatchGetResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
+  // @@protoc_insertion_point(field_mutable:proto.InternalBatchGetResponse.header)
+  return header_;
+}
+inline ::proto::ResponseHeader* InternalBatchGetResponse::release_header() {
+  clear_has_header();
+  ::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void InternalBatchGetResponse::set_allocated_header(::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
     set_has_header();
   } else {
-    clear_has_header();
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalBatchGetResponse.header)
+}
+
+// repeated .proto.BatchGetValue values = 2;
+inline int InternalBatchGetResponse::values_size() const {
+  return values_.size();
+}
+inline void InternalBatchGetResponse::clear_values() {
+  values_.Clear();
+}
+inline const ::proto::BatchGetValue& InternalBatchGetResponse::values(int index) const {
+  // @@protoc_insertion_point(field_get:proto.InternalBatchGetResponse.values)
+  return values_.Get(index);
+}
+inline ::proto::BatchGetValue* InternalBatchGetResponse::mutable_values(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.InternalBatchGetResponse.values)
+  return values_.Mutable(index);
+}
+inline ::proto::BatchGetValue* InternalBatchGetResponse::add_values() {
+  // @@protoc_insertion_point(field_add:proto.InternalBatchGetResponse.values)
+  return values_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::BatchGetValue >&
+InternalBatchGetResponse::values() const {
+  // @@protoc_insertion_point(field_list:proto.InternalBatchGetResponse.values)
+  return values_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::BatchGetValue >*
+InternalBatchGetResponse::mutable_values() {
+  // @@protoc_insertion_point(field_mutable_list:proto.InternalBatchGetResponse.values)
+  return &values_;
+}
+
+// -------------------------------------------------------------------
+
+// ReadWriteCmdResponse
+
+// optional .proto.PutResponse put = 1;
+inline bool ReadWriteCmdResponse::has_put() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ReadWriteCmdResponse::set_has_put() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ReadWriteCmdResponse::clear_has_put() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ReadWriteCmdResponse::clear_put() {
+  if (put_ != NULL) put_->::proto::PutResponse::Clear();
+  clear_has_put();
+}
+inline const ::proto::PutResponse& ReadWriteCmdResponse::put() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.put)
+  return put_ != NULL ? *put_ : *default_instance_->put_;
+}
+inline ::proto::PutResponse* ReadWriteCmdResponse::mutable_put() {
+  set_has_put();
+  if (put_ == NULL) put_ = new ::proto::PutResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.put)
+  return put_;
+}
+inline ::proto::PutResponse* ReadWriteCmdResponse::release_put() {
+  clear_has_put();
+  ::proto::PutResponse* temp = put_;
+  put_ = NULL;
+  return temp;
+}
+inline void ReadWriteCmdResponse::set_allocated_put(::proto::PutResponse* put) {
+  delete put_;
+  put_ = put;
+  if (put) {
+    set_has_put();
+  } else {
+    clear_has_put();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.put)
 }
 
-// optional .proto.Transaction pushee_txn = 2;
-inline bool InternalPushTxnRequest::has_pushee_txn() const {
+// optional .proto.ConditionalPutResponse conditional_put = 2;
+inline bool ReadWriteCmdResponse::has_conditional_put() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void InternalPushTxnRequest::set_has_pushee_txn() {
+inline void ReadWriteCmdResponse::set_has_conditional_put() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void InternalPushTxnRequest::clear_has_pushee_txn() {
+inline void ReadWriteCmdResponse::clear_has_conditional_put() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void InternalPushTxnRequest::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::proto::Transaction::Clear();
-  clear_has_pushee_txn();
+inline void ReadWriteCmdResponse::clear_conditional_put() {
+  if (conditional_put_ != NULL) conditional_put_->::proto::ConditionalPutResponse::Clear();
+  clear_has_conditional_put();
 }
-inline const ::proto::Transaction& InternalPushTxnRequest::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:proto.InternalPushTxnRequest.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+inline const ::proto::ConditionalPutResponse& ReadWriteCmdResponse::conditional_put() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.conditional_put)
+  return conditional_put_ != NULL ? *conditional_put_ : *default_instance_->conditional_put_;
 }
-inline ::proto::Transaction* InternalPushTxnRequest::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) pushee_txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnRequest.pushee_txn)
-  return pushee_txn_;
+inline ::proto::ConditionalPutResponse* ReadWriteCmdResponse::mutable_conditional_put() {
+  set_has_conditional_put();
+  if (conditional_put_ == NULL) conditional_put_ = new ::proto::ConditionalPutResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.conditional_put)
+  return conditional_put_;
 }
-inline ::proto::Transaction* InternalPushTxnRequest::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::proto::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
+inline ::proto::ConditionalPutResponse* ReadWriteCmdResponse::release_conditional_put() {
+  clear_has_conditional_put();
+  ::proto::ConditionalPutResponse* temp = conditional_put_;
+  conditional_put_ = NULL;
   return temp;
 }
-inline void InternalPushTxnRequest::set_allocated_pushee_txn(::proto::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
+inline void ReadWriteCmdResponse::set_allocated_conditional_put(::proto::ConditionalPutResponse* conditional_put) {
+  delete conditional_put_;
+  conditional_put_ = conditional_put;
+  if (conditional_put) {
+    set_has_conditional_put();
   } else {
-    clear_has_pushee_txn();
+    clear_has_conditional_put();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnRequest.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.conditional_put)
 }
 
-// optional bool Abort = 3;
-inline bool InternalPushTxnRequest::has_abort() const {
+// optional .proto.IncrementResponse increment = 3;
+inline bool ReadWriteCmdResponse::has_increment() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void InternalPushTxnRequest::set_has_abort() {
+inline void ReadWriteCmdResponse::set_has_increment() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void InternalPushTxnRequest::clear_has_abort() {
+inline void ReadWriteCmdResponse::clear_has_increment() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void InternalPushTxnRequest::clear_abort() {
-  abort_ = false;
-  clear_has_abort();
+inline void ReadWriteCmdResponse::clear_increment() {
+  if (increment_ != NULL) increment_->::proto::IncrementResponse::Clear();
+  clear_has_increment();
 }
-inline bool InternalPushTxnRequest::abort() const {
-  // @@protoc_insertion_point(field_get:proto.InternalPushTxnRequest.Abort)
-  return abort_;
+inline const ::proto::IncrementResponse& ReadWriteCmdResponse::increment() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.increment)
+  return increment_ != NULL ? *increment_ : *default_instance_->increment_;
 }
-inline void InternalPushTxnRequest::set_abort(bool value) {
-  set_has_abort();
-  abort_ = value;
-  // @@protoc_insertion_point(field_set:proto.InternalPushTxnRequest.Abort)
+inline ::proto::IncrementResponse* ReadWriteCmdResponse::mutable_increment() {
+  set_has_increment();
+  if (increment_ == NULL) increment_ = new ::proto::IncrementResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.increment)
+  return increment_;
+}
+inline ::proto::IncrementResponse* ReadWriteCmdResponse::release_increment() {
+  clear_has_increment();
+  ::proto::IncrementResponse* temp = increment_;
+  increment_ = NULL;
+  return temp;
+}
+inline void ReadWriteCmdResponse::set_allocated_increment(::proto::IncrementResponse* increment) {
+  delete increment_;
+  increment_ = increment;
+  if (increment) {
+    set_has_increment();
+  } else {
+    clear_has_increment();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.increment)
 }
 
-// -------------------------------------------------------------------
-
-// InternalPushTxnResponse
+// optional .proto.DeleteResponse delete = 4;
+inline bool ReadWriteCmdResponse::has_delete_() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ReadWriteCmdResponse::set_has_delete_() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ReadWriteCmdResponse::clear_has_delete_() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ReadWriteCmdResponse::clear_delete_() {
+  if (delete__ != NULL) delete__->::proto::DeleteResponse::Clear();
+  clear_has_delete_();
+}
+inline const ::proto::DeleteResponse& ReadWriteCmdResponse::delete_() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.delete)
+  return delete__ != NULL ? *delete__ : *default_instance_->delete__;
+}
+inline ::proto::DeleteResponse* ReadWriteCmdResponse::mutable_delete_() {
+  set_has_delete_();
+  if (delete__ == NULL) delete__ = new ::proto::DeleteResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.delete)
+  return delete__;
+}
+inline ::proto::DeleteResponse* ReadWriteCmdResponse::release_delete_() {
+  clear_has_delete_();
+  ::proto::DeleteResponse* temp = delete__;
+  delete__ = NULL;
+  return temp;
+}
+inline void ReadWriteCmdResponse::set_allocated_delete_(::proto::DeleteResponse* delete_) {
+  delete delete__;
+  delete__ = delete_;
+  if (delete_) {
+    set_has_delete_();
+  } else {
+    clear_has_delete_();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.delete)
+}
 
-// optional .proto.ResponseHeader header = 1;
-inline bool InternalPushTxnResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.DeleteRangeResponse delete_range = 5;
+inline bool ReadWriteCmdResponse::has_delete_range() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
 }
-inline void InternalPushTxnResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_delete_range() {
+  _has_bits_[0] |= 0x00000010u;
 }
-inline void InternalPushTxnResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_delete_range() {
+  _has_bits_[0] &= ~0x00000010u;
 }
-inline void InternalPushTxnResponse::clear_header() {
-  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ReadWriteCmdResponse::clear_delete_range() {
+  if (delete_range_ != NULL) delete_range_->::proto::DeleteRangeResponse::Clear();
+  clear_has_delete_range();
 }
-inline const ::proto::ResponseHeader& InternalPushTxnResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalPushTxnResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::proto::DeleteRangeResponse& ReadWriteCmdResponse::delete_range() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.delete_range)
+  return delete_range_ != NULL ? *delete_range_ : *default_instance_->delete_range_;
 }
-inline ::proto::ResponseHeader* InternalPushTxnResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnResponse.header)
-  return header_;
+inline ::proto::DeleteRangeResponse* ReadWriteCmdResponse::mutable_delete_range() {
+  set_has_delete_range();
+  if (delete_range_ == NULL) delete_range_ = new ::proto::DeleteRangeResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.delete_range)
+  return delete_range_;
 }
-inline ::proto::ResponseHeader* InternalPushTxnResponse::release_header() {
-  clear_has_header();
-  ::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::proto::DeleteRangeResponse* ReadWriteCmdResponse::release_delete_range() {
+  clear_has_delete_range();
+  ::proto::DeleteRangeResponse* temp = delete_range_;
+  delete_range_ = NULL;
   return temp;
 }
-inline void InternalPushTxnResponse::set_allocated_header(::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ReadWriteCmdResponse::set_allocated_delete_range(::proto::DeleteRangeResponse* delete_range) {
+  delete delete_range_;
+  delete_range_ = delete_range;
+  if (delete_range) {
+    set_has_delete_range();
   } else {
-    clear_has_header();
+    clear_has_delete_range();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.delete_range)
 }
 
-// optional .proto.Transaction pushee_txn = 2;
-inline bool InternalPushTxnResponse::has_pushee_txn() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .proto.EndTransactionResponse end_transaction = 6;
+inline bool ReadWriteCmdResponse::has_end_transaction() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
 }
-inline void InternalPushTxnResponse::set_has_pushee_txn() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ReadWriteCmdResponse::set_has_end_transaction() {
+  _has_bits_[0] |= 0x00000020u;
 }
-inline void InternalPushTxnResponse::clear_has_pushee_txn() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ReadWriteCmdResponse::clear_has_end_transaction() {
+  _has_bits_[0] &= ~0x00000020u;
 }
-inline void InternalPushTxnResponse::clear_pushee_txn() {
-  if (pushee_txn_ != NULL) pushee_txn_->::proto::Transaction::Clear();
-  clear_has_pushee_txn();
+inline void ReadWriteCmdResponse::clear_end_transaction() {
+  if (end_transaction_ != NULL) end_transaction_->::proto::EndTransactionResponse::Clear();
+  clear_has_end_transaction();
 }
-inline const ::proto::Transaction& InternalPushTxnResponse::pushee_txn() const {
-  // @@protoc_insertion_point(field_get:proto.InternalPushTxnResponse.pushee_txn)
-  return pushee_txn_ != NULL ? *pushee_txn_ : *default_instance_->pushee_txn_;
+inline const ::proto::EndTransactionResponse& ReadWriteCmdResponse::end_transaction() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.end_transaction)
+  return end_transaction_ != NULL ? *end_transaction_ : *default_instance_->end_transaction_;
 }
-inline ::proto::Transaction* InternalPushTxnResponse::mutable_pushee_txn() {
-  set_has_pushee_txn();
-  if (pushee_txn_ == NULL) pushee_txn_ = new ::proto::Transaction;
-  // @@protoc_insertion_point(field_mutable:proto.InternalPushTxnResponse.pushee_txn)
-  return pushee_txn_;
+inline ::proto::EndTransactionResponse* ReadWriteCmdResponse::mutable_end_transaction() {
+  set_has_end_transaction();
+  if (end_transaction_ == NULL) end_transaction_ = new ::proto::EndTransactionResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.end_transaction)
+  return end_transaction_;
 }
-inline ::proto::Transaction* InternalPushTxnResponse::release_pushee_txn() {
-  clear_has_pushee_txn();
-  ::proto::Transaction* temp = pushee_txn_;
-  pushee_txn_ = NULL;
+inline ::proto::EndTransactionResponse* ReadWriteCmdResponse::release_end_transaction() {
+  clear_has_end_transaction();
+  ::proto::EndTransactionResponse* temp = end_transaction_;
+  end_transaction_ = NULL;
   return temp;
 }
-inline void InternalPushTxnResponse::set_allocated_pushee_txn(::proto::Transaction* pushee_txn) {
-  delete pushee_txn_;
-  pushee_txn_ = pushee_txn;
-  if (pushee_txn) {
-    set_has_pushee_txn();
+inline void ReadWriteCmdResponse::set_allocated_end_transaction(::proto::EndTransactionResponse* end_transaction) {
+  delete end_transaction_;
+  end_transaction_ = end_transaction;
+  if (end_transaction) {
+    set_has_end_transaction();
   } else {
-    clear_has_pushee_txn();
+    clear_has_end_transaction();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalPushTxnResponse.pushee_txn)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.end_transaction)
 }
 
-// -------------------------------------------------------------------
-
-// InternalResolveIntentRequest
-
-// optional .proto.RequestHeader header = 1;
-inline bool InternalResolveIntentRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.ReapQueueResponse reap_queue = 7;
+inline bool ReadWriteCmdResponse::has_reap_queue() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
 }
-inline void InternalResolveIntentRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_reap_queue() {
+  _has_bits_[0] |= 0x00000040u;
 }
-inline void InternalResolveIntentRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_reap_queue() {
+  _has_bits_[0] &= ~0x00000040u;
 }
-inline void InternalResolveIntentRequest::clear_header() {
-  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ReadWriteCmdResponse::clear_reap_queue() {
+  if (reap_queue_ != NULL) reap_queue_->::proto::ReapQueueResponse::Clear();
+  clear_has_reap_queue();
 }
-inline const ::proto::RequestHeader& InternalResolveIntentRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalResolveIntentRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::proto::ReapQueueResponse& ReadWriteCmdResponse::reap_queue() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.reap_queue)
+  return reap_queue_ != NULL ? *reap_queue_ : *default_instance_->reap_queue_;
 }
-inline ::proto::RequestHeader* InternalResolveIntentRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalResolveIntentRequest.header)
-  return header_;
+inline ::proto::ReapQueueResponse* ReadWriteCmdResponse::mutable_reap_queue() {
+  set_has_reap_queue();
+  if (reap_queue_ == NULL) reap_queue_ = new ::proto::ReapQueueResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.reap_queue)
+  return reap_queue_;
 }
-inline ::proto::RequestHeader* InternalResolveIntentRequest::release_header() {
-  clear_has_header();
-  ::proto::RequestHeader* temp = header_;
-  header_ = NULL;
+inline ::proto::ReapQueueResponse* ReadWriteCmdResponse::release_reap_queue() {
+  clear_has_reap_queue();
+  ::proto::ReapQueueResponse* temp = reap_queue_;
+  reap_queue_ = NULL;
   return temp;
 }
-inline void InternalResolveIntentRequest::set_allocated_header(::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ReadWriteCmdResponse::set_allocated_reap_queue(::proto::ReapQueueResponse* reap_queue) {
+  delete reap_queue_;
+  reap_queue_ = reap_queue;
+  if (reap_queue) {
+    set_has_reap_queue();
   } else {
-    clear_has_header();
+    clear_has_reap_queue();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalResolveIntentRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.reap_queue)
 }
 
-// -------------------------------------------------------------------
-
-// InternalResolveIntentResponse
-
-// optional .proto.ResponseHeader header = 1;
-inline bool InternalResolveIntentResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.EnqueueUpdateResponse enqueue_update = 8;
+inline bool ReadWriteCmdResponse::has_enqueue_update() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
 }
-inline void InternalResolveIntentResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_enqueue_update() {
+  _has_bits_[0] |= 0x00000080u;
 }
-inline void InternalResolveIntentResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_enqueue_update() {
+  _has_bits_[0] &= ~0x00000080u;
 }
-inline void InternalResolveIntentResponse::clear_header() {
-  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ReadWriteCmdResponse::clear_enqueue_update() {
+  if (enqueue_update_ != NULL) enqueue_update_->::proto::EnqueueUpdateResponse::Clear();
+  clear_has_enqueue_update();
 }
-inline const ::proto::ResponseHeader& InternalResolveIntentResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalResolveIntentResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::proto::EnqueueUpdateResponse& ReadWriteCmdResponse::enqueue_update() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.enqueue_update)
+  return enqueue_update_ != NULL ? *enqueue_update_ : *default_instance_->enqueue_update_;
 }
-inline ::proto::ResponseHeader* InternalResolveIntentResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalResolveIntentResponse.header)
-  return header_;
+inline ::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::mutable_enqueue_update() {
+  set_has_enqueue_update();
+  if (enqueue_update_ == NULL) enqueue_update_ = new ::proto::EnqueueUpdateResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.enqueue_update)
+  return enqueue_update_;
 }
-inline ::proto::ResponseHeader* InternalResolveIntentResponse::release_header() {
-  clear_has_header();
-  ::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::release_enqueue_update() {
+  clear_has_enqueue_update();
+  ::proto::EnqueueUpdateResponse* temp = enqueue_update_;
+  enqueue_update_ = NULL;
   return temp;
 }
-inline void InternalResolveIntentResponse::set_allocated_header(::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ReadWriteCmdResponse::set_allocated_enqueue_update(::proto::EnqueueUpdateResponse* enqueue_update) {
+  delete enqueue_update_;
+  enqueue_update_ = enqueue_update;
+  if (enqueue_update) {
+    set_has_enqueue_update();
   } else {
-    clear_has_header();
+    clear_has_enqueue_update();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalResolveIntentResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.enqueue_update)
 }
 
-// -------------------------------------------------------------------
-
-// InternalMergeRequest
-
-// optional .proto.RequestHeader header = 1;
-inline bool InternalMergeRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.EnqueueMessageResponse enqueue_message = 9;
+inline bool ReadWriteCmdResponse::has_enqueue_message() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
 }
-inline void InternalMergeRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_enqueue_message() {
+  _has_bits_[0] |= 0x00000100u;
 }
-inline void InternalMergeRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_enqueue_message() {
+  _has_bits_[0] &= ~0x00000100u;
 }
-inline void InternalMergeRequest::clear_header() {
-  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ReadWriteCmdResponse::clear_enqueue_message() {
+  if (enqueue_message_ != NULL) enqueue_message_->::proto::EnqueueMessageResponse::Clear();
+  clear_has_enqueue_message();
 }
-inline const ::proto::RequestHeader& InternalMergeRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalMergeRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::proto::EnqueueMessageResponse& ReadWriteCmdResponse::enqueue_message() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.enqueue_message)
+  return enqueue_message_ != NULL ? *enqueue_message_ : *default_instance_->enqueue_message_;
 }
-inline ::proto::RequestHeader* InternalMergeRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalMergeRequest.header)
-  return header_;
+inline ::proto::EnqueueMessageResponse* ReadWriteCmdResponse::mutable_enqueue_message() {
+  set_has_enqueue_message();
+  if (enqueue_message_ == NULL) enqueue_message_ = new ::proto::EnqueueMessageResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.enqueue_message)
+  return enqueue_message_;
 }
-inline ::proto::RequestHeader* InternalMergeRequest::release_header() {
-  clear_has_header();
-  ::proto::RequestHeader* temp = header_;
-  header_ = NULL;
+inline ::proto::EnqueueMessageResponse* ReadWriteCmdResponse::release_enqueue_message() {
+  clear_has_enqueue_message();
+  ::proto::EnqueueMessageResponse* temp = enqueue_message_;
+  enqueue_message_ = NULL;
   return temp;
 }
-inline void InternalMergeRequest::set_allocated_header(::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ReadWriteCmdResponse::set_allocated_enqueue_message(::proto::EnqueueMessageResponse* enqueue_message) {
+  delete enqueue_message_;
+  enqueue_message_ = enqueue_message;
+  if (enqueue_message) {
+    set_has_enqueue_message();
   } else {
-    clear_has_header();
+    clear_has_enqueue_message();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalMergeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.enqueue_message)
 }
 
-// optional .proto.Value value = 2;
-inline bool InternalMergeRequest::has_value() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
+inline bool ReadWriteCmdResponse::has_internal_heartbeat_txn() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
 }
-inline void InternalMergeRequest::set_has_value() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ReadWriteCmdResponse::set_has_internal_heartbeat_txn() {
+  _has_bits_[0] |= 0x00000200u;
 }
-inline void InternalMergeRequest::clear_has_value() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ReadWriteCmdResponse::clear_has_internal_heartbeat_txn() {
+  _has_bits_[0] &= ~0x00000200u;
 }
-inline void InternalMergeRequest::clear_value() {
-  if (value_ != NULL) value_->::proto::Value::Clear();
-  clear_has_value();
+inline void ReadWriteCmdResponse::clear_internal_heartbeat_txn() {
+  if (internal_heartbeat_txn_ != NULL) internal_heartbeat_txn_->::proto::InternalHeartbeatTxnResponse::Clear();
+  clear_has_internal_heartbeat_txn();
 }
-inline const ::proto::Value& InternalMergeRequest::value() const {
-  // @@protoc_insertion_point(field_get:proto.InternalMergeRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline const ::proto::InternalHeartbeatTxnResponse& ReadWriteCmdResponse::internal_heartbeat_txn() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_heartbeat_txn)
+  return internal_heartbeat_txn_ != NULL ? *internal_heartbeat_txn_ : *default_instance_->internal_heartbeat_txn_;
 }
-inline ::proto::Value* InternalMergeRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) value_ = new ::proto::Value;
-  // @@protoc_insertion_point(field_mutable:proto.InternalMergeRequest.value)
-  return value_;
+inline ::proto::InternalHeartbeatTxnResponse* ReadWriteCmdResponse::mutable_internal_heartbeat_txn() {
+  set_has_internal_heartbeat_txn();
+  if (internal_heartbeat_txn_ == NULL) internal_heartbeat_txn_ = new ::proto::InternalHeartbeatTxnResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_heartbeat_txn)
+  return internal_heartbeat_txn_;
 }
-inline ::proto::Value* InternalMergeRequest::release_value() {
-  clear_has_value();
-  ::proto::Value* temp = value_;
-  value_ = NULL;
+inline ::proto::InternalHeartbeatTxnResponse* ReadWriteCmdResponse::release_internal_heartbeat_txn() {
+  clear_has_internal_heartbeat_txn();
+  ::proto::InternalHeartbeatTxnResponse* temp = internal_heartbeat_txn_;
+  internal_heartbeat_txn_ = NULL;
   return temp;
 }
-inline void InternalMergeRequest::set_allocated_value(::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
+inline void ReadWriteCmdResponse::set_allocated_internal_heartbeat_txn(::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn) {
+  delete internal_heartbeat_txn_;
+  internal_heartbeat_txn_ = internal_heartbeat_txn;
+  if (internal_heartbeat_txn) {
+    set_has_internal_heartbeat_txn();
   } else {
-    clear_has_value();
+    clear_has_internal_heartbeat_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalMergeRequest.value)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_heartbeat_txn)
 }
 
-// -------------------------------------------------------------------
-
-// InternalMergeResponse
-
-// optional .proto.ResponseHeader header = 1;
-inline bool InternalMergeResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.InternalPushTxnResponse internal_push_txn = 11;
+inline bool ReadWriteCmdResponse::has_internal_push_txn() const {
+  return (_has_bits_[0] & 0x00000400u) != 0;
 }
-inline void InternalMergeResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_internal_push_txn() {
+  _has_bits_[0] |= 0x00000400u;
 }
-inline void InternalMergeResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_internal_push_txn() {
+  _has_bits_[0] &= ~0x00000400u;
 }
-inline void InternalMergeResponse::clear_header() {
-  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ReadWriteCmdResponse::clear_internal_push_txn() {
+  if (internal_push_txn_ != NULL) internal_push_txn_->::proto::InternalPushTxnResponse::Clear();
+  clear_has_internal_push_txn();
 }
-inline const ::proto::ResponseHeader& InternalMergeResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalMergeResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::proto::InternalPushTxnResponse& ReadWriteCmdResponse::internal_push_txn() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_push_txn)
+  return internal_push_txn_ != NULL ? *internal_push_txn_ : *default_instance_->internal_push_txn_;
 }
-inline ::proto::ResponseHeader* InternalMergeResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalMergeResponse.header)
-  return header_;
+inline ::proto::InternalPushTxnResponse* ReadWriteCmdResponse::mutable_internal_push_txn() {
+  set_has_internal_push_txn();
+  if (internal_push_txn_ == NULL) internal_push_txn_ = new ::proto::InternalPushTxnResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_push_txn)
+  return internal_push_txn_;
 }
-inline ::proto::ResponseHeader* InternalMergeResponse::release_header() {
-  clear_has_header();
-  ::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::proto::InternalPushTxnResponse* ReadWriteCmdResponse::release_internal_push_txn() {
+  clear_has_internal_push_txn();
+  ::proto::InternalPushTxnResponse* temp = internal_push_txn_;
+  internal_push_txn_ = NULL;
   return temp;
 }
-inline void InternalMergeResponse::set_allocated_header(::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ReadWriteCmdResponse::set_allocated_internal_push_txn(::proto::InternalPushTxnResponse* internal_push_txn) {
+  delete internal_push_txn_;
+  internal_push_txn_ = internal_push_txn;
+  if (internal_push_txn) {
+    set_has_internal_push_txn();
   } else {
-    clear_has_header();
+    clear_has_internal_push_txn();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalMergeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_push_txn)
 }
 
-// -------------------------------------------------------------------
-
-// InternalTruncateLogRequest
-
-// optional .proto.RequestHeader header = 1;
-inline bool InternalTruncateLogRequest::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
+inline bool ReadWriteCmdResponse::has_internal_resolve_intent() const {
+  return (_has_bits_[0] & 0x00000800u) != 0;
 }
-inline void InternalTruncateLogRequest::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_internal_resolve_intent() {
+  _has_bits_[0] |= 0x00000800u;
 }
-inline void InternalTruncateLogRequest::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_internal_resolve_intent() {
+  _has_bits_[0] &= ~0x00000800u;
 }
-inline void InternalTruncateLogRequest::clear_header() {
-  if (header_ != NULL) header_->::proto::RequestHeader::Clear();
-  clear_has_header();
+inline void ReadWriteCmdResponse::clear_internal_resolve_intent() {
+  if (internal_resolve_intent_ != NULL) internal_resolve_intent_->::proto::InternalResolveIntentResponse::Clear();
+  clear_has_internal_resolve_intent();
 }
-inline const ::proto::RequestHeader& InternalTruncateLogRequest::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalTruncateLogRequest.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::proto::InternalResolveIntentResponse& ReadWriteCmdResponse::internal_resolve_intent() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_resolve_intent)
+  return internal_resolve_intent_ != NULL ? *internal_resolve_intent_ : *default_instance_->internal_resolve_intent_;
 }
-inline ::proto::RequestHeader* InternalTruncateLogRequest::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::proto::RequestHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalTruncateLogRequest.header)
-  return header_;
+inline ::proto::InternalResolveIntentResponse* ReadWriteCmdResponse::mutable_internal_resolve_intent() {
+  set_has_internal_resolve_intent();
+  if (internal_resolve_intent_ == NULL) internal_resolve_intent_ = new ::proto::InternalResolveIntentResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_resolve_intent)
+  return internal_resolve_intent_;
 }
-inline ::proto::RequestHeader* InternalTruncateLogRequest::release_header() {
-  clear_has_header();
-  ::proto::RequestHeader* temp = header_;
-  header_ = NULL;
+inline ::proto::InternalResolveIntentResponse* ReadWriteCmdResponse::release_internal_resolve_intent() {
+  clear_has_internal_resolve_intent();
+  ::proto::InternalResolveIntentResponse* temp = internal_resolve_intent_;
+  internal_resolve_intent_ = NULL;
   return temp;
 }
-inline void InternalTruncateLogRequest::set_allocated_header(::proto::RequestHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ReadWriteCmdResponse::set_allocated_internal_resolve_intent(::proto::InternalResolveIntentResponse* internal_resolve_intent) {
+  delete internal_resolve_intent_;
+  internal_resolve_intent_ = internal_resolve_intent;
+  if (internal_resolve_intent) {
+    set_has_internal_resolve_intent();
   } else {
-    clear_has_header();
+    clear_has_internal_resolve_intent();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalTruncateLogRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_resolve_intent)
 }
 
-// optional uint64 index = 2;
-inline bool InternalTruncateLogRequest::has_index() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .proto.InternalMergeResponse internal_merge = 13;
+inline bool ReadWriteCmdResponse::has_internal_merge() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
 }
-inline void InternalTruncateLogRequest::set_has_index() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ReadWriteCmdResponse::set_has_internal_merge() {
+  _has_bits_[0] |= 0x00001000u;
 }
-inline void InternalTruncateLogRequest::clear_has_index() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ReadWriteCmdResponse::clear_has_internal_merge() {
+  _has_bits_[0] &= ~0x00001000u;
 }
-inline void InternalTruncateLogRequest::clear_index() {
-  index_ = GOOGLE_ULONGLONG(0);
-  clear_has_index();
+inline void ReadWriteCmdResponse::clear_internal_merge() {
+  if (internal_merge_ != NULL) internal_merge_->::proto::InternalMergeResponse::Clear();
+  clear_has_internal_merge();
 }
-inline ::google::protobuf::uint64 InternalTruncateLogRequest::index() const {
-  // @@protoc_insertion_point(field_get:proto.InternalTruncateLogRequest.index)
-  return index_;
+inline const ::proto::InternalMergeResponse& ReadWriteCmdResponse::internal_merge() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_merge)
+  return internal_merge_ != NULL ? *internal_merge_ : *default_instance_->internal_merge_;
 }
-inline void InternalTruncateLogRequest::set_index(::google::protobuf::uint64 value) {
-  set_has_index();
-  index_ = value;
-  // @@protoc_insertion_point(field_set:proto.InternalTruncateLogRequest.index)
+inline ::proto::InternalMergeResponse* ReadWriteCmdResponse::mutable_internal_merge() {
+  set_has_internal_merge();
+  if (internal_merge_ == NULL) internal_merge_ = new ::proto::InternalMergeResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_merge)
+  return internal_merge_;
+}
+inline ::proto::InternalMergeResponse* ReadWriteCmdResponse::release_internal_merge() {
+  clear_has_internal_merge();
+  ::proto::InternalMergeResponse* temp = internal_merge_;
+  internal_merge_ = NULL;
+  return temp;
+}
+inline void ReadWriteCmdResponse::set_allocated_internal_merge(::proto::InternalMergeResponse* internal_merge) {
+  delete internal_merge_;
+  internal_merge_ = internal_merge;
+  if (internal_merge) {
+    set_has_internal_merge();
+  } else {
+    clear_has_internal_merge();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_merge)
 }
 
-// -------------------------------------------------------------------
-
-// InternalTruncateLogResponse
-
-// optional .proto.ResponseHeader header = 1;
-inline bool InternalTruncateLogResponse::has_header() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
+inline bool ReadWriteCmdResponse::has_internal_truncate_log() const {
+  return (_has_bits_[0] & 0x00002000u) != 0;
 }
-inline void InternalTruncateLogResponse::set_has_header() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_internal_truncate_log() {
+  _has_bits_[0] |= 0x00002000u;
 }
-inline void InternalTruncateLogResponse::clear_has_header() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_internal_truncate_log() {
+  _has_bits_[0] &= ~0x00002000u;
 }
-inline void InternalTruncateLogResponse::clear_header() {
-  if (header_ != NULL) header_->::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void ReadWriteCmdResponse::clear_internal_truncate_log() {
+  if (internal_truncate_log_ != NULL) internal_truncate_log_->::proto::InternalTruncateLogResponse::Clear();
+  clear_has_internal_truncate_log();
 }
-inline const ::proto::ResponseHeader& InternalTruncateLogResponse::header() const {
-  // @@protoc_insertion_point(field_get:proto.InternalTruncateLogResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::proto::InternalTruncateLogResponse& ReadWriteCmdResponse::internal_truncate_log() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_truncate_log)
+  return internal_truncate_log_ != NULL ? *internal_truncate_log_ : *default_instance_->internal_truncate_log_;
 }
-inline ::proto::ResponseHeader* InternalTruncateLogResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) header_ = new ::proto::ResponseHeader;
-  // @@protoc_insertion_point(field_mutable:proto.InternalTruncateLogResponse.header)
-  return header_;
+inline ::proto::InternalTruncateLogResponse* ReadWriteCmdResponse::mutable_internal_truncate_log() {
+  set_has_internal_truncate_log();
+  if (internal_truncate_log_ == NULL) internal_truncate_log_ = new ::proto::InternalTruncateLogResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_truncate_log)
+  return internal_truncate_log_;
 }
-inline ::proto::ResponseHeader* InternalTruncateLogResponse::release_header() {
-  clear_has_header();
-  ::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
+inline ::proto::InternalTruncateLogResponse* ReadWriteCmdResponse::release_internal_truncate_log() {
+  clear_has_internal_truncate_log();
+  ::proto::InternalTruncateLogResponse* temp = internal_truncate_log_;
+  internal_truncate_log_ = NULL;
   return temp;
 }
-inline void InternalTruncateLogResponse::set_allocated_header(::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void ReadWriteCmdResponse::set_allocated_internal_truncate_log(::proto::InternalTruncateLogResponse* internal_truncate_log) {
+  delete internal_truncate_log_;
+  internal_truncate_log_ = internal_truncate_log;
+  if (internal_truncate_log) {
+    set_has_internal_truncate_log();
   } else {
-    clear_has_header();
+    clear_has_internal_truncate_log();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.InternalTruncateLogResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_truncate_log)
 }
 
-// -------------------------------------------------------------------
-
-// ReadWriteCmdResponse
-
-// optional .proto.PutResponse put = 1;
-inline bool ReadWriteCmdResponse::has_put() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
+// optional .proto.InternalGCResponse internal_gc = 15;
+inline bool ReadWriteCmdResponse::has_internal_gc() const {
+  return (_has_bits_[0] & 0x00004000u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_put() {
-  _has_bits_[0] |= 0x00000001u;
+inline void ReadWriteCmdResponse::set_has_internal_gc() {
+  _has_bits_[0] |= 0x00004000u;
 }
-inline void ReadWriteCmdResponse::clear_has_put() {
-  _has_bits_[0] &= ~0x00000001u;
+inline void ReadWriteCmdResponse::clear_has_internal_gc() {
+  _has_bits_[0] &= ~0x00004000u;
 }
-inline void ReadWriteCmdResponse::clear_put() {
-  if (put_ != NULL) put_->::proto::PutResponse::Clear();
-  clear_has_put();
+inline void ReadWriteCmdResponse::clear_internal_gc() {
+  if (internal_gc_ != NULL) internal_gc_->::proto::InternalGCResponse::Clear();
+  clear_has_internal_gc();
 }
-inline const ::proto::PutResponse& ReadWriteCmdResponse::put() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.put)
-  return put_ != NULL ? *put_ : *default_instance_->put_;
+inline const ::proto::InternalGCResponse& ReadWriteCmdResponse::internal_gc() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_gc)
+  return internal_gc_ != NULL ? *internal_gc_ : *default_instance_->internal_gc_;
 }
-inline ::proto::PutResponse* ReadWriteCmdResponse::mutable_put() {
-  set_has_put();
-  if (put_ == NULL) put_ = new ::proto::PutResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.put)
-  return put_;
+inline ::proto::InternalGCResponse* ReadWriteCmdResponse::mutable_internal_gc() {
+  set_has_internal_gc();
+  if (internal_gc_ == NULL) internal_gc_ = new ::proto::InternalGCResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_gc)
+  return internal_gc_;
 }
-inline ::proto::PutResponse* ReadWriteCmdResponse::release_put() {
-  clear_has_put();
-  ::proto::PutResponse* temp = put_;
-  put_ = NULL;
+inline ::proto::InternalGCResponse* ReadWriteCmdResponse::release_internal_gc() {
+  clear_has_internal_gc();
+  ::proto::InternalGCResponse* temp = internal_gc_;
+  internal_gc_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_put(::proto::PutResponse* put) {
-  delete put_;
-  put_ = put;
-  if (put) {
-    set_has_put();
+inline void ReadWriteCmdResponse::set_allocated_internal_gc(::proto::InternalGCResponse* internal_gc) {
+  delete internal_gc_;
+  internal_gc_ = internal_gc;
+  if (internal_gc) {
+    set_has_internal_gc();
   } else {
-    clear_has_put();
+    clear_has_internal_gc();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.put)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_gc)
 }
 
-// optional .proto.ConditionalPutResponse conditional_put = 2;
-inline bool ReadWriteCmdResponse::has_conditional_put() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// optional .proto.InternalBeginTransactionResponse internal_begin_transaction = 16;
+inline bool ReadWriteCmdResponse::has_internal_begin_transaction() const {
+  return (_has_bits_[0] & 0x00008000u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_conditional_put() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ReadWriteCmdResponse::set_has_internal_begin_transaction() {
+  _has_bits_[0] |= 0x00008000u;
 }
-inline void ReadWriteCmdResponse::clear_has_conditional_put() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ReadWriteCmdResponse::clear_has_internal_begin_transaction() {
+  _has_bits_[0] &= ~0x00008000u;
 }
-inline void ReadWriteCmdResponse::clear_conditional_put() {
-  if (conditional_put_ != NULL) conditional_put_->::proto::ConditionalPutResponse::Clear();
-  clear_has_conditional_put();
+inline void ReadWriteCmdResponse::clear_internal_begin_transaction() {
+  if (internal_begin_transaction_ != NULL) internal_begin_transaction_->::proto::InternalBeginTransactionResponse::Clear();
+  clear_has_internal_begin_transaction();
 }
-inline const ::proto::ConditionalPutResponse& ReadWriteCmdResponse::conditional_put() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.conditional_put)
-  return conditional_put_ != NULL ? *conditional_put_ : *default_instance_->conditional_put_;
+inline const ::proto::InternalBeginTransactionResponse& ReadWriteCmdResponse::internal_begin_transaction() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_begin_transaction)
+  return internal_begin_transaction_ != NULL ? *internal_begin_transaction_ : *default_instance_->internal_begin_transaction_;
 }
-inline ::proto::ConditionalPutResponse* ReadWriteCmdResponse::mutable_conditional_put() {
-  set_has_conditional_put();
-  if (conditional_put_ == NULL) conditional_put_ = new ::proto::ConditionalPutResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.conditional_put)
-  return conditional_put_;
+inline ::proto::InternalBeginTransactionResponse* ReadWriteCmdResponse::mutable_internal_begin_transaction() {
+  set_has_internal_begin_transaction();
+  if (internal_begin_transaction_ == NULL) internal_begin_transaction_ = new ::proto::InternalBeginTransactionResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_begin_transaction)
+  return internal_begin_transaction_;
 }
-inline ::proto::ConditionalPutResponse* ReadWriteCmdResponse::release_conditional_put() {
-  clear_has_conditional_put();
-  ::proto::ConditionalPutResponse* temp = conditional_put_;
-  conditional_put_ = NULL;
+inline ::proto::InternalBeginTransactionResponse* ReadWriteCmdResponse::release_internal_begin_transaction() {
+  clear_has_internal_begin_transaction();
+  ::proto::InternalBeginTransactionResponse* temp = internal_begin_transaction_;
+  internal_begin_transaction_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_conditional_put(::proto::ConditionalPutResponse* conditional_put) {
-  delete conditional_put_;
-  conditional_put_ = conditional_put;
-  if (conditional_put) {
-    set_has_conditional_put();
+inline void ReadWriteCmdResponse::set_allocated_internal_begin_transaction(::proto::InternalBeginTransactionResponse* internal_begin_transaction) {
+  delete internal_begin_transaction_;
+  internal_begin_transaction_ = internal_begin_transaction;
+  if (internal_begin_transaction) {
+    set_has_internal_begin_transaction();
   } else {
-    clear_has_conditional_put();
+    clear_has_internal_begin_transaction();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.conditional_put)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_begin_transaction)
 }
 
-// optional .proto.IncrementResponse increment = 3;
-inline bool ReadWriteCmdResponse::has_increment() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// optional .proto.InternalPutIfAbsentResponse internal_put_if_absent = 17;
+inline bool ReadWriteCmdResponse::has_internal_put_if_absent() const {
+  return (_has_bits_[0] & 0x00010000u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_increment() {
-  _has_bits_[0] |= 0x00000004u;
+inline void ReadWriteCmdResponse::set_has_internal_put_if_absent() {
+  _has_bits_[0] |= 0x00010000u;
 }
-inline void ReadWriteCmdResponse::clear_has_increment() {
-  _has_bits_[0] &= ~0x00000004u;
+inline void ReadWriteCmdResponse::clear_has_internal_put_if_absent() {
+  _has_bits_[0] &= ~0x00010000u;
 }
-inline void ReadWriteCmdResponse::clear_increment() {
-  if (increment_ != NULL) increment_->::proto::IncrementResponse::Clear();
-  clear_has_increment();
+inline void ReadWriteCmdResponse::clear_internal_put_if_absent() {
+  if (internal_put_if_absent_ != NULL) internal_put_if_absent_->::proto::InternalPutIfAbsentResponse::Clear();
+  clear_has_internal_put_if_absent();
 }
-inline const ::proto::IncrementResponse& ReadWriteCmdResponse::increment() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.increment)
-  return increment_ != NULL ? *increment_ : *default_instance_->increment_;
+inline const ::proto::InternalPutIfAbsentResponse& ReadWriteCmdResponse::internal_put_if_absent() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_put_if_absent)
+  return internal_put_if_absent_ != NULL ? *internal_put_if_absent_ : *default_instance_->internal_put_if_absent_;
 }
-inline ::proto::IncrementResponse* ReadWriteCmdResponse::mutable_increment() {
-  set_has_increment();
-  if (increment_ == NULL) increment_ = new ::proto::IncrementResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.increment)
-  return increment_;
+inline ::proto::InternalPutIfAbsentResponse* ReadWriteCmdResponse::mutable_internal_put_if_absent() {
+  set_has_internal_put_if_absent();
+  if (internal_put_if_absent_ == NULL) internal_put_if_absent_ = new ::proto::InternalPutIfAbsentResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_put_if_absent)
+  return internal_put_if_absent_;
 }
-inline ::proto::IncrementResponse* ReadWriteCmdResponse::release_increment() {
-  clear_has_increment();
-  ::proto::IncrementResponse* temp = increment_;
-  increment_ = NULL;
+inline ::proto::InternalPutIfAbsentResponse* ReadWriteCmdResponse::release_internal_put_if_absent() {
+  clear_has_internal_put_if_absent();
+  ::proto::InternalPutIfAbsentResponse* temp = internal_put_if_absent_;
+  internal_put_if_absent_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_increment(::proto::IncrementResponse* increment) {
-  delete increment_;
-  increment_ = increment;
-  if (increment) {
-    set_has_increment();
+inline void ReadWriteCmdResponse::set_allocated_internal_put_if_absent(::proto::InternalPutIfAbsentResponse* internal_put_if_absent) {
+  delete internal_put_if_absent_;
+  internal_put_if_absent_ = internal_put_if_absent;
+  if (internal_put_if_absent) {
+    set_has_internal_put_if_absent();
   } else {
-    clear_has_increment();
+    clear_has_internal_put_if_absent();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.increment)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_put_if_absent)
 }
 
-// optional .proto.DeleteResponse delete = 4;
-inline bool ReadWriteCmdResponse::has_delete_() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
+// optional .proto.GetResponse get = 18;
+inline bool ReadWriteCmdResponse::has_get() const {
+  return (_has_bits_[0] & 0x00020000u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_delete_() {
-  _has_bits_[0] |= 0x00000008u;
+inline void ReadWriteCmdResponse::set_has_get() {
+  _has_bits_[0] |= 0x00020000u;
 }
-inline void ReadWriteCmdResponse::clear_has_delete_() {
-  _has_bits_[0] &= ~0x00000008u;
+inline void ReadWriteCmdResponse::clear_has_get() {
+  _has_bits_[0] &= ~0x00020000u;
 }
-inline void ReadWriteCmdResponse::clear_delete_() {
-  if (delete__ != NULL) delete__->::proto::DeleteResponse::Clear();
-  clear_has_delete_();
+inline void ReadWriteCmdResponse::clear_get() {
+  if (get_ != NULL) get_->::proto::GetResponse::Clear();
+  clear_has_get();
 }
-inline const ::proto::DeleteResponse& ReadWriteCmdResponse::delete_() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.delete)
-  return delete__ != NULL ? *delete__ : *default_instance_->delete__;
+inline const ::proto::GetResponse& ReadWriteCmdResponse::get() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.get)
+  return get_ != NULL ? *get_ : *default_instance_->get_;
 }
-inline ::proto::DeleteResponse* ReadWriteCmdResponse::mutable_delete_() {
-  set_has_delete_();
-  if (delete__ == NULL) delete__ = new ::proto::DeleteResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.delete)
-  return delete__;
+inline ::proto::GetResponse* ReadWriteCmdResponse::mutable_get() {
+  set_has_get();
+  if (get_ == NULL) get_ = new ::proto::GetResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.get)
+  return get_;
 }
-inline ::proto::DeleteResponse* ReadWriteCmdResponse::release_delete_() {
-  clear_has_delete_();
-  ::proto::DeleteResponse* temp = delete__;
-  delete__ = NULL;
+inline ::proto::GetResponse* ReadWriteCmdResponse::release_get() {
+  clear_has_get();
+  ::proto::GetResponse* temp = get_;
+  get_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_delete_(::proto::DeleteResponse* delete_) {
-  delete delete__;
-  delete__ = delete_;
-  if (delete_) {
-    set_has_delete_();
+inline void ReadWriteCmdResponse::set_allocated_get(::proto::GetResponse* get) {
+  delete get_;
+  get_ = get;
+  if (get) {
+    set_has_get();
   } else {
-    clear_has_delete_();
+    clear_has_get();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.delete)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.get)
 }
 
-// optional .proto.DeleteRangeResponse delete_range = 5;
-inline bool ReadWriteCmdResponse::has_delete_range() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
+// optional .proto.ConditionalDeleteResponse conditional_delete = 19;
+inline bool ReadWriteCmdResponse::has_conditional_delete() const {
+  return (_has_bits_[0] & 0x00040000u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_delete_range() {
-  _has_bits_[0] |= 0x00000010u;
+inline void ReadWriteCmdResponse::set_has_conditional_delete() {
+  _has_bits_[0] |= 0x00040000u;
 }
-inline void ReadWriteCmdResponse::clear_has_delete_range() {
-  _has_bits_[0] &= ~0x00000010u;
+inline void ReadWriteCmdResponse::clear_has_conditional_delete() {
+  _has_bits_[0] &= ~0x00040000u;
 }
-inline void ReadWriteCmdResponse::clear_delete_range() {
-  if (delete_range_ != NULL) delete_range_->::proto::DeleteRangeResponse::Clear();
-  clear_has_delete_range();
+inline void ReadWriteCmdResponse::clear_conditional_delete() {
+  if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteResponse::Clear();
+  clear_has_conditional_delete();
 }
-inline const ::proto::DeleteRangeResponse& ReadWriteCmdResponse::delete_range() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.delete_range)
-  return delete_range_ != NULL ? *delete_range_ : *default_instance_->delete_range_;
+inline const ::proto::ConditionalDeleteResponse& ReadWriteCmdResponse::conditional_delete() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.conditional_delete)
+  return conditional_delete_ != NULL ? *conditional_delete_ : *default_instance_->conditional_delete_;
 }
-inline ::proto::DeleteRangeResponse* ReadWriteCmdResponse::mutable_delete_range() {
-  set_has_delete_range();
-  if (delete_range_ == NULL) delete_range_ = new ::proto::DeleteRangeResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.delete_range)
-  return delete_range_;
+inline ::proto::ConditionalDeleteResponse* ReadWriteCmdResponse::mutable_conditional_delete() {
+  set_has_conditional_delete();
+  if (conditional_delete_ == NULL) conditional_delete_ = new ::proto::ConditionalDeleteResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.conditional_delete)
+  return conditional_delete_;
 }
-inline ::proto::DeleteRangeResponse* ReadWriteCmdResponse::release_delete_range() {
-  clear_has_delete_range();
-  ::proto::DeleteRangeResponse* temp = delete_range_;
-  delete_range_ = NULL;
+inline ::proto::ConditionalDeleteResponse* ReadWriteCmdResponse::release_conditional_delete() {
+  clear_has_conditional_delete();
+  ::proto::ConditionalDeleteResponse* temp = conditional_delete_;
+  conditional_delete_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_delete_range(::proto::DeleteRangeResponse* delete_range) {
-  delete delete_range_;
-  delete_range_ = delete_range;
-  if (delete_range) {
-    set_has_delete_range();
+inline void ReadWriteCmdResponse::set_allocated_conditional_delete(::proto::ConditionalDeleteResponse* conditional_delete) {
+  delete conditional_delete_;
+  conditional_delete_ = conditional_delete;
+  if (conditional_delete) {
+    set_has_conditional_delete();
   } else {
-    clear_has_delete_range();
+    clear_has_conditional_delete();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.delete_range)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.conditional_delete)
 }
 
-// optional .proto.EndTransactionResponse end_transaction = 6;
-inline bool ReadWriteCmdResponse::has_end_transaction() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
+// optional .proto.BatchResponse batch = 20;
+inline bool ReadWriteCmdResponse::has_batch() const {
+  return (_has_bits_[0] & 0x00080000u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_end_transaction() {
-  _has_bits_[0] |= 0x00000020u;
+inline void ReadWriteCmdResponse::set_has_batch() {
+  _has_bits_[0] |= 0x00080000u;
 }
-inline void ReadWriteCmdResponse::clear_has_end_transaction() {
-  _has_bits_[0] &= ~0x00000020u;
+inline void ReadWriteCmdResponse::clear_has_batch() {
+  _has_bits_[0] &= ~0x00080000u;
 }
-inline void ReadWriteCmdResponse::clear_end_transaction() {
-  if (end_transaction_ != NULL) end_transaction_->::proto::EndTransactionResponse::Clear();
-  clear_has_end_transaction();
+inline void ReadWriteCmdResponse::clear_batch() {
+  if (batch_ != NULL) batch_->::proto::BatchResponse::Clear();
+  clear_has_batch();
 }
-inline const ::proto::EndTransactionResponse& ReadWriteCmdResponse::end_transaction() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.end_transaction)
-  return end_transaction_ != NULL ? *end_transaction_ : *default_instance_->end_transaction_;
+inline const ::proto::BatchResponse& ReadWriteCmdResponse::batch() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.batch)
+  return batch_ != NULL ? *batch_ : *default_instance_->batch_;
 }
-inline ::proto::EndTransactionResponse* ReadWriteCmdResponse::mutable_end_transaction() {
-  set_has_end_transaction();
-  if (end_transaction_ == NULL) end_transaction_ = new ::proto::EndTransactionResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.end_transaction)
-  return end_transaction_;
+inline ::proto::BatchResponse* ReadWriteCmdResponse::mutable_batch() {
+  set_has_batch();
+  if (batch_ == NULL) batch_ = new ::proto::BatchResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.batch)
+  return batch_;
 }
-inline ::proto::EndTransactionResponse* ReadWriteCmdResponse::release_end_transaction() {
-  clear_has_end_transaction();
-  ::proto::EndTransactionResponse* temp = end_transaction_;
-  end_transaction_ = NULL;
+inline ::proto::BatchResponse* ReadWriteCmdResponse::release_batch() {
+  clear_has_batch();
+  ::proto::BatchResponse* temp = batch_;
+  batch_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_end_transaction(::proto::EndTransactionResponse* end_transaction) {
-  delete end_transaction_;
-  end_transaction_ = end_transaction;
-  if (end_transaction) {
-    set_has_end_transaction();
+inline void ReadWriteCmdResponse::set_allocated_batch(::proto::BatchResponse* batch) {
+  delete batch_;
+  batch_ = batch;
+  if (batch) {
+    set_has_batch();
   } else {
-    clear_has_end_transaction();
+    clear_has_batch();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.end_transaction)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.batch)
 }
 
-// optional .proto.ReapQueueResponse reap_queue = 7;
-inline bool ReadWriteCmdResponse::has_reap_queue() const {
-  return (_has_bits_[0] & 0x00000040u) != 0;
+// optional .proto.InternalResolveIntentRangeResponse internal_resolve_intent_range = 21;
+inline bool ReadWriteCmdResponse::has_internal_resolve_intent_range() const {
+  return (_has_bits_[0] & 0x00100000u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_reap_queue() {
-  _has_bits_[0] |= 0x00000040u;
+inline void ReadWriteCmdResponse::set_has_internal_resolve_intent_range() {
+  _has_bits_[0] |= 0x00100000u;
 }
-inline void ReadWriteCmdResponse::clear_has_reap_queue() {
-  _has_bits_[0] &= ~0x00000040u;
+inline void ReadWriteCmdResponse::clear_has_internal_resolve_intent_range() {
+  _has_bits_[0] &= ~0x00100000u;
 }
-inline void ReadWriteCmdResponse::clear_reap_queue() {
-  if (reap_queue_ != NULL) reap_queue_->::proto::ReapQueueResponse::Clear();
-  clear_has_reap_queue();
+inline void ReadWriteCmdResponse::clear_internal_resolve_intent_range() {
+  if (internal_resolve_intent_range_ != NULL) internal_resolve_intent_range_->::proto::InternalResolveIntentRangeResponse::Clear();
+  clear_has_internal_resolve_intent_range();
 }
-inline const ::proto::ReapQueueResponse& ReadWriteCmdResponse::reap_queue() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.reap_queue)
-  return reap_queue_ != NULL ? *reap_queue_ : *default_instance_->reap_queue_;
+inline const ::proto::InternalResolveIntentRangeResponse& ReadWriteCmdResponse::internal_resolve_intent_range() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_resolve_intent_range)
+  return internal_resolve_intent_range_ != NULL ? *internal_resolve_intent_range_ : *default_instance_->internal_resolve_intent_range_;
 }
-inline ::proto::ReapQueueResponse* ReadWriteCmdResponse::mutable_reap_queue() {
-  set_has_reap_queue();
-  if (reap_queue_ == NULL) reap_queue_ = new ::proto::ReapQueueResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.reap_queue)
-  return reap_queue_;
+inline ::proto::InternalResolveIntentRangeResponse* ReadWriteCmdResponse::mutable_internal_resolve_intent_range() {
+  set_has_internal_resolve_intent_range();
+  if (internal_resolve_intent_range_ == NULL) internal_resolve_intent_range_ = new ::proto::InternalResolveIntentRangeResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_resolve_intent_range)
+  return internal_resolve_intent_range_;
 }
-inline ::proto::ReapQueueResponse* ReadWriteCmdResponse::release_reap_queue() {
-  clear_has_reap_queue();
-  ::proto::ReapQueueResponse* temp = reap_queue_;
-  reap_queue_ = NULL;
+inline ::proto::InternalResolveIntentRangeResponse* ReadWriteCmdResponse::release_internal_resolve_intent_range() {
+  clear_has_internal_resolve_intent_range();
+  ::proto::InternalResolveIntentRangeResponse* temp = internal_resolve_intent_range_;
+  internal_resolve_intent_range_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_reap_queue(::proto::ReapQueueResponse* reap_queue) {
-  delete reap_queue_;
-  reap_queue_ = reap_queue;
-  if (reap_queue) {
-    set_has_reap_queue();
+inline void ReadWriteCmdResponse::set_allocated_internal_resolve_intent_range(::proto::InternalResolveIntentRangeResponse* internal_resolve_intent_range) {
+  delete internal_resolve_intent_range_;
+  internal_resolve_intent_range_ = internal_resolve_intent_range;
+  if (internal_resolve_intent_range) {
+    set_has_internal_resolve_intent_range();
   } else {
-    clear_has_reap_queue();
+    clear_has_internal_resolve_intent_range();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.reap_queue)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_resolve_intent_range)
 }
 
-// optional .proto.EnqueueUpdateResponse enqueue_update = 8;
-inline bool ReadWriteCmdResponse::has_enqueue_update() const {
-  return (_has_bits_[0] & 0x00000080u) != 0;
-}
-inline void ReadWriteCmdResponse::set_has_enqueue_update() {
-  _has_bits_[0] |= 0x00000080u;
+// optional .proto.InternalLeaderLeaseResponse internal_leader_lease = 22;
+inline bool ReadWriteCmdResponse::has_internal_leader_lease() const {
+  return (_has_bits_[0] & 0x00200000u) != 0;
 }
-inline void ReadWriteCmdResponse::clear_has_enqueue_update() {
-  _has_bits_[0] &= ~0x00000080u;
+inline void ReadWriteCmdResponse::set_has_internal_leader_lease() {
+  _has_bits_[0] |= 0x00200000u;
 }
-inline void ReadWriteCmdResponse::clear_enqueue_update() {
-  if (enqueue_update_ != NULL) enqueue_update_->::proto::EnqueueUpdateResponse::Clear();
-  clear_has_enqueue_update();
+inline void ReadWriteCmdResponse::clear_has_internal_leader_lease() {
+  _has_bits_[0] &= ~0x00200000u;
 }
-inline const ::proto::EnqueueUpdateResponse& ReadWriteCmdResponse::enqueue_update() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.enqueue_update)
-  return enqueue_update_ != NULL ? *enqueue_update_ : *default_instance_->enqueue_update_;
+inline void ReadWriteCmdResponse::clear_internal_leader_lease() {
+  if (internal_leader_lease_ != NULL) internal_leader_lease_->::proto::InternalLeaderLeaseResponse::Clear();
+  clear_has_internal_leader_lease();
 }
-inline ::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::mutable_enqueue_update() {
-  set_has_enqueue_update();
-  if (enqueue_update_ == NULL) enqueue_update_ = new ::proto::EnqueueUpdateResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.enqueue_update)
-  return enqueue_update_;
+inline const ::proto::InternalLeaderLeaseResponse& ReadWriteCmdResponse::internal_leader_lease() const {
+  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_leader_lease)
+  return internal_leader_lease_ != NULL ? *internal_leader_lease_ : *default_instance_->internal_leader_lease_;
 }
-inline ::proto::EnqueueUpdateResponse* ReadWriteCmdResponse::release_enqueue_update() {
-  clear_has_enqueue_update();
-  ::proto::EnqueueUpdateResponse* temp = enqueue_update_;
-  enqueue_update_ = NULL;
+inline ::proto::InternalLeaderLeaseResponse* ReadWriteCmdResponse::mutable_internal_leader_lease() {
+  set_has_internal_leader_lease();
+  if (internal_leader_lease_ == NULL) internal_leader_lease_ = new ::proto::InternalLeaderLeaseResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_leader_lease)
+  return internal_leader_lease_;
+}
+inline ::proto::InternalLeaderLeaseResponse* ReadWriteCmdResponse::release_internal_leader_lease() {
+  clear_has_internal_leader_lease();
+  ::proto::InternalLeaderLeaseResponse* temp = internal_leader_lease_;
+  internal_leader_lease_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_enqueue_update(::proto::EnqueueUpdateResponse* enqueue_update) {
-  delete enqueue_update_;
-  enqueue_update_ = enqueue_update;
-  if (enqueue_update) {
-    set_has_enqueue_update();
+inline void ReadWriteCmdResponse::set_allocated_internal_leader_lease(::proto::InternalLeaderLeaseResponse* internal_leader_lease) {
+  delete internal_leader_lease_;
+  internal_leader_lease_ = internal_leader_lease;
+  if (internal_leader_lease) {
+    set_has_internal_leader_lease();
   } else {
-    clear_has_enqueue_update();
+    clear_has_internal_leader_lease();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.enqueue_update)
+  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_leader_lease)
 }
 
-// optional .proto.EnqueueMessageResponse enqueue_message = 9;
-inline bool ReadWriteCmdResponse::has_enqueue_message() const {
-  return (_has_bits_[0] & 0x00000100u) != 0;
+// -------------------------------------------------------------------
+
+// ResponseCacheEntry
+
+// optional .proto.ClientCmdID cmd_id = 1;
+inline bool ResponseCacheEntry::has_cmd_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_enqueue_message() {
-  _has_bits_[0] |= 0x00000100u;
+inline void ResponseCacheEntry::set_has_cmd_id() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ReadWriteCmdResponse::clear_has_enqueue_message() {
-  _has_bits_[0] &= ~0x00000100u;
+inline void ResponseCacheEntry::clear_has_cmd_id() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void ReadWriteCmdResponse::clear_enqueue_message() {
-  if (enqueue_message_ != NULL) enqueue_message_->::proto::EnqueueMessageResponse::Clear();
-  clear_has_enqueue_message();
+inline void ResponseCacheEntry::clear_cmd_id() {
+  if (cmd_id_ != NULL) cmd_id_->::proto::ClientCmdID::Clear();
+  clear_has_cmd_id();
 }
-inline const ::proto::EnqueueMessageResponse& ReadWriteCmdResponse::enqueue_message() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.enqueue_message)
-  return enqueue_message_ != NULL ? *enqueue_message_ : *default_instance_->enqueue_message_;
+inline const ::proto::ClientCmdID& ResponseCacheEntry::cmd_id() const {
+  // @@protoc_insertion_point(field_get:proto.ResponseCacheEntry.cmd_id)
+  return cmd_id_ != NULL ? *cmd_id_ : *default_instance_->cmd_id_;
 }
-inline ::proto::EnqueueMessageResponse* ReadWriteCmdResponse::mutable_enqueue_message() {
-  set_has_enqueue_message();
-  if (enqueue_message_ == NULL) enqueue_message_ = new ::proto::EnqueueMessageResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.enqueue_message)
-  return enqueue_message_;
+inline ::proto::ClientCmdID* ResponseCacheEntry::mutable_cmd_id() {
+  set_has_cmd_id();
+  if (cmd_id_ == NULL) cmd_id_ = new ::proto::ClientCmdID;
+  // @@protoc_insertion_point(field_mutable:proto.ResponseCacheEntry.cmd_id)
+  return cmd_id_;
 }
-inline ::proto::EnqueueMessageResponse* ReadWriteCmdResponse::release_enqueue_message() {
-  clear_has_enqueue_message();
-  ::proto::EnqueueMessageResponse* temp = enqueue_message_;
-  enqueue_message_ = NULL;
+inline ::proto::ClientCmdID* ResponseCacheEntry::release_cmd_id() {
+  clear_has_cmd_id();
+  ::proto::ClientCmdID* temp = cmd_id_;
+  cmd_id_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_enqueue_message(::proto::EnqueueMessageResponse* enqueue_message) {
-  delete enqueue_message_;
-  enqueue_message_ = enqueue_message;
-  if (enqueue_message) {
-    set_has_enqueue_message();
+inline void ResponseCacheEntry::set_allocated_cmd_id(::proto::ClientCmdID* cmd_id) {
+  delete cmd_id_;
+  cmd_id_ = cmd_id;
+  if (cmd_id) {
+    set_has_cmd_id();
   } else {
-    clear_has_enqueue_message();
+    clear_has_cmd_id();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.enqueue_message)
+  // @@protoc_insertion_point(field_set_allocated:proto.ResponseCacheEntry.cmd_id)
 }
 
-// optional .proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
-inline bool ReadWriteCmdResponse::has_internal_heartbeat_txn() const {
-  return (_has_bits_[0] & 0x00000200u) != 0;
+// optional .proto.ReadWriteCmdResponse response = 2;
+inline bool ResponseCacheEntry::has_response() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_internal_heartbeat_txn() {
-  _has_bits_[0] |= 0x00000200u;
+inline void ResponseCacheEntry::set_has_response() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void ReadWriteCmdResponse::clear_has_internal_heartbeat_txn() {
-  _has_bits_[0] &= ~0x00000200u;
+inline void ResponseCacheEntry::clear_has_response() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void ReadWriteCmdResponse::clear_internal_heartbeat_txn() {
-  if (internal_heartbeat_txn_ != NULL) internal_heartbeat_txn_->::proto::InternalHeartbeatTxnResponse::Clear();
-  clear_has_internal_heartbeat_txn();
+inline void ResponseCacheEntry::clear_response() {
+  if (response_ != NULL) response_->::proto::ReadWriteCmdResponse::Clear();
+  clear_has_response();
 }
-inline const ::proto::InternalHeartbeatTxnResponse& ReadWriteCmdResponse::internal_heartbeat_txn() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_heartbeat_txn)
-  return internal_heartbeat_txn_ != NULL ? *internal_heartbeat_txn_ : *default_instance_->internal_heartbeat_txn_;
+inline const ::proto::ReadWriteCmdResponse& ResponseCacheEntry::response() const {
+  // @@protoc_insertion_point(field_get:proto.ResponseCacheEntry.response)
+  return response_ != NULL ? *response_ : *default_instance_->response_;
 }
-inline ::proto::InternalHeartbeatTxnResponse* ReadWriteCmdResponse::mutable_internal_heartbeat_txn() {
-  set_has_internal_heartbeat_txn();
-  if (internal_heartbeat_txn_ == NULL) internal_heartbeat_txn_ = new ::proto::InternalHeartbeatTxnResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_heartbeat_txn)
-  return internal_heartbeat_txn_;
+inline ::proto::ReadWriteCmdResponse* ResponseCacheEntry::mutable_response() {
+  set_has_response();
+  if (response_ == NULL) response_ = new ::proto::ReadWriteCmdResponse;
+  // @@protoc_insertion_point(field_mutable:proto.ResponseCacheEntry.response)
+  return response_;
 }
-inline ::proto::InternalHeartbeatTxnResponse* ReadWriteCmdResponse::release_internal_heartbeat_txn() {
-  clear_has_internal_heartbeat_txn();
-  ::proto::InternalHeartbeatTxnResponse* temp = internal_heartbeat_txn_;
-  internal_heartbeat_txn_ = NULL;
+inline ::proto::ReadWriteCmdResponse* ResponseCacheEntry::release_response() {
+  clear_has_response();
+  ::proto::ReadWriteCmdResponse* temp = response_;
+  response_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_heartbeat_txn(::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn) {
-  delete internal_heartbeat_txn_;
-  internal_heartbeat_txn_ = internal_heartbeat_txn;
-  if (internal_heartbeat_txn) {
-    set_has_internal_heartbeat_txn();
+inline void ResponseCacheEntry::set_allocated_response(::proto::ReadWriteCmdResponse* response) {
+  delete response_;
+  response_ = response;
+  if (response) {
+    set_has_response();
   } else {
-    clear_has_internal_heartbeat_txn();
+    clear_has_response();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_heartbeat_txn)
+  // @@protoc_insertion_point(field_set_allocated:proto.ResponseCacheEntry.response)
 }
 
-// optional .proto.InternalPushTxnResponse internal_push_txn = 11;
-inline bool ReadWriteCmdResponse::has_internal_push_txn() const {
-  return (_has_bits_[0] & 0x00000400u) != 0;
+// -------------------------------------------------------------------
+
+// Lease
+
+// optional .proto.Timestamp start = 1;
+inline bool Lease::has_start() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_internal_push_txn() {
-  _has_bits_[0] |= 0x00000400u;
+inline void Lease::set_has_start() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void ReadWriteCmdResponse::clear_has_internal_push_txn() {
-  _has_bits_[0] &= ~0x00000400u;
+inline void Lease::clear_has_start() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void ReadWriteCmdResponse::clear_internal_push_txn() {
-  if (internal_push_txn_ != NULL) internal_push_txn_->::proto::InternalPushTxnResponse::Clear();
-  clear_has_internal_push_txn();
+inline void Lease::clear_start() {
+  if (start_ != NULL) start_->::proto::Timestamp::Clear();
+  clear_has_start();
 }
-inline const ::proto::InternalPushTxnResponse& ReadWriteCmdResponse::internal_push_txn() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_push_txn)
-  return internal_push_txn_ != NULL ? *internal_push_txn_ : *default_instance_->internal_push_txn_;
+inline const ::proto::Timestamp& Lease::start() const {
+  // @@protoc_insertion_point(field_get:proto.Lease.start)
+  return start_ != NULL ? *start_ : *default_instance_->start_;
 }
-inline ::proto::InternalPushTxnResponse* ReadWriteCmdResponse::mutable_internal_push_txn() {
-  set_has_internal_push_txn();
-  if (internal_push_txn_ == NULL) internal_push_txn_ = new ::proto::InternalPushTxnResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_push_txn)
-  return internal_push_txn_;
+inline ::proto::Timestamp* Lease::mutable_start() {
+  set_has_start();
+  if (start_ == NULL) start_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.Lease.start)
+  return start_;
 }
-inline ::proto::InternalPushTxnResponse* ReadWriteCmdResponse::release_internal_push_txn() {
-  clear_has_internal_push_txn();
-  ::proto::InternalPushTxnResponse* temp = internal_push_txn_;
-  internal_push_txn_ = NULL;
+inline ::proto::Timestamp* Lease::release_start() {
+  clear_has_start();
+  ::proto::Timestamp* temp = start_;
+  start_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_push_txn(::proto::InternalPushTxnResponse* internal_push_txn) {
-  delete internal_push_txn_;
-  internal_push_txn_ = internal_push_txn;
-  if (internal_push_txn) {
-    set_has_internal_push_txn();
+inline void Lease::set_allocated_start(::proto::Timestamp* start) {
+  delete start_;
+  start_ = start;
+  if (start) {
+    set_has_start();
   } else {
-    clear_has_internal_push_txn();
+    clear_has_start();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_push_txn)
+  // @@protoc_insertion_point(field_set_allocated:proto.Lease.start)
 }
 
-// optional .proto.InternalResolveIntentResponse internal_resolve_intent = 12;
-inline bool ReadWriteCmdResponse::has_internal_resolve_intent() const {
-  return (_has_bits_[0] & 0x00000800u) != 0;
+// optional .proto.Timestamp expiration = 2;
+inline bool Lease::has_expiration() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_internal_resolve_intent() {
-  _has_bits_[0] |= 0x00000800u;
+inline void Lease::set_has_expiration() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void ReadWriteCmdResponse::clear_has_internal_resolve_intent() {
-  _has_bits_[0] &= ~0x00000800u;
+inline void Lease::clear_has_expiration() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void ReadWriteCmdResponse::clear_internal_resolve_intent() {
-  if (internal_resolve_intent_ != NULL) internal_resolve_intent_->::proto::InternalResolveIntentResponse::Clear();
-  clear_has_internal_resolve_intent();
+inline void Lease::clear_expiration() {
+  if (expiration_ != NULL) expiration_->::proto::Timestamp::Clear();
+  clear_has_expiration();
 }
-inline const ::proto::InternalResolveIntentResponse& ReadWriteCmdResponse::internal_resolve_intent() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_resolve_intent)
-  return internal_resolve_intent_ != NULL ? *internal_resolve_intent_ : *default_instance_->internal_resolve_intent_;
+inline const ::proto::Timestamp& Lease::expiration() const {
+  // @@protoc_insertion_point(field_get:proto.Lease.expiration)
+  return expiration_ != NULL ? *expiration_ : *default_instance_->expiration_;
 }
-inline ::proto::InternalResolveIntentResponse* ReadWriteCmdResponse::mutable_internal_resolve_intent() {
-  set_has_internal_resolve_intent();
-  if (internal_resolve_intent_ == NULL) internal_resolve_intent_ = new ::proto::InternalResolveIntentResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_resolve_intent)
-  return internal_resolve_intent_;
+inline ::proto::Timestamp* Lease::mutable_expiration() {
+  set_has_expiration();
+  if (expiration_ == NULL) expiration_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.Lease.expiration)
+  return expiration_;
 }
-inline ::proto::InternalResolveIntentResponse* ReadWriteCmdResponse::release_internal_resolve_intent() {
-  clear_has_internal_resolve_intent();
-  ::proto::InternalResolveIntentResponse* temp = internal_resolve_intent_;
-  internal_resolve_intent_ = NULL;
+inline ::proto::Timestamp* Lease::release_expiration() {
+  clear_has_expiration();
+  ::proto::Timestamp* temp = expiration_;
+  expiration_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_resolve_intent(::proto::InternalResolveIntentResponse* internal_resolve_intent) {
-  delete internal_resolve_intent_;
-  internal_resolve_intent_ = internal_resolve_intent;
-  if (internal_resolve_intent) {
-    set_has_internal_resolve_intent();
+inline void Lease::set_allocated_expiration(::proto::Timestamp* expiration) {
+  delete expiration_;
+  expiration_ = expiration;
+  if (expiration) {
+    set_has_expiration();
   } else {
-    clear_has_internal_resolve_intent();
+    clear_has_expiration();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_resolve_intent)
+  // @@protoc_insertion_point(field_set_allocated:proto.Lease.expiration)
 }
 
-// optional .proto.InternalMergeResponse internal_merge = 13;
-inline bool ReadWriteCmdResponse::has_internal_merge() const {
-  return (_has_bits_[0] & 0x00001000u) != 0;
+// optional .proto.Replica replica = 3;
+inline bool Lease::has_replica() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_internal_merge() {
-  _has_bits_[0] |= 0x00001000u;
+inline void Lease::set_has_replica() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline void ReadWriteCmdResponse::clear_has_internal_merge() {
-  _has_bits_[0] &= ~0x00001000u;
+inline void Lease::clear_has_replica() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline void ReadWriteCmdResponse::clear_internal_merge() {
-  if (internal_merge_ != NULL) internal_merge_->::proto::InternalMergeResponse::Clear();
-  clear_has_internal_merge();
+inline void Lease::clear_replica() {
+  if (replica_ != NULL) replica_->::proto::Replica::Clear();
+  clear_has_replica();
 }
-inline const ::proto::InternalMergeResponse& ReadWriteCmdResponse::internal_merge() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_merge)
-  return internal_merge_ != NULL ? *internal_merge_ : *default_instance_->internal_merge_;
+inline const ::proto::Replica& Lease::replica() const {
+  // @@protoc_insertion_point(field_get:proto.Lease.replica)
+  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
 }
-inline ::proto::InternalMergeResponse* ReadWriteCmdResponse::mutable_internal_merge() {
-  set_has_internal_merge();
-  if (internal_merge_ == NULL) internal_merge_ = new ::proto::InternalMergeResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_merge)
-  return internal_merge_;
+inline ::proto::Replica* Lease::mutable_replica() {
+  set_has_replica();
+  if (replica_ == NULL) replica_ = new ::proto::Replica;
+  // @@protoc_insertion_point(field_mutable:proto.Lease.replica)
+  return replica_;
 }
-inline ::proto::InternalMergeResponse* ReadWriteCmdResponse::release_internal_merge() {
-  clear_has_internal_merge();
-  ::proto::InternalMergeResponse* temp = internal_merge_;
-  internal_merge_ = NULL;
+inline ::proto::Replica* Lease::release_replica() {
+  clear_has_replica();
+  ::proto::Replica* temp = replica_;
+  replica_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_merge(::proto::InternalMergeResponse* internal_merge) {
-  delete internal_merge_;
-  internal_merge_ = internal_merge;
-  if (internal_merge) {
-    set_has_internal_merge();
+inline void Lease::set_allocated_replica(::proto::Replica* replica) {
+  delete replica_;
+  replica_ = replica;
+  if (replica) {
+    set_has_replica();
   } else {
-    clear_has_internal_merge();
+    clear_has_replica();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_merge)
+  // @@protoc_insertion_point(field_set_allocated:proto.Lease.replica)
 }
 
-// optional .proto.InternalTruncateLogResponse internal_truncate_log = 14;
-inline bool ReadWriteCmdResponse::has_internal_truncate_log() const {
-  return (_has_bits_[0] & 0x00002000u) != 0;
+// optional int64 epoch = 4;
+inline bool Lease::has_epoch() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
 }
-inline void ReadWriteCmdResponse::set_has_internal_truncate_log() {
-  _has_bits_[0] |= 0x00002000u;
+inline void Lease::set_has_epoch() {
+  _has_bits_[0] |= 0x00000008u;
 }
-inline void ReadWriteCmdResponse::clear_has_internal_truncate_log() {
-  _has_bits_[0] &= ~0x00002000u;
+inline void Lease::clear_has_epoch() {
+  _has_bits_[0] &= ~0x00000008u;
 }
-inline void ReadWriteCmdResponse::clear_internal_truncate_log() {
-  if (internal_truncate_log_ != NULL) internal_truncate_log_->::proto::InternalTruncateLogResponse::Clear();
-  clear_has_internal_truncate_log();
+inline void Lease::clear_epoch() {
+  epoch_ = GOOGLE_LONGLONG(0);
+  clear_has_epoch();
 }
-inline const ::proto::InternalTruncateLogResponse& ReadWriteCmdResponse::internal_truncate_log() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_truncate_log)
-  return internal_truncate_log_ != NULL ? *internal_truncate_log_ : *default_instance_->internal_truncate_log_;
+inline ::google::protobuf::int64 Lease::epoch() const {
+  // @@protoc_insertion_point(field_get:proto.Lease.epoch)
+  return epoch_;
 }
-inline ::proto::InternalTruncateLogResponse* ReadWriteCmdResponse::mutable_internal_truncate_log() {
-  set_has_internal_truncate_log();
-  if (internal_truncate_log_ == NULL) internal_truncate_log_ = new ::proto::InternalTruncateLogResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_truncate_log)
-  return internal_truncate_log_;
+inline void Lease::set_epoch(::google::protobuf::int64 value) {
+  set_has_epoch();
+  epoch_ = value;
+  // @@protoc_insertion_point(field_set:proto.Lease.epoch)
 }
-inline ::proto::InternalTruncateLogResponse* ReadWriteCmdResponse::release_internal_truncate_log() {
-  clear_has_internal_truncate_log();
-  ::proto::InternalTruncateLogResponse* temp = internal_truncate_log_;
-  internal_truncate_log_ = NULL;
+
+// -------------------------------------------------------------------
+
+// LeaseTransfer
+
+// optional .proto.Timestamp fence = 1;
+inline bool LeaseTransfer::has_fence() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void LeaseTransfer::set_has_fence() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void LeaseTransfer::clear_has_fence() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void LeaseTransfer::clear_fence() {
+  if (fence_ != NULL) fence_->::proto::Timestamp::Clear();
+  clear_has_fence();
+}
+inline const ::proto::Timestamp& LeaseTransfer::fence() const {
+  // @@protoc_insertion_point(field_get:proto.LeaseTransfer.fence)
+  return fence_ != NULL ? *fence_ : *default_instance_->fence_;
+}
+inline ::proto::Timestamp* LeaseTransfer::mutable_fence() {
+  set_has_fence();
+  if (fence_ == NULL) fence_ = new ::proto::Timestamp;
+  // @@protoc_insertion_point(field_mutable:proto.LeaseTransfer.fence)
+  return fence_;
+}
+inline ::proto::Timestamp* LeaseTransfer::release_fence() {
+  clear_has_fence();
+  ::proto::Timestamp* temp = fence_;
+  fence_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_truncate_log(::proto::InternalTruncateLogResponse* internal_truncate_log) {
-  delete internal_truncate_log_;
-  internal_truncate_log_ = internal_truncate_log;
-  if (internal_truncate_log) {
-    set_has_internal_truncate_log();
+inline void LeaseTransfer::set_allocated_fence(::proto::Timestamp* fence) {
+  delete fence_;
+  fence_ = fence;
+  if (fence) {
+    set_has_fence();
   } else {
-    clear_has_internal_truncate_log();
+    clear_has_fence();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_truncate_log)
+  // @@protoc_insertion_point(field_set_allocated:proto.LeaseTransfer.fence)
 }
 
-// optional .proto.InternalGCResponse internal_gc = 15;
-inline bool ReadWriteCmdResponse::has_internal_gc() const {
-  return (_has_bits_[0] & 0x00004000u) != 0;
+// repeated .proto.ResponseCacheEntry response_cache = 2;
+inline int LeaseTransfer::response_cache_size() const {
+  return response_cache_.size();
 }
-inline void ReadWriteCmdResponse::set_has_internal_gc() {
-  _has_bits_[0] |= 0x00004000u;
+inline void LeaseTransfer::clear_response_cache() {
+  response_cache_.Clear();
 }
-inline void ReadWriteCmdResponse::clear_has_internal_gc() {
-  _has_bits_[0] &= ~0x00004000u;
+inline const ::proto::ResponseCacheEntry& LeaseTransfer::response_cache(int index) const {
+  // @@protoc_insertion_point(field_get:proto.LeaseTransfer.response_cache)
+  return response_cache_.Get(index);
 }
-inline void ReadWriteCmdResponse::clear_internal_gc() {
-  if (internal_gc_ != NULL) internal_gc_->::proto::InternalGCResponse::Clear();
-  clear_has_internal_gc();
+inline ::proto::ResponseCacheEntry* LeaseTransfer::mutable_response_cache(int index) {
+  // @@protoc_insertion_point(field_mutable:proto.LeaseTransfer.response_cache)
+  return response_cache_.Mutable(index);
+}
+inline ::proto::ResponseCacheEntry* LeaseTransfer::add_response_cache() {
+  // @@protoc_insertion_point(field_add:proto.LeaseTransfer.response_cache)
+  return response_cache_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::proto::ResponseCacheEntry >&
+LeaseTransfer::response_cache() const {
+  // @@protoc_insertion_point(field_list:proto.LeaseTransfer.response_cache)
+  return response_cache_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::proto::ResponseCacheEntry >*
+LeaseTransfer::mutable_response_cache() {
+  // @@protoc_insertion_point(field_mutable_list:proto.LeaseTransfer.response_cache)
+  return &response_cache_;
+}
+
+// optional .proto.Replica holder = 3;
+inline bool LeaseTransfer::has_holder() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void LeaseTransfer::set_has_holder() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void LeaseTransfer::clear_has_holder() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline const ::proto::InternalGCResponse& ReadWriteCmdResponse::internal_gc() const {
-  // @@protoc_insertion_point(field_get:proto.ReadWriteCmdResponse.internal_gc)
-  return internal_gc_ != NULL ? *internal_gc_ : *default_instance_->internal_gc_;
+inline void LeaseTransfer::clear_holder() {
+  if (holder_ != NULL) holder_->::proto::Replica::Clear();
+  clear_has_holder();
 }
-inline ::proto::InternalGCResponse* ReadWriteCmdResponse::mutable_internal_gc() {
-  set_has_internal_gc();
-  if (internal_gc_ == NULL) internal_gc_ = new ::proto::InternalGCResponse;
-  // @@protoc_insertion_point(field_mutable:proto.ReadWriteCmdResponse.internal_gc)
-  return internal_gc_;
+inline const ::proto::Replica& LeaseTransfer::holder() const {
+  // @@protoc_insertion_point(field_get:proto.LeaseTransfer.holder)
+  return holder_ != NULL ? *holder_ : *default_instance_->holder_;
 }
-inline ::proto::InternalGCResponse* ReadWriteCmdResponse::release_internal_gc() {
-  clear_has_internal_gc();
-  ::proto::InternalGCResponse* temp = internal_gc_;
-  internal_gc_ = NULL;
+inline ::proto::Replica* LeaseTransfer::mutable_holder() {
+  set_has_holder();
+  if (holder_ == NULL) holder_ = new ::proto::Replica;
+  // @@protoc_insertion_point(field_mutable:proto.LeaseTransfer.holder)
+  return holder_;
+}
+inline ::proto::Replica* LeaseTransfer::release_holder() {
+  clear_has_holder();
+  ::proto::Replica* temp = holder_;
+  holder_ = NULL;
   return temp;
 }
-inline void ReadWriteCmdResponse::set_allocated_internal_gc(::proto::InternalGCResponse* internal_gc) {
-  delete internal_gc_;
-  internal_gc_ = internal_gc;
-  if (internal_gc) {
-    set_has_internal_gc();
+inline void LeaseTransfer::set_allocated_holder(::proto::Replica* holder) {
+  delete holder_;
+  holder_ = holder;
+  if (holder) {
+    set_has_holder();
   } else {
-    clear_has_internal_gc();
+    clear_has_holder();
   }
-  // @@protoc_insertion_point(field_set_allocated:proto.ReadWriteCmdResponse.internal_gc)
+  // @@protoc_insertion_point(field_set_allocated:proto.LeaseTransfer.holder)
 }
 
 // -------------------------------------------------------------------
@@ -4488,15 +11658,97 @@ inline void InternalRaftCommandUnion::set_allocated_enqueue_message(::proto::Enq
   // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.enqueue_message)
 }
 
+// optional .proto.ReverseScanRequest reverse_scan = 13;
+inline bool InternalRaftCommandUnion::has_reverse_scan() const {
+  return (_has_bits_[0] & 0x00001000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_reverse_scan() {
+  _has_bits_[0] |= 0x00001000u;
+}
+inline void InternalRaftCommandUnion::clear_has_reverse_scan() {
+  _has_bits_[0] &= ~0x00001000u;
+}
+inline void InternalRaftCommandUnion::clear_reverse_scan() {
+  if (reverse_scan_ != NULL) reverse_scan_->::proto::ReverseScanRequest::Clear();
+  clear_has_reverse_scan();
+}
+inline const ::proto::ReverseScanRequest& InternalRaftCommandUnion::reverse_scan() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.reverse_scan)
+  return reverse_scan_ != NULL ? *reverse_scan_ : *default_instance_->reverse_scan_;
+}
+inline ::proto::ReverseScanRequest* InternalRaftCommandUnion::mutable_reverse_scan() {
+  set_has_reverse_scan();
+  if (reverse_scan_ == NULL) reverse_scan_ = new ::proto::ReverseScanRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.reverse_scan)
+  return reverse_scan_;
+}
+inline ::proto::ReverseScanRequest* InternalRaftCommandUnion::release_reverse_scan() {
+  clear_has_reverse_scan();
+  ::proto::ReverseScanRequest* temp = reverse_scan_;
+  reverse_scan_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_reverse_scan(::proto::ReverseScanRequest* reverse_scan) {
+  delete reverse_scan_;
+  reverse_scan_ = reverse_scan;
+  if (reverse_scan) {
+    set_has_reverse_scan();
+  } else {
+    clear_has_reverse_scan();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.reverse_scan)
+}
+
+// optional .proto.ConditionalDeleteRequest conditional_delete = 14;
+inline bool InternalRaftCommandUnion::has_conditional_delete() const {
+  return (_has_bits_[0] & 0x00002000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_conditional_delete() {
+  _has_bits_[0] |= 0x00002000u;
+}
+inline void InternalRaftCommandUnion::clear_has_conditional_delete() {
+  _has_bits_[0] &= ~0x00002000u;
+}
+inline void InternalRaftCommandUnion::clear_conditional_delete() {
+  if (conditional_delete_ != NULL) conditional_delete_->::proto::ConditionalDeleteRequest::Clear();
+  clear_has_conditional_delete();
+}
+inline const ::proto::ConditionalDeleteRequest& InternalRaftCommandUnion::conditional_delete() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.conditional_delete)
+  return conditional_delete_ != NULL ? *conditional_delete_ : *default_instance_->conditional_delete_;
+}
+inline ::proto::ConditionalDeleteRequest* InternalRaftCommandUnion::mutable_conditional_delete() {
+  set_has_conditional_delete();
+  if (conditional_delete_ == NULL) conditional_delete_ = new ::proto::ConditionalDeleteRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.conditional_delete)
+  return conditional_delete_;
+}
+inline ::proto::ConditionalDeleteRequest* InternalRaftCommandUnion::release_conditional_delete() {
+  clear_has_conditional_delete();
+  ::proto::ConditionalDeleteRequest* temp = conditional_delete_;
+  conditional_delete_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_conditional_delete(::proto::ConditionalDeleteRequest* conditional_delete) {
+  delete conditional_delete_;
+  conditional_delete_ = conditional_delete;
+  if (conditional_delete) {
+    set_has_conditional_delete();
+  } else {
+    clear_has_conditional_delete();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.conditional_delete)
+}
+
 // optional .proto.BatchRequest batch = 30;
 inline bool InternalRaftCommandUnion::has_batch() const {
-  return (_has_bits_[0] & 0x00001000u) != 0;
+  return (_has_bits_[0] & 0x00004000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_batch() {
-  _has_bits_[0] |= 0x00001000u;
+  _has_bits_[0] |= 0x00004000u;
 }
 inline void InternalRaftCommandUnion::clear_has_batch() {
-  _has_bits_[0] &= ~0x00001000u;
+  _has_bits_[0] &= ~0x00004000u;
 }
 inline void InternalRaftCommandUnion::clear_batch() {
   if (batch_ != NULL) batch_->::proto::BatchRequest::Clear();
@@ -4531,13 +11783,13 @@ inline void InternalRaftCommandUnion::set_allocated_batch(::proto::BatchRequest*
 
 // optional .proto.InternalRangeLookupRequest internal_range_lookup = 31;
 inline bool InternalRaftCommandUnion::has_internal_range_lookup() const {
-  return (_has_bits_[0] & 0x00002000u) != 0;
+  return (_has_bits_[0] & 0x00008000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_internal_range_lookup() {
-  _has_bits_[0] |= 0x00002000u;
+  _has_bits_[0] |= 0x00008000u;
 }
 inline void InternalRaftCommandUnion::clear_has_internal_range_lookup() {
-  _has_bits_[0] &= ~0x00002000u;
+  _has_bits_[0] &= ~0x00008000u;
 }
 inline void InternalRaftCommandUnion::clear_internal_range_lookup() {
   if (internal_range_lookup_ != NULL) internal_range_lookup_->::proto::InternalRangeLookupRequest::Clear();
@@ -4572,13 +11824,13 @@ inline void InternalRaftCommandUnion::set_allocated_internal_range_lookup(::prot
 
 // optional .proto.InternalHeartbeatTxnRequest internal_heartbeat_txn = 32;
 inline bool InternalRaftCommandUnion::has_internal_heartbeat_txn() const {
-  return (_has_bits_[0] & 0x00004000u) != 0;
+  return (_has_bits_[0] & 0x00010000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_internal_heartbeat_txn() {
-  _has_bits_[0] |= 0x00004000u;
+  _has_bits_[0] |= 0x00010000u;
 }
 inline void InternalRaftCommandUnion::clear_has_internal_heartbeat_txn() {
-  _has_bits_[0] &= ~0x00004000u;
+  _has_bits_[0] &= ~0x00010000u;
 }
 inline void InternalRaftCommandUnion::clear_internal_heartbeat_txn() {
   if (internal_heartbeat_txn_ != NULL) internal_heartbeat_txn_->::proto::InternalHeartbeatTxnRequest::Clear();
@@ -4613,13 +11865,13 @@ inline void InternalRaftCommandUnion::set_allocated_internal_heartbeat_txn(::pro
 
 // optional .proto.InternalPushTxnRequest internal_push_txn = 33;
 inline bool InternalRaftCommandUnion::has_internal_push_txn() const {
-  return (_has_bits_[0] & 0x00008000u) != 0;
+  return (_has_bits_[0] & 0x00020000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_internal_push_txn() {
-  _has_bits_[0] |= 0x00008000u;
+  _has_bits_[0] |= 0x00020000u;
 }
 inline void InternalRaftCommandUnion::clear_has_internal_push_txn() {
-  _has_bits_[0] &= ~0x00008000u;
+  _has_bits_[0] &= ~0x00020000u;
 }
 inline void InternalRaftCommandUnion::clear_internal_push_txn() {
   if (internal_push_txn_ != NULL) internal_push_txn_->::proto::InternalPushTxnRequest::Clear();
@@ -4654,13 +11906,13 @@ inline void InternalRaftCommandUnion::set_allocated_internal_push_txn(::proto::I
 
 // optional .proto.InternalResolveIntentRequest internal_resolve_intent = 34;
 inline bool InternalRaftCommandUnion::has_internal_resolve_intent() const {
-  return (_has_bits_[0] & 0x00010000u) != 0;
+  return (_has_bits_[0] & 0x00040000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_internal_resolve_intent() {
-  _has_bits_[0] |= 0x00010000u;
+  _has_bits_[0] |= 0x00040000u;
 }
 inline void InternalRaftCommandUnion::clear_has_internal_resolve_intent() {
-  _has_bits_[0] &= ~0x00010000u;
+  _has_bits_[0] &= ~0x00040000u;
 }
 inline void InternalRaftCommandUnion::clear_internal_resolve_intent() {
   if (internal_resolve_intent_ != NULL) internal_resolve_intent_->::proto::InternalResolveIntentRequest::Clear();
@@ -4695,13 +11947,13 @@ inline void InternalRaftCommandUnion::set_allocated_internal_resolve_intent(::pr
 
 // optional .proto.InternalMergeRequest internal_merge_response = 35;
 inline bool InternalRaftCommandUnion::has_internal_merge_response() const {
-  return (_has_bits_[0] & 0x00020000u) != 0;
+  return (_has_bits_[0] & 0x00080000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_internal_merge_response() {
-  _has_bits_[0] |= 0x00020000u;
+  _has_bits_[0] |= 0x00080000u;
 }
 inline void InternalRaftCommandUnion::clear_has_internal_merge_response() {
-  _has_bits_[0] &= ~0x00020000u;
+  _has_bits_[0] &= ~0x00080000u;
 }
 inline void InternalRaftCommandUnion::clear_internal_merge_response() {
   if (internal_merge_response_ != NULL) internal_merge_response_->::proto::InternalMergeRequest::Clear();
@@ -4736,13 +11988,13 @@ inline void InternalRaftCommandUnion::set_allocated_internal_merge_response(::pr
 
 // optional .proto.InternalTruncateLogRequest internal_truncate_log = 36;
 inline bool InternalRaftCommandUnion::has_internal_truncate_log() const {
-  return (_has_bits_[0] & 0x00040000u) != 0;
+  return (_has_bits_[0] & 0x00100000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_internal_truncate_log() {
-  _has_bits_[0] |= 0x00040000u;
+  _has_bits_[0] |= 0x00100000u;
 }
 inline void InternalRaftCommandUnion::clear_has_internal_truncate_log() {
-  _has_bits_[0] &= ~0x00040000u;
+  _has_bits_[0] &= ~0x00100000u;
 }
 inline void InternalRaftCommandUnion::clear_internal_truncate_log() {
   if (internal_truncate_log_ != NULL) internal_truncate_log_->::proto::InternalTruncateLogRequest::Clear();
@@ -4777,13 +12029,13 @@ inline void InternalRaftCommandUnion::set_allocated_internal_truncate_log(::prot
 
 // optional .proto.InternalGCRequest internal_gc = 37;
 inline bool InternalRaftCommandUnion::has_internal_gc() const {
-  return (_has_bits_[0] & 0x00080000u) != 0;
+  return (_has_bits_[0] & 0x00200000u) != 0;
 }
 inline void InternalRaftCommandUnion::set_has_internal_gc() {
-  _has_bits_[0] |= 0x00080000u;
+  _has_bits_[0] |= 0x00200000u;
 }
 inline void InternalRaftCommandUnion::clear_has_internal_gc() {
-  _has_bits_[0] &= ~0x00080000u;
+  _has_bits_[0] &= ~0x00200000u;
 }
 inline void InternalRaftCommandUnion::clear_internal_gc() {
   if (internal_gc_ != NULL) internal_gc_->::proto::InternalGCRequest::Clear();
@@ -4816,6 +12068,498 @@ inline void InternalRaftCommandUnion::set_allocated_internal_gc(::proto::Interna
   // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_gc)
 }
 
+// optional .proto.InternalBeginTransactionRequest internal_begin_transaction = 38;
+inline bool InternalRaftCommandUnion::has_internal_begin_transaction() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_begin_transaction() {
+  _has_bits_[0] |= 0x00400000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_begin_transaction() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_begin_transaction() {
+  if (internal_begin_transaction_ != NULL) internal_begin_transaction_->::proto::InternalBeginTransactionRequest::Clear();
+  clear_has_internal_begin_transaction();
+}
+inline const ::proto::InternalBeginTransactionRequest& InternalRaftCommandUnion::internal_begin_transaction() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_begin_transaction)
+  return internal_begin_transaction_ != NULL ? *internal_begin_transaction_ : *default_instance_->internal_begin_transaction_;
+}
+inline ::proto::InternalBeginTransactionRequest* InternalRaftCommandUnion::mutable_internal_begin_transaction() {
+  set_has_internal_begin_transaction();
+  if (internal_begin_transaction_ == NULL) internal_begin_transaction_ = new ::proto::InternalBeginTransactionRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_begin_transaction)
+  return internal_begin_transaction_;
+}
+inline ::proto::InternalBeginTransactionRequest* InternalRaftCommandUnion::release_internal_begin_transaction() {
+  clear_has_internal_begin_transaction();
+  ::proto::InternalBeginTransactionRequest* temp = internal_begin_transaction_;
+  internal_begin_transaction_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_begin_transaction(::proto::InternalBeginTransactionRequest* internal_begin_transaction) {
+  delete internal_begin_transaction_;
+  internal_begin_transaction_ = internal_begin_transaction;
+  if (internal_begin_transaction) {
+    set_has_internal_begin_transaction();
+  } else {
+    clear_has_internal_begin_transaction();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_begin_transaction)
+}
+
+// optional .proto.InternalScanIntentsRequest internal_scan_intents = 39;
+inline bool InternalRaftCommandUnion::has_internal_scan_intents() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_scan_intents() {
+  _has_bits_[0] |= 0x00800000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_scan_intents() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_scan_intents() {
+  if (internal_scan_intents_ != NULL) internal_scan_intents_->::proto::InternalScanIntentsRequest::Clear();
+  clear_has_internal_scan_intents();
+}
+inline const ::proto::InternalScanIntentsRequest& InternalRaftCommandUnion::internal_scan_intents() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_scan_intents)
+  return internal_scan_intents_ != NULL ? *internal_scan_intents_ : *default_instance_->internal_scan_intents_;
+}
+inline ::proto::InternalScanIntentsRequest* InternalRaftCommandUnion::mutable_internal_scan_intents() {
+  set_has_internal_scan_intents();
+  if (internal_scan_intents_ == NULL) internal_scan_intents_ = new ::proto::InternalScanIntentsRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_scan_intents)
+  return internal_scan_intents_;
+}
+inline ::proto::InternalScanIntentsRequest* InternalRaftCommandUnion::release_internal_scan_intents() {
+  clear_has_internal_scan_intents();
+  ::proto::InternalScanIntentsRequest* temp = internal_scan_intents_;
+  internal_scan_intents_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_scan_intents(::proto::InternalScanIntentsRequest* internal_scan_intents) {
+  delete internal_scan_intents_;
+  internal_scan_intents_ = internal_scan_intents;
+  if (internal_scan_intents) {
+    set_has_internal_scan_intents();
+  } else {
+    clear_has_internal_scan_intents();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_scan_intents)
+}
+
+// optional .proto.InternalInspectTimestampCacheRequest internal_inspect_timestamp_cache = 40;
+inline bool InternalRaftCommandUnion::has_internal_inspect_timestamp_cache() const {
+  return (_has_bits_[0] & 0x01000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_inspect_timestamp_cache() {
+  _has_bits_[0] |= 0x01000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_inspect_timestamp_cache() {
+  _has_bits_[0] &= ~0x01000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_inspect_timestamp_cache() {
+  if (internal_inspect_timestamp_cache_ != NULL) internal_inspect_timestamp_cache_->::proto::InternalInspectTimestampCacheRequest::Clear();
+  clear_has_internal_inspect_timestamp_cache();
+}
+inline const ::proto::InternalInspectTimestampCacheRequest& InternalRaftCommandUnion::internal_inspect_timestamp_cache() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_inspect_timestamp_cache)
+  return internal_inspect_timestamp_cache_ != NULL ? *internal_inspect_timestamp_cache_ : *default_instance_->internal_inspect_timestamp_cache_;
+}
+inline ::proto::InternalInspectTimestampCacheRequest* InternalRaftCommandUnion::mutable_internal_inspect_timestamp_cache() {
+  set_has_internal_inspect_timestamp_cache();
+  if (internal_inspect_timestamp_cache_ == NULL) internal_inspect_timestamp_cache_ = new ::proto::InternalInspectTimestampCacheRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_inspect_timestamp_cache)
+  return internal_inspect_timestamp_cache_;
+}
+inline ::proto::InternalInspectTimestampCacheRequest* InternalRaftCommandUnion::release_internal_inspect_timestamp_cache() {
+  clear_has_internal_inspect_timestamp_cache();
+  ::proto::InternalInspectTimestampCacheRequest* temp = internal_inspect_timestamp_cache_;
+  internal_inspect_timestamp_cache_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_inspect_timestamp_cache(::proto::InternalInspectTimestampCacheRequest* internal_inspect_timestamp_cache) {
+  delete internal_inspect_timestamp_cache_;
+  internal_inspect_timestamp_cache_ = internal_inspect_timestamp_cache;
+  if (internal_inspect_timestamp_cache) {
+    set_has_internal_inspect_timestamp_cache();
+  } else {
+    clear_has_internal_inspect_timestamp_cache();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_inspect_timestamp_cache)
+}
+
+// optional .proto.InternalGetTransactionRequest internal_get_transaction = 41;
+inline bool InternalRaftCommandUnion::has_internal_get_transaction() const {
+  return (_has_bits_[0] & 0x02000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_get_transaction() {
+  _has_bits_[0] |= 0x02000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_get_transaction() {
+  _has_bits_[0] &= ~0x02000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_get_transaction() {
+  if (internal_get_transaction_ != NULL) internal_get_transaction_->::proto::InternalGetTransactionRequest::Clear();
+  clear_has_internal_get_transaction();
+}
+inline const ::proto::InternalGetTransactionRequest& InternalRaftCommandUnion::internal_get_transaction() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_get_transaction)
+  return internal_get_transaction_ != NULL ? *internal_get_transaction_ : *default_instance_->internal_get_transaction_;
+}
+inline ::proto::InternalGetTransactionRequest* InternalRaftCommandUnion::mutable_internal_get_transaction() {
+  set_has_internal_get_transaction();
+  if (internal_get_transaction_ == NULL) internal_get_transaction_ = new ::proto::InternalGetTransactionRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_get_transaction)
+  return internal_get_transaction_;
+}
+inline ::proto::InternalGetTransactionRequest* InternalRaftCommandUnion::release_internal_get_transaction() {
+  clear_has_internal_get_transaction();
+  ::proto::InternalGetTransactionRequest* temp = internal_get_transaction_;
+  internal_get_transaction_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_get_transaction(::proto::InternalGetTransactionRequest* internal_get_transaction) {
+  delete internal_get_transaction_;
+  internal_get_transaction_ = internal_get_transaction;
+  if (internal_get_transaction) {
+    set_has_internal_get_transaction();
+  } else {
+    clear_has_internal_get_transaction();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_get_transaction)
+}
+
+// optional .proto.InternalPutIfAbsentRequest internal_put_if_absent = 42;
+inline bool InternalRaftCommandUnion::has_internal_put_if_absent() const {
+  return (_has_bits_[0] & 0x04000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_put_if_absent() {
+  _has_bits_[0] |= 0x04000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_put_if_absent() {
+  _has_bits_[0] &= ~0x04000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_put_if_absent() {
+  if (internal_put_if_absent_ != NULL) internal_put_if_absent_->::proto::InternalPutIfAbsentRequest::Clear();
+  clear_has_internal_put_if_absent();
+}
+inline const ::proto::InternalPutIfAbsentRequest& InternalRaftCommandUnion::internal_put_if_absent() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_put_if_absent)
+  return internal_put_if_absent_ != NULL ? *internal_put_if_absent_ : *default_instance_->internal_put_if_absent_;
+}
+inline ::proto::InternalPutIfAbsentRequest* InternalRaftCommandUnion::mutable_internal_put_if_absent() {
+  set_has_internal_put_if_absent();
+  if (internal_put_if_absent_ == NULL) internal_put_if_absent_ = new ::proto::InternalPutIfAbsentRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_put_if_absent)
+  return internal_put_if_absent_;
+}
+inline ::proto::InternalPutIfAbsentRequest* InternalRaftCommandUnion::release_internal_put_if_absent() {
+  clear_has_internal_put_if_absent();
+  ::proto::InternalPutIfAbsentRequest* temp = internal_put_if_absent_;
+  internal_put_if_absent_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_put_if_absent(::proto::InternalPutIfAbsentRequest* internal_put_if_absent) {
+  delete internal_put_if_absent_;
+  internal_put_if_absent_ = internal_put_if_absent;
+  if (internal_put_if_absent) {
+    set_has_internal_put_if_absent();
+  } else {
+    clear_has_internal_put_if_absent();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_put_if_absent)
+}
+
+// optional .proto.InternalRangeKeyBoundsRequest internal_range_key_bounds = 43;
+inline bool InternalRaftCommandUnion::has_internal_range_key_bounds() const {
+  return (_has_bits_[0] & 0x08000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_range_key_bounds() {
+  _has_bits_[0] |= 0x08000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_range_key_bounds() {
+  _has_bits_[0] &= ~0x08000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_range_key_bounds() {
+  if (internal_range_key_bounds_ != NULL) internal_range_key_bounds_->::proto::InternalRangeKeyBoundsRequest::Clear();
+  clear_has_internal_range_key_bounds();
+}
+inline const ::proto::InternalRangeKeyBoundsRequest& InternalRaftCommandUnion::internal_range_key_bounds() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_range_key_bounds)
+  return internal_range_key_bounds_ != NULL ? *internal_range_key_bounds_ : *default_instance_->internal_range_key_bounds_;
+}
+inline ::proto::InternalRangeKeyBoundsRequest* InternalRaftCommandUnion::mutable_internal_range_key_bounds() {
+  set_has_internal_range_key_bounds();
+  if (internal_range_key_bounds_ == NULL) internal_range_key_bounds_ = new ::proto::InternalRangeKeyBoundsRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_range_key_bounds)
+  return internal_range_key_bounds_;
+}
+inline ::proto::InternalRangeKeyBoundsRequest* InternalRaftCommandUnion::release_internal_range_key_bounds() {
+  clear_has_internal_range_key_bounds();
+  ::proto::InternalRangeKeyBoundsRequest* temp = internal_range_key_bounds_;
+  internal_range_key_bounds_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_range_key_bounds(::proto::InternalRangeKeyBoundsRequest* internal_range_key_bounds) {
+  delete internal_range_key_bounds_;
+  internal_range_key_bounds_ = internal_range_key_bounds;
+  if (internal_range_key_bounds) {
+    set_has_internal_range_key_bounds();
+  } else {
+    clear_has_internal_range_key_bounds();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_range_key_bounds)
+}
+
+// optional .proto.InternalVerifyRangeRequest internal_verify_range = 44;
+inline bool InternalRaftCommandUnion::has_internal_verify_range() const {
+  return (_has_bits_[0] & 0x10000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_verify_range() {
+  _has_bits_[0] |= 0x10000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_verify_range() {
+  _has_bits_[0] &= ~0x10000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_verify_range() {
+  if (internal_verify_range_ != NULL) internal_verify_range_->::proto::InternalVerifyRangeRequest::Clear();
+  clear_has_internal_verify_range();
+}
+inline const ::proto::InternalVerifyRangeRequest& InternalRaftCommandUnion::internal_verify_range() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_verify_range)
+  return internal_verify_range_ != NULL ? *internal_verify_range_ : *default_instance_->internal_verify_range_;
+}
+inline ::proto::InternalVerifyRangeRequest* InternalRaftCommandUnion::mutable_internal_verify_range() {
+  set_has_internal_verify_range();
+  if (internal_verify_range_ == NULL) internal_verify_range_ = new ::proto::InternalVerifyRangeRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_verify_range)
+  return internal_verify_range_;
+}
+inline ::proto::InternalVerifyRangeRequest* InternalRaftCommandUnion::release_internal_verify_range() {
+  clear_has_internal_verify_range();
+  ::proto::InternalVerifyRangeRequest* temp = internal_verify_range_;
+  internal_verify_range_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_verify_range(::proto::InternalVerifyRangeRequest* internal_verify_range) {
+  delete internal_verify_range_;
+  internal_verify_range_ = internal_verify_range;
+  if (internal_verify_range) {
+    set_has_internal_verify_range();
+  } else {
+    clear_has_internal_verify_range();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_verify_range)
+}
+
+// optional .proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 45;
+inline bool InternalRaftCommandUnion::has_internal_resolve_intent_range() const {
+  return (_has_bits_[0] & 0x20000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_resolve_intent_range() {
+  _has_bits_[0] |= 0x20000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_resolve_intent_range() {
+  _has_bits_[0] &= ~0x20000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_resolve_intent_range() {
+  if (internal_resolve_intent_range_ != NULL) internal_resolve_intent_range_->::proto::InternalResolveIntentRangeRequest::Clear();
+  clear_has_internal_resolve_intent_range();
+}
+inline const ::proto::InternalResolveIntentRangeRequest& InternalRaftCommandUnion::internal_resolve_intent_range() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_resolve_intent_range)
+  return internal_resolve_intent_range_ != NULL ? *internal_resolve_intent_range_ : *default_instance_->internal_resolve_intent_range_;
+}
+inline ::proto::InternalResolveIntentRangeRequest* InternalRaftCommandUnion::mutable_internal_resolve_intent_range() {
+  set_has_internal_resolve_intent_range();
+  if (internal_resolve_intent_range_ == NULL) internal_resolve_intent_range_ = new ::proto::InternalResolveIntentRangeRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_resolve_intent_range)
+  return internal_resolve_intent_range_;
+}
+inline ::proto::InternalResolveIntentRangeRequest* InternalRaftCommandUnion::release_internal_resolve_intent_range() {
+  clear_has_internal_resolve_intent_range();
+  ::proto::InternalResolveIntentRangeRequest* temp = internal_resolve_intent_range_;
+  internal_resolve_intent_range_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_resolve_intent_range(::proto::InternalResolveIntentRangeRequest* internal_resolve_intent_range) {
+  delete internal_resolve_intent_range_;
+  internal_resolve_intent_range_ = internal_resolve_intent_range;
+  if (internal_resolve_intent_range) {
+    set_has_internal_resolve_intent_range();
+  } else {
+    clear_has_internal_resolve_intent_range();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_resolve_intent_range)
+}
+
+// optional .proto.InternalLeaderLeaseRequest internal_leader_lease = 46;
+inline bool InternalRaftCommandUnion::has_internal_leader_lease() const {
+  return (_has_bits_[0] & 0x40000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_leader_lease() {
+  _has_bits_[0] |= 0x40000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_leader_lease() {
+  _has_bits_[0] &= ~0x40000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_leader_lease() {
+  if (internal_leader_lease_ != NULL) internal_leader_lease_->::proto::InternalLeaderLeaseRequest::Clear();
+  clear_has_internal_leader_lease();
+}
+inline const ::proto::InternalLeaderLeaseRequest& InternalRaftCommandUnion::internal_leader_lease() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_leader_lease)
+  return internal_leader_lease_ != NULL ? *internal_leader_lease_ : *default_instance_->internal_leader_lease_;
+}
+inline ::proto::InternalLeaderLeaseRequest* InternalRaftCommandUnion::mutable_internal_leader_lease() {
+  set_has_internal_leader_lease();
+  if (internal_leader_lease_ == NULL) internal_leader_lease_ = new ::proto::InternalLeaderLeaseRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_leader_lease)
+  return internal_leader_lease_;
+}
+inline ::proto::InternalLeaderLeaseRequest* InternalRaftCommandUnion::release_internal_leader_lease() {
+  clear_has_internal_leader_lease();
+  ::proto::InternalLeaderLeaseRequest* temp = internal_leader_lease_;
+  internal_leader_lease_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_leader_lease(::proto::InternalLeaderLeaseRequest* internal_leader_lease) {
+  delete internal_leader_lease_;
+  internal_leader_lease_ = internal_leader_lease;
+  if (internal_leader_lease) {
+    set_has_internal_leader_lease();
+  } else {
+    clear_has_internal_leader_lease();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_leader_lease)
+}
+
+// optional .proto.InternalComputeChecksumRequest internal_compute_checksum = 47;
+inline bool InternalRaftCommandUnion::has_internal_compute_checksum() const {
+  return (_has_bits_[0] & 0x80000000u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_compute_checksum() {
+  _has_bits_[0] |= 0x80000000u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_compute_checksum() {
+  _has_bits_[0] &= ~0x80000000u;
+}
+inline void InternalRaftCommandUnion::clear_internal_compute_checksum() {
+  if (internal_compute_checksum_ != NULL) internal_compute_checksum_->::proto::InternalComputeChecksumRequest::Clear();
+  clear_has_internal_compute_checksum();
+}
+inline const ::proto::InternalComputeChecksumRequest& InternalRaftCommandUnion::internal_compute_checksum() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_compute_checksum)
+  return internal_compute_checksum_ != NULL ? *internal_compute_checksum_ : *default_instance_->internal_compute_checksum_;
+}
+inline ::proto::InternalComputeChecksumRequest* InternalRaftCommandUnion::mutable_internal_compute_checksum() {
+  set_has_internal_compute_checksum();
+  if (internal_compute_checksum_ == NULL) internal_compute_checksum_ = new ::proto::InternalComputeChecksumRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_compute_checksum)
+  return internal_compute_checksum_;
+}
+inline ::proto::InternalComputeChecksumRequest* InternalRaftCommandUnion::release_internal_compute_checksum() {
+  clear_has_internal_compute_checksum();
+  ::proto::InternalComputeChecksumRequest* temp = internal_compute_checksum_;
+  internal_compute_checksum_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_compute_checksum(::proto::InternalComputeChecksumRequest* internal_compute_checksum) {
+  delete internal_compute_checksum_;
+  internal_compute_checksum_ = internal_compute_checksum;
+  if (internal_compute_checksum) {
+    set_has_internal_compute_checksum();
+  } else {
+    clear_has_internal_compute_checksum();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_compute_checksum)
+}
+
+// optional .proto.InternalGetHistoryRequest internal_get_history = 48;
+inline bool InternalRaftCommandUnion::has_internal_get_history() const {
+  return (_has_bits_[1] & 0x00000001u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_get_history() {
+  _has_bits_[1] |= 0x00000001u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_get_history() {
+  _has_bits_[1] &= ~0x00000001u;
+}
+inline void InternalRaftCommandUnion::clear_internal_get_history() {
+  if (internal_get_history_ != NULL) internal_get_history_->::proto::InternalGetHistoryRequest::Clear();
+  clear_has_internal_get_history();
+}
+inline const ::proto::InternalGetHistoryRequest& InternalRaftCommandUnion::internal_get_history() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_get_history)
+  return internal_get_history_ != NULL ? *internal_get_history_ : *default_instance_->internal_get_history_;
+}
+inline ::proto::InternalGetHistoryRequest* InternalRaftCommandUnion::mutable_internal_get_history() {
+  set_has_internal_get_history();
+  if (internal_get_history_ == NULL) internal_get_history_ = new ::proto::InternalGetHistoryRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_get_history)
+  return internal_get_history_;
+}
+inline ::proto::InternalGetHistoryRequest* InternalRaftCommandUnion::release_internal_get_history() {
+  clear_has_internal_get_history();
+  ::proto::InternalGetHistoryRequest* temp = internal_get_history_;
+  internal_get_history_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_get_history(::proto::InternalGetHistoryRequest* internal_get_history) {
+  delete internal_get_history_;
+  internal_get_history_ = internal_get_history;
+  if (internal_get_history) {
+    set_has_internal_get_history();
+  } else {
+    clear_has_internal_get_history();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_get_history)
+}
+
+// optional .proto.InternalBatchGetRequest internal_batch_get = 49;
+inline bool InternalRaftCommandUnion::has_internal_batch_get() const {
+  return (_has_bits_[1] & 0x00000002u) != 0;
+}
+inline void InternalRaftCommandUnion::set_has_internal_batch_get() {
+  _has_bits_[1] |= 0x00000002u;
+}
+inline void InternalRaftCommandUnion::clear_has_internal_batch_get() {
+  _has_bits_[1] &= ~0x00000002u;
+}
+inline void InternalRaftCommandUnion::clear_internal_batch_get() {
+  if (internal_batch_get_ != NULL) internal_batch_get_->::proto::InternalBatchGetRequest::Clear();
+  clear_has_internal_batch_get();
+}
+inline const ::proto::InternalBatchGetRequest& InternalRaftCommandUnion::internal_batch_get() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommandUnion.internal_batch_get)
+  return internal_batch_get_ != NULL ? *internal_batch_get_ : *default_instance_->internal_batch_get_;
+}
+inline ::proto::InternalBatchGetRequest* InternalRaftCommandUnion::mutable_internal_batch_get() {
+  set_has_internal_batch_get();
+  if (internal_batch_get_ == NULL) internal_batch_get_ = new ::proto::InternalBatchGetRequest;
+  // @@protoc_insertion_point(field_mutable:proto.InternalRaftCommandUnion.internal_batch_get)
+  return internal_batch_get_;
+}
+inline ::proto::InternalBatchGetRequest* InternalRaftCommandUnion::release_internal_batch_get() {
+  clear_has_internal_batch_get();
+  ::proto::InternalBatchGetRequest* temp = internal_batch_get_;
+  internal_batch_get_ = NULL;
+  return temp;
+}
+inline void InternalRaftCommandUnion::set_allocated_internal_batch_get(::proto::InternalBatchGetRequest* internal_batch_get) {
+  delete internal_batch_get_;
+  internal_batch_get_ = internal_batch_get;
+  if (internal_batch_get) {
+    set_has_internal_batch_get();
+  } else {
+    clear_has_internal_batch_get();
+  }
+  // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommandUnion.internal_batch_get)
+}
+
 // -------------------------------------------------------------------
 
 // InternalRaftCommand
@@ -4885,6 +12629,54 @@ inline void InternalRaftCommand::set_allocated_cmd(::proto::InternalRaftCommandU
   // @@protoc_insertion_point(field_set_allocated:proto.InternalRaftCommand.cmd)
 }
 
+// optional int64 generation = 4;
+inline bool InternalRaftCommand::has_generation() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void InternalRaftCommand::set_has_generation() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void InternalRaftCommand::clear_has_generation() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void InternalRaftCommand::clear_generation() {
+  generation_ = GOOGLE_LONGLONG(0);
+  clear_has_generation();
+}
+inline ::google::protobuf::int64 InternalRaftCommand::generation() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommand.generation)
+  return generation_;
+}
+inline void InternalRaftCommand::set_generation(::google::protobuf::int64 value) {
+  set_has_generation();
+  generation_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalRaftCommand.generation)
+}
+
+// optional int64 lease_epoch = 5;
+inline bool InternalRaftCommand::has_lease_epoch() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalRaftCommand::set_has_lease_epoch() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalRaftCommand::clear_has_lease_epoch() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalRaftCommand::clear_lease_epoch() {
+  lease_epoch_ = GOOGLE_LONGLONG(0);
+  clear_has_lease_epoch();
+}
+inline ::google::protobuf::int64 InternalRaftCommand::lease_epoch() const {
+  // @@protoc_insertion_point(field_get:proto.InternalRaftCommand.lease_epoch)
+  return lease_epoch_;
+}
+inline void InternalRaftCommand::set_lease_epoch(::google::protobuf::int64 value) {
+  set_has_lease_epoch();
+  lease_epoch_ = value;
+  // @@protoc_insertion_point(field_set:proto.InternalRaftCommand.lease_epoch)
+}
+
 // -------------------------------------------------------------------
 
 // InternalTimeSeriesData
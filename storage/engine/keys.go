@@ -50,6 +50,13 @@ func StoreStatKey(storeID int32, stat proto.Key) proto.Key {
 	return MakeStoreKey(KeyLocalStoreStatSuffix, stat)
 }
 
+// RangeTombstoneKey returns a store-local key for the tombstone of
+// the range with the specified Raft ID. The tombstone is store-local
+// so that it survives the clearing of the range's Raft ID-local data.
+func RangeTombstoneKey(raftID int64) proto.Key {
+	return MakeStoreKey(KeyLocalStoreRangeTombstoneSuffix, encoding.EncodeUvarint(nil, uint64(raftID)))
+}
+
 // MakeRangeIDKey creates a range-local key based on the range's
 // Raft ID, metadata key suffix, and optional detail (e.g. the
 // encoded command ID for a response cache entry, etc.).
@@ -139,6 +146,40 @@ func RangeGCMetadataKey(raftID int64) proto.Key {
 	return MakeRangeIDKey(raftID, KeyLocalRangeGCMetadataSuffix, proto.Key{})
 }
 
+// RangeAuditKey returns a range-local key by Raft ID for an entry in
+// the range's audit log. Entries sort by timestamp, then by client
+// command ID and mutated key, with seq, the mutation's position
+// within its command, distinguishing mutations of the same key by a
+// single command. Commands without a client command ID, such as
+// internal writes, thus don't overwrite each other's entries.
+func RangeAuditKey(raftID int64, timestamp proto.Timestamp, cmdID proto.ClientCmdID, key proto.Key, seq int32) proto.Key {
+	detail := encoding.EncodeUint64(nil, uint64(timestamp.WallTime))
+	detail = encoding.EncodeUint32(detail, uint32(timestamp.Logical))
+	detail = encoding.EncodeUint64(detail, uint64(cmdID.WallTime))
+	detail = encoding.EncodeUint64(detail, uint64(cmdID.Random))
+	detail = encoding.EncodeBytes(detail, key)
+	detail = encoding.EncodeUint32(detail, uint32(seq))
+	return MakeRangeIDKey(raftID, KeyLocalRangeAuditSuffix, detail)
+}
+
+// RangeAuditPrefix returns the range-local prefix shared by all
+// entries in a range's audit log.
+func RangeAuditPrefix(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeAuditSuffix, proto.Key{})
+}
+
+// RangeClosedTimestampKey returns a range-local key for the range's
+// closed timestamp.
+func RangeClosedTimestampKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeClosedTimestampSuffix, proto.Key{})
+}
+
+// RangeLeaderLeaseKey returns a range-local key for the range's
+// leader lease.
+func RangeLeaderLeaseKey(raftID int64) proto.Key {
+	return MakeRangeIDKey(raftID, KeyLocalRangeLeaderLeaseSuffix, proto.Key{})
+}
+
 // RangeLastVerificationTimestampKey returns a range-local key for
 // the range's last verification timestamp.
 func RangeLastVerificationTimestampKey(raftID int64) proto.Key {
@@ -158,6 +199,12 @@ func TransactionKey(key proto.Key, id []byte) proto.Key {
 	return MakeRangeKey(key, KeyLocalTransactionSuffix, proto.Key(id))
 }
 
+// AcctRollupKey returns the key under which the accounting rollup
+// for the specified accounting key prefix is stored.
+func AcctRollupKey(prefix proto.Key) proto.Key {
+	return MakeKey(KeyAcctRollupPrefix, prefix)
+}
+
 // KeyAddress returns the address for the key, used to lookup the
 // range containing the key. In the normal case, this is simply the
 // key's value. However, for local keys, such as transaction records,
@@ -337,6 +384,9 @@ var (
 	KeyLocalStoreIdentSuffix = proto.Key("iden")
 	// KeyLocalStoreStatSuffix is the suffix for store statistics.
 	KeyLocalStoreStatSuffix = proto.Key("sst-")
+	// KeyLocalStoreRangeTombstoneSuffix is the suffix for the tombstones
+	// of ranges removed from the store.
+	KeyLocalStoreRangeTombstoneSuffix = proto.Key("rtmb")
 
 	// KeyLocalRangeIDPrefix is the prefix identifying per-range data
 	// indexed by Raft ID. The Raft ID is appended to this prefix,
@@ -352,8 +402,17 @@ var (
 	KeyLocalRaftLogSuffix = proto.Key("rftl")
 	// KeyLocalRaftStateSuffix is the Suffix for the raft HardState.
 	KeyLocalRaftStateSuffix = proto.Key("rfts")
+	// KeyLocalRangeAuditSuffix is the suffix for a range's audit log
+	// entries.
+	KeyLocalRangeAuditSuffix = proto.Key("raud")
+	// KeyLocalRangeClosedTimestampSuffix is the suffix for a range's
+	// closed timestamp, below which no further writes are accepted.
+	KeyLocalRangeClosedTimestampSuffix = proto.Key("rcts")
 	// KeyLocalRangeGCMetadataSuffix is the suffix for a range's GC metadata.
 	KeyLocalRangeGCMetadataSuffix = proto.Key("rgcm")
+	// KeyLocalRangeLeaderLeaseSuffix is the suffix for a range's leader
+	// lease.
+	KeyLocalRangeLeaderLeaseSuffix = proto.Key("rll-")
 	// KeyLocalRangeLastVerificationTimestampSuffix is the suffix for a range's
 	// last verification timestamp (for checking integrity of on-disk data).
 	KeyLocalRangeLastVerificationTimestampSuffix = proto.Key("rlvt")
@@ -411,6 +470,10 @@ var (
 	// KeyConfigAccountingPrefix specifies the key prefix for accounting
 	// configurations. The suffix is the affected key prefix.
 	KeyConfigAccountingPrefix = MakeKey(KeySystemPrefix, proto.Key("acct"))
+	// KeyAcctRollupPrefix specifies the key prefix for accounting
+	// rollups. The suffix is the accounting key prefix whose MVCC stats
+	// are aggregated.
+	KeyAcctRollupPrefix = MakeKey(KeySystemPrefix, proto.Key("rollup-acct-"))
 	// KeyConfigPermissionPrefix specifies the key prefix for accounting
 	// configurations. The suffix is the affected key prefix.
 	KeyConfigPermissionPrefix = MakeKey(KeySystemPrefix, proto.Key("perm"))
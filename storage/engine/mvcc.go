@@ -20,8 +20,11 @@ package engine
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -36,6 +39,8 @@ const (
 	splitReservoirSize = 100
 	// The size of the timestamp portion of MVCC version keys (used to update stats).
 	mvccVersionTimestampSize int64 = 12
+	// The number of key/value pairs scanned at a time by MVCCComputeChecksum.
+	checksumScanBatchSize = 1000
 )
 
 // MVCCStats tracks byte and instance counts for:
@@ -131,6 +136,29 @@ func (ms *MVCCStats) updateStatsForInline(key proto.Key, origMetaKeySize, origMe
 	}
 }
 
+// updateStatsOnLock updates stat counters for the metadata of a key
+// which is read-locked or released, changing in size from the
+// original to the new metadata key and value sizes. A zero key size
+// indicates absent metadata. As locks have no versioned values, the
+// GC'able bytes age of a lock on a key without versions isn't
+// tracked.
+func (ms *MVCCStats) updateStatsOnLock(key proto.Key, origMetaKeySize, origMetaValSize,
+	metaKeySize, metaValSize int64, meta *proto.MVCCMetadata) {
+	if !ms.updateStatsForKey(key) {
+		return
+	}
+	if !meta.Deleted {
+		ms.LiveBytes += (metaKeySize + metaValSize) - (origMetaKeySize + origMetaValSize)
+	}
+	ms.KeyBytes += metaKeySize - origMetaKeySize
+	ms.ValBytes += metaValSize - origMetaValSize
+	if origMetaKeySize == 0 {
+		ms.KeyCount++
+	} else if metaKeySize == 0 {
+		ms.KeyCount--
+	}
+}
+
 // updateStatsOnMerge updates metadata stats while merging inlined
 // values. Unfortunately, we're unable to keep accurate stats on merge
 // as the actual details of the merge play out asynchronously during
@@ -200,6 +228,19 @@ func (ms *MVCCStats) updateStatsOnPut(key proto.Key, origMetaKeySize, origMetaVa
 	}
 }
 
+// updateStatsOnCoalesce updates stat counters for the removal of a
+// key's latest version ahead of its replacement by a put, which
+// otherwise accounts for it as an overwritten, GC'able value.
+func (ms *MVCCStats) updateStatsOnCoalesce(key proto.Key, meta *proto.MVCCMetadata, ageSeconds int64) {
+	if !ms.updateStatsForKey(key) {
+		return
+	}
+	ms.KeyBytes -= meta.KeyBytes
+	ms.ValBytes -= meta.ValBytes
+	ms.ValCount--
+	ms.GCBytesAge -= MVCCComputeGCBytesAge(meta.KeyBytes+meta.ValBytes, ageSeconds)
+}
+
 // updateStatsOnResolve updates stat counters with the difference
 // between the original and new metadata sizes. The size of the
 // resolved value (key & bytes) are subtracted from the intents
@@ -420,7 +461,8 @@ func MVCCPutProto(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.T
 // MVCCGet returns the value for the key specified in the request,
 // while satisfying the given timestamp condition. The key may contain
 // arbitrary bytes. If no value for the key exists, or it has been
-// deleted, returns nil for value.
+// deleted, returns nil for value. A versioned value which doesn't
+// match its stored checksum returns a ChecksumMismatchError.
 //
 // The values of multiple versions for the given key should
 // be organized as follows:
@@ -478,6 +520,10 @@ func mvccGetInternal(engine Engine, key proto.Key, kv proto.RawKeyValue, timesta
 	if meta.IsInline() {
 		return meta.Value, nil
 	}
+	// A read lock doesn't block readers; read as if the key were unlocked.
+	if meta.Lock {
+		meta.Txn = nil
+	}
 
 	// First case: Our read timestamp is ahead of the latest write, or the
 	// latest write and current read are within the same transaction.
@@ -555,11 +601,16 @@ func mvccGetInternal(engine Engine, key proto.Key, kv proto.RawKeyValue, timesta
 
 	// Unmarshal the mvcc value.
 	value := &proto.MVCCValue{}
-	if err := gogoproto.Unmarshal(kv.Value, value); err != nil {
+	if err := decodeMVCCValue(kv.Value, meta.Raw, value); err != nil {
 		return nil, err
 	}
-	// Set the timestamp if the value is not nil (i.e. not a deletion tombstone).
+	// Set the timestamp if the value is not nil (i.e. not a deletion
+	// tombstone), after verifying its checksum to detect corruption.
 	if value.Value != nil {
+		if err := value.Value.Verify(key); err != nil {
+			log.Errorf("corrupted value at key %q: %s", key, err)
+			return nil, &proto.ChecksumMismatchError{Key: key}
+		}
 		value.Value.Timestamp = &ts
 	} else if !value.Deleted {
 		// Sanity check.
@@ -569,6 +620,118 @@ func mvccGetInternal(engine Engine, key proto.Key, kv proto.RawKeyValue, timesta
 	return value.Value, nil
 }
 
+// An MVCCVersion is a single committed version of a key.
+type MVCCVersion struct {
+	Timestamp proto.Timestamp
+	Deleted   bool         // True for a deletion tombstone
+	Value     *proto.Value // Nil if Deleted is true
+}
+
+// MVCCGetHistory returns the committed versions of key, newest first.
+// If before is non-zero, only versions with timestamps strictly older
+// than it are returned; callers page through a long history by passing
+// the timestamp of the oldest version previously returned. If
+// maxVersions is non-zero, iteration stops once that many versions
+// have been collected. An uncommitted write intent at the head of the
+// version chain is skipped. An inline value has no history and is
+// returned as a single version with a zero timestamp.
+func MVCCGetHistory(engine Engine, key proto.Key, before proto.Timestamp, maxVersions int64) ([]MVCCVersion, error) {
+	if len(key) == 0 {
+		return nil, emptyKeyError()
+	}
+	metaKey := MVCCEncodeKey(key)
+	meta := &proto.MVCCMetadata{}
+	ok, _, _, err := GetProto(engine, metaKey, meta)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if meta.IsInline() {
+		return []MVCCVersion{{Value: meta.Value}}, nil
+	}
+
+	start := metaKey.Next()
+	if meta.Txn != nil && !meta.Lock {
+		start = MVCCEncodeVersionKey(key, meta.Timestamp).Next()
+	}
+	if !before.Equal(proto.ZeroTimestamp) {
+		// Versions sort newest first, so those older than before follow
+		// its version key.
+		if boundStart := MVCCEncodeVersionKey(key, before).Next(); start.Less(boundStart) {
+			start = boundStart
+		}
+	}
+	var versions []MVCCVersion
+	err = engine.Iterate(start, MVCCEncodeKey(key.Next()), func(kv proto.RawKeyValue) (bool, error) {
+		_, ts, isValue := MVCCDecodeKey(kv.Key)
+		if !isValue {
+			return false, util.Errorf("expected versioned value reading history of key %q; got %q", key, kv.Key)
+		}
+		value := &proto.MVCCValue{}
+		if err := decodeMVCCValue(kv.Value, meta.Raw, value); err != nil {
+			return false, err
+		}
+		if value.Value != nil {
+			value.Value.Timestamp = &ts
+		}
+		versions = append(versions, MVCCVersion{Timestamp: ts, Deleted: value.Deleted, Value: value.Value})
+		return maxVersions != 0 && int64(len(versions)) == maxVersions, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// rawValuePrefixes holds the key prefixes whose versioned values are
+// stored as raw bytes rather than wrapped in an encoded MVCCValue,
+// making them readable by external tools. The encoding chosen when a
+// key is first written is recorded in its MVCCMetadata, so reads
+// decode correctly regardless of later changes to the prefix set.
+var rawValuePrefixes struct {
+	sync.RWMutex
+	prefixes []proto.Key
+}
+
+// RegisterRawValuePrefix designates that versioned values for keys
+// with the specified prefix be stored as raw bytes. Raw-encoded
+// values may contain only non-empty byte slices; zero-length raw
+// values are reserved for deletion tombstones.
+func RegisterRawValuePrefix(prefix proto.Key) {
+	rawValuePrefixes.Lock()
+	defer rawValuePrefixes.Unlock()
+	rawValuePrefixes.prefixes = append(rawValuePrefixes.prefixes, prefix)
+}
+
+// isRawValueKey returns whether key matches one of the registered
+// raw value prefixes.
+func isRawValueKey(key proto.Key) bool {
+	rawValuePrefixes.RLock()
+	defer rawValuePrefixes.RUnlock()
+	for _, prefix := range rawValuePrefixes.prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeMVCCValue decodes the versioned value data into value. If raw
+// is true, data holds the value's bytes directly, with a zero-length
+// value indicating a deletion tombstone; otherwise, data is an
+// encoded MVCCValue.
+func decodeMVCCValue(data []byte, raw bool, value *proto.MVCCValue) error {
+	if !raw {
+		return gogoproto.Unmarshal(data, value)
+	}
+	value.Reset()
+	if len(data) == 0 {
+		value.Deleted = true
+	} else {
+		value.Value = &proto.Value{Bytes: data}
+	}
+	return nil
+}
+
 // putBuffer holds pointer data needed by mvccPutInternal. Bundling
 // this data into a single structure reduces memory
 // allocations. Managing this temporary buffer using a sync.Pool
@@ -609,6 +772,11 @@ func MVCCPut(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timest
 
 	buf := putBufferPool.Get().(*putBuffer)
 	buf.pvalue = value
+	// Versioned values are stored with a checksum, verified on read.
+	// Inline values are excluded, as merges would invalidate it.
+	if !timestamp.Equal(proto.ZeroTimestamp) {
+		buf.pvalue.InitChecksum(key)
+	}
 	buf.value.Reset()
 	buf.value.Value = &buf.pvalue
 
@@ -620,6 +788,32 @@ func MVCCPut(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timest
 	return err
 }
 
+// MVCCCoalescePut is a non-transactional put which, rather than adding
+// a new version, replaces the key's latest version if that is a live,
+// committed value older than timestamp. It bounds the versions
+// accumulated by a key which is rewritten at a high rate, and is only
+// safe if the latest version hasn't been read at or after its
+// timestamp. Otherwise it behaves as MVCCPut.
+func MVCCCoalescePut(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp,
+	value proto.Value) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	metaKey := MVCCEncodeKey(key)
+	meta := &proto.MVCCMetadata{}
+	ok, _, _, err := GetProto(engine, metaKey, meta)
+	if err != nil {
+		return err
+	}
+	if ok && meta.Txn == nil && !meta.Deleted && !meta.IsInline() && meta.Timestamp.Less(timestamp) {
+		if err := engine.Clear(mvccEncodeTimestamp(metaKey, meta.Timestamp)); err != nil {
+			return err
+		}
+		ms.updateStatsOnCoalesce(key, meta, timestamp.WallTime/1E9-meta.Timestamp.WallTime/1E9)
+	}
+	return MVCCPut(engine, ms, key, timestamp, value, nil)
+}
+
 // MVCCDelete marks the key deleted so that it will not be returned in
 // future get responses.
 func MVCCDelete(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp,
@@ -636,6 +830,49 @@ func MVCCDelete(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Tim
 	return err
 }
 
+// MVCCLock lays a read lock on key for txn. Until the lock is
+// released by resolving it as an intent of txn, writes to the key by
+// other transactions, or outside of a transaction, fail with a
+// WriteIntentError. Reads are unaffected. Locking is a noop if txn
+// already holds an intent or lock on the key; if another transaction
+// does, a WriteIntentError is returned.
+func MVCCLock(engine Engine, ms *MVCCStats, key proto.Key, txn *proto.Transaction) error {
+	if len(key) == 0 {
+		return emptyKeyError()
+	}
+	if txn == nil {
+		return util.Error("no txn specified")
+	}
+	metaKey := MVCCEncodeKey(key)
+	meta := &proto.MVCCMetadata{}
+	ok, origMetaKeySize, origMetaValSize, err := GetProto(engine, metaKey, meta)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if meta.IsInline() {
+			return util.Errorf("cannot lock key %q with inline value", key)
+		}
+		if meta.Txn != nil {
+			if !bytes.Equal(meta.Txn.ID, txn.ID) {
+				return &proto.WriteIntentError{Key: key, Txn: *meta.Txn}
+			}
+			return nil
+		}
+	} else {
+		// Lock a key without versions as though it were deleted.
+		meta.Deleted = true
+		meta.Raw = isRawValueKey(key)
+	}
+	meta.Txn, meta.Lock = txn, true
+	metaKeySize, metaValSize, err := PutProto(engine, metaKey, meta)
+	if err != nil {
+		return err
+	}
+	ms.updateStatsOnLock(key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize, meta)
+	return nil
+}
+
 // mvccPutInternal adds a new timestamped value to the specified key.
 // If value is nil, creates a deletion tombstone value.
 func mvccPutInternal(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp,
@@ -660,6 +897,15 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key proto.Key, timestamp prot
 	if ok && putIsInline != meta.IsInline() {
 		return util.Errorf("put is inline=%t, but existing value is inline=%t", putIsInline, meta.IsInline())
 	}
+	// Verify raw-encoded keys store only non-empty byte values and
+	// aren't mixed with MVCCValue-encoded versions.
+	putIsRaw := !putIsInline && isRawValueKey(key)
+	if ok && !putIsInline && putIsRaw != meta.Raw {
+		return util.Errorf("put is raw=%t, but existing value is raw=%t", putIsRaw, meta.Raw)
+	}
+	if putIsRaw && !value.Deleted && (value.Value.Integer != nil || len(value.Value.Bytes) == 0) {
+		return util.Errorf("raw-encoded key %q requires a non-empty byte value: %+v", key, value)
+	}
 	if putIsInline {
 		var metaKeySize, metaValSize int64
 		if value.Deleted {
@@ -672,6 +918,22 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key proto.Key, timestamp prot
 		return err
 	}
 
+	// A read lock held by txn is upgraded to a write intent by its
+	// write, rather than conflicting with it. If the key has no
+	// versions, the lock's metadata is discounted and the write
+	// proceeds as though the key were absent; deleting such a key
+	// leaves the lock in place.
+	if ok && meta.Lock && txn != nil && bytes.Equal(meta.Txn.ID, txn.ID) {
+		if meta.KeyBytes == 0 && value.Deleted {
+			return nil
+		}
+		meta.Txn, meta.Lock = nil, false
+		if meta.KeyBytes == 0 {
+			ms.updateStatsOnLock(key, origMetaKeySize, origMetaValSize, 0, 0, meta)
+			ok, origMetaKeySize, origMetaValSize = false, 0, 0
+		}
+	}
+
 	var newMeta *proto.MVCCMetadata
 	// In case the key metadata exists.
 	if ok {
@@ -699,7 +961,7 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key proto.Key, timestamp prot
 				engine.Clear(versionKey)
 			}
 			newMeta = &buf.newMeta
-			*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp}
+			*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp, Raw: putIsRaw}
 		} else if timestamp.Less(meta.Timestamp) && meta.Txn == nil {
 			// If we receive a Put request to write before an already-
 			// committed version, send write tool old error.
@@ -716,7 +978,7 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key proto.Key, timestamp prot
 		// Create key metadata.
 		meta = nil
 		newMeta = &buf.newMeta
-		*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp}
+		*newMeta = proto.MVCCMetadata{Txn: txn, Timestamp: timestamp, Raw: putIsRaw}
 	}
 
 	// Make sure to zero the redundant timestamp (timestamp is encoded
@@ -727,7 +989,16 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key proto.Key, timestamp prot
 
 	// The metaKey is always the prefix of the versionKey.
 	versionKey := mvccEncodeTimestamp(metaKey, timestamp)
-	_, valueSize, err := PutProto(engine, versionKey, &buf.value)
+	var valueSize int64
+	if putIsRaw {
+		var data []byte
+		if !value.Deleted {
+			data = value.Value.Bytes
+		}
+		valueSize, err = int64(len(data)), engine.Put(versionKey, data)
+	} else {
+		_, valueSize, err = PutProto(engine, versionKey, &buf.value)
+	}
 	if err != nil {
 		return err
 	}
@@ -751,6 +1022,15 @@ func mvccPutInternal(engine Engine, ms *MVCCStats, key proto.Key, timestamp prot
 // an "integer" type, increments it by inc and stores the new
 // value. The newly incremented value is returned.
 func MVCCIncrement(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction, inc int64) (int64, error) {
+	return MVCCIncrementWithBounds(engine, ms, key, timestamp, txn, inc, 0, nil)
+}
+
+// MVCCIncrementWithBounds is like MVCCIncrement, but a missing key is
+// taken to hold initial instead of zero and, if max is not nil, an
+// increment which would produce a value greater than *max returns an
+// error without modifying the value.
+func MVCCIncrementWithBounds(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction,
+	inc, initial int64, max *int64) (int64, error) {
 	// Handle check for non-existence of key. In order to detect
 	// the potential write intent by another concurrent transaction
 	// with a newer timestamp, we need to use the max timestamp
@@ -760,7 +1040,7 @@ func MVCCIncrement(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.
 		return 0, err
 	}
 
-	var int64Val int64
+	int64Val := initial
 	// If the value exists, verify it's an integer type not a byte slice.
 	if value != nil {
 		if value.Bytes != nil || value.Integer == nil {
@@ -780,6 +1060,9 @@ func MVCCIncrement(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.
 	}
 
 	r := int64Val + inc
+	if max != nil && r > *max {
+		return 0, util.Errorf("key %q with value %d incremented by %d exceeds maximum %d", key, int64Val, inc, *max)
+	}
 	value = &proto.Value{Integer: gogoproto.Int64(r)}
 	value.InitChecksum(key)
 	return r, MVCCPut(engine, ms, key, timestamp, *value, txn)
@@ -790,6 +1073,27 @@ func MVCCIncrement(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.
 // containing the actual value.
 func MVCCConditionalPut(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp, value proto.Value,
 	expValue *proto.Value, txn *proto.Transaction) error {
+	if err := mvccVerifyExpectedValue(engine, key, expValue, txn); err != nil {
+		return err
+	}
+	return MVCCPut(engine, ms, key, timestamp, value, txn)
+}
+
+// MVCCConditionalDelete marks the key deleted only if the expected
+// value matches, under the same conditions as MVCCConditionalPut. If
+// not, it returns a ConditionFailedError containing the actual value.
+func MVCCConditionalDelete(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp,
+	expValue *proto.Value, txn *proto.Transaction) error {
+	if err := mvccVerifyExpectedValue(engine, key, expValue, txn); err != nil {
+		return err
+	}
+	return MVCCDelete(engine, ms, key, timestamp, txn)
+}
+
+// mvccVerifyExpectedValue returns a ConditionFailedError containing
+// the actual value of key if it doesn't match expValue. A nil
+// expValue expects the key not to exist.
+func mvccVerifyExpectedValue(engine Engine, key proto.Key, expValue *proto.Value, txn *proto.Transaction) error {
 	// Handle check for non-existence of key. In order to detect
 	// the potential write intent by another concurrent transaction
 	// with a newer timestamp, we need to use the max timestamp
@@ -817,8 +1121,7 @@ func MVCCConditionalPut(engine Engine, ms *MVCCStats, key proto.Key, timestamp p
 			}
 		}
 	}
-
-	return MVCCPut(engine, ms, key, timestamp, value, txn)
+	return nil
 }
 
 // MVCCMerge implements a merge operation. Merge adds integer values,
@@ -865,6 +1168,16 @@ func MVCCDeleteRange(engine Engine, ms *MVCCStats, key, endKey proto.Key, max in
 	return num, nil
 }
 
+// MVCCScanStats accumulates iteration statistics for a scan, useful
+// for gauging the amplification of reads over keys with many versions.
+type MVCCScanStats struct {
+	KeysExamined       int64 // Distinct keys (MVCC metadata) examined
+	VersionsExamined   int64 // MVCC versions examined
+	VersionsSkipped    int64 // Versions examined which weren't returned
+	IntentsEncountered int64 // Write intents examined
+	TombstonesSkipped  int64 // Keys skipped for lack of a live value, e.g. deletion tombstones
+}
+
 // MVCCScan scans the key range specified by start key through end key
 // up to some maximum number of results. Specify max=0 for unbounded
 // scans.
@@ -910,6 +1223,155 @@ func MVCCScan(engine Engine, key, endKey proto.Key, max int64, timestamp proto.T
 	}
 }
 
+// MVCCScanWithStats is like MVCCScan, but additionally accumulates
+// iteration statistics into stats. Counting versions requires stepping
+// through each scanned key's version history, so MVCCScan should be
+// preferred where statistics aren't needed.
+func MVCCScanWithStats(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	txn *proto.Transaction, stats *MVCCScanStats) ([]proto.KeyValue, error) {
+	return mvccScanInternal(engine, key, endKey, max, timestamp, txn, stats, true)
+}
+
+// MVCCScanCountTombstones is like MVCCScan, but additionally counts
+// the keys skipped for lack of a live value into
+// stats.TombstonesSkipped. Unlike MVCCScanWithStats, it doesn't step
+// through each scanned key's version history, and it leaves the other
+// statistics untouched.
+func MVCCScanCountTombstones(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	txn *proto.Transaction, stats *MVCCScanStats) ([]proto.KeyValue, error) {
+	return mvccScanInternal(engine, key, endKey, max, timestamp, txn, stats, false)
+}
+
+// mvccScanInternal implements MVCCScanWithStats and
+// MVCCScanCountTombstones, counting each key's versions only if
+// countVersions is true.
+func mvccScanInternal(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	txn *proto.Transaction, stats *MVCCScanStats, countVersions bool) ([]proto.KeyValue, error) {
+	if len(endKey) == 0 {
+		return nil, emptyKeyError()
+	}
+	encKey := MVCCEncodeKey(key)
+	encEndKey := MVCCEncodeKey(endKey)
+
+	// Get a new iterator and define our getEarlierFunc using iter.Seek.
+	iter := engine.NewIterator()
+	defer iter.Close()
+	earlier := func(engine Engine, start, end proto.EncodedKey) (proto.RawKeyValue, error) {
+		iter.Seek(start)
+		if iter.Valid() && bytes.Compare(iter.Key(), end) < 0 {
+			return proto.RawKeyValue{Key: iter.Key(), Value: iter.Value()}, nil
+		}
+		return proto.RawKeyValue{}, iter.Error()
+	}
+
+	res := []proto.KeyValue{}
+	for {
+		kv, err := earlier(engine, encKey, encEndKey)
+		if err != nil || kv.Value == nil {
+			return res, err
+		}
+		key, _, isValue := MVCCDecodeKey(kv.Key)
+		if isValue {
+			return nil, util.Errorf("expected an MVCC metadata key: %q", kv.Key)
+		}
+		var versions int64
+		if countVersions {
+			versions = stats.countVersions(iter, kv)
+		}
+		value, err := mvccGetInternal(engine, key, kv, timestamp, txn, earlier)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			if versions > 0 {
+				// One of the examined versions was returned.
+				stats.VersionsSkipped--
+			}
+			res = append(res, proto.KeyValue{Key: key, Value: *value})
+			if max != 0 && max == int64(len(res)) {
+				return res, nil
+			}
+		} else {
+			stats.TombstonesSkipped++
+		}
+		encKey = MVCCEncodeKey(key.Next())
+	}
+}
+
+// MVCCReverseScan scans the key range specified by start key through
+// end key in descending order up to some maximum number of results.
+// As with MVCCScan, the span includes key and excludes endKey. Each
+// step seeks backwards from the previously returned key, so a reverse
+// scan costs a reverse seek per key examined.
+func MVCCReverseScan(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	txn *proto.Transaction) ([]proto.KeyValue, error) {
+	if len(endKey) == 0 {
+		return nil, emptyKeyError()
+	}
+	encEndKey := MVCCEncodeKey(endKey)
+
+	iter := engine.NewIterator()
+	defer iter.Close()
+	earlier := func(engine Engine, start, end proto.EncodedKey) (proto.RawKeyValue, error) {
+		iter.Seek(start)
+		if iter.Valid() && bytes.Compare(iter.Key(), end) < 0 {
+			return proto.RawKeyValue{Key: iter.Key(), Value: iter.Value()}, nil
+		}
+		return proto.RawKeyValue{}, iter.Error()
+	}
+
+	res := []proto.KeyValue{}
+	for {
+		// The reverse seek lands on the oldest version of the last key
+		// preceding encEndKey, or on its metadata if it has no versions.
+		iter.SeekReverse(encEndKey)
+		if !iter.Valid() {
+			return res, iter.Error()
+		}
+		curKey, _, _ := MVCCDecodeKey(iter.Key())
+		if curKey.Less(key) {
+			return res, nil
+		}
+		metaKey := MVCCEncodeKey(curKey)
+		encEndKey = metaKey
+		data, err := engine.Get(metaKey)
+		if err != nil {
+			return nil, err
+		}
+		if data == nil {
+			continue
+		}
+		value, err := mvccGetInternal(engine, curKey, proto.RawKeyValue{Key: metaKey, Value: data}, timestamp, txn, earlier)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			res = append(res, proto.KeyValue{Key: curKey, Value: *value})
+			if max != 0 && max == int64(len(res)) {
+				return res, nil
+			}
+		}
+	}
+}
+
+// countVersions updates the statistics for the MVCC metadata key/value
+// kv, stepping iter through all of the key's versions, and returns the
+// number of versions. All versions are initially counted as skipped.
+func (stats *MVCCScanStats) countVersions(iter Iterator, kv proto.RawKeyValue) int64 {
+	stats.KeysExamined++
+	meta := &proto.MVCCMetadata{}
+	if err := gogoproto.Unmarshal(kv.Value, meta); err == nil && meta.Txn != nil {
+		stats.IntentsEncountered++
+	}
+	var versions int64
+	for iter.Seek(kv.Key.Next()); iter.Valid() && bytes.HasPrefix(iter.Key(), kv.Key); iter.Next() {
+		versions++
+	}
+	stats.VersionsExamined += versions
+	stats.VersionsSkipped += versions
+	return versions
+}
+
 // MVCCIterateCommitted iterates over the key range specified by start
 // and end keys, returning only the most recently committed version of
 // each key/value pair. Intents are ignored. If a key has an intent
@@ -923,6 +1385,7 @@ func MVCCIterateCommitted(engine Engine, key, endKey proto.Key, f func(proto.Key
 
 	var currentKey proto.Key        // The current unencoded key
 	var versionKey proto.EncodedKey // Need to read this version of the key
+	var raw bool                    // Whether currentKey's versions are raw-encoded
 	nextKey := encKey               // The next key--no additional versions of currentKey past this
 	return engine.Iterate(encKey, encEndKey, func(rawKV proto.RawKeyValue) (bool, error) {
 		if bytes.Compare(nextKey, rawKV.Key) <= 0 {
@@ -936,12 +1399,13 @@ func MVCCIterateCommitted(engine Engine, key, endKey proto.Key, f func(proto.Key
 				return false, err
 			}
 			nextKey = MVCCEncodeKey(currentKey.Next())
+			raw = meta.Raw
 			if meta.IsInline() {
 				versionKey = nextKey
 				return f(proto.KeyValue{Key: currentKey, Value: *meta.Value})
 			}
 			// If most recent value isn't an intent, the key to read will be next in iteration.
-			if meta.Txn == nil {
+			if meta.Txn == nil || meta.Lock {
 				versionKey = rawKV.Key
 			} else {
 				// Otherwise, this is an intent; the version we want is one
@@ -960,7 +1424,7 @@ func MVCCIterateCommitted(engine Engine, key, endKey proto.Key, f func(proto.Key
 					return false, util.Errorf("expected an MVCC value at key %q", rawKV.Key)
 				}
 				value := &proto.MVCCValue{}
-				if err := gogoproto.Unmarshal(rawKV.Value, value); err != nil {
+				if err := decodeMVCCValue(rawKV.Value, raw, value); err != nil {
 					return false, err
 				}
 				if value.Deleted {
@@ -974,6 +1438,37 @@ func MVCCIterateCommitted(engine Engine, key, endKey proto.Key, f func(proto.Key
 	})
 }
 
+// MVCCIterateIntents iterates over the key range specified by start
+// and end keys, invoking f() with the key and MVCC metadata of each
+// write intent encountered. Committed values are skipped. If f
+// returns true (done) or an error, the iteration stops and the error
+// is propagated.
+func MVCCIterateIntents(engine Engine, key, endKey proto.Key, f func(proto.Key, *proto.MVCCMetadata) (bool, error)) error {
+	encKey := MVCCEncodeKey(key)
+	encEndKey := MVCCEncodeKey(endKey)
+
+	iter := engine.NewIterator()
+	defer iter.Close()
+	for iter.Seek(encKey); iter.Valid() && bytes.Compare(iter.Key(), encEndKey) < 0; {
+		currentKey, _, isValue := MVCCDecodeKey(iter.Key())
+		if isValue {
+			return util.Errorf("expected an MVCC metadata key: %q", iter.Key())
+		}
+		meta := &proto.MVCCMetadata{}
+		if err := gogoproto.Unmarshal(iter.Value(), meta); err != nil {
+			return err
+		}
+		if meta.Txn != nil {
+			if done, err := f(currentKey, meta); done || err != nil {
+				return err
+			}
+		}
+		// Skip past all versions of the current key.
+		iter.Seek(MVCCEncodeKey(currentKey.Next()))
+	}
+	return iter.Error()
+}
+
 // MVCCResolveWriteIntent either commits or aborts (rolls back) an
 // extant write intent for a given txn according to commit parameter.
 // ResolveWriteIntent will skip write intents of other txns.
@@ -994,27 +1489,72 @@ func MVCCIterateCommitted(engine Engine, key, endKey proto.Key, f func(proto.Key
 // committed in the event the transaction succeeds (all those with
 // epoch matching the commit epoch), and which intents get aborted,
 // even if the transaction succeeds.
-func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction) error {
+//
+// Returns true if a write intent, as opposed to a read lock, was
+// committed, in which case the key has a newly committed value.
+func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timestamp proto.Timestamp, txn *proto.Transaction) (bool, error) {
 	if len(key) == 0 {
-		return emptyKeyError()
+		return false, emptyKeyError()
 	}
 	if txn == nil {
-		return util.Error("no txn specified")
+		return false, util.Error("no txn specified")
 	}
 
 	metaKey := MVCCEncodeKey(key)
-	meta := &proto.MVCCMetadata{}
-	ok, origMetaKeySize, origMetaValSize, err := GetProto(engine, metaKey, meta)
+	intent := mvccIntent{key: key}
+	ok, origMetaKeySize, origMetaValSize, err := GetProto(engine, metaKey, &intent.meta)
 	if err != nil {
-		return err
+		return false, err
 	}
 	// For cases where there's no write intent to resolve, or one exists
 	// which we can't resolve, this is a noop.
-	if !ok || meta.Txn == nil || !bytes.Equal(meta.Txn.ID, txn.ID) {
-		return nil
+	if !ok || intent.meta.Txn == nil || !bytes.Equal(intent.meta.Txn.ID, txn.ID) {
+		return false, nil
 	}
+	intent.metaKeySize, intent.metaValSize = origMetaKeySize, origMetaValSize
+	return mvccResolveIntent(engine, ms, &intent, timestamp, txn, func() (*proto.RawKeyValue, error) {
+		// Scan from the version following the intent value to the last
+		// possible mvcc value for this key.
+		nextKey := MVCCEncodeVersionKey(key, intent.meta.Timestamp).Next()
+		endScanKey := MVCCEncodeKey(key.Next())
+		kvs, err := Scan(engine, nextKey, endScanKey, 1)
+		if err != nil || len(kvs) == 0 {
+			return nil, err
+		}
+		return &kvs[0], nil
+	})
+}
+
+// An mvccIntent holds the metadata of a write intent, read in
+// preparation for resolving it, along with the encoded sizes of the
+// metadata key and value for stat accounting.
+type mvccIntent struct {
+	key                      proto.Key
+	meta                     proto.MVCCMetadata
+	metaKeySize, metaValSize int64
+}
+
+// mvccResolveIntent commits, pushes or aborts the write intent
+// described by intent, which must belong to txn. The prev function
+// is invoked only if the intent is aborted, and returns the next
+// older version of the key, or nil if there is none. Returns true if
+// a write intent was committed.
+func mvccResolveIntent(engine Engine, ms *MVCCStats, intent *mvccIntent, timestamp proto.Timestamp,
+	txn *proto.Transaction, prev func() (*proto.RawKeyValue, error)) (bool, error) {
+	key, meta := intent.key, &intent.meta
+	metaKey := MVCCEncodeKey(key)
+	origMetaKeySize, origMetaValSize := intent.metaKeySize, intent.metaValSize
 	origAgeSeconds := timestamp.WallTime/1E9 - meta.Timestamp.WallTime/1E9
 
+	// A read lock is kept while the transaction is pending in the same
+	// epoch and is otherwise released, whether committing or aborting.
+	if meta.Lock {
+		if txn.Status == proto.PENDING && meta.Txn.Epoch == txn.Epoch {
+			return false, nil
+		}
+		return false, mvccReleaseLock(engine, ms, intent)
+	}
+
 	// If we're committing, or if the commit timestamp of the intent has
 	// been moved forward, and if the proposed epoch matches the existing
 	// epoch: update the meta.Txn. For commit, it's set to nil;
@@ -1034,7 +1574,7 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timesta
 		}
 		metaKeySize, metaValSize, err := PutProto(engine, metaKey, &newMeta)
 		if err != nil {
-			return err
+			return false, err
 		}
 
 		// Update stat counters related to resolving the intent.
@@ -1049,63 +1589,60 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timesta
 			newKey := MVCCEncodeVersionKey(key, txn.Timestamp)
 			valBytes, err := engine.Get(origKey)
 			if err != nil {
-				return err
+				return false, err
 			}
 			engine.Clear(origKey)
 			engine.Put(newKey, valBytes)
 		}
-		return nil
+		return commit, nil
 	}
 
 	// This method shouldn't be called with this instance, but there's
 	// nothing to do if the epochs match and the state is still PENDING.
 	if txn.Status == proto.PENDING && meta.Txn.Epoch == txn.Epoch {
-		return nil
+		return false, nil
 	}
 
 	// Otherwise, we're deleting the intent. We must find the next
 	// versioned value and reset the metadata's latest timestamp. If
 	// there are no other versioned values, we delete the metadata
 	// key.
+	prevKV, err := prev()
+	if err != nil {
+		return false, err
+	}
 
 	// First clear the intent value.
 	latestKey := MVCCEncodeVersionKey(key, meta.Timestamp)
 	engine.Clear(latestKey)
 
-	// Compute the next possible mvcc value for this key.
-	nextKey := latestKey.Next()
-	// Compute the last possible mvcc value for this key.
-	endScanKey := MVCCEncodeKey(key.Next())
-	kvs, err := Scan(engine, nextKey, endScanKey, 1)
-	if err != nil {
-		return err
-	}
 	// If there is no other version, we should just clean up the key entirely.
-	if len(kvs) == 0 {
+	if prevKV == nil {
 		engine.Clear(metaKey)
 		// Clear stat counters attributable to the intent we're aborting.
 		ms.updateStatsOnAbort(key, origMetaKeySize, origMetaValSize, 0, 0, meta, nil, origAgeSeconds, 0)
 	} else {
-		_, ts, isValue := MVCCDecodeKey(kvs[0].Key)
+		_, ts, isValue := MVCCDecodeKey(prevKV.Key)
 		if !isValue {
-			return util.Errorf("expected an MVCC value key: %s", kvs[0].Key)
+			return false, util.Errorf("expected an MVCC value key: %s", prevKV.Key)
 		}
-		// Get the bytes for the next version so we have size for stat counts.
+		// Decode the next version so we have size for stat counts.
 		value := proto.MVCCValue{}
-		ok, _, valueSize, err := GetProto(engine, kvs[0].Key, &value)
-		if err != nil || !ok {
-			return util.Errorf("unable to fetch previous version for key %q (%t): %s", kvs[0].Key, ok, err)
+		if err := decodeMVCCValue(prevKV.Value, meta.Raw, &value); err != nil {
+			return false, util.Errorf("unable to decode previous version for key %q: %s", prevKV.Key, err)
 		}
+		valueSize := int64(len(prevKV.Value))
 		// Update the keyMetadata with the next version.
 		newMeta := &proto.MVCCMetadata{
 			Timestamp: ts,
 			Deleted:   value.Deleted,
 			KeyBytes:  mvccVersionTimestampSize,
 			ValBytes:  valueSize,
+			Raw:       meta.Raw,
 		}
 		metaKeySize, metaValSize, err := PutProto(engine, metaKey, newMeta)
 		if err != nil {
-			return err
+			return false, err
 		}
 		restoredAgeSeconds := timestamp.WallTime/1E9 - ts.WallTime/1E9
 
@@ -1113,53 +1650,139 @@ func MVCCResolveWriteIntent(engine Engine, ms *MVCCStats, key proto.Key, timesta
 		ms.updateStatsOnAbort(key, origMetaKeySize, origMetaValSize, metaKeySize, metaValSize, meta, newMeta, origAgeSeconds, restoredAgeSeconds)
 	}
 
+	return false, nil
+}
+
+// mvccReleaseLock releases the read lock described by intent,
+// restoring the key's metadata, or removing it if the key has no
+// versions.
+func mvccReleaseLock(engine Engine, ms *MVCCStats, intent *mvccIntent) error {
+	metaKey := MVCCEncodeKey(intent.key)
+	newMeta := intent.meta
+	newMeta.Txn, newMeta.Lock = nil, false
+	var metaKeySize, metaValSize int64
+	var err error
+	if newMeta.KeyBytes == 0 {
+		err = engine.Clear(metaKey)
+	} else {
+		metaKeySize, metaValSize, err = PutProto(engine, metaKey, &newMeta)
+	}
+	if err != nil {
+		return err
+	}
+	ms.updateStatsOnLock(intent.key, intent.metaKeySize, intent.metaValSize, metaKeySize, metaValSize, &newMeta)
 	return nil
 }
 
 // MVCCResolveWriteIntentRange commits or aborts (rolls back) the
 // range of write intents specified by start and end keys for a given
 // txn. ResolveWriteIntentRange will skip write intents of other
-// txns. Specify max=0 for unbounded resolves.
-func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey proto.Key, max int64, timestamp proto.Timestamp, txn *proto.Transaction) (int64, error) {
+// txns. Specify max=0 for unbounded resolves. Returns the number of
+// intents resolved and the keys of the write intents committed.
+//
+// The intents belonging to txn are first collected in key order by a
+// single forward pass of one iterator, which also captures the
+// version preceding each intent in case it must be restored on
+// abort. The intents are then resolved in order without further
+// iteration, and stat counters are updated once at the end.
+func MVCCResolveWriteIntentRange(engine Engine, ms *MVCCStats, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	txn *proto.Transaction) (int64, []proto.Key, error) {
 	if txn == nil {
-		return 0, util.Error("no txn specified")
+		return 0, nil, util.Error("no txn specified")
 	}
 
-	encKey := MVCCEncodeKey(key)
-	encEndKey := MVCCEncodeKey(endKey)
-	nextKey := encKey
-
-	num := int64(0)
-	for {
-		kvs, err := Scan(engine, nextKey, encEndKey, 1)
+	intents, prevs, num, err := mvccCollectIntents(engine, key, endKey, max, txn)
+	if err != nil {
+		return 0, nil, err
+	}
+	sort.Sort(intents)
+
+	var delta MVCCStats
+	var committed []proto.Key
+	for i := range intents {
+		intent := &intents[i]
+		prevKV := prevs[string(intent.key)]
+		ok, err := mvccResolveIntent(engine, &delta, intent, timestamp, txn, func() (*proto.RawKeyValue, error) {
+			return prevKV, nil
+		})
 		if err != nil {
-			return num, err
+			log.Warningf("failed to resolve intent for key %q: %v", intent.key, err)
+			num--
+		} else if ok {
+			committed = append(committed, intent.key)
 		}
-		// No more keys exists in the given range.
-		if len(kvs) == 0 {
+	}
+	if ms != nil {
+		ms.Accumulate(delta)
+	}
+
+	return num, committed, nil
+}
+
+// mvccIntents implements sort.Interface, ordering intents by key.
+type mvccIntents []mvccIntent
+
+func (mi mvccIntents) Len() int           { return len(mi) }
+func (mi mvccIntents) Swap(i, j int)      { mi[i], mi[j] = mi[j], mi[i] }
+func (mi mvccIntents) Less(i, j int) bool { return mi[i].key.Less(mi[j].key) }
+
+// mvccCollectIntents scans up to max (0 for unbounded) keys in
+// [key, endKey) with a single iterator and returns the write intents
+// belonging to txn along with the number of keys scanned. For each
+// intent, the version immediately preceding the intent value, if
+// any, is returned in the map keyed by the intent's key.
+func mvccCollectIntents(engine Engine, key, endKey proto.Key, max int64, txn *proto.Transaction) (mvccIntents, map[string]*proto.RawKeyValue, int64, error) {
+	iter := engine.NewIterator()
+	defer iter.Close()
+
+	var intents mvccIntents
+	prevs := map[string]*proto.RawKeyValue{}
+	num := int64(0)
+	encEndKey := MVCCEncodeKey(endKey)
+	for iter.Seek(MVCCEncodeKey(key)); iter.Valid() && bytes.Compare(iter.Key(), encEndKey) < 0; {
+		if max != 0 && num == max {
 			break
 		}
-
-		currentKey, _, isValue := MVCCDecodeKey(kvs[0].Key)
+		num++
+		metaKey := iter.Key()
+		currentKey, _, isValue := MVCCDecodeKey(metaKey)
 		if isValue {
-			return 0, util.Errorf("expected an MVCC metadata key: %s", kvs[0].Key)
+			return nil, nil, 0, util.Errorf("expected an MVCC metadata key: %s", metaKey)
 		}
-		err = MVCCResolveWriteIntent(engine, ms, currentKey, timestamp, txn)
-		if err != nil {
-			log.Warningf("failed to resolve intent for key %q: %v", currentKey, err)
-		} else {
-			num++
-			if max != 0 && max == num {
-				break
+		intent := mvccIntent{
+			key:         currentKey,
+			metaKeySize: int64(len(metaKey)),
+			metaValSize: int64(len(iter.Value())),
+		}
+		if err := gogoproto.Unmarshal(iter.Value(), &intent.meta); err != nil {
+			return nil, nil, 0, util.Errorf("unable to decode MVCCMetadata for key %q: %s", currentKey, err)
+		}
+		if intent.meta.Txn != nil && bytes.Equal(intent.meta.Txn.ID, txn.ID) {
+			intents = append(intents, intent)
+			// The first version following the metadata is the intent
+			// value; the one after that, if it belongs to the same key,
+			// is the version to restore should the intent be aborted.
+			iter.Next()
+			if iter.Valid() {
+				iter.Next()
+			}
+			if iter.Valid() {
+				if k, _, isValue := MVCCDecodeKey(iter.Key()); isValue && k.Equal(currentKey) {
+					prevs[string(currentKey)] = &proto.RawKeyValue{
+						Key:   append([]byte(nil), iter.Key()...),
+						Value: append([]byte(nil), iter.Value()...),
+					}
+				}
 			}
 		}
-
-		// In order to efficiently skip the possibly long list of
-		// old versions for this key; refer to Scan for details.
-		nextKey = MVCCEncodeKey(currentKey.Next())
+		if err := iter.Error(); err != nil {
+			return nil, nil, 0, err
+		}
+		// Skip the possibly long list of old versions for this key;
+		// refer to Scan for details.
+		iter.Seek(MVCCEncodeKey(currentKey.Next()))
 	}
-
-	return num, nil
+	return intents, prevs, num, iter.Error()
 }
 
 // MVCCGarbageCollect creates an iterator on the engine. In parallel
@@ -1258,11 +1881,58 @@ func isValidEncodedSplitKey(key proto.EncodedKey) bool {
 	return true
 }
 
+// SequentialSplitThreshold is the fraction of consecutive keys whose
+// latest versions must have strictly increasing timestamps for
+// MVCCFindSplitKey to consider a range's keys sequentially written.
+// Values greater than one disable sequential key detection.
+var SequentialSplitThreshold = 0.9
+
+// sequentialSplitMinKeys is the minimum number of keys for which
+// sequential key detection is attempted.
+const sequentialSplitMinKeys = 10
+
+// mvccDetectSequentialKeys scans the metadata keys in the encoded
+// span and reports whether they were written in key order, as with
+// keys prefixed by a timestamp or counter, along with the number of
+// keys scanned.
+func mvccDetectSequentialKeys(engine Engine, encStartKey, encEndKey proto.EncodedKey) (bool, int64, error) {
+	var keys, increasing int64
+	var lastTS proto.Timestamp
+	meta := &proto.MVCCMetadata{}
+	if err := engine.Iterate(encStartKey, encEndKey, func(kv proto.RawKeyValue) (bool, error) {
+		if _, _, isValue := MVCCDecodeKey(kv.Key); isValue {
+			return false, nil
+		}
+		if err := gogoproto.Unmarshal(kv.Value, meta); err != nil {
+			return false, err
+		}
+		if keys > 0 && lastTS.Less(meta.Timestamp) {
+			increasing++
+		}
+		lastTS = meta.Timestamp
+		keys++
+		return false, nil
+	}); err != nil {
+		return false, 0, err
+	}
+	if keys < sequentialSplitMinKeys {
+		return false, keys, nil
+	}
+	return float64(increasing) >= SequentialSplitThreshold*float64(keys-1), keys, nil
+}
+
 // MVCCFindSplitKey suggests a split key from the given user-space key
 // range that aims to roughly cut into half the total number of bytes
 // used (in raw key and value byte strings) in both subranges. Specify
 // a snapshot engine to safely invoke this method in a goroutine.
 //
+// If the keys were written sequentially (see SequentialSplitThreshold),
+// the most recently written keys at the high end of the range carry
+// its write load, and a split balanced by bytes tends to land among
+// them. Instead, the split key is chosen to cut the number of keys in
+// half, and prefixing keys with a hash bucket is suggested in the log
+// as the remedy for the hotspot.
+//
 // The split key will never be chosen from the key ranges listed in
 // illegalSplitKeyRanges.
 func MVCCFindSplitKey(engine Engine, raftID int64, key, endKey proto.Key) (proto.Key, error) {
@@ -1272,11 +1942,21 @@ func MVCCFindSplitKey(engine Engine, raftID int64, key, endKey proto.Key) (proto
 	encStartKey := MVCCEncodeKey(key)
 	encEndKey := MVCCEncodeKey(endKey)
 
+	sequential, keyCount, err := mvccDetectSequentialKeys(engine, encStartKey, encEndKey)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get range size from stats.
 	rangeSize, err := MVCCGetRangeSize(engine, raftID)
 	if err != nil {
 		return nil, err
 	}
+	if sequential {
+		log.Infof("keys in range %d (%q-%q) are written sequentially; consider prefixing them with a hash bucket "+
+			"to distribute writes", raftID, key, endKey)
+		rangeSize = keyCount
+	}
 
 	targetSize := rangeSize / 2
 	sizeSoFar := int64(0)
@@ -1300,9 +1980,14 @@ func MVCCFindSplitKey(engine Engine, raftID int64, key, endKey proto.Key) (proto
 		// Determine whether we've found best key and can exit iteration.
 		done := !bestSplitKey.Equal(encStartKey) && diff > bestSplitDiff
 
-		// Add this key/value to the size scanned so far.
+		// Add this key/value to the size scanned so far, or count the
+		// key if splitting sequential keys.
 		_, _, isValue := MVCCDecodeKey(kv.Key)
-		if isValue {
+		if sequential {
+			if !isValue {
+				sizeSoFar++
+			}
+		} else if isValue {
 			sizeSoFar += mvccVersionTimestampSize + int64(len(kv.Value))
 		} else {
 			sizeSoFar += int64(len(kv.Key) + len(kv.Value))
@@ -1323,6 +2008,31 @@ func MVCCFindSplitKey(engine Engine, raftID int64, key, endKey proto.Key) (proto
 	return humanKey, nil
 }
 
+// MVCCFindKeyBounds returns the smallest and largest keys in the span
+// [key, endKey) which have MVCC data, including deletion tombstones
+// not yet garbage collected. The bounds are found with a forward seek
+// from key and a reverse seek from endKey. Both keys are nil if the
+// span contains no data.
+func MVCCFindKeyBounds(engine Engine, key, endKey proto.Key) (proto.Key, proto.Key, error) {
+	encStartKey, encEndKey := MVCCEncodeKey(key), MVCCEncodeKey(endKey)
+	iter := engine.NewIterator()
+	defer iter.Close()
+	iter.Seek(encStartKey)
+	if !iter.Valid() || !iter.Key().Less(encEndKey) {
+		return nil, nil, iter.Error()
+	}
+	minKey, _, _ := MVCCDecodeKey(iter.Key())
+	iter.SeekReverse(encEndKey)
+	if !iter.Valid() {
+		if err := iter.Error(); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, util.Errorf("unable to seek to last key before %q", endKey)
+	}
+	maxKey, _, _ := MVCCDecodeKey(iter.Key())
+	return minKey, maxKey, nil
+}
+
 // MVCCComputeStats scans the underlying engine from start to end keys
 // and computes stats counters based on the values. This method is
 // used after a range is split to recompute stats for each
@@ -1372,7 +2082,7 @@ func MVCCComputeStats(engine Engine, key, endKey proto.Key, nowNanos int64) (MVC
 					// First value is deleted, so it's GC'able; add key & value bytes to age stat.
 					ms.GCBytesAge += totalBytes * (nowNanos/1E9 - meta.Timestamp.WallTime/1E9)
 				}
-				if meta.Txn != nil {
+				if meta.Txn != nil && !meta.Lock {
 					ms.IntentBytes += totalBytes
 					ms.IntentCount++
 					ms.IntentAge += nowNanos/1E9 - meta.Timestamp.WallTime/1E9
@@ -1396,6 +2106,45 @@ func MVCCComputeStats(engine Engine, key, endKey proto.Key, nowNanos int64) (MVC
 	return ms, err
 }
 
+// MVCCComputeChecksum returns a SHA-256 digest of the key/value pairs
+// visible from start to end keys at the specified timestamp, followed
+// by ms, if not nil. The pairs are digested in key order, so engines
+// holding the same data produce the same checksum. As with
+// MVCCComputeStats, local keys are excluded. Returns a
+// WriteIntentError if an intent is encountered at or below timestamp.
+func MVCCComputeChecksum(engine Engine, key, endKey proto.Key, timestamp proto.Timestamp, ms *MVCCStats) ([]byte, error) {
+	if key.Less(KeyLocalMax) {
+		key = KeyLocalMax
+	}
+	h := sha256.New()
+	writeBytes := func(b []byte) {
+		binary.Write(h, binary.BigEndian, int64(len(b)))
+		h.Write(b)
+	}
+	for key.Less(endKey) {
+		kvs, err := MVCCScan(engine, key, endKey, checksumScanBatchSize, timestamp, nil)
+		if err != nil {
+			return nil, err
+		}
+		for i := range kvs {
+			data, err := gogoproto.Marshal(&kvs[i].Value)
+			if err != nil {
+				return nil, err
+			}
+			writeBytes(kvs[i].Key)
+			writeBytes(data)
+		}
+		if len(kvs) < checksumScanBatchSize {
+			break
+		}
+		key = kvs[len(kvs)-1].Key.Next()
+	}
+	if ms != nil {
+		binary.Write(h, binary.BigEndian, ms)
+	}
+	return h.Sum(nil), nil
+}
+
 // MVCCEncodeKey makes an MVCC key for storing MVCC metadata or
 // for storing raw values directly. Use MVCCEncodeVersionValue for
 // storing timestamped version values.
@@ -127,7 +127,7 @@ func TestMVCCEmptyKey(t *testing.T) {
 	if _, err := MVCCScan(engine, testKey1, proto.Key{}, 0, makeTS(0, 1), nil); err == nil {
 		t.Error("expected empty key error")
 	}
-	if err := MVCCResolveWriteIntent(engine, nil, proto.Key{}, makeTS(0, 1), txn1); err == nil {
+	if _, err := MVCCResolveWriteIntent(engine, nil, proto.Key{}, makeTS(0, 1), txn1); err == nil {
 		t.Error("expected empty key error")
 	}
 }
@@ -218,6 +218,79 @@ func TestMVCCIncrement(t *testing.T) {
 	}
 }
 
+func TestMVCCIncrementWithBounds(t *testing.T) {
+	engine := createTestEngine()
+	newVal, err := MVCCIncrementWithBounds(engine, nil, testKey1, makeTS(0, 1), nil, 1, math.MaxInt64-1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newVal != math.MaxInt64 {
+		t.Errorf("expected new value of %d; got %d", int64(math.MaxInt64), newVal)
+	}
+	if _, err := MVCCIncrementWithBounds(engine, nil, testKey1, makeTS(0, 2), nil, 1, 0, nil); err == nil {
+		t.Error("expected overflow error")
+	}
+
+	max := int64(5)
+	if _, err := MVCCIncrementWithBounds(engine, nil, testKey2, makeTS(0, 1), nil, 6, 0, &max); err == nil {
+		t.Error("expected error exceeding maximum")
+	}
+	if val, err := MVCCGet(engine, testKey2, makeTS(0, 1), nil); err != nil || val != nil {
+		t.Errorf("expected no value after failed increment; got %+v, %v", val, err)
+	}
+	if newVal, err = MVCCIncrementWithBounds(engine, nil, testKey2, makeTS(0, 2), nil, 5, 0, &max); err != nil {
+		t.Fatal(err)
+	}
+	if newVal != 5 {
+		t.Errorf("expected new value of 5; got %d", newVal)
+	}
+}
+
+// TestMVCCComputeChecksum verifies that engines holding the same data
+// produce the same checksum and that the checksum covers both values
+// and stats.
+func TestMVCCComputeChecksum(t *testing.T) {
+	engines := []Engine{createTestEngine(), createTestEngine()}
+	for _, engine := range engines {
+		for _, key := range []proto.Key{testKey1, testKey2} {
+			if err := MVCCPut(engine, nil, key, makeTS(0, 1), value1, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	var checksums [][]byte
+	for _, engine := range engines {
+		checksum, err := MVCCComputeChecksum(engine, KeyMin, KeyMax, makeTS(0, 1), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checksums = append(checksums, checksum)
+	}
+	if !bytes.Equal(checksums[0], checksums[1]) {
+		t.Errorf("expected equal checksums; got %x and %x", checksums[0], checksums[1])
+	}
+
+	// The stats are part of the digest.
+	checksum, err := MVCCComputeChecksum(engines[0], KeyMin, KeyMax, makeTS(0, 1), &MVCCStats{LiveCount: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(checksum, checksums[0]) {
+		t.Error("expected stats to change checksum")
+	}
+
+	// So is each value.
+	if err := MVCCPut(engines[1], nil, testKey2, makeTS(0, 2), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if checksum, err = MVCCComputeChecksum(engines[1], KeyMin, KeyMax, makeTS(0, 2), nil); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(checksum, checksums[0]) {
+		t.Error("expected new value to change checksum")
+	}
+}
+
 func TestMVCCUpdateExistingKey(t *testing.T) {
 	engine := createTestEngine()
 	err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, nil)
@@ -330,6 +403,303 @@ func TestMVCCGetNoMoreOldVersion(t *testing.T) {
 	}
 }
 
+// TestMVCCGetChecksumMismatch verifies that versioned values are
+// stored with checksums and that a read of a value corrupted in the
+// engine fails with a ChecksumMismatchError.
+func TestMVCCGetChecksumMismatch(t *testing.T) {
+	engine := createTestEngine()
+	ts := makeTS(1, 0)
+	if err := MVCCPut(engine, nil, testKey1, ts, value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	value, err := MVCCGet(engine, testKey1, makeTS(2, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Checksum == nil {
+		t.Fatal("expected value to be stored with a checksum")
+	}
+
+	// Corrupt the stored bytes directly in the engine.
+	versionKey := MVCCEncodeVersionKey(testKey1, ts)
+	mvccValue := &proto.MVCCValue{}
+	if ok, _, _, err := GetProto(engine, versionKey, mvccValue); !ok || err != nil {
+		t.Fatalf("expected stored value; got %t, %v", ok, err)
+	}
+	mvccValue.Value.Bytes = []byte("corrupted")
+	if _, _, err := PutProto(engine, versionKey, mvccValue); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := MVCCGet(engine, testKey1, makeTS(2, 0), nil); err == nil {
+		t.Fatal("expected read of corrupted value to fail")
+	} else if cErr, ok := err.(*proto.ChecksumMismatchError); !ok || !cErr.Key.Equal(testKey1) {
+		t.Errorf("expected checksum mismatch error for %q; got %v", testKey1, err)
+	}
+	if _, err := MVCCScan(engine, testKey1, testKey2, 0, makeTS(2, 0), nil); err == nil {
+		t.Error("expected scan of corrupted value to fail")
+	}
+}
+
+// TestMVCCGetHistoryMaxVersions verifies that the history of a key
+// is returned newest first and limited to maxVersions.
+func TestMVCCGetHistoryMaxVersions(t *testing.T) {
+	engine := createTestEngine()
+	for i := int64(1); i <= 10; i++ {
+		value := proto.Value{Bytes: []byte(fmt.Sprintf("value%d", i))}
+		if err := MVCCPut(engine, nil, testKey1, makeTS(i, 0), value, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// An intent at the head of the version chain isn't history.
+	txn := &proto.Transaction{ID: []byte("txn"), Timestamp: makeTS(11, 0)}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(11, 0), value1, txn); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, maxVersions := range []int64{5, 0} {
+		versions, err := MVCCGetHistory(engine, testKey1, proto.ZeroTimestamp, maxVersions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expLen := maxVersions
+		if expLen == 0 {
+			expLen = 10
+		}
+		if int64(len(versions)) != expLen {
+			t.Fatalf("max %d: expected %d versions; got %d", maxVersions, expLen, len(versions))
+		}
+		for i, v := range versions {
+			expWallTime := 10 - int64(i)
+			expBytes := []byte(fmt.Sprintf("value%d", expWallTime))
+			if !v.Timestamp.Equal(makeTS(expWallTime, 0)) || v.Deleted || !bytes.Equal(v.Value.Bytes, expBytes) {
+				t.Errorf("max %d: expected version %d at %d with %q; got %+v", maxVersions, i, expWallTime, expBytes, v)
+			}
+		}
+	}
+}
+
+// TestMVCCGetHistory verifies that the history of a key includes
+// deletion tombstones and can be paged through by timestamp.
+func TestMVCCGetHistory(t *testing.T) {
+	engine := createTestEngine()
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey1, makeTS(3, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(4, 0), value3, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	expVersions := []MVCCVersion{
+		{Timestamp: makeTS(4, 0), Value: &value3},
+		{Timestamp: makeTS(3, 0), Deleted: true},
+		{Timestamp: makeTS(2, 0), Value: &value2},
+		{Timestamp: makeTS(1, 0), Value: &value1},
+	}
+	checkVersions := func(versions, expVersions []MVCCVersion) {
+		if len(versions) != len(expVersions) {
+			t.Fatalf("expected %d versions; got %+v", len(expVersions), versions)
+		}
+		for i, v := range versions {
+			exp := expVersions[i]
+			if !v.Timestamp.Equal(exp.Timestamp) || v.Deleted != exp.Deleted || (v.Value == nil) != (exp.Value == nil) {
+				t.Errorf("%d: expected version %+v; got %+v", i, exp, v)
+			} else if v.Value != nil && !bytes.Equal(v.Value.Bytes, exp.Value.Bytes) {
+				t.Errorf("%d: expected value %q; got %q", i, exp.Value.Bytes, v.Value.Bytes)
+			}
+		}
+	}
+
+	versions, err := MVCCGetHistory(engine, testKey1, proto.ZeroTimestamp, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkVersions(versions, expVersions)
+
+	// Page through the history two versions at a time.
+	var paged []MVCCVersion
+	before := proto.ZeroTimestamp
+	for {
+		page, err := MVCCGetHistory(engine, testKey1, before, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		paged = append(paged, page...)
+		before = page[len(page)-1].Timestamp
+	}
+	checkVersions(paged, expVersions)
+
+	// A bound between versions excludes the newer ones.
+	versions, err = MVCCGetHistory(engine, testKey1, makeTS(3, 1), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkVersions(versions, expVersions[1:])
+}
+
+// TestMVCCLock verifies that a read lock blocks writes by other
+// transactions but not reads, that the locking transaction may write
+// the key, and that stats remain consistent after resolution.
+func TestMVCCLock(t *testing.T) {
+	engine := createTestEngine()
+	ms := &MVCCStats{}
+	ts1, ts2 := makeTS(1E9, 0), makeTS(2E9, 0)
+
+	// Lock a key with a committed value and a key without versions.
+	if err := MVCCPut(engine, ms, testKey1, ts1, value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []proto.Key{testKey1, testKey2} {
+		if err := MVCCLock(engine, ms, key, makeTxn(txn1, ts2)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Reads are unaffected.
+	value, err := MVCCGet(engine, testKey1, ts2, nil)
+	if err != nil || value == nil || !bytes.Equal(value.Bytes, value1.Bytes) {
+		t.Fatalf("expected read of locked key to return %q; got %+v, %v", value1.Bytes, value, err)
+	}
+	if value, err := MVCCGet(engine, testKey2, ts2, nil); err != nil || value != nil {
+		t.Fatalf("expected read of locked key without versions to return nil; got %+v, %v", value, err)
+	}
+
+	// Writes by another transaction or outside a transaction conflict.
+	if err := MVCCPut(engine, ms, testKey1, ts2, value2, makeTxn(txn2, ts2)); err == nil {
+		t.Fatal("expected write intent error writing locked key in another txn")
+	} else if _, ok := err.(*proto.WriteIntentError); !ok {
+		t.Fatalf("expected write intent error; got %s", err)
+	}
+	if err := MVCCPut(engine, ms, testKey2, ts2, value2, nil); err == nil {
+		t.Fatal("expected write intent error writing locked key outside a txn")
+	}
+	// Another transaction can't lock the key either.
+	if err := MVCCLock(engine, ms, testKey1, makeTxn(txn2, ts2)); err == nil {
+		t.Fatal("expected write intent error locking a locked key")
+	}
+
+	// The locking txn writes testKey2 and commits, releasing its locks.
+	if err := MVCCPut(engine, ms, testKey2, ts2, value2, makeTxn(txn1, ts2)); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []proto.Key{testKey1, testKey2} {
+		if _, err := MVCCResolveWriteIntent(engine, ms, key, ts2, makeTxn(txn1Commit, ts2)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, kv := range []struct {
+		key   proto.Key
+		value proto.Value
+	}{{testKey1, value1}, {testKey2, value2}} {
+		value, err := MVCCGet(engine, kv.key, ts2, nil)
+		if err != nil || value == nil || !bytes.Equal(value.Bytes, kv.value.Bytes) {
+			t.Errorf("expected %q at key %q; got %+v, %v", kv.value.Bytes, kv.key, value, err)
+		}
+	}
+	if err := MVCCPut(engine, ms, testKey1, makeTS(3E9, 0), value3, makeTxn(txn2, makeTS(3E9, 0))); err != nil {
+		t.Fatalf("expected write after lock release to succeed; got %s", err)
+	}
+
+	expMS, err := MVCCComputeStats(engine, KeyMin, KeyMax, ts2.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The age stats depend on the time of computation; skip them.
+	ms.IntentAge, ms.GCBytesAge = expMS.IntentAge, expMS.GCBytesAge
+	verifyStats("lock", ms, &expMS, t)
+}
+
+// TestMVCCLockUpgrade verifies that a write by the transaction
+// holding a read lock upgrades the lock to a write intent.
+func TestMVCCLockUpgrade(t *testing.T) {
+	engine := createTestEngine()
+	ms := &MVCCStats{}
+	ts1, ts2 := makeTS(1E9, 0), makeTS(2E9, 0)
+
+	if err := MVCCPut(engine, ms, testKey1, ts1, value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []proto.Key{testKey1, testKey2} {
+		if err := MVCCLock(engine, ms, key, makeTxn(txn1, ts2)); err != nil {
+			t.Fatal(err)
+		}
+		if err := MVCCPut(engine, ms, key, ts2, value2, makeTxn(txn1, ts2)); err != nil {
+			t.Fatalf("expected write of key %q locked by the same txn to succeed; got %s", key, err)
+		}
+		meta := &proto.MVCCMetadata{}
+		if ok, _, _, err := GetProto(engine, MVCCEncodeKey(key), meta); !ok || err != nil {
+			t.Fatalf("expected metadata for key %q; got %t, %v", key, ok, err)
+		}
+		if meta.Lock || meta.Txn == nil || !bytes.Equal(meta.Txn.ID, txn1.ID) || !meta.Timestamp.Equal(ts2) {
+			t.Errorf("expected key %q to hold a write intent for txn1 at %s; got %+v", key, ts2, meta)
+		}
+		value, err := MVCCGet(engine, key, ts2, makeTxn(txn1, ts2))
+		if err != nil || value == nil || !bytes.Equal(value.Bytes, value2.Bytes) {
+			t.Errorf("expected txn1 to read its write %q at key %q; got %+v, %v", value2.Bytes, key, value, err)
+		}
+	}
+
+	expMS, err := MVCCComputeStats(engine, KeyMin, KeyMax, ts2.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms.IntentAge, ms.GCBytesAge = expMS.IntentAge, expMS.GCBytesAge
+	verifyStats("lock upgrade", ms, &expMS, t)
+}
+
+// TestMVCCCoalescePut verifies that a coalescing put replaces the
+// latest committed version of a key, keeping stats consistent, and
+// otherwise adds a version as a put would.
+func TestMVCCCoalescePut(t *testing.T) {
+	engine := createTestEngine()
+	ms := &MVCCStats{}
+	ts1, ts2, ts3 := makeTS(1E9, 0), makeTS(2E9, 0), makeTS(3E9, 0)
+
+	if err := MVCCCoalescePut(engine, ms, testKey1, ts1, value1); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCCoalescePut(engine, ms, testKey1, ts2, value2); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := MVCCGet(engine, testKey1, ts1, nil); err != nil || value != nil {
+		t.Errorf("expected version at %s to be replaced; got %+v, %v", ts1, value, err)
+	}
+	if value, err := MVCCGet(engine, testKey1, ts2, nil); err != nil || value == nil || !bytes.Equal(value.Bytes, value2.Bytes) {
+		t.Errorf("expected %q at %s; got %+v, %v", value2.Bytes, ts2, value, err)
+	}
+	expMS, err := MVCCComputeStats(engine, KeyMin, KeyMax, ts2.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyStats("coalesced", ms, &expMS, t)
+
+	// A deleted latest version isn't replaced.
+	if err := MVCCDelete(engine, ms, testKey1, makeTS(2E9, 1), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCCoalescePut(engine, ms, testKey1, ts3, value3); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := MVCCGet(engine, testKey1, ts2, nil); err != nil || value == nil || !bytes.Equal(value.Bytes, value2.Bytes) {
+		t.Errorf("expected %q at %s; got %+v, %v", value2.Bytes, ts2, value, err)
+	}
+	expMS, err = MVCCComputeStats(engine, KeyMin, KeyMax, ts3.WallTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms.GCBytesAge = expMS.GCBytesAge
+	verifyStats("after delete", ms, &expMS, t)
+}
+
 // TestMVCCGetUncertainty verifies that the appropriate error results when
 // a transaction reads a key at a timestamp that has versions newer than that
 // timestamp, but older than the transaction's MaxTimestamp.
@@ -432,6 +802,63 @@ func TestMVCCGetAndDelete(t *testing.T) {
 	}
 }
 
+// TestMVCCRawValueEncoding verifies that values for keys with a
+// registered raw value prefix are stored unwrapped and round-trip
+// correctly, alongside MVCCValue-encoded values in the same range.
+func TestMVCCRawValueEncoding(t *testing.T) {
+	RegisterRawValuePrefix(proto.Key("raw/"))
+	defer func() { rawValuePrefixes.prefixes = nil }()
+
+	engine := createTestEngine()
+	rawKey, pbKey := proto.Key("raw/a"), proto.Key("raw0")
+	if err := MVCCPut(engine, nil, rawKey, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, pbKey, makeTS(1, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, rawKey, makeTS(3, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The raw version must hold exactly the value's bytes, while the
+	// other key's version is an encoded MVCCValue.
+	data, err := engine.Get(MVCCEncodeVersionKey(rawKey, makeTS(1, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, value1.Bytes) {
+		t.Errorf("expected raw version %q; got %q", value1.Bytes, data)
+	}
+	mvccVal := &proto.MVCCValue{}
+	if _, _, _, err := GetProto(engine, MVCCEncodeVersionKey(pbKey, makeTS(1, 0)), mvccVal); err != nil {
+		t.Fatal(err)
+	}
+	if mvccVal.Value == nil || !bytes.Equal(mvccVal.Value.Bytes, value2.Bytes) {
+		t.Errorf("expected encoded MVCCValue %q; got %+v", value2.Bytes, mvccVal)
+	}
+
+	// Reads decode each encoding correctly, including the raw tombstone.
+	if value, err := MVCCGet(engine, rawKey, makeTS(2, 0), nil); err != nil || value == nil || !bytes.Equal(value.Bytes, value1.Bytes) {
+		t.Errorf("expected raw value %q; got %+v, %v", value1.Bytes, value, err)
+	}
+	if value, err := MVCCGet(engine, rawKey, makeTS(4, 0), nil); err != nil || value != nil {
+		t.Errorf("expected raw value to be deleted; got %+v, %v", value, err)
+	}
+	kvs, err := MVCCScan(engine, proto.Key("raw"), proto.Key("raw1"), 0, makeTS(2, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 || !bytes.Equal(kvs[0].Value.Bytes, value1.Bytes) || !bytes.Equal(kvs[1].Value.Bytes, value2.Bytes) {
+		t.Errorf("unexpected scan results: %+v", kvs)
+	}
+
+	// Raw-encoded keys only accept non-empty byte values.
+	if err := MVCCPut(engine, nil, proto.Key("raw/b"), makeTS(1, 0), proto.Value{Integer: gogoproto.Int64(1)}, nil); err == nil {
+		t.Error("expected error writing integer to raw-encoded key")
+	}
+}
+
 func TestMVCCDeleteMissingKey(t *testing.T) {
 	engine := NewInMem(proto.Attributes{}, 1<<20)
 	defer engine.Stop()
@@ -496,6 +923,56 @@ func TestMVCCGetWriteIntentError(t *testing.T) {
 	}
 }
 
+// TestMVCCScanSnapshot verifies that a scan of a snapshot sees the
+// state of the engine at the snapshot's creation, regardless of
+// subsequent writes and deletions.
+func TestMVCCScanSnapshot(t *testing.T) {
+	engine := NewInMem(proto.Attributes{}, 1<<20)
+	defer engine.Stop()
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	snap := engine.NewSnapshot()
+	defer snap.Stop()
+
+	// Overwrite, delete and add keys after the snapshot.
+	if err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value4, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey2, makeTS(2, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(2, 0), value3, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		eng       Engine
+		expKeys   []proto.Key
+		expValues []proto.Value
+	}{
+		{snap, []proto.Key{testKey1, testKey2}, []proto.Value{value1, value2}},
+		{engine, []proto.Key{testKey1, testKey3}, []proto.Value{value4, value3}},
+	}
+	for i, test := range testCases {
+		kvs, err := MVCCScan(test.eng, testKey1, testKey4, 0, makeTS(3, 0), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kvs) != len(test.expKeys) {
+			t.Fatalf("%d: expected %d rows; got %+v", i, len(test.expKeys), kvs)
+		}
+		for j, kv := range kvs {
+			if !kv.Key.Equal(test.expKeys[j]) || !bytes.Equal(kv.Value.Bytes, test.expValues[j].Bytes) {
+				t.Errorf("%d: expected %q=%q; got %q=%q", i, test.expKeys[j], test.expValues[j].Bytes, kv.Key, kv.Value.Bytes)
+			}
+		}
+	}
+}
+
 func TestMVCCScan(t *testing.T) {
 	engine := createTestEngine()
 	err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil)
@@ -628,6 +1105,89 @@ func TestMVCCScanInTxn(t *testing.T) {
 	}
 }
 
+// TestMVCCReverseScan verifies that a reverse scan returns the values
+// visible at the read timestamp in descending key order, excluding
+// the end key and including the start key.
+func TestMVCCReverseScan(t *testing.T) {
+	engine := createTestEngine()
+	err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil)
+	err = MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, nil)
+	err = MVCCPut(engine, nil, testKey2, makeTS(3, 0), value3, nil)
+	err = MVCCPut(engine, nil, testKey3, makeTS(1, 0), value3, nil)
+	err = MVCCPut(engine, nil, testKey3, makeTS(4, 0), value2, nil)
+	err = MVCCPut(engine, nil, testKey4, makeTS(1, 0), value4, nil)
+
+	kvs, err := MVCCReverseScan(engine, testKey2, testKey4, 0, makeTS(1, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 ||
+		!bytes.Equal(kvs[0].Key, testKey3) ||
+		!bytes.Equal(kvs[1].Key, testKey2) ||
+		!bytes.Equal(kvs[0].Value.Bytes, value3.Bytes) ||
+		!bytes.Equal(kvs[1].Value.Bytes, value2.Bytes) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+
+	kvs, err = MVCCReverseScan(engine, testKey2, testKey4, 0, makeTS(4, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 ||
+		!bytes.Equal(kvs[0].Key, testKey3) ||
+		!bytes.Equal(kvs[1].Key, testKey2) ||
+		!bytes.Equal(kvs[0].Value.Bytes, value2.Bytes) ||
+		!bytes.Equal(kvs[1].Value.Bytes, value3.Bytes) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+
+	kvs, err = MVCCReverseScan(engine, KeyMin, KeyMax, 3, makeTS(1, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 3 ||
+		!bytes.Equal(kvs[0].Key, testKey4) ||
+		!bytes.Equal(kvs[1].Key, testKey3) ||
+		!bytes.Equal(kvs[2].Key, testKey2) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+
+	kvs, err = MVCCReverseScan(engine, testKey1, testKey1.Next(), 0, makeTS(1, 0), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 1 || !bytes.Equal(kvs[0].Key, testKey1) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+}
+
+// TestMVCCReverseScanInTxn verifies that a reverse scan reads its own
+// transaction's intents and fails on another's.
+func TestMVCCReverseScanInTxn(t *testing.T) {
+	engine := createTestEngine()
+	err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil)
+	err = MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, txn1)
+	err = MVCCPut(engine, nil, testKey3, makeTS(1, 0), value3, nil)
+
+	kvs, err := MVCCReverseScan(engine, testKey1, testKey4, 0, makeTS(1, 0), txn1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 3 ||
+		!bytes.Equal(kvs[0].Key, testKey3) ||
+		!bytes.Equal(kvs[1].Key, testKey2) ||
+		!bytes.Equal(kvs[2].Key, testKey1) ||
+		!bytes.Equal(kvs[1].Value.Bytes, value2.Bytes) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+
+	if _, err = MVCCReverseScan(engine, testKey1, testKey4, 0, makeTS(1, 0), nil); err == nil {
+		t.Fatal("expected error on uncommitted write intent")
+	} else if _, ok := err.(*proto.WriteIntentError); !ok {
+		t.Fatalf("expected write intent error; got %s", err)
+	}
+}
+
 // TestMVCCIterateCommitted writes several values, some as intents
 // and verifies that IterateCommitted sees only the committed versions.
 func TestMVCCIterateCommitted(t *testing.T) {
@@ -670,11 +1230,42 @@ func TestMVCCIterateCommitted(t *testing.T) {
 		{Key: testKey2, Value: proto.Value{Bytes: value2.Bytes, Timestamp: &ts4}},
 		{Key: testKey4, Value: proto.Value{Bytes: value4.Bytes, Timestamp: &ts6}},
 	}
+	for i := range expKVs {
+		expKVs[i].Value.InitChecksum(expKVs[i].Key)
+	}
 	if !reflect.DeepEqual(kvs, expKVs) {
 		t.Errorf("expected key values equal %v != %v", kvs, expKVs)
 	}
 }
 
+func TestMVCCIterateIntents(t *testing.T) {
+	engine := createTestEngine()
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, txn1); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(2, 0), value3, txn2); err != nil {
+		t.Fatal(err)
+	}
+
+	var keys []proto.Key
+	var txnIDs [][]byte
+	if err := MVCCIterateIntents(engine, testKey1, testKey4, func(key proto.Key, meta *proto.MVCCMetadata) (bool, error) {
+		keys = append(keys, key)
+		txnIDs = append(txnIDs, meta.Txn.ID)
+		return false, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	expKeys := []proto.Key{testKey1, testKey3}
+	expIDs := [][]byte{txn1.ID, txn2.ID}
+	if !reflect.DeepEqual(keys, expKeys) || !reflect.DeepEqual(txnIDs, expIDs) {
+		t.Errorf("expected intents %q owned by %q; got %q owned by %q", expKeys, expIDs, keys, txnIDs)
+	}
+}
+
 func TestMVCCDeleteRange(t *testing.T) {
 	engine := createTestEngine()
 	err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil)
@@ -725,6 +1316,52 @@ func TestMVCCDeleteRange(t *testing.T) {
 	}
 }
 
+// TestMVCCDeleteRangeStats verifies that deleting a span, with and
+// without a limit on the entries deleted, leaves tombstones for the
+// deleted keys and stats which match those computed by a scan.
+func TestMVCCDeleteRangeStats(t *testing.T) {
+	engine := createTestEngine()
+	ms := &MVCCStats{}
+	for _, kv := range []struct {
+		key   proto.Key
+		value proto.Value
+	}{{testKey1, value1}, {testKey2, value2}, {testKey3, value3}, {testKey4, value4}} {
+		if err := MVCCPut(engine, ms, kv.key, makeTS(1E9, 0), kv.value, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	num, err := MVCCDeleteRange(engine, ms, testKey2, KeyMax, 2, makeTS(1E9, 1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num != 2 {
+		t.Fatalf("expected 2 keys deleted; got %d", num)
+	}
+	if ms.LiveCount != 2 || ms.KeyCount != 4 || ms.ValCount != 6 {
+		t.Errorf("expected 2 live keys, 4 keys and 6 values; got %+v", ms)
+	}
+	expMS, err := MVCCComputeStats(engine, KeyMin, KeyMax, 1E9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyStats("limited delete", ms, &expMS, t)
+
+	if num, err = MVCCDeleteRange(engine, ms, KeyMin, KeyMax, 0, makeTS(1E9, 2), nil); err != nil {
+		t.Fatal(err)
+	}
+	if num != 2 {
+		t.Fatalf("expected 2 keys deleted; got %d", num)
+	}
+	if ms.LiveCount != 0 || ms.LiveBytes != 0 || ms.ValCount != 8 {
+		t.Errorf("expected no live keys and 8 values; got %+v", ms)
+	}
+	if expMS, err = MVCCComputeStats(engine, KeyMin, KeyMax, 1E9); err != nil {
+		t.Fatal(err)
+	}
+	verifyStats("unlimited delete", ms, &expMS, t)
+}
+
 func TestMVCCDeleteRangeFailed(t *testing.T) {
 	engine := createTestEngine()
 	err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil)
@@ -839,6 +1476,54 @@ func TestMVCCConditionalPut(t *testing.T) {
 	}
 }
 
+// TestMVCCConditionalDelete verifies that a conditional delete writes
+// a tombstone only if the existing value matches the expected value.
+func TestMVCCConditionalDelete(t *testing.T) {
+	engine := createTestEngine()
+	ms := &MVCCStats{}
+	if err := MVCCPut(engine, ms, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Conditional delete expecting wrong value2, will fail.
+	err := MVCCConditionalDelete(engine, ms, testKey1, makeTS(2, 0), &value2, nil)
+	switch e := err.(type) {
+	default:
+		t.Fatalf("unexpected error %T", e)
+	case *proto.ConditionFailedError:
+		if e.ActualValue == nil || !bytes.Equal(e.ActualValue.Bytes, value1.Bytes) {
+			t.Fatalf("expected actual value %s; got %v", value1.Bytes, e.ActualValue)
+		}
+	}
+	// Conditional delete expecting the key not to exist, will fail.
+	if _, ok := MVCCConditionalDelete(engine, ms, testKey1, makeTS(2, 0), nil, nil).(*proto.ConditionFailedError); !ok {
+		t.Fatal("expected error on key already exists")
+	}
+	if value, err := MVCCGet(engine, testKey1, makeTS(2, 0), nil); err != nil || value == nil {
+		t.Fatalf("expected value to survive failed conditional deletes; got %v, %v", value, err)
+	}
+
+	// Conditional delete expecting value1 succeeds.
+	if err := MVCCConditionalDelete(engine, ms, testKey1, makeTS(2, 0), &value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := MVCCGet(engine, testKey1, makeTS(2, 0), nil); err != nil || value != nil {
+		t.Fatalf("expected key to be deleted; got %v, %v", value, err)
+	}
+	if value, err := MVCCGet(engine, testKey1, makeTS(1, 0), nil); err != nil || value == nil {
+		t.Fatalf("expected earlier version to remain readable; got %v, %v", value, err)
+	}
+	if ms.LiveCount != 0 || ms.ValCount != 2 {
+		t.Errorf("expected no live keys and 2 values; got %+v", ms)
+	}
+
+	// With the key deleted, a conditional delete expecting a value fails.
+	err = MVCCConditionalDelete(engine, ms, testKey1, makeTS(3, 0), &value1, nil)
+	if cErr, ok := err.(*proto.ConditionFailedError); !ok || cErr.ActualValue != nil {
+		t.Fatalf("expected condition failed error with missing actual value; got %v", err)
+	}
+}
+
 func TestMVCCResolveTxn(t *testing.T) {
 	engine := createTestEngine()
 	err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, txn1)
@@ -849,10 +1534,17 @@ func TestMVCCResolveTxn(t *testing.T) {
 	}
 
 	// Resolve will write with txn1's timestamp which is 0,1.
-	err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn1Commit)
+	committed, err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn1Commit)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if !committed {
+		t.Error("expected resolve to report the committed write intent")
+	}
+	// Resolving again is a noop, committing nothing.
+	if committed, err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn1Commit); err != nil || committed {
+		t.Errorf("expected repeated resolve to commit nothing; got %t, %v", committed, err)
+	}
 
 	value, err = MVCCGet(engine, testKey1, makeTS(0, 1), nil)
 	if !bytes.Equal(value1.Bytes, value.Bytes) {
@@ -864,7 +1556,7 @@ func TestMVCCResolveTxn(t *testing.T) {
 func TestMVCCAbortTxn(t *testing.T) {
 	engine := createTestEngine()
 	err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, txn1)
-	err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn1Abort)
+	_, err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn1Abort)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -890,7 +1582,7 @@ func TestMVCCAbortTxnWithPreviousVersion(t *testing.T) {
 	err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, nil)
 	err = MVCCPut(engine, nil, testKey1, makeTS(1, 0), value2, nil)
 	err = MVCCPut(engine, nil, testKey1, makeTS(2, 0), value3, txn1)
-	err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(2, 0), txn1Abort)
+	_, err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(2, 0), txn1Abort)
 	if err := engine.Commit(); err != nil {
 		t.Fatal(err)
 	}
@@ -939,7 +1631,7 @@ func TestMVCCWriteWithDiffTimestampsAndEpochs(t *testing.T) {
 		t.Fatal(err)
 	}
 	// Resolve the intent.
-	if err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), makeTxn(txn1e2Commit, makeTS(1, 0))); err != nil {
+	if _, err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), makeTxn(txn1e2Commit, makeTS(1, 0))); err != nil {
 		t.Fatal(err)
 	}
 	// Now try writing an earlier intent--should get write too old error.
@@ -1020,7 +1712,7 @@ func TestMVCCReadWithPushedTimestamp(t *testing.T) {
 		t.Fatal(err)
 	}
 	// Resolve the intent, pushing its timestamp forward.
-	if err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), makeTxn(txn1, makeTS(1, 0))); err != nil {
+	if _, err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), makeTxn(txn1, makeTS(1, 0))); err != nil {
 		t.Fatal(err)
 	}
 	// Attempt to read using naive txn's previous timestamp.
@@ -1034,7 +1726,7 @@ func TestMVCCResolveWithDiffEpochs(t *testing.T) {
 	engine := createTestEngine()
 	err := MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, txn1)
 	err = MVCCPut(engine, nil, testKey2, makeTS(0, 1), value2, txn1e2)
-	num, err := MVCCResolveWriteIntentRange(engine, nil, testKey1, testKey2.Next(), 2, makeTS(0, 1), txn1e2Commit)
+	num, _, err := MVCCResolveWriteIntentRange(engine, nil, testKey1, testKey2.Next(), 2, makeTS(0, 1), txn1e2Commit)
 	if num != 2 {
 		t.Errorf("expected 2 rows resolved; got %d", num)
 	}
@@ -1065,7 +1757,7 @@ func TestMVCCResolveWithUpdatedTimestamp(t *testing.T) {
 
 	// Resolve with a higher commit timestamp -- this should rewrite the
 	// intent when making it permanent.
-	err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), makeTxn(txn1Commit, makeTS(1, 0)))
+	_, err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), makeTxn(txn1Commit, makeTS(1, 0)))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1095,7 +1787,7 @@ func TestMVCCResolveWithPushedTimestamp(t *testing.T) {
 
 	// Resolve with a higher commit timestamp, but with still-pending transaction.
 	// This represents a straightforward push (i.e. from a read/write conflict).
-	err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), makeTxn(txn1, makeTS(1, 0)))
+	_, err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), makeTxn(txn1, makeTS(1, 0)))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1119,21 +1811,21 @@ func TestMVCCResolveTxnNoOps(t *testing.T) {
 	engine := createTestEngine()
 
 	// Resolve a non existent key; noop.
-	err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn1Commit)
+	_, err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn1Commit)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Add key and resolve despite there being no intent.
 	err = MVCCPut(engine, nil, testKey1, makeTS(0, 1), value1, nil)
-	err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn2Commit)
+	_, err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(0, 1), txn2Commit)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Write intent and resolve with different txn.
 	err = MVCCPut(engine, nil, testKey1, makeTS(1, 0), value2, txn1)
-	err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), txn2Commit)
+	_, err = MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), txn2Commit)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1146,13 +1838,16 @@ func TestMVCCResolveTxnRange(t *testing.T) {
 	err = MVCCPut(engine, nil, testKey3, makeTS(0, 1), value3, txn2)
 	err = MVCCPut(engine, nil, testKey4, makeTS(0, 1), value4, txn1)
 
-	num, err := MVCCResolveWriteIntentRange(engine, nil, testKey1, testKey4.Next(), 0, makeTS(0, 1), txn1Commit)
+	num, committed, err := MVCCResolveWriteIntentRange(engine, nil, testKey1, testKey4.Next(), 0, makeTS(0, 1), txn1Commit)
 	if err != nil {
 		t.Fatal(err)
 	}
 	if num != 4 {
 		t.Fatalf("expected all keys to process for resolution, even though 2 are noops; got %d", num)
 	}
+	if !reflect.DeepEqual(committed, []proto.Key{testKey1, testKey4}) {
+		t.Errorf("expected committed write intents at %q and %q; got %q", testKey1, testKey4, committed)
+	}
 
 	value, err := MVCCGet(engine, testKey1, makeTS(0, 1), nil)
 	if !bytes.Equal(value1.Bytes, value.Bytes) {
@@ -1179,6 +1874,86 @@ func TestMVCCResolveTxnRange(t *testing.T) {
 	}
 }
 
+// iterCountingEngine wraps an Engine, counting the iterators it
+// creates, either directly or via Iterate.
+type iterCountingEngine struct {
+	Engine
+	iters int
+}
+
+func (e *iterCountingEngine) Iterate(start, end proto.EncodedKey, f func(proto.RawKeyValue) (bool, error)) error {
+	e.iters++
+	return e.Engine.Iterate(start, end, f)
+}
+
+func (e *iterCountingEngine) NewIterator() Iterator {
+	e.iters++
+	return e.Engine.NewIterator()
+}
+
+// TestMVCCResolveTxnRangeOrdered verifies that resolving intents at
+// non-adjacent keys over a span yields the same data and stats as
+// resolving each intent individually, while using a single iterator.
+func TestMVCCResolveTxnRangeOrdered(t *testing.T) {
+	keys := []proto.Key{proto.Key("a"), proto.Key("b"), proto.Key("c"), proto.Key("d"), proto.Key("e")}
+	intentKeys := []proto.Key{proto.Key("a"), proto.Key("c"), proto.Key("e")}
+	populate := func() (*iterCountingEngine, *MVCCStats) {
+		engine := &iterCountingEngine{Engine: createTestEngine()}
+		ms := &MVCCStats{}
+		for _, key := range keys {
+			if err := MVCCPut(engine, ms, key, makeTS(1, 0), value1, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, key := range intentKeys {
+			if err := MVCCPut(engine, ms, key, makeTS(2, 0), value2, txn1); err != nil {
+				t.Fatal(err)
+			}
+		}
+		engine.iters = 0
+		return engine, ms
+	}
+
+	for _, txn := range []*proto.Transaction{makeTxn(txn1Commit, makeTS(2, 0)), makeTxn(txn1Abort, makeTS(2, 0))} {
+		single, singleMS := populate()
+		for _, key := range intentKeys {
+			if _, err := MVCCResolveWriteIntent(single, singleMS, key, makeTS(3, 0), txn); err != nil {
+				t.Fatal(err)
+			}
+		}
+		ranged, rangedMS := populate()
+		num, _, err := MVCCResolveWriteIntentRange(ranged, rangedMS, proto.Key("a"), proto.Key("f"), 0, makeTS(3, 0), txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if num != int64(len(keys)) {
+			t.Errorf("%s: expected %d keys processed; got %d", txn.Status, len(keys), num)
+		}
+
+		singleKVs, err := Scan(single.Engine, MVCCEncodeKey(KeyMin), MVCCEncodeKey(KeyMax), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rangedKVs, err := Scan(ranged.Engine, MVCCEncodeKey(KeyMin), MVCCEncodeKey(KeyMax), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(singleKVs, rangedKVs) {
+			t.Errorf("%s: expected ranged resolve to match individual resolves:\n%v\n%v", txn.Status, singleKVs, rangedKVs)
+		}
+		if !reflect.DeepEqual(singleMS, rangedMS) {
+			t.Errorf("%s: expected stats %+v; got %+v", txn.Status, singleMS, rangedMS)
+		}
+		if ranged.iters != 1 {
+			t.Errorf("%s: expected ranged resolve to use a single iterator; got %d", txn.Status, ranged.iters)
+		}
+		// Individual aborts each scan for the version to restore.
+		if txn.Status == proto.ABORTED && ranged.iters >= single.iters {
+			t.Errorf("%s: expected fewer iterators than individual resolves; got %d vs %d", txn.Status, ranged.iters, single.iters)
+		}
+	}
+}
+
 func TestValidSplitKeys(t *testing.T) {
 	testCases := []struct {
 		key   proto.Key
@@ -1254,6 +2029,49 @@ func TestFindSplitKey(t *testing.T) {
 	}
 }
 
+// TestFindSplitKeySequential verifies that for sequentially written
+// keys, whose most recently written values dominate the range's size,
+// the split key divides the keys evenly rather than clustering at the
+// high end, as a byte-balanced split of the same data does.
+func TestFindSplitKeySequential(t *testing.T) {
+	raftID := int64(1)
+	for _, sequential := range []bool{true, false} {
+		engine := NewInMem(proto.Attributes{}, 1<<20)
+		defer engine.Stop()
+
+		ms := &MVCCStats{}
+		for i := 0; i < 100; i++ {
+			size := 10
+			if i >= 90 {
+				size = 5000
+			}
+			ts := makeTS(0, 1)
+			if sequential {
+				ts = makeTS(int64(i+1), 0)
+			}
+			val := proto.Value{Bytes: []byte(strings.Repeat("X", size))}
+			if err := MVCCPut(engine, ms, []byte(fmt.Sprintf("%09d", i)), ts, val, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		ms.MergeStats(engine, raftID) // write stats
+		snap := engine.NewSnapshot()
+		defer snap.Stop()
+		splitKey, err := MVCCFindSplitKey(snap, raftID, KeyMin, KeyMax)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ind, _ := strconv.Atoi(string(splitKey))
+		if sequential {
+			if diff := 50 - ind; diff > 1 || diff < -1 {
+				t.Errorf("expected sequential keys to split at key #50+-1; got %d", ind)
+			}
+		} else if ind < 90 {
+			t.Errorf("expected byte-balanced split among the large values; got key #%d", ind)
+		}
+	}
+}
+
 // TestFindValidSplitKeys verifies split keys are located such that
 // they avoid splits through invalid key ranges.
 func TestFindValidSplitKeys(t *testing.T) {
@@ -1499,8 +2317,9 @@ func TestMVCCStatsBasic(t *testing.T) {
 		t.Errorf("expected version timestamp size %d; got %d", mvccVersionTimestampSize, keySize)
 	}
 
-	// Put a value.
+	// Put a value. Versioned values are stored with checksums.
 	value := proto.Value{Bytes: []byte("value")}
+	value.InitChecksum(key)
 	if err := MVCCPut(engine, ms, key, ts, value, nil); err != nil {
 		t.Fatal(err)
 	}
@@ -1542,7 +2361,7 @@ func TestMVCCStatsBasic(t *testing.T) {
 
 	// Resolve the deletion by aborting it.
 	txn.Status = proto.ABORTED
-	if err := MVCCResolveWriteIntent(engine, ms, key, ts2, txn); err != nil {
+	if _, err := MVCCResolveWriteIntent(engine, ms, key, ts2, txn); err != nil {
 		t.Fatal(err)
 	}
 	// Stats should equal same as before the deletion after aborting the intent.
@@ -1563,6 +2382,7 @@ func TestMVCCStatsBasic(t *testing.T) {
 	txn.Timestamp = ts4
 	key2 := proto.Key("b")
 	value2 := proto.Value{Bytes: []byte("value")}
+	value2.InitChecksum(key2)
 	if err := MVCCPut(engine, ms, key2, ts4, value2, txn); err != nil {
 		t.Fatal(err)
 	}
@@ -1585,10 +2405,10 @@ func TestMVCCStatsBasic(t *testing.T) {
 
 	// Now commit both values.
 	txn.Status = proto.COMMITTED
-	if err := MVCCResolveWriteIntent(engine, ms, key, ts4, txn); err != nil {
+	if _, err := MVCCResolveWriteIntent(engine, ms, key, ts4, txn); err != nil {
 		t.Fatal(err)
 	}
-	if err := MVCCResolveWriteIntent(engine, ms, key2, ts4, txn); err != nil {
+	if _, err := MVCCResolveWriteIntent(engine, ms, key2, ts4, txn); err != nil {
 		t.Fatal(err)
 	}
 	m3ValSize := encodedSize(&proto.MVCCMetadata{Timestamp: ts4, Deleted: true}, t)
@@ -1662,7 +2482,7 @@ func TestMVCCStatsWithRandomRuns(t *testing.T) {
 				if wiErr, ok := err.(*proto.WriteIntentError); ok {
 					wiErr.Txn.Status = proto.ABORTED
 					log.V(1).Infof("*** ABORT index %d", idx)
-					if err := MVCCResolveWriteIntent(engine, ms, keys[idx], makeTS(int64(i+1)*1E9, 0), &wiErr.Txn); err != nil {
+					if _, err := MVCCResolveWriteIntent(engine, ms, keys[idx], makeTS(int64(i+1)*1E9, 0), &wiErr.Txn); err != nil {
 						t.Fatal(err)
 					}
 					// Now, re-delete.
@@ -1687,7 +2507,7 @@ func TestMVCCStatsWithRandomRuns(t *testing.T) {
 				txn.Status = proto.ABORTED
 			}
 			log.V(1).Infof("*** RESOLVE index %d; COMMIT=%t", i, txn.Status == proto.COMMITTED)
-			if err := MVCCResolveWriteIntent(engine, ms, key, makeTS(int64(i+1)*1E9, 0), txn); err != nil {
+			if _, err := MVCCResolveWriteIntent(engine, ms, key, makeTS(int64(i+1)*1E9, 0), txn); err != nil {
 				t.Fatal(err)
 			}
 		}
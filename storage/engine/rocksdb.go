@@ -27,7 +27,9 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -37,10 +39,11 @@ import (
 
 // RocksDB is a wrapper around a RocksDB database instance.
 type RocksDB struct {
-	rdb       *C.DBEngine
-	attrs     proto.Attributes // Attributes for this engine
-	dir       string           // The data directory
-	cacheSize int64            // Memory to use to cache values.
+	lastCompaction int64 // Unix nanos of the last CompactRange; accessed atomically
+	rdb            *C.DBEngine
+	attrs          proto.Attributes // Attributes for this engine
+	dir            string           // The data directory
+	cacheSize      int64            // Memory to use to cache values.
 }
 
 // NewRocksDB allocates and returns a new RocksDB object.
@@ -174,6 +177,15 @@ func (r *RocksDB) Clear(key proto.EncodedKey) error {
 	return statusToError(C.DBDelete(r.rdb, goToCSlice(key)))
 }
 
+// ClearRange removes all items with keys in the span [start, end)
+// atomically via a single write batch. The version of RocksDB in use
+// does not support range deletions, so keys are enumerated and deleted
+// individually within the batch.
+func (r *RocksDB) ClearRange(start, end proto.EncodedKey) error {
+	_, err := ClearRange(r, start, end)
+	return err
+}
+
 // Iterate iterates from start to end keys, invoking f on each
 // key/value pair. See engine.Iterate for details.
 func (r *RocksDB) Iterate(start, end proto.EncodedKey, f func(proto.RawKeyValue) (bool, error)) error {
@@ -206,6 +218,16 @@ func (r *RocksDB) iterateInternal(start, end proto.EncodedKey, f func(proto.RawK
 // the RocksDB write batch facility. The list must only contain
 // elements of type Batch{Put,Merge,Delete}.
 func (r *RocksDB) WriteBatch(cmds []interface{}) error {
+	return r.writeBatch(cmds, false)
+}
+
+// SyncWriteBatch is like WriteBatch, but syncs the write-ahead log
+// before returning.
+func (r *RocksDB) SyncWriteBatch(cmds []interface{}) error {
+	return r.writeBatch(cmds, true)
+}
+
+func (r *RocksDB) writeBatch(cmds []interface{}, sync bool) error {
 	if len(cmds) == 0 {
 		return nil
 	}
@@ -230,7 +252,7 @@ func (r *RocksDB) WriteBatch(cmds []interface{}) error {
 		}
 	}
 
-	return statusToError(C.DBWrite(r.rdb, batch))
+	return statusToError(C.DBWrite(r.rdb, batch, C.bool(sync)))
 }
 
 // Capacity queries the underlying file system for disk capacity
@@ -276,7 +298,21 @@ func (r *RocksDB) CompactRange(start, end proto.EncodedKey) {
 	err := statusToError(C.DBCompactRange(r.rdb, sPtr, ePtr))
 	if err != nil {
 		log.Warningf("compact range: %s", err)
+		return
+	}
+	atomic.StoreInt64(&r.lastCompaction, time.Now().UnixNano())
+}
+
+// CompactionStatus returns RocksDB's estimate of the bytes awaiting
+// compaction and the time at which CompactRange last completed.
+func (r *RocksDB) CompactionStatus() CompactionStatus {
+	status := CompactionStatus{
+		PendingBytes: int64(C.DBPendingCompactionBytes(r.rdb)),
+	}
+	if nanos := atomic.LoadInt64(&r.lastCompaction); nanos != 0 {
+		status.LastCompaction = time.Unix(0, nanos)
 	}
+	return status
 }
 
 // Destroy destroys the underlying filesystem data associated with the database.
@@ -295,6 +331,12 @@ func (r *RocksDB) Flush() error {
 	return statusToError(C.DBFlush(r.rdb))
 }
 
+// PendingBytes returns the size of RocksDB's memtables, which hold
+// writes not yet flushed to disk.
+func (r *RocksDB) PendingBytes() int64 {
+	return int64(C.DBPendingBytes(r.rdb))
+}
+
 // goToCSlice converts a go byte slice to a DBSlice. Note that this is
 // potentially dangerous as the DBSlice holds a reference to the go
 // byte slice memory that the Go GC does not know about. This method
@@ -383,6 +425,11 @@ func (r *RocksDB) Commit() error {
 	return nil
 }
 
+// CommitSync is a noop for RocksDB engine.
+func (r *RocksDB) CommitSync() error {
+	return nil
+}
+
 type rocksDBSnapshot struct {
 	parent *RocksDB
 	handle *C.DBSnapshot
@@ -426,11 +473,21 @@ func (r *rocksDBSnapshot) Clear(key proto.EncodedKey) error {
 	return util.Errorf("cannot Clear from a snapshot")
 }
 
+// ClearRange is illegal for snapshot and returns an error.
+func (r *rocksDBSnapshot) ClearRange(start, end proto.EncodedKey) error {
+	return util.Errorf("cannot ClearRange from a snapshot")
+}
+
 // WriteBatch is illegal for snapshot and returns an error.
 func (r *rocksDBSnapshot) WriteBatch([]interface{}) error {
 	return util.Errorf("cannot WriteBatch to a snapshot")
 }
 
+// SyncWriteBatch is illegal for snapshot and returns an error.
+func (r *rocksDBSnapshot) SyncWriteBatch([]interface{}) error {
+	return util.Errorf("cannot SyncWriteBatch to a snapshot")
+}
+
 // Merge is illegal for snapshot and returns an error.
 func (r *rocksDBSnapshot) Merge(key proto.EncodedKey, value []byte) error {
 	return util.Errorf("cannot Merge to a snapshot")
@@ -445,6 +502,10 @@ func (r *rocksDBSnapshot) Capacity() (StoreCapacity, error) {
 func (r *rocksDBSnapshot) SetGCTimeouts(minTxnTS, minRCacheTS int64) {
 }
 
+// CompactRange is a noop for a snapshot.
+func (r *rocksDBSnapshot) CompactRange(start, end proto.EncodedKey) {
+}
+
 // ApproximateSize returns the approximate number of bytes the engine is
 // using to store data for the given range of keys.
 func (r *rocksDBSnapshot) ApproximateSize(start, end proto.EncodedKey) (uint64, error) {
@@ -456,6 +517,16 @@ func (r *rocksDBSnapshot) Flush() error {
 	return nil
 }
 
+// CompactionStatus returns the compaction status of the parent engine.
+func (r *rocksDBSnapshot) CompactionStatus() CompactionStatus {
+	return r.parent.CompactionStatus()
+}
+
+// PendingBytes returns 0 for snapshots, which are read-only.
+func (r *rocksDBSnapshot) PendingBytes() int64 {
+	return 0
+}
+
 // NewIterator returns a new instance of an Iterator over the
 // engine using the snapshot handle.
 func (r *rocksDBSnapshot) NewIterator() Iterator {
@@ -477,6 +548,11 @@ func (r *rocksDBSnapshot) Commit() error {
 	return util.Errorf("cannot Commit to a snapshot")
 }
 
+// CommitSync is illegal for snapshot and returns an error.
+func (r *rocksDBSnapshot) CommitSync() error {
+	return util.Errorf("cannot CommitSync to a snapshot")
+}
+
 type rocksDBIterator struct {
 	iter *C.DBIterator
 }
@@ -510,6 +586,15 @@ func (r *rocksDBIterator) Seek(key []byte) {
 	}
 }
 
+func (r *rocksDBIterator) SeekReverse(key []byte) {
+	C.DBIterSeek(r.iter, goToCSlice(key))
+	if r.Valid() {
+		C.DBIterPrev(r.iter)
+	} else {
+		C.DBIterSeekToLast(r.iter)
+	}
+}
+
 func (r *rocksDBIterator) Valid() bool {
 	return C.DBIterValid(r.iter) == 1
 }
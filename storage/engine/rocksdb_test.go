@@ -132,6 +132,39 @@ func TestRocksDBCompaction(t *testing.T) {
 	}
 }
 
+// TestRocksDBCompactionStatus verifies that CompactRange records the
+// time of its completion in the reported compaction status.
+func TestRocksDBCompactionStatus(t *testing.T) {
+	rocksdb := newMemRocksDB(proto.Attributes{}, testCacheSize)
+	if err := rocksdb.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer rocksdb.Stop()
+
+	if status := rocksdb.CompactionStatus(); !status.LastCompaction.IsZero() || status.PendingBytes < 0 {
+		t.Fatalf("expected no compaction before CompactRange; got %+v", status)
+	}
+	for i := 0; i < 10; i++ {
+		key := proto.Key(fmt.Sprintf("key%d", i))
+		if err := MVCCPut(rocksdb, nil, key, makeTS(1, 0), proto.Value{Bytes: []byte("value")}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rocksdb.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	before := time.Now()
+	rocksdb.CompactRange(nil, nil)
+	status := rocksdb.CompactionStatus()
+	if status.LastCompaction.Before(before) || status.LastCompaction.After(time.Now()) {
+		t.Errorf("expected last compaction after %s; got %s", before, status.LastCompaction)
+	}
+	if status.PendingBytes != 0 {
+		t.Errorf("expected no pending compaction bytes after full compaction; got %d", status.PendingBytes)
+	}
+}
+
 // setupMVCCData writes up to numVersions values at each of numKeys
 // keys. The number of versions written for each key is chosen
 // randomly according to a uniform distribution. Each successive
@@ -20,6 +20,7 @@ package storage
 import (
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/gossip"
@@ -44,6 +45,10 @@ const (
 	// intentAgeThreshold is the threshold after which an extant intent
 	// will be resolved.
 	intentAgeThreshold = 2 * time.Hour // 2 hour
+	// tombstoneSkipNormalization is the count of tombstones skipped by
+	// scans since the last GC which amount to a score of "1" added to
+	// total range priority.
+	tombstoneSkipNormalization = 10000
 )
 
 // gcQueue manages a queue of ranges slated to be scanned in their
@@ -71,7 +76,8 @@ func newGCQueue() *gcQueue {
 // shouldQueue determines whether a range should be queued for garbage
 // collection, and if so, at what priority. Returns true for shouldQ
 // in the event that the cumulative ages of GC'able bytes or extant
-// intents exceed thresholds.
+// intents, or the count of tombstones skipped by scans, exceed
+// thresholds.
 func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool, priority float64) {
 	// Lookup GC policy for this range.
 	policy, err := gcq.lookupGCPolicy(rng)
@@ -87,6 +93,10 @@ func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool,
 	// and normalizes.
 	intentScore := rng.stats.GetAvgIntentAge(now.WallTime) / float64(intentAgeNormalization.Nanoseconds()/1E9)
 
+	// Tombstone score. Scans over spans with many deleted keys are
+	// slow even if few live keys remain.
+	tombstoneScore := float64(atomic.LoadInt64(&rng.tombstonesSkipped)) / float64(tombstoneSkipNormalization)
+
 	// Compute priority.
 	if gcScore > 1 {
 		priority += gcScore
@@ -94,6 +104,9 @@ func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool,
 	if intentScore > 1 {
 		priority += intentScore
 	}
+	if tombstoneScore > 1 {
+		priority += tombstoneScore
+	}
 	shouldQ = priority > 0
 	return
 }
@@ -220,6 +233,13 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	if err := rng.AddCmd(proto.InternalGC, gcArgs, &proto.InternalGCResponse{}, true); err != nil {
 		return err
 	}
+	atomic.StoreInt64(&rng.tombstonesSkipped, 0)
+
+	// Compact the range-local keyspace holding the range's transaction
+	// records. Records older than the engine's transaction GC timeout
+	// are dropped as they're compacted, reclaiming their space.
+	localSpan := makeRangeLocalKeyRanges(rng.Desc())[1]
+	rng.rm.Engine().CompactRange(localSpan.start, localSpan.end)
 
 	// Store current timestamp as last verification for this range, as
 	// we've just successfully scanned.
@@ -287,14 +307,10 @@ func (gcq *gcQueue) resolveIntent(rng *Range, key proto.Key, meta *proto.MVCCMet
 // and then iterates from most specific to least, returning the first
 // non-nil GC policy.
 func (gcq *gcQueue) lookupGCPolicy(rng *Range) (proto.GCPolicy, error) {
-	info, err := rng.rm.Gossip().GetInfo(gossip.KeyConfigZone)
+	configMap, err := GossipedConfigMap(rng.rm.Gossip(), gossip.KeyConfigZone)
 	if err != nil {
 		return proto.GCPolicy{}, util.Errorf("unable to fetch zone config from gossip: %s", err)
 	}
-	configMap, ok := info.(PrefixConfigMap)
-	if !ok {
-		return proto.GCPolicy{}, util.Errorf("gossiped info is not a prefix configuration map: %+v", info)
-	}
 
 	// Verify that the range doesn't cross over the zone config prefix.
 	// This could be the case if the zone config is new and the range
@@ -18,6 +18,7 @@
 package storage
 
 import (
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -112,6 +113,62 @@ func TestGCQueueShouldQueue(t *testing.T) {
 	}
 }
 
+// TestGCQueueShouldQueueTombstones verifies that scans over a span
+// in which most keys are deleted report the tombstones skipped, and
+// that enough skipping raises the range's GC priority.
+func TestGCQueueShouldQueueTombstones(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Put an empty GC metadata; all that's read from it is last scan nanos.
+	key := engine.RangeGCMetadataKey(tc.rng.Desc().RaftID)
+	if err := engine.MVCCPutProto(tc.rng.rm.Engine(), nil, key, proto.ZeroTimestamp, nil, &proto.GCMetadata{}); err != nil {
+		t.Fatal(err)
+	}
+	tc.manualClock.Set(1 * time.Second.Nanoseconds())
+
+	// Write 200 keys and delete all but 10 of them.
+	const numKeys, numLive = 200, 10
+	for i := 0; i < numKeys; i++ {
+		k := proto.Key([]byte{'k', byte(i)})
+		pArgs, pReply := putArgs(k, []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+		if i < numKeys-numLive {
+			dArgs, dReply := deleteArgs(k, tc.rng.Desc().RaftID, tc.store.StoreID())
+			if err := tc.rng.AddCmd(proto.Delete, dArgs, dReply, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	gcQ := newGCQueue()
+	if shouldQ, priority := gcQ.shouldQueue(tc.clock.Now(), tc.rng); shouldQ {
+		t.Fatalf("expected range not to be queued before scanning; got priority %f", priority)
+	}
+
+	// Scan repeatedly until the tombstones skipped exceed the normalization.
+	for skipped := int64(0); skipped <= tombstoneSkipNormalization; {
+		sArgs, sReply := scanArgs([]byte("k"), []byte("l"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+			t.Fatal(err)
+		}
+		if len(sReply.Rows) != numLive {
+			t.Fatalf("expected %d rows; got %d", numLive, len(sReply.Rows))
+		}
+		if sReply.TombstonesSkipped != numKeys-numLive {
+			t.Fatalf("expected %d tombstones skipped; got %d", numKeys-numLive, sReply.TombstonesSkipped)
+		}
+		skipped += sReply.TombstonesSkipped
+	}
+
+	if shouldQ, priority := gcQ.shouldQueue(tc.clock.Now(), tc.rng); !shouldQ || priority <= 1 {
+		t.Errorf("expected range to be queued with priority > 1; got %t, %f", shouldQ, priority)
+	}
+}
+
 // TestGCQueueProcess creates test data in the range over various time
 // scales and verifies that scan queue process properly GCs test data.
 func TestGCQueueProcess(t *testing.T) {
@@ -276,6 +333,102 @@ func TestGCQueueProcess(t *testing.T) {
 	}
 }
 
+// TestStoreForceRangeGC verifies that forcing GC of the store's ranges
+// removes values older than the zone's GC TTL while preserving the
+// latest value.
+func TestStoreForceRangeGC(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const now int64 = 48 * 60 * 60 * 1E9 // 2d past the epoch
+	tc.manualClock.Set(now)
+	key := proto.Key("a")
+	for _, ts := range []proto.Timestamp{makeTS(now-2*24*60*60*1E9+1, 0), makeTS(now-1E9, 0)} {
+		pArgs, pReply := putArgs(key, []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		pArgs.Timestamp = ts
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tc.store.ForceRangeGC(); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err := engine.Scan(tc.store.Engine(), engine.MVCCEncodeKey(key), engine.MVCCEncodeKey(key.Next()), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Expect the MVCC metadata and the latest value.
+	if len(kvs) != 2 {
+		t.Fatalf("expected 2 keys after GC; got %d", len(kvs))
+	}
+	if _, ts, _ := engine.MVCCDecodeKey(kvs[1].Key); !ts.Equal(makeTS(now-1E9, 0)) {
+		t.Errorf("expected latest value to remain; got value at %s", ts)
+	}
+}
+
+// TestGCQueueCompactsTransactionRecords verifies that processing a
+// range compacts its range-local keyspace, dropping transaction records
+// eligible for GC and reclaiming the space they occupied.
+func TestGCQueueCompactsTransactionRecords(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	tc.manualClock.Set(1 * time.Second.Nanoseconds())
+	eng := tc.store.Engine()
+
+	// Write several transaction records and flush them so that they
+	// occupy space in the engine's sstables.
+	var txnKeys []proto.Key
+	for i := 0; i < 10; i++ {
+		key := proto.Key(fmt.Sprintf("key%d", i))
+		txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+		txnKey := engine.TransactionKey(key, txn.ID)
+		if err := engine.MVCCPutProto(eng, nil, txnKey, proto.ZeroTimestamp, nil, txn); err != nil {
+			t.Fatal(err)
+		}
+		txnKeys = append(txnKeys, txnKey)
+	}
+	if err := eng.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	localSpan := makeRangeLocalKeyRanges(tc.rng.Desc())[1]
+	sizeBefore, err := eng.ApproximateSize(localSpan.start, localSpan.end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sizeBefore == 0 {
+		t.Fatal("expected transaction records to occupy space before GC")
+	}
+
+	// Make all transaction records eligible for GC and process the range.
+	tc.manualClock.Set(2 * time.Second.Nanoseconds())
+	eng.SetGCTimeouts(tc.clock.Now().WallTime, 0)
+	gcQ := newGCQueue()
+	if err := gcQ.process(tc.clock.Now(), tc.rng); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, txnKey := range txnKeys {
+		ok, err := engine.MVCCGetProto(eng, txnKey, proto.ZeroTimestamp, nil, &proto.Transaction{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			t.Errorf("expected transaction record %q to be GC'd", txnKey)
+		}
+	}
+	sizeAfter, err := eng.ApproximateSize(localSpan.start, localSpan.end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sizeAfter >= sizeBefore {
+		t.Errorf("expected compaction to reduce range-local size below %d; got %d", sizeBefore, sizeAfter)
+	}
+}
+
 // TestGCQueueLookupGCPolicy verifies the hierarchical lookup of GC
 // policy in the event that the longest matching key prefix does not
 // have a zone configured.
@@ -0,0 +1,115 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+const (
+	// hotKeySampleSize is the number of accessed keys retained by a
+	// hotKeySampler.
+	hotKeySampleSize = 256
+	// hotKeysReported is the maximum number of keys returned by
+	// Range.HotKeys.
+	hotKeysReported = 10
+)
+
+// A HotKey is a frequently accessed key and its approximate rate of
+// access.
+type HotKey struct {
+	Key  proto.Key
+	Rate float64 // Estimated accesses per second
+}
+
+// A hotKeySampler maintains a uniform reservoir sample of the keys
+// accessed since it was started, from which the most frequently
+// accessed keys and their access rates can be estimated.
+type hotKeySampler struct {
+	sync.Mutex
+	startNanos int64    // Wall time at which sampling began
+	count      int64    // Number of accesses recorded
+	sample     []string // Reservoir of accessed keys
+}
+
+// newHotKeySampler returns a new, empty hotKeySampler started at the
+// specified wall time.
+func newHotKeySampler(nowNanos int64) *hotKeySampler {
+	return &hotKeySampler{
+		startNanos: nowNanos,
+		sample:     make([]string, 0, hotKeySampleSize),
+	}
+}
+
+// record records an access to key. Each access recorded so far is
+// retained in the sample with equal probability.
+func (hs *hotKeySampler) record(key proto.Key) {
+	hs.Lock()
+	defer hs.Unlock()
+	hs.count++
+	if len(hs.sample) < hotKeySampleSize {
+		hs.sample = append(hs.sample, string(key))
+	} else if i := rand.Int63n(hs.count); i < hotKeySampleSize {
+		hs.sample[i] = string(key)
+	}
+}
+
+// hotKeys returns up to max of the sampled keys in decreasing order of
+// their frequency in the sample, with their access rates estimated as
+// of the specified wall time. Rates are measured over at least one
+// second.
+func (hs *hotKeySampler) hotKeys(nowNanos int64, max int) []HotKey {
+	hs.Lock()
+	defer hs.Unlock()
+	if len(hs.sample) == 0 {
+		return nil
+	}
+	occurrences := map[string]int{}
+	for _, k := range hs.sample {
+		occurrences[k]++
+	}
+	hotKeys := make(hotKeysByRate, 0, len(occurrences))
+	seconds := float64(nowNanos-hs.startNanos) / 1E9
+	if seconds < 1 {
+		seconds = 1
+	}
+	perSample := float64(hs.count) / float64(len(hs.sample)) / seconds
+	for k, n := range occurrences {
+		hotKeys = append(hotKeys, HotKey{Key: proto.Key(k), Rate: float64(n) * perSample})
+	}
+	sort.Sort(hotKeys)
+	if len(hotKeys) > max {
+		hotKeys = hotKeys[:max]
+	}
+	return hotKeys
+}
+
+// hotKeysByRate sorts HotKeys in decreasing order of rate, breaking
+// ties by key.
+type hotKeysByRate []HotKey
+
+func (h hotKeysByRate) Len() int      { return len(h) }
+func (h hotKeysByRate) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h hotKeysByRate) Less(i, j int) bool {
+	if h[i].Rate != h[j].Rate {
+		return h[i].Rate > h[j].Rate
+	}
+	return h[i].Key.Less(h[j].Key)
+}
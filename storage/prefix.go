@@ -21,6 +21,7 @@ import (
 	"bytes"
 	"container/list"
 	"fmt"
+	"reflect"
 	"sort"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -51,6 +52,23 @@ type PrefixConfig struct {
 // account for the ends of prefix ranges.
 type PrefixConfigMap []*PrefixConfig
 
+// PrefixConfigDelta describes the changes from one prefix config map
+// to another: the configs added or updated and the prefixes
+// removed. Only the prefix configs from which the maps were built
+// are included; the end-of-range entries are rebuilt when the delta
+// is applied. When gossiped, Base holds the gossip info timestamp of
+// the full map to which the delta applies.
+type PrefixConfigDelta struct {
+	Base    int64
+	Updated []*PrefixConfig
+	Removed []proto.Key
+}
+
+// Empty returns true if the delta contains no changes.
+func (d PrefixConfigDelta) Empty() bool {
+	return len(d.Updated) == 0 && len(d.Removed) == 0
+}
+
 // RangeResult is returned by SplitRangeByPrefixes.
 type RangeResult struct {
 	start, end proto.Key
@@ -304,3 +322,59 @@ func (p PrefixConfigMap) SplitRangeByPrefixes(start, end proto.Key) ([]*RangeRes
 	}
 	return results, nil
 }
+
+// configs returns the prefix configs from which the map was built,
+// omitting the entries added to mark the ends of prefix ranges.
+func (p PrefixConfigMap) configs() []*PrefixConfig {
+	var configs []*PrefixConfig
+	for _, pc := range p {
+		if pc.Canonical == nil {
+			configs = append(configs, pc)
+		}
+	}
+	return configs
+}
+
+// Diff returns the delta which, applied to p, yields to.
+func (p PrefixConfigMap) Diff(to PrefixConfigMap) PrefixConfigDelta {
+	from := map[string]*PrefixConfig{}
+	for _, pc := range p.configs() {
+		from[string(pc.Prefix)] = pc
+	}
+	var d PrefixConfigDelta
+	for _, pc := range to.configs() {
+		if old, ok := from[string(pc.Prefix)]; !ok || !reflect.DeepEqual(old.Config, pc.Config) {
+			d.Updated = append(d.Updated, &PrefixConfig{Prefix: pc.Prefix, Config: pc.Config})
+		}
+		delete(from, string(pc.Prefix))
+	}
+	for _, pc := range p.configs() {
+		if _, ok := from[string(pc.Prefix)]; ok {
+			d.Removed = append(d.Removed, pc.Prefix)
+		}
+	}
+	return d
+}
+
+// ApplyDelta returns a new prefix config map built from the configs
+// of p with the delta's updates and removals applied. p itself is
+// not modified.
+func (p PrefixConfigMap) ApplyDelta(d PrefixConfigDelta) (PrefixConfigMap, error) {
+	changed := map[string]struct{}{}
+	for _, prefix := range d.Removed {
+		changed[string(prefix)] = struct{}{}
+	}
+	for _, pc := range d.Updated {
+		changed[string(pc.Prefix)] = struct{}{}
+	}
+	var configs []*PrefixConfig
+	for _, pc := range p.configs() {
+		if _, ok := changed[string(pc.Prefix)]; !ok {
+			configs = append(configs, &PrefixConfig{Prefix: pc.Prefix, Config: pc.Config})
+		}
+	}
+	for _, pc := range d.Updated {
+		configs = append(configs, &PrefixConfig{Prefix: pc.Prefix, Config: pc.Config})
+	}
+	return NewPrefixConfigMap(configs)
+}
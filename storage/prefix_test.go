@@ -440,3 +440,44 @@ func TestVisitPrefixes(t *testing.T) {
 		t.Errorf("expected configs %+v; got %+v", expConfigs, configs)
 	}
 }
+
+// TestPrefixConfigMapDelta verifies that applying the delta between
+// two prefix config maps to the first yields the second, and that
+// the delta includes only the changed prefixes.
+func TestPrefixConfigMapDelta(t *testing.T) {
+	from := buildTestPrefixConfigMap()
+	to, err := NewPrefixConfigMap([]*PrefixConfig{
+		{engine.KeyMin, nil, config1},
+		{proto.Key("/db1"), nil, config2},
+		{proto.Key("/db1/table"), nil, config5},
+		{proto.Key("/db2"), nil, config4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := from.Diff(to)
+	expUpdated := []*PrefixConfig{
+		{proto.Key("/db1/table"), nil, config5},
+		{proto.Key("/db2"), nil, config4},
+	}
+	if !reflect.DeepEqual(d.Updated, expUpdated) {
+		t.Errorf("expected updated configs %v; got %v", expUpdated, d.Updated)
+	}
+	if expRemoved := []proto.Key{proto.Key("/db3")}; !reflect.DeepEqual(d.Removed, expRemoved) {
+		t.Errorf("expected removed prefixes %q; got %q", expRemoved, d.Removed)
+	}
+
+	applied, err := from.ApplyDelta(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(applied, to) {
+		t.Errorf("expected applied delta to yield %v; got %v", to, applied)
+	}
+	if !reflect.DeepEqual(from, buildTestPrefixConfigMap()) {
+		t.Errorf("expected original map to be unmodified; got %v", from)
+	}
+	if d := to.Diff(applied); !d.Empty() {
+		t.Errorf("expected empty delta between equal maps; got %+v", d)
+	}
+}
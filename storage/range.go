@@ -22,10 +22,13 @@ package storage
 
 import (
 	"bytes"
+	"crypto/md5"
+	"encoding/binary"
 	"encoding/gob"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -47,12 +50,16 @@ import (
 // init pre-registers RangeDescriptor, PrefixConfigMap types and Transaction.
 func init() {
 	gob.Register(StoreDescriptor{})
+	gob.Register(RangeLoad{})
 	gob.Register(PrefixConfigMap{})
+	gob.Register(PrefixConfigDelta{})
 	gob.Register(&proto.AcctConfig{})
 	gob.Register(&proto.PermConfig{})
 	gob.Register(&proto.ZoneConfig{})
 	gob.Register(proto.RangeDescriptor{})
 	gob.Register(proto.Transaction{})
+	gob.Register(proto.Timestamp{})
+	gob.Register(proto.Replica{})
 }
 
 var (
@@ -70,6 +77,47 @@ var (
 	// continually re-gossiped. The replica which is the raft leader of
 	// the first range gossips it.
 	ttlClusterIDGossip = 30 * time.Second
+
+	// maxTimestampOrderedScanResults bounds the number of keys a scan
+	// ordered by timestamp may buffer for sorting.
+	maxTimestampOrderedScanResults int64 = 10000
+
+	// maxScanParallelism bounds the number of sub-spans which a
+	// parallel scan may scan concurrently.
+	maxScanParallelism = 16
+
+	// ttlClosedTimestampGossip is the time-to-live for a range's
+	// gossiped closed timestamp. Followers which stop hearing about a
+	// range's closed timestamp simply keep the last value they saw.
+	ttlClosedTimestampGossip = 1 * time.Minute
+
+	// DefaultConfigGossipInterval is how often the leader of a range
+	// holding configuration maps re-gossips them, absent changes, so
+	// that gossip peers which missed an update eventually converge.
+	DefaultConfigGossipInterval = 1 * time.Minute
+
+	// configGossipJitter is the fraction of the config gossip interval
+	// by which each wait is randomly shortened, so that ranges don't
+	// re-gossip in lockstep.
+	configGossipJitter = 0.25
+
+	// maxLeaseTransferWait bounds how long a read above the fence of
+	// an in-progress lease transfer waits for the transfer to complete
+	// before it is redirected to retry against the lease holder.
+	maxLeaseTransferWait = 1 * time.Second
+
+	// cmdQTimeoutCheckInterval is how often a command waiting in the
+	// command queue checks the clock against its timeout.
+	cmdQTimeoutCheckInterval = 10 * time.Millisecond
+
+	// intentResolutionRetryOptions are the retry options for resolving
+	// the intents of an ended transaction.
+	intentResolutionRetryOptions = util.RetryOptions{
+		Backoff:     50 * time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+		Constant:    2,
+		MaxAttempts: 10,
+	}
 )
 
 // TestingCommandFilter may be set in tests to intercept the handling of commands
@@ -98,6 +146,13 @@ type configDescriptor struct {
 	configI   interface{} // Config struct interface
 }
 
+// A validatableConfig is a configuration which can verify its own
+// contents.
+type validatableConfig interface {
+	gogoproto.Message
+	Validate() error
+}
+
 // configDescriptors is a slice containing the accounting, permissions
 // and zone configuration descriptors.
 var configDescriptors = []*configDescriptor{
@@ -106,22 +161,67 @@ var configDescriptors = []*configDescriptor{
 	{engine.KeyConfigZonePrefix, gossip.KeyConfigZone, proto.ZoneConfig{}},
 }
 
+// validateConfigWrite returns an error if value, written to key under
+// one of the configuration map prefixes, doesn't hold a valid
+// configuration. Writes to other keys are not checked.
+func validateConfigWrite(key proto.Key, value proto.Value) error {
+	for _, cd := range configDescriptors {
+		if !bytes.HasPrefix(key, cd.keyPrefix) {
+			continue
+		}
+		config := reflect.New(reflect.TypeOf(cd.configI)).Interface().(validatableConfig)
+		if err := gogoproto.Unmarshal(value.Bytes, config); err != nil {
+			return util.Errorf("unable to unmarshal config key %q: %s", key, err)
+		}
+		if err := config.Validate(); err != nil {
+			return util.Errorf("invalid config for key %q: %s", key, err)
+		}
+	}
+	return nil
+}
+
 // tsCacheMethods specifies the set of methods which affect the
 // timestamp cache.
 var tsCacheMethods = map[string]struct{}{
-	proto.Contains:              {},
-	proto.Get:                   {},
-	proto.Put:                   {},
-	proto.ConditionalPut:        {},
-	proto.Increment:             {},
-	proto.Scan:                  {},
-	proto.Delete:                {},
-	proto.DeleteRange:           {},
-	proto.ReapQueue:             {},
-	proto.EnqueueUpdate:         {},
-	proto.EnqueueMessage:        {},
-	proto.InternalResolveIntent: {},
-	proto.InternalMerge:         {},
+	proto.Contains:                   {},
+	proto.Get:                        {},
+	proto.Put:                        {},
+	proto.ConditionalPut:             {},
+	proto.ConditionalDelete:          {},
+	proto.Increment:                  {},
+	proto.Batch:                      {},
+	proto.Scan:                       {},
+	proto.ReverseScan:                {},
+	proto.Delete:                     {},
+	proto.DeleteRange:                {},
+	proto.ReapQueue:                  {},
+	proto.EnqueueUpdate:              {},
+	proto.EnqueueMessage:             {},
+	proto.InternalResolveIntent:      {},
+	proto.InternalResolveIntentRange: {},
+	proto.InternalMerge:              {},
+	proto.InternalPutIfAbsent:        {},
+}
+
+// closedTSExemptMethods specifies the set of writes permitted at or
+// below the closed timestamp: intent resolutions, which commit writes
+// made before the timestamp was closed, and merges, which don't write
+// MVCC versions.
+var closedTSExemptMethods = map[string]struct{}{
+	proto.InternalResolveIntent:      {},
+	proto.InternalResolveIntentRange: {},
+	proto.InternalMerge:              {},
+}
+
+// auditedMethods specifies the set of mutations recorded in a range's
+// audit log when auditing is enabled in its zone config.
+var auditedMethods = map[string]struct{}{
+	proto.Put:               {},
+	proto.ConditionalPut:    {},
+	proto.Increment:         {},
+	proto.Delete:            {},
+	proto.ConditionalDelete: {},
+	proto.DeleteRange:       {},
 }
 
 // UsesTimestampCache returns true if the method affects or is
@@ -135,8 +235,13 @@ func UsesTimestampCache(method string) bool {
 // sent to Raft. Once committed to the Raft log, the command is
 // executed and the result returned via the done channel.
 type pendingCmd struct {
-	Reply proto.Response
-	done  chan error // Used to signal waiting RPC handler
+	Reply    proto.Response
+	done     chan error // Used to signal waiting RPC handler
+	proposed time.Time  // Time at which the command was proposed to Raft
+	// Timestamp of the command; the closed timestamp may not advance
+	// past it while the command is pending.
+	timestamp proto.Timestamp
+	size      int64 // Serialized size of the command in bytes
 }
 
 // A RangeManager is an interface satisfied by Store through which ranges
@@ -149,11 +254,15 @@ type RangeManager interface {
 	DB() *client.KV
 	Engine() engine.Engine
 	Gossip() *gossip.Gossip
+	IntentResolutionSem() chan struct{}
+	KeyAddress(key proto.Key) proto.Key
 	StoreID() proto.StoreID
 	RaftNodeID() multiraft.NodeID
 
 	// Range manipulation methods.
 	AddRange(rng *Range) error
+	AdmitCommand(method string) error
+	LookupRange(start, end proto.Key) *Range
 	MergeRange(subsumingRng *Range, updatedEndKey proto.Key, subsumedRaftID int64) error
 	NewRangeDescriptor(start, end proto.Key, replicas []proto.Replica) (*proto.RangeDescriptor, error)
 	NewSnapshot() engine.Engine
@@ -179,13 +288,81 @@ type Range struct {
 	// Last index persisted to the raft log (not necessarily committed).
 	// Updated atomically.
 	lastIndex uint64
-	closer    chan struct{} // Channel for closing the range
+	// Index of the last raft log entry applied to the state machine;
+	// zero until the first entry is applied after startup. Updated
+	// atomically.
+	appliedIndex uint64
+	// Nanoseconds between proposal and application of the most recently
+	// applied command proposed by this replica. Updated atomically.
+	replLatency int64
+	// Number of keys skipped for lack of a live value by scans since
+	// the range was last garbage collected.
+	// Updated atomically.
+	tombstonesSkipped int64
+	// Generation of this replica, loaded from the range tombstone if
+	// the range was previously removed from this store. A replica of
+	// an earlier generation than the store's tombstone may not propose
+	// commands.
+	generation int64
+	// Atomic pointer for *bloomFilter over the keys written to this
+	// range; nil unless enabled via bloomFilterBits.
+	bloom           unsafe.Pointer
+	bloomFilterBits int // Size of the bloom filter; zero if disabled
+	// Cache of values read by non-transactional Gets; nil if disabled.
+	valueCache *valueCache
+	hotKeys    *hotKeySampler      // Sample of the keys accessed by commands
+	load       *loadTracker        // Counts commands for load gossip
+	feeds      *changeFeedRegistry // Subscribed change feeds
+	// Target lag of the closed timestamp behind the current time; zero
+	// if the closed timestamp is not advanced by this replica.
+	closedTSTarget time.Duration
+	// Maximum number of open intents on the range; zero if unlimited.
+	maxIntents int64
+	// Maximum serialized size of read-write commands; zero if unlimited.
+	maxCommandSize int64
+	// Maximum total size of proposed but unapplied commands; zero if
+	// unlimited.
+	maxPendingBytes int64
+	// Maximum offset of command timestamps ahead of the clock; zero if
+	// unlimited.
+	maxFutureOffset time.Duration
+	// Maximum time, measured by the clock, which a command may wait in
+	// the command queue; zero if unlimited.
+	cmdQTimeout time.Duration
+	// Number of non-transactional writes to a key per second beyond
+	// which they are coalesced; zero if unlimited.
+	maxVersionsPerSecond int
+	writeRates           *writeRateTracker // Per-key write rates; nil if unlimited
+	// Interval at which configuration maps are re-gossiped; zero if
+	// they are gossiped only on change.
+	configGossipInterval time.Duration
+	// Interval at which the range's load is gossiped; zero if it is
+	// not gossiped.
+	loadGossipInterval time.Duration
+	// Serializes config gossip, protecting gossipedConfigs: the
+	// configuration maps most recently gossiped in full, by gossip key,
+	// against which deltas are computed.
+	configGossipMu  sync.Mutex
+	gossipedConfigs map[string]gossipedConfig
+	closer          chan struct{} // Channel for closing the range
 
 	sync.RWMutex                 // Protects the following fields (and Desc)
 	cmdQ         *CommandQueue   // Enforce at most one command is running per key(s)
 	tsCache      *TimestampCache // Most recent timestamps for keys / key ranges
 	respCache    *ResponseCache  // Provides idempotence for retries
 	pendingCmds  map[cmdIDKey]*pendingCmd
+	pendingBytes int64 // Total size of pendingCmds awaiting application
+	// Timestamp at or below which no further writes are accepted and
+	// reads may be served by any replica.
+	closedTS proto.Timestamp
+	// Non-nil while a leader lease transfer is underway. Reads at
+	// timestamps above the fence wait for leaseTransferred to close.
+	leaseFence       *proto.Timestamp
+	leaseTransferred chan struct{}
+	// Replica holding the leader lease, gossiped as the route hint.
+	leaseHolder proto.Replica
+	// The most recently granted leader lease; nil if none has been.
+	leaderLease *proto.Lease
 }
 
 var _ multiraft.WriteableGroupStorage = &Range{}
@@ -199,6 +376,9 @@ func NewRange(desc *proto.RangeDescriptor, rm RangeManager) (*Range, error) {
 		tsCache:     NewTimestampCache(rm.Clock()),
 		respCache:   NewResponseCache(desc.RaftID, rm.Engine()),
 		pendingCmds: map[cmdIDKey]*pendingCmd{},
+		hotKeys:     newHotKeySampler(rm.Clock().PhysicalNow()),
+		load:        newLoadTracker(rm.Clock().PhysicalNow()),
+		feeds:       newChangeFeedRegistry(),
 	}
 	r.SetDesc(desc)
 
@@ -209,6 +389,11 @@ func NewRange(desc *proto.RangeDescriptor, rm RangeManager) (*Range, error) {
 	if r.stats, err = newRangeStats(desc.RaftID, rm.Engine()); err != nil {
 		return nil, err
 	}
+	var tombstone proto.RangeTombstone
+	if _, err := engine.MVCCGetProto(rm.Engine(), engine.RangeTombstoneKey(desc.RaftID), proto.ZeroTimestamp, nil, &tombstone); err != nil {
+		return nil, err
+	}
+	r.generation = tombstone.Generation
 
 	return r, nil
 }
@@ -222,29 +407,74 @@ func (r *Range) String() string {
 // range in the map and gossips config information if the range
 // contains any of the configuration maps.
 func (r *Range) start() {
+	if r.bloomFilterBits > 0 {
+		if err := r.loadBloomFilter(); err != nil {
+			log.Errorf("unable to load bloom filter for range %d: %s", r.Desc().RaftID, err)
+		}
+	}
+	if err := r.loadClosedTimestamp(); err != nil {
+		log.Errorf("unable to load closed timestamp for range %d: %s", r.Desc().RaftID, err)
+	}
+	if err := r.loadLeaderLease(); err != nil {
+		log.Errorf("unable to load leader lease for range %d: %s", r.Desc().RaftID, err)
+	}
 	r.maybeGossipClusterID()
 	r.maybeGossipFirstRange()
+	// Absent a persisted leader lease, which names its own holder, the
+	// leader is taken to hold the range.
+	if r.LeaderLease() == nil && r.IsLeader() {
+		if replica := r.GetReplica(); replica != nil {
+			r.setLeaseHolder(*replica)
+		}
+	}
 	r.maybeGossipConfigs(configDescriptors...)
 	// Only start gossiping if this range is the first range.
 	if r.IsFirstRange() {
 		go r.startGossip()
 	}
+	if r.configGossipInterval > 0 && r.containsConfigs() {
+		go r.startConfigGossip()
+	}
+	if r.loadGossipInterval > 0 {
+		go r.startLoadGossip()
+	}
 }
 
 // Stop ends the log processing loop.
 func (r *Range) stop() {
 	close(r.closer)
+	r.feeds.closeAll(util.Errorf("range %d stopped", r.Desc().RaftID))
 }
 
 // Destroy cleans up all data associated with this range.
 func (r *Range) Destroy() error {
-	var deletes []interface{}
-	iter := newRangeDataIterator(r, r.rm.Engine())
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		deletes = append(deletes, engine.BatchDelete{RawKeyValue: proto.RawKeyValue{Key: iter.Key()}})
+	batch := r.rm.Engine().NewBatch()
+	for _, kr := range makeRangeKeyRanges(r.Desc()) {
+		if err := batch.ClearRange(kr.start, kr.end); err != nil {
+			return err
+		}
 	}
-	return r.rm.Engine().WriteBatch(deletes)
+	return batch.Commit()
+}
+
+// IntentCount returns the number of open write intents on the range.
+func (r *Range) IntentCount() int64 {
+	return r.stats.GetMVCC().IntentCount
+}
+
+// Stats returns the range's MVCC stats. These are cached in memory,
+// updated incrementally as each command commits, and mirror the stats
+// persisted in the engine.
+func (r *Range) Stats() engine.MVCCStats {
+	return r.stats.GetMVCC()
+}
+
+// ReplicationLatency returns the time elapsed between proposal to
+// Raft and application to the state machine of the most recently
+// applied command which was proposed by this replica. Returns zero if
+// no such command has been applied.
+func (r *Range) ReplicationLatency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&r.replLatency))
 }
 
 // IsFirstRange returns true if this is the first range.
@@ -258,6 +488,155 @@ func (r *Range) IsLeader() bool {
 	return true
 }
 
+// BeginLeaseTransfer fences reads in preparation for handing the
+// leader lease to another replica. Reads at or below the fence
+// timestamp continue to be served by this replica; reads above it
+// block until CompleteLeaseTransfer is invoked.
+func (r *Range) BeginLeaseTransfer(fence proto.Timestamp) {
+	r.Lock()
+	defer r.Unlock()
+	if r.leaseFence == nil {
+		r.leaseTransferred = make(chan struct{})
+	}
+	r.leaseFence = &fence
+}
+
+// CompleteLeaseTransfer removes the fence installed by
+// BeginLeaseTransfer once the new leader lease is active, releasing
+// any reads waiting on it.
+func (r *Range) CompleteLeaseTransfer() {
+	r.Lock()
+	defer r.Unlock()
+	if r.leaseFence != nil {
+		close(r.leaseTransferred)
+		r.leaseFence, r.leaseTransferred = nil, nil
+	}
+}
+
+// ExportLeaseTransfer begins a transfer of the leader lease to holder
+// by fencing reads above the supplied timestamp, and returns the
+// state which the incoming holder should install via
+// ImportLeaseTransfer. The transfer is completed by granting the
+// incoming holder the lease via InternalLeaderLease, which advances
+// the lease epoch so that commands this replica proposed under the
+// outgoing lease are rejected if applied after the transfer.
+func (r *Range) ExportLeaseTransfer(fence proto.Timestamp, holder proto.Replica) (*proto.LeaseTransfer, error) {
+	r.BeginLeaseTransfer(fence)
+	entries, err := r.respCache.Export()
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LeaseTransfer{Fence: fence, ResponseCache: entries, Holder: holder}, nil
+}
+
+// ImportLeaseTransfer installs the state exported by the outgoing
+// holder of the leader lease. The imported response cache entries
+// ensure that commands replayed to this replica return their
+// original results instead of being executed again. On success, the
+// incoming holder is gossiped as the range's route hint.
+func (r *Range) ImportLeaseTransfer(lt *proto.LeaseTransfer) error {
+	if err := r.respCache.Import(lt.ResponseCache); err != nil {
+		return err
+	}
+	r.setLeaseHolder(lt.Holder)
+	return nil
+}
+
+// LeaseEpoch returns the epoch of the range's most recently granted
+// leader lease, or zero if none has been granted. As the lease is
+// installed only when its InternalLeaderLease command is applied,
+// or loaded from its persisted value on startup, every replica
+// observes the same epoch at the same point in the raft log.
+func (r *Range) LeaseEpoch() int64 {
+	if lease := r.LeaderLease(); lease != nil {
+		return lease.Epoch
+	}
+	return 0
+}
+
+// LeaderLease returns the range's most recently granted leader lease,
+// or nil if none has been granted.
+func (r *Range) LeaderLease() *proto.Lease {
+	r.RLock()
+	defer r.RUnlock()
+	return r.leaderLease
+}
+
+// loadLeaderLease reads the persisted leader lease.
+func (r *Range) loadLeaderLease() error {
+	lease := &proto.Lease{}
+	ok, err := engine.MVCCGetProto(r.rm.Engine(), engine.RangeLeaderLeaseKey(r.Desc().RaftID), proto.ZeroTimestamp, nil, lease)
+	if err != nil || !ok {
+		return err
+	}
+	r.setLeaderLease(lease)
+	return nil
+}
+
+// setLeaderLease installs a newly granted leader lease, whose replica
+// becomes the lease holder.
+func (r *Range) setLeaderLease(lease *proto.Lease) {
+	r.Lock()
+	r.leaderLease = lease
+	r.Unlock()
+	r.setLeaseHolder(lease.Replica)
+}
+
+// verifyLeaderLease returns an error if this replica may not serve
+// reads locally under the range's leader lease: a NotLeaderError
+// naming the holder if the lease is held by another replica, or one
+// naming no holder if the lease has expired. Reads are not gated on a
+// lease until one has been granted.
+func (r *Range) verifyLeaderLease() error {
+	lease := r.LeaderLease()
+	if lease == nil {
+		return nil
+	}
+	if !r.rm.Clock().Now().Less(lease.Expiration) {
+		return &proto.NotLeaderError{}
+	}
+	if lease.Replica.StoreID != r.rm.StoreID() {
+		return &proto.NotLeaderError{Leader: lease.Replica}
+	}
+	return nil
+}
+
+// verifyGeneration returns a RaftGroupDeletedError if the range was
+// removed from the store after this replica was created, as recorded
+// by a range tombstone of a later generation than the replica's.
+func (r *Range) verifyGeneration() error {
+	var tombstone proto.RangeTombstone
+	if _, err := engine.MVCCGetProto(r.rm.Engine(), engine.RangeTombstoneKey(r.Desc().RaftID), proto.ZeroTimestamp, nil, &tombstone); err != nil {
+		return err
+	}
+	if r.generation < tombstone.Generation {
+		return &proto.RaftGroupDeletedError{RaftID: r.Desc().RaftID}
+	}
+	return nil
+}
+
+// LeaseHolder returns the replica holding the range's leader lease.
+func (r *Range) LeaseHolder() proto.Replica {
+	r.RLock()
+	defer r.RUnlock()
+	return r.leaseHolder
+}
+
+// setLeaseHolder records the replica holding the leader lease and
+// gossips it as the range's route hint, allowing clients to send
+// consistent reads and writes to the holder on the first try.
+func (r *Range) setLeaseHolder(holder proto.Replica) {
+	r.Lock()
+	r.leaseHolder = holder
+	r.Unlock()
+	if r.rm.Gossip() != nil {
+		gossipKey := gossip.MakeRouteHintGossipKey(r.Desc().RaftID)
+		if err := r.rm.Gossip().AddInfo(gossipKey, holder, 0*time.Second); err != nil {
+			log.Errorf("failed to gossip route hint %s: %s", gossipKey, err)
+		}
+	}
+}
+
 // Desc atomically returns the range's descriptor.
 func (r *Range) Desc() *proto.RangeDescriptor {
 	return (*proto.RangeDescriptor)(atomic.LoadPointer(&r.desc))
@@ -279,13 +658,59 @@ func (r *Range) GetReplica() *proto.Replica {
 // Read-lock the mutex to protect access to Desc, which might be changed
 // concurrently via range split.
 func (r *Range) ContainsKey(key proto.Key) bool {
-	return r.Desc().ContainsKey(engine.KeyAddress(key))
+	return r.Desc().ContainsKey(r.rm.KeyAddress(key))
 }
 
 // ContainsKeyRange returns whether this range contains the specified
-// key range from start to end.
+// key range from start (inclusive) to end (exclusive).
 func (r *Range) ContainsKeyRange(start, end proto.Key) bool {
-	return r.Desc().ContainsKeyRange(engine.KeyAddress(start), engine.KeyAddress(end))
+	return r.ContainsKeyRangeStrict(start, end, false)
+}
+
+// ContainsKeyRangeStrict returns whether this range contains the
+// specified key range from start to end. If exclusive is true, end
+// must also address a key within the range.
+func (r *Range) ContainsKeyRangeStrict(start, end proto.Key, exclusive bool) bool {
+	return r.Desc().ContainsKeyRangeStrict(r.rm.KeyAddress(start), r.rm.KeyAddress(end), exclusive)
+}
+
+// EnableBloomFilter builds a bloom filter of the specified size in
+// bits over the keys in this range. Once enabled, the filter is kept
+// up to date as commands write keys and allows Get and Contains to
+// answer for definitely absent keys without reading the engine. It
+// is rebuilt whenever the range is started.
+func (r *Range) EnableBloomFilter(bits int) error {
+	r.bloomFilterBits = bits
+	return r.loadBloomFilter()
+}
+
+// loadBloomFilter builds a new bloom filter from the keys currently
+// stored in the range and installs it in place of any existing one.
+func (r *Range) loadBloomFilter() error {
+	bf := newBloomFilter(r.bloomFilterBits)
+	desc := r.Desc()
+	start, end := engine.MVCCEncodeKey(rangeStartKey(desc)), engine.MVCCEncodeKey(desc.EndKey)
+	if err := r.rm.Engine().Iterate(start, end, func(kv proto.RawKeyValue) (bool, error) {
+		if key, _, isValue := engine.MVCCDecodeKey(kv.Key); !isValue {
+			bf.add(key)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	atomic.StorePointer(&r.bloom, unsafe.Pointer(bf))
+	return nil
+}
+
+// mayContainKey returns false if the range's bloom filter is enabled
+// and key has definitely never been written. Range-local keys, which
+// may be written directly to the engine, are always assumed present.
+func (r *Range) mayContainKey(key proto.Key) bool {
+	bf := (*bloomFilter)(atomic.LoadPointer(&r.bloom))
+	if bf == nil || bytes.HasPrefix(key, engine.KeyLocalPrefix) {
+		return true
+	}
+	return bf.mayContain(key)
 }
 
 // GetGCMetadata reads the latest GC metadata for this range.
@@ -318,6 +743,89 @@ func (r *Range) SetLastVerificationTimestamp(timestamp proto.Timestamp) error {
 	return engine.MVCCPutProto(r.rm.Engine(), nil, key, proto.ZeroTimestamp, nil, &timestamp)
 }
 
+// ClosedTimestamp returns the range's closed timestamp. Writes at or
+// below the closed timestamp are rejected, so reads at or below it
+// observe a fixed view of the range's data.
+func (r *Range) ClosedTimestamp() proto.Timestamp {
+	r.RLock()
+	defer r.RUnlock()
+	return r.closedTS
+}
+
+// CanServeFollowerRead returns true if a read at the specified
+// timestamp may be served by this replica whether or not it is the
+// leader, which is the case if the timestamp is not above the closed
+// timestamp.
+func (r *Range) CanServeFollowerRead(timestamp proto.Timestamp) bool {
+	closedTS := r.ClosedTimestamp()
+	return !closedTS.Equal(proto.ZeroTimestamp) && !closedTS.Less(timestamp)
+}
+
+// loadClosedTimestamp reads the persisted closed timestamp.
+func (r *Range) loadClosedTimestamp() error {
+	var closedTS proto.Timestamp
+	key := engine.RangeClosedTimestampKey(r.Desc().RaftID)
+	if _, err := engine.MVCCGetProto(r.rm.Engine(), key, proto.ZeroTimestamp, nil, &closedTS); err != nil {
+		return err
+	}
+	r.forwardClosedTimestamp(closedTS)
+	return nil
+}
+
+// forwardClosedTimestamp ratchets the closed timestamp forward to the
+// specified timestamp; it never moves backwards. Returns true if the
+// closed timestamp was advanced.
+func (r *Range) forwardClosedTimestamp(timestamp proto.Timestamp) bool {
+	r.Lock()
+	defer r.Unlock()
+	if !r.closedTS.Less(timestamp) {
+		return false
+	}
+	r.closedTS = timestamp
+	return true
+}
+
+// maybeAdvanceClosedTimestamp advances the closed timestamp to lag
+// the current time by the closed timestamp target, provided no
+// pending command has a timestamp at or below the new value. To limit
+// the cost of persisting and gossiping, the closed timestamp is only
+// advanced in increments of at least a tenth of the target.
+func (r *Range) maybeAdvanceClosedTimestamp() {
+	if r.closedTSTarget <= 0 || !r.IsLeader() {
+		return
+	}
+	now := r.rm.Clock().Now()
+	if now.WallTime <= r.closedTSTarget.Nanoseconds() {
+		return
+	}
+	candidate := proto.Timestamp{WallTime: now.WallTime - r.closedTSTarget.Nanoseconds()}
+
+	r.Lock()
+	if candidate.WallTime-r.closedTS.WallTime < (r.closedTSTarget / 10).Nanoseconds() {
+		r.Unlock()
+		return
+	}
+	for _, cmd := range r.pendingCmds {
+		if !candidate.Less(cmd.timestamp) {
+			r.Unlock()
+			return
+		}
+	}
+	r.closedTS = candidate
+	r.Unlock()
+
+	key := engine.RangeClosedTimestampKey(r.Desc().RaftID)
+	if err := engine.MVCCPutProto(r.rm.Engine(), nil, key, proto.ZeroTimestamp, nil, &candidate); err != nil {
+		log.Errorf("unable to persist closed timestamp for range %d: %s", r.Desc().RaftID, err)
+	}
+	if r.rm.Gossip() != nil {
+		gossipKey := gossip.MakeClosedTimestampGossipKey(r.Desc().RaftID)
+		if err := r.rm.Gossip().AddInfo(gossipKey, candidate, ttlClosedTimestampGossip); err != nil {
+			log.Errorf("failed to gossip closed timestamp %s: %s", gossipKey, err)
+		}
+	}
+}
+
 // AddCmd adds a command for execution on this range. The command's
 // affected keys are verified to be contained within the range and the
 // range's leadership is confirmed. The command is then dispatched
@@ -325,36 +833,283 @@ func (r *Range) SetLastVerificationTimestamp(timestamp proto.Timestamp) error {
 // command queue. If wait is false, read-write commands are added to
 // Raft without waiting for their completion.
 func (r *Range) AddCmd(method string, args proto.Request, reply proto.Response, wait bool) error {
-	if !r.IsLeader() {
+	// A batch spans the union of its requests' keys, so that it's
+	// verified, queued and timestamped against all of them.
+	if batchArgs, ok := args.(*proto.BatchRequest); ok {
+		batchArgs.Key, batchArgs.EndKey = batchKeySpan(batchArgs)
+	}
+
+	if err := r.verifyTimestampCeiling(args.Header().Timestamp); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
+
+	// Reads at or below the closed timestamp may be served by any
+	// replica.
+	followerRead := proto.IsReadOnly(method) && !isLockingRead(method, args) &&
+		r.CanServeFollowerRead(args.Header().Timestamp)
+	if !r.IsLeader() && !followerRead {
 		// TODO(spencer): when we happen to know the leader, fill it in here via replica.
 		err := &proto.NotLeaderError{}
 		reply.Header().SetGoError(err)
 		return err
 	}
 
-	// Differentiate between read-only and read-write.
+	// Reads served locally require this replica to hold an unexpired
+	// leader lease.
+	if proto.IsReadOnly(method) && !followerRead {
+		if err := r.verifyLeaderLease(); err != nil {
+			reply.Header().SetGoError(err)
+			return err
+		}
+	}
+
+	// Shed lower priority commands if the store is overloaded.
+	if err := r.rm.AdmitCommand(method); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
+
+	// Reject user writes to reserved system keys.
+	if err := verifyUnprotectedWrite(method, args.Header()); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
+
+	// Sample the keys accessed by client commands.
+	if !proto.IsInternal(method) {
+		r.hotKeys.record(args.Header().Key)
+	}
+	r.load.record(proto.IsReadOnly(method))
+
+	// Differentiate between read-only and read-write. Read-only
+	// commands are served directly from the engine by the leader,
+	// bypassing Raft. Locking reads write locks and so are executed as
+	// read-write commands.
 	if proto.IsAdmin(method) {
 		return r.addAdminCmd(method, args, reply)
-	} else if proto.IsReadOnly(method) {
+	} else if proto.IsReadOnly(method) && !isLockingRead(method, args) {
 		return r.addReadOnlyCmd(method, args, reply)
 	}
+	if err := r.verifyCommandSize(args); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
 	return r.addReadWriteCmd(method, args, reply, wait)
 }
 
+// PendingProposalBytes returns the total serialized size of the
+// commands proposed by this replica which have yet to be applied.
+func (r *Range) PendingProposalBytes() int64 {
+	r.RLock()
+	defer r.RUnlock()
+	return r.pendingBytes
+}
+
+// HotKeys returns the most frequently accessed keys of the range, most
+// accessed first, with their approximate access rates. The keys and
+// rates are estimated from a sample of the keys accessed by client
+// commands.
+func (r *Range) HotKeys() []HotKey {
+	return r.hotKeys.hotKeys(r.rm.Clock().PhysicalNow(), hotKeysReported)
+}
+
+// isLockingRead returns whether the command is a transactional Get
+// which read-locks its key. See proto.GetRequest.Lock.
+func isLockingRead(method string, args proto.Request) bool {
+	if method != proto.Get || args.Header().Txn == nil {
+		return false
+	}
+	return args.(*proto.GetRequest).Lock
+}
+
+// invalidateValueCache removes the value cache entries, if enabled,
+// for the keys written by a committed command. Commands which may
+// write keys outside their header's span, such as garbage collection
+// and commit triggers, clear the cache entirely.
+func (r *Range) invalidateValueCache(method string, args proto.Request) {
+	if r.valueCache == nil {
+		return
+	}
+	clearAll := method == proto.InternalGC
+	if et, ok := args.(*proto.EndTransactionRequest); ok && et.InternalCommitTrigger != nil {
+		clearAll = true
+	}
+	if clearAll {
+		r.valueCache.clear()
+		return
+	}
+	r.valueCache.invalidate(args.Header().Key, args.Header().EndKey)
+}
+
+// maybeCoalescePut marks a non-transactional put for coalescing with
+// the key's latest version once the key has been written more than
+// maxVersionsPerSecond times within the current second. Replacing the
+// latest version is only safe if it hasn't been read at or since its
+// timestamp. As replicas don't share a timestamp cache, this is
+// decided by the leader before the command is proposed.
+func (r *Range) maybeCoalescePut(args *proto.PutRequest) {
+	args.Coalesce = false
+	if r.writeRates == nil || args.Txn != nil ||
+		r.writeRates.record(args.Key, args.Timestamp.WallTime) <= r.maxVersionsPerSecond {
+		return
+	}
+	meta := &proto.MVCCMetadata{}
+	ok, _, _, err := engine.GetProto(r.rm.Engine(), engine.MVCCEncodeKey(args.Key), meta)
+	if err != nil || !ok || meta.Txn != nil {
+		return
+	}
+	r.Lock()
+	rTS, _ := r.tsCache.GetMax(args.Key, nil, proto.NoTxnMD5)
+	r.Unlock()
+	args.Coalesce = rTS.Less(meta.Timestamp)
+}
+
+// commandSize returns the serialized size of args in bytes.
+func commandSize(args proto.Request) (int64, error) {
+	data, err := gogoproto.Marshal(args)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(data)), nil
+}
+
+// batchKeySpan returns the smallest key span containing the keys of
+// all of the batch's requests.
+func batchKeySpan(args *proto.BatchRequest) (proto.Key, proto.Key) {
+	var start, end proto.Key
+	for i := range args.Requests {
+		header := args.Requests[i].GetValue().(proto.Request).Header()
+		key, endKey := header.Key, header.EndKey
+		if len(endKey) == 0 {
+			endKey = key.Next()
+		}
+		if i == 0 || key.Less(start) {
+			start = key
+		}
+		if end.Less(endKey) {
+			end = endKey
+		}
+	}
+	return start, end
+}
+
+// refreshKeySpan returns the span covering both the transaction key of
+// an EndTransaction and the spans whose reads it refreshes.
+func refreshKeySpan(args *proto.EndTransactionRequest) (proto.Key, proto.Key) {
+	start, end := args.Key, args.EndKey
+	if len(end) == 0 {
+		end = start.Next()
+	}
+	for _, span := range args.RefreshSpans {
+		key, endKey := span.Key, span.EndKey
+		if len(endKey) == 0 {
+			endKey = key.Next()
+		}
+		if key.Less(start) {
+			start = key
+		}
+		if end.Less(endKey) {
+			end = endKey
+		}
+	}
+	return start, end
+}
+
+// verifyCommandSize returns a CommandTooLargeError if the range
+// limits the size of the commands it proposes to Raft and the
+// serialized size of args exceeds the limit.
+func (r *Range) verifyCommandSize(args proto.Request) error {
+	if r.maxCommandSize <= 0 {
+		return nil
+	}
+	size, err := commandSize(args)
+	if err != nil {
+		return err
+	}
+	if size > r.maxCommandSize {
+		return &proto.CommandTooLargeError{Size: size, MaxSize: r.maxCommandSize}
+	}
+	return nil
+}
+
+// verifyTimestampCeiling returns a TimestampTooFarError if the range
+// limits how far command timestamps may lead its clock and timestamp
+// exceeds the clock's physical time by more than the limit.
+func (r *Range) verifyTimestampCeiling(timestamp proto.Timestamp) error {
+	if r.maxFutureOffset <= 0 {
+		return nil
+	}
+	maxTS := proto.Timestamp{WallTime: r.rm.Clock().PhysicalNow() + r.maxFutureOffset.Nanoseconds()}
+	if maxTS.Less(timestamp) {
+		return &proto.TimestampTooFarError{Timestamp: timestamp, MaxTimestamp: maxTS}
+	}
+	return nil
+}
+
+// verifyUnprotectedWrite returns a ProtectedKeyError if the command is
+// a public write originated by a user and its key span overlaps the
+// range-local or range metadata keys. Internal commands, and commands
+// issued by UserRoot or without a user, are taken to originate with
+// the system and may write these keys.
+func verifyUnprotectedWrite(method string, header *proto.RequestHeader) error {
+	if !proto.IsPublic(method) || !proto.IsReadWrite(method) ||
+		header.User == "" || header.User == UserRoot {
+		return nil
+	}
+	end := header.EndKey
+	if len(end) == 0 {
+		end = header.Key.Next()
+	}
+	if header.Key.Less(engine.KeyMetaMax) && engine.KeyLocalPrefix.Less(end) {
+		return &proto.ProtectedKeyError{Key: header.Key}
+	}
+	return nil
+}
+
 // beginCmd waits for any overlapping, already-executing commands via
 // the command queue and adds itself to the queue to gate follow-on
-// commands which overlap its key range. This method will block if
+// commands which overlap its key range. Waiting commands of lower
+// priority are admitted after this one. This method will block if
 // there are any overlapping commands already in the queue. Returns
 // the command queue insertion key, to be supplied to subsequent
-// invocation of cmdQ.Remove().
-func (r *Range) beginCmd(start, end proto.Key, readOnly bool) interface{} {
+// invocation of cmdQ.Remove(). If the range has a command queue
+// timeout and the wait exceeds it as measured by the clock, the
+// command is removed from the queue and a CommandQueueTimeoutError is
+// returned.
+func (r *Range) beginCmd(start, end proto.Key, readOnly bool, priority int32) (interface{}, error) {
 	r.Lock()
 	var wg sync.WaitGroup
-	r.cmdQ.GetWait(start, end, readOnly, &wg)
-	cmdKey := r.cmdQ.Add(start, end, readOnly)
+	cmdKey := r.cmdQ.AddWaiting(start, end, readOnly, priority, &wg)
 	r.Unlock()
-	wg.Wait()
-	return cmdKey
+	if r.cmdQTimeout <= 0 {
+		wg.Wait()
+		return cmdKey, nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	clock := r.rm.Clock()
+	deadline := clock.PhysicalNow() + r.cmdQTimeout.Nanoseconds()
+	ticker := time.NewTicker(cmdQTimeoutCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return cmdKey, nil
+		case <-ticker.C:
+			if clock.PhysicalNow() < deadline {
+				continue
+			}
+			r.Lock()
+			r.cmdQ.Remove(cmdKey)
+			r.Unlock()
+			return nil, &proto.CommandQueueTimeoutError{Key: start, EndKey: end, Timeout: r.cmdQTimeout.Nanoseconds()}
+		}
+	}
 }
 
 // addAdminCmd executes the command directly. There is no interaction
@@ -372,15 +1127,74 @@ func (r *Range) addAdminCmd(method string, args proto.Request, reply proto.Respo
 	return reply.Header().GoError()
 }
 
+// waitForLeaseTransfer waits for the lease transfer signaled by
+// transferred to complete, for at most maxLeaseTransferWait or until
+// deadline, if earlier. A NotLeaderError is returned if the wait is
+// abandoned or the range is stopped, so that the read is retried.
+func (r *Range) waitForLeaseTransfer(transferred <-chan struct{}, deadline time.Time) error {
+	wait := maxLeaseTransferWait
+	if !deadline.IsZero() {
+		if d := deadline.Sub(time.Now()); d < wait {
+			wait = d
+		}
+	}
+	select {
+	case <-transferred:
+		return nil
+	case <-r.closer:
+	case <-time.After(wait):
+	}
+	return &proto.NotLeaderError{Leader: r.LeaseHolder()}
+}
+
 // addReadOnlyCmd updates the read timestamp cache and waits for any
 // overlapping writes currently processing through Raft ahead of us to
 // clear via the read queue.
 func (r *Range) addReadOnlyCmd(method string, args proto.Request, reply proto.Response) error {
 	header := args.Header()
+	var deadline time.Time
+	if header.Timeout > 0 {
+		deadline = time.Now().Add(time.Duration(header.Timeout))
+	}
+
+	// A read which permits it is clamped to the closed timestamp where
+	// it would otherwise wait on in-flight writes. No write may be
+	// pending at or below the closed timestamp, so the clamped read
+	// needn't wait in the command queue.
+	clamped := false
+	if header.ClampToClosedTimestamp && header.Txn == nil {
+		r.Lock()
+		if !r.closedTS.Equal(proto.ZeroTimestamp) && r.closedTS.Less(header.Timestamp) &&
+			r.cmdQ.WouldWait(header.Key, header.EndKey, true) {
+			header.Timestamp = r.closedTS
+			clamped = true
+		}
+		r.Unlock()
+	}
+
+	// During a lease transfer, only reads at or below the fence may be
+	// served by the outgoing leader; later reads wait for the transfer
+	// to complete.
+	r.RLock()
+	fence, transferred := r.leaseFence, r.leaseTransferred
+	r.RUnlock()
+	if fence != nil && fence.Less(header.Timestamp) {
+		if err := r.waitForLeaseTransfer(transferred, deadline); err != nil {
+			reply.Header().SetGoError(err)
+			return err
+		}
+	}
 
 	// Add the read to the command queue to gate subsequent
 	// overlapping, commands until this command completes.
-	cmdKey := r.beginCmd(header.Key, header.EndKey, true)
+	var cmdKey interface{}
+	if !clamped {
+		var err error
+		if cmdKey, err = r.beginCmd(header.Key, header.EndKey, true, header.GetUserPriority()); err != nil {
+			reply.Header().SetGoError(err)
+			return err
+		}
+	}
 
 	// It's possible that arbitrary delays (e.g. major GC, VM
 	// de-prioritization, etc.) could cause the execution of this read
@@ -396,19 +1210,23 @@ func (r *Range) addReadOnlyCmd(method string, args proto.Request, reply proto.Re
 	// because any writes during that period necessarily had higher
 	// timestamps. This is because the read-timestamp-cache prevents it
 	// for the active leader and leadership changes force the
-	// read-timestamp-cache to reset its low water mark.
-	if !r.IsLeader() {
+	// read-timestamp-cache to reset its low water mark. Reads at or
+	// below the closed timestamp need no leader, as no write may
+	// precede them.
+	if !r.IsLeader() && !r.CanServeFollowerRead(header.Timestamp) {
 		// TODO(spencer): when we happen to know the leader, fill it in here via replica.
 		return &proto.NotLeaderError{}
 	}
-	err := r.executeCmd(method, args, reply)
+	err := r.executeCmd(method, args, reply, deadline)
 
 	// Only update the timestamp cache if the command succeeded.
 	r.Lock()
 	if err == nil && UsesTimestampCache(method) {
 		r.tsCache.Add(header.Key, header.EndKey, header.Timestamp, header.Txn.MD5(), true /* readOnly */)
 	}
-	r.cmdQ.Remove(cmdKey)
+	if cmdKey != nil {
+		r.cmdQ.Remove(cmdKey)
+	}
 	r.Unlock()
 
 	return err
@@ -445,7 +1263,19 @@ func (r *Range) addReadWriteCmd(method string, args proto.Request, reply proto.R
 	// done before getting the max timestamp for the key(s), as
 	// timestamp cache is only updated after preceding commands have
 	// been run to successful completion.
-	cmdKey := r.beginCmd(header.Key, header.EndKey, false)
+	// A commit refreshing its reads additionally gates writes to the
+	// refreshed spans until it has been applied.
+	cmdStart, cmdEnd := header.Key, header.EndKey
+	var refreshArgs *proto.EndTransactionRequest
+	if etArgs, ok := args.(*proto.EndTransactionRequest); ok && etArgs.Commit && len(etArgs.RefreshSpans) > 0 {
+		refreshArgs = etArgs
+		cmdStart, cmdEnd = refreshKeySpan(etArgs)
+	}
+	cmdKey, err := r.beginCmd(cmdStart, cmdEnd, false, header.GetUserPriority())
+	if err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
 
 	// Two important invariants of Cockroach: 1) encountering a more
 	// recently written value means transaction restart. 2) values must
@@ -482,15 +1312,50 @@ func (r *Range) addReadWriteCmd(method string, args proto.Request, reply proto.R
 		}
 	}
 
-	// Create command and enqueue for Raft.
-	pendingCmd := &pendingCmd{
-		Reply: reply,
-		done:  make(chan error, 1),
-	}
-	raftCmd := proto.InternalRaftCommand{
-		RaftID: r.Desc().RaftID,
+	if method == proto.Put {
+		r.maybeCoalescePut(args.(*proto.PutRequest))
+	} else if batchArgs, ok := args.(*proto.BatchRequest); ok {
+		// Puts within a batch are never coalesced.
+		for i := range batchArgs.Requests {
+			if put := batchArgs.Requests[i].GetPut(); put != nil {
+				put.Coalesce = false
+			}
+		}
 	}
-	var cmdID proto.ClientCmdID
+
+	// Create command and enqueue for Raft.
+	size, err := commandSize(args)
+	if err != nil {
+		r.Lock()
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+		reply.Header().SetGoError(err)
+		return err
+	}
+	// A replica removed from the store may not propose commands. This
+	// is checked before proposal rather than on application, as the
+	// tombstone is local to this store and checking it on application
+	// would cause the replicas of the range to diverge.
+	if err := r.verifyGeneration(); err != nil {
+		r.Lock()
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+		reply.Header().SetGoError(err)
+		return err
+	}
+	pendingCmd := &pendingCmd{
+		Reply:     reply,
+		done:      make(chan error, 1),
+		proposed:  time.Now(),
+		timestamp: header.Timestamp,
+		size:      size,
+	}
+	raftCmd := proto.InternalRaftCommand{
+		RaftID:     r.Desc().RaftID,
+		Generation: r.generation,
+		LeaseEpoch: r.LeaseEpoch(),
+	}
+	var cmdID proto.ClientCmdID
 	if !args.Header().CmdID.IsEmpty() {
 		cmdID = args.Header().CmdID
 	} else {
@@ -505,7 +1370,45 @@ func (r *Range) addReadWriteCmd(method string, args proto.Request, reply proto.R
 	}
 	idKey := makeCmdIDKey(cmdID)
 	r.Lock()
+	// Writes at or below the closed timestamp are rejected. The check
+	// is made while holding the lock under which the command becomes
+	// pending so that the closed timestamp can't advance past it in
+	// the interim.
+	_, exempt := closedTSExemptMethods[method]
+	if UsesTimestampCache(method) && !exempt &&
+		!r.closedTS.Equal(proto.ZeroTimestamp) && !r.closedTS.Less(header.Timestamp) {
+		err := &proto.WriteTooOldError{Timestamp: header.Timestamp, ExistingTimestamp: r.closedTS}
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+		reply.Header().SetGoError(err)
+		return err
+	}
+	// Apply backpressure to client writes while the proposals awaiting
+	// application exceed the limit. A command is always admitted to an
+	// empty buffer so that commands larger than the limit can proceed.
+	if r.maxPendingBytes > 0 && !proto.IsInternal(method) &&
+		r.pendingBytes > 0 && r.pendingBytes+size > r.maxPendingBytes {
+		err := &proto.ProposalBufferFullError{
+			RaftID:       r.Desc().RaftID,
+			PendingBytes: r.pendingBytes,
+			MaxBytes:     r.maxPendingBytes,
+		}
+		r.cmdQ.Remove(cmdKey)
+		r.Unlock()
+		reply.Header().SetGoError(err)
+		return err
+	}
+	// Reads refreshed by a commit are recorded at the commit's
+	// timestamp before it's proposed, so that writes to the refreshed
+	// spans which follow the commit can't be ordered below it. The
+	// commit may not be pushed past this timestamp; see EndTransaction.
+	if refreshArgs != nil {
+		for _, span := range refreshArgs.RefreshSpans {
+			r.tsCache.Add(span.Key, span.EndKey, header.Timestamp, txnMD5, true /* readOnly */)
+		}
+	}
 	r.pendingCmds[idKey] = pendingCmd
+	r.pendingBytes += size
 	r.Unlock()
 	// TODO(bdarnell): In certain raft failover scenarios, proposed
 	// commands may be abandoned. We need to re-propose the command
@@ -525,7 +1428,13 @@ func (r *Range) addReadWriteCmd(method string, args proto.Request, reply proto.R
 			r.tsCache.Add(header.Key, header.EndKey, header.Timestamp, txnMD5, false /* !readOnly */)
 		}
 		r.cmdQ.Remove(cmdKey)
+		r.pendingBytes -= pendingCmd.size
 		r.Unlock()
+		r.maybeAdvanceClosedTimestamp()
+
+		if err == nil && method == proto.EndTransaction {
+			r.resolveIntentsAsync(args.(*proto.EndTransactionRequest), reply.(*proto.EndTransactionResponse))
+		}
 
 		// If the original client didn't wait (e.g. resolve write intent),
 		// log execution errors so they're surfaced somewhere.
@@ -543,7 +1452,81 @@ func (r *Range) addReadWriteCmd(method string, args proto.Request, reply proto.R
 	return nil
 }
 
-func (r *Range) processRaftCommand(idKey cmdIDKey, raftCmd proto.InternalRaftCommand) error {
+// resolveIntentsAsync resolves the intents listed in the arguments to
+// an EndTransaction, using the final transaction record from its
+// reply. Each intent is resolved in its own goroutine, holding a slot
+// of the store's intent resolution semaphore; intents beyond the
+// store's limit wait their turn. Intents within this range are
+// resolved via a local command and others through the store's DB.
+// Failed resolutions are retried with backoff. Resolution is
+// idempotent, so intents which have already been resolved, for
+// example by the transaction's coordinator, are simply skipped.
+func (r *Range) resolveIntentsAsync(args *proto.EndTransactionRequest, reply *proto.EndTransactionResponse) {
+	if reply.Txn == nil || len(args.Intents) == 0 {
+		return
+	}
+	txn := gogoproto.Clone(reply.Txn).(*proto.Transaction)
+	intents := args.Intents
+	sem := r.rm.IntentResolutionSem()
+	go func() {
+		for _, key := range intents {
+			sem <- struct{}{}
+			go func(key proto.Key) {
+				defer func() { <-sem }()
+				r.resolveIntentWithRetry(txn, key)
+			}(key)
+		}
+	}()
+}
+
+// resolveIntentWithRetry resolves the intent at key left by txn,
+// retrying failed resolutions with backoff.
+func (r *Range) resolveIntentWithRetry(txn *proto.Transaction, key proto.Key) {
+	retryOpts := intentResolutionRetryOptions
+	retryOpts.Tag = fmt.Sprintf("resolve intent %q", key)
+	err := util.RetryWithBackoff(retryOpts, func() (util.RetryStatus, error) {
+		resolveArgs := &proto.InternalResolveIntentRequest{
+			RequestHeader: proto.RequestHeader{
+				Timestamp: txn.Timestamp,
+				Key:       key,
+				User:      UserRoot,
+				Txn:       txn,
+			},
+		}
+		resolveReply := &proto.InternalResolveIntentResponse{}
+		var err error
+		if r.ContainsKey(key) {
+			err = r.AddCmd(proto.InternalResolveIntent, resolveArgs, resolveReply, true)
+		} else if db := r.rm.DB(); db != nil {
+			err = db.Call(proto.InternalResolveIntent, resolveArgs, resolveReply)
+		} else {
+			return util.RetryBreak, util.Errorf("no DB available to resolve intent %q", key)
+		}
+		if err != nil {
+			log.V(1).Infof("resolve of intent %q for txn %s failed; retrying: %s", key, txn, err)
+			return util.RetryContinue, nil
+		}
+		return util.RetryBreak, nil
+	})
+	if err != nil {
+		log.Warningf("failed to resolve intent %q for txn %s: %s", key, txn, err)
+	}
+}
+
+// advanceAppliedIndex records that the raft log entry at index is
+// being applied. Entries must be applied in log order without gaps;
+// an error is returned if index does not immediately follow the last
+// applied index.
+func (r *Range) advanceAppliedIndex(index uint64) error {
+	if applied := atomic.LoadUint64(&r.appliedIndex); applied != 0 && index != applied+1 {
+		return util.Errorf("range %d: raft entry %d applied out of order; last applied entry is %d",
+			r.Desc().RaftID, index, applied)
+	}
+	atomic.StoreUint64(&r.appliedIndex, index)
+	return nil
+}
+
+func (r *Range) processRaftCommand(idKey cmdIDKey, index uint64, raftCmd proto.InternalRaftCommand) error {
 	r.Lock()
 	cmd := r.pendingCmds[idKey]
 	delete(r.pendingCmds, idKey)
@@ -566,8 +1549,21 @@ func (r *Range) processRaftCommand(idKey cmdIDKey, raftCmd proto.InternalRaftCom
 			log.Fatal(err)
 		}
 	}
-	err = r.executeCmd(method, args, reply)
+	if err = r.advanceAppliedIndex(index); err != nil {
+		// Applying the command out of order would silently corrupt the
+		// state machine.
+		log.Errorf("refusing to apply raft command: %s", err)
+	} else if raftCmd.LeaseEpoch < r.LeaseEpoch() {
+		// The command was proposed under a lease which has since been
+		// granted to another replica; its proposer may no longer hold
+		// the lease. The epoch is that of the persisted lease, which
+		// all replicas apply at the same log position.
+		err = &proto.NotLeaderError{Leader: r.LeaseHolder()}
+	} else {
+		err = r.executeCmd(method, args, reply, time.Time{})
+	}
 	if cmd != nil {
+		atomic.StoreInt64(&r.replLatency, int64(time.Since(cmd.proposed)))
 		cmd.done <- err
 	} else if err != nil {
 		log.Errorf("error executing raft command: %s", err)
@@ -590,6 +1586,68 @@ func (r *Range) startGossip() {
 	}
 }
 
+// startConfigGossip periodically re-gossips the configuration maps
+// held by this range, waiting a randomly jittered fraction of the
+// config gossip interval between each.
+func (r *Range) startConfigGossip() {
+	for {
+		select {
+		case <-time.After(jitteredInterval(r.configGossipInterval)):
+			r.maybeGossipConfigs(configDescriptors...)
+		case <-r.closer:
+			return
+		}
+	}
+}
+
+// startLoadGossip periodically gossips the range's load, waiting a
+// randomly jittered fraction of the load gossip interval between each.
+func (r *Range) startLoadGossip() {
+	for {
+		select {
+		case <-time.After(jitteredInterval(r.loadGossipInterval)):
+			r.maybeGossipLoad()
+		case <-r.closer:
+			return
+		}
+	}
+}
+
+// maybeGossipLoad gossips the range's command rates since the load
+// was last gossiped, and its size, if this range is the raft leader.
+// The load expires if not re-gossiped within twice the load gossip
+// interval.
+func (r *Range) maybeGossipLoad() {
+	if r.rm.Gossip() == nil || !r.IsLeader() {
+		return
+	}
+	raftID := r.Desc().RaftID
+	ms := r.stats.GetMVCC()
+	load := RangeLoad{RaftID: raftID, Bytes: ms.KeyBytes + ms.ValBytes}
+	load.ReadsPerSecond, load.WritesPerSecond = r.load.rates(r.rm.Clock().PhysicalNow())
+	gossipKey := gossip.MakeRangeLoadGossipKey(raftID)
+	if err := r.rm.Gossip().AddInfo(gossipKey, load, 2*r.loadGossipInterval); err != nil {
+		log.Errorf("failed to gossip range load %s: %s", gossipKey, err)
+	}
+}
+
+// jitteredInterval returns a random duration in the interval
+// (1-configGossipJitter)*interval to interval.
+func jitteredInterval(interval time.Duration) time.Duration {
+	return time.Duration(float64(interval) * (1 - configGossipJitter*rand.Float64()))
+}
+
+// containsConfigs returns whether this range holds any of the
+// configuration maps.
+func (r *Range) containsConfigs() bool {
+	for _, cd := range configDescriptors {
+		if r.ContainsKey(cd.keyPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // maybeGossipClusterID gossips the cluster ID if this range is
 // the start of the key space and the raft leader.
 func (r *Range) maybeGossipClusterID() {
@@ -610,12 +1668,42 @@ func (r *Range) maybeGossipFirstRange() {
 	}
 }
 
-// maybeGossipConfigs gossips configuration maps if their data falls
-// within the range, this replica is the raft leader, and their
+// maybeGossipConfigs gossips configuration maps in full if their data
+// falls within the range, this replica is the raft leader, and their
 // contents are marked dirty. Configuration maps include accounting,
 // permissions, and zones.
 func (r *Range) maybeGossipConfigs(dirtyConfigs ...*configDescriptor) {
+	r.gossipConfigs(false, dirtyConfigs...)
+}
+
+// maybeGossipConfigDeltas is like maybeGossipConfigs, but gossips only
+// the changes to each configuration map since it was last gossiped in
+// full. The changes are folded into the full map when it is next
+// re-gossiped. Maps which haven't yet been gossiped in full by this
+// replica are gossiped in full.
+func (r *Range) maybeGossipConfigDeltas(dirtyConfigs ...*configDescriptor) {
+	r.gossipConfigs(true, dirtyConfigs...)
+}
+
+// gossipedConfig is a configuration map as last gossiped in full,
+// with the timestamp of its gossip info.
+type gossipedConfig struct {
+	configMap PrefixConfigMap
+	timestamp int64
+}
+
+// gossipConfigs gossips the dirty configuration maps held by the
+// range, or only their deltas if deltas is true. A full map is
+// gossiped together with an empty delta. Each delta names the info
+// timestamp of the full map it applies to, so that nodes ignore a
+// delta received out of order with its full map.
+func (r *Range) gossipConfigs(deltas bool, dirtyConfigs ...*configDescriptor) {
 	if r.rm.Gossip() != nil && r.IsLeader() {
+		r.configGossipMu.Lock()
+		defer r.configGossipMu.Unlock()
+		if r.gossipedConfigs == nil {
+			r.gossipedConfigs = map[string]gossipedConfig{}
+		}
 		for _, cd := range dirtyConfigs {
 			if r.ContainsKey(cd.keyPrefix) {
 				// Check for a bad range split. This should never happen as ranges
@@ -627,11 +1715,29 @@ func (r *Range) maybeGossipConfigs(dirtyConfigs ...*configDescriptor) {
 				if err != nil {
 					log.Errorf("failed loading %s config map: %s", cd.gossipKey, err)
 					continue
+				}
+				var delta PrefixConfigDelta
+				base, ok := r.gossipedConfigs[cd.gossipKey]
+				if deltas && ok {
+					delta = base.configMap.Diff(configMap)
 				} else {
 					if err := r.rm.Gossip().AddInfo(cd.gossipKey, configMap, 0*time.Second); err != nil {
 						log.Errorf("failed to gossip %s configMap: %s", cd.gossipKey, err)
 						continue
 					}
+					_, timestamp, err := r.rm.Gossip().GetInfoWithTimestamp(cd.gossipKey)
+					if err != nil {
+						log.Errorf("failed to fetch gossiped %s configMap: %s", cd.gossipKey, err)
+						delete(r.gossipedConfigs, cd.gossipKey)
+						continue
+					}
+					base = gossipedConfig{configMap: configMap, timestamp: timestamp}
+					r.gossipedConfigs[cd.gossipKey] = base
+				}
+				delta.Base = base.timestamp
+				deltaKey := gossip.MakeConfigDeltaGossipKey(cd.gossipKey)
+				if err := r.rm.Gossip().AddInfo(deltaKey, delta, 0*time.Second); err != nil {
+					log.Errorf("failed to gossip %s config delta: %s", cd.gossipKey, err)
 				}
 			}
 		}
@@ -640,7 +1746,9 @@ func (r *Range) maybeGossipConfigs(dirtyConfigs ...*configDescriptor) {
 
 // loadConfigMap scans the config entries under keyPrefix and
 // instantiates/returns a config map. Prefix configuration maps
-// include accounting, permissions, and zones.
+// include accounting, permissions, and zones. Configs identical to
+// the config they would inherit from an enclosing prefix are omitted
+// to keep the map minimal.
 func (r *Range) loadConfigMap(keyPrefix proto.Key, configI interface{}) (PrefixConfigMap, error) {
 	kvs, err := engine.MVCCScan(r.rm.Engine(), keyPrefix, keyPrefix.PrefixEnd(), 0, proto.MaxTimestamp, nil)
 	if err != nil {
@@ -654,22 +1762,64 @@ func (r *Range) loadConfigMap(keyPrefix proto.Key, configI interface{}) (PrefixC
 		if err := gogoproto.Unmarshal(kv.Value.Bytes, config); err != nil {
 			return nil, util.Errorf("unable to unmarshal config key %s: %s", string(kv.Key), err)
 		}
-		configs = append(configs, &PrefixConfig{Prefix: bytes.TrimPrefix(kv.Key, keyPrefix), Config: config})
+		prefix := bytes.TrimPrefix(kv.Key, keyPrefix)
+		// Configs are scanned in key order, so any enclosing prefix has
+		// already been visited. Find the closest retained one.
+		var parent *PrefixConfig
+		for i := len(configs) - 1; i >= 0; i-- {
+			if bytes.HasPrefix(prefix, configs[i].Prefix) {
+				parent = configs[i]
+				break
+			}
+		}
+		if parent != nil && reflect.DeepEqual(parent.Config, config) {
+			continue
+		}
+		configs = append(configs, &PrefixConfig{Prefix: prefix, Config: config})
 	}
 	return NewPrefixConfigMap(configs)
 }
 
-// maybeUpdateGossipConfigs is used to update gossip configs.
-func (r *Range) maybeUpdateGossipConfigs(key proto.Key) {
-	// Check whether this put has modified a configuration map.
+// maybeUpdateGossipConfigs is used to update gossip configs after a
+// write to key, or to the span from key to end (exclusive) if end is
+// non-empty.
+func (r *Range) maybeUpdateGossipConfigs(key, end proto.Key) {
+	// Check whether this write has modified a configuration map.
 	for _, cd := range configDescriptors {
-		if bytes.HasPrefix(key, cd.keyPrefix) {
-			r.maybeGossipConfigs(cd)
-			break
+		if bytes.HasPrefix(key, cd.keyPrefix) ||
+			(len(end) > 0 && key.Less(cd.keyPrefix.PrefixEnd()) && cd.keyPrefix.Less(end)) {
+			r.maybeGossipConfigDeltas(cd)
 		}
 	}
 }
 
+// GossipedConfigMap returns the configuration map gossiped under key,
+// with any gossiped changes to it applied. Changes to a full map
+// other than the one held are ignored.
+func GossipedConfigMap(g *gossip.Gossip, key string) (PrefixConfigMap, error) {
+	info, timestamp, err := g.GetInfoWithTimestamp(key)
+	if err != nil {
+		return nil, err
+	}
+	configMap, ok := info.(PrefixConfigMap)
+	if !ok {
+		return nil, util.Errorf("gossiped info is not a prefix configuration map: %+v", info)
+	}
+	// The delta is absent until first gossiped with the full map.
+	info, err = g.GetInfo(gossip.MakeConfigDeltaGossipKey(key))
+	if err != nil {
+		return configMap, nil
+	}
+	delta, ok := info.(PrefixConfigDelta)
+	if !ok {
+		return nil, util.Errorf("gossiped info is not a prefix configuration delta: %+v", info)
+	}
+	if delta.Base != timestamp || delta.Empty() {
+		return configMap, nil
+	}
+	return configMap.ApplyDelta(delta)
+}
+
 // ShouldSplit returns whether the current size of the range exceeds
 // the max size specified in the zone config.
 func (r *Range) ShouldSplit() bool {
@@ -679,12 +1829,12 @@ func (r *Range) ShouldSplit() bool {
 	}
 
 	// Fetch the zone config for the zone containing this range's start key.
-	zoneMap, err := r.rm.Gossip().GetInfo(gossip.KeyConfigZone)
+	zoneMap, err := GossipedConfigMap(r.rm.Gossip(), gossip.KeyConfigZone)
 	if err != nil || zoneMap == nil {
 		log.Errorf("unable to fetch zone config from gossip: %s", err)
 		return false
 	}
-	prefixConfig := zoneMap.(PrefixConfigMap).MatchByPrefix(r.Desc().StartKey)
+	prefixConfig := zoneMap.MatchByPrefix(r.Desc().StartKey)
 	zone := prefixConfig.Config.(*proto.ZoneConfig)
 
 	// Fetch the current size of this range in total bytes.
@@ -710,8 +1860,78 @@ func (r *Range) maybeSplit() {
 	}
 }
 
+// auditEnabled returns whether the zone config for the zone containing
+// this range's start key enables auditing of mutations.
+func (r *Range) auditEnabled() bool {
+	if r.rm.Gossip() == nil {
+		return false
+	}
+	zoneMap, err := GossipedConfigMap(r.rm.Gossip(), gossip.KeyConfigZone)
+	if err != nil || zoneMap == nil {
+		return false
+	}
+	prefixConfig := zoneMap.MatchByPrefix(r.Desc().StartKey)
+	return prefixConfig.Config.(*proto.ZoneConfig).Audit
+}
+
+// auditValueHash returns the MD5 hash of the value's contents, or nil
+// if the value is nil. The value's timestamp and checksum are excluded.
+func auditValueHash(value *proto.Value) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+	data, err := gogoproto.Marshal(&proto.Value{Bytes: value.Bytes, Integer: value.Integer, Tag: value.Tag})
+	if err != nil {
+		return nil, err
+	}
+	sum := md5.Sum(data)
+	return sum[:], nil
+}
+
+// newAuditEntry returns an audit entry for the mutation described by
+// method and header. For single key mutations, the hash of the prior
+// value is recorded; if it can't be read (e.g. because of a
+// conflicting intent), the mutation itself will fail.
+func newAuditEntry(batch engine.Engine, method string, header *proto.RequestHeader) *proto.AuditEntry {
+	audit := &proto.AuditEntry{
+		User:      header.User,
+		Method:    method,
+		Key:       header.Key,
+		EndKey:    header.EndKey,
+		Timestamp: header.Timestamp,
+	}
+	if len(header.EndKey) == 0 {
+		if value, err := engine.MVCCGet(batch, header.Key, header.Timestamp, header.Txn); err == nil {
+			audit.OldValueHash, _ = auditValueHash(value)
+		}
+	}
+	return audit
+}
+
+// writeAuditEntry completes the audit entry with the hash of the
+// mutation's new value and appends it to the range's audit log in
+// batch, so that it commits atomically with the mutation. seq is the
+// mutation's position within its command.
+func (r *Range) writeAuditEntry(batch engine.Engine, ms *engine.MVCCStats, audit *proto.AuditEntry, header *proto.RequestHeader, seq int32) error {
+	if len(header.EndKey) == 0 {
+		value, err := engine.MVCCGet(batch, header.Key, header.Timestamp, header.Txn)
+		if err != nil {
+			return err
+		}
+		if audit.NewValueHash, err = auditValueHash(value); err != nil {
+			return err
+		}
+	}
+	key := engine.RangeAuditKey(r.Desc().RaftID, header.Timestamp, header.CmdID, header.Key, seq)
+	return engine.MVCCPutProto(batch, ms, key, proto.ZeroTimestamp, nil, audit)
+}
+
 // executeCmd switches over the method and multiplexes to execute the
-// appropriate storage API command.
+// appropriate storage API command. If deadline is non-zero, engine
+// operations which haven't completed by the deadline are abandoned
+// and the command fails with a DeadlineExceededError. Commands applied
+// via Raft must not specify a deadline, as abandoning them would
+// cause replicas to diverge.
 //
 // TODO(Spencer): Differentiate between errors caused by the normal culprits --
 // bad inputs from clients, stale information, etc. and errors which might
@@ -720,7 +1940,7 @@ func (r *Range) maybeSplit() {
 // errors which should be classified as a ReplicaCorruptionError--when those
 // bubble up to the point where we've just tried to execute a Raft command, the
 // Raft replica would need to stall itself.
-func (r *Range) executeCmd(method string, args proto.Request, reply proto.Response) error {
+func (r *Range) executeCmd(method string, args proto.Request, reply proto.Response, deadline time.Time) error {
 	// Verify key is contained within range here to catch any range split
 	// or merge activity.
 	header := args.Header()
@@ -735,15 +1955,37 @@ func (r *Range) executeCmd(method string, args proto.Request, reply proto.Respon
 	}
 
 	// Create a new batch for the command to ensure all or nothing semantics.
-	batch := r.rm.Engine().NewBatch()
+	eng := r.rm.Engine()
+	if !deadline.IsZero() {
+		eng = engine.NewDeadlineEngine(eng, deadline)
+	}
+	batch := eng.NewBatch()
+	// Scans read from a snapshot so that they see a consistent view of
+	// the range for the duration of the command, even as other
+	// commands commit concurrently.
+	var snap engine.Engine
+	if method == proto.Scan || method == proto.ReverseScan {
+		snap = eng.NewSnapshot()
+		defer snap.Stop()
+	}
 	// Create an engine.MVCCStats instance.
 	ms := engine.MVCCStats{}
+	// The keys of write intents committed by resolving intents, whose
+	// values are delivered to change feeds.
+	var committed []proto.Key
+
+	// Begin an audit entry, capturing the prior value, if this is an
+	// audited mutation.
+	var audit *proto.AuditEntry
+	if _, ok := auditedMethods[method]; ok && r.auditEnabled() {
+		audit = newAuditEntry(batch, method, header)
+	}
 
 	switch method {
 	case proto.Contains:
 		r.Contains(batch, args.(*proto.ContainsRequest), reply.(*proto.ContainsResponse))
 	case proto.Get:
-		r.Get(batch, args.(*proto.GetRequest), reply.(*proto.GetResponse))
+		r.Get(batch, &ms, args.(*proto.GetRequest), reply.(*proto.GetResponse))
 	case proto.Put:
 		r.Put(batch, &ms, args.(*proto.PutRequest), reply.(*proto.PutResponse))
 	case proto.ConditionalPut:
@@ -755,7 +1997,7 @@ func (r *Range) executeCmd(method string, args proto.Request, reply proto.Respon
 	case proto.DeleteRange:
 		r.DeleteRange(batch, &ms, args.(*proto.DeleteRangeRequest), reply.(*proto.DeleteRangeResponse))
 	case proto.Scan:
-		r.Scan(batch, args.(*proto.ScanRequest), reply.(*proto.ScanResponse))
+		r.Scan(snap, args.(*proto.ScanRequest), reply.(*proto.ScanResponse))
 	case proto.EndTransaction:
 		r.EndTransaction(batch, args.(*proto.EndTransactionRequest), reply.(*proto.EndTransactionResponse))
 	case proto.ReapQueue:
@@ -773,24 +2015,119 @@ func (r *Range) executeCmd(method string, args proto.Request, reply proto.Respon
 	case proto.InternalPushTxn:
 		r.InternalPushTxn(batch, args.(*proto.InternalPushTxnRequest), reply.(*proto.InternalPushTxnResponse))
 	case proto.InternalResolveIntent:
-		r.InternalResolveIntent(batch, &ms, args.(*proto.InternalResolveIntentRequest), reply.(*proto.InternalResolveIntentResponse))
+		committed = r.InternalResolveIntent(batch, &ms, args.(*proto.InternalResolveIntentRequest), reply.(*proto.InternalResolveIntentResponse))
 	case proto.InternalMerge:
 		r.InternalMerge(batch, &ms, args.(*proto.InternalMergeRequest), reply.(*proto.InternalMergeResponse))
 	case proto.InternalTruncateLog:
 		r.InternalTruncateLog(batch, &ms, args.(*proto.InternalTruncateLogRequest), reply.(*proto.InternalTruncateLogResponse))
+	case proto.InternalBeginTransaction:
+		r.InternalBeginTransaction(batch, args.(*proto.InternalBeginTransactionRequest), reply.(*proto.InternalBeginTransactionResponse))
+	case proto.InternalScanIntents:
+		r.InternalScanIntents(batch, args.(*proto.InternalScanIntentsRequest), reply.(*proto.InternalScanIntentsResponse))
+	case proto.InternalInspectTimestampCache:
+		r.InternalInspectTimestampCache(args.(*proto.InternalInspectTimestampCacheRequest), reply.(*proto.InternalInspectTimestampCacheResponse))
+	case proto.InternalGetTransaction:
+		r.InternalGetTransaction(batch, args.(*proto.InternalGetTransactionRequest), reply.(*proto.InternalGetTransactionResponse))
+	case proto.InternalPutIfAbsent:
+		r.InternalPutIfAbsent(batch, &ms, args.(*proto.InternalPutIfAbsentRequest), reply.(*proto.InternalPutIfAbsentResponse))
+	case proto.InternalRangeKeyBounds:
+		r.InternalRangeKeyBounds(batch, args.(*proto.InternalRangeKeyBoundsRequest), reply.(*proto.InternalRangeKeyBoundsResponse))
+	case proto.InternalVerifyRange:
+		r.InternalVerifyRange(batch, args.(*proto.InternalVerifyRangeRequest), reply.(*proto.InternalVerifyRangeResponse))
+	case proto.ReverseScan:
+		r.ReverseScan(snap, args.(*proto.ReverseScanRequest), reply.(*proto.ReverseScanResponse))
+	case proto.ConditionalDelete:
+		r.ConditionalDelete(batch, &ms, args.(*proto.ConditionalDeleteRequest), reply.(*proto.ConditionalDeleteResponse))
+	case proto.Batch:
+		r.Batch(batch, &ms, args.(*proto.BatchRequest), reply.(*proto.BatchResponse))
+	case proto.InternalResolveIntentRange:
+		committed = r.InternalResolveIntentRange(batch, &ms, args.(*proto.InternalResolveIntentRangeRequest), reply.(*proto.InternalResolveIntentRangeResponse))
+	case proto.InternalLeaderLease:
+		r.InternalLeaderLease(batch, &ms, args.(*proto.InternalLeaderLeaseRequest), reply.(*proto.InternalLeaderLeaseResponse))
+	case proto.InternalComputeChecksum:
+		r.InternalComputeChecksum(batch, args.(*proto.InternalComputeChecksumRequest), reply.(*proto.InternalComputeChecksumResponse))
+	case proto.InternalGetHistory:
+		r.InternalGetHistory(batch, args.(*proto.InternalGetHistoryRequest), reply.(*proto.InternalGetHistoryResponse))
+	case proto.InternalBatchGet:
+		r.InternalBatchGet(batch, args.(*proto.InternalBatchGetRequest), reply.(*proto.InternalBatchGetResponse))
 	default:
 		return util.Errorf("unrecognized command %q", method)
 	}
 
+	// Reject a transactional write which would push the range's count
+	// of open intents past the limit.
+	if reply.Header().Error == nil && header.Txn != nil && r.maxIntents > 0 && ms.IntentCount > 0 {
+		if count := r.IntentCount() + ms.IntentCount; count > r.maxIntents {
+			reply.Header().SetGoError(&proto.TooManyIntentsError{
+				RaftID:      r.Desc().RaftID,
+				IntentCount: count,
+				MaxIntents:  r.maxIntents,
+			})
+		}
+	}
+
+	// Record a successful audited mutation as part of the same batch.
+	if audit != nil && reply.Header().Error == nil {
+		if err := r.writeAuditEntry(batch, &ms, audit, header, 0); err != nil {
+			reply.Header().SetGoError(err)
+		}
+	}
+
 	// On success, flush the MVCC stats to the batch and commit.
+	isWrite := proto.IsReadWrite(method) || isLockingRead(method, args)
 	if err := reply.Header().GoError(); err == nil {
-		if proto.IsReadWrite(method) {
+		if isWrite {
 			r.stats.MergeMVCCStats(batch, &ms, header.Timestamp.WallTime)
-			if err := batch.Commit(); err != nil {
+			// A committed transaction is durable once its batch has been
+			// synced to the engine's write-ahead log.
+			commit := batch.Commit
+			if etArgs, ok := args.(*proto.EndTransactionRequest); ok && etArgs.Commit {
+				commit = batch.CommitSync
+			}
+			if err := commit(); err != nil {
 				reply.Header().SetGoError(err)
 			} else {
 				// After successful commit, update cached stats values.
 				r.stats.Update(ms)
+				reply.Header().StatsDelta = &proto.MVCCStatsDelta{
+					LiveBytes:   ms.LiveBytes,
+					KeyBytes:    ms.KeyBytes,
+					ValBytes:    ms.ValBytes,
+					IntentBytes: ms.IntentBytes,
+					LiveCount:   ms.LiveCount,
+					KeyCount:    ms.KeyCount,
+					ValCount:    ms.ValCount,
+					IntentCount: ms.IntentCount,
+				}
+				// Record the written key in the bloom filter, if enabled.
+				if bf := (*bloomFilter)(atomic.LoadPointer(&r.bloom)); bf != nil {
+					if batchArgs, ok := args.(*proto.BatchRequest); ok {
+						for i := range batchArgs.Requests {
+							bf.add(batchArgs.Requests[i].GetValue().(proto.Request).Header().Key)
+						}
+					} else {
+						bf.add(header.Key)
+					}
+				}
+				r.invalidateValueCache(method, args)
+				r.publishChanges(method, args, committed)
+				// Install a newly granted leader lease from its persisted
+				// value, which carries the epoch assigned on grant.
+				if _, ok := args.(*proto.InternalLeaderLeaseRequest); ok {
+					if err := r.loadLeaderLease(); err != nil {
+						reply.Header().SetGoError(err)
+					}
+				}
+				// Record the span written by a transactional write in the
+				// reply's transaction, from which the coordinator
+				// accumulates the transaction's intents.
+				if header.Txn != nil && (proto.IsTransactional(method) || method == proto.Batch ||
+					isLockingRead(method, args)) {
+					if reply.Header().Txn == nil {
+						reply.Header().Txn = gogoproto.Clone(header.Txn).(*proto.Transaction)
+					}
+					reply.Header().Txn.AddIntent(header.Key, header.EndKey)
+				}
 				// If the commit succeeded, potentially initiate a split of this range.
 				r.maybeSplit()
 			}
@@ -808,10 +2145,25 @@ func (r *Range) executeCmd(method string, args proto.Request, reply proto.Respon
 		err.ExistingTimestamp.Forward(r.rm.Clock().Now())
 	}
 
-	// Maybe update gossip configs on a put if there was no error.
-	if (method == proto.Put || method == proto.ConditionalPut) &&
-		header.Key.Less(engine.KeySystemMax) && reply.Header().Error == nil {
-		r.maybeUpdateGossipConfigs(args.Header().Key)
+	// Maybe update gossip configs if a config write has become visible.
+	// A transactional write leaves an intent, which the writing
+	// transaction reads back as its own but which is only gossiped
+	// once resolved on commit.
+	if header.Key.Less(engine.KeySystemMax) && reply.Header().Error == nil {
+		switch method {
+		case proto.Put, proto.ConditionalPut:
+			if header.Txn == nil {
+				r.maybeUpdateGossipConfigs(header.Key, nil)
+			}
+		case proto.Batch:
+			if header.Txn == nil {
+				r.maybeUpdateGossipConfigs(header.Key, header.EndKey)
+			}
+		case proto.InternalResolveIntent, proto.InternalResolveIntentRange:
+			if header.Txn != nil && header.Txn.Status == proto.COMMITTED {
+				r.maybeUpdateGossipConfigs(header.Key, header.EndKey)
+			}
+		}
 	}
 
 	// Propagate the request timestamp (which may have changed).
@@ -823,79 +2175,486 @@ func (r *Range) executeCmd(method string, args proto.Request, reply proto.Respon
 	// read/write method. This must be done as part of the execution of
 	// raft commands so that every replica maintains the same responses
 	// to continue request idempotence when leadership changes.
-	if proto.IsReadWrite(method) {
+	if isWrite {
 		if putErr := r.respCache.PutResponse(args.Header().CmdID, reply); putErr != nil {
 			log.Errorf("unable to write result of %+v: %+v to the response cache: %s",
 				args, reply, putErr)
 		}
 	}
-
-	// Return the error (if any) set in the reply.
-	return reply.Header().GoError()
-}
-
-// Contains verifies the existence of a key in the key value store.
-func (r *Range) Contains(batch engine.Engine, args *proto.ContainsRequest, reply *proto.ContainsResponse) {
-	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.Txn)
+
+	// Return the error (if any) set in the reply.
+	return reply.Header().GoError()
+}
+
+// Contains verifies the existence of a key in the key value store.
+func (r *Range) Contains(batch engine.Engine, args *proto.ContainsRequest, reply *proto.ContainsResponse) {
+	if !r.mayContainKey(args.Key) {
+		return
+	}
+	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.Txn)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if val != nil {
+		reply.Exists = true
+	}
+}
+
+// Get returns the value for a specified key.
+func (r *Range) Get(batch engine.Engine, ms *engine.MVCCStats, args *proto.GetRequest, reply *proto.GetResponse) {
+	r.get(batch, ms, args, reply, false /* inBatch */)
+}
+
+// get implements Get. A Get within a Batch must see the writes of the
+// batch's preceding requests, which are neither recorded in the bloom
+// filter nor reflected in the value cache until the batch commits, so
+// if inBatch is true both are bypassed.
+func (r *Range) get(batch engine.Engine, ms *engine.MVCCStats, args *proto.GetRequest, reply *proto.GetResponse, inBatch bool) {
+	// A locking read locks the key whether or not it exists.
+	if isLockingRead(proto.Get, args) {
+		if err := engine.MVCCLock(batch, ms, args.Key, args.Txn); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+	} else if !inBatch && !r.mayContainKey(args.Key) {
+		return
+	}
+	cacheable := r.valueCache != nil && args.Txn == nil && !inBatch
+	if cacheable {
+		if val, ok := r.valueCache.get(args.Key, args.Timestamp); ok {
+			reply.Value = val
+			return
+		}
+	}
+	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.Txn)
+	if cacheable && err == nil {
+		r.valueCache.add(args.Key, args.Timestamp, val)
+	}
+	if wiErr, ok := err.(*proto.WriteIntentError); ok {
+		var staleVal *proto.Value
+		if staleVal, err = staleCommittedValue(batch, wiErr, args.Header()); staleVal != nil {
+			val = staleVal
+		}
+	}
+	reply.Value = val
+	reply.SetGoError(err)
+}
+
+// Put sets the value for a specified key. Values written to
+// configuration keys must hold valid configurations.
+func (r *Range) Put(batch engine.Engine, ms *engine.MVCCStats, args *proto.PutRequest, reply *proto.PutResponse) {
+	if err := validateConfigWrite(args.Key, args.Value); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if args.Coalesce && args.Txn == nil {
+		reply.SetGoError(engine.MVCCCoalescePut(batch, ms, args.Key, args.Timestamp, args.Value))
+		return
+	}
+	err := engine.MVCCPut(batch, ms, args.Key, args.Timestamp, args.Value, args.Txn)
+	reply.SetGoError(err)
+}
+
+// ConditionalPut sets the value for a specified key only if
+// the expected value matches. If not, the return value contains
+// the actual value.
+func (r *Range) ConditionalPut(batch engine.Engine, ms *engine.MVCCStats, args *proto.ConditionalPutRequest, reply *proto.ConditionalPutResponse) {
+	if err := validateConfigWrite(args.Key, args.Value); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	err := engine.MVCCConditionalPut(batch, ms, args.Key, args.Timestamp, args.Value, args.ExpValue, args.Txn)
+	reply.SetGoError(err)
+}
+
+// Increment increments the value (interpreted as varint64 encoded) and
+// returns the newly incremented value (encoded as varint64). If no value
+// exists for the key, the request's initial value is incremented. An
+// increment which overflows or exceeds the request's maximum, if any,
+// returns an error.
+func (r *Range) Increment(batch engine.Engine, ms *engine.MVCCStats, args *proto.IncrementRequest, reply *proto.IncrementResponse) {
+	val, err := engine.MVCCIncrementWithBounds(batch, ms, args.Key, args.Timestamp, args.Txn,
+		args.Increment, args.InitialValue, args.Max)
+	reply.NewValue = val
+	reply.SetGoError(err)
+}
+
+// Delete deletes the key and value specified by key.
+func (r *Range) Delete(batch engine.Engine, ms *engine.MVCCStats, args *proto.DeleteRequest, reply *proto.DeleteResponse) {
+	reply.SetGoError(engine.MVCCDelete(batch, ms, args.Key, args.Timestamp, args.Txn))
+}
+
+// ConditionalDelete deletes the key only if the existing value
+// matches the expected value.
+func (r *Range) ConditionalDelete(batch engine.Engine, ms *engine.MVCCStats, args *proto.ConditionalDeleteRequest, reply *proto.ConditionalDeleteResponse) {
+	reply.SetGoError(engine.MVCCConditionalDelete(batch, ms, args.Key, args.Timestamp, args.ExpValue, args.Txn))
+}
+
+// DeleteRange deletes the range of key/value pairs specified by
+// start and end keys.
+func (r *Range) DeleteRange(batch engine.Engine, ms *engine.MVCCStats, args *proto.DeleteRangeRequest, reply *proto.DeleteRangeResponse) {
+	num, err := engine.MVCCDeleteRange(batch, ms, args.Key, args.EndKey, args.MaxEntriesToDelete, args.Timestamp, args.Txn)
+	reply.NumDeleted = num
+	reply.SetGoError(err)
+}
+
+// Batch executes the batch's requests in order as part of the same
+// engine batch, so that they're applied atomically. Each request takes
+// on the batch's timestamp, transaction and user. Execution stops at
+// the first error, which is set on the batch reply and fails the batch
+// as a whole.
+func (r *Range) Batch(batch engine.Engine, ms *engine.MVCCStats, args *proto.BatchRequest, reply *proto.BatchResponse) {
+	reply.Txn = args.Txn
+	auditEnabled := r.auditEnabled()
+	for i := range args.Requests {
+		reply.Completed = int32(i)
+		bArgs := args.Requests[i].GetValue().(proto.Request)
+		method, err := proto.MethodForRequest(bArgs)
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		bHeader := bArgs.Header()
+		bHeader.Timestamp = args.Timestamp
+		bHeader.Txn = args.Txn
+		bHeader.User = args.User
+		if bHeader.UserPriority == nil {
+			bHeader.UserPriority = args.UserPriority
+		}
+
+		// Use the pre-initialized reply if there is one.
+		var bReply proto.Response
+		if i < len(reply.Responses) {
+			bReply = reply.Responses[i].GetValue().(proto.Response)
+		} else if bReply, err = proto.CreateReply(method); err == nil {
+			reply.Add(bReply)
+		}
+
+		// Each audited mutation is recorded individually, as for
+		// mutations sent outside a batch.
+		var audit *proto.AuditEntry
+		if _, ok := auditedMethods[method]; ok && auditEnabled {
+			audit = newAuditEntry(batch, method, bHeader)
+		}
+
+		switch method {
+		case proto.Get:
+			r.get(batch, ms, bArgs.(*proto.GetRequest), bReply.(*proto.GetResponse), true /* inBatch */)
+		case proto.Put:
+			r.Put(batch, ms, bArgs.(*proto.PutRequest), bReply.(*proto.PutResponse))
+		case proto.Increment:
+			r.Increment(batch, ms, bArgs.(*proto.IncrementRequest), bReply.(*proto.IncrementResponse))
+		case proto.Delete:
+			r.Delete(batch, ms, bArgs.(*proto.DeleteRequest), bReply.(*proto.DeleteResponse))
+		default:
+			reply.SetGoError(util.Errorf("unsupported method in batch: %s", method))
+			return
+		}
+		bReply.Header().Timestamp = args.Timestamp
+		if bReply.Header().Error == nil && audit != nil {
+			if err := r.writeAuditEntry(batch, ms, audit, bHeader, int32(i)); err != nil {
+				bReply.Header().SetGoError(err)
+			}
+		}
+		if bReply.Header().Error != nil {
+			reply.Error = bReply.Header().Error
+			return
+		}
+	}
+	reply.Completed = int32(len(args.Requests))
+}
+
+// Scan scans the key range specified by start key through end key up
+// to some maximum number of results. The last key of the iteration is
+// returned with the reply. If args.OrderByTimestamp is set, the span
+// is buffered in its entirety and the rows are sorted by the
+// timestamp of each key's latest version.
+func (r *Range) Scan(batch engine.Engine, args *proto.ScanRequest, reply *proto.ScanResponse) {
+	// Iteration statistics are collected only on request, as counting
+	// versions steps through each examined key's version history.
+	// Skipped tombstones are always counted, as they feed the range's
+	// garbage collection score.
+	stats := &engine.MVCCScanStats{}
+	defer func() {
+		if args.ReturnStats {
+			reply.KeysExamined = stats.KeysExamined
+			reply.VersionsExamined = stats.VersionsExamined
+			reply.VersionsSkipped = stats.VersionsSkipped
+			reply.IntentsEncountered = stats.IntentsEncountered
+		}
+		reply.TombstonesSkipped = stats.TombstonesSkipped
+		atomic.AddInt64(&r.tombstonesSkipped, stats.TombstonesSkipped)
+	}()
+	if !args.OrderByTimestamp {
+		if len(args.ResumeToken) > 0 {
+			key, err := r.resumeScanKey(args)
+			if err != nil {
+				reply.SetGoError(err)
+				return
+			}
+			resumed := *args
+			resumed.Key = key
+			args = &resumed
+		}
+		var rows []proto.KeyValue
+		var err error
+		if args.Parallelism > 1 {
+			rows, err = parallelScanRows(batch, args, args.MaxResults, int(args.Parallelism), stats)
+		} else {
+			rows, err = scanRows(batch, args, args.MaxResults, stats)
+		}
+		if err == nil {
+			if err = verifyRowChecksums(rows); err != nil {
+				rows = nil
+			}
+		}
+		if err == nil && args.MaxResults != 0 && int64(len(rows)) == args.MaxResults {
+			reply.ResumeToken, err = r.makeResumeToken(rows[len(rows)-1].Key.Next())
+		}
+		reply.Rows = rows
+		reply.SetGoError(err)
+		return
+	}
+	rows, err := scanRows(batch, args, maxTimestampOrderedScanResults+1, stats)
+	if err == nil {
+		err = verifyRowChecksums(rows)
+	}
 	if err != nil {
 		reply.SetGoError(err)
 		return
 	}
-	if val != nil {
-		reply.Exists = true
+	if int64(len(rows)) > maxTimestampOrderedScanResults {
+		reply.SetGoError(util.Errorf("scan ordered by timestamp of %q-%q exceeds %d keys",
+			args.Key, args.EndKey, maxTimestampOrderedScanResults))
+		return
+	}
+	sort.Stable(rowsByTimestamp(rows))
+	if args.MaxResults != 0 && int64(len(rows)) > args.MaxResults {
+		rows = rows[:args.MaxResults]
 	}
+	reply.Rows = rows
 }
 
-// Get returns the value for a specified key.
-func (r *Range) Get(batch engine.Engine, args *proto.GetRequest, reply *proto.GetResponse) {
-	val, err := engine.MVCCGet(batch, args.Key, args.Timestamp, args.Txn)
-	reply.Value = val
+// ReverseScan scans the key range specified by start key through end
+// key in descending order up to some maximum number of results.
+func (r *Range) ReverseScan(batch engine.Engine, args *proto.ReverseScanRequest, reply *proto.ReverseScanResponse) {
+	rows, err := engine.MVCCReverseScan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.Txn)
+	if err == nil {
+		if err = verifyRowChecksums(rows); err != nil {
+			rows = nil
+		}
+	}
+	reply.Rows = rows
 	reply.SetGoError(err)
 }
 
-// Put sets the value for a specified key.
-func (r *Range) Put(batch engine.Engine, ms *engine.MVCCStats, args *proto.PutRequest, reply *proto.PutResponse) {
-	err := engine.MVCCPut(batch, ms, args.Key, args.Timestamp, args.Value, args.Txn)
-	reply.SetGoError(err)
+// makeResumeToken returns an encoded resume token for a scan which is
+// to continue at key, recording the range's current bounds.
+func (r *Range) makeResumeToken(key proto.Key) ([]byte, error) {
+	desc := r.Desc()
+	return gogoproto.Marshal(&proto.ScanResumeToken{
+		Key:      key,
+		RaftID:   desc.RaftID,
+		StartKey: desc.StartKey,
+		EndKey:   desc.EndKey,
+	})
 }
 
-// ConditionalPut sets the value for a specified key only if
-// the expected value matches. If not, the return value contains
-// the actual value.
-func (r *Range) ConditionalPut(batch engine.Engine, ms *engine.MVCCStats, args *proto.ConditionalPutRequest, reply *proto.ConditionalPutResponse) {
-	err := engine.MVCCConditionalPut(batch, ms, args.Key, args.Timestamp, args.Value, args.ExpValue, args.Txn)
-	reply.SetGoError(err)
+// resumeScanKey decodes the scan's resume token and returns the key
+// at which to resume. If the range has split or merged since the
+// token was issued, a RangeKeyMismatchError is returned so that the
+// client re-resolves the range for the remainder of the scan.
+func (r *Range) resumeScanKey(args *proto.ScanRequest) (proto.Key, error) {
+	token := &proto.ScanResumeToken{}
+	if err := gogoproto.Unmarshal(args.ResumeToken, token); err != nil {
+		return nil, util.Errorf("invalid scan resume token: %s", err)
+	}
+	desc := r.Desc()
+	if token.RaftID != desc.RaftID || !token.StartKey.Equal(desc.StartKey) || !token.EndKey.Equal(desc.EndKey) {
+		return nil, proto.NewRangeKeyMismatchError(args.Key, args.EndKey, desc)
+	}
+	return token.Key, nil
 }
 
-// Increment increments the value (interpreted as varint64 encoded) and
-// returns the newly incremented value (encoded as varint64). If no value
-// exists for the key, zero is incremented.
-func (r *Range) Increment(batch engine.Engine, ms *engine.MVCCStats, args *proto.IncrementRequest, reply *proto.IncrementResponse) {
-	val, err := engine.MVCCIncrement(batch, ms, args.Key, args.Timestamp, args.Txn, args.Increment)
-	reply.NewValue = val
-	reply.SetGoError(err)
+// verifyRowChecksums verifies each scanned value against its stored
+// checksum, if any. This catches corruption of values on disk during
+// regular traffic. Returns a ChecksumMismatchError naming the key of
+// the first value which fails verification.
+func verifyRowChecksums(rows []proto.KeyValue) error {
+	for i := range rows {
+		if err := rows[i].Value.Verify(rows[i].Key); err != nil {
+			return &proto.ChecksumMismatchError{Key: rows[i].Key}
+		}
+	}
+	return nil
 }
 
-// Delete deletes the key and value specified by key.
-func (r *Range) Delete(batch engine.Engine, ms *engine.MVCCStats, args *proto.DeleteRequest, reply *proto.DeleteResponse) {
-	reply.SetGoError(engine.MVCCDelete(batch, ms, args.Key, args.Timestamp, args.Txn))
+// parallelScanRows divides the span specified by args into up to
+// parallelism sub-spans, bounded by maxScanParallelism, and scans each
+// concurrently from batch, which should be a snapshot so that the
+// sub-spans see a consistent view of the range. The rows are merged in
+// key order and truncated to max, if non-zero. Sub-span boundaries are
+// interpolated between the first and last keys in the span, so keys
+// which are unevenly distributed yield unevenly sized sub-spans.
+// Iteration statistics are accumulated into stats, as for scanRows.
+func parallelScanRows(batch engine.Engine, args *proto.ScanRequest, max int64, parallelism int,
+	stats *engine.MVCCScanStats) ([]proto.KeyValue, error) {
+	if parallelism > maxScanParallelism {
+		parallelism = maxScanParallelism
+	}
+	minKey, maxKey, err := engine.MVCCFindKeyBounds(batch, args.Key, args.EndKey)
+	if err != nil || minKey == nil {
+		return nil, err
+	}
+	bounds := append([]proto.Key{args.Key}, interpolateKeys(minKey, maxKey.Next(), parallelism)...)
+	bounds = append(bounds, args.EndKey)
+
+	n := len(bounds) - 1
+	results := make([][]proto.KeyValue, n)
+	errs := make([]error, n)
+	subStats := make([]engine.MVCCScanStats, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			subArgs := *args
+			subArgs.Key, subArgs.EndKey = bounds[i], bounds[i+1]
+			results[i], errs[i] = scanRows(batch, &subArgs, max, &subStats[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var rows []proto.KeyValue
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		stats.KeysExamined += subStats[i].KeysExamined
+		stats.VersionsExamined += subStats[i].VersionsExamined
+		stats.VersionsSkipped += subStats[i].VersionsSkipped
+		stats.IntentsEncountered += subStats[i].IntentsEncountered
+		stats.TombstonesSkipped += subStats[i].TombstonesSkipped
+		rows = append(rows, results[i]...)
+		if max != 0 && int64(len(rows)) >= max {
+			return rows[:max], nil
+		}
+	}
+	return rows, nil
+}
+
+// interpolateKeys returns up to n-1 ordered keys which divide the span
+// from start to end into n roughly equal parts, interpolating the eight
+// bytes following the keys' common prefix. Fewer keys are returned if
+// the span is too narrow to divide.
+func interpolateKeys(start, end proto.Key, n int) []proto.Key {
+	var p int
+	for p < len(start) && p < len(end) && start[p] == end[p] {
+		p++
+	}
+	suffix := func(k proto.Key) uint64 {
+		var buf [8]byte
+		copy(buf[:], k[p:])
+		return binary.BigEndian.Uint64(buf[:])
+	}
+	a, b := suffix(start), suffix(end)
+	if b <= a || n < 2 {
+		return nil
+	}
+	step := (b - a) / uint64(n)
+	if step == 0 {
+		return nil
+	}
+	keys := make([]proto.Key, 0, n-1)
+	for i := 1; i < n; i++ {
+		k := make(proto.Key, p+8)
+		copy(k, start[:p])
+		binary.BigEndian.PutUint64(k[p:], a+step*uint64(i))
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// scanRows scans the span specified by args, returning up to max
+// rows in key order. Write intents encountered by reads which allow
+// stale values are replaced by their most recent committed version.
+// Skipped tombstones are counted into stats; the remaining iteration
+// statistics are accumulated only if requested by args.ReturnStats.
+func scanRows(batch engine.Engine, args *proto.ScanRequest, max int64, stats *engine.MVCCScanStats) ([]proto.KeyValue, error) {
+	scan := func(key, endKey proto.Key, max int64) ([]proto.KeyValue, error) {
+		if !args.ReturnStats {
+			return engine.MVCCScanCountTombstones(batch, key, endKey, max, args.Timestamp, args.Txn, stats)
+		}
+		return engine.MVCCScanWithStats(batch, key, endKey, max, args.Timestamp, args.Txn, stats)
+	}
+	var rows []proto.KeyValue
+	key := args.Key
+	for {
+		kvs, err := scan(key, args.EndKey, max)
+		wiErr, ok := err.(*proto.WriteIntentError)
+		if !ok {
+			return append(rows, kvs...), err
+		}
+		staleVal, err := staleCommittedValue(batch, wiErr, args.Header())
+		if staleVal == nil {
+			return nil, err
+		}
+		// The span preceding the intent holds no other intents, so it
+		// can be rescanned before appending the stale value and
+		// resuming the scan past the intent.
+		if kvs, err = scan(key, wiErr.Key, max); err != nil {
+			return nil, err
+		}
+		rows = append(rows, kvs...)
+		if max != 0 && int64(len(kvs)) == max {
+			return rows, nil
+		}
+		rows = append(rows, proto.KeyValue{Key: wiErr.Key, Value: *staleVal})
+		if max != 0 {
+			if max -= int64(len(kvs)) + 1; max == 0 {
+				return rows, nil
+			}
+		}
+		key = wiErr.Key.Next()
+	}
 }
 
-// DeleteRange deletes the range of key/value pairs specified by
-// start and end keys.
-func (r *Range) DeleteRange(batch engine.Engine, ms *engine.MVCCStats, args *proto.DeleteRangeRequest, reply *proto.DeleteRangeResponse) {
-	num, err := engine.MVCCDeleteRange(batch, ms, args.Key, args.EndKey, args.MaxEntriesToDelete, args.Timestamp, args.Txn)
-	reply.NumDeleted = num
-	reply.SetGoError(err)
+// rowsByTimestamp sorts scanned rows by the timestamp of their
+// values. Inline values, which carry no timestamp, sort first.
+type rowsByTimestamp []proto.KeyValue
+
+func (r rowsByTimestamp) Len() int      { return len(r) }
+func (r rowsByTimestamp) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r rowsByTimestamp) Less(i, j int) bool {
+	if r[j].Value.Timestamp == nil {
+		return false
+	}
+	return r[i].Value.Timestamp == nil || r[i].Value.Timestamp.Less(*r[j].Value.Timestamp)
 }
 
-// Scan scans the key range specified by start key through end key up
-// to some maximum number of results. The last key of the iteration is
-// returned with the reply.
-func (r *Range) Scan(batch engine.Engine, args *proto.ScanRequest, reply *proto.ScanResponse) {
-	kvs, err := engine.MVCCScan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.Txn)
-	reply.Rows = kvs
-	reply.SetGoError(err)
+// staleCommittedValue is invoked when a non-transactional read
+// encounters the write intent described by wiErr. If the read
+// specifies a MaxStaleness and the most recent committed version
+// beneath the intent is within MaxStaleness of the read timestamp,
+// that version is returned. Otherwise, returns a nil value and wiErr
+// so that the reader pushes the intent's transaction as usual.
+func staleCommittedValue(batch engine.Engine, wiErr *proto.WriteIntentError, header *proto.RequestHeader) (*proto.Value, error) {
+	if header.MaxStaleness <= 0 || header.Txn != nil {
+		return nil, wiErr
+	}
+	var val *proto.Value
+	if err := engine.MVCCIterateCommitted(batch, wiErr.Key, wiErr.Key.Next(), func(kv proto.KeyValue) (bool, error) {
+		val = &kv.Value
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	if val == nil || val.Timestamp == nil ||
+		val.Timestamp.WallTime < header.Timestamp.WallTime-header.MaxStaleness {
+		return nil, wiErr
+	}
+	return val, nil
 }
 
 // EndTransaction either commits or aborts (rolls back) an extant
@@ -942,6 +2701,9 @@ func (r *Range) EndTransaction(batch engine.Engine, args *proto.EndTransactionRe
 		if reply.Txn.Epoch < args.Txn.Epoch {
 			reply.Txn.Epoch = args.Txn.Epoch
 		}
+		if reply.Txn.Restarts < args.Txn.Restarts {
+			reply.Txn.Restarts = args.Txn.Restarts
+		}
 		// Take max of requested priority and existing priority. This isn't
 		// terribly useful, but we do it for completeness.
 		if reply.Txn.Priority < args.Txn.Priority {
@@ -961,13 +2723,38 @@ func (r *Range) EndTransaction(batch engine.Engine, args *proto.EndTransactionRe
 	// Set transaction status to COMMITTED or ABORTED as per the
 	// args.Commit parameter.
 	if args.Commit {
+		// A transaction pushed past its original timestamp which lists
+		// the spans it read re-validates them at the commit timestamp,
+		// committing at the pushed timestamp if they're unchanged.
+		refreshed := false
+		if len(args.RefreshSpans) > 0 && !reply.Txn.Timestamp.Equal(args.Txn.OrigTimestamp) {
+			// The refreshed reads are protected by the timestamp cache
+			// only up to the request's timestamp, so the commit may not
+			// refresh them at a timestamp to which the transaction record
+			// was pushed beyond it.
+			if args.Timestamp.Less(reply.Txn.Timestamp) {
+				reply.SetGoError(proto.NewTransactionRetryError(reply.Txn))
+				return
+			}
+			if err := r.refreshReads(batch, args.RefreshSpans, args.Txn.OrigTimestamp, reply.Txn); err != nil {
+				reply.SetGoError(err)
+				return
+			}
+			refreshed = true
+		}
 		// If the isolation level is SERIALIZABLE, return a transaction
 		// retry error if the commit timestamp isn't equal to the txn
-		// timestamp.
-		if args.Txn.Isolation == proto.SERIALIZABLE && !reply.Txn.Timestamp.Equal(args.Txn.OrigTimestamp) {
+		// timestamp and the txn's reads weren't refreshed.
+		if args.Txn.Isolation == proto.SERIALIZABLE && !refreshed && !reply.Txn.Timestamp.Equal(args.Txn.OrigTimestamp) {
 			reply.SetGoError(proto.NewTransactionRetryError(reply.Txn))
 			return
 		}
+		// A transaction with a deadline may not commit at a timestamp
+		// past that deadline.
+		if reply.Txn.Deadline != nil && reply.Txn.Deadline.Less(reply.Txn.Timestamp) {
+			reply.SetGoError(proto.NewTransactionStatusError(reply.Txn, "transaction deadline exceeded"))
+			return
+		}
 		reply.Txn.Status = proto.COMMITTED
 	} else {
 		reply.Txn.Status = proto.ABORTED
@@ -978,6 +2765,10 @@ func (r *Range) EndTransaction(batch engine.Engine, args *proto.EndTransactionRe
 		reply.SetGoError(err)
 		return
 	}
+	reply.Restarts = reply.Txn.Restarts
+	if reply.Txn.Status == proto.COMMITTED {
+		reply.CommitTimestamp = reply.Txn.Timestamp
+	}
 
 	// Run triggers if successfully committed. Any failures running
 	// triggers will set an error and prevent the batch from committing.
@@ -994,6 +2785,50 @@ func (r *Range) EndTransaction(batch engine.Engine, args *proto.EndTransactionRe
 	}
 }
 
+// refreshReads re-reads the specified spans at the timestamp of txn
+// and returns a TransactionRetryError if any value differs from the
+// one read at origTimestamp, or if another transaction has since
+// written an intent in the spans. The spans must lie within the range.
+// Inline values aren't versioned, so changes to them can't be
+// detected; spans holding inline values can't be refreshed.
+func (r *Range) refreshReads(batch engine.Engine, spans []proto.KeySpan, origTimestamp proto.Timestamp, txn *proto.Transaction) error {
+	// Clock uncertainty doesn't apply to the re-reads.
+	readTxn := gogoproto.Clone(txn).(*proto.Transaction)
+	readTxn.MaxTimestamp = proto.ZeroTimestamp
+	for _, span := range spans {
+		start, end := span.Key, span.EndKey
+		if len(end) == 0 {
+			end = start.Next()
+		}
+		if !r.ContainsKeyRange(start, end) {
+			return proto.NewRangeKeyMismatchError(start, end, r.Desc())
+		}
+		orig, err := engine.MVCCScan(batch, start, end, 0, origTimestamp, readTxn)
+		if err != nil {
+			return err
+		}
+		cur, err := engine.MVCCScan(batch, start, end, 0, txn.Timestamp, readTxn)
+		if _, ok := err.(*proto.WriteIntentError); ok {
+			return proto.NewTransactionRetryError(txn)
+		} else if err != nil {
+			return err
+		}
+		if len(orig) != len(cur) {
+			return proto.NewTransactionRetryError(txn)
+		}
+		for i := range orig {
+			origTS, curTS := orig[i].Value.Timestamp, cur[i].Value.Timestamp
+			if origTS == nil || curTS == nil {
+				return util.Errorf("cannot refresh read of inline value at key %q", orig[i].Key)
+			}
+			if !orig[i].Key.Equal(cur[i].Key) || !origTS.Equal(*curTS) {
+				return proto.NewTransactionRetryError(txn)
+			}
+		}
+	}
+	return nil
+}
+
 // ReapQueue destructively queries messages from a delivery inbox
 // queue. This method must be called from within a transaction.
 func (r *Range) ReapQueue(batch engine.Engine, args *proto.ReapQueueRequest, reply *proto.ReapQueueResponse) {
@@ -1090,6 +2925,76 @@ func (r *Range) InternalRangeLookup(batch engine.Engine, args *proto.InternalRan
 	return
 }
 
+// InternalBeginTransaction writes the PENDING transaction record for
+// the transaction specified in the request header, persisting its
+// isolation, priority and deadline. An error is returned if a record
+// for the transaction already exists.
+func (r *Range) InternalBeginTransaction(batch engine.Engine, args *proto.InternalBeginTransactionRequest, reply *proto.InternalBeginTransactionResponse) {
+	if args.Txn == nil {
+		reply.SetGoError(util.Errorf("no transaction specified to InternalBeginTransaction"))
+		return
+	}
+	key := engine.TransactionKey(args.Txn.Key, args.Txn.ID)
+
+	var existTxn proto.Transaction
+	ok, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, nil, &existTxn)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if ok {
+		reply.SetGoError(proto.NewTransactionStatusError(&existTxn, "transaction record already exists"))
+		return
+	}
+
+	txn := gogoproto.Clone(args.Txn).(*proto.Transaction)
+	txn.Status = proto.PENDING
+	if txn.LastHeartbeat == nil {
+		txn.LastHeartbeat = &proto.Timestamp{}
+		*txn.LastHeartbeat = args.Header().Timestamp
+	}
+	if err := engine.MVCCPutProto(batch, nil, key, proto.ZeroTimestamp, nil, txn); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	reply.Txn = txn
+}
+
+// InternalScanIntents scans the key span specified in the request
+// header and returns the write intents found, grouped by the
+// transaction which owns them. Transactions are listed in the order
+// in which their first intent was encountered.
+func (r *Range) InternalScanIntents(batch engine.Engine, args *proto.InternalScanIntentsRequest, reply *proto.InternalScanIntentsResponse) {
+	byTxn := map[string]int{}
+	err := engine.MVCCIterateIntents(batch, args.Key, args.EndKey, func(key proto.Key, meta *proto.MVCCMetadata) (bool, error) {
+		idx, ok := byTxn[string(meta.Txn.ID)]
+		if !ok {
+			idx = len(reply.TxnIntents)
+			byTxn[string(meta.Txn.ID)] = idx
+			reply.TxnIntents = append(reply.TxnIntents, proto.InternalTxnIntents{Txn: *meta.Txn})
+		}
+		reply.TxnIntents[idx].Keys = append(reply.TxnIntents[idx].Keys, key)
+		return false, nil
+	})
+	reply.SetGoError(err)
+}
+
+// InternalInspectTimestampCache returns the maximum read and write
+// timestamps recorded in the timestamp cache for the key span
+// specified in the request header, along with whether each was
+// supplied by the cache's low water mark. Entries belonging to the
+// request's transaction, if any, are ignored as they are by GetMax.
+func (r *Range) InternalInspectTimestampCache(args *proto.InternalInspectTimestampCacheRequest, reply *proto.InternalInspectTimestampCacheResponse) {
+	r.Lock()
+	defer r.Unlock()
+	rTS, wTS := r.tsCache.GetMax(args.Key, args.EndKey, args.Txn.MD5())
+	lowWater := r.tsCache.LowWater()
+	reply.ReadTimestamp = rTS
+	reply.WriteTimestamp = wTS
+	reply.ReadLowWater = rTS.Equal(lowWater)
+	reply.WriteLowWater = wTS.Equal(lowWater)
+}
+
 // InternalHeartbeatTxn updates the transaction status and heartbeat
 // timestamp after receiving transaction heartbeat messages from
 // coordinator. Returns the updated transaction.
@@ -1108,12 +3013,27 @@ func (r *Range) InternalHeartbeatTxn(batch engine.Engine, args *proto.InternalHe
 		gogoproto.Merge(&txn, args.Txn)
 	}
 	if txn.Status == proto.PENDING {
+		// Ratchet the epoch; the coordinator heartbeats with the
+		// incremented epoch when the transaction restarts, making
+		// intents from earlier epochs pushable.
+		if txn.Epoch < args.Txn.Epoch {
+			txn.Epoch = args.Txn.Epoch
+		}
+		if txn.Restarts < args.Txn.Restarts {
+			txn.Restarts = args.Txn.Restarts
+		}
 		if txn.LastHeartbeat == nil {
 			txn.LastHeartbeat = &proto.Timestamp{}
 		}
 		if txn.LastHeartbeat.Less(args.Header().Timestamp) {
 			*txn.LastHeartbeat = args.Header().Timestamp
 		}
+		// Record the intents the coordinator has accumulated.
+		if ok {
+			for _, span := range args.Txn.Intents {
+				txn.AddIntent(span.Key, span.EndKey)
+			}
+		}
 		if err := engine.MVCCPutProto(batch, nil, key, proto.ZeroTimestamp, nil, &txn); err != nil {
 			reply.SetGoError(err)
 			return
@@ -1122,6 +3042,165 @@ func (r *Range) InternalHeartbeatTxn(batch engine.Engine, args *proto.InternalHe
 	reply.Txn = &txn
 }
 
+// InternalPutIfAbsent writes the value to the key only if the key is
+// absent, returning whether the value was inserted and the key's
+// resulting value. As with ConditionalPut, the key's existence is
+// checked at the max timestamp to detect write intents written by
+// concurrent transactions at newer timestamps.
+func (r *Range) InternalPutIfAbsent(batch engine.Engine, ms *engine.MVCCStats, args *proto.InternalPutIfAbsentRequest, reply *proto.InternalPutIfAbsentResponse) {
+	existVal, err := engine.MVCCGet(batch, args.Key, proto.MaxTimestamp, args.Txn)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if existVal != nil {
+		reply.Value = existVal
+		return
+	}
+	if err := engine.MVCCPut(batch, ms, args.Key, args.Timestamp, args.Value, args.Txn); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	value, ts := args.Value, args.Timestamp
+	value.Timestamp = &ts
+	reply.Inserted = true
+	reply.Value = &value
+}
+
+// InternalGetTransaction reads the complete record of the transaction
+// with the anchor key and ID specified in the arguments. If no record
+// exists, the reply's Txn is nil.
+func (r *Range) InternalGetTransaction(batch engine.Engine, args *proto.InternalGetTransactionRequest, reply *proto.InternalGetTransactionResponse) {
+	key := engine.TransactionKey(args.Key, args.TxnID)
+
+	var txn proto.Transaction
+	ok, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, nil, &txn)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if ok {
+		reply.Txn = &txn
+	}
+}
+
+// InternalRangeKeyBounds returns the smallest and largest keys with
+// data in the range. Range-local keys, which are stored beneath the
+// range's start key when it is KeyMin, are excluded.
+func (r *Range) InternalRangeKeyBounds(batch engine.Engine, args *proto.InternalRangeKeyBoundsRequest, reply *proto.InternalRangeKeyBoundsResponse) {
+	desc := r.Desc()
+	start := desc.StartKey
+	if start.Less(engine.KeyLocalMax) {
+		start = engine.KeyLocalMax
+	}
+	minKey, maxKey, err := engine.MVCCFindKeyBounds(batch, start, desc.EndKey)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	reply.MinKey, reply.MaxKey = minKey, maxKey
+}
+
+// InternalVerifyRange reports the keys stored outside the range's
+// descriptor bounds which no range on the store holds, as may be left
+// behind by a faulty split or merge. Range-local keys are ignored.
+func (r *Range) InternalVerifyRange(batch engine.Engine, args *proto.InternalVerifyRangeRequest, reply *proto.InternalVerifyRangeResponse) {
+	desc := r.Desc()
+	spans := [][2]proto.EncodedKey{
+		{engine.MVCCEncodeKey(engine.KeyLocalMax), engine.MVCCEncodeKey(desc.StartKey)},
+		{engine.MVCCEncodeKey(desc.EndKey), proto.EncodedKey(engine.KeyMax)},
+	}
+	for _, span := range spans {
+		if !span[0].Less(span[1]) {
+			continue
+		}
+		var done bool
+		err := batch.Iterate(span[0], span[1], func(kv proto.RawKeyValue) (bool, error) {
+			key, _, isValue := engine.MVCCDecodeKey(kv.Key)
+			if isValue || r.rm.LookupRange(key, key) != nil {
+				return false, nil
+			}
+			reply.OutOfBoundsKeys = append(reply.OutOfBoundsKeys, key)
+			done = args.MaxKeys > 0 && int64(len(reply.OutOfBoundsKeys)) >= args.MaxKeys
+			return done, nil
+		})
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		if done {
+			return
+		}
+	}
+}
+
+// InternalComputeChecksum computes a checksum of the range's key/value
+// pairs as of the header's timestamp, together with its MVCC stats,
+// for comparison with the checksums computed by other replicas. To
+// compare followers, the timestamp should be closed; see
+// CanServeFollowerRead.
+func (r *Range) InternalComputeChecksum(batch engine.Engine, args *proto.InternalComputeChecksumRequest, reply *proto.InternalComputeChecksumResponse) {
+	desc := r.Desc()
+	var ms engine.MVCCStats
+	if err := engine.MVCCGetRangeStats(batch, desc.RaftID, &ms); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	checksum, err := engine.MVCCComputeChecksum(batch, desc.StartKey, desc.EndKey, args.Timestamp, &ms)
+	reply.Checksum = checksum
+	reply.SetGoError(err)
+}
+
+// InternalGetHistory returns the committed versions of the key, newest
+// first. Versions newer than the header's timestamp are omitted, as are
+// those no older than args.Before, if specified.
+func (r *Range) InternalGetHistory(batch engine.Engine, args *proto.InternalGetHistoryRequest, reply *proto.InternalGetHistoryResponse) {
+	before := args.Timestamp.Add(0, 1)
+	if !args.Before.Equal(proto.ZeroTimestamp) {
+		before.Backward(args.Before)
+	}
+	versions, err := engine.MVCCGetHistory(batch, args.Key, before, args.MaxVersions)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	reply.Versions = make([]proto.MVCCVersion, len(versions))
+	for i, v := range versions {
+		reply.Versions[i] = proto.MVCCVersion{Timestamp: v.Timestamp, Deleted: v.Deleted, Value: v.Value}
+	}
+}
+
+// InternalBatchGet returns the values of the listed keys, in order. A
+// missing key yields an entry with a nil value. Each key must lie
+// within the span of the request header, which the command queue
+// holds for the duration of the lookups.
+func (r *Range) InternalBatchGet(batch engine.Engine, args *proto.InternalBatchGetRequest, reply *proto.InternalBatchGetResponse) {
+	end := args.EndKey
+	if len(end) == 0 {
+		end = args.Key.Next()
+	}
+	values := make([]proto.BatchGetValue, len(args.Keys))
+	for i, key := range args.Keys {
+		if key.Less(args.Key) || !key.Less(end) {
+			reply.SetGoError(util.Errorf("key %q outside of batch get span %q-%q", key, args.Key, end))
+			return
+		}
+		if !r.mayContainKey(key) {
+			continue
+		}
+		val, err := engine.MVCCGet(batch, key, args.Timestamp, args.Txn)
+		if wiErr, ok := err.(*proto.WriteIntentError); ok {
+			val, err = staleCommittedValue(batch, wiErr, args.Header())
+		}
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		values[i].Value = val
+	}
+	reply.Values = values
+}
+
 // InternalGC iterates through the list of keys to garbage collect
 // specified in the arguments. MVCCGarbageCollect is invoked on each
 // listed key along with the expiration timestamp. The GC metadata
@@ -1133,6 +3212,33 @@ func (r *Range) InternalGC(batch engine.Engine, ms *engine.MVCCStats, args *prot
 		return
 	}
 
+	// Delete the specified transaction records, which must be
+	// finalized and no newer than the threshold.
+	for _, key := range args.TxnKeys {
+		txn := &proto.Transaction{}
+		ok, err := engine.MVCCGetProto(batch, key, proto.ZeroTimestamp, nil, txn)
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+		if !ok {
+			continue
+		}
+		if txn.Status == proto.PENDING {
+			reply.SetGoError(util.Errorf("cannot garbage collect pending transaction record %q", key))
+			return
+		}
+		if args.TxnThreshold.Less(txn.Timestamp) {
+			reply.SetGoError(util.Errorf("cannot garbage collect transaction record %q at %s newer than threshold %s",
+				key, txn.Timestamp, args.TxnThreshold))
+			return
+		}
+		if err := engine.MVCCDelete(batch, ms, key, proto.ZeroTimestamp, nil); err != nil {
+			reply.SetGoError(err)
+			return
+		}
+	}
+
 	// Store the GC metadata for this range.
 	key := engine.RangeGCMetadataKey(r.Desc().RaftID)
 	err := engine.MVCCPutProto(batch, ms, key, proto.ZeroTimestamp, nil, &args.GCMeta)
@@ -1270,6 +3376,8 @@ func (r *Range) InternalPushTxn(batch engine.Engine, args *proto.InternalPushTxn
 	}
 
 	// Upgrade priority of pushed transaction to one less than pusher's.
+	// The pushee inherits the persisted priority on restart, so that
+	// repeated pushes ratchet it upward and it can't be starved.
 	reply.PusheeTxn.UpgradePriority(priority - 1)
 
 	// If aborting transaction, set new status and return success.
@@ -1291,18 +3399,68 @@ func (r *Range) InternalPushTxn(batch engine.Engine, args *proto.InternalPushTxn
 // InternalResolveIntent updates the transaction status and heartbeat
 // timestamp after receiving transaction heartbeat messages from
 // coordinator. The range will return the current status for this
-// transaction to the coordinator.
-func (r *Range) InternalResolveIntent(batch engine.Engine, ms *engine.MVCCStats, args *proto.InternalResolveIntentRequest, reply *proto.InternalResolveIntentResponse) {
+// transaction to the coordinator. Returns the keys of the write
+// intents committed.
+func (r *Range) InternalResolveIntent(batch engine.Engine, ms *engine.MVCCStats, args *proto.InternalResolveIntentRequest, reply *proto.InternalResolveIntentResponse) []proto.Key {
 	if args.Txn == nil {
 		reply.SetGoError(util.Errorf("no transaction specified to InternalResolveIntent"))
-		return
+		return nil
 	}
 	if len(args.EndKey) == 0 || bytes.Equal(args.Key, args.EndKey) {
-		reply.SetGoError(engine.MVCCResolveWriteIntent(batch, ms, args.Key, args.Timestamp, args.Txn))
-	} else {
-		_, err := engine.MVCCResolveWriteIntentRange(batch, ms, args.Key, args.EndKey, 0, args.Timestamp, args.Txn)
+		committed, err := engine.MVCCResolveWriteIntent(batch, ms, args.Key, args.Timestamp, args.Txn)
+		reply.SetGoError(err)
+		if err != nil || !committed {
+			return nil
+		}
+		return []proto.Key{args.Key}
+	}
+	_, committed, err := engine.MVCCResolveWriteIntentRange(batch, ms, args.Key, args.EndKey, 0, args.Timestamp, args.Txn)
+	reply.SetGoError(err)
+	return committed
+}
+
+// InternalResolveIntentRange resolves all of the intents written by
+// the specified transaction in the span from Key to EndKey with a
+// single scan, updating MVCC stats once for the span. Returns the keys
+// of the write intents committed.
+func (r *Range) InternalResolveIntentRange(batch engine.Engine, ms *engine.MVCCStats, args *proto.InternalResolveIntentRangeRequest, reply *proto.InternalResolveIntentRangeResponse) []proto.Key {
+	if args.Txn == nil {
+		reply.SetGoError(util.Errorf("no transaction specified to InternalResolveIntentRange"))
+		return nil
+	}
+	_, committed, err := engine.MVCCResolveWriteIntentRange(batch, ms, args.Key, args.EndKey, 0, args.Timestamp, args.Txn)
+	reply.SetGoError(err)
+	return committed
+}
+
+// InternalLeaderLease grants the requested leader lease, persisting
+// it under the range's lease key. The lease is refused with a
+// NotLeaderError if it would begin before the expiration of a lease
+// held by another replica. A replica renews its own lease by
+// requesting a later expiration. The lease epoch is carried over
+// from the persisted lease on renewal and advanced when the lease is
+// granted to a different replica.
+func (r *Range) InternalLeaderLease(batch engine.Engine, ms *engine.MVCCStats, args *proto.InternalLeaderLeaseRequest, reply *proto.InternalLeaderLeaseResponse) {
+	lease := args.Lease
+	if !lease.Start.Less(lease.Expiration) {
+		reply.SetGoError(util.Errorf("lease expiration %s must follow its start %s", lease.Expiration, lease.Start))
+		return
+	}
+	prev := proto.Lease{}
+	ok, err := engine.MVCCGetProto(batch, engine.RangeLeaderLeaseKey(r.Desc().RaftID), proto.ZeroTimestamp, nil, &prev)
+	if err != nil {
 		reply.SetGoError(err)
+		return
+	}
+	if ok && prev.Replica.StoreID != lease.Replica.StoreID && lease.Start.Less(prev.Expiration) {
+		reply.SetGoError(&proto.NotLeaderError{Leader: prev.Replica})
+		return
 	}
+	lease.Epoch = prev.Epoch
+	if !ok || prev.Replica.StoreID != lease.Replica.StoreID {
+		lease.Epoch++
+	}
+	reply.SetGoError(engine.MVCCPutProto(batch, ms, engine.RangeLeaderLeaseKey(r.Desc().RaftID), proto.ZeroTimestamp, nil, &lease))
 }
 
 // InternalMerge is used to merge a value into an existing key. Merge is an
@@ -1434,7 +3592,9 @@ func (r *Range) changeReplicasTrigger(change *proto.ChangeReplicasTrigger) error
 // a distributed txn which writes updated and new range descriptors, and
 // updates the range addressing metadata. The handover of responsibility for
 // the reassigned key range is carried out seamlessly through a split trigger
-// carried out as part of the commit of that transaction.
+// carried out as part of the commit of that transaction. If args.DryRun
+// is set, the split key is validated and the projected sizes of the
+// two halves are returned without carrying out the split.
 func (r *Range) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSplitResponse) {
 	// Only allow a single split per range at a time.
 	if !atomic.CompareAndSwapInt32(&r.metaLock, int32(0), int32(1)) {
@@ -1447,10 +3607,10 @@ func (r *Range) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSpli
 	// allowed to be relatively slow because admin commands don't block
 	// other commands.
 	desc := r.Desc()
+	snap := r.rm.NewSnapshot()
+	defer snap.Stop()
 	splitKey := proto.Key(args.SplitKey)
 	if len(splitKey) == 0 {
-		snap := r.rm.NewSnapshot()
-		defer snap.Stop()
 		var err error
 		if splitKey, err = engine.MVCCFindSplitKey(snap, desc.RaftID, desc.StartKey, desc.EndKey); err != nil {
 			reply.SetGoError(util.Errorf("unable to determine split key: %s", err))
@@ -1471,6 +3631,24 @@ func (r *Range) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSpli
 		reply.SetGoError(util.Errorf("range has already been split by key %q", splitKey))
 		return
 	}
+	reply.SplitKey = splitKey
+
+	if args.DryRun {
+		nowNanos := r.rm.Clock().Now().WallTime
+		leftMS, err := engine.MVCCComputeStats(snap, desc.StartKey, splitKey, nowNanos)
+		if err != nil {
+			reply.SetGoError(util.Errorf("unable to compute stats for left half of split: %s", err))
+			return
+		}
+		rightMS, err := engine.MVCCComputeStats(snap, splitKey, desc.EndKey, nowNanos)
+		if err != nil {
+			reply.SetGoError(util.Errorf("unable to compute stats for right half of split: %s", err))
+			return
+		}
+		reply.LeftBytes = leftMS.KeyBytes + leftMS.ValBytes
+		reply.RightBytes = rightMS.KeyBytes + rightMS.ValBytes
+		return
+	}
 
 	// Create new range descriptor with newly-allocated replica IDs and Raft IDs.
 	newDesc, err := r.rm.NewRangeDescriptor(splitKey, desc.EndKey, desc.Replicas)
@@ -1521,6 +3699,22 @@ func (r *Range) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSpli
 	}
 }
 
+// Split divides the range at splitKey. The range retains the keys
+// preceding splitKey and a new range, added to the store, takes the
+// keys from splitKey through the original end key. The range data and
+// stats are divided between the two by the split's commit trigger.
+func (r *Range) Split(splitKey proto.Key) error {
+	desc := r.Desc()
+	args := &proto.AdminSplitRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:    desc.StartKey,
+			RaftID: desc.RaftID,
+		},
+		SplitKey: splitKey,
+	}
+	return r.AddCmd(proto.AdminSplit, args, &proto.AdminSplitResponse{}, true)
+}
+
 // InitialState implements the raft.Storage interface.
 func (r *Range) InitialState() (raftpb.HardState, raftpb.ConfState, error) {
 	var hs raftpb.HardState
@@ -1704,6 +3898,13 @@ func (r *Range) ApplySnapshot(snap raftpb.Snapshot) error {
 	r.SetDesc(desc)
 	atomic.StoreUint64(&r.firstIndex, snap.Metadata.Index+1)
 	atomic.StoreUint64(&r.lastIndex, snap.Metadata.Index)
+	atomic.StoreUint64(&r.appliedIndex, snap.Metadata.Index)
+	if err == nil && r.bloomFilterBits > 0 {
+		err = r.loadBloomFilter()
+	}
+	if r.valueCache != nil {
+		r.valueCache.clear()
+	}
 	return err
 }
 
@@ -1770,6 +3971,7 @@ func (r *Range) AdminMerge(args *proto.AdminMergeRequest, reply *proto.AdminMerg
 	// their replicas.
 	if !ReplicaSetsEqual(subsumedDesc.GetReplicas(), desc.GetReplicas()) {
 		reply.SetGoError(util.Error("The two ranges replicas are not collocate"))
+		return
 	}
 
 	// Init updated version of existing range descriptor.
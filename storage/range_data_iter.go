@@ -41,30 +41,73 @@ type rangeDataIterator struct {
 	iter     engine.Iterator
 }
 
+// makeRangeIDKeyRange returns the key range containing all
+// system-local metadata keyed by the specified Raft ID.
+func makeRangeIDKeyRange(raftID int64) keyRange {
+	return keyRange{
+		start: engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(raftID)))),
+		end:   engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(raftID+1)))),
+	}
+}
+
+// rangeStartKey returns the first user key which may be stored in
+// the range with the specified descriptor. The first range begins
+// after the local keyspace.
+func rangeStartKey(d *proto.RangeDescriptor) proto.Key {
+	if d.StartKey.Equal(engine.KeyMin) {
+		return engine.KeyLocalMax
+	}
+	return d.StartKey
+}
+
+// makeRangeLocalKeyRanges returns the key ranges which comprise the
+// system-local metadata for the range with the specified descriptor:
+// the Raft ID-local metadata (e.g. response cache, Raft state and
+// range stats) and the range-local metadata addressed by key
+// (e.g. the range descriptor and transaction records).
+func makeRangeLocalKeyRanges(d *proto.RangeDescriptor) []keyRange {
+	return []keyRange{
+		makeRangeIDKeyRange(d.RaftID),
+		{
+			start: engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, rangeStartKey(d)))),
+			end:   engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, d.EndKey))),
+		},
+	}
+}
+
+// makeRangeKeyRanges returns the key ranges which comprise all of
+// the data for the range with the specified descriptor: the Raft
+// ID-local metadata, the range-local metadata addressed by key and
+// the user data.
+func makeRangeKeyRanges(d *proto.RangeDescriptor) []keyRange {
+	return append(makeRangeLocalKeyRanges(d), keyRange{
+		start: engine.MVCCEncodeKey(rangeStartKey(d)),
+		end:   engine.MVCCEncodeKey(d.EndKey),
+	})
+}
+
 func newRangeDataIterator(r *Range, e engine.Engine) *rangeDataIterator {
 	r.RLock()
-	startKey := r.Desc().StartKey
-	if startKey.Equal(engine.KeyMin) {
-		startKey = engine.KeyLocalMax
-	}
-	endKey := r.Desc().EndKey
+	desc := r.Desc()
+	r.RUnlock()
+	return newKeyRangesIterator(makeRangeKeyRanges(desc), e)
+}
+
+// newRangeLocalIterator returns an iterator over only the
+// system-local metadata of the range, omitting all user data.
+func newRangeLocalIterator(r *Range, e engine.Engine) *rangeDataIterator {
+	r.RLock()
+	desc := r.Desc()
 	r.RUnlock()
+	return newKeyRangesIterator(makeRangeLocalKeyRanges(desc), e)
+}
+
+// newKeyRangesIterator returns an iterator over the supplied key
+// ranges, which must be sorted and non-overlapping.
+func newKeyRangesIterator(ranges []keyRange, e engine.Engine) *rangeDataIterator {
 	ri := &rangeDataIterator{
-		ranges: []keyRange{
-			{
-				start: engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(r.Desc().RaftID)))),
-				end:   engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeIDPrefix, encoding.EncodeUvarint(nil, uint64(r.Desc().RaftID+1)))),
-			},
-			{
-				start: engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, startKey))),
-				end:   engine.MVCCEncodeKey(engine.MakeKey(engine.KeyLocalRangeKeyPrefix, encoding.EncodeBytes(nil, endKey))),
-			},
-			{
-				start: engine.MVCCEncodeKey(startKey),
-				end:   engine.MVCCEncodeKey(endKey),
-			},
-		},
-		iter: e.NewIterator(),
+		ranges: ranges,
+		iter:   e.NewIterator(),
 	}
 	ri.iter.Seek(ri.ranges[ri.curIndex].start)
 	ri.advance()
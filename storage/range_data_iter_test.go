@@ -88,6 +88,65 @@ func createRangeData(r *Range, t *testing.T) []proto.EncodedKey {
 	return keys
 }
 
+// TestRangeLocalIterator verifies that the range-local iterator
+// visits the range's descriptor, stats and transaction records but
+// none of its user keys.
+func TestRangeLocalIterator(t *testing.T) {
+	tc := testContext{
+		bootstrapMode: bootstrapRangeOnly,
+	}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// See notes in EmptyRange test method for adjustment to descriptor.
+	newDesc := *tc.rng.Desc()
+	newDesc.StartKey = proto.Key("b")
+	newDesc.EndKey = proto.Key("c")
+	tc.rng.SetDesc(&newDesc)
+
+	var expKeys []proto.EncodedKey
+	for _, key := range createRangeData(tc.rng, t) {
+		if k, _, _ := engine.MVCCDecodeKey(key); bytes.HasPrefix(k, engine.KeyLocalPrefix) {
+			expKeys = append(expKeys, key)
+		}
+	}
+	mustVisit := map[string]struct{}{
+		string(engine.MVCCEncodeKey(engine.RangeDescriptorKey(newDesc.StartKey))):                    {},
+		string(engine.MVCCEncodeKey(engine.RangeStatKey(newDesc.RaftID, engine.StatKeyBytes))):       {},
+		string(engine.MVCCEncodeKey(engine.TransactionKey(newDesc.StartKey, []byte("1234")))):        {},
+		string(engine.MVCCEncodeKey(engine.TransactionKey(prevKey(newDesc.EndKey), []byte("2468")))): {},
+	}
+
+	iter := newRangeLocalIterator(tc.rng, tc.rng.rm.Engine())
+	defer iter.Close()
+	i := 0
+	for ; iter.Valid(); iter.Next() {
+		if err := iter.Error(); err != nil {
+			t.Fatal(err)
+		}
+		key := iter.Key()
+		if k, _, _ := engine.MVCCDecodeKey(key); !bytes.HasPrefix(k, engine.KeyLocalPrefix) {
+			t.Fatalf("%d: unexpected user key %q", i, k)
+		}
+		if i >= len(expKeys) {
+			t.Fatal("there are more keys in the iteration than expected")
+		}
+		if !key.Equal(expKeys[i]) {
+			k1, ts1, _ := engine.MVCCDecodeKey(key)
+			k2, ts2, _ := engine.MVCCDecodeKey(expKeys[i])
+			t.Errorf("%d: expected %q(%d); got %q(%d)", i, k2, ts2, k1, ts1)
+		}
+		delete(mustVisit, string(key))
+		i++
+	}
+	if i != len(expKeys) {
+		t.Errorf("expected %d keys; got %d", len(expKeys), i)
+	}
+	if len(mustVisit) > 0 {
+		t.Errorf("expected descriptor, stats and transaction records to be visited; missed %d", len(mustVisit))
+	}
+}
+
 // TestRangeDataIterator verifies correct operation of iterator if
 // a range contains no data and never has.
 func TestRangeDataIteratorEmptyRange(t *testing.T) {
@@ -0,0 +1,76 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultLoadGossipInterval is how often the leader of a range gossips
+// the range's load.
+const DefaultLoadGossipInterval = 10 * time.Second
+
+// A RangeLoad is a lightweight summary of the load on a range,
+// gossiped by the range's leader for use in rebalancing decisions.
+type RangeLoad struct {
+	RaftID          int64
+	ReadsPerSecond  float64 // Read-only commands per second
+	WritesPerSecond float64 // Read-write commands per second
+	Bytes           int64   // Total key and value bytes of the range
+}
+
+// A loadTracker counts the commands executed on a range since the
+// load was last reported.
+type loadTracker struct {
+	sync.Mutex
+	startNanos int64 // Wall time at which counting began
+	reads      int64
+	writes     int64
+}
+
+// newLoadTracker returns a new loadTracker started at the specified
+// wall time.
+func newLoadTracker(nowNanos int64) *loadTracker {
+	return &loadTracker{startNanos: nowNanos}
+}
+
+// record counts a command, which is a read if readOnly is true and a
+// write otherwise.
+func (lt *loadTracker) record(readOnly bool) {
+	lt.Lock()
+	defer lt.Unlock()
+	if readOnly {
+		lt.reads++
+	} else {
+		lt.writes++
+	}
+}
+
+// rates returns the read and write rates per second since counting
+// began, as of the specified wall time, and restarts counting. Rates
+// are measured over at least one second.
+func (lt *loadTracker) rates(nowNanos int64) (reads, writes float64) {
+	lt.Lock()
+	defer lt.Unlock()
+	seconds := float64(nowNanos-lt.startNanos) / 1E9
+	if seconds < 1 {
+		seconds = 1
+	}
+	reads, writes = float64(lt.reads)/seconds, float64(lt.writes)/seconds
+	lt.startNanos, lt.reads, lt.writes = nowNanos, 0, 0
+	return reads, writes
+}
@@ -20,6 +20,7 @@ package storage
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"sync"
@@ -106,7 +107,7 @@ type testContext struct {
 
 // testContext.Start initializes the test context with a single range covering the
 // entire keyspace.
-func (tc *testContext) Start(t *testing.T) {
+func (tc *testContext) Start(t testing.TB) {
 	if tc.gossip == nil {
 		rpcContext := rpc.NewContext(hlc.NewClock(hlc.UnixNano), rpc.LoadInsecureTLSConfig())
 		tc.gossip = gossip.New(rpcContext, gossip.TestInterval, "")
@@ -169,7 +170,7 @@ func (tc *testContext) Stop() {
 }
 
 // initConfigs creates default configuration entries.
-func initConfigs(e engine.Engine, t *testing.T) {
+func initConfigs(e engine.Engine, t testing.TB) {
 	if err := engine.MVCCPutProto(e, nil, engine.KeyConfigAccountingPrefix, proto.MinTimestamp, nil, &testDefaultAcctConfig); err != nil {
 		t.Fatal(err)
 	}
@@ -232,6 +233,55 @@ func TestRangeContains(t *testing.T) {
 		engine.RangeDescriptorKey([]byte("b"))) {
 		t.Errorf("expected range to contain key transaction range \"aa\"-\"b\"")
 	}
+	if r.ContainsKeyRangeStrict(proto.Key("aa"), proto.Key("b"), true) {
+		t.Errorf("expected range not to contain key range \"aa\"-\"b\" with exclusive end")
+	}
+	if !r.ContainsKeyRangeStrict(proto.Key("a"), proto.Key("aa"), true) {
+		t.Errorf("expected range to contain key range \"a\"-\"aa\" with exclusive end")
+	}
+}
+
+// TestRangeContainsKeyAddressFunc verifies that range membership is
+// determined by the store's custom key address transformation.
+func TestRangeContainsKeyAddressFunc(t *testing.T) {
+	desc := &proto.RangeDescriptor{
+		RaftID:   1,
+		StartKey: proto.Key("a"),
+		EndKey:   proto.Key("b"),
+	}
+
+	e := engine.NewInMem(proto.Attributes{Attrs: []string{"dc1", "mem"}}, 1<<20)
+	clock := hlc.NewClock(hlc.UnixNano)
+	transport := multiraft.NewLocalRPCTransport()
+	defer transport.Close()
+	store := NewStore(clock, e, nil, nil, multiraft.NewLocalRPCTransport())
+	defer store.Stop()
+	r, err := NewRange(desc, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tenantKey := proto.Key("tenant1/aa")
+	if r.ContainsKey(tenantKey) {
+		t.Errorf("expected range not to contain key %q by default", tenantKey)
+	}
+
+	// Strip the tenant prefix from the default address.
+	tenantPrefix := []byte("tenant1/")
+	store.KeyAddressFunc = func(key proto.Key) proto.Key {
+		return bytes.TrimPrefix(engine.KeyAddress(key), tenantPrefix)
+	}
+	if !r.ContainsKey(tenantKey) {
+		t.Errorf("expected range to contain key %q", tenantKey)
+	}
+	if r.ContainsKey(proto.Key("tenant1/bb")) {
+		t.Errorf("expected range not to contain key \"tenant1/bb\"")
+	}
+	if !r.ContainsKey(engine.RangeDescriptorKey([]byte("tenant1/aa"))) {
+		t.Errorf("expected range to contain range descriptor key for %q", tenantKey)
+	}
+	if !r.ContainsKeyRange(tenantKey, proto.Key("tenant1/b")) {
+		t.Errorf("expected range to contain key range \"tenant1/aa\"-\"tenant1/b\"")
+	}
 }
 
 // TestRangeGossipFirstRange verifies that the first range gossips its
@@ -314,15 +364,14 @@ func TestRangeGossipConfigWithMultipleKeyPrefixes(t *testing.T) {
 	}
 	reply := &proto.PutResponse{}
 
-	if err := tc.rng.executeCmd(proto.Put, req, reply); err != nil {
+	if err := tc.rng.executeCmd(proto.Put, req, reply, time.Time{}); err != nil {
 		t.Fatal(err)
 	}
 
-	info, err := tc.gossip.GetInfo(gossip.KeyConfigPermission)
+	configMap, err := GossipedConfigMap(tc.gossip, gossip.KeyConfigPermission)
 	if err != nil {
 		t.Fatal(err)
 	}
-	configMap := info.(PrefixConfigMap)
 	expConfigs := []*PrefixConfig{
 		{engine.KeyMin, nil, &testDefaultPermConfig},
 		{proto.Key("/db1"), nil, db1Perm},
@@ -333,6 +382,118 @@ func TestRangeGossipConfigWithMultipleKeyPrefixes(t *testing.T) {
 	}
 }
 
+// TestRangeGossipConfigTransactional verifies that a transaction which
+// writes a config reads back its own uncommitted value, and that the
+// config is gossiped only once the transaction commits and its intent
+// is resolved.
+func TestRangeGossipConfigTransactional(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	db1Perm := &proto.PermConfig{
+		Read:  []string{"spencer"},
+		Write: []string{"spencer"},
+	}
+	key := engine.MakeKey(engine.KeyConfigPermissionPrefix, proto.Key("/db1"))
+	data, err := gogoproto.Marshal(db1Perm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	pArgs, pReply := putArgs(key, data, 1, tc.store.StoreID())
+	pArgs.Timestamp = txn.Timestamp
+	pArgs.Txn = txn
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// The transaction reads its own uncommitted config.
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = txn.Timestamp
+	gArgs.Txn = txn
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, data) {
+		t.Errorf("expected transaction to read its own config; got %+v", gReply.Value)
+	}
+
+	hasDB1 := func() bool {
+		configMap, err := GossipedConfigMap(tc.gossip, gossip.KeyConfigPermission)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, pc := range configMap {
+			if bytes.Equal(pc.Prefix, proto.Key("/db1")) && pc.Canonical == nil {
+				return true
+			}
+		}
+		return false
+	}
+	if hasDB1() {
+		t.Fatal("expected uncommitted config not to be gossiped")
+	}
+
+	// Commit and resolve the intent, as the coordinator would.
+	eArgs, eReply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	eArgs.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(proto.EndTransaction, eArgs, eReply, true); err != nil {
+		t.Fatal(err)
+	}
+	rArgs := &proto.InternalResolveIntentRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       key,
+			Timestamp: txn.Timestamp,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Txn:       eReply.Txn,
+		},
+	}
+	if err := tc.rng.AddCmd(proto.InternalResolveIntent, rArgs, &proto.InternalResolveIntentResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+	if !hasDB1() {
+		t.Error("expected committed config to be gossiped")
+	}
+}
+
+// TestRangeGossipConfigOmitsInheritedDuplicates verifies that a
+// prefix config identical to the config it would inherit is not
+// added to the gossiped config map.
+func TestRangeGossipConfigOmitsInheritedDuplicates(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	// Add a permission for a new key prefix identical to the default.
+	db1Perm := gogoproto.Clone(&testDefaultPermConfig).(*proto.PermConfig)
+	key := engine.MakeKey(engine.KeyConfigPermissionPrefix, proto.Key("/db1"))
+	data, err := gogoproto.Marshal(db1Perm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &proto.PutRequest{
+		RequestHeader: proto.RequestHeader{Key: key, Timestamp: proto.MinTimestamp},
+		Value:         proto.Value{Bytes: data},
+	}
+	reply := &proto.PutResponse{}
+
+	if err := tc.rng.executeCmd(proto.Put, req, reply, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+
+	configMap, err := GossipedConfigMap(tc.gossip, gossip.KeyConfigPermission)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expConfigs := []*PrefixConfig{
+		{engine.KeyMin, nil, &testDefaultPermConfig},
+	}
+	if !reflect.DeepEqual([]*PrefixConfig(configMap), expConfigs) {
+		t.Errorf("expected gossiped configs to be equal %s vs %s", configMap, expConfigs)
+	}
+}
+
 // TestRangeGossipConfigUpdates verifies that writes to the
 // permissions cause the updated configs to be re-gossiped.
 func TestRangeGossipConfigUpdates(t *testing.T) {
@@ -355,15 +516,14 @@ func TestRangeGossipConfigUpdates(t *testing.T) {
 	}
 	reply := &proto.PutResponse{}
 
-	if err := tc.rng.executeCmd(proto.Put, req, reply); err != nil {
+	if err := tc.rng.executeCmd(proto.Put, req, reply, time.Time{}); err != nil {
 		t.Fatal(err)
 	}
 
-	info, err := tc.gossip.GetInfo(gossip.KeyConfigPermission)
+	configMap, err := GossipedConfigMap(tc.gossip, gossip.KeyConfigPermission)
 	if err != nil {
 		t.Fatal(err)
 	}
-	configMap := info.(PrefixConfigMap)
 	expConfigs := []*PrefixConfig{
 		{engine.KeyMin, nil, &testDefaultPermConfig},
 		{proto.Key("/db1"), nil, db1Perm},
@@ -374,6 +534,249 @@ func TestRangeGossipConfigUpdates(t *testing.T) {
 	}
 }
 
+// TestRangeValidateConfigWrites verifies that writes of invalid
+// configs are rejected before they're persisted or gossiped.
+func TestRangeValidateConfigWrites(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	testCases := []struct {
+		prefix proto.Key
+		config gogoproto.Message
+	}{
+		{engine.KeyConfigPermissionPrefix, &proto.PermConfig{Read: []string{""}}},
+		{engine.KeyConfigZonePrefix, &proto.ZoneConfig{RangeMinBytes: 1 << 10, RangeMaxBytes: 1 << 18}},
+		{engine.KeyConfigZonePrefix, &proto.ZoneConfig{
+			ReplicaAttrs:  testDefaultZoneConfig.ReplicaAttrs,
+			RangeMinBytes: -1,
+			RangeMaxBytes: 1 << 18,
+		}},
+		{engine.KeyConfigZonePrefix, &proto.ZoneConfig{ReplicaAttrs: testDefaultZoneConfig.ReplicaAttrs}},
+		{engine.KeyConfigZonePrefix, &proto.ZoneConfig{
+			ReplicaAttrs:  testDefaultZoneConfig.ReplicaAttrs,
+			RangeMinBytes: 1 << 18,
+			RangeMaxBytes: 1 << 10,
+		}},
+		{engine.KeyConfigZonePrefix, &proto.ZoneConfig{
+			ReplicaAttrs:          testDefaultZoneConfig.ReplicaAttrs[:1],
+			PreferredReplicaAttrs: testDefaultZoneConfig.ReplicaAttrs,
+			RangeMaxBytes:         1 << 18,
+		}},
+	}
+	for i, test := range testCases {
+		data, err := gogoproto.Marshal(test.config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		key := engine.MakeKey(test.prefix, proto.Key("/db1"))
+		pArgs, pReply := putArgs(key, data, 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err == nil {
+			t.Errorf("%d: expected invalid config %+v to be rejected", i, test.config)
+		}
+		if val, err := engine.MVCCGet(tc.engine, key, proto.MaxTimestamp, nil); err != nil || val != nil {
+			t.Errorf("%d: expected no config to be written; got %+v, %v", i, val, err)
+		}
+	}
+
+	// Malformed bytes are rejected as well.
+	key := engine.MakeKey(engine.KeyConfigZonePrefix, proto.Key("/db1"))
+	pArgs, pReply := putArgs(key, []byte("\xff\xff"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err == nil {
+		t.Error("expected malformed config to be rejected")
+	}
+
+	// And configs gossiped are unaffected.
+	configMap, err := GossipedConfigMap(tc.gossip, gossip.KeyConfigZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expConfigs := []*PrefixConfig{{engine.KeyMin, nil, &testDefaultZoneConfig}}; !reflect.DeepEqual([]*PrefixConfig(configMap), expConfigs) {
+		t.Errorf("expected gossiped configs to be equal %s vs %s", configMap, expConfigs)
+	}
+}
+
+// TestRangeGossipConfigDeltas verifies that config writes gossip only
+// the changes to the config map since it was last gossiped in full,
+// and that applying them yields the same map as a full gossip.
+func TestRangeGossipConfigDeltas(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	info, err := tc.gossip.GetInfo(gossip.KeyConfigPermission)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := info.(PrefixConfigMap)
+
+	putPerm := func(prefix string, perm *proto.PermConfig) {
+		data, err := gogoproto.Marshal(perm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := &proto.PutRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       engine.MakeKey(engine.KeyConfigPermissionPrefix, proto.Key(prefix)),
+				Timestamp: tc.clock.Now(),
+			},
+			Value: proto.Value{Bytes: data},
+		}
+		if err := tc.rng.executeCmd(proto.Put, req, &proto.PutResponse{}, time.Time{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	db1Perm := &proto.PermConfig{Read: []string{"spencer"}, Write: []string{"spencer"}}
+	db2Perm := &proto.PermConfig{Read: []string{"foo"}, Write: []string{"foo"}}
+	putPerm("/db1", &proto.PermConfig{Read: []string{"bar"}})
+	putPerm("/db2", db2Perm)
+	putPerm("/db1", db1Perm)
+
+	// The full map is not re-gossiped.
+	if info, err = tc.gossip.GetInfo(gossip.KeyConfigPermission); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(info.(PrefixConfigMap), base) {
+		t.Errorf("expected full config map to remain %s; got %s", base, info)
+	}
+	// The delta holds only the changed prefixes.
+	if info, err = tc.gossip.GetInfo(gossip.MakeConfigDeltaGossipKey(gossip.KeyConfigPermission)); err != nil {
+		t.Fatal(err)
+	}
+	expUpdated := []*PrefixConfig{
+		{proto.Key("/db1"), nil, db1Perm},
+		{proto.Key("/db2"), nil, db2Perm},
+	}
+	if delta := info.(PrefixConfigDelta); !reflect.DeepEqual(delta.Updated, expUpdated) || len(delta.Removed) != 0 {
+		t.Errorf("expected delta updating %s; got %+v", expUpdated, delta)
+	}
+
+	// Applying the delta yields the map which a full gossip sends.
+	configMap, err := GossipedConfigMap(tc.gossip, gossip.KeyConfigPermission)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.rng.maybeGossipConfigs(configDescriptors...)
+	if info, err = tc.gossip.GetInfo(gossip.KeyConfigPermission); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(configMap, info.(PrefixConfigMap)) {
+		t.Errorf("expected merged config map %s to equal full gossip %s", configMap, info)
+	}
+	if info, err = tc.gossip.GetInfo(gossip.MakeConfigDeltaGossipKey(gossip.KeyConfigPermission)); err != nil {
+		t.Fatal(err)
+	}
+	if delta := info.(PrefixConfigDelta); !delta.Empty() {
+		t.Errorf("expected full gossip to reset delta; got %+v", delta)
+	}
+
+	// A delta isn't applied to a full map other than its base, such as
+	// one gossiped by another node.
+	putPerm("/db3", db1Perm)
+	if err := tc.gossip.AddInfo(gossip.KeyConfigPermission, base, 0*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if configMap, err = GossipedConfigMap(tc.gossip, gossip.KeyConfigPermission); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(configMap, base) {
+		t.Errorf("expected stale delta to be ignored, leaving %s; got %s", base, configMap)
+	}
+}
+
+// TestRangeGossipConfigPeriodic verifies that configs are re-gossiped
+// at the config gossip interval even absent any change.
+func TestRangeGossipConfigPeriodic(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	gossiped := make(chan struct{}, 10)
+	tc.gossip.RegisterCallback(gossip.KeyConfigPermission, func(_ string, _ bool) {
+		select {
+		case gossiped <- struct{}{}:
+		default:
+		}
+	})
+	// Drain the callback for the initial gossip on registration or start.
+	for len(gossiped) > 0 {
+		<-gossiped
+	}
+	const interval = 20 * time.Millisecond
+	tc.rng.configGossipInterval = interval
+	go tc.rng.startConfigGossip()
+
+	select {
+	case <-gossiped:
+	case <-time.After(10 * interval):
+		t.Fatalf("expected configs to be re-gossiped within %s", interval)
+	}
+}
+
+// TestRangeGossipLoad verifies that the range's command rates and size
+// are gossiped under its load gossip key.
+func TestRangeGossipLoad(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Discard any commands counted while starting the range.
+	tc.rng.load.rates(tc.clock.PhysicalNow())
+	for i := 0; i < 4; i++ {
+		pArgs, pReply := putArgs(proto.Key(fmt.Sprintf("a%d", i)), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		gArgs, gReply := getArgs(proto.Key("a0"), 1, tc.store.StoreID())
+		gArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tc.rng.loadGossipInterval = time.Minute
+	tc.rng.maybeGossipLoad()
+	info, err := tc.gossip.GetInfo(gossip.MakeRangeLoadGossipKey(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	load := info.(RangeLoad)
+	if load.RaftID != 1 || load.ReadsPerSecond != 2 || load.WritesPerSecond != 4 || load.Bytes <= 0 {
+		t.Errorf("unexpected gossiped load %+v", load)
+	}
+
+	// Counting restarts after each gossip.
+	tc.rng.maybeGossipLoad()
+	if info, err = tc.gossip.GetInfo(gossip.MakeRangeLoadGossipKey(1)); err != nil {
+		t.Fatal(err)
+	}
+	if load = info.(RangeLoad); load.ReadsPerSecond != 0 || load.WritesPerSecond != 0 {
+		t.Errorf("expected zero rates after regossip; got %+v", load)
+	}
+}
+
+// TestJitteredInterval verifies that jittered intervals are spread
+// between (1-configGossipJitter)*interval and interval.
+func TestJitteredInterval(t *testing.T) {
+	const interval = time.Minute
+	min := time.Duration(float64(interval) * (1 - configGossipJitter))
+	seen := map[time.Duration]struct{}{}
+	for i := 0; i < 100; i++ {
+		d := jitteredInterval(interval)
+		if d < min || d > interval {
+			t.Errorf("jittered interval %s outside [%s, %s]", d, min, interval)
+		}
+		seen[d] = struct{}{}
+	}
+	if len(seen) < 2 {
+		t.Errorf("expected jitter to spread intervals; got %d distinct values", len(seen))
+	}
+}
+
 // A blockingEngine allows us to delay get/put (but not other ops!).
 // It works by allowing a single key to be primed for a delay. When
 // a get/put ops arrives for that key, it's blocked via a mutex
@@ -519,6 +922,21 @@ func scanArgs(start, end []byte, raftID int64, storeID proto.StoreID) (*proto.Sc
 	return args, reply
 }
 
+// reverseScanArgs returns a request/response pair for a ReverseScan
+// RPC addressed to the default replica for the specified keys.
+func reverseScanArgs(start, end []byte, raftID int64, storeID proto.StoreID) (*proto.ReverseScanRequest, *proto.ReverseScanResponse) {
+	args := &proto.ReverseScanRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     start,
+			EndKey:  end,
+			RaftID:  raftID,
+			Replica: proto.Replica{StoreID: storeID},
+		},
+	}
+	reply := &proto.ReverseScanResponse{}
+	return args, reply
+}
+
 // endTxnArgs returns request/response pair for EndTransaction RPC
 // addressed to the default replica for the specified key.
 func endTxnArgs(txn *proto.Transaction, commit bool, raftID int64, storeID proto.StoreID) (
@@ -570,6 +988,22 @@ func heartbeatArgs(txn *proto.Transaction, raftID int64, storeID proto.StoreID)
 	return args, reply
 }
 
+// beginTxnArgs returns request/response pair for InternalBeginTransaction
+// RPC addressed to the default replica for the specified key.
+func beginTxnArgs(txn *proto.Transaction, raftID int64, storeID proto.StoreID) (
+	*proto.InternalBeginTransactionRequest, *proto.InternalBeginTransactionResponse) {
+	args := &proto.InternalBeginTransactionRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     txn.Key,
+			RaftID:  raftID,
+			Replica: proto.Replica{StoreID: storeID},
+			Txn:     txn,
+		},
+	}
+	reply := &proto.InternalBeginTransactionResponse{}
+	return args, reply
+}
+
 // internalMergeArgs returns a InternalMergeRequest and InternalMergeResponse
 // pair addressed to the default replica for the specified key. The request will
 // contain the given proto.Value.
@@ -670,382 +1104,3079 @@ func TestRangeUpdateTSCache(t *testing.T) {
 	}
 }
 
-// TestRangeCommandQueue verifies that reads/writes must wait for
-// pending commands to complete through Raft before being executed on
-// range.
-func TestRangeCommandQueue(t *testing.T) {
-	be := newBlockingEngine()
-	tc := testContext{
-		engine: be,
-	}
+// TestRangeInspectTimestampCache verifies that
+// InternalInspectTimestampCache reports the same timestamps as the
+// timestamp cache after a read and a write on different keys, and
+// that it identifies values supplied by the low water mark.
+func TestRangeInspectTimestampCache(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	// Read "a" at time 1s.
+	t0 := 1 * time.Second
+	tc.manualClock.Set(t0.Nanoseconds())
+	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	// Write "b" at time 2s.
+	t1 := 2 * time.Second
+	tc.manualClock.Set(t1.Nanoseconds())
+	pArgs, pReply := putArgs([]byte("b"), []byte("1"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		key                proto.Key
+		expRTS, expWTS     int64
+		expRLowW, expWLowW bool
+	}{
+		{proto.Key("a"), t0.Nanoseconds(), 0, false, true},
+		{proto.Key("b"), 0, t1.Nanoseconds(), true, false},
+		{proto.Key("c"), 0, 0, true, true},
+	}
+	for i, test := range testCases {
+		args := &proto.InternalInspectTimestampCacheRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       test.key,
+				Timestamp: tc.clock.Now(),
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			},
+		}
+		reply := &proto.InternalInspectTimestampCacheResponse{}
+		if err := tc.rng.AddCmd(proto.InternalInspectTimestampCache, args, reply, true); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if reply.ReadTimestamp.WallTime != test.expRTS || reply.WriteTimestamp.WallTime != test.expWTS {
+			t.Errorf("%d: expected rTS=%d and wTS=%d, but got %s, %s", i, test.expRTS, test.expWTS, reply.ReadTimestamp, reply.WriteTimestamp)
+		}
+		if reply.ReadLowWater != test.expRLowW || reply.WriteLowWater != test.expWLowW {
+			t.Errorf("%d: expected low water flags %t, %t; got %t, %t", i, test.expRLowW, test.expWLowW, reply.ReadLowWater, reply.WriteLowWater)
+		}
+	}
+}
+
+// TestRangeAppliedIndexGap verifies that a raft command whose index
+// does not immediately follow the last applied index is rejected
+// without being applied.
+func TestRangeAppliedIndexGap(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Use a fresh replica not registered with the store so that raft
+	// doesn't apply commands to it concurrently.
+	rng, err := NewRange(tc.rng.Desc(), tc.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	applyPut := func(key string, index uint64) error {
+		pArgs, _ := putArgs([]byte(key), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		raftCmd := proto.InternalRaftCommand{RaftID: 1, Generation: rng.generation}
+		raftCmd.Cmd.SetValue(pArgs)
+		return rng.processRaftCommand(cmdIDKey(key), index, raftCmd)
+	}
+	if err := applyPut("a", 10); err != nil {
+		t.Fatal(err)
+	}
+	// Skipping index 11 is detected and the command isn't applied.
+	if err := applyPut("b", 12); err == nil {
+		t.Fatal("expected error applying command after an index gap")
+	}
+	if val, err := engine.MVCCGet(tc.engine, proto.Key("b"), tc.clock.Now(), nil); err != nil || val != nil {
+		t.Errorf("expected out of order command to write nothing; got %v, %v", val, err)
+	}
+	// So is re-applying an index.
+	if err := applyPut("c", 10); err == nil {
+		t.Fatal("expected error re-applying a command")
+	}
+	if err := applyPut("d", 11); err != nil {
+		t.Errorf("expected command at next index to apply; got %v", err)
+	}
+}
+
+// TestRangeLeaseEpochFencing verifies that the lease epoch is
+// persisted with the lease, advancing only when the lease is granted
+// to a different replica, and that a raft command proposed under an
+// earlier lease epoch is rejected when applied after the lease has
+// changed hands.
+func TestRangeLeaseEpochFencing(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Use a fresh replica not registered with the store so that raft
+	// doesn't apply commands to it concurrently.
+	rng, err := NewRange(tc.rng.Desc(), tc.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apply := func(key string, index uint64, epoch int64, args proto.Request) error {
+		raftCmd := proto.InternalRaftCommand{RaftID: 1, Generation: rng.generation, LeaseEpoch: epoch}
+		raftCmd.Cmd.SetValue(args)
+		return rng.processRaftCommand(cmdIDKey(key), index, raftCmd)
+	}
+	applyPut := func(key string, index uint64, epoch int64) error {
+		pArgs, _ := putArgs([]byte(key), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		return apply(key, index, epoch, pArgs)
+	}
+	applyLease := func(key string, index uint64, replica proto.Replica, start int64) error {
+		lArgs, _ := leaderLeaseArgs(proto.Timestamp{WallTime: start}, proto.Timestamp{WallTime: start + 10},
+			replica, 1, tc.store.StoreID())
+		return apply(key, index, rng.LeaseEpoch(), lArgs)
+	}
+	local := *rng.GetReplica()
+	remote := proto.Replica{NodeID: 2, StoreID: tc.store.StoreID() + 1}
+
+	// The first lease granted begins epoch 1; renewals keep it.
+	if err := applyLease("lease1", 10, local, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyLease("lease2", 11, local, 5); err != nil {
+		t.Fatal(err)
+	}
+	if rng.LeaseEpoch() != 1 {
+		t.Fatalf("expected lease epoch 1; got %d", rng.LeaseEpoch())
+	}
+	if err := applyPut("a", 12, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Granting the lease to another replica advances the epoch to 2; a
+	// command proposed under epoch 1 is rejected without being applied.
+	if err := applyLease("lease3", 13, remote, 20); err != nil {
+		t.Fatal(err)
+	}
+	if rng.LeaseEpoch() != 2 {
+		t.Fatalf("expected lease epoch 2; got %d", rng.LeaseEpoch())
+	}
+	if err := applyPut("b", 14, 1); err == nil {
+		t.Fatal("expected error applying a command proposed under an earlier lease epoch")
+	} else if _, ok := err.(*proto.NotLeaderError); !ok {
+		t.Fatalf("expected not leader error; got %T: %s", err, err)
+	}
+	if val, err := engine.MVCCGet(tc.engine, proto.Key("b"), tc.clock.Now(), nil); err != nil || val != nil {
+		t.Errorf("expected fenced command to write nothing; got %v, %v", val, err)
+	}
+	if err := applyPut("c", 15, 2); err != nil {
+		t.Errorf("expected command proposed under the current epoch to apply; got %v", err)
+	}
+
+	// The epoch is persisted with the lease.
+	lease := &proto.Lease{}
+	if ok, err := engine.MVCCGetProto(tc.engine, engine.RangeLeaderLeaseKey(1), proto.ZeroTimestamp, nil, lease); err != nil || !ok {
+		t.Fatalf("expected persisted lease; got %t, %v", ok, err)
+	}
+	if lease.Epoch != 2 {
+		t.Errorf("expected persisted lease epoch 2; got %d", lease.Epoch)
+	}
+}
+
+// TestRangeReplicationLatency verifies that the latency between
+// proposal and application is measured for read-write commands.
+func TestRangeReplicationLatency(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	if l := tc.rng.ReplicationLatency(); l != 0 {
+		t.Errorf("expected zero latency before any command; got %s", l)
+	}
+	start := time.Now()
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	elapsed := time.Since(start)
+	if l := tc.rng.ReplicationLatency(); l <= 0 || l > elapsed {
+		t.Errorf("expected latency in (0, %s]; got %s", elapsed, l)
+	}
+}
+
+// TestRangeScanStats verifies that a scan requesting statistics
+// reports the number of keys and versions examined, in addition to the
+// rows returned, and that a scan which doesn't request them reports
+// none.
+func TestRangeScanStats(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	keys := []string{"a", "b", "c"}
+	const versions = 3
+	for i := 0; i < versions; i++ {
+		for _, k := range keys {
+			pArgs, pReply := putArgs([]byte(k), []byte(fmt.Sprintf("value%d", i)), 1, tc.store.StoreID())
+			pArgs.Timestamp = tc.clock.Now()
+			if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	sArgs, sReply := scanArgs([]byte("a"), []byte("d"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if sReply.KeysExamined != 0 || sReply.VersionsExamined != 0 {
+		t.Errorf("expected no statistics unless requested; got %d keys, %d versions examined",
+			sReply.KeysExamined, sReply.VersionsExamined)
+	}
+
+	sArgs, sReply = scanArgs([]byte("a"), []byte("d"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	sArgs.ReturnStats = true
+	if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+		t.Fatal(err)
+	}
+	rows := int64(len(sReply.Rows))
+	if rows != int64(len(keys)) {
+		t.Fatalf("expected %d rows; got %d", len(keys), rows)
+	}
+	if sReply.KeysExamined != rows {
+		t.Errorf("expected %d keys examined; got %d", rows, sReply.KeysExamined)
+	}
+	if sReply.VersionsExamined != versions*rows {
+		t.Errorf("expected %d versions examined; got %d", versions*rows, sReply.VersionsExamined)
+	}
+	if sReply.VersionsSkipped != (versions-1)*rows {
+		t.Errorf("expected %d versions skipped; got %d", (versions-1)*rows, sReply.VersionsSkipped)
+	}
+	if sReply.IntentsEncountered != 0 {
+		t.Errorf("expected no intents; got %d", sReply.IntentsEncountered)
+	}
+}
+
+// keyReadCountingEngine wraps an engine, counting gets, iterations
+// and iterator seeks which address versions of a watched key.
+type keyReadCountingEngine struct {
+	engine.Engine
+	watch atomic.Value // proto.EncodedKey
+	reads int32
+}
+
+func (e *keyReadCountingEngine) count(key proto.EncodedKey) {
+	if watch, ok := e.watch.Load().(proto.EncodedKey); ok && bytes.HasPrefix(key, watch) {
+		atomic.AddInt32(&e.reads, 1)
+	}
+}
+
+func (e *keyReadCountingEngine) Get(key proto.EncodedKey) ([]byte, error) {
+	e.count(key)
+	return e.Engine.Get(key)
+}
+
+func (e *keyReadCountingEngine) Iterate(start, end proto.EncodedKey, f func(proto.RawKeyValue) (bool, error)) error {
+	e.count(start)
+	return e.Engine.Iterate(start, end, f)
+}
+
+func (e *keyReadCountingEngine) NewIterator() engine.Iterator {
+	return &keyReadCountingIterator{Iterator: e.Engine.NewIterator(), e: e}
+}
+
+func (e *keyReadCountingEngine) NewBatch() engine.Engine {
+	return engine.NewBatch(e)
+}
+
+type keyReadCountingIterator struct {
+	engine.Iterator
+	e *keyReadCountingEngine
+}
+
+func (it *keyReadCountingIterator) Seek(key []byte) {
+	it.e.count(key)
+	it.Iterator.Seek(key)
+}
+
+// TestRangeBloomFilter verifies that with a bloom filter enabled, a
+// get of a key which was never written is answered without reading
+// the engine, while keys written before and after enabling the
+// filter are still read from the engine.
+func TestRangeBloomFilter(t *testing.T) {
+	eng := &keyReadCountingEngine{
+		Engine: engine.NewInMem(proto.Attributes{Attrs: []string{"dc1", "mem"}}, 1<<20),
+	}
+	tc := testContext{engine: eng}
+	tc.Start(t)
+	defer tc.Stop()
+
+	put := func(key string) {
+		pArgs, pReply := putArgs([]byte(key), []byte("value-"+key), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// "c" is written before the filter is enabled, and so is loaded
+	// from the engine when the filter is built.
+	put("c")
+	if err := tc.rng.EnableBloomFilter(1 << 16); err != nil {
+		t.Fatal(err)
+	}
+	put("a")
+	put("b")
+
+	for _, test := range []struct {
+		key     string
+		expRead bool
+	}{
+		{"z", false},
+		{"a", true},
+		{"b", true},
+		{"c", true},
+	} {
+		eng.watch.Store(engine.MVCCEncodeKey(proto.Key(test.key)))
+		atomic.StoreInt32(&eng.reads, 0)
+		gArgs, gReply := getArgs([]byte(test.key), 1, tc.store.StoreID())
+		gArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+			t.Fatal(err)
+		}
+		if read := atomic.LoadInt32(&eng.reads) > 0; read != test.expRead {
+			t.Errorf("key %q: expected engine read %t; got %t", test.key, test.expRead, read)
+		}
+		if exists := gReply.Value != nil; exists != test.expRead {
+			t.Errorf("key %q: expected value to exist %t; got %+v", test.key, test.expRead, gReply.Value)
+		}
+	}
+}
+
+// TestRangeScanOrderByTimestamp verifies that a scan ordered by
+// timestamp returns keys sorted by the time they were last written,
+// limited to the earliest MaxResults keys.
+func TestRangeScanOrderByTimestamp(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Write "a" at t1, "b" at t3 and "c" at t2.
+	now := tc.clock.Now()
+	for i, k := range []string{"a", "b", "c"} {
+		pArgs, pReply := putArgs([]byte(k), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = now
+		pArgs.Timestamp.WallTime += int64([]int{1, 3, 2}[i])
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range []struct {
+		max     int64
+		expKeys []string
+	}{
+		{0, []string{"a", "c", "b"}},
+		{2, []string{"a", "c"}},
+	} {
+		sArgs, sReply := scanArgs([]byte("a"), []byte("d"), 1, tc.store.StoreID())
+		sArgs.Timestamp = now
+		sArgs.Timestamp.WallTime += 4
+		sArgs.MaxResults = test.max
+		sArgs.OrderByTimestamp = true
+		if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, kv := range sReply.Rows {
+			keys = append(keys, string(kv.Key))
+		}
+		if !reflect.DeepEqual(keys, test.expKeys) {
+			t.Errorf("max %d: expected keys %v; got %v", test.max, test.expKeys, keys)
+		}
+	}
+}
+
+// TestRangeHistoricalReads verifies that reads at past timestamps
+// return the version of a key which was live at that time and skip
+// intents written at later timestamps, rather than returning a
+// WriteIntentError.
+func TestRangeHistoricalReads(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Write versions of "a" at t1, t2 and t3.
+	key := proto.Key("a")
+	now := tc.clock.Now()
+	readTS := func(offset int64) proto.Timestamp {
+		ts := now
+		ts.WallTime += offset
+		return ts
+	}
+	for i := int64(1); i <= 3; i++ {
+		pArgs, pReply := putArgs(key, []byte(fmt.Sprintf("value%d", i)), 1, tc.store.StoreID())
+		pArgs.Timestamp = readTS(i)
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Write an intent at t5.
+	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	txn.Timestamp = readTS(5)
+	pArgs, pReply := putArgs(key, []byte("value5"), 1, tc.store.StoreID())
+	pArgs.Timestamp = txn.Timestamp
+	pArgs.Txn = txn
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		offset   int64
+		expValue string
+	}{
+		{0, ""},
+		{1, "value1"},
+		{2, "value2"},
+		{3, "value3"},
+		{4, "value3"},
+	} {
+		gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+		gArgs.Timestamp = readTS(test.offset)
+		if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+			t.Fatalf("get at t%d: %s", test.offset, err)
+		}
+		if test.expValue == "" {
+			if gReply.Value != nil {
+				t.Errorf("get at t%d: expected no value; got %+v", test.offset, gReply.Value)
+			}
+		} else if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte(test.expValue)) {
+			t.Errorf("get at t%d: expected %q; got %+v", test.offset, test.expValue, gReply.Value)
+		}
+
+		sArgs, sReply := scanArgs(key, key.Next(), 1, tc.store.StoreID())
+		sArgs.Timestamp = readTS(test.offset)
+		if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+			t.Fatalf("scan at t%d: %s", test.offset, err)
+		}
+		if test.expValue == "" {
+			if len(sReply.Rows) != 0 {
+				t.Errorf("scan at t%d: expected no rows; got %+v", test.offset, sReply.Rows)
+			}
+		} else if len(sReply.Rows) != 1 || !bytes.Equal(sReply.Rows[0].Value.Bytes, []byte(test.expValue)) {
+			t.Errorf("scan at t%d: expected %q; got %+v", test.offset, test.expValue, sReply.Rows)
+		}
+	}
+
+	// A read at or after the intent's timestamp encounters it.
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = readTS(6)
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err == nil {
+		t.Error("expected write intent error reading at t6")
+	} else if _, ok := err.(*proto.WriteIntentError); !ok {
+		t.Errorf("expected write intent error reading at t6; got %s", err)
+	}
+}
+
+// TestRangeReverseScan verifies that a reverse scan returns keys in
+// descending order, honoring max results and excluding the end key.
+func TestRangeReverseScan(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, k := range []string{"a", "b", "c", "d"} {
+		pArgs, pReply := putArgs([]byte(k), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, test := range []struct {
+		start, end string
+		max        int64
+		expKeys    []string
+	}{
+		{"a", "d", 0, []string{"c", "b", "a"}},
+		{"a", "e", 2, []string{"d", "c"}},
+		{"b", "c", 0, []string{"b"}},
+		{"e", "f", 0, nil},
+	} {
+		rsArgs, rsReply := reverseScanArgs([]byte(test.start), []byte(test.end), 1, tc.store.StoreID())
+		rsArgs.Timestamp = tc.clock.Now()
+		rsArgs.MaxResults = test.max
+		if err := tc.rng.AddCmd(proto.ReverseScan, rsArgs, rsReply, true); err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, kv := range rsReply.Rows {
+			keys = append(keys, string(kv.Key))
+		}
+		if !reflect.DeepEqual(keys, test.expKeys) {
+			t.Errorf("%q-%q max %d: expected keys %v; got %v", test.start, test.end, test.max, test.expKeys, keys)
+		}
+	}
+}
+
+// TestRangeReverseScanUpdatesTSCache verifies that a reverse scan
+// records its span in the timestamp cache, so that a subsequent write
+// within the span is pushed above the scan's timestamp.
+func TestRangeReverseScanUpdatesTSCache(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	rsArgs, rsReply := reverseScanArgs([]byte("a"), []byte("c"), 1, tc.store.StoreID())
+	rsArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.ReverseScan, rsArgs, rsReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	pArgs, pReply := putArgs([]byte("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = rsArgs.Timestamp
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !rsArgs.Timestamp.Less(pReply.Timestamp) {
+		t.Errorf("expected write timestamp to be pushed above %s; got %s", rsArgs.Timestamp, pReply.Timestamp)
+	}
+}
+
+// TestRangeScanVerifiesChecksums verifies that a scan over a value
+// whose stored checksum doesn't match its contents fails with a
+// checksum mismatch error identifying the key.
+func TestRangeScanVerifiesChecksums(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, k := range []string{"a", "b", "c"} {
+		pArgs, pReply := putArgs([]byte(k), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		pArgs.Value.InitChecksum(pArgs.Key)
+		if k == "b" {
+			// Corrupt the stored checksum.
+			pArgs.Value.Checksum = gogoproto.Uint32(pArgs.Value.GetChecksum() + 1)
+		}
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sArgs, sReply := scanArgs([]byte("a"), []byte("b"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+		t.Fatalf("expected scan excluding corrupted value to succeed; got %s", err)
+	}
+	sArgs, sReply = scanArgs([]byte("a"), []byte("d"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true)
+	if cErr, ok := err.(*proto.ChecksumMismatchError); !ok || !cErr.Key.Equal(proto.Key("b")) {
+		t.Fatalf("expected checksum mismatch error for key \"b\"; got %v", err)
+	}
+	if sReply.Rows != nil {
+		t.Errorf("expected no rows with checksum mismatch; got %+v", sReply.Rows)
+	}
+}
+
+// TestRangeScanResumeToken verifies that a limited scan returns a
+// resume token which continues the scan, and that the token is
+// rejected once the range's bounds have changed.
+func TestRangeScanResumeToken(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, k := range []string{"a", "b", "c"} {
+		pArgs, pReply := putArgs([]byte(k), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sArgs, sReply := scanArgs([]byte("a"), []byte("d"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	sArgs.MaxResults = 2
+	if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(sReply.Rows) != 2 || len(sReply.ResumeToken) == 0 {
+		t.Fatalf("expected 2 rows and a resume token; got %+v", sReply)
+	}
+	token := sReply.ResumeToken
+
+	sArgs, sReply = scanArgs([]byte("a"), []byte("d"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	sArgs.MaxResults = 2
+	sArgs.ResumeToken = token
+	if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(sReply.Rows) != 1 || !sReply.Rows[0].Key.Equal(proto.Key("c")) {
+		t.Fatalf("expected resumed scan to return only \"c\"; got %+v", sReply.Rows)
+	}
+	if len(sReply.ResumeToken) != 0 {
+		t.Errorf("expected no resume token for exhausted scan; got %q", sReply.ResumeToken)
+	}
+
+	// Simulate a split by shrinking the range's bounds.
+	desc := *tc.rng.Desc()
+	desc.EndKey = proto.Key("m")
+	tc.rng.SetDesc(&desc)
+
+	sArgs, sReply = scanArgs([]byte("a"), []byte("d"), 1, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	sArgs.ResumeToken = token
+	err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true)
+	if _, ok := err.(*proto.RangeKeyMismatchError); !ok {
+		t.Fatalf("expected range key mismatch error after split; got %v", err)
+	}
+}
+
+// TestRangeAuditLog verifies that with auditing enabled in the zone
+// config mutations are recorded in the range's audit log, and that
+// with auditing disabled none are.
+func TestRangeAuditLog(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	setAudit := func(audit bool) {
+		zone := testDefaultZoneConfig
+		zone.Audit = audit
+		data, err := gogoproto.Marshal(&zone)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pArgs, pReply := putArgs(engine.KeyConfigZonePrefix, data, 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	auditEntries := func() []proto.AuditEntry {
+		prefix := engine.RangeAuditPrefix(1)
+		kvs, err := engine.MVCCScan(tc.engine, prefix, prefix.PrefixEnd(), 0, proto.ZeroTimestamp, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries := make([]proto.AuditEntry, len(kvs))
+		for i, kv := range kvs {
+			if err := gogoproto.Unmarshal(kv.Value.Bytes, &entries[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return entries
+	}
+
+	setAudit(true)
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	pArgs.CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	entries := auditEntries()
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 audit entry; got %+v", entries)
+	}
+	expHash, err := auditValueHash(&proto.Value{Bytes: []byte("value")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := entries[0]; !e.Key.Equal(proto.Key("a")) || e.Method != proto.Put ||
+		!bytes.Equal(e.NewValueHash, expHash) || e.OldValueHash != nil {
+		t.Errorf("unexpected audit entry %+v", e)
+	}
+
+	// Mutations without a client command ID at the same timestamp
+	// are each recorded.
+	ts := tc.clock.Now()
+	for _, key := range []string{"c", "d"} {
+		pArgs, pReply := putArgs([]byte(key), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = ts
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if entries := auditEntries(); len(entries) != 3 {
+		t.Fatalf("expected 3 audit entries; got %+v", entries)
+	}
+
+	// Each mutation within a batch is recorded, including repeated
+	// mutations of the same key.
+	bArgs := &proto.BatchRequest{
+		RequestHeader: proto.RequestHeader{
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Timestamp: tc.clock.Now(),
+		},
+	}
+	for _, key := range []string{"e", "f", "e"} {
+		pArgs, _ := putArgs([]byte(key), []byte("value"), 1, tc.store.StoreID())
+		bArgs.Add(pArgs)
+	}
+	gArgs, _ := getArgs([]byte("e"), 1, tc.store.StoreID())
+	bArgs.Add(gArgs)
+	if err := tc.rng.AddCmd(proto.Batch, bArgs, &proto.BatchResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+	if entries := auditEntries(); len(entries) != 6 {
+		t.Fatalf("expected 6 audit entries; got %+v", entries)
+	}
+
+	setAudit(false)
+	pArgs, pReply = putArgs([]byte("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	pArgs.CmdID = proto.ClientCmdID{WallTime: 2, Random: 2}
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	// The put disabling auditing is itself audited; the put of "b" isn't.
+	for _, e := range auditEntries() {
+		if e.Key.Equal(proto.Key("b")) {
+			t.Errorf("expected no audit entry with auditing disabled; got %+v", e)
+		}
+	}
+}
+
+// TestRangeKeyBounds verifies that InternalRangeKeyBounds returns the
+// smallest and largest keys stored in the range, ignoring data
+// outside the range's bounds.
+func TestRangeKeyBounds(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, k := range []string{"c", "m", "zz"} {
+		pArgs, pReply := putArgs([]byte(k), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Narrow the range's bounds to exclude system keys and "zz".
+	desc := *tc.rng.Desc()
+	desc.StartKey, desc.EndKey = proto.Key("a"), proto.Key("z")
+	tc.rng.SetDesc(&desc)
+
+	args := &proto.InternalRangeKeyBoundsRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Timestamp: tc.clock.Now(),
+		},
+	}
+	reply := &proto.InternalRangeKeyBoundsResponse{}
+	if err := tc.rng.AddCmd(proto.InternalRangeKeyBounds, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.MinKey.Equal(proto.Key("c")) || !reply.MaxKey.Equal(proto.Key("m")) {
+		t.Errorf("expected key bounds [\"c\", \"m\"]; got [%q, %q]", reply.MinKey, reply.MaxKey)
+	}
+}
+
+// TestRangeReadOnlyBypassesRaft verifies that read-only commands are
+// served directly from the engine without being proposed to Raft,
+// and that they observe previously committed writes.
+func TestRangeReadOnlyBypassesRaft(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	applied := atomic.LoadUint64(&tc.rng.appliedIndex)
+
+	for i := 0; i < 10; i++ {
+		gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+		gArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+			t.Fatal(err)
+		}
+		if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value")) {
+			t.Fatalf("%d: expected committed value; got %+v", i, gReply.Value)
+		}
+	}
+	if a := atomic.LoadUint64(&tc.rng.appliedIndex); a != applied {
+		t.Errorf("expected reads not to be applied through raft; applied index %d -> %d", applied, a)
+	}
+}
+
+// leaderLeaseArgs returns a request/response pair for an
+// InternalLeaderLease command granting a lease to the specified
+// replica from start until expiration.
+func leaderLeaseArgs(start, expiration proto.Timestamp, replica proto.Replica, raftID int64, storeID proto.StoreID) (*proto.InternalLeaderLeaseRequest, *proto.InternalLeaderLeaseResponse) {
+	args := &proto.InternalLeaderLeaseRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       engine.KeyMin,
+			Timestamp: start,
+			RaftID:    raftID,
+			Replica:   proto.Replica{StoreID: storeID},
+		},
+		Lease: proto.Lease{
+			Start:      start,
+			Expiration: expiration,
+			Replica:    replica,
+		},
+	}
+	reply := &proto.InternalLeaderLeaseResponse{}
+	return args, reply
+}
+
+// TestRangeLeaderLease verifies that reads are served locally only
+// while this replica holds an unexpired leader lease, and that leases
+// are acquired, renewed and refused as expected.
+func TestRangeLeaderLease(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	local := proto.Replica{NodeID: 1, StoreID: tc.store.StoreID()}
+	remote := proto.Replica{NodeID: 2, StoreID: tc.store.StoreID() + 1}
+	read := func() error {
+		gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+		gArgs.Timestamp = tc.clock.Now()
+		return tc.rng.AddCmd(proto.Get, gArgs, gReply, true)
+	}
+	acquire := func(replica proto.Replica, duration int64) error {
+		start := tc.clock.Now()
+		expiration := proto.Timestamp{WallTime: start.WallTime + duration}
+		args, reply := leaderLeaseArgs(start, expiration, replica, 1, tc.store.StoreID())
+		return tc.rng.AddCmd(proto.InternalLeaderLease, args, reply, true)
+	}
+
+	// Reads aren't gated until a lease has been granted.
+	if err := read(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Acquire the lease locally; reads are served.
+	tc.manualClock.Set(1 * 1E9)
+	if err := acquire(local, 10*1E9); err != nil {
+		t.Fatal(err)
+	}
+	if lease := tc.rng.LeaderLease(); lease == nil || lease.Replica.StoreID != local.StoreID {
+		t.Fatalf("expected lease held by %+v; got %+v", local, lease)
+	}
+	if err := read(); err != nil {
+		t.Fatal(err)
+	}
+	// Another replica can't acquire the lease before it expires.
+	if err := acquire(remote, 10*1E9); err == nil {
+		t.Fatal("expected lease to be refused while held by another replica")
+	}
+
+	// Once expired, reads are refused.
+	tc.manualClock.Set(11 * 1E9)
+	if _, ok := read().(*proto.NotLeaderError); !ok {
+		t.Fatal("expected not leader error after lease expiration")
+	}
+
+	// Renew the lease; reads are served again.
+	if err := acquire(local, 10*1E9); err != nil {
+		t.Fatal(err)
+	}
+	if err := read(); err != nil {
+		t.Fatal(err)
+	}
+
+	// After expiration, another replica acquires the lease; reads are
+	// redirected to it.
+	tc.manualClock.Set(22 * 1E9)
+	if err := acquire(remote, 10*1E9); err != nil {
+		t.Fatal(err)
+	}
+	err := read()
+	if nlErr, ok := err.(*proto.NotLeaderError); !ok || nlErr.Leader.StoreID != remote.StoreID {
+		t.Errorf("expected not leader error naming %+v; got %v", remote, err)
+	}
+
+	// On restart, the holder of the persisted lease remains the lease
+	// holder.
+	rng, err := NewRange(tc.rng.Desc(), tc.store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng.start()
+	defer rng.stop()
+	if holder := rng.LeaseHolder(); holder.StoreID != remote.StoreID {
+		t.Errorf("expected restarted range's lease holder to be %+v; got %+v", remote, holder)
+	}
+}
+
+// TestRangeLockingRead verifies that a locking Get by one transaction
+// causes writes to the key by another transaction to fail with a
+// WriteIntentError without blocking readers, and that the lock is
+// released once the transaction ends and its intents are resolved.
+func TestRangeLockingRead(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	txn1 := newTransaction("txn1", proto.Key("b"), 1, proto.SERIALIZABLE, tc.clock)
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = txn1.Timestamp
+	gArgs.Txn = txn1
+	gArgs.Lock = true
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value")) {
+		t.Fatalf("expected locking read to return value; got %+v", gReply.Value)
+	}
+
+	// Readers aren't blocked by the lock.
+	gArgs, gReply = getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatalf("expected read of locked key to succeed; got %s", err)
+	}
+
+	// A write by another transaction conflicts with the lock.
+	txn2 := newTransaction("txn2", key, 1, proto.SERIALIZABLE, tc.clock)
+	pArgs, pReply = putArgs(key, []byte("value2"), 1, tc.store.StoreID())
+	pArgs.Timestamp = txn2.Timestamp
+	pArgs.Txn = txn2
+	err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
+	if wiErr, ok := err.(*proto.WriteIntentError); !ok || !bytes.Equal(wiErr.Txn.ID, txn1.ID) {
+		t.Fatalf("expected write intent error for txn1's lock; got %v", err)
+	}
+
+	// End txn1 and resolve its lock, as its coordinator would.
+	eArgs, eReply := endTxnArgs(txn1, true, 1, tc.store.StoreID())
+	eArgs.Timestamp = txn1.Timestamp
+	if err := tc.rng.AddCmd(proto.EndTransaction, eArgs, eReply, true); err != nil {
+		t.Fatal(err)
+	}
+	rArgs := &proto.InternalResolveIntentRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       key,
+			Timestamp: txn1.Timestamp,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Txn:       eReply.Txn,
+		},
+	}
+	if err := tc.rng.AddCmd(proto.InternalResolveIntent, rArgs, &proto.InternalResolveIntentResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	pArgs, pReply = putArgs(key, []byte("value2"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatalf("expected write after lock release to succeed; got %s", err)
+	}
+}
+
+// TestRangeLockUpgrade verifies that a transaction which read-locks a
+// key and then writes it upgrades its lock to a write intent instead
+// of conflicting with itself.
+func TestRangeLockUpgrade(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = txn.Timestamp
+	gArgs.Txn = txn
+	gArgs.Lock = true
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	pArgs, pReply = putArgs(key, []byte("value2"), 1, tc.store.StoreID())
+	pArgs.Timestamp = txn.Timestamp
+	pArgs.Txn = txn
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatalf("expected write of key locked by the same txn to succeed; got %s", err)
+	}
+	if !pReply.Timestamp.Equal(txn.Timestamp) {
+		t.Errorf("expected write at txn timestamp %s; got %s", txn.Timestamp, pReply.Timestamp)
+	}
+
+	meta := &proto.MVCCMetadata{}
+	if ok, _, _, err := engine.GetProto(tc.engine, engine.MVCCEncodeKey(key), meta); !ok || err != nil {
+		t.Fatalf("expected metadata for key %q; got %t, %v", key, ok, err)
+	}
+	if meta.Lock || meta.Txn == nil || !bytes.Equal(meta.Txn.ID, txn.ID) {
+		t.Errorf("expected a write intent for txn; got %+v", meta)
+	}
+}
+
+// TestRangeAdminSplitDryRun verifies that a dry-run split returns the
+// projected sizes of both halves without splitting the range, and
+// reports an invalid split key.
+func TestRangeAdminSplitDryRun(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	value := bytes.Repeat([]byte("v"), 1000)
+	for c := 'a'; c <= 'z'; c++ {
+		pArgs, pReply := putArgs(proto.Key([]byte{byte(c)}), value, tc.rng.Desc().RaftID, tc.store.StoreID())
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	origDesc := *tc.rng.Desc()
+	args := &proto.AdminSplitRequest{
+		RequestHeader: proto.RequestHeader{Key: proto.Key("n")},
+		SplitKey:      proto.Key("n"),
+		DryRun:        true,
+	}
+	reply := &proto.AdminSplitResponse{}
+	tc.rng.AdminSplit(args, reply)
+	if err := reply.GoError(); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.SplitKey.Equal(proto.Key("n")) {
+		t.Errorf("expected split key %q; got %q", "n", reply.SplitKey)
+	}
+	if reply.LeftBytes == 0 || reply.RightBytes == 0 {
+		t.Fatalf("expected non-zero projected sizes; got %d, %d", reply.LeftBytes, reply.RightBytes)
+	}
+	if diff := reply.LeftBytes - reply.RightBytes; diff > reply.RightBytes/10 || -diff > reply.RightBytes/10 {
+		t.Errorf("expected roughly equal projected sizes; got %d, %d", reply.LeftBytes, reply.RightBytes)
+	}
+	if !reflect.DeepEqual(*tc.rng.Desc(), origDesc) {
+		t.Errorf("expected dry run to leave range descriptor unchanged; got %+v", tc.rng.Desc())
+	}
+
+	// A dry run at an invalid split key reports the error.
+	args.SplitKey = engine.KeyMeta1Prefix
+	reply = &proto.AdminSplitResponse{}
+	tc.rng.AdminSplit(args, reply)
+	if err := reply.GoError(); err == nil {
+		t.Error("expected invalid split key error")
+	} else if matched, _ := regexp.MatchString("cannot split range", err.Error()); !matched {
+		t.Errorf("expected invalid split key error; got %s", err)
+	}
+}
+
+// TestRangeParallelScan verifies that a scan divided into parallel
+// sub-spans returns the same ordered rows as a sequential scan.
+func TestRangeParallelScan(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for i := 0; i < 100; i++ {
+		key := proto.Key(fmt.Sprintf("a%02d", i))
+		pArgs, pReply := putArgs(key, []byte(key), tc.rng.Desc().RaftID, tc.store.StoreID())
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, max := range []int64{0, 55} {
+		sArgs, sReply := scanArgs([]byte("a"), []byte("b"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		sArgs.MaxResults = max
+		sArgs.ReturnStats = true
+		if err := tc.rng.AddCmd(proto.Scan, sArgs, sReply, true); err != nil {
+			t.Fatal(err)
+		}
+		pArgs, pReply := scanArgs([]byte("a"), []byte("b"), tc.rng.Desc().RaftID, tc.store.StoreID())
+		pArgs.MaxResults = max
+		pArgs.Parallelism = 4
+		pArgs.ReturnStats = true
+		if err := tc.rng.AddCmd(proto.Scan, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+		expLen := int64(100)
+		if max != 0 {
+			expLen = max
+		}
+		if int64(len(pReply.Rows)) != expLen {
+			t.Errorf("max=%d: expected %d rows; got %d", max, expLen, len(pReply.Rows))
+		}
+		if !reflect.DeepEqual(sReply.Rows, pReply.Rows) {
+			t.Errorf("max=%d: expected parallel scan rows to equal sequential scan rows", max)
+		}
+		if max == 0 && sReply.KeysExamined != pReply.KeysExamined {
+			t.Errorf("expected %d keys examined; got %d", sReply.KeysExamined, pReply.KeysExamined)
+		}
+	}
+
+	// Every sub-span is scanned from the supplied engine: keys written
+	// after a snapshot was taken aren't seen, and sub-span scans are
+	// subject to the deadline of a deadline engine.
+	snap := tc.engine.NewSnapshot()
+	defer snap.Stop()
+	pArgs, pReply := putArgs(proto.Key("a50x"), []byte("value"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	sArgs, _ := scanArgs([]byte("a"), []byte("b"), tc.rng.Desc().RaftID, tc.store.StoreID())
+	sArgs.Timestamp = tc.clock.Now()
+	rows, err := parallelScanRows(snap, sArgs, 0, 4, &engine.MVCCScanStats{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 100 {
+		t.Errorf("expected 100 rows from snapshot; got %d", len(rows))
+	}
+	expired := engine.NewDeadlineEngine(snap, time.Now().Add(-time.Second))
+	if _, err := parallelScanRows(expired, sArgs, 0, 4, &engine.MVCCScanStats{}); err == nil {
+		t.Error("expected parallel scan to fail past the engine's deadline")
+	} else if _, ok := err.(*proto.DeadlineExceededError); !ok {
+		t.Errorf("expected deadline exceeded error; got %T: %s", err, err)
+	}
+
+	// Verify sub-span boundaries are ordered and interior to the span.
+	start, end := proto.Key("a00"), proto.Key("a99")
+	keys := interpolateKeys(start, end, 4)
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 interpolated keys; got %d", len(keys))
+	}
+	prev := start
+	for _, k := range append(keys, end) {
+		if !prev.Less(k) {
+			t.Errorf("expected %q < %q", prev, k)
+		}
+		prev = k
+	}
+}
+
+// TestRangeCommandTooLarge verifies that a read-write command whose
+// serialized size exceeds the range's maximum command size is rejected
+// before being proposed to Raft.
+func TestRangeCommandTooLarge(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.maxCommandSize = 1 << 10
+
+	bArgs := &proto.BatchRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     proto.Key("a"),
+			EndKey:  proto.Key("b"),
+			RaftID:  1,
+			Replica: proto.Replica{StoreID: tc.store.StoreID()},
+		},
+	}
+	for i := 0; i < 10; i++ {
+		pArgs, _ := putArgs(proto.Key(fmt.Sprintf("a%d", i)), bytes.Repeat([]byte("v"), 200), 1, tc.store.StoreID())
+		bArgs.Add(pArgs)
+	}
+	lastIndex := atomic.LoadUint64(&tc.rng.lastIndex)
+	err := tc.rng.AddCmd(proto.Batch, bArgs, &proto.BatchResponse{}, true)
+	if ctlErr, ok := err.(*proto.CommandTooLargeError); !ok {
+		t.Fatalf("expected command too large error; got %v", err)
+	} else if ctlErr.MaxSize != 1<<10 || ctlErr.Size <= ctlErr.MaxSize {
+		t.Errorf("unexpected command too large error %+v", ctlErr)
+	}
+	if li := atomic.LoadUint64(&tc.rng.lastIndex); li != lastIndex {
+		t.Errorf("expected no command to be proposed; last index advanced from %d to %d", lastIndex, li)
+	}
+
+	// Commands within the limit are unaffected.
+	pArgs, pReply := putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRangeProposalBufferFull verifies that client writes are rejected
+// with a retryable error while the range's unapplied proposals exceed
+// the maximum pending bytes, and are accepted once they drain.
+func TestRangeProposalBufferFull(t *testing.T) {
+	be := newBlockingEngine()
+	tc := testContext{
+		engine: be,
+	}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.maxPendingBytes = 1 << 10
+
+	// Stall the application of a large write to "a".
+	be.block(proto.Key("a"))
+	pArgs, pReply := putArgs(proto.Key("a"), bytes.Repeat([]byte("v"), 600), 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, false); err != nil {
+		t.Fatal(err)
+	}
+	if pb := tc.rng.PendingProposalBytes(); pb < 600 {
+		t.Errorf("expected at least 600 pending proposal bytes; got %d", pb)
+	}
+
+	pArgs, pReply = putArgs(proto.Key("b"), bytes.Repeat([]byte("v"), 600), 1, tc.store.StoreID())
+	err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
+	if pbfErr, ok := err.(*proto.ProposalBufferFullError); !ok {
+		t.Fatalf("expected proposal buffer full error; got %v", err)
+	} else if !pbfErr.CanRetry() || pbfErr.MaxBytes != 1<<10 {
+		t.Errorf("unexpected proposal buffer full error %+v", pbfErr)
+	}
+
+	// Once the stalled write applies, writes are accepted again.
+	be.unblock()
+	if err := util.IsTrueWithin(func() bool {
+		return tc.rng.PendingProposalBytes() == 0
+	}, 500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	pArgs, pReply = putArgs(proto.Key("b"), bytes.Repeat([]byte("v"), 600), 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRangeTimestampTooFar verifies that commands timestamped further
+// ahead of the clock than the maximum future offset are rejected
+// without advancing the clock.
+func TestRangeTimestampTooFar(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.maxFutureOffset = 1 * time.Second
+
+	// A timestamp within the ceiling is accepted.
+	pArgs, pReply := putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = proto.Timestamp{WallTime: tc.manualClock.UnixNano() + 500*time.Millisecond.Nanoseconds()}
+	if err := tc.store.ExecuteCmd(proto.Put, pArgs, pReply); err != nil {
+		t.Fatal(err)
+	}
+
+	farTS := proto.Timestamp{WallTime: tc.manualClock.UnixNano() + time.Hour.Nanoseconds()}
+	pArgs, pReply = putArgs(proto.Key("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = farTS
+	err := tc.store.ExecuteCmd(proto.Put, pArgs, pReply)
+	if tfErr, ok := err.(*proto.TimestampTooFarError); !ok {
+		t.Fatalf("expected timestamp too far error; got %v", err)
+	} else if !tfErr.Timestamp.Equal(farTS) {
+		t.Errorf("expected error for timestamp %s; got %s", farTS, tfErr.Timestamp)
+	}
+	if now := tc.clock.Now(); !now.Less(farTS) {
+		t.Errorf("expected clock not to be advanced to %s; got %s", farTS, now)
+	}
+
+	// Commands added directly to the range are checked too.
+	pArgs, pReply = putArgs(proto.Key("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = farTS
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err == nil {
+		t.Error("expected timestamp too far error adding command to range")
+	}
+}
+
+// countingEngine wraps an engine, counting gets.
+type countingEngine struct {
+	engine.Engine
+	gets int64 // Updated atomically
+}
+
+func (e *countingEngine) Get(key proto.EncodedKey) ([]byte, error) {
+	atomic.AddInt64(&e.gets, 1)
+	return e.Engine.Get(key)
+}
+
+func (e *countingEngine) NewBatch() engine.Engine {
+	return engine.NewBatch(e)
+}
+
+// TestRangeValueCache verifies that a repeated Get of a key at the
+// same timestamp is served from the value cache, and that a write to
+// the key invalidates its entries.
+func TestRangeValueCache(t *testing.T) {
+	eng := &countingEngine{
+		Engine: engine.NewInMem(proto.Attributes{Attrs: []string{"dc1", "mem"}}, 1<<20),
+	}
+	tc := testContext{engine: eng}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.valueCache = newValueCache(100)
+
+	key := proto.Key("a")
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	readTS := tc.clock.Now()
+	get := func(expValue string) int64 {
+		gets := atomic.LoadInt64(&eng.gets)
+		gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+		gArgs.Timestamp = readTS
+		if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+			t.Fatal(err)
+		}
+		if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte(expValue)) {
+			t.Fatalf("expected value %q; got %+v", expValue, gReply.Value)
+		}
+		return atomic.LoadInt64(&eng.gets) - gets
+	}
+	if n := get("value"); n == 0 {
+		t.Fatal("expected first get to read from the engine")
+	}
+	if n := get("value"); n != 0 {
+		t.Errorf("expected second get to be served from cache; got %d engine gets", n)
+	}
+
+	// A write to the key invalidates its cached values, even though
+	// the value at the earlier read timestamp is unchanged.
+	pArgs, pReply = putArgs(key, []byte("value2"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if n := get("value"); n == 0 {
+		t.Error("expected get after write to read from the engine")
+	}
+	readTS = tc.clock.Now()
+	if n := get("value2"); n == 0 {
+		t.Error("expected get at a new timestamp to read from the engine")
+	}
+	if n := get("value2"); n != 0 {
+		t.Errorf("expected repeated get to be served from cache; got %d engine gets", n)
+	}
+}
+
+// TestRangeCoalescePuts verifies that non-transactional rewrites of a
+// key beyond the per-second version limit replace its latest version
+// rather than adding new ones.
+func TestRangeCoalescePuts(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.maxVersionsPerSecond = 2
+	tc.rng.writeRates = newWriteRateTracker()
+
+	key := proto.Key("a")
+	const writes = 10
+	var value []byte
+	for i := 0; i < writes; i++ {
+		value = []byte(fmt.Sprintf("value%d", i))
+		pArgs, pReply := putArgs(key, value, 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	versions, err := engine.MVCCGetHistory(tc.engine, key, proto.ZeroTimestamp, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) >= writes {
+		t.Errorf("expected fewer than %d versions; got %d", writes, len(versions))
+	}
+	if len(versions) == 0 || !bytes.Equal(versions[0].Value.Bytes, value) {
+		t.Errorf("expected latest version to hold %q; got %+v", value, versions)
+	}
+}
+
+// TestRangeHotKeys verifies that after many reads concentrated on a
+// few keys, HotKeys reports those keys as the most accessed.
+func TestRangeHotKeys(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for i := 0; i < 1000; i++ {
+		var key proto.Key
+		switch i % 10 {
+		case 0, 1, 2, 3:
+			key = proto.Key("a")
+		case 4, 5, 6, 7:
+			key = proto.Key("b")
+		default:
+			key = proto.Key(fmt.Sprintf("c%03d", i))
+		}
+		gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+		gArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	hotKeys := tc.rng.HotKeys()
+	if len(hotKeys) < 3 {
+		t.Fatalf("expected at least 3 hot keys; got %+v", hotKeys)
+	}
+	for i, key := range []proto.Key{proto.Key("a"), proto.Key("b")} {
+		if !hotKeys[0].Key.Equal(key) && !hotKeys[1].Key.Equal(key) {
+			t.Errorf("%d: expected %q among the two hottest keys; got %+v", i, key, hotKeys)
+		}
+	}
+	if hotKeys[1].Rate <= hotKeys[2].Rate || hotKeys[1].Rate <= 0 {
+		t.Errorf("expected hottest keys to have the greatest rates; got %+v", hotKeys)
+	}
+}
+
+// TestRangeVerifyRange verifies that InternalVerifyRange reports a key
+// stored outside the range's descriptor bounds, and only that key.
+func TestRangeVerifyRange(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	outKey := proto.Key("\xff\xff\x01")
+	for _, key := range []proto.Key{proto.Key("a"), outKey} {
+		if err := engine.MVCCPut(tc.engine, nil, key, makeTS(1, 0), proto.Value{Bytes: []byte("value")}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	args := &proto.InternalVerifyRangeRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     engine.KeyMin,
+			RaftID:  1,
+			Replica: proto.Replica{StoreID: tc.store.StoreID()},
+		},
+	}
+	reply := &proto.InternalVerifyRangeResponse{}
+	if err := tc.rng.AddCmd(proto.InternalVerifyRange, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if expKeys := []proto.Key{outKey}; !reflect.DeepEqual(reply.OutOfBoundsKeys, expKeys) {
+		t.Errorf("expected out of bounds keys %q; got %q", expKeys, reply.OutOfBoundsKeys)
+	}
+}
+
+// TestRangeComputeChecksum verifies that InternalComputeChecksum
+// returns the same checksum until the range's data is modified.
+func TestRangeComputeChecksum(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	computeChecksum := func() []byte {
+		args := &proto.InternalComputeChecksumRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       engine.KeyMin,
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+				Timestamp: tc.clock.Now(),
+			},
+		}
+		reply := &proto.InternalComputeChecksumResponse{}
+		if err := tc.rng.AddCmd(proto.InternalComputeChecksum, args, reply, true); err != nil {
+			t.Fatal(err)
+		}
+		return reply.Checksum
+	}
+	put := func(key, value string) {
+		pArgs, pReply := putArgs(proto.Key(key), []byte(value), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	put("a", "value")
+	checksum := computeChecksum()
+	if len(checksum) == 0 {
+		t.Fatal("expected a non-empty checksum")
+	}
+	tc.manualClock.Increment(1)
+	if again := computeChecksum(); !bytes.Equal(checksum, again) {
+		t.Errorf("expected unchanged checksum %x; got %x", checksum, again)
+	}
+
+	tc.manualClock.Increment(1)
+	put("a", "other value")
+	tc.manualClock.Increment(1)
+	if mutated := computeChecksum(); bytes.Equal(checksum, mutated) {
+		t.Errorf("expected checksum to change after write; got %x", mutated)
+	}
+}
+
+// TestRangeGetHistory verifies that InternalGetHistory returns the
+// versions of a key, including deletion tombstones, bounded by the
+// request timestamp and by the optional before timestamp.
+func TestRangeGetHistory(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	var timestamps []proto.Timestamp
+	for i := 0; i < 4; i++ {
+		tc.manualClock.Increment(1)
+		var err error
+		if i == 2 {
+			dArgs, dReply := deleteArgs(key, 1, tc.store.StoreID())
+			dArgs.Timestamp = tc.clock.Now()
+			timestamps = append(timestamps, dArgs.Timestamp)
+			err = tc.rng.AddCmd(proto.Delete, dArgs, dReply, true)
+		} else {
+			pArgs, pReply := putArgs(key, []byte(fmt.Sprintf("value%d", i)), 1, tc.store.StoreID())
+			pArgs.Timestamp = tc.clock.Now()
+			timestamps = append(timestamps, pArgs.Timestamp)
+			err = tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	getHistory := func(timestamp, before proto.Timestamp) []proto.MVCCVersion {
+		args := &proto.InternalGetHistoryRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       key,
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+				Timestamp: timestamp,
+			},
+			Before: before,
+		}
+		reply := &proto.InternalGetHistoryResponse{}
+		if err := tc.rng.AddCmd(proto.InternalGetHistory, args, reply, true); err != nil {
+			t.Fatal(err)
+		}
+		return reply.Versions
+	}
+
+	// Read as of the deletion; the newest version is omitted.
+	versions := getHistory(timestamps[2], proto.ZeroTimestamp)
+	if len(versions) != 3 {
+		t.Fatalf("expected 3 versions; got %+v", versions)
+	}
+	if !versions[0].Timestamp.Equal(timestamps[2]) || !versions[0].Deleted || versions[0].Value != nil {
+		t.Errorf("expected deletion tombstone at %s; got %+v", timestamps[2], versions[0])
+	}
+	for i, v := range versions[1:] {
+		expTS, expBytes := timestamps[1-i], []byte(fmt.Sprintf("value%d", 1-i))
+		if !v.Timestamp.Equal(expTS) || v.Deleted || v.Value == nil || !bytes.Equal(v.Value.Bytes, expBytes) {
+			t.Errorf("expected %q at %s; got %+v", expBytes, expTS, v)
+		}
+	}
+
+	// Page back from the second version.
+	versions = getHistory(tc.clock.Now(), timestamps[1])
+	if len(versions) != 1 || !versions[0].Timestamp.Equal(timestamps[0]) {
+		t.Errorf("expected only the version at %s; got %+v", timestamps[0], versions)
+	}
+}
+
+// TestRangeBatchGet verifies that InternalBatchGet returns values
+// parallel to the requested keys, with nil entries for absent keys,
+// and that an intent on any key is reported to all but its owner.
+func TestRangeBatchGet(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for _, key := range []string{"a", "c"} {
+		pArgs, pReply := putArgs([]byte(key), []byte("value-"+key), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	txn := newTransaction("test", proto.Key("d"), 1, proto.SERIALIZABLE, tc.clock)
+	pArgs, pReply := putArgs([]byte("d"), []byte("value-d"), 1, tc.store.StoreID())
+	pArgs.Timestamp = txn.Timestamp
+	pArgs.Txn = txn
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	batchGet := func(txn *proto.Transaction, keys ...string) ([]proto.BatchGetValue, error) {
+		args := &proto.InternalBatchGetRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       proto.Key(keys[0]),
+				EndKey:    proto.Key(keys[len(keys)-1]).Next(),
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+				Timestamp: tc.clock.Now(),
+				Txn:       txn,
+			},
+		}
+		for _, key := range keys {
+			args.Keys = append(args.Keys, proto.Key(key))
+		}
+		reply := &proto.InternalBatchGetResponse{}
+		err := tc.rng.AddCmd(proto.InternalBatchGet, args, reply, true)
+		return reply.Values, err
+	}
+
+	values, err := batchGet(nil, "a", "b", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 3 {
+		t.Fatalf("expected 3 values; got %+v", values)
+	}
+	for i, expValue := range []string{"value-a", "", "value-c"} {
+		if expValue == "" {
+			if values[i].Value != nil {
+				t.Errorf("%d: expected nil value; got %+v", i, values[i].Value)
+			}
+		} else if values[i].Value == nil || !bytes.Equal(values[i].Value.Bytes, []byte(expValue)) {
+			t.Errorf("%d: expected %q; got %+v", i, expValue, values[i].Value)
+		}
+	}
+
+	// The intent on "d" is encountered by a non-transactional read.
+	if _, err := batchGet(nil, "a", "d"); err == nil {
+		t.Error("expected write intent error")
+	} else if _, ok := err.(*proto.WriteIntentError); !ok {
+		t.Errorf("expected write intent error; got %s", err)
+	}
+
+	// The intent's owner reads its own write.
+	txn.Timestamp = tc.clock.Now()
+	values, err = batchGet(txn, "a", "d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[1].Value == nil || !bytes.Equal(values[1].Value.Bytes, []byte("value-d")) {
+		t.Errorf("expected transaction to read its own intent; got %+v", values)
+	}
+
+	// Keys outside the header span are rejected.
+	args := &proto.InternalBatchGetRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			EndKey:    proto.Key("b"),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Timestamp: tc.clock.Now(),
+		},
+		Keys: []proto.Key{proto.Key("a"), proto.Key("c")},
+	}
+	if err := tc.rng.AddCmd(proto.InternalBatchGet, args, &proto.InternalBatchGetResponse{}, true); err == nil {
+		t.Error("expected error for key outside of span")
+	}
+}
+
+// TestRangeChangeFeed verifies that a change feed receives the
+// committed writes to its span in commit order, including deletions
+// and resolved transactional writes, each delivered once and excluding
+// released read locks, and that a subscriber falling behind its buffer
+// has its feed terminated.
+func TestRangeChangeFeed(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	feed := tc.rng.Subscribe(proto.Key("a"), proto.Key("c"), proto.ZeroTimestamp, 0)
+	defer feed.Close()
+	slowFeed := tc.rng.Subscribe(proto.Key("a"), proto.Key("c"), proto.ZeroTimestamp, 1)
+
+	put := func(key, value string, txn *proto.Transaction) {
+		pArgs, pReply := putArgs(proto.Key(key), []byte(value), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		if txn != nil {
+			pArgs.Timestamp = txn.Timestamp
+			pArgs.Txn = txn
+		}
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	put("a", "value1", nil)
+	put("b", "value2", nil)
+	put("c", "value3", nil)
+	dArgs, dReply := deleteArgs(proto.Key("a"), 1, tc.store.StoreID())
+	dArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Delete, dArgs, dReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// A transactional write is only delivered once its intent is
+	// resolved as committed.
+	txn := newTransaction("test", proto.Key("b"), 1, proto.SERIALIZABLE, tc.clock)
+	put("b", "value4", txn)
+	eArgs, eReply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	eArgs.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(proto.EndTransaction, eArgs, eReply, true); err != nil {
+		t.Fatal(err)
+	}
+	rArgs := &proto.InternalResolveIntentRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("b"),
+			Timestamp: txn.Timestamp,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Txn:       eReply.Txn,
+		},
+	}
+	if err := tc.rng.AddCmd(proto.InternalResolveIntent, rArgs, &proto.InternalResolveIntentResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+	// Resolving the intent again delivers nothing further.
+	if err := tc.rng.AddCmd(proto.InternalResolveIntent, rArgs, &proto.InternalResolveIntentResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Resolving a span delivers the committed write of the transaction
+	// but not the release of its read lock.
+	txn = newTransaction("test2", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	put("a", "value5", txn)
+	gArgs, gReply := getArgs(proto.Key("b"), 1, tc.store.StoreID())
+	gArgs.Timestamp = txn.Timestamp
+	gArgs.Txn = txn
+	gArgs.Lock = true
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	eArgs, eReply = endTxnArgs(txn, true, 1, tc.store.StoreID())
+	eArgs.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(proto.EndTransaction, eArgs, eReply, true); err != nil {
+		t.Fatal(err)
+	}
+	rrArgs := &proto.InternalResolveIntentRangeRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			EndKey:    proto.Key("c"),
+			Timestamp: txn.Timestamp,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Txn:       eReply.Txn,
+		},
+	}
+	if err := tc.rng.AddCmd(proto.InternalResolveIntentRange, rrArgs, &proto.InternalResolveIntentRangeResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	expEvents := []struct {
+		key, value string
+		deleted    bool
+	}{
+		{"a", "value1", false},
+		{"b", "value2", false},
+		{"a", "", true},
+		{"b", "value4", false},
+		{"a", "value5", false},
+	}
+	var lastTS proto.Timestamp
+	for i, exp := range expEvents {
+		var ev ChangeEvent
+		select {
+		case ev = <-feed.C:
+		case <-time.After(time.Second):
+			t.Fatalf("%d: timed out waiting for change event", i)
+		}
+		if !ev.Key.Equal(proto.Key(exp.key)) || ev.Deleted != exp.deleted {
+			t.Errorf("%d: expected event for %q (deleted=%t); got %+v", i, exp.key, exp.deleted, ev)
+		} else if !exp.deleted && (ev.Value == nil || !bytes.Equal(ev.Value.Bytes, []byte(exp.value))) {
+			t.Errorf("%d: expected value %q; got %+v", i, exp.value, ev.Value)
+		}
+		if ev.Timestamp.Less(lastTS) {
+			t.Errorf("%d: event timestamp %s precedes prior event's %s", i, ev.Timestamp, lastTS)
+		}
+		lastTS = ev.Timestamp
+	}
+	select {
+	case ev := <-feed.C:
+		t.Errorf("unexpected change event %+v", ev)
+	default:
+	}
+
+	// The slow feed buffered the first event and was then terminated.
+	if ev, ok := <-slowFeed.C; !ok || !ev.Key.Equal(proto.Key("a")) {
+		t.Errorf("expected buffered event for \"a\"; got %+v", ev)
+	}
+	if _, ok := <-slowFeed.C; ok {
+		t.Error("expected slow feed to be closed")
+	}
+	if slowFeed.Err() == nil {
+		t.Error("expected slow feed to be terminated with an error")
+	}
+}
+
+// TestRangeLeaseTransferFence verifies that during a lease transfer
+// reads at or below the fence timestamp are served immediately while
+// reads above it block until the new lease is active.
+func TestRangeLeaseTransferFence(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	fence := tc.clock.Now()
+	tc.rng.BeginLeaseTransfer(fence)
+
+	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = fence
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	gArgs, gReply = getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- tc.rng.AddCmd(proto.Get, gArgs, gReply, true)
+	}()
+	select {
+	case err := <-errChan:
+		t.Fatalf("expected read above fence to block; got %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	tc.rng.CompleteLeaseTransfer()
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read above fence did not complete after lease transfer")
+	}
+}
+
+// TestRangeLeaseTransferFenceTimeout verifies that a read above the
+// fence of a lease transfer which doesn't complete gives up waiting at
+// its deadline with a NotLeaderError.
+func TestRangeLeaseTransferFenceTimeout(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	fence := tc.clock.Now()
+	tc.rng.BeginLeaseTransfer(fence)
+	defer tc.rng.CompleteLeaseTransfer()
+
+	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	gArgs.Timeout = (10 * time.Millisecond).Nanoseconds()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- tc.rng.AddCmd(proto.Get, gArgs, gReply, true)
+	}()
+	select {
+	case err := <-errChan:
+		if _, ok := err.(*proto.NotLeaderError); !ok {
+			t.Fatalf("expected not leader error; got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read above fence did not give up waiting at its deadline")
+	}
+}
+
+// TestRangeCommandQueue verifies that reads/writes must wait for
+// pending commands to complete through Raft before being executed on
+// range.
+func TestRangeCommandQueue(t *testing.T) {
+	be := newBlockingEngine()
+	tc := testContext{
+		engine: be,
+	}
 	tc.Start(t)
 	defer tc.Stop()
 
 	// Test all four combinations of reads & writes waiting.
 	testCases := []struct {
-		cmd1Read, cmd2Read bool
-		expWait            bool
+		cmd1Read, cmd2Read bool
+		expWait            bool
+	}{
+		// Read/read doesn't wait.
+		{true, true, false},
+		// All other combinations must wait.
+		{true, false, true},
+		{false, true, true},
+		{false, false, true},
+	}
+
+	for i, test := range testCases {
+		key1 := proto.Key(fmt.Sprintf("key1-%d", i))
+		key2 := proto.Key(fmt.Sprintf("key2-%d", i))
+		// Asynchronously put a value to the rng with blocking enabled.
+		be.block(key1)
+		cmd1Done := make(chan struct{})
+		go func() {
+			method, args, reply := readOrWriteArgs(key1, test.cmd1Read, tc.rng.Desc().RaftID,
+				tc.store.StoreID())
+			err := tc.rng.AddCmd(method, args, reply, true)
+			if err != nil {
+				t.Fatalf("test %d: %s", i, err)
+			}
+			close(cmd1Done)
+		}()
+
+		// First, try a command for same key as cmd1 to verify it blocks.
+		cmd2Done := make(chan struct{})
+		go func() {
+			method, args, reply := readOrWriteArgs(key1, test.cmd2Read, tc.rng.Desc().RaftID,
+				tc.store.StoreID())
+			err := tc.rng.AddCmd(method, args, reply, true)
+			if err != nil {
+				t.Fatalf("test %d: %s", i, err)
+			}
+			close(cmd2Done)
+		}()
+
+		// Next, try read for a non-impacted key--should go through immediately.
+		cmd3Done := make(chan struct{})
+		go func() {
+			method, args, reply := readOrWriteArgs(key2, true, tc.rng.Desc().RaftID, tc.store.StoreID())
+			err := tc.rng.AddCmd(method, args, reply, true)
+			if err != nil {
+				t.Fatalf("test %d: %s", i, err)
+			}
+			close(cmd3Done)
+		}()
+
+		if test.expWait {
+			// Verify cmd3 finishes but not cmd2.
+			select {
+			case <-cmd2Done:
+				t.Fatalf("test %d: should not have been able to execute cmd2", i)
+			case <-cmd3Done:
+				// success.
+			case <-cmd1Done:
+				t.Fatalf("test %d: should not have been able execute cmd1 while blocked", i)
+			case <-time.After(500 * time.Millisecond):
+				t.Fatalf("test %d: waited 500ms for cmd3 of key2", i)
+			}
+		} else {
+			select {
+			case <-cmd2Done:
+				// success.
+			case <-cmd1Done:
+				t.Fatalf("test %d: should not have been able to execute cmd1 while blocked", i)
+			case <-time.After(500 * time.Millisecond):
+				t.Fatalf("test %d: waited 500ms for cmd2 of key1", i)
+			}
+			<-cmd3Done
+		}
+
+		be.unblock()
+		select {
+		case <-cmd2Done:
+			// success.
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("test %d: waited 500ms for cmd2 of key1", i)
+		}
+	}
+}
+
+// TestRangeCommandQueueTimeout verifies that a command waiting in the
+// command queue behind a blocked command fails with a
+// CommandQueueTimeoutError once the timeout elapses on the clock.
+func TestRangeCommandQueueTimeout(t *testing.T) {
+	be := newBlockingEngine()
+	tc := testContext{
+		engine: be,
+	}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.cmdQTimeout = 1 * time.Second
+
+	// Block a write to the key.
+	key := proto.Key("a")
+	be.block(key)
+	cmd1Done := make(chan error)
+	go func() {
+		method, args, reply := readOrWriteArgs(key, false, tc.rng.Desc().RaftID, tc.store.StoreID())
+		cmd1Done <- tc.rng.AddCmd(method, args, reply, true)
+	}()
+	// Wait for the write to enter the command queue.
+	if err := util.IsTrueWithin(func() bool {
+		tc.rng.Lock()
+		defer tc.rng.Unlock()
+		return tc.rng.cmdQ.WouldWait(key, nil, true)
+	}, 500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	// A read of the key waits behind the write.
+	cmd2Done := make(chan error)
+	go func() {
+		method, args, reply := readOrWriteArgs(key, true, tc.rng.Desc().RaftID, tc.store.StoreID())
+		cmd2Done <- tc.rng.AddCmd(method, args, reply, true)
+	}()
+	select {
+	case err := <-cmd2Done:
+		t.Fatalf("expected read to wait in the command queue; got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Once the timeout elapses on the clock, the read fails.
+	tc.manualClock.Increment(tc.rng.cmdQTimeout.Nanoseconds())
+	select {
+	case err := <-cmd2Done:
+		if _, ok := err.(*proto.CommandQueueTimeoutError); !ok {
+			t.Errorf("expected command queue timeout error; got %v", err)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("expected read to time out in the command queue")
+	}
+
+	be.unblock()
+	if err := <-cmd1Done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRangeUseTSCache verifies that write timestamps are upgraded
+// based on the read timestamp cache.
+func TestRangeUseTSCache(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	// Set clock to time 1s and do the read.
+	t0 := 1 * time.Second
+	tc.manualClock.Set(t0.Nanoseconds())
+	args, reply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	err := tc.rng.AddCmd(proto.Get, args, reply, true)
+	if err != nil {
+		t.Error(err)
+	}
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	err = tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pReply.Timestamp.WallTime != tc.clock.Timestamp().WallTime {
+		t.Errorf("expected write timestamp to upgrade to 1s; got %+v", pReply.Timestamp)
+	}
+}
+
+// TestRangeNoTSCacheUpdateOnFailure verifies that read and write
+// commands do not update the timestamp cache if they result in
+// failure.
+func TestRangeNoTSCacheUpdateOnFailure(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Test for both read & write attempts.
+	for i, read := range []bool{true, false} {
+		key := proto.Key(fmt.Sprintf("key-%d", i))
+
+		// Start by laying down an intent to trip up future read or write to same key.
+		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+		pArgs.Txn = newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+		pArgs.Timestamp = pArgs.Txn.Timestamp
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatalf("test %d: %s", i, err)
+		}
+
+		// Now attempt read or write.
+		method, args, reply := readOrWriteArgs(key, read, tc.rng.Desc().RaftID, tc.store.StoreID())
+		args.Header().Timestamp = tc.clock.Now() // later timestamp
+		if err := tc.rng.AddCmd(method, args, reply, true); err == nil {
+			t.Errorf("test %d: expected failure", i)
+		}
+
+		// Write the intent again -- should not have its timestamp upgraded!
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatalf("test %d: %s", i, err)
+		}
+		if !pReply.Timestamp.Equal(pArgs.Timestamp) {
+			t.Errorf("expected timestamp not to advance %s != %s", pReply.Timestamp, pArgs.Timestamp)
+		}
+	}
+}
+
+// TestRangeNoTimestampIncrementWithinTxn verifies that successive
+// read the write commands within the same transaction do not cause
+// the write to receive an incremented timestamp.
+func TestRangeNoTimestampIncrementWithinTxn(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Test for both read & write attempts.
+	key := proto.Key("a")
+	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+
+	// Start with a read to warm the timestamp cache.
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Txn = txn
+	gArgs.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Now try a write and verify timestamp isn't incremented.
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Txn = txn
+	pArgs.Timestamp = pArgs.Txn.Timestamp
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !pReply.Timestamp.Equal(pArgs.Timestamp) {
+		t.Errorf("expected timestamp to remain %s; got %s", pArgs.Timestamp, pReply.Timestamp)
+	}
+
+	// Finally, try a non-transactional write and verify timestamp is incremented.
+	pArgs.Txn = nil
+	expTS := pArgs.Timestamp
+	expTS.Logical++
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err == nil {
+		t.Errorf("expected write intent error")
+	}
+	if !pReply.Timestamp.Equal(expTS) {
+		t.Errorf("expected timestamp to increment to %s; got %s", expTS, pReply.Timestamp)
+	}
+}
+
+// TestRangeIncrementBounds verifies that increments start from the
+// request's initial value, and that increments which overflow int64
+// or exceed the request's maximum fail without modifying the value.
+func TestRangeIncrementBounds(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	max := int64(10)
+	testCases := []struct {
+		key          proto.Key
+		initial, inc int64
+		max          *int64
+		expValue     int64
+		expErr       bool
+	}{
+		{proto.Key("a"), 5, 1, nil, 6, false},
+		{proto.Key("a"), 5, 1, nil, 7, false}, // initial value ignored once key exists
+		{proto.Key("b"), math.MaxInt64 - 1, 1, nil, math.MaxInt64, false},
+		{proto.Key("b"), 0, 1, nil, 0, true},
+		{proto.Key("c"), math.MinInt64 + 1, -1, nil, math.MinInt64, false},
+		{proto.Key("c"), 0, -1, nil, 0, true},
+		{proto.Key("d"), 9, 1, &max, 10, false},
+		{proto.Key("d"), 0, 1, &max, 0, true},
+		{proto.Key("d"), 0, -1, &max, 9, false},
+	}
+	for i, test := range testCases {
+		args, reply := incrementArgs(test.key, test.inc, 1, tc.store.StoreID())
+		args.InitialValue = test.initial
+		args.Max = test.max
+		args.Timestamp = tc.clock.Now()
+		err := tc.rng.AddCmd(proto.Increment, args, reply, true)
+		if test.expErr {
+			if err == nil {
+				t.Errorf("%d: expected error", i)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err)
+		} else if reply.NewValue != test.expValue {
+			t.Errorf("%d: expected new value %d; got %d", i, test.expValue, reply.NewValue)
+		}
+	}
+}
+
+// TestRangeIdempotence verifies that a retry increment with
+// same client command ID receives same reply.
+func TestRangeIdempotence(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Run the same increment 100 times, 50 with identical command ID,
+	// interleaved with 50 using a sequence of different command IDs.
+	goldenArgs, _ := incrementArgs([]byte("a"), 1, 1, tc.store.StoreID())
+	incDones := make([]chan struct{}, 100)
+	var count int64
+	for i := range incDones {
+		incDones[i] = make(chan struct{})
+		idx := i
+		go func() {
+			var args proto.IncrementRequest
+			var reply proto.IncrementResponse
+			args = *goldenArgs
+			args.Header().Timestamp = tc.clock.Now()
+			if idx%2 == 0 {
+				args.CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
+			} else {
+				args.CmdID = proto.ClientCmdID{WallTime: 1, Random: int64(idx + 100)}
+			}
+			err := tc.rng.AddCmd(proto.Increment, &args, &reply, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if idx%2 == 0 && reply.NewValue != 1 {
+				t.Errorf("expected all incremented values to be 1; got %d", reply.NewValue)
+			} else if idx%2 == 1 {
+				atomic.AddInt64(&count, reply.NewValue)
+			}
+			close(incDones[idx])
+		}()
+	}
+	// Wait for all to complete.
+	for _, done := range incDones {
+		select {
+		case <-done:
+			// Success.
+		case <-time.After(2000 * time.Millisecond):
+			t.Fatal("had to wait for increment to complete")
+		}
+	}
+	// Verify that all non-repeated client commands incremented the
+	// counter starting at 2 all the way to 51 (sum of sequence = 1325).
+	if count != 1325 {
+		t.Errorf("expected sum of all increments to be 1325; got %d", count)
+	}
+}
+
+// TestRangeLeaseTransferResponseCache verifies that response cache
+// entries carried by a lease transfer allow a replayed increment to
+// receive its original reply rather than incrementing again.
+func TestRangeLeaseTransferResponseCache(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	args, reply := incrementArgs([]byte("a"), 1, 1, tc.store.StoreID())
+	args.CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
+	args.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Increment, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	lt, err := tc.rng.ExportLeaseTransfer(tc.clock.Now(), *tc.rng.GetReplica())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.rng.CompleteLeaseTransfer()
+	if len(lt.ResponseCache) != 1 {
+		t.Fatalf("expected 1 response cache entry; got %d", len(lt.ResponseCache))
+	}
+
+	// Simulate the incoming holder, which lacks the response cache,
+	// by clearing it before importing the transferred entries.
+	if err := tc.rng.respCache.ClearData(); err != nil {
+		t.Fatal(err)
+	}
+	if err := tc.rng.ImportLeaseTransfer(lt); err != nil {
+		t.Fatal(err)
+	}
+	replay, replayReply := incrementArgs([]byte("a"), 1, 1, tc.store.StoreID())
+	replay.CmdID = args.CmdID
+	replay.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Increment, replay, replayReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if replayReply.NewValue != 1 {
+		t.Errorf("expected replayed increment to return cached value 1; got %d", replayReply.NewValue)
+	}
+}
+
+// TestRangeRouteHintGossip verifies that the replica holding the
+// leader lease is gossiped as the range's route hint, and that the
+// hint is updated when the lease is transferred.
+func TestRangeRouteHintGossip(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	gossipKey := gossip.MakeRouteHintGossipKey(tc.rng.Desc().RaftID)
+	verifyHint := func(expHolder proto.Replica) {
+		info, err := tc.gossip.GetInfo(gossipKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hint := info.(proto.Replica); !reflect.DeepEqual(hint, expHolder) {
+			t.Errorf("expected route hint %+v; got %+v", expHolder, hint)
+		}
+		if holder := tc.rng.LeaseHolder(); !reflect.DeepEqual(holder, expHolder) {
+			t.Errorf("expected lease holder %+v; got %+v", expHolder, holder)
+		}
+	}
+	verifyHint(*tc.rng.GetReplica())
+
+	// Transfer the lease to a replica on another store.
+	newHolder := proto.Replica{NodeID: 2, StoreID: 2}
+	lt, err := tc.rng.ExportLeaseTransfer(tc.clock.Now(), newHolder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tc.rng.CompleteLeaseTransfer()
+	if err := tc.rng.ImportLeaseTransfer(lt); err != nil {
+		t.Fatal(err)
+	}
+	verifyHint(newHolder)
+}
+
+// TestEndTransactionRestarts verifies that the restart count of a
+// transaction which bumped its epoch is returned on commit.
+func TestEndTransactionRestarts(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("a")
+	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	for i := 0; i < 2; i++ {
+		txn.Restart(1, 0, tc.clock.Now())
+	}
+	if txn.Restarts != 2 || txn.Epoch != 2 {
+		t.Fatalf("expected 2 restarts at epoch 2; got %d at epoch %d", txn.Restarts, txn.Epoch)
+	}
+
+	args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	args.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Restarts != 2 {
+		t.Errorf("expected restart count of 2; got %d", reply.Restarts)
+	}
+	if reply.Txn.Restarts != 2 {
+		t.Errorf("expected transaction record restart count of 2; got %d", reply.Txn.Restarts)
+	}
+}
+
+// TestEndTransactionBeforeHeartbeat verifies that a transaction
+// can be committed/aborted before being heartbeat.
+func TestEndTransactionBeforeHeartbeat(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("a")
+	for _, commit := range []bool{true, false} {
+		txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+		args, reply := endTxnArgs(txn, commit, 1, tc.store.StoreID())
+		args.Timestamp = txn.Timestamp
+		if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
+			t.Error(err)
+		}
+		expStatus := proto.COMMITTED
+		if !commit {
+			expStatus = proto.ABORTED
+		}
+		if reply.Txn.Status != expStatus {
+			t.Errorf("expected transaction status to be %s; got %s", expStatus, reply.Txn.Status)
+		}
+
+		// Try a heartbeat to the already-committed transaction; should get
+		// committed txn back, but without last heartbeat timestamp set.
+		hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
+		if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
+			t.Error(err)
+		}
+		if hbReply.Txn.Status != expStatus || hbReply.Txn.LastHeartbeat != nil {
+			t.Errorf("unexpected heartbeat reply contents: %+v", hbReply)
+		}
+	}
+}
+
+// TestInternalBeginTransaction verifies that InternalBeginTransaction
+// writes a PENDING transaction record with the isolation, priority and
+// deadline of the supplied transaction, and that a second attempt to
+// begin the same transaction fails.
+func TestInternalBeginTransaction(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("a")
+	txn := newTransaction("test", key, 10, proto.SNAPSHOT, tc.clock)
+	deadline := txn.Timestamp
+	deadline.WallTime += 100
+	txn.Deadline = &deadline
+
+	args, reply := beginTxnArgs(txn, 1, tc.store.StoreID())
+	args.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(proto.InternalBeginTransaction, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	var rec proto.Transaction
+	txnKey := engine.TransactionKey(txn.Key, txn.ID)
+	ok, err := engine.MVCCGetProto(tc.engine, txnKey, proto.ZeroTimestamp, nil, &rec)
+	if err != nil || !ok {
+		t.Fatalf("expected transaction record; got %t, %v", ok, err)
+	}
+	if rec.Status != proto.PENDING || rec.Isolation != proto.SNAPSHOT || rec.Priority != txn.Priority {
+		t.Errorf("unexpected transaction record: %s", rec)
+	}
+	if rec.Deadline == nil || !rec.Deadline.Equal(deadline) {
+		t.Errorf("expected deadline %s; got %v", deadline, rec.Deadline)
+	}
+	if rec.LastHeartbeat == nil || !rec.LastHeartbeat.Equal(txn.Timestamp) {
+		t.Errorf("expected last heartbeat %s; got %v", txn.Timestamp, rec.LastHeartbeat)
+	}
+
+	// A duplicate begin must fail.
+	args, reply = beginTxnArgs(txn, 1, tc.store.StoreID())
+	args.Timestamp = txn.Timestamp
+	err = tc.rng.AddCmd(proto.InternalBeginTransaction, args, reply, true)
+	if _, ok := err.(*proto.TransactionStatusError); !ok {
+		t.Errorf("expected transaction status error on duplicate begin; got %v", err)
+	}
+}
+
+// TestInternalScanIntents verifies that InternalScanIntents returns
+// the intents within the span grouped by owning transaction.
+func TestInternalScanIntents(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	txn1 := newTransaction("txn1", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	txn2 := newTransaction("txn2", proto.Key("b"), 1, proto.SERIALIZABLE, tc.clock)
+	writes := []struct {
+		key string
+		txn *proto.Transaction
 	}{
-		// Read/read doesn't wait.
-		{true, true, false},
-		// All other combinations must wait.
-		{true, false, true},
-		{false, true, true},
-		{false, false, true},
+		{"a", txn1},
+		{"b", txn2},
+		{"c", nil},
+		{"d", txn1},
+		{"e", txn2},
+	}
+	for _, w := range writes {
+		pArgs, pReply := putArgs([]byte(w.key), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Txn = w.txn
+		if w.txn != nil {
+			pArgs.Timestamp = w.txn.Timestamp
+		}
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	args := &proto.InternalScanIntentsRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			EndKey:    proto.Key("z"),
+			Timestamp: tc.clock.Now(),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		},
+	}
+	reply := &proto.InternalScanIntentsResponse{}
+	if err := tc.rng.AddCmd(proto.InternalScanIntents, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]proto.Key{
+		string(txn1.ID): {proto.Key("a"), proto.Key("d")},
+		string(txn2.ID): {proto.Key("b"), proto.Key("e")},
+	}
+	if len(reply.TxnIntents) != len(expected) {
+		t.Fatalf("expected intents for %d txns; got %+v", len(expected), reply.TxnIntents)
+	}
+	for _, ti := range reply.TxnIntents {
+		if keys := expected[string(ti.Txn.ID)]; !reflect.DeepEqual(ti.Keys, keys) {
+			t.Errorf("expected txn %s to own intents %q; got %q", ti.Txn.Name, keys, ti.Keys)
+		}
+	}
+}
+
+// A recordingEngine counts invocations of SyncWriteBatch() and
+// Flush(), failing synced writes with syncErr if set.
+type recordingEngine struct {
+	*engine.InMem
+	syncs   int32
+	flushes int32
+	syncErr error
+}
+
+func (re *recordingEngine) SyncWriteBatch(cmds []interface{}) error {
+	atomic.AddInt32(&re.syncs, 1)
+	if re.syncErr != nil {
+		return re.syncErr
+	}
+	return re.InMem.SyncWriteBatch(cmds)
+}
+
+func (re *recordingEngine) Flush() error {
+	atomic.AddInt32(&re.flushes, 1)
+	return re.InMem.Flush()
+}
+
+func (re *recordingEngine) NewBatch() engine.Engine {
+	return engine.NewBatch(re)
+}
+
+// TestEndTransactionSyncsOnCommit verifies that the batch of a
+// committing transaction is synced to the write-ahead log, but not
+// that of an abort, and that neither flushes the engine.
+func TestEndTransactionSyncsOnCommit(t *testing.T) {
+	re := &recordingEngine{InMem: engine.NewInMem(proto.Attributes{}, 1<<20)}
+	tc := testContext{
+		engine: re,
+	}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for i, commit := range []bool{false, true} {
+		txn := newTransaction(fmt.Sprintf("test-%d", i), proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+		args, reply := endTxnArgs(txn, commit, 1, tc.store.StoreID())
+		args.Timestamp = txn.Timestamp
+		syncs, flushes := atomic.LoadInt32(&re.syncs), atomic.LoadInt32(&re.flushes)
+		if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
+			t.Fatal(err)
+		}
+		expSyncs := syncs
+		if commit {
+			expSyncs++
+		}
+		if syncs := atomic.LoadInt32(&re.syncs); syncs != expSyncs {
+			t.Errorf("commit=%t: expected %d synced writes; got %d", commit, expSyncs, syncs)
+		}
+		if f := atomic.LoadInt32(&re.flushes); f != flushes {
+			t.Errorf("commit=%t: expected no flush; got %d", commit, f-flushes)
+		}
 	}
+}
+
+// TestEndTransactionDurable verifies that the reply to a durable commit
+// is returned only after the commit has been synced to the write-ahead
+// log, and that a failure to sync fails the commit without writing
+// the transaction record.
+func TestEndTransactionDurable(t *testing.T) {
+	re := &recordingEngine{InMem: engine.NewInMem(proto.Attributes{}, 1<<20)}
+	tc := testContext{
+		engine: re,
+	}
+	tc.Start(t)
+	defer tc.Stop()
 
+	testCases := []struct {
+		syncErr error
+		expErr  bool
+	}{
+		{nil, false},
+		{util.Errorf("injected sync error"), true},
+	}
 	for i, test := range testCases {
-		key1 := proto.Key(fmt.Sprintf("key1-%d", i))
-		key2 := proto.Key(fmt.Sprintf("key2-%d", i))
-		// Asynchronously put a value to the rng with blocking enabled.
-		be.block(key1)
-		cmd1Done := make(chan struct{})
-		go func() {
-			method, args, reply := readOrWriteArgs(key1, test.cmd1Read, tc.rng.Desc().RaftID,
-				tc.store.StoreID())
-			err := tc.rng.AddCmd(method, args, reply, true)
-			if err != nil {
-				t.Fatalf("test %d: %s", i, err)
+		re.syncErr = test.syncErr
+		txn := newTransaction(fmt.Sprintf("test-%d", i), proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+		args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+		args.Timestamp = txn.Timestamp
+		args.Durable = true
+		before := atomic.LoadInt32(&re.syncs)
+		err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true)
+		if syncs := atomic.LoadInt32(&re.syncs); syncs != before+1 {
+			t.Errorf("%d: expected a synced write before the reply; got %d", i, syncs-before)
+		}
+		if test.expErr != (err != nil) {
+			t.Errorf("%d: expected error %t; got %v", i, test.expErr, err)
+		}
+		var rec proto.Transaction
+		txnKey := engine.TransactionKey(txn.Key, txn.ID)
+		ok, err := engine.MVCCGetProto(tc.engine, txnKey, proto.ZeroTimestamp, nil, &rec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok == test.expErr {
+			t.Errorf("%d: expected transaction record %t; got %t", i, !test.expErr, ok)
+		}
+	}
+	re.syncErr = nil
+}
+
+// TestEndTransactionAfterHeartbeat verifies that a transaction
+// can be committed/aborted after being heartbeat.
+func TestEndTransactionAfterHeartbeat(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("a")
+	for _, commit := range []bool{true, false} {
+		txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+
+		// Start out with a heartbeat to the transaction.
+		hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
+		hbArgs.Timestamp = txn.Timestamp
+		if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
+			t.Error(err)
+		}
+		if hbReply.Txn.Status != proto.PENDING || hbReply.Txn.LastHeartbeat == nil {
+			t.Errorf("unexpected heartbeat reply contents: %+v", hbReply)
+		}
+
+		args, reply := endTxnArgs(txn, commit, 1, tc.store.StoreID())
+		args.Timestamp = txn.Timestamp
+		if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
+			t.Error(err)
+		}
+		expStatus := proto.COMMITTED
+		if !commit {
+			expStatus = proto.ABORTED
+		}
+		if reply.Txn.Status != expStatus {
+			t.Errorf("expected transaction status to be %s; got %s", expStatus, reply.Txn.Status)
+		}
+		if reply.Txn.LastHeartbeat == nil || !reply.Txn.LastHeartbeat.Equal(*hbReply.Txn.LastHeartbeat) {
+			t.Errorf("expected heartbeats to remain equal: %+v != %+v",
+				reply.Txn.LastHeartbeat, hbReply.Txn.LastHeartbeat)
+		}
+	}
+}
+
+// putIfAbsentArgs returns an InternalPutIfAbsentRequest and response
+// pair addressed to the default replica for the specified key and value.
+func putIfAbsentArgs(key, value []byte, raftID int64, storeID proto.StoreID) (*proto.InternalPutIfAbsentRequest, *proto.InternalPutIfAbsentResponse) {
+	args := &proto.InternalPutIfAbsentRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     key,
+			RaftID:  raftID,
+			Replica: proto.Replica{StoreID: storeID},
+		},
+		Value: proto.Value{Bytes: value},
+	}
+	reply := &proto.InternalPutIfAbsentResponse{}
+	return args, reply
+}
+
+// TestRangePutIfAbsent verifies that InternalPutIfAbsent inserts a
+// value only for an absent key, returns the resulting value either
+// way and is idempotent on replay.
+func TestRangePutIfAbsent(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("a")
+	args, reply := putIfAbsentArgs(key, []byte("value1"), 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	args.CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
+	if err := tc.rng.AddCmd(proto.InternalPutIfAbsent, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Inserted || reply.Value == nil || !bytes.Equal(reply.Value.Bytes, []byte("value1")) {
+		t.Fatalf("expected value1 to be inserted; got %+v", reply)
+	}
+
+	// A replay of the same command returns the original response.
+	reply = &proto.InternalPutIfAbsentResponse{}
+	if err := tc.rng.AddCmd(proto.InternalPutIfAbsent, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !reply.Inserted || reply.Value == nil || !bytes.Equal(reply.Value.Bytes, []byte("value1")) {
+		t.Fatalf("expected replay to report value1 inserted; got %+v", reply)
+	}
+
+	// A new command for the present key returns the existing value.
+	args, reply = putIfAbsentArgs(key, []byte("value2"), 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.InternalPutIfAbsent, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Inserted || reply.Value == nil || !bytes.Equal(reply.Value.Bytes, []byte("value1")) {
+		t.Fatalf("expected existing value1 without insertion; got %+v", reply)
+	}
+
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value1")) {
+		t.Errorf("expected value1; got %+v", gReply.Value)
+	}
+}
+
+// TestRangeGetTransaction verifies that InternalGetTransaction returns
+// the stored record, including the last heartbeat timestamp, and
+// indicates when no record exists.
+func TestRangeGetTransaction(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("a")
+	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	args := &proto.InternalGetTransactionRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       key,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Timestamp: tc.clock.Now(),
+		},
+		TxnID: txn.ID,
+	}
+
+	// Before the heartbeat, there's no record.
+	reply := &proto.InternalGetTransactionResponse{}
+	if err := tc.rng.AddCmd(proto.InternalGetTransaction, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Txn != nil {
+		t.Fatalf("expected no transaction record; got %+v", reply.Txn)
+	}
+
+	hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
+	hbArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	args.Timestamp = tc.clock.Now()
+	reply = &proto.InternalGetTransactionResponse{}
+	if err := tc.rng.AddCmd(proto.InternalGetTransaction, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Txn == nil || reply.Txn.Status != proto.PENDING || !bytes.Equal(reply.Txn.ID, txn.ID) {
+		t.Fatalf("expected PENDING record for %s; got %+v", txn, reply.Txn)
+	}
+	if reply.Txn.LastHeartbeat == nil || !reply.Txn.LastHeartbeat.Equal(hbArgs.Timestamp) {
+		t.Errorf("expected last heartbeat %s; got %+v", hbArgs.Timestamp, reply.Txn.LastHeartbeat)
+	}
+}
+
+// TestEndTransactionWithPushedTimestamp verifies that txn can be
+// ended (both commit or abort) correctly when the commit timestamp is
+// greater than the transaction timestamp, depending on the isolation
+// level.
+func TestEndTransactionWithPushedTimestamp(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	testCases := []struct {
+		commit    bool
+		isolation proto.IsolationType
+		expErr    bool
+	}{
+		{true, proto.SERIALIZABLE, true},
+		{true, proto.SNAPSHOT, false},
+		{false, proto.SERIALIZABLE, false},
+		{false, proto.SNAPSHOT, false},
+	}
+	key := []byte("a")
+	for _, test := range testCases {
+		txn := newTransaction("test", key, 1, test.isolation, tc.clock)
+		// End the transaction with args timestamp moved forward in time.
+		args, reply := endTxnArgs(txn, test.commit, 1, tc.store.StoreID())
+		tc.manualClock.Set(1)
+		args.Timestamp = tc.clock.Now()
+		err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true)
+		if test.expErr {
+			if err == nil {
+				t.Errorf("expected error")
 			}
-			close(cmd1Done)
-		}()
-
-		// First, try a command for same key as cmd1 to verify it blocks.
-		cmd2Done := make(chan struct{})
-		go func() {
-			method, args, reply := readOrWriteArgs(key1, test.cmd2Read, tc.rng.Desc().RaftID,
-				tc.store.StoreID())
-			err := tc.rng.AddCmd(method, args, reply, true)
-			if err != nil {
-				t.Fatalf("test %d: %s", i, err)
+			if _, ok := err.(*proto.TransactionRetryError); !ok {
+				t.Errorf("expected retry error; got %s", err)
 			}
-			close(cmd2Done)
-		}()
-
-		// Next, try read for a non-impacted key--should go through immediately.
-		cmd3Done := make(chan struct{})
-		go func() {
-			method, args, reply := readOrWriteArgs(key2, true, tc.rng.Desc().RaftID, tc.store.StoreID())
-			err := tc.rng.AddCmd(method, args, reply, true)
+		} else {
 			if err != nil {
-				t.Fatalf("test %d: %s", i, err)
+				t.Errorf("unexpected error: %s", err)
 			}
-			close(cmd3Done)
-		}()
-
-		if test.expWait {
-			// Verify cmd3 finishes but not cmd2.
-			select {
-			case <-cmd2Done:
-				t.Fatalf("test %d: should not have been able to execute cmd2", i)
-			case <-cmd3Done:
-				// success.
-			case <-cmd1Done:
-				t.Fatalf("test %d: should not have been able execute cmd1 while blocked", i)
-			case <-time.After(500 * time.Millisecond):
-				t.Fatalf("test %d: waited 500ms for cmd3 of key2", i)
+			expStatus := proto.COMMITTED
+			if !test.commit {
+				expStatus = proto.ABORTED
 			}
-		} else {
-			select {
-			case <-cmd2Done:
-				// success.
-			case <-cmd1Done:
-				t.Fatalf("test %d: should not have been able to execute cmd1 while blocked", i)
-			case <-time.After(500 * time.Millisecond):
-				t.Fatalf("test %d: waited 500ms for cmd2 of key1", i)
+			if reply.Txn.Status != expStatus {
+				t.Errorf("expected transaction status to be %s; got %s", expStatus, reply.Txn.Status)
 			}
-			<-cmd3Done
-		}
-
-		be.unblock()
-		select {
-		case <-cmd2Done:
-			// success.
-		case <-time.After(500 * time.Millisecond):
-			t.Fatalf("test %d: waited 500ms for cmd2 of key1", i)
 		}
 	}
 }
 
-// TestRangeUseTSCache verifies that write timestamps are upgraded
-// based on the read timestamp cache.
-func TestRangeUseTSCache(t *testing.T) {
+// TestEndTransactionCommitTimestamp verifies that a transaction
+// committed at a pushed timestamp returns that timestamp as its
+// commit timestamp.
+func TestEndTransactionCommitTimestamp(t *testing.T) {
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
-	// Set clock to time 1s and do the read.
-	t0 := 1 * time.Second
-	tc.manualClock.Set(t0.Nanoseconds())
-	args, reply := getArgs([]byte("a"), 1, tc.store.StoreID())
+
+	txn := newTransaction("test", []byte("a"), 1, proto.SNAPSHOT, tc.clock)
+	args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	tc.manualClock.Set(1)
 	args.Timestamp = tc.clock.Now()
-	err := tc.rng.AddCmd(proto.Get, args, reply, true)
-	if err != nil {
-		t.Error(err)
+	if !txn.Timestamp.Less(args.Timestamp) {
+		t.Fatalf("expected commit timestamp %s to be pushed past %s", args.Timestamp, txn.Timestamp)
 	}
-	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
-	err = tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
-	if err != nil {
+	if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
 		t.Fatal(err)
 	}
-	if pReply.Timestamp.WallTime != tc.clock.Timestamp().WallTime {
-		t.Errorf("expected write timestamp to upgrade to 1s; got %+v", pReply.Timestamp)
+	if !reply.CommitTimestamp.Equal(args.Timestamp) {
+		t.Errorf("expected commit timestamp %s; got %s", args.Timestamp, reply.CommitTimestamp)
+	}
+	if !reply.CommitTimestamp.Equal(reply.Txn.Timestamp) {
+		t.Errorf("expected commit timestamp to match transaction timestamp %s; got %s", reply.Txn.Timestamp, reply.CommitTimestamp)
 	}
 }
 
-// TestRangeNoTSCacheUpdateOnFailure verifies that read and write
-// commands do not update the timestamp cache if they result in
-// failure.
-func TestRangeNoTSCacheUpdateOnFailure(t *testing.T) {
+// TestEndTransactionRefreshReads verifies that a transaction pushed
+// past its original timestamp commits at the pushed timestamp if the
+// spans it read are unchanged at that timestamp, and fails with a retry
+// error otherwise, for both isolation levels.
+func TestEndTransactionRefreshReads(t *testing.T) {
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
 
-	// Test for both read & write attempts.
-	for i, read := range []bool{true, false} {
-		key := proto.Key(fmt.Sprintf("key-%d", i))
+	for i, iso := range []proto.IsolationType{proto.SNAPSHOT, proto.SERIALIZABLE} {
+		for _, changed := range []bool{false, true} {
+			key := proto.Key(fmt.Sprintf("key-%d-%t", i, changed))
+			pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+			pArgs.Timestamp = tc.clock.Now()
+			if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+				t.Fatal(err)
+			}
 
-		// Start by laying down an intent to trip up future read or write to same key.
-		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
-		pArgs.Txn = newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
-		pArgs.Timestamp = pArgs.Txn.Timestamp
-		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
-			t.Fatalf("test %d: %s", i, err)
+			txn := newTransaction("test", key, 1, iso, tc.clock)
+			tc.manualClock.Increment(1)
+			if changed {
+				// Write the read key after the txn's original timestamp.
+				pArgs, pReply = putArgs(key, []byte("value2"), 1, tc.store.StoreID())
+				pArgs.Timestamp = tc.clock.Now()
+				if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+					t.Fatal(err)
+				}
+				tc.manualClock.Increment(1)
+			}
+
+			// Commit with the timestamp pushed forward.
+			args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+			args.Timestamp = tc.clock.Now()
+			args.RefreshSpans = []proto.KeySpan{{Key: key}}
+			err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true)
+			if changed {
+				if _, ok := err.(*proto.TransactionRetryError); !ok {
+					t.Errorf("%s: expected retry error for changed read; got %v", iso, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", iso, err)
+			}
+			if reply.Txn.Status != proto.COMMITTED {
+				t.Errorf("%s: expected committed transaction; got %s", iso, reply.Txn.Status)
+			}
+			if !reply.CommitTimestamp.Equal(args.Timestamp) {
+				t.Errorf("%s: expected commit timestamp %s; got %s", iso, args.Timestamp, reply.CommitTimestamp)
+			}
 		}
+	}
+}
 
-		// Now attempt read or write.
-		method, args, reply := readOrWriteArgs(key, read, tc.rng.Desc().RaftID, tc.store.StoreID())
-		args.Header().Timestamp = tc.clock.Now() // later timestamp
-		if err := tc.rng.AddCmd(method, args, reply, true); err == nil {
-			t.Errorf("test %d: expected failure", i)
+// TestEndTransactionRefreshReadsTimestampCache verifies that the spans
+// refreshed by a commit are recorded in the timestamp cache at the
+// commit timestamp, so that a later write to them is ordered after
+// the commit, and that a commit whose transaction record was pushed
+// beyond the request's timestamp can't refresh its reads.
+func TestEndTransactionRefreshReadsTimestampCache(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	for i, pushRecord := range []bool{false, true} {
+		key := proto.Key(fmt.Sprintf("key-%d", i))
+		txn := newTransaction("test", key, 1, proto.SNAPSHOT, tc.clock)
+		tc.manualClock.Increment(10)
+		args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+		args.Timestamp = tc.clock.Now()
+		args.RefreshSpans = []proto.KeySpan{{Key: key}}
+		if pushRecord {
+			// Persist a transaction record pushed past the commit.
+			pushed := *txn
+			pushed.Timestamp = args.Timestamp.Add(1, 0)
+			if err := engine.MVCCPutProto(tc.engine, nil, engine.TransactionKey(txn.Key, txn.ID), proto.ZeroTimestamp, nil, &pushed); err != nil {
+				t.Fatal(err)
+			}
+		}
+		err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true)
+		if pushRecord {
+			if _, ok := err.(*proto.TransactionRetryError); !ok {
+				t.Errorf("expected retry error for commit pushed beyond its timestamp; got %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
 		}
 
-		// Write the intent again -- should not have its timestamp upgraded!
+		// A write to the refreshed key at the original timestamp is
+		// pushed above the commit timestamp.
+		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = txn.OrigTimestamp
 		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
-			t.Fatalf("test %d: %s", i, err)
+			t.Fatal(err)
 		}
-		if !pReply.Timestamp.Equal(pArgs.Timestamp) {
-			t.Errorf("expected timestamp not to advance %s != %s", pReply.Timestamp, pArgs.Timestamp)
+		if !reply.CommitTimestamp.Less(pReply.Timestamp) {
+			t.Errorf("expected write timestamp to be pushed above %s; got %s", reply.CommitTimestamp, pReply.Timestamp)
 		}
 	}
 }
 
-// TestRangeNoTimestampIncrementWithinTxn verifies that successive
-// read the write commands within the same transaction do not cause
-// the write to receive an incremented timestamp.
-func TestRangeNoTimestampIncrementWithinTxn(t *testing.T) {
+// TestEndTransactionRefreshReadsInline verifies that refreshing a
+// span holding an inline value fails with an error rather than
+// comparing the value's missing timestamp.
+func TestEndTransactionRefreshReadsInline(t *testing.T) {
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
 
-	// Test for both read & write attempts.
 	key := proto.Key("a")
-	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
-
-	// Start with a read to warm the timestamp cache.
-	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
-	gArgs.Txn = txn
-	gArgs.Timestamp = txn.Timestamp
-	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
-		t.Fatal(err)
-	}
-
-	// Now try a write and verify timestamp isn't incremented.
-	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
-	pArgs.Txn = txn
-	pArgs.Timestamp = pArgs.Txn.Timestamp
-	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+	if err := engine.MVCCPut(tc.engine, nil, key, proto.ZeroTimestamp, proto.Value{Bytes: []byte("inline")}, nil); err != nil {
 		t.Fatal(err)
 	}
-	if !pReply.Timestamp.Equal(pArgs.Timestamp) {
-		t.Errorf("expected timestamp to remain %s; got %s", pArgs.Timestamp, pReply.Timestamp)
-	}
-
-	// Finally, try a non-transactional write and verify timestamp is incremented.
-	pArgs.Txn = nil
-	expTS := pArgs.Timestamp
-	expTS.Logical++
-	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err == nil {
-		t.Errorf("expected write intent error")
-	}
-	if !pReply.Timestamp.Equal(expTS) {
-		t.Errorf("expected timestamp to increment to %s; got %s", expTS, pReply.Timestamp)
-	}
+	txn := newTransaction("test", key, 1, proto.SNAPSHOT, tc.clock)
+	tc.manualClock.Increment(10)
+	args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	args.Timestamp = tc.clock.Now()
+	args.RefreshSpans = []proto.KeySpan{{Key: key}}
+	err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true)
+	verifyErrorMatches(err, "cannot refresh read of inline value", t)
 }
 
-// TestRangeIdempotence verifies that a retry increment with
-// same client command ID receives same reply.
-func TestRangeIdempotence(t *testing.T) {
+// TestInternalGCTxnRecords verifies that InternalGC deletes finalized
+// transaction records no newer than the threshold, and refuses to
+// delete pending ones.
+func TestInternalGCTxnRecords(t *testing.T) {
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
 
-	// Run the same increment 100 times, 50 with identical command ID,
-	// interleaved with 50 using a sequence of different command IDs.
-	goldenArgs, _ := incrementArgs([]byte("a"), 1, 1, tc.store.StoreID())
-	incDones := make([]chan struct{}, 100)
-	var count int64
-	for i := range incDones {
-		incDones[i] = make(chan struct{})
-		idx := i
-		go func() {
-			var args proto.IncrementRequest
-			var reply proto.IncrementResponse
-			args = *goldenArgs
-			args.Header().Timestamp = tc.clock.Now()
-			if idx%2 == 0 {
-				args.CmdID = proto.ClientCmdID{WallTime: 1, Random: 1}
-			} else {
-				args.CmdID = proto.ClientCmdID{WallTime: 1, Random: int64(idx + 100)}
-			}
-			err := tc.rng.AddCmd(proto.Increment, &args, &reply, true)
-			if err != nil {
-				t.Fatal(err)
-			}
-			if idx%2 == 0 && reply.NewValue != 1 {
-				t.Errorf("expected all incremented values to be 1; got %d", reply.NewValue)
-			} else if idx%2 == 1 {
-				atomic.AddInt64(&count, reply.NewValue)
-			}
-			close(incDones[idx])
-		}()
-	}
-	// Wait for all to complete.
-	for _, done := range incDones {
-		select {
-		case <-done:
-			// Success.
-		case <-time.After(2000 * time.Millisecond):
-			t.Fatal("had to wait for increment to complete")
+	gc := func(keys []proto.Key) error {
+		gcArgs := &proto.InternalGCRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       engine.KeyMin,
+				EndKey:    engine.KeyMax,
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+				Timestamp: tc.clock.Now(),
+			},
+			TxnKeys:      keys,
+			TxnThreshold: tc.clock.Now(),
 		}
+		return tc.rng.AddCmd(proto.InternalGC, gcArgs, &proto.InternalGCResponse{}, true)
 	}
-	// Verify that all non-repeated client commands incremented the
-	// counter starting at 2 all the way to 51 (sum of sequence = 1325).
-	if count != 1325 {
-		t.Errorf("expected sum of all increments to be 1325; got %d", count)
-	}
-}
-
-// TestEndTransactionBeforeHeartbeat verifies that a transaction
-// can be committed/aborted before being heartbeat.
-func TestEndTransactionBeforeHeartbeat(t *testing.T) {
-	tc := testContext{}
-	tc.Start(t)
-	defer tc.Stop()
 
-	key := []byte("a")
-	for _, commit := range []bool{true, false} {
-		txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
-		args, reply := endTxnArgs(txn, commit, 1, tc.store.StoreID())
+	// Finalize several transactions, alternately committing and aborting.
+	var keys []proto.Key
+	for i := 0; i < 4; i++ {
+		txn := newTransaction("test", proto.Key(fmt.Sprintf("key-%d", i)), 1, proto.SERIALIZABLE, tc.clock)
+		args, reply := endTxnArgs(txn, i%2 == 0, 1, tc.store.StoreID())
 		args.Timestamp = txn.Timestamp
 		if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
-			t.Error(err)
-		}
-		expStatus := proto.COMMITTED
-		if !commit {
-			expStatus = proto.ABORTED
+			t.Fatal(err)
 		}
-		if reply.Txn.Status != expStatus {
-			t.Errorf("expected transaction status to be %s; got %s", expStatus, reply.Txn.Status)
+		keys = append(keys, engine.TransactionKey(txn.Key, txn.ID))
+	}
+	tc.manualClock.Increment(1)
+	if err := gc(keys); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range keys {
+		if ok, err := engine.MVCCGetProto(tc.engine, key, proto.ZeroTimestamp, nil, &proto.Transaction{}); err != nil || ok {
+			t.Errorf("expected transaction record %q to be deleted; got %t, %v", key, ok, err)
 		}
+	}
 
-		// Try a heartbeat to the already-committed transaction; should get
-		// committed txn back, but without last heartbeat timestamp set.
-		hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
-		if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
-			t.Error(err)
-		}
-		if hbReply.Txn.Status != expStatus || hbReply.Txn.LastHeartbeat != nil {
-			t.Errorf("unexpected heartbeat reply contents: %+v", hbReply)
-		}
+	// A pending transaction's record isn't deleted.
+	txn := newTransaction("test", proto.Key("pending"), 1, proto.SERIALIZABLE, tc.clock)
+	hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
+	hbArgs.Timestamp = txn.Timestamp
+	if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
+		t.Fatal(err)
+	}
+	key := engine.TransactionKey(txn.Key, txn.ID)
+	tc.manualClock.Increment(1)
+	if err := gc([]proto.Key{key}); err == nil {
+		t.Error("expected error garbage collecting pending transaction record")
+	}
+	if ok, err := engine.MVCCGetProto(tc.engine, key, proto.ZeroTimestamp, nil, &proto.Transaction{}); err != nil || !ok {
+		t.Errorf("expected pending transaction record to remain; got %t, %v", ok, err)
 	}
 }
 
-// TestEndTransactionAfterHeartbeat verifies that a transaction
-// can be committed/aborted after being heartbeat.
-func TestEndTransactionAfterHeartbeat(t *testing.T) {
+// TestEndTransactionResolvesIntents verifies that the intents listed
+// on a committed EndTransaction are resolved asynchronously, that
+// failed resolutions are retried and that listing a key without an
+// intent is harmless.
+func TestEndTransactionResolvesIntents(t *testing.T) {
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
 
-	key := []byte("a")
-	for _, commit := range []bool{true, false} {
-		txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
-
-		// Start out with a heartbeat to the transaction.
-		hbArgs, hbReply := heartbeatArgs(txn, 1, tc.store.StoreID())
-		hbArgs.Timestamp = txn.Timestamp
-		if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
-			t.Error(err)
-		}
-		if hbReply.Txn.Status != proto.PENDING || hbReply.Txn.LastHeartbeat == nil {
-			t.Errorf("unexpected heartbeat reply contents: %+v", hbReply)
+	// Fail the first resolution of "b".
+	var failures int32
+	defer func() { TestingCommandFilter = nil }()
+	TestingCommandFilter = func(method string, args proto.Request, reply proto.Response) bool {
+		if method == proto.InternalResolveIntent && args.Header().Key.Equal(proto.Key("b")) &&
+			atomic.AddInt32(&failures, 1) == 1 {
+			reply.Header().SetGoError(util.Errorf("injected failure"))
+			return true
 		}
+		return false
+	}
 
-		args, reply := endTxnArgs(txn, commit, 1, tc.store.StoreID())
-		args.Timestamp = txn.Timestamp
-		if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
-			t.Error(err)
-		}
-		expStatus := proto.COMMITTED
-		if !commit {
-			expStatus = proto.ABORTED
-		}
-		if reply.Txn.Status != expStatus {
-			t.Errorf("expected transaction status to be %s; got %s", expStatus, reply.Txn.Status)
+	keys := []proto.Key{proto.Key("a"), proto.Key("b")}
+	txn := newTransaction("test", keys[0], 1, proto.SERIALIZABLE, tc.clock)
+	for _, key := range keys {
+		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = txn.Timestamp
+		pArgs.Txn = txn
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
 		}
-		if reply.Txn.LastHeartbeat == nil || !reply.Txn.LastHeartbeat.Equal(*hbReply.Txn.LastHeartbeat) {
-			t.Errorf("expected heartbeats to remain equal: %+v != %+v",
-				reply.Txn.LastHeartbeat, hbReply.Txn.LastHeartbeat)
+	}
+
+	args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	args.Timestamp = txn.Timestamp
+	args.Intents = append(keys, proto.Key("c"))
+	if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := util.IsTrueWithin(func() bool {
+		for _, key := range keys {
+			value, err := engine.MVCCGet(tc.engine, key, tc.clock.Now(), nil)
+			if err != nil || value == nil || !bytes.Equal(value.Bytes, []byte("value")) {
+				return false
+			}
 		}
+		return true
+	}, 500*time.Millisecond); err != nil {
+		t.Fatalf("intents were not resolved: %s", err)
+	}
+	if f := atomic.LoadInt32(&failures); f < 2 {
+		t.Errorf("expected failed resolution to be retried; got %d attempt(s)", f)
 	}
 }
 
-// TestEndTransactionWithPushedTimestamp verifies that txn can be
-// ended (both commit or abort) correctly when the commit timestamp is
-// greater than the transaction timestamp, depending on the isolation
-// level.
-func TestEndTransactionWithPushedTimestamp(t *testing.T) {
+// TestEndTransactionResolveIntentsBounded verifies that the intents of
+// an ended transaction are resolved only while holding a slot of the
+// store's intent resolution semaphore.
+func TestEndTransactionResolveIntentsBounded(t *testing.T) {
 	tc := testContext{}
 	tc.Start(t)
 	defer tc.Stop()
+	tc.store.SetIntentResolutionConcurrency(1)
 
-	testCases := []struct {
-		commit    bool
-		isolation proto.IsolationType
-		expErr    bool
-	}{
-		{true, proto.SERIALIZABLE, true},
-		{true, proto.SNAPSHOT, false},
-		{false, proto.SERIALIZABLE, false},
-		{false, proto.SNAPSHOT, false},
+	key := proto.Key("a")
+	txn := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = txn.Timestamp
+	pArgs.Txn = txn
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
 	}
-	key := []byte("a")
-	for _, test := range testCases {
-		txn := newTransaction("test", key, 1, test.isolation, tc.clock)
-		// End the transaction with args timestamp moved forward in time.
-		args, reply := endTxnArgs(txn, test.commit, 1, tc.store.StoreID())
-		tc.manualClock.Set(1)
-		args.Timestamp = tc.clock.Now()
-		err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true)
-		if test.expErr {
-			if err == nil {
-				t.Errorf("expected error")
-			}
-			if _, ok := err.(*proto.TransactionRetryError); !ok {
-				t.Errorf("expected retry error; got %s", err)
-			}
-		} else {
-			if err != nil {
-				t.Errorf("unexpected error: %s", err)
-			}
-			expStatus := proto.COMMITTED
-			if !test.commit {
-				expStatus = proto.ABORTED
-			}
-			if reply.Txn.Status != expStatus {
-				t.Errorf("expected transaction status to be %s; got %s", expStatus, reply.Txn.Status)
-			}
-		}
+
+	// Hold the only slot, leaving the intent unresolved.
+	sem := tc.store.IntentResolutionSem()
+	sem <- struct{}{}
+	args, reply := endTxnArgs(txn, true, 1, tc.store.StoreID())
+	args.Timestamp = txn.Timestamp
+	args.Intents = []proto.Key{key}
+	if err := tc.rng.AddCmd(proto.EndTransaction, args, reply, true); err != nil {
+		t.Fatal(err)
+	}
+	resolved := func() bool {
+		value, err := engine.MVCCGet(tc.engine, key, tc.clock.Now(), nil)
+		return err == nil && value != nil
+	}
+	time.Sleep(50 * time.Millisecond)
+	if resolved() {
+		t.Fatal("expected intent to remain unresolved while the semaphore is full")
+	}
+
+	<-sem
+	if err := util.IsTrueWithin(resolved, 500*time.Millisecond); err != nil {
+		t.Fatalf("intent was not resolved: %s", err)
 	}
 }
 
@@ -1344,6 +4475,122 @@ func TestInternalPushTxnOldEpoch(t *testing.T) {
 	}
 }
 
+// TestInternalHeartbeatTxnEpochBump verifies that heartbeating a
+// transaction at an incremented epoch updates its record, after which
+// an intent written during the prior epoch may be pushed and resolved
+// by a lower priority transaction.
+func TestInternalHeartbeatTxnEpochBump(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	pusher := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	pushee := newTransaction("test", key, 1, proto.SERIALIZABLE, tc.clock)
+	pushee.Priority = 2
+	pusher.Priority = 1 // Pusher won't win based on priority.
+
+	// Write an intent and the transaction record at epoch 0.
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = pushee.Timestamp
+	pArgs.Txn = pushee
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	oldEpochTxn := *pushee
+	hbArgs, hbReply := heartbeatArgs(pushee, 1, tc.store.StoreID())
+	hbArgs.Timestamp = pushee.Timestamp
+	if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Bump the epoch, as the coordinator does on restart.
+	pushee.Epoch++
+	hbArgs, hbReply = heartbeatArgs(pushee, 1, tc.store.StoreID())
+	hbArgs.Timestamp = pushee.Timestamp
+	if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if hbReply.Txn.Epoch != 1 {
+		t.Fatalf("expected heartbeat to record epoch 1; got %d", hbReply.Txn.Epoch)
+	}
+
+	// The intent's epoch is now stale, so the push succeeds.
+	args, reply := pushTxnArgs(pusher, &oldEpochTxn, true, 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(proto.InternalPushTxn, args, reply, true); err != nil {
+		t.Fatalf("expected push of old epoch intent to succeed; got %s", err)
+	}
+	if reply.PusheeTxn.Status != proto.ABORTED {
+		t.Fatalf("expected pushee to be aborted; got %s", reply.PusheeTxn)
+	}
+
+	// Resolve the intent and verify the value is gone.
+	rArgs := &proto.InternalResolveIntentRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       key,
+			Timestamp: pushee.Timestamp,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Txn:       reply.PusheeTxn,
+		},
+	}
+	if err := tc.rng.AddCmd(proto.InternalResolveIntent, rArgs, &proto.InternalResolveIntentResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value != nil {
+		t.Errorf("expected old epoch intent to be resolved away; got %+v", gReply.Value)
+	}
+}
+
+// TestRangeTrackTxnIntents verifies that transactional writes report
+// the spans they write in the reply's transaction and that heartbeats
+// record the accumulated intents in the transaction record.
+func TestRangeTrackTxnIntents(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	txn := newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	var hbReply *proto.InternalHeartbeatTxnResponse
+	for i, key := range []proto.Key{proto.Key("a"), proto.Key("c"), proto.Key("a").Next()} {
+		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = txn.Timestamp
+		pArgs.Txn = gogoproto.Clone(txn).(*proto.Transaction)
+		pArgs.Txn.Intents = nil
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+		expSpans := []proto.KeySpan{{Key: key, EndKey: key.Next()}}
+		if pReply.Txn == nil || !reflect.DeepEqual(pReply.Txn.Intents, expSpans) {
+			t.Fatalf("%d: expected reply intents %+v; got %+v", i, expSpans, pReply.Txn)
+		}
+		// Accumulate the intents as the coordinator does and heartbeat.
+		for _, span := range pReply.Txn.Intents {
+			txn.AddIntent(span.Key, span.EndKey)
+		}
+		var hbArgs *proto.InternalHeartbeatTxnRequest
+		hbArgs, hbReply = heartbeatArgs(txn, 1, tc.store.StoreID())
+		hbArgs.Timestamp = txn.Timestamp
+		if err := tc.rng.AddCmd(proto.InternalHeartbeatTxn, hbArgs, hbReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The contiguous intents on "a" and its successor share a span.
+	expSpans := []proto.KeySpan{
+		{Key: proto.Key("a"), EndKey: proto.Key("a").Next().Next()},
+		{Key: proto.Key("c"), EndKey: proto.Key("c").Next()},
+	}
+	if !reflect.DeepEqual(hbReply.Txn.Intents, expSpans) {
+		t.Errorf("expected txn record intents %+v; got %+v", expSpans, hbReply.Txn.Intents)
+	}
+}
+
 // TestInternalPushTxnPriorities verifies that txns with lower
 // priority are pushed; if priorities are equal, then the txns
 // are ordered by txn timestamp, with the more recent timestamp
@@ -1396,13 +4643,70 @@ func TestInternalPushTxnPriorities(t *testing.T) {
 			t.Errorf("expected success on trial %d? %t; got err %s", i, test.expSuccess, err)
 		}
 		if err != nil {
-			if _, ok := err.(*proto.TransactionPushError); !ok {
+			if pErr, ok := err.(*proto.TransactionPushError); !ok {
 				t.Errorf("expected txn push error: %s", err)
+			} else if pErr.PusheePriority != test.pusheePriority {
+				t.Errorf("%d: expected push error to carry pushee priority %d; got %d",
+					i, test.pusheePriority, pErr.PusheePriority)
 			}
 		}
 	}
 }
 
+// TestInternalPushTxnPriorityRatchet verifies that each successful
+// push raises the pushee's persisted priority to one less than its
+// pusher's, so that a repeatedly pushed txn eventually prevails, and
+// that the pushee inherits the raised priority on restart.
+func TestInternalPushTxnPriorityRatchet(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	pushee := newTransaction("pushee", key, 1, proto.SERIALIZABLE, tc.clock)
+	pushee.Priority = 1
+
+	var priority int32
+	for i, pusherPriority := range []int32{5, 10, 20} {
+		tc.manualClock.Increment(1)
+		pusher := newTransaction("pusher", key, 1, proto.SERIALIZABLE, tc.clock)
+		pusher.Priority = pusherPriority
+		// The pusher presents the pushee as found in its intent, with
+		// the original priority.
+		args, reply := pushTxnArgs(pusher, pushee, false, 1, tc.store.StoreID())
+		if err := tc.rng.AddCmd(proto.InternalPushTxn, args, reply, true); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if expPri := pusherPriority - 1; reply.PusheeTxn.Priority != expPri {
+			t.Errorf("%d: expected pushee priority %d; got %d", i, expPri, reply.PusheeTxn.Priority)
+		}
+		if reply.PusheeTxn.Priority <= priority {
+			t.Errorf("%d: expected pushee priority to ratchet above %d; got %d", i, priority, reply.PusheeTxn.Priority)
+		}
+		priority = reply.PusheeTxn.Priority
+	}
+
+	// A pusher with priority lower than the pushee's raised priority
+	// fails, although it exceeds the pushee's original priority.
+	tc.manualClock.Increment(1)
+	pusher := newTransaction("pusher", key, 1, proto.SERIALIZABLE, tc.clock)
+	pusher.Priority = priority - 1
+	args, reply := pushTxnArgs(pusher, pushee, false, 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(proto.InternalPushTxn, args, reply, true); err == nil {
+		t.Error("expected push by lower priority txn to fail")
+	} else if pErr, ok := err.(*proto.TransactionPushError); !ok {
+		t.Errorf("expected txn push error; got %s", err)
+	} else if pErr.PusheePriority != priority {
+		t.Errorf("expected push error to carry pushee priority %d; got %d", priority, pErr.PusheePriority)
+	}
+
+	// On restart, the pushee inherits the priority of its record.
+	pushee.Restart(1, priority, tc.clock.Now())
+	if pushee.Priority < priority {
+		t.Errorf("expected restarted pushee priority of at least %d; got %d", priority, pushee.Priority)
+	}
+}
+
 // TestInternalPushTxnPushTimestamp verifies that with args.Abort is
 // false (i.e. for read/write conflict), the pushed txn keeps status
 // PENDING, but has its txn Timestamp moved forward to the pusher's
@@ -1498,7 +4802,7 @@ func TestRangeStatsComputation(t *testing.T) {
 	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
 		t.Fatal(err)
 	}
-	expMS := engine.MVCCStats{LiveBytes: 39, KeyBytes: 15, ValBytes: 24, IntentBytes: 0, LiveCount: 1, KeyCount: 1, ValCount: 1, IntentCount: 0}
+	expMS := engine.MVCCStats{LiveBytes: 44, KeyBytes: 15, ValBytes: 29, IntentBytes: 0, LiveCount: 1, KeyCount: 1, ValCount: 1, IntentCount: 0}
 	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
 
 	// Put a 2nd value transactionally.
@@ -1508,7 +4812,7 @@ func TestRangeStatsComputation(t *testing.T) {
 	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
 		t.Fatal(err)
 	}
-	expMS = engine.MVCCStats{LiveBytes: 116, KeyBytes: 30, ValBytes: 86, IntentBytes: 24, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 1}
+	expMS = engine.MVCCStats{LiveBytes: 126, KeyBytes: 30, ValBytes: 96, IntentBytes: 29, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 1}
 	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
 
 	// Resolve the 2nd value.
@@ -1526,7 +4830,7 @@ func TestRangeStatsComputation(t *testing.T) {
 	if err := tc.rng.AddCmd(proto.InternalResolveIntent, rArgs, rReply, true); err != nil {
 		t.Fatal(err)
 	}
-	expMS = engine.MVCCStats{LiveBytes: 78, KeyBytes: 30, ValBytes: 48, IntentBytes: 0, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 0}
+	expMS = engine.MVCCStats{LiveBytes: 88, KeyBytes: 30, ValBytes: 58, IntentBytes: 0, LiveCount: 2, KeyCount: 2, ValCount: 2, IntentCount: 0}
 	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
 
 	// Delete the 1st value.
@@ -1535,8 +4839,167 @@ func TestRangeStatsComputation(t *testing.T) {
 	if err := tc.rng.AddCmd(proto.Delete, dArgs, dReply, true); err != nil {
 		t.Fatal(err)
 	}
-	expMS = engine.MVCCStats{LiveBytes: 39, KeyBytes: 42, ValBytes: 50, IntentBytes: 0, LiveCount: 1, KeyCount: 2, ValCount: 3, IntentCount: 0}
-	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
+	expMS = engine.MVCCStats{LiveBytes: 44, KeyBytes: 42, ValBytes: 60, IntentBytes: 0, LiveCount: 1, KeyCount: 2, ValCount: 3, IntentCount: 0}
+	verifyRangeStats(tc.engine, tc.rng.Desc().RaftID, expMS, t)
+}
+
+// TestRangeStatsCached verifies that the range's cached stats match
+// the stats persisted in the engine after each of a series of commands.
+func TestRangeStatsCached(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	verifyCachedStats := func() {
+		var ms engine.MVCCStats
+		if err := engine.MVCCGetRangeStats(tc.engine, tc.rng.Desc().RaftID, &ms); err != nil {
+			t.Fatal(err)
+		}
+		if stats := tc.rng.Stats(); !reflect.DeepEqual(stats, ms) {
+			t.Errorf("expected cached stats %+v; got %+v", ms, stats)
+		}
+	}
+	verifyCachedStats()
+
+	addCmd := func(method string, args proto.Request, reply proto.Response) {
+		// Transactional commands carry the transaction's timestamp.
+		tc.manualClock.Increment(int64(time.Second))
+		if args.Header().Txn == nil {
+			args.Header().Timestamp = tc.clock.Now()
+		}
+		if err := tc.rng.AddCmd(method, args, reply, true); err != nil {
+			t.Fatalf("%s: %s", method, err)
+		}
+		verifyCachedStats()
+	}
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value1"), 1, tc.store.StoreID())
+	addCmd(proto.Put, pArgs, pReply)
+
+	// Write an intent, then commit it.
+	txn := newTransaction("test", proto.Key("b"), 1, proto.SERIALIZABLE, tc.clock)
+	pArgs, pReply = putArgs([]byte("b"), []byte("value2"), 1, tc.store.StoreID())
+	pArgs.Timestamp = txn.Timestamp
+	pArgs.Txn = txn
+	addCmd(proto.Put, pArgs, pReply)
+	txn.Status = proto.COMMITTED
+	rArgs := &proto.InternalResolveIntentRequest{
+		RequestHeader: proto.RequestHeader{
+			Timestamp: txn.Timestamp,
+			Key:       pArgs.Key,
+			RaftID:    tc.rng.Desc().RaftID,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Txn:       txn,
+		},
+	}
+	addCmd(proto.InternalResolveIntent, rArgs, &proto.InternalResolveIntentResponse{})
+
+	iArgs, iReply := incrementArgs([]byte("c"), 5, 1, tc.store.StoreID())
+	addCmd(proto.Increment, iArgs, iReply)
+	dArgs, dReply := deleteArgs([]byte("a"), 1, tc.store.StoreID())
+	addCmd(proto.Delete, dArgs, dReply)
+}
+
+// TestRangeResolveIntentRange verifies that a single
+// InternalResolveIntentRange command resolves every intent written by
+// the transaction in the span, skipping those of other transactions.
+func TestRangeResolveIntentRange(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	txn := newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	otherTxn := newTransaction("other", proto.Key("b2"), 1, proto.SERIALIZABLE, tc.clock)
+	keys := []proto.Key{proto.Key("a"), proto.Key("b"), proto.Key("c")}
+	for _, key := range keys {
+		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = txn.Timestamp
+		pArgs.Txn = txn
+		if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	pArgs, pReply := putArgs(proto.Key("b2"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = otherTxn.Timestamp
+	pArgs.Txn = otherTxn
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if count := tc.rng.stats.GetMVCC().IntentCount; count != 4 {
+		t.Fatalf("expected 4 intents; got %d", count)
+	}
+
+	// Resolve all of the first transaction's intents with one command.
+	txn.Status = proto.COMMITTED
+	rArgs := &proto.InternalResolveIntentRangeRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			EndKey:    proto.Key("d"),
+			Timestamp: txn.Timestamp,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Txn:       txn,
+		},
+	}
+	if err := tc.rng.AddCmd(proto.InternalResolveIntentRange, rArgs, &proto.InternalResolveIntentRangeResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+	if count := tc.rng.stats.GetMVCC().IntentCount; count != 1 {
+		t.Errorf("expected 1 intent remaining; got %d", count)
+	}
+
+	// The resolved values are visible to non-transactional reads.
+	for _, key := range keys {
+		gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+		gArgs.Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+			t.Fatalf("key %q: %s", key, err)
+		}
+		if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value")) {
+			t.Errorf("key %q: expected value; got %+v", key, gReply.Value)
+		}
+	}
+	// The other transaction's intent is untouched.
+	if _, err := engine.MVCCGet(tc.engine, proto.Key("b2"), tc.clock.Now(), nil); err == nil {
+		t.Error("expected intent of other transaction to remain")
+	} else if _, ok := err.(*proto.WriteIntentError); !ok {
+		t.Errorf("expected write intent error; got %s", err)
+	}
+}
+
+// TestRangeStatsDelta verifies that a read-write command's response
+// header reports the change it made to the range's MVCC stats, and
+// that reads report none.
+func TestRangeStatsDelta(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	before := tc.rng.stats.GetMVCC()
+	pArgs, pReply := putArgs([]byte("a"), []byte("value1"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	after := tc.rng.stats.GetMVCC()
+	delta := pReply.StatsDelta
+	if delta == nil {
+		t.Fatal("expected put to return a stats delta")
+	}
+	if delta.KeyBytes <= 0 || delta.ValBytes <= 0 {
+		t.Errorf("expected positive key and value byte deltas; got %+v", delta)
+	}
+	if kb, vb := after.KeyBytes-before.KeyBytes, after.ValBytes-before.ValBytes; delta.KeyBytes != kb || delta.ValBytes != vb {
+		t.Errorf("expected delta key/val bytes %d/%d; got %d/%d", kb, vb, delta.KeyBytes, delta.ValBytes)
+	}
+
+	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.StatsDelta != nil {
+		t.Errorf("expected no stats delta for a read; got %+v", gReply.StatsDelta)
+	}
 }
 
 // TestInternalMerge verifies that the InternalMerge command is behaving as
@@ -1648,7 +5111,7 @@ func TestConditionFailedError(t *testing.T) {
 	key := []byte("k")
 	value := []byte("quack")
 	pArgs, pReply := putArgs(key, value, 1, tc.store.StoreID())
-	if err := tc.rng.executeCmd(proto.Put, pArgs, pReply); err != nil {
+	if err := tc.rng.executeCmd(proto.Put, pArgs, pReply, time.Time{}); err != nil {
 		t.Fatal(err)
 	}
 	args := &proto.ConditionalPutRequest{
@@ -1666,7 +5129,7 @@ func TestConditionFailedError(t *testing.T) {
 		},
 	}
 	reply := &proto.ConditionalPutResponse{}
-	err := tc.rng.executeCmd(proto.ConditionalPut, args, reply)
+	err := tc.rng.executeCmd(proto.ConditionalPut, args, reply, time.Time{})
 	if cErr, ok := err.(*proto.ConditionFailedError); err == nil || !ok {
 		t.Fatalf("expected ConditionFailedError, got %T with content %+v",
 			err, err)
@@ -1676,6 +5139,53 @@ func TestConditionFailedError(t *testing.T) {
 	}
 }
 
+// TestRangeConditionalDelete verifies that a conditional delete
+// removes a key only if its value matches, and otherwise returns a
+// ConditionFailedError carrying the actual value.
+func TestRangeConditionalDelete(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := []byte("lock")
+	value := []byte("owner1")
+	pArgs, pReply := putArgs(key, value, 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	cdArgs := &proto.ConditionalDeleteRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       key,
+			Timestamp: tc.clock.Now(),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		},
+		ExpValue: &proto.Value{Bytes: []byte("owner2")},
+	}
+	err := tc.rng.AddCmd(proto.ConditionalDelete, cdArgs, &proto.ConditionalDeleteResponse{}, true)
+	if cErr, ok := err.(*proto.ConditionFailedError); !ok {
+		t.Fatalf("expected ConditionFailedError; got %T with content %+v", err, err)
+	} else if v := cErr.ActualValue; v == nil || !bytes.Equal(v.Bytes, value) {
+		t.Errorf("ConditionFailedError with bytes %q expected, but got %+v", value, v)
+	}
+
+	cdArgs.ExpValue = &proto.Value{Bytes: value}
+	cdArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.ConditionalDelete, cdArgs, &proto.ConditionalDeleteResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value != nil {
+		t.Errorf("expected key to be deleted; got %+v", gReply.Value)
+	}
+}
+
 // TestReplicaSetsEqual tests to ensure that intersectReplicaSets
 // returns the correct responses.
 func TestReplicaSetsEqual(t *testing.T) {
@@ -1703,3 +5213,508 @@ func TestReplicaSetsEqual(t *testing.T) {
 		}
 	}
 }
+
+// TestRangeAdmissionControl verifies that an overloaded store sheds
+// background commands with a retryable error while continuing to
+// serve user reads, and sheds user writes only at a higher level of
+// overload.
+func TestRangeAdmissionControl(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	tc.store.SetShedTier(TierBackground)
+	gcArgs := &proto.InternalGCRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       engine.KeyMin,
+			EndKey:    engine.KeyMax,
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Timestamp: tc.clock.Now(),
+		},
+	}
+	err := tc.rng.AddCmd(proto.InternalGC, gcArgs, &proto.InternalGCResponse{}, true)
+	if oErr, ok := err.(*proto.StoreOverloadedError); !ok || !oErr.CanRetry() {
+		t.Fatalf("expected retryable store overloaded error; got %v", err)
+	}
+	gArgs, gReply := getArgs([]byte("a"), 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatalf("expected get to succeed; got %s", err)
+	}
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatalf("expected put to succeed; got %s", err)
+	}
+
+	// Shedding user writes still admits reads.
+	tc.store.SetShedTier(TierUserWrite)
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err == nil {
+		t.Fatal("expected put to be shed")
+	}
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatalf("expected get to succeed; got %s", err)
+	}
+
+	// Resetting the shed tier admits all commands again.
+	tc.store.SetShedTier(0)
+	gcArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.InternalGC, gcArgs, &proto.InternalGCResponse{}, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRangeClosedTimestamp verifies that the closed timestamp
+// advances as commands complete, that writes at or below it are
+// rejected and that reads at or below it may be served by followers.
+func TestRangeClosedTimestamp(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.closedTSTarget = 1 * time.Second
+	tc.manualClock.Set((10 * time.Second).Nanoseconds())
+
+	if closedTS := tc.rng.ClosedTimestamp(); !closedTS.Equal(proto.ZeroTimestamp) {
+		t.Fatalf("expected zero closed timestamp; got %s", closedTS)
+	}
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	expClosedTS := proto.Timestamp{WallTime: (9 * time.Second).Nanoseconds()}
+	if closedTS := tc.rng.ClosedTimestamp(); !closedTS.Equal(expClosedTS) {
+		t.Fatalf("expected closed timestamp %s; got %s", expClosedTS, closedTS)
+	}
+
+	// The closed timestamp is persisted.
+	var persistedTS proto.Timestamp
+	key := engine.RangeClosedTimestampKey(tc.rng.Desc().RaftID)
+	if _, err := engine.MVCCGetProto(tc.engine, key, proto.ZeroTimestamp, nil, &persistedTS); err != nil {
+		t.Fatal(err)
+	}
+	if !persistedTS.Equal(expClosedTS) {
+		t.Errorf("expected persisted closed timestamp %s; got %s", expClosedTS, persistedTS)
+	}
+
+	// A write below the closed timestamp is rejected.
+	pArgs, pReply = putArgs([]byte("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = proto.Timestamp{WallTime: (5 * time.Second).Nanoseconds()}
+	err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
+	if wErr, ok := err.(*proto.WriteTooOldError); !ok || !wErr.ExistingTimestamp.Equal(expClosedTS) {
+		t.Fatalf("expected write too old error at %s; got %v", expClosedTS, err)
+	}
+	// As is an internal write of a new version.
+	piArgs, piReply := putIfAbsentArgs([]byte("b"), []byte("value"), 1, tc.store.StoreID())
+	piArgs.Timestamp = proto.Timestamp{WallTime: (5 * time.Second).Nanoseconds()}
+	err = tc.rng.AddCmd(proto.InternalPutIfAbsent, piArgs, piReply, true)
+	if wErr, ok := err.(*proto.WriteTooOldError); !ok || !wErr.ExistingTimestamp.Equal(expClosedTS) {
+		t.Fatalf("expected write too old error at %s; got %v", expClosedTS, err)
+	}
+
+	// Reads at or below the closed timestamp may be served by followers.
+	if !tc.rng.CanServeFollowerRead(proto.Timestamp{WallTime: (5 * time.Second).Nanoseconds()}) {
+		t.Error("expected follower read below closed timestamp to be permitted")
+	}
+	if tc.rng.CanServeFollowerRead(tc.clock.Now()) {
+		t.Error("expected follower read above closed timestamp to be refused")
+	}
+}
+
+// TestRangeClampReadToClosedTimestamp verifies that a read which
+// permits clamping is served at the closed timestamp, without waiting,
+// when a write to its key is in flight.
+func TestRangeClampReadToClosedTimestamp(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.closedTSTarget = 1 * time.Second
+	tc.manualClock.Set((10 * time.Second).Nanoseconds())
+
+	key := []byte("a")
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = proto.Timestamp{WallTime: (5 * time.Second).Nanoseconds()}
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	closedTS := tc.rng.ClosedTimestamp()
+	if closedTS.Equal(proto.ZeroTimestamp) {
+		t.Fatal("expected closed timestamp to advance")
+	}
+
+	// Without writes in flight, the read is served at its timestamp.
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	gArgs.ClampToClosedTimestamp = true
+	readTS := gArgs.Timestamp
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !gReply.Timestamp.Equal(readTS) {
+		t.Errorf("expected read at %s; got %s", readTS, gReply.Timestamp)
+	}
+
+	// Simulate a write to the key in flight.
+	tc.rng.Lock()
+	cmdKey := tc.rng.cmdQ.Add(key, nil, false)
+	tc.rng.Unlock()
+	defer func() {
+		tc.rng.Lock()
+		tc.rng.cmdQ.Remove(cmdKey)
+		tc.rng.Unlock()
+	}()
+
+	gArgs, gReply = getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	gArgs.ClampToClosedTimestamp = true
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- tc.rng.AddCmd(proto.Get, gArgs, gReply, true)
+	}()
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("clamped read blocked on in-flight write")
+	}
+	if !gReply.Timestamp.Equal(closedTS) {
+		t.Errorf("expected read at closed timestamp %s; got %s", closedTS, gReply.Timestamp)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value")) {
+		t.Errorf("expected value written below closed timestamp; got %+v", gReply.Value)
+	}
+}
+
+// armedEngine wraps an engine, blocking the first get of an armed
+// key until unblock is closed.
+type armedEngine struct {
+	engine.Engine
+	armed   atomic.Value // proto.EncodedKey
+	blocked chan struct{}
+	unblock chan struct{}
+}
+
+func (e *armedEngine) Get(key proto.EncodedKey) ([]byte, error) {
+	if armed, ok := e.armed.Load().(proto.EncodedKey); ok && armed != nil && bytes.Equal(key, armed) {
+		e.armed.Store(proto.EncodedKey(nil))
+		e.blocked <- struct{}{}
+		<-e.unblock
+	}
+	return e.Engine.Get(key)
+}
+
+func (e *armedEngine) NewBatch() engine.Engine {
+	return engine.NewBatch(e)
+}
+
+// TestRangeReadDeadline verifies that a read whose engine operation
+// blocks past the request's timeout fails with a deadline exceeded
+// error and releases the command queue for a waiting write.
+func TestRangeReadDeadline(t *testing.T) {
+	eng := &armedEngine{
+		Engine:  engine.NewInMem(proto.Attributes{Attrs: []string{"dc1", "mem"}}, 1<<20),
+		blocked: make(chan struct{}, 1),
+		unblock: make(chan struct{}),
+	}
+	defer close(eng.unblock)
+	tc := testContext{engine: eng}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	eng.armed.Store(engine.MVCCEncodeKey(key))
+	gArgs, gReply := getArgs(key, 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	gArgs.Timeout = (50 * time.Millisecond).Nanoseconds()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- tc.rng.AddCmd(proto.Get, gArgs, gReply, true)
+	}()
+	<-eng.blocked
+
+	// The write waits on the blocked read in the command queue and
+	// proceeds once the read's deadline passes.
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errChan; err == nil {
+		t.Fatal("expected read to exceed its deadline")
+	} else if _, ok := err.(*proto.DeadlineExceededError); !ok {
+		t.Fatalf("expected deadline exceeded error; got %T: %s", err, err)
+	}
+}
+
+// TestRangeProtectedKeys verifies that user writes to range-local
+// and range metadata keys are rejected, while writes by the system
+// and internal commands succeed.
+func TestRangeProtectedKeys(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	descKey := engine.RangeDescriptorKey(proto.Key("a"))
+	for _, key := range []proto.Key{descKey, engine.RangeMetaKey(proto.Key("a"))} {
+		pArgs, pReply := putArgs(key, []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		pArgs.User = "user1"
+		err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
+		if pErr, ok := err.(*proto.ProtectedKeyError); !ok || !pErr.Key.Equal(key) {
+			t.Errorf("expected protected key error for %q; got %v", key, err)
+		}
+	}
+
+	// User writes to other keys are permitted.
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	pArgs.User = "user1"
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// The system may write the descriptor key.
+	pArgs, pReply = putArgs(descKey, []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	pArgs.User = UserRoot
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Internal commands bypass the guard.
+	iArgs, iReply := putIfAbsentArgs(engine.RangeDescriptorKey(proto.Key("b")), []byte("value"), 1, tc.store.StoreID())
+	iArgs.Timestamp = tc.clock.Now()
+	iArgs.User = "user1"
+	if err := tc.rng.AddCmd(proto.InternalPutIfAbsent, iArgs, iReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !iReply.Inserted {
+		t.Error("expected internal descriptor update to be inserted")
+	}
+}
+
+// TestRangeIntentLimit verifies that a transactional write which
+// would push the range's open intent count past the limit is
+// rejected, while rewrites of existing intents and non-transactional
+// writes succeed.
+func TestRangeIntentLimit(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	tc.rng.maxIntents = 2
+
+	txn := newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	put := func(key string, txn *proto.Transaction) error {
+		pArgs, pReply := putArgs([]byte(key), []byte("value"), 1, tc.store.StoreID())
+		pArgs.Timestamp = tc.clock.Now()
+		pArgs.Txn = txn
+		if txn != nil {
+			pArgs.Timestamp = txn.Timestamp
+		}
+		return tc.rng.AddCmd(proto.Put, pArgs, pReply, true)
+	}
+	for _, key := range []string{"a", "b"} {
+		if err := put(key, txn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count := tc.rng.IntentCount(); count != 2 {
+		t.Fatalf("expected 2 intents; got %d", count)
+	}
+	err := put("c", txn)
+	if tErr, ok := err.(*proto.TooManyIntentsError); !ok || tErr.IntentCount != 3 || tErr.MaxIntents != 2 {
+		t.Fatalf("expected too many intents error; got %v", err)
+	}
+	if count := tc.rng.IntentCount(); count != 2 {
+		t.Errorf("expected rejected write to leave 2 intents; got %d", count)
+	}
+	if err := put("a", txn); err != nil {
+		t.Errorf("expected rewrite of existing intent to succeed; got %s", err)
+	}
+	if err := put("d", nil); err != nil {
+		t.Errorf("expected non-transactional write to succeed; got %s", err)
+	}
+}
+
+// TestRangeBatch verifies that a batch's requests are applied
+// atomically in a single command spanning all of their keys.
+func TestRangeBatch(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pArgs, _ := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	iArgs, _ := incrementArgs([]byte("c"), 5, 1, tc.store.StoreID())
+	gArgs, _ := getArgs([]byte("a"), 1, tc.store.StoreID())
+	bArgs := &proto.BatchRequest{
+		RequestHeader: proto.RequestHeader{
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Timestamp: tc.clock.Now(),
+		},
+	}
+	bArgs.Add(pArgs)
+	bArgs.Add(iArgs)
+	bArgs.Add(gArgs)
+	bReply := &proto.BatchResponse{}
+	if err := tc.rng.AddCmd(proto.Batch, bArgs, bReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if !bArgs.Key.Equal(proto.Key("a")) || !bArgs.EndKey.Equal(proto.Key("c").Next()) {
+		t.Errorf("expected batch to span [a, c]; got [%q, %q)", bArgs.Key, bArgs.EndKey)
+	}
+	if bReply.Completed != 3 || len(bReply.Responses) != 3 {
+		t.Fatalf("expected 3 completed responses; got %d of %d", bReply.Completed, len(bReply.Responses))
+	}
+	if newValue := bReply.Responses[1].GetIncrement().NewValue; newValue != 5 {
+		t.Errorf("expected incremented value 5; got %d", newValue)
+	}
+	if value := bReply.Responses[2].GetGet().Value; value == nil || !bytes.Equal(value.Bytes, []byte("value")) {
+		t.Errorf("expected batch to read its own put; got %+v", value)
+	}
+
+	// Incrementing the non-integer value at "a" fails, and with it the
+	// preceding put to "b".
+	pArgs, _ = putArgs([]byte("b"), []byte("value"), 1, tc.store.StoreID())
+	iArgs, _ = incrementArgs([]byte("a"), 1, 1, tc.store.StoreID())
+	bArgs = &proto.BatchRequest{
+		RequestHeader: proto.RequestHeader{
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			Timestamp: tc.clock.Now(),
+		},
+	}
+	bArgs.Add(pArgs)
+	bArgs.Add(iArgs)
+	bReply = &proto.BatchResponse{}
+	if err := tc.rng.AddCmd(proto.Batch, bArgs, bReply, true); err == nil {
+		t.Fatal("expected batch to fail incrementing a non-integer value")
+	}
+	if bReply.Completed != 1 {
+		t.Errorf("expected 1 completed request; got %d", bReply.Completed)
+	}
+	gArgs, gReply := getArgs([]byte("b"), 1, tc.store.StoreID())
+	gArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value != nil {
+		t.Errorf("expected failed batch's put to be discarded; got %+v", gReply.Value)
+	}
+}
+
+// TestRangeBatchGetReadsOwnWrites verifies that a Get within a batch
+// reads the batch's preceding writes despite the bloom filter, and
+// that it doesn't fill the value cache with writes of a batch which
+// fails.
+func TestRangeBatchGetReadsOwnWrites(t *testing.T) {
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+	if err := tc.rng.EnableBloomFilter(1 << 16); err != nil {
+		t.Fatal(err)
+	}
+	tc.rng.valueCache = newValueCache(100)
+
+	newBatch := func(requests ...proto.Request) *proto.BatchRequest {
+		bArgs := &proto.BatchRequest{
+			RequestHeader: proto.RequestHeader{
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+				Timestamp: tc.clock.Now(),
+			},
+		}
+		for _, req := range requests {
+			bArgs.Add(req)
+		}
+		return bArgs
+	}
+
+	pArgs, _ := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	gArgs, _ := getArgs([]byte("a"), 1, tc.store.StoreID())
+	bArgs := newBatch(pArgs, gArgs)
+	bReply := &proto.BatchResponse{}
+	if err := tc.rng.AddCmd(proto.Batch, bArgs, bReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if value := bReply.Responses[1].GetGet().Value; value == nil || !bytes.Equal(value.Bytes, []byte("value")) {
+		t.Errorf("expected batch to read its own put of a key absent from the bloom filter; got %+v", value)
+	}
+
+	// The get of "b" reads the failed batch's put, which must not
+	// be served from the value cache once the batch is discarded.
+	pArgs, pReply := putArgs([]byte("b"), []byte("old"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	pArgs, _ = putArgs([]byte("b"), []byte("value"), 1, tc.store.StoreID())
+	gArgs, _ = getArgs([]byte("b"), 1, tc.store.StoreID())
+	iArgs, _ := incrementArgs([]byte("b"), 1, 1, tc.store.StoreID())
+	bArgs = newBatch(pArgs, gArgs, iArgs)
+	if err := tc.rng.AddCmd(proto.Batch, bArgs, &proto.BatchResponse{}, true); err == nil {
+		t.Fatal("expected batch to fail incrementing a non-integer value")
+	}
+	gArgs, gReply := getArgs([]byte("b"), 1, tc.store.StoreID())
+	gArgs.Timestamp = bArgs.Timestamp
+	if err := tc.rng.AddCmd(proto.Get, gArgs, gReply, true); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("old")) {
+		t.Errorf("expected failed batch's put to be discarded; got %+v", gReply.Value)
+	}
+}
+
+// benchmarkRangeCmd measures the latency of the command returned by
+// newCmd when added to the range.
+func benchmarkRangeCmd(b *testing.B, method string, newCmd func(tc *testContext) (proto.Request, proto.Response)) {
+	tc := testContext{}
+	tc.Start(b)
+	defer tc.Stop()
+
+	pArgs, pReply := putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		args, reply := newCmd(&tc)
+		args.Header().Timestamp = tc.clock.Now()
+		if err := tc.rng.AddCmd(method, args, reply, true); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkRangeGet measures the latency of reads, which are served
+// directly from the engine.
+func BenchmarkRangeGet(b *testing.B) {
+	benchmarkRangeCmd(b, proto.Get, func(tc *testContext) (proto.Request, proto.Response) {
+		return getArgs(proto.Key("a"), 1, tc.store.StoreID())
+	})
+}
+
+// BenchmarkRangePut measures the latency of writes, which are
+// proposed to and applied through Raft, for comparison with
+// BenchmarkRangeGet.
+func BenchmarkRangePut(b *testing.B) {
+	benchmarkRangeCmd(b, proto.Put, func(tc *testContext) (proto.Request, proto.Response) {
+		return putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+	})
+}
@@ -180,6 +180,52 @@ func (rc *ResponseCache) CopyFrom(e engine.Engine, originRaftID int64) error {
 	})
 }
 
+// Export returns all the cached results as a slice of entries, for
+// transmission to another replica, e.g. as part of a leader lease
+// transfer. The cache will be locked while exporting is in progress.
+func (rc *ResponseCache) Export() ([]proto.ResponseCacheEntry, error) {
+	rc.Lock()
+	defer rc.Unlock()
+
+	prefix := engine.ResponseCacheKey(rc.raftID, nil) // response cache prefix
+	start := engine.MVCCEncodeKey(prefix)
+	end := engine.MVCCEncodeKey(prefix.PrefixEnd())
+
+	var cmdIDs []proto.ClientCmdID
+	if err := rc.engine.Iterate(start, end, func(kv proto.RawKeyValue) (bool, error) {
+		cmdID, err := rc.decodeResponseCacheKey(kv.Key)
+		if err != nil {
+			return false, util.Errorf("could not decode a response cache key %q: %s", kv.Key, err)
+		}
+		cmdIDs = append(cmdIDs, cmdID)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	entries := make([]proto.ResponseCacheEntry, len(cmdIDs))
+	for i := range cmdIDs {
+		entries[i].CmdID = cmdIDs[i]
+		key := engine.ResponseCacheKey(rc.raftID, &cmdIDs[i])
+		if _, err := engine.MVCCGetProto(rc.engine, key, proto.ZeroTimestamp, nil, &entries[i].Response); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// Import writes the supplied entries, as returned by Export, into
+// the cache, overwriting any existing results for the same client
+// commands.
+func (rc *ResponseCache) Import(entries []proto.ResponseCacheEntry) error {
+	for i := range entries {
+		key := engine.ResponseCacheKey(rc.raftID, &entries[i].CmdID)
+		if err := engine.MVCCPutProto(rc.engine, nil, key, proto.ZeroTimestamp, nil, &entries[i].Response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // PutResponse writes a response to the cache for the specified cmdID.
 // The inflight entry corresponding to cmdID is removed from the
 // inflight map. Any requests waiting on the outcome of the inflight
@@ -22,6 +22,7 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
@@ -38,9 +39,9 @@ type rangeQueue interface {
 	// The provided stopper is used to signal that the goroutine should exit.
 	Start(*hlc.Clock, *util.Stopper)
 	// MaybeAdd adds the range to the queue if the range meets
-	// the queue's inclusion criteria and the queue is not already
-	// too full, etc.
-	MaybeAdd(*Range)
+	// the queue's inclusion criteria at the specified time and the
+	// queue is not already too full, etc.
+	MaybeAdd(*Range, proto.Timestamp)
 	// MaybeRemove removes the range from the queue if it is present.
 	MaybeRemove(*Range)
 }
@@ -76,6 +77,7 @@ type rangeScanner struct {
 	removed  chan *Range    // Ranges to remove from queues
 	count    int64          // Count of times through the scanning loop
 	stats    unsafe.Pointer // Latest store stats object; updated atomically
+	clock    *hlc.Clock     // Provides the time ranges are considered at
 	stopper  *util.Stopper
 }
 
@@ -94,6 +96,7 @@ func newRangeScanner(interval time.Duration, iter rangeIterator, queues []rangeQ
 
 // Start spins up the scanning loop. Call Stop() to exit the loop.
 func (rs *rangeScanner) Start(clock *hlc.Clock) {
+	rs.clock = clock
 	for _, queue := range rs.queues {
 		queue.Start(clock, rs.stopper)
 	}
@@ -151,7 +154,7 @@ func (rs *rangeScanner) scanLoop() {
 			if rng != nil {
 				// Try adding range to all queues.
 				for _, q := range rs.queues {
-					q.MaybeAdd(rng)
+					q.MaybeAdd(rng, rs.clock.Now())
 				}
 				stats.RangeCount++
 				stats.MVCC.Accumulate(rng.stats.GetMVCC())
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/hlc"
@@ -131,7 +132,7 @@ func (tq *testQueue) Start(clock *hlc.Clock, stopper *util.Stopper) {
 	}()
 }
 
-func (tq *testQueue) MaybeAdd(rng *Range) {
+func (tq *testQueue) MaybeAdd(rng *Range, now proto.Timestamp) {
 	tq.Lock()
 	defer tq.Unlock()
 	if index := tq.indexOf(rng); index == -1 {
@@ -23,7 +23,10 @@ import (
 	"fmt"
 	"net"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cockroachdb/cockroach/client"
@@ -50,6 +53,17 @@ const (
 	// defaultScanInterval is the default value for the scan interval
 	// command line flag.
 	defaultScanInterval = 10 * time.Minute
+	// defaultConflictTimeout is the default maximum duration a read
+	// spends pushing transactions and resolving conflicting intents
+	// before failing with a retryable error.
+	defaultConflictTimeout = 30 * time.Second
+	// defaultIntentResolutionConcurrency is the default maximum number
+	// of intents of ended transactions the store's ranges resolve at
+	// once.
+	defaultIntentResolutionConcurrency = 16
+	// DefaultAcctRollupInterval is the default interval at which a
+	// store rolls up the stats of accounting prefixes.
+	DefaultAcctRollupInterval = 1 * time.Minute
 )
 
 var (
@@ -148,7 +162,7 @@ type rangeAlreadyExists struct {
 
 // Error implements the error interface.
 func (e *rangeAlreadyExists) Error() string {
-	return fmt.Sprintf("range for Raft ID %d already exists on store", e.rng.Desc().RaftID)
+	return fmt.Sprintf("duplicate range: range for Raft ID %d already exists on store", e.rng.Desc().RaftID)
 }
 
 // A RangeSlice is a slice of Range pointers used for replica lookups
@@ -218,11 +232,13 @@ func newStoreRangeIterator(store *Store) *storeRangeIterator {
 	r := &storeRangeIterator{
 		store: store,
 	}
-	r.reset()
+	r.Reset()
 	return r
 }
 
-func (si *storeRangeIterator) next() *Range {
+// Next returns the next range in the store's rangesByKey slice, or
+// nil once all ranges have been visited.
+func (si *storeRangeIterator) Next() *Range {
 	si.store.mu.Lock()
 	defer si.store.mu.Unlock()
 	if index, remaining := si.index, len(si.store.rangesByKey)-si.index; remaining > 0 {
@@ -233,24 +249,125 @@ func (si *storeRangeIterator) next() *Range {
 	return nil
 }
 
-func (si *storeRangeIterator) estimatedCount() int {
+// EstimatedCount returns the number of ranges remaining in the
+// iteration as of the last call to Next or Reset.
+func (si *storeRangeIterator) EstimatedCount() int {
 	return si.remaining
 }
 
-func (si *storeRangeIterator) reset() {
+// Reset restarts the iteration at the store's first range.
+func (si *storeRangeIterator) Reset() {
 	si.store.mu.Lock()
 	defer si.store.mu.Unlock()
 	si.remaining = len(si.store.rangesByKey)
 	si.index = 0
 }
 
+// A CommandTier classifies commands by priority for admission
+// control. When a store is overloaded, it sheds commands from the
+// lowest priority tiers first.
+type CommandTier int32
+
+const (
+	// TierUserRead is the highest priority tier, holding read-only
+	// commands.
+	TierUserRead CommandTier = iota + 1
+	// TierUserWrite holds read-write commands.
+	TierUserWrite
+	// TierBackground is the lowest priority tier, holding garbage
+	// collection, log truncation and the admin commands used for
+	// splitting and rebalancing ranges.
+	TierBackground
+)
+
+// commandTier returns the admission control tier for method.
+func commandTier(method string) CommandTier {
+	switch {
+	case proto.IsAdmin(method), method == proto.InternalGC, method == proto.InternalTruncateLog:
+		return TierBackground
+	case proto.IsReadOnly(method):
+		return TierUserRead
+	}
+	return TierUserWrite
+}
+
 // A Store maintains a map of ranges by start key. A Store corresponds
 // to one physical device.
 type Store struct {
 	*StoreFinder
 
-	Ident       proto.StoreIdent
-	RetryOpts   util.RetryOptions
+	Ident     proto.StoreIdent
+	RetryOpts util.RetryOptions
+	// ConflictTimeout bounds the time reads spend resolving conflicts
+	// with write intents for requests which don't specify a timeout.
+	ConflictTimeout time.Duration
+	// BloomFilterBits, if non-zero, enables a bloom filter of the
+	// specified size over each range's keys. See Range.EnableBloomFilter.
+	BloomFilterBits int
+	// ClosedTimestampTarget, if non-zero, is the duration by which each
+	// range's closed timestamp lags the current time. Writes at or below
+	// the closed timestamp are rejected and reads at or below it may be
+	// served by followers.
+	ClosedTimestampTarget time.Duration
+	// MaxRangeIntents, if non-zero, limits the number of open write
+	// intents on each range; transactional writes which would exceed
+	// it fail with a TooManyIntentsError. As the limit is enforced as
+	// commands are applied, it must be the same on every store.
+	MaxRangeIntents int64
+	// MaxCommandSize, if non-zero, limits the serialized size in bytes
+	// of read-write commands; larger commands fail with a
+	// CommandTooLargeError before being proposed to Raft.
+	MaxCommandSize int64
+	// MaxPendingProposalBytes, if non-zero, limits the total serialized
+	// size of the commands each range has proposed to Raft but not yet
+	// applied. Client writes beyond it fail with a retryable
+	// ProposalBufferFullError until the proposals drain.
+	MaxPendingProposalBytes int64
+	// MaxTimestampFutureOffset, if non-zero, is the maximum distance
+	// ahead of the node's clock of the timestamps of commands. Commands
+	// timestamped further in the future fail with a TimestampTooFarError
+	// rather than advancing the clock.
+	MaxTimestampFutureOffset time.Duration
+	// CommandQueueTimeout, if non-zero, is the maximum time, measured
+	// by the store's clock, which a command may wait in a range's
+	// command queue for overlapping commands before failing with a
+	// CommandQueueTimeoutError.
+	CommandQueueTimeout time.Duration
+	// TimestampCacheMaxEntries, if non-zero, bounds the number of
+	// entries in each range's timestamp cache. The oldest entries
+	// beyond it are evicted into the cache's low water mark.
+	TimestampCacheMaxEntries int
+	// ValueCacheSize, if non-zero, enables on each range an LRU cache
+	// of up to this many values read by non-transactional Gets, keyed
+	// by key and timestamp and invalidated on writes to the key.
+	ValueCacheSize int
+	// MaxVersionsPerSecond, if non-zero, limits the rate at which
+	// non-transactional writes to a key create MVCC versions. Writes
+	// beyond it replace the key's latest version where that is safe.
+	MaxVersionsPerSecond int
+	// ConfigGossipInterval is the interval at which ranges holding
+	// configuration maps re-gossip them absent changes. Defaults to
+	// DefaultConfigGossipInterval; negative to disable.
+	ConfigGossipInterval time.Duration
+	// LoadGossipInterval is the interval at which range leaders gossip
+	// the load on their ranges. Defaults to DefaultLoadGossipInterval;
+	// negative to disable.
+	LoadGossipInterval time.Duration
+	// AcctRollupInterval is the interval at which the store rolls up
+	// the MVCC stats of accounting prefixes with rollups enabled.
+	// Defaults to DefaultAcctRollupInterval; negative to disable.
+	AcctRollupInterval time.Duration
+	// KeyAddressFunc, if not nil, maps keys to the addresses which
+	// determine the ranges containing them, in place of
+	// engine.KeyAddress. Custom functions should generally apply
+	// engine.KeyAddress to the transformed key so that local keys
+	// continue to address correctly.
+	KeyAddressFunc func(proto.Key) proto.Key
+	// ScanInterval is the target duration of a single scan through the
+	// store's ranges, during which each range is considered for garbage
+	// collection. Defaults to the --scan_interval flag.
+	ScanInterval time.Duration
+
 	clock       *hlc.Clock
 	engine      engine.Engine       // The underlying key-value store
 	db          *client.KV          // Cockroach KV DB
@@ -261,6 +378,10 @@ type Store struct {
 	configMu    sync.Mutex          // Limit config update processing
 	multiraft   *multiraft.MultiRaft
 	stopper     *util.Stopper
+	shedTier    int32         // Atomic CommandTier; commands at or below are shed
+	resolveSem  chan struct{} // Bounds concurrent async intent resolutions
+	gcQueue     *gcQueue      // Garbage collects ranges
+	scanner     *rangeScanner // Scans ranges into the queues
 
 	mu          sync.RWMutex     // Protects variables below...
 	ranges      map[int64]*Range // Map of ranges by Raft ID
@@ -273,16 +394,23 @@ var _ multiraft.Storage = &Store{}
 func NewStore(clock *hlc.Clock, eng engine.Engine, db *client.KV, gossip *gossip.Gossip,
 	transport multiraft.Transport) *Store {
 	s := &Store{
-		StoreFinder: &StoreFinder{gossip: gossip},
-		RetryOpts:   defaultRangeRetryOptions,
-		clock:       clock,
-		engine:      eng,
-		db:          db,
-		allocator:   &allocator{},
-		gossip:      gossip,
-		transport:   transport,
-		stopper:     util.NewStopper(0),
-		ranges:      map[int64]*Range{},
+		StoreFinder:          &StoreFinder{gossip: gossip},
+		RetryOpts:            defaultRangeRetryOptions,
+		ConflictTimeout:      defaultConflictTimeout,
+		ConfigGossipInterval: DefaultConfigGossipInterval,
+		LoadGossipInterval:   DefaultLoadGossipInterval,
+		AcctRollupInterval:   DefaultAcctRollupInterval,
+		ScanInterval:         *scanInterval,
+		clock:                clock,
+		engine:               eng,
+		db:                   db,
+		allocator:            &allocator{},
+		gossip:               gossip,
+		transport:            transport,
+		stopper:              util.NewStopper(0),
+		ranges:               map[int64]*Range{},
+		gcQueue:              newGCQueue(),
+		resolveSem:           make(chan struct{}, defaultIntentResolutionConcurrency),
 	}
 	s.allocator.storeFinder = s.findStores
 	return s
@@ -290,6 +418,9 @@ func NewStore(clock *hlc.Clock, eng engine.Engine, db *client.KV, gossip *gossip
 
 // Stop calls Range.Stop() on all active ranges.
 func (s *Store) Stop() {
+	if s.scanner != nil {
+		s.scanner.Stop()
+	}
 	for _, rng := range s.ranges {
 		rng.stop()
 	}
@@ -389,8 +520,19 @@ func (s *Store) Start() error {
 	s.stopper.Add(1)
 	go s.processRaft()
 
+	// Start the scanner, which paces ranges through the GC queue.
+	s.scanner = newRangeScanner(s.ScanInterval, newStoreRangeIterator(s), []rangeQueue{s.gcQueue})
+	s.scanner.Start(s.clock)
+
+	// Start periodic rollups of accounting stats.
+	if s.AcctRollupInterval > 0 {
+		s.stopper.Add(1)
+		go s.startAcctRollups()
+	}
+
 	// Register callbacks for any changes to accounting and zone
-	// configurations; we split ranges along prefix boundaries.
+	// configurations; we split ranges along prefix boundaries. The
+	// callbacks also match the keys of the configurations' deltas.
 	// Gossip is only ever nil for unittests.
 	if s.gossip != nil {
 		s.gossip.RegisterCallback(gossip.KeyConfigAccounting, s.configGossipUpdate)
@@ -398,6 +540,9 @@ func (s *Store) Start() error {
 		// Callback triggers on capacity gossip from all stores.
 		capacityRegex := fmt.Sprintf("%s.*", gossip.KeyMaxAvailCapacityPrefix)
 		s.gossip.RegisterCallback(capacityRegex, s.capacityGossipUpdate)
+		// Callback triggers on closed timestamp gossip from range leaders.
+		closedTSRegex := fmt.Sprintf("%s.*", gossip.KeyClosedTimestampPrefix)
+		s.gossip.RegisterCallback(closedTSRegex, s.closedTimestampGossipUpdate)
 	}
 
 	return nil
@@ -409,21 +554,18 @@ func (s *Store) configGossipUpdate(key string, contentsChanged bool) {
 	s.configMu.Lock()
 	defer s.configMu.Unlock()
 
+	// Updates to a configuration's delta apply to the configuration.
+	key = strings.TrimPrefix(key, gossip.KeyConfigDeltaPrefix)
 	switch key {
 	case gossip.KeyConfigAccounting, gossip.KeyConfigZone:
 		if !contentsChanged {
 			return // Skip update if it's just a newer timestamp or fewer hops to info
 		}
-		info, err := s.gossip.GetInfo(key)
+		configMap, err := GossipedConfigMap(s.gossip, key)
 		if err != nil {
 			log.Errorf("unable to fetch %s config from gossip: %s", key, err)
 			return
 		}
-		configMap, ok := info.(PrefixConfigMap)
-		if !ok {
-			log.Errorf("gossiped info is not a prefix configuration map: %+v", info)
-			return
-		}
 		s.maybeSplitRangesByConfigs(configMap)
 	default:
 		log.Warningf("unhandled gossip update to key %s", key)
@@ -431,6 +573,35 @@ func (s *Store) configGossipUpdate(key string, contentsChanged bool) {
 	}
 }
 
+// closedTimestampGossipUpdate is a callback for gossip updates to a
+// range's closed timestamp. The closed timestamp of the local replica
+// of the range, if any, is forwarded to the gossiped value.
+func (s *Store) closedTimestampGossipUpdate(key string, contentsChanged bool) {
+	if !contentsChanged {
+		return
+	}
+	raftID, err := strconv.ParseInt(strings.TrimPrefix(key, gossip.KeyClosedTimestampPrefix), 10, 64)
+	if err != nil {
+		log.Errorf("unable to parse raft ID from closed timestamp gossip key %s: %s", key, err)
+		return
+	}
+	rng, err := s.GetRange(raftID)
+	if err != nil {
+		return // Not a replica of this range
+	}
+	info, err := s.gossip.GetInfo(key)
+	if err != nil {
+		log.Errorf("unable to fetch %s from gossip: %s", key, err)
+		return
+	}
+	closedTS, ok := info.(proto.Timestamp)
+	if !ok {
+		log.Errorf("gossiped info is not a timestamp: %+v", info)
+		return
+	}
+	rng.forwardClosedTimestamp(closedTS)
+}
+
 // maybeSplitRangesByConfigs determines ranges which should be
 // split by the boundaries of the prefix config map, if any, and
 // issues AdminSplit commands.
@@ -482,6 +653,90 @@ func (s *Store) maybeSplitRangesByConfigs(configMap PrefixConfigMap) {
 	}
 }
 
+// startAcctRollups periodically rolls up the stats of accounting
+// prefixes until the store is stopped.
+func (s *Store) startAcctRollups() {
+	for {
+		select {
+		case <-time.After(jitteredInterval(s.AcctRollupInterval)):
+			if err := s.RollupAccounting(); err != nil {
+				log.Warningf("unable to roll up accounting stats: %s", err)
+			}
+		case <-s.stopper.ShouldStop():
+			s.stopper.SetStopped()
+			return
+		}
+	}
+}
+
+// RollupAccounting aggregates the MVCC stats of the ranges covering
+// the key span of each accounting prefix with rollups enabled and
+// writes the result to engine.AcctRollupKey(prefix). A prefix is only
+// rolled up by a store which leads every range covering its span, and
+// only if the span's first and last ranges don't extend beyond it;
+// otherwise, the prefix is skipped.
+func (s *Store) RollupAccounting() error {
+	if s.gossip == nil {
+		return nil
+	}
+	configMap, err := GossipedConfigMap(s.gossip, gossip.KeyConfigAccounting)
+	if err != nil {
+		return err
+	}
+	for _, config := range configMap.configs() {
+		if !config.Config.(*proto.AcctConfig).Rollup {
+			continue
+		}
+		rollup, ok := s.rollupSpan(config.Prefix, config.Prefix.PrefixEnd())
+		if !ok {
+			continue
+		}
+		rollup.LastUpdateNanos = s.clock.PhysicalNow()
+		if err := s.db.PutProto(engine.AcctRollupKey(config.Prefix), rollup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollupSpan sums the MVCC stats of the ranges covering the span from
+// start to end. Returns false if the store doesn't lead every range
+// covering the span or the covering ranges extend beyond it.
+func (s *Store) rollupSpan(start, end proto.Key) (*proto.AcctRollup, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n := sort.Search(len(s.rangesByKey), func(i int) bool {
+		return start.Less(s.rangesByKey[i].Desc().EndKey)
+	})
+	if n >= len(s.rangesByKey) || !s.rangesByKey[n].Desc().StartKey.Equal(start) {
+		return nil, false
+	}
+	rollup := &proto.AcctRollup{}
+	next := start
+	for ; n < len(s.rangesByKey) && next.Less(end); n++ {
+		rng := s.rangesByKey[n]
+		desc := rng.Desc()
+		if !desc.StartKey.Equal(next) || end.Less(desc.EndKey) || !rng.IsLeader() {
+			return nil, false
+		}
+		ms := rng.stats.GetMVCC()
+		rollup.LiveBytes += ms.LiveBytes
+		rollup.KeyBytes += ms.KeyBytes
+		rollup.ValBytes += ms.ValBytes
+		rollup.IntentBytes += ms.IntentBytes
+		rollup.LiveCount += ms.LiveCount
+		rollup.KeyCount += ms.KeyCount
+		rollup.ValCount += ms.ValCount
+		rollup.IntentCount += ms.IntentCount
+		rollup.Ranges++
+		next = desc.EndKey
+	}
+	if next.Less(end) {
+		return nil, false
+	}
+	return rollup, true
+}
+
 // Bootstrap writes a new store ident to the underlying engine. To
 // ensure that no crufty data already exists in the engine, it scans
 // the engine contents before writing the new store ident. The engine
@@ -512,16 +767,37 @@ func (s *Store) GetRange(raftID int64) (*Range, error) {
 	return nil, proto.NewRangeNotFoundError(raftID)
 }
 
+// MinClosedTimestamp returns the minimum of the closed timestamps of
+// the specified ranges, a timestamp at which reads observe a
+// consistent snapshot across all of them. The result is the zero
+// timestamp if any of the ranges has yet to close a timestamp.
+func (s *Store) MinClosedTimestamp(raftIDs []int64) (proto.Timestamp, error) {
+	if len(raftIDs) == 0 {
+		return proto.ZeroTimestamp, util.Errorf("no ranges specified")
+	}
+	minTS := proto.MaxTimestamp
+	for _, raftID := range raftIDs {
+		rng, err := s.GetRange(raftID)
+		if err != nil {
+			return proto.ZeroTimestamp, err
+		}
+		if closedTS := rng.ClosedTimestamp(); closedTS.Less(minTS) {
+			minTS = closedTS
+		}
+	}
+	return minTS, nil
+}
+
 // LookupRange looks up a range via binary search over the sorted
 // "rangesByKey" RangeSlice. Returns nil if no range is found for
 // specified key range. Note that the specified keys are transformed
-// using Key.Address() to ensure we lookup ranges correctly for local
+// using KeyAddress() to ensure we lookup ranges correctly for local
 // keys.
 func (s *Store) LookupRange(start, end proto.Key) *Range {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	startAddr := engine.KeyAddress(start)
-	endAddr := engine.KeyAddress(end)
+	startAddr := s.KeyAddress(start)
+	endAddr := s.KeyAddress(end)
 	n := sort.Search(len(s.rangesByKey), func(i int) bool {
 		return startAddr.Less(s.rangesByKey[i].Desc().EndKey)
 	})
@@ -531,6 +807,58 @@ func (s *Store) LookupRange(start, end proto.Key) *Range {
 	return s.rangesByKey[n]
 }
 
+// Scan scans the keys from start to end (exclusive) across all of the
+// store's ranges which they span, at a single timestamp so that the
+// stitched results are consistent. If timestamp is zero, the store's
+// clock supplies one. At most maxResults rows are returned, unless
+// maxResults is zero; when the limit is reached before end, the key
+// at which to resume the scan is returned as well. An error is
+// returned if some part of the span isn't held by a local range.
+func (s *Store) Scan(start, end proto.Key, timestamp proto.Timestamp, maxResults int64) ([]proto.KeyValue, proto.Key, error) {
+	if err := verifyKeys(start, end); err != nil {
+		return nil, nil, err
+	}
+	if timestamp.Equal(proto.ZeroTimestamp) {
+		timestamp = s.clock.Now()
+	}
+	var rows []proto.KeyValue
+	for key := start; key.Less(end); {
+		rng := s.LookupRange(key, key)
+		if rng == nil {
+			return nil, nil, util.Errorf("no range found for key %q", key)
+		}
+		desc := rng.Desc()
+		args := &proto.ScanRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       key,
+				EndKey:    end,
+				Timestamp: timestamp,
+				RaftID:    desc.RaftID,
+				Replica:   proto.Replica{StoreID: s.StoreID()},
+			},
+		}
+		if desc.EndKey.Less(end) {
+			args.EndKey = desc.EndKey
+		}
+		if maxResults > 0 {
+			args.MaxResults = maxResults - int64(len(rows))
+		}
+		reply := &proto.ScanResponse{}
+		if err := s.ExecuteCmd(proto.Scan, args, reply); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, reply.Rows...)
+		if maxResults > 0 && int64(len(rows)) >= maxResults {
+			if resume := rows[len(rows)-1].Key.Next(); resume.Less(end) {
+				return rows, resume, nil
+			}
+			return rows, nil, nil
+		}
+		key = args.EndKey
+	}
+	return rows, nil, nil
+}
+
 // BootstrapRange creates the first range in the cluster and manually
 // writes it to the store. Default range addressing records are
 // created for meta1 and meta2. Default configurations for accounting,
@@ -614,8 +942,34 @@ func (s *Store) BootstrapRange() error {
 	return nil
 }
 
+// SetShedTier configures the store to reject commands in the specified
+// tier and all lower priority tiers, for use when the store is
+// overloaded. Specifying TierBackground sheds only background work;
+// zero restores admission of all commands.
+func (s *Store) SetShedTier(tier CommandTier) {
+	atomic.StoreInt32(&s.shedTier, int32(tier))
+}
+
+// SetIntentResolutionConcurrency sets the maximum number of intents
+// of ended transactions the store's ranges resolve at once; further
+// resolutions are queued. It must be called before any of the
+// store's ranges end a transaction.
+func (s *Store) SetIntentResolutionConcurrency(n int) {
+	s.resolveSem = make(chan struct{}, n)
+}
+
 // The following methods implement the RangeManager interface.
 
+// AdmitCommand returns a retryable StoreOverloadedError if method
+// falls into a tier currently being shed.
+func (s *Store) AdmitCommand(method string) error {
+	shed := CommandTier(atomic.LoadInt32(&s.shedTier))
+	if shed != 0 && commandTier(method) >= shed {
+		return &proto.StoreOverloadedError{Method: method}
+	}
+	return nil
+}
+
 // ClusterID accessor.
 func (s *Store) ClusterID() string { return s.Ident.ClusterID }
 
@@ -642,6 +996,19 @@ func (s *Store) Allocator() *allocator { return s.allocator }
 // Gossip accessor.
 func (s *Store) Gossip() *gossip.Gossip { return s.gossip }
 
+// IntentResolutionSem returns the semaphore whose slots bound the
+// store's concurrent asynchronous intent resolutions.
+func (s *Store) IntentResolutionSem() chan struct{} { return s.resolveSem }
+
+// KeyAddress returns the address of key used to determine range
+// membership, as transformed by KeyAddressFunc if set.
+func (s *Store) KeyAddress(key proto.Key) proto.Key {
+	if s.KeyAddressFunc != nil {
+		return s.KeyAddressFunc(key)
+	}
+	return engine.KeyAddress(key)
+}
+
 // NewRangeDescriptor creates a new descriptor based on start and end
 // keys and the supplied proto.Replicas slice. It allocates new Raft
 // and range IDs to fill out the supplied replicas.
@@ -699,18 +1066,51 @@ func (s *Store) MergeRange(subsumingRng *Range, updatedEndKey proto.Key, subsume
 			subsumedRng.Desc().GetReplicas(), subsumingRng.Desc().GetReplicas())
 	}
 
-	// Remove and destroy the subsumed range.
+	// Remove the subsumed range, clearing its Raft ID-local metadata.
 	if err = s.RemoveRange(subsumedRng); err != nil {
 		return util.Errorf("cannot remove range %s", err)
 	}
 
-	// TODO(bram): The removed range needs to have all of its metadata removed.
-
 	// Update the end key of the subsuming range.
 	copy := *subsumingRng.Desc()
 	copy.EndKey = updatedEndKey
 	subsumingRng.SetDesc(&copy)
 
+	// Rebuild the bloom filter to include the subsumed range's keys.
+	if subsumingRng.bloomFilterBits > 0 {
+		return subsumingRng.loadBloomFilter()
+	}
+	return nil
+}
+
+// MergeRanges merges the right range into the left, extending the
+// left range to the right range's end key and removing the right range
+// from the store. The ranges must be adjacent and have equal replica
+// sets.
+func (s *Store) MergeRanges(left, right *Range) error {
+	desc := left.Desc()
+	args := &proto.AdminMergeRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:    desc.StartKey,
+			RaftID: desc.RaftID,
+		},
+		SubsumedRange: *right.Desc(),
+	}
+	return left.AddCmd(proto.AdminMerge, args, &proto.AdminMergeResponse{}, true)
+}
+
+// ForceRangeGC garbage collects each of the store's ranges in turn,
+// without waiting for the scanner to queue them. It's intended for
+// use in tests.
+func (s *Store) ForceRangeGC() error {
+	s.mu.RLock()
+	ranges := append(RangeSlice(nil), s.rangesByKey...)
+	s.mu.RUnlock()
+	for _, rng := range ranges {
+		if err := s.gcQueue.process(s.clock.Now(), rng); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -736,12 +1136,12 @@ func (s *Store) AddRange(rng *Range) error {
 // is held. Returns a rangeAlreadyExists error if a range with the
 // same Raft ID has already been added to this store.
 func (s *Store) addRangeInternal(rng *Range, resort bool) error {
-	rng.start()
 	// TODO(spencer); will need to determine which range is
 	// newer, and keep that one.
 	if exRng, ok := s.ranges[rng.Desc().RaftID]; ok {
 		return &rangeAlreadyExists{exRng}
 	}
+	s.startRange(rng)
 	s.ranges[rng.Desc().RaftID] = rng
 	s.rangesByKey = append(s.rangesByKey, rng)
 	if resort {
@@ -750,14 +1150,75 @@ func (s *Store) addRangeInternal(rng *Range, resort bool) error {
 	return nil
 }
 
+// ReplaceRange replaces the range with the same Raft ID as rng in the
+// store's range map and sorted rangesByKey slice. The replaced range
+// is stopped; its Raft group and persisted metadata are retained for
+// use by rng. Returns an error if no range with rng's Raft ID has been
+// added to the store. Use AddRange for ranges new to the store.
+func (s *Store) ReplaceRange(rng *Range) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	raftID := rng.Desc().RaftID
+	exRng, ok := s.ranges[raftID]
+	if !ok {
+		return proto.NewRangeNotFoundError(raftID)
+	}
+	exRng.stop()
+	for i, r := range s.rangesByKey {
+		if r == exRng {
+			s.rangesByKey = append(s.rangesByKey[:i], s.rangesByKey[i+1:]...)
+			break
+		}
+	}
+	s.startRange(rng)
+	s.ranges[raftID] = rng
+	s.rangesByKey = append(s.rangesByKey, rng)
+	sort.Sort(s.rangesByKey)
+	return nil
+}
+
+// startRange applies the store's range options to rng and starts it.
+func (s *Store) startRange(rng *Range) {
+	if s.BloomFilterBits > 0 {
+		rng.bloomFilterBits = s.BloomFilterBits
+	}
+	rng.closedTSTarget = s.ClosedTimestampTarget
+	rng.maxIntents = s.MaxRangeIntents
+	rng.maxCommandSize = s.MaxCommandSize
+	rng.maxPendingBytes = s.MaxPendingProposalBytes
+	rng.maxFutureOffset = s.MaxTimestampFutureOffset
+	rng.cmdQTimeout = s.CommandQueueTimeout
+	if s.TimestampCacheMaxEntries > 0 {
+		rng.Lock()
+		rng.tsCache.SetMaxEntries(s.TimestampCacheMaxEntries)
+		rng.Unlock()
+	}
+	if s.ValueCacheSize > 0 {
+		rng.valueCache = newValueCache(s.ValueCacheSize)
+	}
+	if s.MaxVersionsPerSecond > 0 {
+		rng.maxVersionsPerSecond = s.MaxVersionsPerSecond
+		rng.writeRates = newWriteRateTracker()
+	}
+	rng.configGossipInterval = s.ConfigGossipInterval
+	rng.loadGossipInterval = s.LoadGossipInterval
+	rng.start()
+}
+
 // RemoveRange removes the range from the store's range map and from
-// the sorted rangesByKey slice.
+// the sorted rangesByKey slice and clears the range's Raft ID-local
+// metadata from the underlying engine. A range tombstone is persisted
+// so that commands proposed by replicas of the removed range are
+// rejected should the range reappear.
 func (s *Store) RemoveRange(rng *Range) error {
 	// RemoveGroup needs to access the storage, which in turn needs the
 	// lock. Some care is needed to avoid deadlocks.
 	if err := s.multiraft.RemoveGroup(uint64(rng.Desc().RaftID)); err != nil {
 		return err
 	}
+	if s.scanner != nil {
+		s.scanner.RemoveRange(rng)
+	}
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	rng.stop()
@@ -771,7 +1232,15 @@ func (s *Store) RemoveRange(rng *Range) error {
 		return util.Errorf("couldn't find range in rangesByKey slice")
 	}
 	s.rangesByKey = append(s.rangesByKey[:n], s.rangesByKey[n+1:]...)
-	return nil
+	// Clear all metadata keyed by the range's Raft ID. Data addressed
+	// by key is left intact, as it may now belong to another range (as
+	// is the case with a merge).
+	kr := makeRangeIDKeyRange(rng.Desc().RaftID)
+	if err := s.engine.ClearRange(kr.start, kr.end); err != nil {
+		return err
+	}
+	tombstone := proto.RangeTombstone{Generation: rng.generation + 1}
+	return engine.MVCCPutProto(s.engine, nil, engine.RangeTombstoneKey(rng.Desc().RaftID), proto.ZeroTimestamp, nil, &tombstone)
 }
 
 // NewSnapshot creates a new snapshot engine.
@@ -789,6 +1258,14 @@ func (s *Store) Capacity() (engine.StoreCapacity, error) {
 	return s.engine.Capacity()
 }
 
+// CompactionStatus returns the compaction status of the store's
+// engine: the estimated bytes awaiting compaction and the time of the
+// last compaction. A growing backlog suggests foreground traffic
+// should be throttled.
+func (s *Store) CompactionStatus() engine.CompactionStatus {
+	return s.engine.CompactionStatus()
+}
+
 // Descriptor returns a StoreDescriptor including current store
 // capacity information.
 func (s *Store) Descriptor(nodeDesc *NodeDescriptor) (*StoreDescriptor, error) {
@@ -814,10 +1291,21 @@ func (s *Store) ExecuteCmd(method string, args proto.Request, reply proto.Respon
 	if err := verifyKeys(header.Key, header.EndKey); err != nil {
 		return err
 	}
+	// Get range to which the command will be added for execution.
+	rng, err := s.GetRange(header.RaftID)
+	if err != nil {
+		return err
+	}
+
 	if header.Timestamp.Equal(proto.ZeroTimestamp) {
 		// Update the incoming timestamp if unset.
 		header.Timestamp = s.clock.Now()
 	} else {
+		// Reject timestamps too far in the future before they can
+		// advance the clock.
+		if err := rng.verifyTimestampCeiling(header.Timestamp); err != nil {
+			return err
+		}
 		// Otherwise, update our clock with the incoming request. This
 		// advances the local node's clock to a high water mark from
 		// amongst all nodes with which it has interacted. The update is
@@ -828,11 +1316,13 @@ func (s *Store) ExecuteCmd(method string, args proto.Request, reply proto.Respon
 		}
 	}
 
-	// Get range and add command to the range for execution.
-	rng, err := s.GetRange(header.RaftID)
-	if err != nil {
-		return err
+	// Reads give up resolving conflicts with write intents once the
+	// conflict timeout has elapsed.
+	conflictTimeout := s.ConflictTimeout
+	if header.ConflictTimeout > 0 {
+		conflictTimeout = time.Duration(header.ConflictTimeout)
 	}
+	conflictDeadline := time.Now().Add(conflictTimeout)
 
 	// Backoff and retry loop for handling errors.
 	retryOpts := s.RetryOpts
@@ -858,6 +1348,12 @@ func (s *Store) ExecuteCmd(method string, args proto.Request, reply proto.Respon
 			header.Timestamp.Logical++
 			return util.RetryReset, nil
 		case *proto.WriteIntentError:
+			// If this is a read which has exceeded its conflict timeout,
+			// return a retryable error to the client instead.
+			if proto.IsReadOnly(method) && conflictTimeout > 0 && time.Now().After(conflictDeadline) {
+				reply.Header().SetGoError(&proto.ConflictTimeoutError{Key: t.Key, Txn: t.Txn})
+				return util.RetryBreak, nil
+			}
 			// If write intent error is resolved, exit retry/backoff loop to
 			// immediately retry.
 			if t.Resolved {
@@ -1009,12 +1505,20 @@ func (s *Store) processRaft() {
 			var cmd proto.InternalRaftCommand
 			var groupID int64
 			var commandID string
+			var index uint64
 			var callback func(error)
 
 			switch e := e.(type) {
 			case *multiraft.EventCommandCommitted:
+				if e.Command == nil {
+					// An empty entry appended by raft; it has no effect beyond
+					// occupying its index.
+					s.advanceAppliedIndex(int64(e.GroupID), e.Index)
+					continue
+				}
 				groupID = int64(e.GroupID)
 				commandID = e.CommandID
+				index = e.Index
 				err := gogoproto.Unmarshal(e.Command, &cmd)
 				if err != nil {
 					log.Fatal(err)
@@ -1023,6 +1527,7 @@ func (s *Store) processRaft() {
 			case *multiraft.EventMembershipChangeCommitted:
 				groupID = int64(e.GroupID)
 				commandID = e.CommandID
+				index = e.Index
 				callback = e.Callback
 				err := gogoproto.Unmarshal(e.Payload, &cmd)
 				if err != nil {
@@ -1042,11 +1547,17 @@ func (s *Store) processRaft() {
 			s.mu.Unlock()
 			var err error
 			if !ok {
-				err = util.Errorf("got committed raft command for %d but have no range with that ID: %+v",
-					groupID, cmd)
+				var tombstone proto.RangeTombstone
+				if found, _ := engine.MVCCGetProto(s.engine, engine.RangeTombstoneKey(groupID), proto.ZeroTimestamp, nil, &tombstone); found &&
+					cmd.Generation < tombstone.Generation {
+					err = &proto.RaftGroupDeletedError{RaftID: groupID}
+				} else {
+					err = util.Errorf("got committed raft command for %d but have no range with that ID: %+v",
+						groupID, cmd)
+				}
 				log.Error(err)
 			} else {
-				err = r.processRaftCommand(cmdIDKey(commandID), cmd)
+				err = r.processRaftCommand(cmdIDKey(commandID), index, cmd)
 			}
 			if callback != nil {
 				callback(err)
@@ -1060,6 +1571,20 @@ func (s *Store) processRaft() {
 	}
 }
 
+// advanceAppliedIndex records the application of an empty raft
+// entry at index to the range with the specified Raft ID.
+func (s *Store) advanceAppliedIndex(raftID int64, index uint64) {
+	s.mu.Lock()
+	r, ok := s.ranges[raftID]
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	if err := r.advanceAppliedIndex(index); err != nil {
+		log.Errorf("refusing to apply empty raft entry: %s", err)
+	}
+}
+
 // GroupStorage implements the multiraft.Storage interface.
 func (s *Store) GroupStorage(groupID uint64) multiraft.WriteableGroupStorage {
 	s.mu.Lock()
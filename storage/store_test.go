@@ -25,6 +25,8 @@ import (
 	"log"
 	"math"
 	"sort"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -272,6 +274,94 @@ func TestStoreAddRemoveRanges(t *testing.T) {
 	}
 }
 
+// TestStoreAddRangeDuplicate verifies that adding a range whose Raft
+// ID is already in use fails without disturbing the existing range,
+// and that ReplaceRange swaps in the new range.
+func TestStoreAddRangeDuplicate(t *testing.T) {
+	store, _ := createTestStore(t)
+	defer store.Stop()
+	rng1, err := store.GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dupRng, err := NewRange(testRangeDescriptor(), store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = store.AddRange(dupRng)
+	if _, ok := err.(*rangeAlreadyExists); !ok {
+		t.Fatalf("expected rangeAlreadyExists error; got %v", err)
+	}
+	if !strings.Contains(err.Error(), "duplicate range") {
+		t.Errorf("expected duplicate range error; got %s", err)
+	}
+	if rng, err := store.GetRange(1); err != nil || rng != rng1 {
+		t.Fatalf("expected original range to remain; got %v, %v", rng, err)
+	}
+
+	if err := store.ReplaceRange(dupRng); err != nil {
+		t.Fatal(err)
+	}
+	if rng, err := store.GetRange(1); err != nil || rng != dupRng {
+		t.Fatalf("expected replaced range; got %v, %v", rng, err)
+	}
+	if rng := store.LookupRange(proto.Key("a"), nil); rng != dupRng {
+		t.Errorf("expected lookup to find replaced range; got %v", rng)
+	}
+	if err := store.ReplaceRange(createRange(store, 2, proto.Key("a"), proto.Key("b"))); err == nil {
+		t.Error("expected error replacing range which doesn't exist")
+	}
+}
+
+// TestStoreRemoveRangeTombstone verifies that removing a range
+// persists a tombstone and that a replica of the removed range may
+// subsequently no longer propose commands.
+func TestStoreRemoveRangeTombstone(t *testing.T) {
+	store, _ := createTestStore(t)
+	defer store.Stop()
+
+	rng1, err := store.GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.RemoveRange(rng1); err != nil {
+		t.Fatal(err)
+	}
+	var tombstone proto.RangeTombstone
+	ok, err := engine.MVCCGetProto(store.Engine(), engine.RangeTombstoneKey(1), proto.ZeroTimestamp, nil, &tombstone)
+	if !ok || err != nil {
+		t.Fatalf("expected range tombstone; got %t, %v", ok, err)
+	}
+	if tombstone.Generation != rng1.generation+1 {
+		t.Errorf("expected tombstone generation %d; got %d", rng1.generation+1, tombstone.Generation)
+	}
+
+	// Recreate the range; it picks up its generation from the tombstone.
+	rng, err := NewRange(rng1.Desc(), store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rng.generation != tombstone.Generation {
+		t.Errorf("expected recreated range at generation %d; got %d", tombstone.Generation, rng.generation)
+	}
+
+	// The removed replica may no longer propose commands.
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, store.StoreID())
+	pArgs.Timestamp = store.clock.Now()
+	err = rng1.AddCmd(proto.Put, pArgs, pReply, true)
+	if _, ok := err.(*proto.RaftGroupDeletedError); !ok {
+		t.Fatalf("expected raft group deleted error; got %v", err)
+	}
+	if val, err := engine.MVCCGet(store.Engine(), proto.Key("a"), pArgs.Timestamp, nil); err != nil || val != nil {
+		t.Errorf("expected rejected command to write nothing; got %v, %v", val, err)
+	}
+
+	// The recreated replica, at the current generation, may.
+	if err := rng.verifyGeneration(); err != nil {
+		t.Errorf("expected recreated range to be at the current generation; got %v", err)
+	}
+}
+
 func TestStoreRangeIterator(t *testing.T) {
 	store, _ := createTestStore(t)
 	defer store.Stop()
@@ -296,17 +386,17 @@ func TestStoreRangeIterator(t *testing.T) {
 	// Verify two passes of the iteration.
 	iter := newStoreRangeIterator(store)
 	for pass := 0; pass < 2; pass++ {
-		for i := 1; iter.estimatedCount() > 0; i++ {
-			if rng := iter.next(); rng == nil || rng.Desc().RaftID != int64(i) {
+		for i := 1; iter.EstimatedCount() > 0; i++ {
+			if rng := iter.Next(); rng == nil || rng.Desc().RaftID != int64(i) {
 				t.Errorf("expected range with Raft ID %d; got %v", i, rng)
 			}
 		}
-		iter.reset()
+		iter.Reset()
 	}
 
 	// Try iterating with an addition.
-	iter.next()
-	if ec := iter.estimatedCount(); ec != 9 {
+	iter.Next()
+	if ec := iter.EstimatedCount(); ec != 9 {
 		t.Errorf("expected 9 remaining; got %d", ec)
 	}
 	// Insert range as second range.
@@ -314,14 +404,14 @@ func TestStoreRangeIterator(t *testing.T) {
 	if err := store.AddRange(rng); err != nil {
 		t.Fatal(err)
 	}
-	// Estimated count will still be 9, as it's cached, but next() will refresh.
-	if ec := iter.estimatedCount(); ec != 9 {
+	// Estimated count will still be 9, as it's cached, but Next() will refresh.
+	if ec := iter.EstimatedCount(); ec != 9 {
 		t.Errorf("expected 9 remaining; got %d", ec)
 	}
-	if r := iter.next(); r == nil || r != rng {
+	if r := iter.Next(); r == nil || r != rng {
 		t.Errorf("expected r==rng; got %d", r.Desc().RaftID)
 	}
-	if ec := iter.estimatedCount(); ec != 9 {
+	if ec := iter.EstimatedCount(); ec != 9 {
 		t.Errorf("expected 9 remaining; got %d", ec)
 	}
 
@@ -334,14 +424,14 @@ func TestStoreRangeIterator(t *testing.T) {
 	if err := store.RemoveRange(rng); err != nil {
 		t.Error(err)
 	}
-	if ec := iter.estimatedCount(); ec != 9 {
+	if ec := iter.EstimatedCount(); ec != 9 {
 		t.Errorf("expected 9 remaining; got %d", ec)
 	}
 	// Verify we skip removed range (id=2).
-	if r := iter.next(); r.Desc().RaftID != 3 {
+	if r := iter.Next(); r.Desc().RaftID != 3 {
 		t.Errorf("expected raftID=3; got %d", r.Desc().RaftID)
 	}
-	if ec := iter.estimatedCount(); ec != 7 {
+	if ec := iter.EstimatedCount(); ec != 7 {
 		t.Errorf("expected 7 remaining; got %d", ec)
 	}
 }
@@ -528,6 +618,95 @@ func TestStoreExecuteCmdOutOfRange(t *testing.T) {
 	}
 }
 
+// TestStoreMinClosedTimestamp verifies that the minimum closed
+// timestamp of a set of ranges is the lowest of their closed
+// timestamps.
+func TestStoreMinClosedTimestamp(t *testing.T) {
+	store, _ := createTestStore(t)
+	defer store.Stop()
+	rng1, err := store.GetRange(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng2 := splitTestRange(store, engine.KeyMin, proto.Key("a"), t)
+
+	ts1, ts2 := makeTS(2*1E9, 0), makeTS(1*1E9, 0)
+	rng1.forwardClosedTimestamp(ts1)
+	rng2.forwardClosedTimestamp(ts2)
+	raftIDs := []int64{rng1.Desc().RaftID, rng2.Desc().RaftID}
+	if minTS, err := store.MinClosedTimestamp(raftIDs); err != nil || !minTS.Equal(ts2) {
+		t.Errorf("expected min closed timestamp %s; got %s, %v", ts2, minTS, err)
+	}
+	if _, err := store.MinClosedTimestamp(append(raftIDs, 1000)); err == nil {
+		t.Error("expected error for missing range")
+	}
+}
+
+// TestStoreScan verifies that a store scan stitches together the
+// results of scans across ranges at a single timestamp, and returns a
+// resume key when the max results are reached.
+func TestStoreScan(t *testing.T) {
+	store, mc := createTestStore(t)
+	defer store.Stop()
+	splitTestRange(store, engine.KeyMin, proto.Key("c"), t)
+
+	put := func(key, value string) {
+		rng := store.LookupRange(proto.Key(key), proto.Key(key))
+		args, reply := putArgs(proto.Key(key), []byte(value), rng.Desc().RaftID, store.StoreID())
+		args.Timestamp = store.Clock().Now()
+		if err := store.ExecuteCmd(proto.Put, args, reply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	keys := []string{"a", "b", "c", "d", "e"}
+	for _, key := range keys {
+		put(key, "v1")
+	}
+	mc.Increment(1)
+	ts := store.Clock().Now()
+	mc.Increment(1)
+	// Newer writes aren't visible to a scan at the earlier timestamp.
+	put("b", "v2")
+	put("d", "v2")
+
+	verify := func(rows []proto.KeyValue, expKeys []string) {
+		if len(rows) != len(expKeys) {
+			t.Fatalf("expected %d rows; got %d", len(expKeys), len(rows))
+		}
+		for i, row := range rows {
+			if !row.Key.Equal(proto.Key(expKeys[i])) || !bytes.Equal(row.Value.Bytes, []byte("v1")) {
+				t.Errorf("%d: expected %q=v1; got %q=%q", i, expKeys[i], row.Key, row.Value.Bytes)
+			}
+		}
+	}
+	rows, resume, err := store.Scan(proto.Key("a"), proto.Key("z"), ts, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify(rows, keys)
+	if resume != nil {
+		t.Errorf("expected no resume key; got %q", resume)
+	}
+
+	// Scan in batches of three, resuming in the second range.
+	rows, resume, err = store.Scan(proto.Key("a"), proto.Key("z"), ts, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify(rows, keys[:3])
+	if !resume.Equal(proto.Key("c").Next()) {
+		t.Fatalf("expected resume key %q; got %q", proto.Key("c").Next(), resume)
+	}
+	rows, resume, err = store.Scan(resume, proto.Key("z"), ts, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify(rows, keys[3:])
+	if resume != nil {
+		t.Errorf("expected no resume key; got %q", resume)
+	}
+}
+
 // TestStoreRaftIDAllocation verifies that raft IDs are
 // allocated in successive blocks.
 func TestStoreRaftIDAllocation(t *testing.T) {
@@ -787,6 +966,117 @@ func TestStoreResolveWriteIntentPushOnRead(t *testing.T) {
 	}
 }
 
+// TestStoreResolveWriteIntentConflictTimeout verifies that a read
+// which is unable to push a conflicting intent gives up with a
+// retryable ConflictTimeoutError once its conflict timeout elapses.
+func TestStoreResolveWriteIntentConflictTimeout(t *testing.T) {
+	store, _ := createTestStore(t)
+	defer store.Stop()
+
+	key := proto.Key("a")
+	pusher := newTransaction("test", key, 1, proto.SERIALIZABLE, store.clock)
+	pushee := newTransaction("test", key, 1, proto.SERIALIZABLE, store.clock)
+	pushee.Priority = 2
+	pusher.Priority = 1 // Pusher will lose.
+
+	// Lay down intent using the pushee's txn.
+	args, reply := putArgs(key, []byte("value"), 1, store.StoreID())
+	args.Timestamp = store.clock.Now()
+	args.Txn = pushee
+	if err := store.ExecuteCmd(proto.Put, args, reply); err != nil {
+		t.Fatal(err)
+	}
+
+	// Now, try to read using the pusher's txn with a short timeout. The
+	// store's retry options retry indefinitely, so without the timeout
+	// the read would never return.
+	gArgs, gReply := getArgs(key, 1, store.StoreID())
+	gArgs.Timestamp = store.clock.Now()
+	gArgs.Txn = pusher
+	gArgs.ConflictTimeout = (10 * time.Millisecond).Nanoseconds()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- store.ExecuteCmd(proto.Get, gArgs, gReply)
+	}()
+	select {
+	case err := <-errChan:
+		ctErr, ok := err.(*proto.ConflictTimeoutError)
+		if !ok {
+			t.Fatalf("expected conflict timeout error; got %v", err)
+		}
+		if !ctErr.CanRetry() {
+			t.Errorf("expected conflict timeout error to be retryable")
+		}
+		if !bytes.Equal(ctErr.Txn.ID, pushee.ID) {
+			t.Errorf("expected error to reference pushee %q; got %s", pushee.ID, ctErr.Txn)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read did not return after conflict timeout elapsed")
+	}
+}
+
+// TestStoreReadMaxStaleness verifies that a non-transactional read
+// specifying a sufficient MaxStaleness returns the committed value
+// beneath a conflicting intent instead of pushing its transaction,
+// and that an insufficient MaxStaleness falls back to pushing.
+func TestStoreReadMaxStaleness(t *testing.T) {
+	store, manual := createTestStore(t)
+	defer store.Stop()
+
+	// Write the original value at 1s.
+	key := proto.Key("a")
+	manual.Set(1 * time.Second.Nanoseconds())
+	args, reply := putArgs(key, []byte("value1"), 1, store.StoreID())
+	args.Timestamp = store.clock.Now()
+	if err := store.ExecuteCmd(proto.Put, args, reply); err != nil {
+		t.Fatal(err)
+	}
+	committedTS := args.Timestamp
+
+	// Lay down an intent at 2s using a txn which will win any push.
+	manual.Set(2 * time.Second.Nanoseconds())
+	pushee := newTransaction("test", key, 1, proto.SERIALIZABLE, store.clock)
+	pushee.Priority = math.MaxInt32
+	args.Timestamp = pushee.Timestamp
+	args.Txn = pushee
+	args.Value.Bytes = []byte("value2")
+	if err := store.ExecuteCmd(proto.Put, args, reply); err != nil {
+		t.Fatal(err)
+	}
+
+	// Read at 3s, tolerating 5s of staleness.
+	manual.Set(3 * time.Second.Nanoseconds())
+	gArgs, gReply := getArgs(key, 1, store.StoreID())
+	gArgs.Timestamp = store.clock.Now()
+	gArgs.MaxStaleness = (5 * time.Second).Nanoseconds()
+	if err := store.ExecuteCmd(proto.Get, gArgs, gReply); err != nil {
+		t.Fatal(err)
+	}
+	if gReply.Value == nil || !bytes.Equal(gReply.Value.Bytes, []byte("value1")) {
+		t.Errorf("expected stale read of value1; got %+v", gReply.Value)
+	} else if !gReply.Value.Timestamp.Equal(committedTS) {
+		t.Errorf("expected value timestamp %s; got %s", committedTS, gReply.Value.Timestamp)
+	}
+	// The pushee's txn must not have been pushed.
+	var txn proto.Transaction
+	txnKey := engine.TransactionKey(pushee.Key, pushee.ID)
+	if ok, err := engine.MVCCGetProto(store.Engine(), txnKey, proto.ZeroTimestamp, nil, &txn); ok || err != nil {
+		t.Errorf("expected no pushee txn record; got %t, %v", ok, err)
+	}
+
+	// With less tolerance than the committed value's age, the read
+	// pushes; the push fails and the read times out.
+	gArgs, gReply = getArgs(key, 1, store.StoreID())
+	gArgs.Timestamp = store.clock.Now()
+	gArgs.MaxStaleness = (1 * time.Millisecond).Nanoseconds()
+	gArgs.ConflictTimeout = (10 * time.Millisecond).Nanoseconds()
+	if err := store.ExecuteCmd(proto.Get, gArgs, gReply); err == nil {
+		t.Errorf("expected read with insufficient staleness to fail")
+	} else if _, ok := err.(*proto.ConflictTimeoutError); !ok {
+		t.Errorf("expected conflict timeout error; got %v", err)
+	}
+}
+
 // TestStoreResolveWriteIntentSnapshotIsolation verifies that the
 // timestamp can always be pushed if txn has snapshot isolation.
 func TestStoreResolveWriteIntentSnapshotIsolation(t *testing.T) {
@@ -958,3 +1248,76 @@ func TestRaftNodeID(t *testing.T) {
 		}()
 	}
 }
+
+// compactionRecordingEngine wraps an engine, reporting the bytes
+// written since its last CompactRange as pending compaction bytes.
+type compactionRecordingEngine struct {
+	engine.Engine
+	sync.Mutex
+	pendingBytes   int64
+	lastCompaction time.Time
+}
+
+func (e *compactionRecordingEngine) WriteBatch(cmds []interface{}) error {
+	e.Lock()
+	for _, cmd := range cmds {
+		switch c := cmd.(type) {
+		case engine.BatchPut:
+			e.pendingBytes += int64(len(c.Key) + len(c.Value))
+		case engine.BatchMerge:
+			e.pendingBytes += int64(len(c.Key) + len(c.Value))
+		}
+	}
+	e.Unlock()
+	return e.Engine.WriteBatch(cmds)
+}
+
+func (e *compactionRecordingEngine) CompactRange(start, end proto.EncodedKey) {
+	e.Engine.CompactRange(start, end)
+	e.Lock()
+	defer e.Unlock()
+	e.pendingBytes = 0
+	e.lastCompaction = time.Now()
+}
+
+func (e *compactionRecordingEngine) CompactionStatus() engine.CompactionStatus {
+	e.Lock()
+	defer e.Unlock()
+	return engine.CompactionStatus{PendingBytes: e.pendingBytes, LastCompaction: e.lastCompaction}
+}
+
+func (e *compactionRecordingEngine) NewBatch() engine.Engine {
+	return engine.NewBatch(e)
+}
+
+// TestStoreCompactionStatus verifies that the store reports its
+// engine's compaction status, and that a compaction updates the last
+// compaction time and pending bytes.
+func TestStoreCompactionStatus(t *testing.T) {
+	eng := &compactionRecordingEngine{
+		Engine: engine.NewInMem(proto.Attributes{}, 1<<20),
+	}
+	tc := testContext{engine: eng}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pArgs, pReply := putArgs([]byte("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(proto.Put, pArgs, pReply, true); err != nil {
+		t.Fatal(err)
+	}
+	status := tc.store.CompactionStatus()
+	if status.PendingBytes == 0 || !status.LastCompaction.IsZero() {
+		t.Fatalf("expected pending bytes and no compaction; got %+v", status)
+	}
+
+	before := time.Now()
+	tc.store.Engine().CompactRange(nil, nil)
+	status = tc.store.CompactionStatus()
+	if status.PendingBytes != 0 {
+		t.Errorf("expected no pending bytes after compaction; got %d", status.PendingBytes)
+	}
+	if status.LastCompaction.Before(before) {
+		t.Errorf("expected last compaction after %s; got %s", before, status.LastCompaction)
+	}
+}
@@ -48,9 +48,15 @@ const (
 // recently evicted entry's timestamp. This value always ratchets
 // with monotonic increases. The low water mark is initialized to
 // the current system time plus the maximum clock offset.
+//
+// Entries are evicted once they fall outside the minCacheWindow or,
+// if a maximum number of entries is set, once the cache exceeds it,
+// oldest first. Evicted entries are folded into the low water mark,
+// which GetMax returns for keys without entries.
 type TimestampCache struct {
 	cache            *util.IntervalCache
 	lowWater, latest proto.Timestamp
+	maxEntries       int // Zero for no maximum
 }
 
 // A cacheEntry combines the timestamp with an optional MD5 of the
@@ -72,6 +78,13 @@ func NewTimestampCache(clock *hlc.Clock) *TimestampCache {
 	return tc
 }
 
+// SetMaxEntries bounds the number of entries in the cache, evicting
+// the oldest entries beyond the maximum. Zero leaves the cache
+// unbounded.
+func (tc *TimestampCache) SetMaxEntries(maxEntries int) {
+	tc.maxEntries = maxEntries
+}
+
 // Clear clears the cache and resets the low water mark to the
 // current time plus the maximum clock offset.
 func (tc *TimestampCache) Clear(clock *hlc.Clock) {
@@ -146,17 +159,27 @@ func (tc *TimestampCache) GetMax(start, end proto.Key, txnMD5 [md5.Size]byte) (p
 	return maxR, maxW
 }
 
+// LowWater returns the cache's low water mark, the timestamp returned
+// by GetMax for keys which have no entry in the cache.
+func (tc *TimestampCache) LowWater() proto.Timestamp {
+	return tc.lowWater
+}
+
 // shouldEvict returns true if the cache entry's timestamp is no
-// longer within the minCacheWindow.
+// longer within the minCacheWindow, or if the cache holds more than
+// its maximum number of entries.
 func (tc *TimestampCache) shouldEvict(size int, key, value interface{}) bool {
 	ce := value.(cacheEntry)
 	// Compute the edge of the cache window.
 	edge := tc.latest
 	edge.WallTime -= minCacheWindow.Nanoseconds()
 	// We evict and update the low water mark if the proposed evictee's
-	// timestamp is <= than the edge of the window.
-	if !edge.Less(ce.timestamp) {
-		tc.lowWater = ce.timestamp
+	// timestamp is <= than the edge of the window, or if the cache is
+	// over capacity.
+	if !edge.Less(ce.timestamp) || (tc.maxEntries > 0 && size > tc.maxEntries) {
+		if tc.lowWater.Less(ce.timestamp) {
+			tc.lowWater = ce.timestamp
+		}
 		return true
 	}
 	return false
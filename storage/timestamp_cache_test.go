@@ -19,6 +19,7 @@ package storage
 
 import (
 	"crypto/md5"
+	"fmt"
 	"testing"
 	"time"
 
@@ -123,6 +124,55 @@ func TestTimestampCacheEviction(t *testing.T) {
 	}
 }
 
+// TestTimestampCacheMaxEntries verifies that a cache with a maximum
+// number of entries stays within it, evicting the oldest entries into
+// the low water mark, and that GetMax remains safe for evicted keys.
+func TestTimestampCacheMaxEntries(t *testing.T) {
+	manual := hlc.NewManualClock(0)
+	clock := hlc.NewClock(manual.UnixNano)
+	clock.SetMaxOffset(maxClockOffset)
+	tc := NewTimestampCache(clock)
+	const maxEntries = 10
+	tc.SetMaxEntries(maxEntries)
+
+	manual.Set(maxClockOffset.Nanoseconds() + 1)
+	var timestamps []proto.Timestamp
+	for i := 0; i < 100; i++ {
+		manual.Increment(1)
+		ts := clock.Now()
+		timestamps = append(timestamps, ts)
+		tc.Add(proto.Key(fmt.Sprintf("%03d", i)), nil, ts, proto.NoTxnMD5, i%2 == 0)
+		if l := tc.cache.Len(); l > maxEntries {
+			t.Fatalf("%d: expected at most %d entries; got %d", i, maxEntries, l)
+		}
+	}
+
+	// The low water mark is the timestamp of the last evicted entry.
+	evicted := len(timestamps) - maxEntries
+	if lw := tc.LowWater(); !lw.Equal(timestamps[evicted-1]) {
+		t.Errorf("expected low water mark %s; got %s", timestamps[evicted-1], lw)
+	}
+	// Evicted keys return the low water mark, which is at least as
+	// recent as their own timestamps.
+	for i := 0; i < evicted; i++ {
+		rTS, wTS := tc.GetMax(proto.Key(fmt.Sprintf("%03d", i)), nil, proto.NoTxnMD5)
+		if rTS.Less(timestamps[i]) || wTS.Less(timestamps[i]) {
+			t.Errorf("%d: expected max timestamps >= %s; got %s, %s", i, timestamps[i], rTS, wTS)
+		}
+	}
+	// Retained keys return their own timestamps.
+	for i := evicted; i < len(timestamps); i++ {
+		rTS, wTS := tc.GetMax(proto.Key(fmt.Sprintf("%03d", i)), nil, proto.NoTxnMD5)
+		ts := rTS
+		if i%2 != 0 {
+			ts = wTS
+		}
+		if !ts.Equal(timestamps[i]) {
+			t.Errorf("%d: expected timestamp %s; got %s", i, timestamps[i], ts)
+		}
+	}
+}
+
 // TestTimestampCacheLayeredIntervals verifies the maximum timestamp
 // is chosen if previous entries have ranges which are layered over
 // each other.
@@ -0,0 +1,118 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"sync"
+
+	"code.google.com/p/biogo.store/llrb"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// valueCacheKey is the key type of the value cache, ordering entries
+// by key and then by read timestamp.
+type valueCacheKey struct {
+	key       string
+	timestamp proto.Timestamp
+}
+
+// Compare implements the llrb.Comparable interface for valueCacheKey.
+func (a valueCacheKey) Compare(b llrb.Comparable) int {
+	bk := b.(valueCacheKey)
+	if c := bytes.Compare([]byte(a.key), []byte(bk.key)); c != 0 {
+		return c
+	}
+	if a.timestamp.Less(bk.timestamp) {
+		return -1
+	} else if bk.timestamp.Less(a.timestamp) {
+		return 1
+	}
+	return 0
+}
+
+// A valueCache is an LRU cache of the values read by non-transactional
+// Gets, keyed by key and read timestamp. Absent keys are cached as nil
+// values. Entries for a key must be invalidated on every write to it.
+type valueCache struct {
+	sync.Mutex
+	cache *util.OrderedCache
+}
+
+// newValueCache returns a new, empty valueCache holding up to the
+// specified number of entries.
+func newValueCache(size int) *valueCache {
+	return &valueCache{
+		cache: util.NewOrderedCache(util.CacheConfig{
+			Policy: util.CacheLRU,
+			ShouldEvict: func(n int, k, v interface{}) bool {
+				return n > size
+			},
+		}),
+	}
+}
+
+// get returns the value cached for key at timestamp, and whether one
+// was found.
+func (vc *valueCache) get(key proto.Key, timestamp proto.Timestamp) (*proto.Value, bool) {
+	vc.Lock()
+	defer vc.Unlock()
+	v, ok := vc.cache.Get(valueCacheKey{string(key), timestamp})
+	if !ok {
+		return nil, false
+	}
+	if v == nil {
+		return nil, true
+	}
+	return gogoproto.Clone(v.(*proto.Value)).(*proto.Value), true
+}
+
+// add caches value, which may be nil, for key at timestamp.
+func (vc *valueCache) add(key proto.Key, timestamp proto.Timestamp, value *proto.Value) {
+	var v interface{}
+	if value != nil {
+		v = gogoproto.Clone(value).(*proto.Value)
+	}
+	vc.Lock()
+	defer vc.Unlock()
+	vc.cache.Add(valueCacheKey{string(key), timestamp}, v)
+}
+
+// invalidate removes the entries for all keys in the span from start
+// to end (exclusive), or for start alone if end is empty.
+func (vc *valueCache) invalidate(start, end proto.Key) {
+	if len(end) == 0 {
+		end = start.Next()
+	}
+	vc.Lock()
+	defer vc.Unlock()
+	for {
+		k, _, ok := vc.cache.Ceil(valueCacheKey{key: string(start)})
+		if !ok || !proto.Key(k.(valueCacheKey).key).Less(end) {
+			return
+		}
+		vc.cache.Del(k)
+	}
+}
+
+// clear removes all entries.
+func (vc *valueCache) clear() {
+	vc.Lock()
+	defer vc.Unlock()
+	vc.cache.Clear()
+}
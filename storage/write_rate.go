@@ -0,0 +1,71 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// writeRateTrackerSize is the number of keys whose write rates are
+// tracked by a writeRateTracker.
+const writeRateTrackerSize = 1024
+
+// writeRate is the number of writes to a key within a one second
+// window, identified by its start in seconds.
+type writeRate struct {
+	window int64
+	count  int
+}
+
+// A writeRateTracker counts the writes to each key within one second
+// windows of their wall times. Only the most recently written keys are
+// tracked, so the counts of keys written less often may be reset.
+type writeRateTracker struct {
+	sync.Mutex
+	cache *util.UnorderedCache
+}
+
+// newWriteRateTracker returns a new, empty writeRateTracker.
+func newWriteRateTracker() *writeRateTracker {
+	return &writeRateTracker{
+		cache: util.NewUnorderedCache(util.CacheConfig{
+			Policy: util.CacheLRU,
+			ShouldEvict: func(n int, k, v interface{}) bool {
+				return n > writeRateTrackerSize
+			},
+		}),
+	}
+}
+
+// record records a write to key at wallTime nanoseconds and returns
+// the number of writes to key within the same one second window,
+// including this one.
+func (wt *writeRateTracker) record(key proto.Key, wallTime int64) int {
+	window := wallTime / 1E9
+	wt.Lock()
+	defer wt.Unlock()
+	if v, ok := wt.cache.Get(string(key)); ok {
+		if wr := v.(*writeRate); wr.window == window {
+			wr.count++
+			return wr.count
+		}
+	}
+	wt.cache.Add(string(key), &writeRate{window: window, count: 1})
+	return 1
+}